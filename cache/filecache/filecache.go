@@ -16,6 +16,7 @@ package filecache
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
@@ -24,6 +25,8 @@ import (
 	"time"
 
 	"github.com/gohugoio/hugo/common/hugio"
+	"github.com/gohugoio/hugo/common/loggers"
+	"github.com/gohugoio/hugo/config/security"
 
 	"github.com/gohugoio/hugo/helpers"
 
@@ -50,7 +53,29 @@ type Cache struct {
 	// When set, we just remove this entire root directory on expiration.
 	pruneAllRootDir string
 
+	// The max size in bytes, see PruneToMaxSize.
+	maxSize int64
+
 	nlocker *lockTracker
+
+	// The shared remote cache, if configured.
+	remote *remoteCache
+
+	// The hash of the entries' inputs used in the remote cache keys, see WithInputHash.
+	inputHash string
+}
+
+// WithInputHash returns a view of c where the entries are shared in the
+// remote cache keyed by both their ID and the given hash of their inputs.
+// This is needed for the caches where the ID isn't derived from the
+// entry's inputs, e.g. the assets cache.
+func (c *Cache) WithInputHash(hash string) *Cache {
+	if c.remote == nil {
+		return c
+	}
+	cc := *c
+	cc.inputHash = hash
+	return &cc
 }
 
 type lockTracker struct {
@@ -96,11 +121,26 @@ func NewCache(fs afero.Fs, maxAge time.Duration, pruneAllRootDir string) *Cache
 type lockedFile struct {
 	afero.File
 	unlock func()
+
+	// Called after a successful Close, before unlock. The func returned,
+	// if any, is called after unlock.
+	closed func() func()
 }
 
 func (l *lockedFile) Close() error {
-	defer l.unlock()
-	return l.File.Close()
+	if err := l.File.Close(); err != nil {
+		l.unlock()
+		return err
+	}
+	var after func()
+	if l.closed != nil {
+		after = l.closed()
+	}
+	l.unlock()
+	if after != nil {
+		after()
+	}
+	return nil
 }
 
 // WriteCloser returns a transactional writer into the cache.
@@ -120,6 +160,7 @@ func (c *Cache) WriteCloser(id string) (ItemInfo, io.WriteCloser, error) {
 	return info, &lockedFile{
 		File:   f,
 		unlock: func() { c.nlocker.Unlock(id) },
+		closed: func() func() { return c.readForRemote(id) },
 	}, nil
 }
 
@@ -133,6 +174,13 @@ func (c *Cache) ReadOrCreate(id string,
 	id = cleanID(id)
 
 	c.nlocker.Lock(id)
+	// Upload to the remote cache, if needed, after the lock is released.
+	var putRemote func()
+	defer func() {
+		if putRemote != nil {
+			putRemote()
+		}
+	}()
 	defer c.nlocker.Unlock(id)
 
 	info = ItemInfo{Name: id}
@@ -155,6 +203,9 @@ func (c *Cache) ReadOrCreate(id string,
 	}
 
 	err = create(info, f)
+	if err == nil {
+		putRemote = c.readForRemote(id)
+	}
 
 	return
 }
@@ -166,6 +217,12 @@ func (c *Cache) GetOrCreate(id string, create func() (io.ReadCloser, error)) (It
 	id = cleanID(id)
 
 	c.nlocker.Lock(id)
+	var putRemote func()
+	defer func() {
+		if putRemote != nil {
+			putRemote()
+		}
+	}()
 	defer c.nlocker.Unlock(id)
 
 	info := ItemInfo{Name: id}
@@ -190,9 +247,14 @@ func (c *Cache) GetOrCreate(id string, create func() (io.ReadCloser, error)) (It
 	}
 
 	var buff bytes.Buffer
-	return info,
-		hugio.ToReadCloser(&buff),
-		afero.WriteReader(c.Fs, id, io.TeeReader(r, &buff))
+	if err := afero.WriteReader(c.Fs, id, io.TeeReader(r, &buff)); err != nil {
+		return info, hugio.ToReadCloser(&buff), err
+	}
+	if c.remote != nil {
+		b := buff.Bytes()
+		putRemote = func() { c.remote.put(id, c.inputHash, b) }
+	}
+	return info, hugio.ToReadCloser(&buff), nil
 }
 
 // GetOrCreateBytes is the same as GetOrCreate, but produces a byte slice.
@@ -200,6 +262,12 @@ func (c *Cache) GetOrCreateBytes(id string, create func() ([]byte, error)) (Item
 	id = cleanID(id)
 
 	c.nlocker.Lock(id)
+	var putRemote func()
+	defer func() {
+		if putRemote != nil {
+			putRemote()
+		}
+	}()
 	defer c.nlocker.Unlock(id)
 
 	info := ItemInfo{Name: id}
@@ -227,6 +295,9 @@ func (c *Cache) GetOrCreateBytes(id string, create func() ([]byte, error)) (Item
 	if err := afero.WriteReader(c.Fs, id, bytes.NewReader(b)); err != nil {
 		return info, nil, err
 	}
+	if c.remote != nil {
+		putRemote = func() { c.remote.put(id, c.inputHash, b) }
+	}
 	return info, b, nil
 }
 
@@ -284,12 +355,46 @@ func (c *Cache) getOrRemove(id string) hugio.ReadSeekCloser {
 
 	f, err := c.Fs.Open(id)
 	if err != nil {
-		return nil
+		return c.getRemote(id)
 	}
 
 	return f
 }
 
+// getRemote fetches the file with the given id from the remote cache, if
+// configured, and stores it in this cache.
+func (c *Cache) getRemote(id string) hugio.ReadSeekCloser {
+	if c.remote == nil {
+		return nil
+	}
+	b := c.remote.get(id, c.inputHash)
+	if b == nil {
+		return nil
+	}
+	if err := afero.WriteReader(c.Fs, id, bytes.NewReader(b)); err != nil {
+		return nil
+	}
+	f, err := c.Fs.Open(id)
+	if err != nil {
+		return nil
+	}
+	return f
+}
+
+// readForRemote reads the file with the given id, with the lock held, and
+// returns a func storing it in the remote cache, if configured, to be
+// called when the lock is released.
+func (c *Cache) readForRemote(id string) func() {
+	if c.remote == nil {
+		return nil
+	}
+	b, err := afero.ReadFile(c.Fs, id)
+	if err != nil {
+		return nil
+	}
+	return func() { c.remote.put(id, c.inputHash, b) }
+}
+
 func (c *Cache) isExpired(modTime time.Time) bool {
 	if c.maxAge < 0 {
 		return false
@@ -326,11 +431,29 @@ func (f Caches) Get(name string) *Cache {
 }
 
 // NewCaches creates a new set of file caches from the given
-// configuration.
-func NewCaches(p *helpers.PathSpec) (Caches, error) {
+// configuration. The logger is used to report remote cache problems.
+func NewCaches(p *helpers.PathSpec, logger loggers.Logger) (Caches, error) {
 	dcfg := p.Cfg.GetConfigSection("caches").(Configs)
+	rcfg, _ := p.Cfg.GetConfigSection("remoteCache").(RemoteConfig)
 	fs := p.Fs.Source
 
+	remote := make(map[string]bool)
+	if rcfg.Enabled() {
+		if sc, ok := p.Cfg.GetConfigSection("security").(security.Config); ok {
+			if err := checkRemoteConfig(rcfg, sc); err != nil {
+				return nil, fmt.Errorf("remoteCache: %w", err)
+			}
+		}
+		for _, name := range rcfg.Caches {
+			if maxAge := dcfg[name].MaxAge; maxAge == 0 {
+				// Disabled, e.g. with --ignoreCache.
+				continue
+			} else if maxAge > 0 {
+				return nil, fmt.Errorf("remoteCache: the %s cache must never expire (maxAge = -1) to be shared", name)
+			}
+			remote[name] = true
+		}
+	}
 	m := make(Caches)
 	for k, v := range dcfg {
 		var cfs afero.Fs
@@ -359,6 +482,10 @@ func NewCaches(p *helpers.PathSpec) (Caches, error) {
 		}
 
 		m[k] = NewCache(bfs, v.MaxAge, pruneAllRootDir)
+		m[k].maxSize = v.MaxSize
+		if remote[k] {
+			m[k].remote = newRemoteCache(k, rcfg, logger)
+		}
 	}
 
 	return m, nil
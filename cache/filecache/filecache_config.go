@@ -18,9 +18,11 @@ import (
 	"fmt"
 	"path"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/gohugoio/hugo/common/maps"
 	"github.com/gohugoio/hugo/config"
 
@@ -47,6 +49,9 @@ const (
 	CacheKeyAssets      = "assets"
 	CacheKeyModules     = "modules"
 	CacheKeyGetResource = "getresource"
+	CacheKeyEmbeddings  = "embeddings"
+	CacheKeyGitInfo     = "gitinfo"
+	CacheKeyLinkCheck   = "linkcheck"
 )
 
 type Configs map[string]FileCacheConfig
@@ -75,6 +80,19 @@ var defaultCacheConfigs = Configs{
 		MaxAge: -1, // Never expire
 		Dir:    cacheDirProject,
 	},
+	CacheKeyEmbeddings: defaultCacheConfig,
+	CacheKeyGitInfo: {
+		// The entries are keyed by the HEAD commit,
+		// so prune those not used for a while.
+		MaxAge: 30 * 24 * time.Hour,
+		Dir:    cacheDirProject,
+	},
+	CacheKeyLinkCheck: {
+		// The external URLs found to work are not checked again
+		// until their entries expire.
+		MaxAge: 24 * time.Hour,
+		Dir:    cacheDirProject,
+	},
 }
 
 type FileCacheConfig struct {
@@ -87,6 +105,12 @@ type FileCacheConfig struct {
 	// Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
 	MaxAge time.Duration
 
+	// The max size in bytes of this cache, e.g. "500MB". When the cache
+	// grows above this after a build, the expired and unused entries are
+	// removed, then the least recently modified ones until it's below 80%
+	// of the max size. 0 means no limit. Not supported for the modules cache.
+	MaxSize int64
+
 	// The directory where files are stored.
 	Dir         string
 	DirCompiled string `json:"-"`
@@ -126,6 +150,21 @@ func (f Caches) GetResourceCache() *Cache {
 	return f[CacheKeyGetResource]
 }
 
+// EmbeddingsCache gets the file cache for the embedding vectors used by related content.
+func (f Caches) EmbeddingsCache() *Cache {
+	return f[CacheKeyEmbeddings]
+}
+
+// GitInfoCache gets the file cache for the Git info, see enableGitInfo.
+func (f Caches) GitInfoCache() *Cache {
+	return f[CacheKeyGitInfo]
+}
+
+// LinkCheckCache gets the file cache for the external links found to work, see checkLinks.
+func (f Caches) LinkCheckCache() *Cache {
+	return f[CacheKeyLinkCheck]
+}
+
 func DecodeConfig(fs afero.Fs, bcfg config.BaseConfig, m map[string]any) (Configs, error) {
 	c := make(Configs)
 	valid := make(map[string]bool)
@@ -145,7 +184,7 @@ func DecodeConfig(fs afero.Fs, bcfg config.BaseConfig, m map[string]any) (Config
 
 		dc := &mapstructure.DecoderConfig{
 			Result:           &cc,
-			DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+			DecodeHook:       mapstructure.ComposeDecodeHookFunc(mapstructure.StringToTimeDurationHookFunc(), stringToByteSizeHookFunc),
 			WeaklyTypedInput: true,
 		}
 
@@ -167,6 +206,13 @@ func DecodeConfig(fs afero.Fs, bcfg config.BaseConfig, m map[string]any) (Config
 			return nil, fmt.Errorf("%q is not a valid cache name", name)
 		}
 
+		if cc.MaxSize < 0 {
+			return nil, fmt.Errorf("invalid maxSize for the %s cache, must be 0 or positive", name)
+		}
+		if cc.MaxSize > 0 && name == CacheKeyModules {
+			return nil, errors.New("maxSize is not supported for the modules cache")
+		}
+
 		c[name] = cc
 	}
 
@@ -223,6 +269,22 @@ func DecodeConfig(fs afero.Fs, bcfg config.BaseConfig, m map[string]any) (Config
 	return c, nil
 }
 
+// stringToByteSizeHookFunc decodes sizes such as "500MB" or "2GiB" to bytes.
+func stringToByteSizeHookFunc(from reflect.Type, to reflect.Type, data any) (any, error) {
+	if from.Kind() != reflect.String || to != reflect.TypeOf(int64(0)) {
+		return data, nil
+	}
+	s := strings.TrimSpace(data.(string))
+	if s == "" {
+		return int64(0), nil
+	}
+	n, err := humanize.ParseBytes(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid size %q: %w", s, err)
+	}
+	return int64(n), nil
+}
+
 // Resolves :resourceDir => /myproject/resources etc., :cacheDir => ...
 func resolveDirPlaceholder(fs afero.Fs, bcfg config.BaseConfig, placeholder string) (cacheDir string, isResource bool, err error) {
 
@@ -53,13 +53,14 @@ dir = "/path/to/c2"
 dir = "/path/to/c3"
 [caches.getResource]
 dir = "/path/to/c4"
+maxSize = "1.5GB"
 `
 
 	cfg, err := config.FromConfigString(configStr, "toml")
 	c.Assert(err, qt.IsNil)
 	fs := afero.NewMemMapFs()
 	decoded := testconfig.GetTestConfigs(fs, cfg).Base.Caches
-	c.Assert(len(decoded), qt.Equals, 6)
+	c.Assert(len(decoded), qt.Equals, 9)
 
 	c2 := decoded["getcsv"]
 	c.Assert(c2.MaxAge.String(), qt.Equals, "11h0m0s")
@@ -72,6 +73,28 @@ dir = "/path/to/c4"
 	c4 := decoded["getresource"]
 	c.Assert(c4.MaxAge, qt.Equals, time.Duration(-1))
 	c.Assert(c4.DirCompiled, qt.Equals, filepath.FromSlash("/path/to/c4/filecache/getresource"))
+	c.Assert(c4.MaxSize, qt.Equals, int64(1500000000))
+	c.Assert(c2.MaxSize, qt.Equals, int64(0))
+}
+
+func TestDecodeConfigMaxSizeInvalid(t *testing.T) {
+	t.Parallel()
+
+	c := qt.New(t)
+
+	for _, test := range []struct {
+		caches string
+		err    string
+	}{
+		{"[caches.getjson]\nmaxSize = \"lots\"", "invalid size"},
+		{"[caches.modules]\nmaxSize = \"1GB\"", "not supported for the modules cache"},
+	} {
+		cfg, err := config.FromConfigString("[caches]\n"+test.caches, "toml")
+		c.Assert(err, qt.IsNil)
+		_, err = filecache.DecodeConfig(afero.NewMemMapFs(), config.BaseConfig{WorkingDir: "/my/project", CacheDir: "/cache"}, cfg.GetStringMap("caches"))
+		c.Assert(err, qt.IsNotNil)
+		c.Assert(err.Error(), qt.Contains, test.err)
+	}
 }
 
 func TestDecodeConfigIgnoreCache(t *testing.T) {
@@ -106,7 +129,7 @@ dir = "/path/to/c4"
 	c.Assert(err, qt.IsNil)
 	fs := afero.NewMemMapFs()
 	decoded := testconfig.GetTestConfigs(fs, cfg).Base.Caches
-	c.Assert(len(decoded), qt.Equals, 6)
+	c.Assert(len(decoded), qt.Equals, 9)
 
 	for _, v := range decoded {
 		c.Assert(v.MaxAge, qt.Equals, time.Duration(0))
@@ -129,7 +152,7 @@ func TestDecodeConfigDefault(t *testing.T) {
 
 	fs := afero.NewMemMapFs()
 	decoded := testconfig.GetTestConfigs(fs, cfg).Base.Caches
-	c.Assert(len(decoded), qt.Equals, 6)
+	c.Assert(len(decoded), qt.Equals, 9)
 
 	imgConfig := decoded[filecache.CacheKeyImages]
 	jsonConfig := decoded[filecache.CacheKeyGetJSON]
@@ -17,9 +17,12 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
+	"time"
 
 	"github.com/gohugoio/hugo/common/herrors"
 	"github.com/gohugoio/hugo/hugofs"
+	"github.com/gohugoio/hugo/metrics"
 
 	"github.com/spf13/afero"
 )
@@ -131,3 +134,141 @@ func (c *Cache) pruneRootDir(force bool) (int, error) {
 
 	return hugofs.MakeReadableAndRemoveAllModulePkgDir(c.Fs, c.pruneAllRootDir)
 }
+
+// When pruning a cache above its max size, we remove entries until the
+// cache is at or below this fraction of the max size, so we don't need
+// to prune again on the next build.
+const maxSizeLowWaterMark = 0.8
+
+// HasMaxSize reports whether any of the caches has a max size configured.
+func (c Caches) HasMaxSize() bool {
+	for _, cache := range c {
+		if cache.maxSize > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// PruneToMaxSize prunes the caches that have grown above their configured
+// max size, see Cache.PruneToMaxSize.
+// Note that we operate directly on the filesystem here, so this is not
+// thread safe.
+func (c Caches) PruneToMaxSize() (int, error) {
+	counter := 0
+	for k, cache := range c {
+		count, err := cache.PruneToMaxSize()
+
+		counter += count
+
+		if err != nil {
+			if herrors.IsNotExist(err) {
+				continue
+			}
+			return counter, fmt.Errorf("failed to prune cache %q: %w", k, err)
+		}
+	}
+
+	return counter, nil
+}
+
+// UpdateGauges sets the filecache.<name>.size and filecache.<name>.files
+// gauges in g to the current disk usage of the caches.
+func (c Caches) UpdateGauges(g *metrics.Gauges) error {
+	for k, cache := range c {
+		size, files, err := cache.DiskUsage()
+		if err != nil {
+			return fmt.Errorf("failed to get disk usage of cache %q: %w", k, err)
+		}
+		g.Set("filecache."+k+".size", float64(size))
+		g.Set("filecache."+k+".files", float64(files))
+	}
+	return nil
+}
+
+// DiskUsage returns the total size in bytes and the number of files in this cache.
+func (c *Cache) DiskUsage() (size int64, files int, err error) {
+	err = afero.Walk(c.Fs, "", func(name string, info os.FileInfo, err error) error {
+		if err != nil {
+			if herrors.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info == nil || info.IsDir() {
+			return nil
+		}
+		size += info.Size()
+		files++
+		return nil
+	})
+	if herrors.IsNotExist(err) {
+		err = nil
+	}
+	return
+}
+
+// PruneToMaxSize prunes this cache if it's above its max size. The expired
+// and unused entries are removed first, see Prune, then the least recently
+// modified entries until the cache is at or below 80% of its max size.
+// It returns the number of entries removed.
+func (c *Cache) PruneToMaxSize() (int, error) {
+	if c.maxSize <= 0 {
+		return 0, nil
+	}
+
+	size, _, err := c.DiskUsage()
+	if err != nil || size <= c.maxSize {
+		return 0, err
+	}
+
+	counter, err := c.Prune(false)
+	if err != nil {
+		return counter, err
+	}
+
+	type entry struct {
+		name    string
+		size    int64
+		modTime time.Time
+	}
+
+	var entries []entry
+	size = 0
+	err = afero.Walk(c.Fs, "", func(name string, info os.FileInfo, err error) error {
+		if info == nil || info.IsDir() {
+			return nil
+		}
+		size += info.Size()
+		entries = append(entries, entry{name: cleanID(name), size: info.Size(), modTime: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return counter, err
+	}
+
+	target := int64(float64(c.maxSize) * maxSizeLowWaterMark)
+	if size <= c.maxSize {
+		return counter, nil
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].modTime.Before(entries[j].modTime)
+	})
+
+	for _, e := range entries {
+		if size <= target {
+			break
+		}
+		if err := c.Fs.Remove(e.name); err != nil {
+			if herrors.IsNotExist(err) {
+				continue
+			}
+			return counter, err
+		}
+		size -= e.size
+		counter++
+	}
+
+	return counter, nil
+}
@@ -19,6 +19,8 @@ import (
 	"time"
 
 	"github.com/gohugoio/hugo/cache/filecache"
+	"github.com/gohugoio/hugo/common/loggers"
+	"github.com/gohugoio/hugo/metrics"
 	"github.com/spf13/afero"
 
 	qt "github.com/frankban/quicktest"
@@ -56,7 +58,7 @@ dir = ":resourceDir/_gen"
 	for _, name := range []string{filecache.CacheKeyGetCSV, filecache.CacheKeyGetJSON, filecache.CacheKeyAssets, filecache.CacheKeyImages} {
 		msg := qt.Commentf("cache: %s", name)
 		p := newPathsSpec(t, afero.NewMemMapFs(), configStr)
-		caches, err := filecache.NewCaches(p)
+		caches, err := filecache.NewCaches(p, loggers.NewWarningLogger())
 		c.Assert(err, qt.IsNil)
 		cache := caches[name]
 		for i := 0; i < 10; i++ {
@@ -84,7 +86,7 @@ dir = ":resourceDir/_gen"
 			}
 		}
 
-		caches, err = filecache.NewCaches(p)
+		caches, err = filecache.NewCaches(p, loggers.NewWarningLogger())
 		c.Assert(err, qt.IsNil)
 		cache = caches[name]
 		// Touch one and then prune.
@@ -109,3 +111,79 @@ dir = ":resourceDir/_gen"
 
 	}
 }
+
+func TestPruneToMaxSize(t *testing.T) {
+	t.Parallel()
+
+	c := qt.New(t)
+
+	configStr := `
+resourceDir = "myresources"
+contentDir = "content"
+dataDir = "data"
+i18nDir = "i18n"
+layoutDir = "layouts"
+assetDir = "assets"
+archeTypedir = "archetypes"
+
+[caches]
+[caches.getjson]
+maxAge = -1
+maxSize = "100B"
+dir = "/cache/c"
+[caches.getcsv]
+maxAge = -1
+dir = "/cache/d"
+`
+
+	p := newPathsSpec(t, afero.NewMemMapFs(), configStr)
+	caches, err := filecache.NewCaches(p, loggers.NewWarningLogger())
+	c.Assert(err, qt.IsNil)
+	c.Assert(caches.HasMaxSize(), qt.IsTrue)
+
+	for _, name := range []string{filecache.CacheKeyGetJSON, filecache.CacheKeyGetCSV} {
+		cache := caches[name]
+		for i := 0; i < 10; i++ {
+			id := fmt.Sprintf("i%d", i)
+			cache.GetOrCreateBytes(id, func() ([]byte, error) {
+				return []byte("abcdefghijklmnopqrst"), nil
+			})
+			// Make sure the modification times differ.
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+
+	size, files, err := caches.Get(filecache.CacheKeyGetJSON).DiskUsage()
+	c.Assert(err, qt.IsNil)
+	c.Assert(size, qt.Equals, int64(200))
+	c.Assert(files, qt.Equals, 10)
+
+	// The oldest are removed until the cache is at 80% of its max size.
+	count, err := caches.PruneToMaxSize()
+	c.Assert(err, qt.IsNil)
+	c.Assert(count, qt.Equals, 6)
+
+	cache := caches.Get(filecache.CacheKeyGetJSON)
+	for i := 0; i < 10; i++ {
+		v := cache.GetString(fmt.Sprintf("i%d", i))
+		if i < 6 {
+			c.Assert(v, qt.Equals, "")
+		} else {
+			c.Assert(v, qt.Equals, "abcdefghijklmnopqrst")
+		}
+	}
+
+	g := metrics.NewGauges()
+	c.Assert(caches.UpdateGauges(g), qt.IsNil)
+	v, _ := g.Get("filecache.getjson.size")
+	c.Assert(v, qt.Equals, float64(80))
+	v, _ = g.Get("filecache.getjson.files")
+	c.Assert(v, qt.Equals, float64(4))
+	// No maxSize, left alone.
+	v, _ = g.Get("filecache.getcsv.size")
+	c.Assert(v, qt.Equals, float64(200))
+
+	count, err = caches.PruneToMaxSize()
+	c.Assert(err, qt.IsNil)
+	c.Assert(count, qt.Equals, 0)
+}
@@ -0,0 +1,320 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filecache
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gohugoio/hugo/common/loggers"
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/config/security"
+	"github.com/mitchellh/mapstructure"
+)
+
+const remoteCacheConfigKey = "remotecache"
+
+// RemoteConfig configures a remote cache shared between builds, e.g. on
+// different CI runners.
+//
+// The protocol is plain HTTP. The entries are stored content-addressed,
+// keyed by the hex encoded SHA-256 digest of their content, and looked up
+// with a small index record named by the hash of the entry's inputs:
+//
+//   - GET <url>/<cache>/<key> responds with 200 and the digest of the entry
+//     as the body, or 404 if not found,
+//   - GET <url>/cas/<digest> responds with 200 and the entry as the body,
+//     or 404 if not found,
+//   - PUT <url>/cas/<digest> and PUT <url>/<cache>/<key> store the entry and
+//     its index record, and respond with any 2xx status.
+//
+// The <key> is the hex encoded SHA-256 hash of the cache name, the entry's
+// ID and, for caches where the ID isn't derived from the entry's inputs,
+// the hash of the inputs, see Cache.WithInputHash.
+// Entries not matching their digest are discarded.
+//
+// Requests are sent with an Authorization: Bearer <token> header if a token
+// is configured.
+type RemoteConfig struct {
+	// The base URL of the cache service, e.g. https://cache.example.org/hugo.
+	URL string
+
+	// The token sent in the Authorization header.
+	// Set it with the HUGO_REMOTECACHE_TOKEN environment variable to keep it
+	// out of the project configuration.
+	Token string
+
+	// The names of the file caches to share. Only caches with entries
+	// that never expire can be shared. Defaults to images, assets and getresource.
+	Caches []string
+
+	// Whether to only read from the remote cache, e.g. in untrusted builds.
+	ReadOnly bool
+
+	// The timeout for one request. Defaults to 30s.
+	Timeout time.Duration
+}
+
+// Enabled reports whether the remote cache is enabled.
+func (c RemoteConfig) Enabled() bool {
+	return c.URL != ""
+}
+
+// DecodeRemoteConfig creates a RemoteConfig from a given Hugo configuration.
+func DecodeRemoteConfig(cfg config.Provider) (RemoteConfig, error) {
+	c := RemoteConfig{
+		Caches:  []string{CacheKeyImages, CacheKeyAssets, CacheKeyGetResource},
+		Timeout: 30 * time.Second,
+	}
+
+	m := cfg.GetStringMap(remoteCacheConfigKey)
+	if m == nil {
+		return c, nil
+	}
+	delete(m, maps.MergeStrategyKey)
+
+	dc := &mapstructure.DecoderConfig{
+		Result:           &c,
+		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+		WeaklyTypedInput: true,
+	}
+	decoder, err := mapstructure.NewDecoder(dc)
+	if err != nil {
+		return c, err
+	}
+	if err := decoder.Decode(m); err != nil {
+		return c, fmt.Errorf("failed to decode remoteCache config: %w", err)
+	}
+
+	if !c.Enabled() {
+		return c, nil
+	}
+
+	u, err := url.Parse(c.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return c, fmt.Errorf("remoteCache: invalid url %q", c.URL)
+	}
+
+	for i, name := range c.Caches {
+		name = strings.ToLower(name)
+		if _, found := defaultCacheConfigs[name]; !found {
+			return c, fmt.Errorf("remoteCache: %q is not a valid cache name", name)
+		}
+		if name == CacheKeyModules {
+			return c, errors.New("remoteCache: the modules cache cannot be shared")
+		}
+		c.Caches[i] = name
+	}
+
+	return c, nil
+}
+
+// remoteCacheInputHashRequired holds the caches where the entry ID isn't
+// derived from the entry's inputs, e.g. the assets cache, keyed by the
+// resource path and transformation options only. Their entries are only
+// shared with an input hash set, see Cache.WithInputHash.
+var remoteCacheInputHashRequired = map[string]bool{
+	CacheKeyAssets: true,
+}
+
+// checkRemoteConfig checks the remote cache config against the security policy.
+func checkRemoteConfig(conf RemoteConfig, sc security.Config) error {
+	if err := sc.CheckAllowedHTTPURL(conf.URL); err != nil {
+		return err
+	}
+	if err := sc.CheckAllowedHTTPMethod(http.MethodGet); err != nil {
+		return err
+	}
+	if !conf.ReadOnly {
+		if err := sc.CheckAllowedHTTPMethod(http.MethodPut); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// remoteCache is the client for the remote cache of one file cache.
+type remoteCache struct {
+	name   string
+	conf   RemoteConfig
+	client *http.Client
+	logger loggers.Logger
+
+	// Whether entries are only shared with an input hash set.
+	inputHashRequired bool
+
+	// Set to 1 when the remote cache is unreachable, to avoid
+	// slowing down the build with more failing requests.
+	unavailable int32
+}
+
+func newRemoteCache(name string, conf RemoteConfig, logger loggers.Logger) *remoteCache {
+	return &remoteCache{
+		name:              name,
+		conf:              conf,
+		client:            &http.Client{Timeout: conf.Timeout},
+		logger:            logger,
+		inputHashRequired: remoteCacheInputHashRequired[name],
+	}
+}
+
+// key returns the key of the index record for the entry with the given id
+// and input hash, or false if the entry can't be shared.
+func (r *remoteCache) key(id, inputHash string) (string, bool) {
+	if r.inputHashRequired && inputHash == "" {
+		return "", false
+	}
+	h := sha256.New()
+	io.WriteString(h, r.name)
+	h.Write([]byte{0})
+	io.WriteString(h, filepath.ToSlash(id))
+	h.Write([]byte{0})
+	io.WriteString(h, inputHash)
+	return hex.EncodeToString(h.Sum(nil)), true
+}
+
+func (r *remoteCache) url(parts ...string) string {
+	return strings.TrimSuffix(r.conf.URL, "/") + "/" + strings.Join(parts, "/")
+}
+
+func (r *remoteCache) do(method, u string, body []byte) (*http.Response, error) {
+	if atomic.LoadInt32(&r.unavailable) == 1 {
+		return nil, nil
+	}
+	req, err := http.NewRequestWithContext(context.Background(), method, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if r.conf.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+r.conf.Token)
+	}
+	res, err := r.client.Do(req)
+	if err != nil {
+		if atomic.CompareAndSwapInt32(&r.unavailable, 0, 1) {
+			r.logger.Warnf("remoteCache: disabled for the %s cache: %s", r.name, err)
+		}
+		return nil, nil
+	}
+	return res, nil
+}
+
+// read gets the body at u, nil if not found.
+func (r *remoteCache) read(id, u string) []byte {
+	res, err := r.do(http.MethodGet, u, nil)
+	if err != nil {
+		r.logger.Warnf("remoteCache: failed to get %q from the %s cache: %s", id, r.name, err)
+		return nil
+	}
+	if res == nil {
+		return nil
+	}
+	defer res.Body.Close()
+	switch {
+	case res.StatusCode == http.StatusOK:
+	case res.StatusCode == http.StatusNotFound:
+		return nil
+	default:
+		r.logger.Warnf("remoteCache: failed to get %q from the %s cache: %s", id, r.name, res.Status)
+		return nil
+	}
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		r.logger.Warnf("remoteCache: failed to get %q from the %s cache: %s", id, r.name, err)
+		return nil
+	}
+	return b
+}
+
+// write puts b at u.
+func (r *remoteCache) write(id, u string, b []byte) bool {
+	res, err := r.do(http.MethodPut, u, b)
+	if err != nil {
+		r.logger.Warnf("remoteCache: failed to put %q in the %s cache: %s", id, r.name, err)
+		return false
+	}
+	if res == nil {
+		return false
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		r.logger.Warnf("remoteCache: failed to put %q in the %s cache: %s", id, r.name, res.Status)
+		return false
+	}
+	return true
+}
+
+// get fetches the entry with the given id and input hash, nil if not found
+// or if it doesn't match its digest.
+func (r *remoteCache) get(id, inputHash string) []byte {
+	key, ok := r.key(id, inputHash)
+	if !ok {
+		return nil
+	}
+	digest := strings.TrimSpace(string(r.read(id, r.url(r.name, key))))
+	if digest == "" {
+		return nil
+	}
+	if !isDigest(digest) {
+		r.logger.Warnf("remoteCache: invalid digest for %q in the %s cache", id, r.name)
+		return nil
+	}
+	b := r.read(id, r.url("cas", digest))
+	if b == nil {
+		return nil
+	}
+	if sum := sha256.Sum256(b); hex.EncodeToString(sum[:]) != digest {
+		r.logger.Warnf("remoteCache: %q in the %s cache does not match its digest, ignoring it", id, r.name)
+		return nil
+	}
+	return b
+}
+
+// put stores the entry with the given id and input hash, unless the remote
+// cache is read only.
+func (r *remoteCache) put(id, inputHash string, b []byte) {
+	if r.conf.ReadOnly {
+		return
+	}
+	key, ok := r.key(id, inputHash)
+	if !ok {
+		return
+	}
+	sum := sha256.Sum256(b)
+	digest := hex.EncodeToString(sum[:])
+	// Store the entry before the index record pointing to it.
+	if !r.write(id, r.url("cas", digest), b) {
+		return
+	}
+	r.write(id, r.url(r.name, key), []byte(digest))
+}
+
+func isDigest(s string) bool {
+	if len(s) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
@@ -0,0 +1,271 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filecache_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/cache/filecache"
+	"github.com/gohugoio/hugo/common/hugio"
+	"github.com/gohugoio/hugo/common/loggers"
+	"github.com/gohugoio/hugo/config"
+	"github.com/spf13/afero"
+)
+
+// newRemoteCacheServer starts an in-memory remote cache service accepting
+// the token "secret".
+func newRemoteCacheServer(t *testing.T) (*httptest.Server, map[string]string) {
+	var mu sync.Mutex
+	entries := make(map[string]string)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodGet:
+			v, found := entries[r.URL.Path]
+			if !found {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			io.WriteString(w, v)
+		case http.MethodPut:
+			b, _ := io.ReadAll(r.Body)
+			entries[r.URL.Path] = string(b)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, entries
+}
+
+const remoteCacheConfig = `
+workingDir = "/my/work"
+resourceDir = "resources"
+cacheDir = "/cache"
+
+[security.http]
+methods = ['(?i)GET|PUT']
+
+[remoteCache]
+url = "%s/hugo"
+token = "secret"
+caches = ["images", "getResource", "assets"]
+readOnly = %t
+`
+
+func TestRemoteCache(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	srv, entries := newRemoteCacheServer(t)
+
+	newCaches := func(readOnly bool) filecache.Caches {
+		p := newPathsSpec(t, afero.NewMemMapFs(), fmt.Sprintf(remoteCacheConfig, srv.URL, readOnly))
+		caches, err := filecache.NewCaches(p, loggers.NewWarningLogger())
+		c.Assert(err, qt.IsNil)
+		return caches
+	}
+
+	create := func(s string) func() (io.ReadCloser, error) {
+		return func() (io.ReadCloser, error) {
+			return hugio.ToReadCloser(strings.NewReader(s)), nil
+		}
+	}
+	createFail := func() (io.ReadCloser, error) {
+		c.Fatal("expected a cache hit")
+		return nil, nil
+	}
+	read := func(ca *filecache.Cache, id string, create func() (io.ReadCloser, error)) string {
+		_, r, err := ca.GetOrCreate(id, create)
+		c.Assert(err, qt.IsNil)
+		defer r.Close()
+		b, _ := io.ReadAll(r)
+		return string(b)
+	}
+
+	// A read only runner doesn't populate the remote cache.
+	caches := newCaches(true)
+	c.Assert(read(caches.ImageCache(), "a/b.png", create("ro")), qt.Equals, "ro")
+	c.Assert(entries, qt.HasLen, 0)
+
+	caches = newCaches(false)
+	c.Assert(read(caches.ImageCache(), "a/b.png", create("image")), qt.Equals, "image")
+	c.Assert(read(caches.GetResourceCache(), "c", create("resource")), qt.Equals, "resource")
+	_, w, err := caches.ImageCache().WriteCloser("d.json")
+	c.Assert(err, qt.IsNil)
+	io.WriteString(w, "meta")
+	c.Assert(w.Close(), qt.IsNil)
+	// The assets cache entries are only shared with an input hash.
+	c.Assert(read(caches.AssetsCache(), "e", create("asset")), qt.Equals, "asset")
+	c.Assert(read(caches.AssetsCache().WithInputHash("h1"), "f", create("asset-h1")), qt.Equals, "asset-h1")
+	// An index record and a content-addressed entry for each.
+	c.Assert(entries, qt.HasLen, 8)
+	for k, v := range entries {
+		if strings.HasPrefix(k, "/hugo/cas/") {
+			sum := sha256.Sum256([]byte(v))
+			c.Assert(k, qt.Equals, "/hugo/cas/"+hex.EncodeToString(sum[:]))
+		} else {
+			c.Assert(k, qt.Matches, `/hugo/(images|getresource|assets)/[0-9a-f]{64}`)
+			c.Assert(entries["/hugo/cas/"+v], qt.Not(qt.Equals), "")
+		}
+	}
+
+	// A new runner with an empty local cache gets the entries from the remote cache.
+	caches = newCaches(false)
+	c.Assert(read(caches.ImageCache(), "a/b.png", createFail), qt.Equals, "image")
+	c.Assert(read(caches.GetResourceCache(), "c", createFail), qt.Equals, "resource")
+	_, b, err := caches.ImageCache().GetBytes("d.json")
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(b), qt.Equals, "meta")
+	c.Assert(read(caches.AssetsCache(), "e", create("asset2")), qt.Equals, "asset2")
+	c.Assert(read(caches.AssetsCache().WithInputHash("h1"), "f", createFail), qt.Equals, "asset-h1")
+	caches = newCaches(false)
+	c.Assert(read(caches.AssetsCache().WithInputHash("h2"), "f", create("asset-h2")), qt.Equals, "asset-h2")
+}
+
+func TestRemoteCacheDigestMismatch(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	srv, entries := newRemoteCacheServer(t)
+
+	newCaches := func() filecache.Caches {
+		p := newPathsSpec(t, afero.NewMemMapFs(), fmt.Sprintf(remoteCacheConfig, srv.URL, false))
+		caches, err := filecache.NewCaches(p, loggers.NewWarningLogger())
+		c.Assert(err, qt.IsNil)
+		return caches
+	}
+
+	_, _, err := newCaches().ImageCache().GetOrCreateBytes("a.png", func() ([]byte, error) { return []byte("image"), nil })
+	c.Assert(err, qt.IsNil)
+
+	for k := range entries {
+		if strings.HasPrefix(k, "/hugo/cas/") {
+			entries[k] = "tampered"
+		}
+	}
+
+	_, b, err := newCaches().ImageCache().GetOrCreateBytes("a.png", func() ([]byte, error) { return []byte("created"), nil })
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(b), qt.Equals, "created")
+}
+
+func TestRemoteCacheSecurity(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	newCaches := func(config string) error {
+		p := newPathsSpec(t, afero.NewMemMapFs(), config)
+		_, err := filecache.NewCaches(p, loggers.NewWarningLogger())
+		return err
+	}
+
+	// PUT is not allowed by default.
+	c.Assert(newCaches(`
+workingDir = "/my/work"
+[remoteCache]
+url = "https://cache.example.org"
+`), qt.ErrorMatches, `(?s).*"PUT" is not whitelisted in policy "security.http.method".*`)
+
+	c.Assert(newCaches(`
+workingDir = "/my/work"
+[remoteCache]
+url = "https://cache.example.org"
+readOnly = true
+`), qt.IsNil)
+
+	c.Assert(newCaches(`
+workingDir = "/my/work"
+[security.http]
+urls = ['^https://example\.org/']
+[remoteCache]
+url = "https://cache.example.org"
+readOnly = true
+`), qt.ErrorMatches, `(?s).*"https://cache.example.org" is not whitelisted in policy "security.http.urls".*`)
+}
+
+func TestRemoteCacheUnavailable(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	srv, _ := newRemoteCacheServer(t)
+	url := srv.URL
+	srv.Close()
+
+	p := newPathsSpec(t, afero.NewMemMapFs(), fmt.Sprintf(`
+workingDir = "/my/work"
+resourceDir = "resources"
+[remoteCache]
+url = %q
+readOnly = true
+`, url))
+	caches, err := filecache.NewCaches(p, loggers.NewWarningLogger())
+	c.Assert(err, qt.IsNil)
+
+	_, r, err := caches.ImageCache().GetOrCreate("a", func() (io.ReadCloser, error) {
+		return hugio.ToReadCloser(strings.NewReader("abc")), nil
+	})
+	c.Assert(err, qt.IsNil)
+	b, _ := io.ReadAll(r)
+	c.Assert(string(b), qt.Equals, "abc")
+}
+
+func TestDecodeRemoteConfig(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	for _, test := range []struct {
+		config string
+		err    string
+	}{
+		{`url = "ftp://example.org"`, "invalid url"},
+		{`url = "https://example.org"
+caches = ["foo"]`, `"foo" is not a valid cache name`},
+		{`url = "https://example.org"
+caches = ["modules"]`, "modules cache cannot be shared"},
+	} {
+		cfg, err := config.FromConfigString("[remoteCache]\n"+test.config, "toml")
+		c.Assert(err, qt.IsNil)
+		_, err = filecache.DecodeRemoteConfig(cfg)
+		c.Assert(err, qt.ErrorMatches, ".*"+regexp.QuoteMeta(test.err)+".*")
+	}
+
+	p := newPathsSpec(t, afero.NewMemMapFs(), `
+workingDir = "/my/work"
+[remoteCache]
+url = "https://example.org"
+readOnly = true
+caches = ["getjson"]
+[caches.getjson]
+maxAge = "1h"
+`)
+	_, err := filecache.NewCaches(p, loggers.NewWarningLogger())
+	c.Assert(err, qt.ErrorMatches, ".*the getjson cache must never expire.*")
+}
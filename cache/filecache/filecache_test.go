@@ -25,6 +25,7 @@ import (
 
 	"github.com/gohugoio/hugo/cache/filecache"
 	"github.com/gohugoio/hugo/common/hugio"
+	"github.com/gohugoio/hugo/common/loggers"
 	"github.com/gohugoio/hugo/config"
 	"github.com/gohugoio/hugo/config/testconfig"
 	"github.com/gohugoio/hugo/helpers"
@@ -80,7 +81,7 @@ dir = ":cacheDir/c"
 
 		p := newPathsSpec(t, osfs, configStr)
 
-		caches, err := filecache.NewCaches(p)
+		caches, err := filecache.NewCaches(p, loggers.NewWarningLogger())
 		c.Assert(err, qt.IsNil)
 
 		cache := caches.Get("GetJSON")
@@ -190,7 +191,7 @@ dir = "/cache/c"
 
 	p := newPathsSpec(t, afero.NewMemMapFs(), configStr)
 
-	caches, err := filecache.NewCaches(p)
+	caches, err := filecache.NewCaches(p, loggers.NewWarningLogger())
 	c.Assert(err, qt.IsNil)
 
 	const cacheName = "getjson"
@@ -14,6 +14,7 @@
 package filecache_test
 
 import (
+	"bytes"
 	"path/filepath"
 
 	jww "github.com/spf13/jwalterweatherman"
@@ -107,3 +108,35 @@ iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNkYPhfDwAChwGA60e6kgAA
 	b.Assert(err, qt.IsNil)
 
 }
+
+func TestPruneImagesToMaxSize(t *testing.T) {
+	files := `
+-- hugo.toml --
+baseURL = "https://example.com"
+[caches]
+[caches.images]
+maxSize = "10B"
+dir = ":resourceDir/_gen"
+-- content/_index.md --
+---
+title: "Home"
+---
+-- assets/a/pixel.png --
+iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNkYPhfDwAChwGA60e6kgAAAABJRU5ErkJggg==
+-- layouts/index.html --
+{{ $img := resources.GetMatch "**.png" }}
+{{ $img = $img.Resize "3x3" }}
+{{ $img.RelPermalink }}
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{T: t, TxtarString: files, NeedsOsFS: true},
+	).Build()
+
+	b.AssertFileContent("public/index.html", "pixel_hu")
+
+	var stats bytes.Buffer
+	b.H.PrintProcessingStats(&stats)
+	b.Assert(stats.String(), qt.Contains, "File caches:")
+	b.Assert(stats.String(), qt.Contains, "images 0 B (0 files)")
+}
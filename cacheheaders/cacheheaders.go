@@ -0,0 +1,338 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cacheheaders recommends Cache-Control headers for the published
+// files, e.g. to cache fingerprinted assets forever at the edge, and checks
+// the headers configured in server.headers against them.
+package cacheheaders
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The classes of published files.
+const (
+	// ClassDocument is HTML, feeds and other files published to a stable URL
+	// whose content changes between builds.
+	ClassDocument = "document"
+
+	// ClassAsset is images, scripts, stylesheets etc. published to a stable URL.
+	ClassAsset = "asset"
+
+	// ClassFingerprinted is files with a content hash in the filename,
+	// e.g. from resources.Fingerprint or image processing.
+	ClassFingerprinted = "fingerprinted"
+)
+
+// The recommended Cache-Control header values for each class.
+const (
+	CacheControlDocument      = "public, max-age=0, must-revalidate"
+	CacheControlAsset         = "public, max-age=86400"
+	CacheControlFingerprinted = "public, max-age=31536000, immutable"
+)
+
+// The minimum max-age for fingerprinted files before we flag it, one week.
+const minFingerprintedMaxAge = 7 * 24 * 60 * 60
+
+var (
+	// Matches e.g. main.<sha256>.css and sunset_hu<md5>_12345_300x0_resize_box_3.png.
+	fingerprintRe = regexp.MustCompile(`\.[0-9a-f]{32,128}\.|_hu[0-9a-f]{32}_`)
+
+	documentSuffixes = map[string]bool{
+		"":            true,
+		"htm":         true,
+		"html":        true,
+		"json":        true,
+		"txt":         true,
+		"webmanifest": true,
+		"xml":         true,
+	}
+)
+
+// Classify returns the class of the file published to filename.
+func Classify(filename string) string {
+	name := path.Base(strings.ReplaceAll(filename, "\\", "/"))
+	if fingerprintRe.MatchString(name) {
+		return ClassFingerprinted
+	}
+	if documentSuffixes[suffix(name)] {
+		return ClassDocument
+	}
+	return ClassAsset
+}
+
+// CacheControl returns the recommended Cache-Control header for class.
+func CacheControl(class string) string {
+	switch class {
+	case ClassFingerprinted:
+		return CacheControlFingerprinted
+	case ClassAsset:
+		return CacheControlAsset
+	default:
+		return CacheControlDocument
+	}
+}
+
+func suffix(name string) string {
+	if i := strings.LastIndex(name, "."); i > 0 {
+		return strings.ToLower(name[i+1:])
+	}
+	return ""
+}
+
+// Rule is a recommended server.headers entry.
+type Rule struct {
+	// The glob matched against the request path.
+	For string
+
+	// The class of the files matched.
+	Class string
+
+	// The Cache-Control header value.
+	CacheControl string
+}
+
+// Recommend returns the header rules for files, the slash separated paths of
+// the published files relative to the publish directory, served below
+// basePath, e.g. "/" or "/docs/".
+// The rules are ordered from the least to the most specific; when several
+// rules match a path, the last one wins.
+func Recommend(basePath string, files []string) []Rule {
+	basePath = cleanBasePath(basePath)
+
+	rules := []Rule{{For: basePath + "**", Class: ClassDocument, CacheControl: CacheControlDocument}}
+
+	assetSuffixes := make(map[string]bool)
+	// Directories and suffixes of the fingerprinted files.
+	type dirSuffix struct {
+		dir, suffix string
+	}
+	fingerprinted := make(map[dirSuffix][]string)
+	// Directories and suffixes with files that aren't fingerprinted.
+	mixed := make(map[dirSuffix]bool)
+
+	for _, f := range files {
+		f = strings.TrimPrefix(strings.ReplaceAll(f, "\\", "/"), "/")
+		ds := dirSuffix{path.Dir(f), suffix(f)}
+		switch Classify(f) {
+		case ClassFingerprinted:
+			fingerprinted[ds] = append(fingerprinted[ds], f)
+		case ClassAsset:
+			assetSuffixes[ds.suffix] = true
+			mixed[ds] = true
+		default:
+			mixed[ds] = true
+		}
+	}
+
+	if len(assetSuffixes) > 0 {
+		suffixes := make([]string, 0, len(assetSuffixes))
+		for s := range assetSuffixes {
+			suffixes = append(suffixes, s)
+		}
+		sort.Strings(suffixes)
+		pattern := basePath + "**." + suffixes[0]
+		if len(suffixes) > 1 {
+			pattern = basePath + "**.{" + strings.Join(suffixes, ",") + "}"
+		}
+		rules = append(rules, Rule{For: pattern, Class: ClassAsset, CacheControl: CacheControlAsset})
+	}
+
+	var fingerprintedPatterns []string
+	for ds, fs := range fingerprinted {
+		if !mixed[ds] && ds.suffix != "" {
+			// A glob with no separator matches across directories, so
+			// don't use one for a directory with subdirectories.
+			dir := ds.dir + "/"
+			if ds.dir == "." {
+				dir = ""
+			}
+			if !hasSubdir(files, dir) {
+				fingerprintedPatterns = append(fingerprintedPatterns, basePath+dir+"*."+ds.suffix)
+				continue
+			}
+		}
+		for _, f := range fs {
+			fingerprintedPatterns = append(fingerprintedPatterns, basePath+f)
+		}
+	}
+	sort.Strings(fingerprintedPatterns)
+	for _, p := range fingerprintedPatterns {
+		rules = append(rules, Rule{For: p, Class: ClassFingerprinted, CacheControl: CacheControlFingerprinted})
+	}
+
+	return rules
+}
+
+func cleanBasePath(s string) string {
+	s = "/" + strings.Trim(s, "/")
+	if s != "/" {
+		s += "/"
+	}
+	return s
+}
+
+func hasSubdir(files []string, dir string) bool {
+	for _, f := range files {
+		f = strings.TrimPrefix(strings.ReplaceAll(f, "\\", "/"), "/")
+		if strings.HasPrefix(f, dir) && strings.Contains(f[len(dir):], "/") {
+			return true
+		}
+	}
+	return false
+}
+
+// Mismatch is a set of files whose currently configured Cache-Control header
+// contradicts the recommendation for their class.
+type Mismatch struct {
+	Class string
+
+	// The currently configured Cache-Control header value, possibly empty.
+	CacheControl string
+
+	// Why the header is a problem.
+	Reason string
+
+	// The request paths of the files.
+	Paths []string
+}
+
+func (m Mismatch) String() string {
+	cc := m.CacheControl
+	if cc == "" {
+		cc = "no Cache-Control"
+	}
+	return fmt.Sprintf("%d %s file(s) served with %q: %s, e.g. %s (recommended: %q)", len(m.Paths), m.Class, cc, m.Reason, m.Paths[0], CacheControl(m.Class))
+}
+
+// Check compares the Cache-Control header returned by current for the request
+// path of each file, served below basePath, to the recommendation for its class,
+// and returns the mismatches grouped by class and header value.
+func Check(basePath string, files []string, current func(path string) string) []Mismatch {
+	basePath = cleanBasePath(basePath)
+
+	type key struct {
+		class, cacheControl string
+	}
+	m := make(map[key]*Mismatch)
+	var keys []key
+
+	for _, f := range files {
+		f = strings.TrimPrefix(strings.ReplaceAll(f, "\\", "/"), "/")
+		p := basePath + f
+		class := Classify(f)
+		cc := current(p)
+		reason := checkCacheControl(class, cc)
+		if reason == "" {
+			continue
+		}
+		k := key{class, cc}
+		if _, found := m[k]; !found {
+			m[k] = &Mismatch{Class: class, CacheControl: cc, Reason: reason}
+			keys = append(keys, k)
+		}
+		m[k].Paths = append(m[k].Paths, p)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].class != keys[j].class {
+			return keys[i].class < keys[j].class
+		}
+		return keys[i].cacheControl < keys[j].cacheControl
+	})
+
+	mismatches := make([]Mismatch, len(keys))
+	for i, k := range keys {
+		mm := m[k]
+		sort.Strings(mm.Paths)
+		mismatches[i] = *mm
+	}
+	return mismatches
+}
+
+func checkCacheControl(class, cc string) string {
+	maxAge, hasMaxAge, immutable, noStore := parseCacheControl(cc)
+	switch class {
+	case ClassFingerprinted:
+		if noStore || !hasMaxAge {
+			return "fingerprinted files can be cached forever"
+		}
+		if maxAge < minFingerprintedMaxAge {
+			return "max-age is shorter than a week for files that never change"
+		}
+	case ClassDocument:
+		if immutable {
+			return "documents must not be immutable"
+		}
+		if maxAge > 0 {
+			return "documents are cached with a stale max-age after a new deploy"
+		}
+	case ClassAsset:
+		if immutable {
+			return "assets without a fingerprint in the filename must not be immutable"
+		}
+	}
+	return ""
+}
+
+// parseCacheControl parses the directives of the Cache-Control header cc we
+// care about. s-maxage wins over max-age, as it's what the edge uses.
+func parseCacheControl(cc string) (maxAge int, hasMaxAge, immutable, noStore bool) {
+	var (
+		sMaxAge    int
+		hasSMaxAge bool
+	)
+	for _, d := range strings.Split(cc, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(d), "=")
+		switch strings.ToLower(name) {
+		case "max-age":
+			maxAge, _ = strconv.Atoi(strings.Trim(value, `"`))
+			hasMaxAge = true
+		case "s-maxage":
+			sMaxAge, _ = strconv.Atoi(strings.Trim(value, `"`))
+			hasSMaxAge = true
+		case "immutable":
+			immutable = true
+		case "no-store", "no-cache":
+			noStore = true
+		}
+	}
+	if hasSMaxAge {
+		maxAge, hasMaxAge = sMaxAge, true
+	}
+	return
+}
+
+// ServerHeaders returns rules as a server.headers configuration, ready to be
+// encoded as TOML, YAML or JSON.
+func ServerHeaders(rules []Rule) map[string]any {
+	headers := make([]map[string]any, len(rules))
+	for i, r := range rules {
+		headers[i] = map[string]any{
+			"for": r.For,
+			"values": map[string]any{
+				"Cache-Control": r.CacheControl,
+			},
+		}
+	}
+	return map[string]any{
+		"server": map[string]any{
+			"headers": headers,
+		},
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cacheheaders
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+const (
+	sha256 = "d2a84f4b8b650937ec8f73cd8be2c74add5a911ba64df27458ed8229da804a26"
+	md5    = "3d03a01dcc18bc5be0e67db3d8d209a6"
+)
+
+var testFiles = []string{
+	"index.html",
+	"sitemap.xml",
+	"_redirects",
+	"posts/p1/index.html",
+	"posts/p1/sunset.jpg",
+	"posts/p1/sunset_hu" + md5 + "_12345_300x0_resize_box_3.jpg",
+	"css/main." + sha256 + ".css",
+	"css/print." + sha256 + ".css",
+	"js/main." + sha256 + ".js",
+	"js/legacy.js",
+	"favicon.ico",
+}
+
+func TestClassify(t *testing.T) {
+	c := qt.New(t)
+
+	for _, test := range []struct {
+		filename string
+		expect   string
+	}{
+		{"index.html", ClassDocument},
+		{"index.xml", ClassDocument},
+		{"CNAME", ClassDocument},
+		{"site.webmanifest", ClassDocument},
+		{"images/logo.PNG", ClassAsset},
+		{"js/main.js", ClassAsset},
+		{"js/main." + sha256 + ".js", ClassFingerprinted},
+		{"css/main.min." + md5 + ".css", ClassFingerprinted},
+		{"css/main.deadbeef.css", ClassAsset},
+		{"p1/sunset_hu" + md5 + "_12345_300x0_resize_box_3.jpg", ClassFingerprinted},
+	} {
+		c.Assert(Classify(test.filename), qt.Equals, test.expect, qt.Commentf(test.filename))
+	}
+}
+
+func TestRecommend(t *testing.T) {
+	c := qt.New(t)
+
+	c.Assert(Recommend("/docs", testFiles), qt.DeepEquals, []Rule{
+		{For: "/docs/**", Class: ClassDocument, CacheControl: CacheControlDocument},
+		{For: "/docs/**.{ico,jpg,js}", Class: ClassAsset, CacheControl: CacheControlAsset},
+		{For: "/docs/css/*.css", Class: ClassFingerprinted, CacheControl: CacheControlFingerprinted},
+		{For: "/docs/js/main." + sha256 + ".js", Class: ClassFingerprinted, CacheControl: CacheControlFingerprinted},
+		{For: "/docs/posts/p1/sunset_hu" + md5 + "_12345_300x0_resize_box_3.jpg", Class: ClassFingerprinted, CacheControl: CacheControlFingerprinted},
+	})
+
+	c.Assert(Recommend("/", []string{"index.html"}), qt.DeepEquals, []Rule{
+		{For: "/**", Class: ClassDocument, CacheControl: CacheControlDocument},
+	})
+}
+
+func TestCheck(t *testing.T) {
+	c := qt.New(t)
+
+	c.Assert(Check("", testFiles, func(p string) string { return "" }), qt.DeepEquals, []Mismatch{
+		{
+			Class:  ClassFingerprinted,
+			Reason: "fingerprinted files can be cached forever",
+			Paths: []string{
+				"/css/main." + sha256 + ".css",
+				"/css/print." + sha256 + ".css",
+				"/js/main." + sha256 + ".js",
+				"/posts/p1/sunset_hu" + md5 + "_12345_300x0_resize_box_3.jpg",
+			},
+		},
+	})
+
+	mismatches := Check("", testFiles, func(p string) string {
+		switch Classify(p) {
+		case ClassFingerprinted:
+			return "public, max-age=3600"
+		default:
+			return "public, max-age=31536000, immutable"
+		}
+	})
+	c.Assert(mismatches, qt.HasLen, 3)
+	c.Assert(mismatches[0].Class, qt.Equals, ClassAsset)
+	c.Assert(mismatches[0].Paths, qt.DeepEquals, []string{"/favicon.ico", "/js/legacy.js", "/posts/p1/sunset.jpg"})
+	c.Assert(mismatches[1].String(), qt.Equals, `4 document file(s) served with "public, max-age=31536000, immutable": documents must not be immutable, e.g. /_redirects (recommended: "public, max-age=0, must-revalidate")`)
+	c.Assert(mismatches[2].Reason, qt.Equals, "max-age is shorter than a week for files that never change")
+
+	c.Assert(Check("", testFiles, func(p string) string {
+		for _, r := range Recommend("", testFiles) {
+			if r.For == "/**" {
+				continue
+			}
+			if Classify(p) == r.Class {
+				return r.CacheControl
+			}
+		}
+		return "public, s-maxage=0, max-age=600"
+	}), qt.HasLen, 0)
+}
+
+func TestParseCacheControl(t *testing.T) {
+	c := qt.New(t)
+
+	maxAge, hasMaxAge, immutable, noStore := parseCacheControl(`public, MAX-AGE="600", s-maxage=60, immutable`)
+	c.Assert(maxAge, qt.Equals, 60)
+	c.Assert(hasMaxAge, qt.IsTrue)
+	c.Assert(immutable, qt.IsTrue)
+	c.Assert(noStore, qt.IsFalse)
+
+	_, hasMaxAge, _, noStore = parseCacheControl("no-store")
+	c.Assert(hasMaxAge, qt.IsFalse)
+	c.Assert(noStore, qt.IsTrue)
+}
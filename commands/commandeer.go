@@ -109,15 +109,18 @@ type rootCommand struct {
 	source      string
 	buildWatch  bool
 	environment string
+	site        string
+	buildAll    bool
 
 	// Common build flags.
-	baseURL              string
-	gc                   bool
-	poll                 string
-	panicOnWarning       bool
-	forceSyncStatic      bool
-	printPathWarnings    bool
-	printUnusedTemplates bool
+	baseURL                string
+	gc                     bool
+	poll                   string
+	panicOnWarning         bool
+	forceSyncStatic        bool
+	printPathWarnings      bool
+	printUnusedTemplates   bool
+	printInternalTemplates bool
 
 	// Profile flags (for debugging of performance problems)
 	cpuprofile   string
@@ -335,6 +338,10 @@ func (r *rootCommand) Run(ctx context.Context, cd *simplecobra.Commandeer, args
 		defer r.timeTrack(time.Now(), "Total")
 	}
 
+	if r.buildAll {
+		return r.buildAllSites(cd)
+	}
+
 	b := newHugoBuilder(r, nil)
 
 	if err := b.loadConfig(cd, false); err != nil {
@@ -385,6 +392,66 @@ func (r *rootCommand) Run(ctx context.Context, cd *simplecobra.Commandeer, args
 	return nil
 }
 
+// buildAllSites builds the sites in the sites config one after the other.
+// The sites share the caches in cacheDir, but must have different publish dirs.
+func (r *rootCommand) buildAllSites(cd *simplecobra.Commandeer) error {
+	if r.site != "" {
+		return errors.New("--site and --all cannot be used together")
+	}
+	if r.buildWatch {
+		return errors.New("--all cannot be used with --watch")
+	}
+
+	b := newHugoBuilder(r, nil)
+	if err := b.loadConfig(cd, false); err != nil {
+		return err
+	}
+	names := b.conf.configs.SiteNames()
+	if len(names) == 0 {
+		return errors.New("no sites found in the sites config")
+	}
+
+	defer func() {
+		r.site = ""
+	}()
+
+	// Load the config for all sites before building any of them, so a
+	// config error or a publishDir conflict fails the build up front.
+	builders := make([]*hugoBuilder, len(names))
+	publishDirs := make(map[string]string)
+	for i, name := range names {
+		r.site = name
+		// Make sure we get a new config for each site.
+		r.configVersionID.Add(1)
+
+		b := newHugoBuilder(r, nil)
+		if err := b.loadConfig(cd, false); err != nil {
+			return fmt.Errorf("site %q: %w", name, err)
+		}
+
+		base := b.conf.configs.Base
+		publishDir := filepath.Clean(paths.AbsPathify(base.WorkingDir, base.PublishDir))
+		if other, found := publishDirs[publishDir]; found {
+			return fmt.Errorf("sites %q and %q have the same publishDir %q", other, name, publishDir)
+		}
+		publishDirs[publishDir] = name
+		builders[i] = b
+	}
+
+	for i, name := range names {
+		r.site = name
+		// Make sure we get a new HugoSites for each site.
+		r.configVersionID.Add(1)
+
+		r.Printf("Building site %q\n", name)
+		if err := builders[i].build(); err != nil {
+			return fmt.Errorf("site %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
 func (r *rootCommand) PreRun(cd, runner *simplecobra.Commandeer) error {
 	r.Out = os.Stdout
 	if r.quiet {
@@ -480,11 +547,12 @@ Complete documentation is available at https://gohugo.io/.`
 	cmd.PersistentFlags().SetAnnotation("destination", cobra.BashCompSubdirsInDir, []string{})
 
 	cmd.PersistentFlags().StringVarP(&r.environment, "environment", "e", "", "build environment")
+	cmd.PersistentFlags().StringVar(&r.site, "site", "", "the site to use from the sites config, e.g. --site docs")
 	cmd.PersistentFlags().StringP("themesDir", "", "", "filesystem path to themes directory")
 	cmd.PersistentFlags().StringP("ignoreVendorPaths", "", "", "ignores any _vendor for module paths matching the given Glob pattern")
 	cmd.PersistentFlags().String("clock", "", "set the clock used by Hugo, e.g. --clock 2021-11-06T22:30:00.00+09:00")
 
-	cmd.PersistentFlags().StringVar(&r.cfgFile, "config", "", "config file (default is hugo.yaml|json|toml)")
+	cmd.PersistentFlags().StringVar(&r.cfgFile, "config", "", "config file(s), comma separated, later files override earlier ones (default is hugo.yaml|json|toml)")
 	cmd.PersistentFlags().StringVar(&r.cfgDir, "configDir", "config", "config dir")
 	cmd.PersistentFlags().BoolVar(&r.quiet, "quiet", false, "build in quiet mode")
 
@@ -496,6 +564,9 @@ Complete documentation is available at https://gohugo.io/.`
 	cmd.PersistentFlags().StringVar(&r.logLevel, "logLevel", "", "log level (debug|info|warn|error)")
 	cmd.Flags().BoolVarP(&r.buildWatch, "watch", "w", false, "watch filesystem for changes and recreate as needed")
 	cmd.Flags().BoolVar(&r.renderToMemory, "renderToMemory", false, "render to memory (only useful for benchmark testing)")
+	cmd.Flags().String("shard", "", "render only the pages of a shard of the site, e.g. --shard 2/8; see hugo shard merge")
+	cmd.Flags().String("shardBy", "hash", "how to assign pages to shards, hash (of the page path) or section")
+	cmd.Flags().BoolVar(&r.buildAll, "all", false, "build all the sites in the sites config")
 
 	// Configure local flags
 	applyLocalFlagsBuild(cmd, r)
@@ -533,6 +604,9 @@ func applyLocalFlagsBuild(cmd *cobra.Command, r *rootCommand) {
 	cmd.Flags().BoolVar(&r.panicOnWarning, "panicOnWarning", false, "panic on first WARNING log")
 	cmd.Flags().Bool("templateMetrics", false, "display metrics about template executions")
 	cmd.Flags().Bool("templateMetricsHints", false, "calculate some improvement hints when combined with --templateMetrics")
+	cmd.Flags().Int("templateMetricsLimit", 0, "only display this number of the most expensive templates and page kinds when combined with --templateMetrics")
+	cmd.Flags().String("templateMetricsFormat", "text", "the format of the template metrics, text or json")
+	cmd.Flags().String("templateMetricsFile", "", "write the JSON template metrics to this file instead of stdout")
 	cmd.Flags().BoolVar(&r.forceSyncStatic, "forceSyncStatic", false, "copy all files when static is changed.")
 	cmd.Flags().BoolP("noTimes", "", false, "don't sync modification time of files")
 	cmd.Flags().BoolP("noChmod", "", false, "don't sync permission mode of files")
@@ -540,6 +614,7 @@ func applyLocalFlagsBuild(cmd *cobra.Command, r *rootCommand) {
 	cmd.Flags().BoolP("printI18nWarnings", "", false, "print missing translations")
 	cmd.Flags().BoolVarP(&r.printPathWarnings, "printPathWarnings", "", false, "print warnings on duplicate target paths etc.")
 	cmd.Flags().BoolVarP(&r.printUnusedTemplates, "printUnusedTemplates", "", false, "print warnings on unused templates.")
+	cmd.Flags().BoolVarP(&r.printInternalTemplates, "printInternalTemplates", "", false, "print the internal templates referenced by the templates executed in the build.")
 	cmd.Flags().StringVarP(&r.cpuprofile, "profile-cpu", "", "", "write cpu profile to `file`")
 	cmd.Flags().StringVarP(&r.memprofile, "profile-mem", "", "", "write memory profile to `file`")
 	cmd.Flags().BoolVarP(&r.printm, "printMemoryUsage", "", false, "print memory usage to screen at intervals")
@@ -553,6 +628,9 @@ func applyLocalFlagsBuild(cmd *cobra.Command, r *rootCommand) {
 
 	cmd.Flags().StringSlice("disableKinds", []string{}, "disable different kind of pages (home, RSS etc.)")
 	cmd.Flags().Bool("minify", false, "minify any supported output format (HTML, XML etc.)")
+	cmd.Flags().Bool("checkLinks", false, "check the links in the published HTML files and report the broken ones, see checkLinks in the site config")
+	cmd.Flags().Bool("safe", false, "safe mode for building untrusted sites: disables os/exec, remote HTTP, os.Getenv, symlinks and reading files outside of the mounts")
+	cmd.Flags().StringToString("flag", nil, "override a feature flag defined in the site config, e.g. --flag newNav=true")
 	_ = cmd.Flags().SetAnnotation("destination", cobra.BashCompSubdirsInDir, []string{})
 
 }
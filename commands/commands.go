@@ -32,6 +32,9 @@ func newExec() (*simplecobra.Exec, error) {
 			newListCommand(),
 			newModCommands(),
 			newGenCommand(),
+			newShardCommand(),
+			newLintCommand(),
+			newTestCommand(),
 			newReleaseCommand(),
 		},
 	}
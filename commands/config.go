@@ -19,10 +19,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/bep/simplecobra"
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/config"
 	"github.com/gohugoio/hugo/config/allconfig"
 	"github.com/gohugoio/hugo/modules"
 	"github.com/gohugoio/hugo/parser"
@@ -42,8 +45,9 @@ func newConfigCommand() *configCommand {
 type configCommand struct {
 	r *rootCommand
 
-	format string
-	lang   string
+	format     string
+	lang       string
+	provenance bool
 
 	commands []simplecobra.Commander
 }
@@ -61,6 +65,11 @@ func (c *configCommand) Run(ctx context.Context, cd *simplecobra.Commandeer, arg
 	if err != nil {
 		return err
 	}
+
+	if c.provenance {
+		return c.printProvenance(conf.configs.LoadingInfo)
+	}
+
 	var config *allconfig.Config
 	if c.lang != "" {
 		var found bool
@@ -105,6 +114,74 @@ func (c *configCommand) Run(ctx context.Context, cd *simplecobra.Commandeer, arg
 	return nil
 }
 
+type configProvenanceEntry struct {
+	Key        string   `json:"key"`
+	Value      any      `json:"value"`
+	Source     string   `json:"source"`
+	Overridden []string `json:"overridden,omitempty"`
+}
+
+// printProvenance prints the configuration keys set in the config files,
+// OS environment, flags etc. with the layer that set the value and the
+// layers it overrode.
+func (c *configCommand) printProvenance(res config.LoadConfigResult) error {
+	root, _ := res.Cfg.Get("").(maps.Params)
+
+	relSource := func(source string) string {
+		if filepath.IsAbs(source) {
+			if rel, err := filepath.Rel(res.BaseConfig.WorkingDir, source); err == nil {
+				return filepath.ToSlash(rel)
+			}
+		}
+		return source
+	}
+
+	var entries []configProvenanceEntry
+	for _, key := range config.LeafKeys(root) {
+		source, overridden := res.Provenance.Source(key)
+		if source == "" && strings.HasPrefix(key, "languages.") {
+			// The language config is created from the root config
+			// for keys not set per language, e.g. params.
+			if parts := strings.SplitN(key, ".", 3); len(parts) == 3 {
+				source, overridden = res.Provenance.Source(parts[2])
+			}
+		}
+		if source == "" {
+			source = config.ProvenanceDefault
+		}
+		for i, s := range overridden {
+			overridden[i] = relSource(s)
+		}
+		entries = append(entries, configProvenanceEntry{
+			Key:        key,
+			Value:      res.Cfg.Get(key),
+			Source:     relSource(source),
+			Overridden: overridden,
+		})
+	}
+
+	if strings.ToLower(c.format) == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		enc.SetEscapeHTML(false)
+		return enc.Encode(entries)
+	}
+
+	for _, e := range entries {
+		v, err := json.Marshal(e.Value)
+		if err != nil {
+			return err
+		}
+		line := fmt.Sprintf("%s = %s # %s", e.Key, v, e.Source)
+		if len(e.Overridden) > 0 {
+			line += fmt.Sprintf(" (overrides %s)", strings.Join(e.Overridden, ", "))
+		}
+		c.r.Println(line)
+	}
+
+	return nil
+}
+
 func (c *configCommand) Init(cd *simplecobra.Commandeer) error {
 	c.r = cd.Root.Command.(*rootCommand)
 	cmd := cd.CobraCommand
@@ -112,6 +189,7 @@ func (c *configCommand) Init(cd *simplecobra.Commandeer) error {
 	cmd.Long = `Print the site configuration, both default and custom settings.`
 	cmd.Flags().StringVar(&c.format, "format", "toml", "preferred file format (toml, yaml or json)")
 	cmd.Flags().StringVar(&c.lang, "lang", "", "the language to display config for. Defaults to the first language defined.")
+	cmd.Flags().BoolVar(&c.provenance, "provenance", false, "print the configuration keys with the config file, environment variable or flag that set them")
 	applyLocalFlagsBuildConfig(cmd, c.r)
 
 	return nil
@@ -62,7 +62,10 @@ documentation.
 			cmd.Flags().String("target", "", "target deployment from deployments section in config file; defaults to the first one")
 			cmd.Flags().Bool("confirm", false, "ask for confirmation before making changes to the target")
 			cmd.Flags().Bool("dryRun", false, "dry run")
+			cmd.Flags().Bool("diff", false, "print the files that would be uploaded, deleted and skipped with sizes and reasons, without making any changes")
+			cmd.Flags().String("diffFormat", "text", "the format of the --diff output, text or json")
 			cmd.Flags().Bool("force", false, "force upload of all files")
+			cmd.Flags().Bool("rollback", false, "make the previous version of an atomic deployment target the current one")
 			cmd.Flags().Bool("invalidateCDN", true, "invalidate the CDN cache listed in the deployment target")
 			cmd.Flags().Int("maxDeletes", 256, "maximum # of files to delete, or -1 to disable")
 			cmd.Flags().Int("workers", 10, "number of workers to transfer files. defaults to 10")
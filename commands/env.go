@@ -15,18 +15,42 @@ package commands
 
 import (
 	"context"
+	"encoding/json"
+	"os"
 	"runtime"
 
 	"github.com/bep/simplecobra"
+	"github.com/gohugoio/hugo/common/hexec"
 	"github.com/gohugoio/hugo/common/hugo"
+	"github.com/gohugoio/hugo/config/security"
+	"github.com/spf13/cobra"
 )
 
 func newEnvCommand() simplecobra.Commander {
+	var printJSON bool
+
 	return &simpleCommand{
 		name:  "env",
 		short: "Print Hugo version and environment info",
-		long:  "Print Hugo version and environment info. This is useful in Hugo bug reports",
+		long: `Print Hugo version and environment info. This is useful in Hugo bug reports.
+
+With --json the info is printed as JSON, including the build tags, the effective
+GOMAXPROCS and GOMEMLIMIT and the versions of the external tools found in $PATH
+and allowed by the security.exec.allow setting.`,
 		run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
+			if printJSON {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				enc.SetEscapeHTML(false)
+				// The tools are run with the security policy of the project
+				// in the current directory, if any.
+				sc := security.DefaultConfig
+				if conf, err := r.ConfigFromProvider(r.configVersionID.Load(), flagsToCfg(cd, nil)); err == nil {
+					sc = conf.configs.Base.Security
+				}
+				return enc.Encode(hugo.GetEnvInfo(r.verbose, hexec.New(sc)))
+			}
+
 			r.Printf("%s\n", hugo.BuildVersionString())
 			r.Printf("GOOS=%q\n", runtime.GOOS)
 			r.Printf("GOARCH=%q\n", runtime.GOARCH)
@@ -47,6 +71,9 @@ func newEnvCommand() simplecobra.Commander {
 			}
 			return nil
 		},
+		withc: func(cmd *cobra.Command, r *rootCommand) {
+			cmd.Flags().BoolVar(&printJSON, "json", false, "print the environment info as JSON")
+		},
 	}
 }
 
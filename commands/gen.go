@@ -14,8 +14,11 @@
 package commands
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path"
@@ -26,10 +29,21 @@ import (
 	"github.com/alecthomas/chroma/v2/formatters/html"
 	"github.com/alecthomas/chroma/v2/styles"
 	"github.com/bep/simplecobra"
+	"github.com/gohugoio/hugo/cacheheaders"
 	"github.com/gohugoio/hugo/common/hugo"
+	"github.com/gohugoio/hugo/common/paths"
+	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/csp"
 	"github.com/gohugoio/hugo/docshelper"
 	"github.com/gohugoio/hugo/helpers"
 	"github.com/gohugoio/hugo/hugofs"
+	"github.com/gohugoio/hugo/hugolib"
+	"github.com/gohugoio/hugo/machinetranslation"
+	"github.com/gohugoio/hugo/markup/highlight"
+	"github.com/gohugoio/hugo/parser"
+	"github.com/gohugoio/hugo/parser/metadecoders"
+	"github.com/gohugoio/hugo/redirects"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"github.com/spf13/cobra/doc"
 )
@@ -42,6 +56,9 @@ func newGenCommand() *genCommand {
 
 		// Chroma flags.
 		style          string
+		darkStyle      string
+		darkMode       string
+		darkModeClass  string
 		highlightStyle string
 		linesStyle     string
 	)
@@ -55,23 +72,38 @@ func newGenCommand() *genCommand {
 See https://xyproto.github.io/splash/docs/all.html for a preview of the available styles`,
 
 			run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
-				builder := styles.Get(style).Builder()
-				if highlightStyle != "" {
-					builder.Add(chroma.LineHighlight, highlightStyle)
+				buildStyle := func(name string) (*chroma.Style, error) {
+					builder := styles.Get(name).Builder()
+					if highlightStyle != "" {
+						builder.Add(chroma.LineHighlight, highlightStyle)
+					}
+					if linesStyle != "" {
+						builder.Add(chroma.LineNumbers, linesStyle)
+					}
+					return builder.Build()
+				}
+				style, err := buildStyle(style)
+				if err != nil {
+					return err
+				}
+				if darkStyle == "" {
+					formatter := html.New(html.WithAllClasses(true))
+					return formatter.WriteCSS(os.Stdout, style)
 				}
-				if linesStyle != "" {
-					builder.Add(chroma.LineNumbers, linesStyle)
+				if darkMode != highlight.DarkModeMedia && darkMode != highlight.DarkModeClass {
+					return fmt.Errorf("invalid darkMode %q, must be one of media or class", darkMode)
 				}
-				style, err := builder.Build()
+				dark, err := buildStyle(darkStyle)
 				if err != nil {
 					return err
 				}
-				formatter := html.New(html.WithAllClasses(true))
-				formatter.WriteCSS(os.Stdout, style)
-				return nil
+				return highlight.WriteStylesCSS(os.Stdout, style, dark, darkMode, darkModeClass)
 			},
 			withc: func(cmd *cobra.Command, r *rootCommand) {
 				cmd.PersistentFlags().StringVar(&style, "style", "friendly", "highlighter style (see https://xyproto.github.io/splash/docs/)")
+				cmd.PersistentFlags().StringVar(&darkStyle, "darkStyle", "", "highlighter style to use in dark mode, with the colors of both styles in CSS custom properties")
+				cmd.PersistentFlags().StringVar(&darkMode, "darkMode", highlight.DarkModeMedia, `how to switch to the dark style, "media" (prefers-color-scheme) or "class"`)
+				cmd.PersistentFlags().StringVar(&darkModeClass, "darkModeClass", highlight.DefaultConfig.DarkModeClass, `the class to switch to the dark style with darkMode "class"`)
 				cmd.PersistentFlags().StringVar(&highlightStyle, "highlightStyle", "bg:#ffffcc", "style used for highlighting lines (see https://github.com/alecthomas/chroma)")
 				cmd.PersistentFlags().StringVar(&linesStyle, "linesStyle", "", "style used for line numbers (see https://github.com/alecthomas/chroma)")
 			},
@@ -211,12 +243,285 @@ url: %s
 		}
 	}
 
+	var (
+		oldPermalinks map[string]string
+		oldManifest   string
+		aliasesFormat string
+	)
+
+	newAliases := func() simplecobra.Commander {
+		return &simpleCommand{
+			name:  "aliases",
+			short: "Generate aliases for the pages with a changed URL.",
+			long: `Generate aliases for the pages published to another URL than before, e.g. after
+a change to the permalinks configuration, to keep the old URLs working.
+
+The previous URLs are computed from the previous permalinks configuration,
+given with --oldPermalinks, or read from the build manifest of a previous build
+(see build.manifest), given with --oldManifest.
+
+With --format aliases (default) the aliases to add to the front matter of the
+content files are listed as CSV. With --format json or --format netlify, the
+redirects are printed in that format (see the redirects configuration).`,
+			run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
+				if (len(oldPermalinks) == 0) == (oldManifest == "") {
+					return errors.New("one of --oldPermalinks and --oldManifest must be provided")
+				}
+				if aliasesFormat != "aliases" && redirects.Filename(aliasesFormat) == "" {
+					return fmt.Errorf("invalid format %q, must be one of aliases, json or netlify", aliasesFormat)
+				}
+
+				var manifest []byte
+				if oldManifest != "" {
+					// Read the manifest before building, it may live in the publish dir.
+					var err error
+					if manifest, err = os.ReadFile(oldManifest); err != nil {
+						return err
+					}
+				}
+
+				h, err := r.Build(cd, hugolib.BuildCfg{SkipRender: true}, config.New())
+				if err != nil {
+					return err
+				}
+
+				var changes []hugolib.PermalinkChange
+				if manifest != nil {
+					changes, err = h.PermalinkChangesFromManifest(bytes.NewReader(manifest))
+				} else {
+					changes, err = h.PermalinkChangesFromPatterns(oldPermalinks)
+				}
+				if err != nil {
+					return err
+				}
+
+				if aliasesFormat != "aliases" {
+					rs := make([]redirects.Redirect, len(changes))
+					for i, c := range changes {
+						rs[i] = c.Redirect
+					}
+					return redirects.Write(r.Out, aliasesFormat, rs)
+				}
+
+				writer := csv.NewWriter(r.Out)
+				defer writer.Flush()
+				writer.Write([]string{"path", "alias"})
+				for _, c := range changes {
+					if err := writer.Write([]string{c.Source, c.Alias}); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			withc: func(cmd *cobra.Command, r *rootCommand) {
+				cmd.Flags().StringToStringVar(&oldPermalinks, "oldPermalinks", nil, "the previous permalinks configuration, e.g. posts=/:year/:month/:title/")
+				cmd.Flags().StringVar(&oldManifest, "oldManifest", "", "the build manifest of a previous build")
+				cmd.Flags().StringVar(&aliasesFormat, "format", "aliases", "the output format, one of aliases, json or netlify")
+			},
+		}
+	}
+
+	var cacheHeadersFormat string
+
+	newCacheHeaders := func() simplecobra.Commander {
+		return &simpleCommand{
+			name:  "cacheheaders",
+			short: "Generate recommended Cache-Control headers for the published files.",
+			long: `Generate recommended Cache-Control headers for the files in the publish
+directory, as server.headers configuration.
+
+Run it after a build. Files with a fingerprint in the filename (e.g. from
+resources.Fingerprint or image processing) are cached forever, other assets
+for a day and HTML and other documents are revalidated on every request.
+
+The Cache-Control headers currently configured in server.headers that don't
+match these recommendations are reported as warnings.`,
+			run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
+				var format metadecoders.Format
+				switch cacheHeadersFormat {
+				case "toml":
+					format = metadecoders.TOML
+				case "yaml":
+					format = metadecoders.YAML
+				case "json":
+					format = metadecoders.JSON
+				default:
+					return fmt.Errorf("invalid format %q, must be one of toml, yaml or json", cacheHeadersFormat)
+				}
+
+				conf, err := r.ConfigFromProvider(r.configVersionID.Load(), flagsToCfg(cd, nil))
+				if err != nil {
+					return err
+				}
+
+				var files []string
+				err = afero.Walk(conf.fs.PublishDir, "", func(filename string, info os.FileInfo, err error) error {
+					if err != nil {
+						return err
+					}
+					if !info.IsDir() {
+						files = append(files, filepath.ToSlash(filename))
+					}
+					return nil
+				})
+				if err != nil && !os.IsNotExist(err) {
+					return err
+				}
+				if len(files) == 0 {
+					return fmt.Errorf("no files found in %q, build the site first", conf.configs.Base.PublishDir)
+				}
+
+				basePath := conf.configs.Base.C.BaseURL.Path()
+				server := conf.configs.Base.Server
+				current := func(p string) string {
+					var cc string
+					for _, h := range server.MatchHeaders(p) {
+						if strings.EqualFold(h.Key, "Cache-Control") {
+							cc = h.Value
+						}
+					}
+					return cc
+				}
+				for _, m := range cacheheaders.Check(basePath, files, current) {
+					r.logger.Warnf("cacheheaders: %s", m)
+				}
+
+				return parser.InterfaceToConfig(cacheheaders.ServerHeaders(cacheheaders.Recommend(basePath, files)), format, r.Out)
+			},
+			withc: func(cmd *cobra.Command, r *rootCommand) {
+				cmd.Flags().StringVar(&cacheHeadersFormat, "format", "toml", "the output format, one of toml, yaml or json")
+			},
+		}
+	}
+
+	var cspFormat string
+
+	newCSP := func() simplecobra.Commander {
+		return &simpleCommand{
+			name:  "csp",
+			short: "Generate a Content-Security-Policy for the published files.",
+			long: `Generate a Content-Security-Policy for the files in the publish directory,
+as server.headers configuration or as a meta tag (--format meta).
+
+Run it after a build. The HTML files are scanned for inline scripts and styles,
+which are allowed by their hashes, and for the external origins of scripts,
+stylesheets, images, fonts, media, frames and form actions. The stylesheets are
+scanned for external fonts, images and imports.
+
+Inline scripts and styles must not change between builds for the hashes to
+stay valid, so run it again when they do, e.g. when an inlined asset from the
+asset pipeline changes.`,
+			run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
+				var format metadecoders.Format
+				switch cspFormat {
+				case "toml":
+					format = metadecoders.TOML
+				case "yaml":
+					format = metadecoders.YAML
+				case "json":
+					format = metadecoders.JSON
+				case "meta":
+				default:
+					return fmt.Errorf("invalid format %q, must be one of toml, yaml, json or meta", cspFormat)
+				}
+
+				conf, err := r.ConfigFromProvider(r.configVersionID.Load(), flagsToCfg(cd, nil))
+				if err != nil {
+					return err
+				}
+
+				baseURL := conf.configs.Base.C.BaseURL
+				collector := csp.NewCollector(baseURL.String())
+				var numFiles int
+				err = afero.Walk(conf.fs.PublishDir, "", func(filename string, info os.FileInfo, err error) error {
+					if err != nil {
+						return err
+					}
+					if info.IsDir() {
+						return nil
+					}
+					numFiles++
+					b, err := afero.ReadFile(conf.fs.PublishDir, filename)
+					if err != nil {
+						return err
+					}
+					collector.AddFile(filename, b)
+					return nil
+				})
+				if err != nil && !os.IsNotExist(err) {
+					return err
+				}
+				if numFiles == 0 {
+					return fmt.Errorf("no files found in %q, build the site first", conf.configs.Base.PublishDir)
+				}
+
+				policy := collector.Policy()
+				if cspFormat == "meta" {
+					r.Println(policy.MetaTag())
+					return nil
+				}
+				return parser.InterfaceToConfig(policy.ServerHeaders(baseURL.Path()), format, r.Out)
+			},
+			withc: func(cmd *cobra.Command, r *rootCommand) {
+				cmd.Flags().StringVar(&cspFormat, "format", "toml", "the output format, one of toml, yaml, json or meta")
+			},
+		}
+	}
+
+	newTranslations := func() simplecobra.Commander {
+		return &simpleCommand{
+			name:  "translations",
+			short: "Generate the missing translations for review.",
+			long: `Generate the i18n strings of the default content language that are missing in
+the other languages, machine translated by the provider configured in
+machineTranslation, and list the content pages without a translation.
+
+The results are written to the review directory (machineTranslation.reviewDir,
+default "_translations") and never published: review the strings in
+<reviewDir>/i18n/<lang>.toml and move them to i18n/<lang>.toml. The untranslated
+pages are listed in <reviewDir>/untranslated.csv.
+
+Without a provider, the missing strings are written in the default content
+language.`,
+			run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
+				h, err := r.Build(cd, hugolib.BuildCfg{SkipRender: true}, config.New())
+				if err != nil {
+					return err
+				}
+
+				conf := h.Configs.Base.MachineTranslation
+				t, err := machinetranslation.New(conf, h.Deps.ExecHelper)
+				if err != nil {
+					return err
+				}
+				reviews, err := h.TranslationReviews(ctx, t, conf.Provider)
+				if err != nil {
+					return err
+				}
+
+				dir := paths.AbsPathify(h.Configs.Base.WorkingDir, conf.ReviewDir)
+				if err := machinetranslation.WriteReviews(hugofs.Os, dir, reviews); err != nil {
+					return err
+				}
+				for _, rv := range reviews {
+					r.Printf("%s: %d missing i18n string(s), %d untranslated page(s)\n", rv.Lang, len(rv.Messages), len(rv.UntranslatedPages))
+				}
+				r.Println("Wrote the results to", dir)
+				return nil
+			},
+		}
+	}
+
 	return &genCommand{
 		commands: []simplecobra.Commander{
+			newAliases(),
+			newCacheHeaders(),
 			newChromaStyles(),
+			newCSP(),
 			newGen(),
 			newMan(),
 			newDocsHelper(),
+			newTranslations(),
 		},
 	}
 
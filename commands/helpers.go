@@ -61,6 +61,9 @@ func setValueFromFlag(flags *pflag.FlagSet, key string, cfg config.Provider, tar
 		case "int":
 			iv, _ := flags.GetInt(key)
 			cfg.Set(configKey, iv)
+		case "stringToString":
+			mv, _ := flags.GetStringToString(key)
+			cfg.Set(configKey, mv)
 		default:
 			panic(fmt.Sprintf("update switch with %s", f.Value.Type()))
 		}
@@ -84,6 +87,7 @@ func flagsToCfgWithAdditionalConfigBase(cd *simplecobra.Commandeer, cfg config.P
 		"printI18nWarnings": "logI18nWarnings",
 		"printPathWarnings": "logPathWarnings",
 		"editor":            "newContentEditor",
+		"checkLinks":        "checkLinks.enable",
 	}
 
 	// Flags that we for some reason don't want to expose in the site config.
@@ -95,6 +99,8 @@ func flagsToCfgWithAdditionalConfigBase(cd *simplecobra.Commandeer, cfg config.P
 		"liveReloadPort":    true,
 		"renderToMemory":    true,
 		"clock":             true,
+		"flag":              true,
+		"site":              true,
 	}
 
 	cmd := cd.CobraCommand
@@ -35,6 +35,7 @@ import (
 	"github.com/gohugoio/hugo/common/terminal"
 	"github.com/gohugoio/hugo/common/types"
 	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/config/internaltemplates"
 	"github.com/gohugoio/hugo/helpers"
 	"github.com/gohugoio/hugo/hugofs"
 	"github.com/gohugoio/hugo/hugolib"
@@ -173,11 +174,29 @@ func (c *hugoBuilder) getDirList() ([]string, error) {
 		}
 	}
 
+	// Files imported by e.g. js.Build may live outside of the directories
+	// above, e.g. in node_modules.
+	filenames = append(filenames, h.ResourceSpec.ResourceCache.DependencyDirs()...)
+
 	filenames = helpers.UniqueStringsSorted(filenames)
 
 	return filenames, nil
 }
 
+// watchResourceDependencies adds the directories of the files registered as
+// resource dependencies to the watcher, as the set of dependencies may grow
+// on rebuilds.
+func (c *hugoBuilder) watchResourceDependencies(watcher *watcher.Batcher) {
+	h, err := c.hugo()
+	if err != nil {
+		return
+	}
+	for _, dir := range h.ResourceSpec.ResourceCache.DependencyDirs() {
+		// Adding an already watched directory is either a no-op or an error, both fine.
+		_ = watcher.Add(dir)
+	}
+}
+
 func (c *hugoBuilder) initCPUProfile() (func(), error) {
 	if c.r.cpuprofile == "" {
 		return nil, nil
@@ -379,6 +398,7 @@ func (c *hugoBuilder) newWatcher(pollIntervalStr string, dirList ...string) (*wa
 					return
 				}
 				c.handleEvents(watcher, staticSyncer, evs, configSet)
+				c.watchResourceDependencies(watcher)
 				if c.showErrorInBrowser && c.errCount() > 0 {
 					// Need to reload browser to show the error
 					livereload.ForceRefresh()
@@ -437,6 +457,21 @@ func (c *hugoBuilder) build() error {
 			}
 		}
 
+		if c.r.printInternalTemplates {
+			conf := h.Conf.GetConfigSection("internalTemplates").(internaltemplates.Config)
+			for _, name := range h.Tmpl().(tpl.InternalTemplatesProvider).InternalTemplates() {
+				tc, _ := conf.Get(name)
+				switch {
+				case tc.Disable:
+					c.r.Printf("Internal template %s is referenced (disabled)\n", name)
+				case tc.Template != "":
+					c.r.Printf("Internal template %s is referenced (overridden by %s)\n", name, tc.Template)
+				default:
+					c.r.Printf("Internal template %s is referenced\n", name)
+				}
+			}
+		}
+
 		h.PrintProcessingStats(os.Stdout)
 		c.r.Println()
 	}
@@ -453,6 +488,14 @@ func (c *hugoBuilder) buildSites(noBuildLock bool) (err error) {
 }
 
 func (c *hugoBuilder) copyStatic() (map[string]uint64, error) {
+	var primaryShard bool
+	c.withConf(func(conf *commonConfig) {
+		primaryShard = conf.configs.Base.C.Shard.IsPrimary()
+	})
+	if !primaryShard {
+		// The static files are copied by the first shard only.
+		return nil, nil
+	}
 	m, err := c.doWithPublishDirs(c.copyStaticTo)
 	if err == nil || herrors.IsNotExist(err) {
 		return m, nil
@@ -1008,10 +1051,16 @@ func (c *hugoBuilder) loadConfig(cd *simplecobra.Commandeer, running bool) error
 	}
 	cfg.Set("environment", c.r.environment)
 
+	var lang string
+	if c.s != nil {
+		lang = c.s.lang
+	}
 	cfg.Set("internal", maps.Params{
 		"running": running,
 		"watch":   watch,
 		"verbose": c.r.verbose,
+		"site":    c.r.site,
+		"lang":    lang,
 	})
 
 	conf, err := c.r.ConfigFromProvider(c.r.configVersionID.Load(), flagsToCfg(cd, cfg))
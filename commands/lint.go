@@ -0,0 +1,79 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bep/simplecobra"
+	"github.com/gohugoio/hugo/common/hugo"
+	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/hugolib"
+	"github.com/gohugoio/hugo/lint"
+	"github.com/spf13/cobra"
+)
+
+func newLintCommand() simplecobra.Commander {
+	var format string
+
+	return &simpleCommand{
+		name:  "lint",
+		short: "Check your content with lint rules",
+		long: `Check your content files with lint rules, e.g. for pages without a description,
+including drafts, future and expired pages.
+
+The built-in rules are missing-description, title-length, stale-lastmod,
+future-draft and missing-cover (disabled by default). The rules can be
+configured, globally or per section, in the lint configuration.
+
+With --format json or --format sarif the findings are printed as JSON or
+as SARIF, e.g. to annotate the content files in CI.
+The command fails if any finding has the severity error.`,
+		run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
+			switch format {
+			case lint.FormatText, lint.FormatJSON, lint.FormatSARIF:
+			default:
+				return fmt.Errorf("invalid format %q, must be one of text, json or sarif", format)
+			}
+
+			cfg := config.New()
+			cfg.Set("buildDrafts", true)
+			cfg.Set("buildFuture", true)
+			cfg.Set("buildExpired", true)
+			h, err := r.Build(cd, hugolib.BuildCfg{SkipRender: true}, cfg)
+			if err != nil {
+				return err
+			}
+
+			linter := lint.New(h.Configs.Base.Lint, h.Configs.Base.WorkingDir)
+			findings, err := linter.Lint(h.Pages())
+			if err != nil {
+				return err
+			}
+
+			if err := lint.Write(r.Out, format, hugo.CurrentVersion.String(), findings); err != nil {
+				return err
+			}
+
+			if errors := lint.NewReport(findings).Summary[lint.SeverityError]; errors > 0 {
+				return fmt.Errorf("lint: found %d error(s)", errors)
+			}
+			return nil
+		},
+		withc: func(cmd *cobra.Command, r *rootCommand) {
+			cmd.Flags().StringVar(&format, "format", lint.FormatText, "the output format, one of text, json or sarif")
+		},
+	}
+}
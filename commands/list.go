@@ -16,8 +16,10 @@ package commands
 import (
 	"context"
 	"encoding/csv"
+	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -25,8 +27,11 @@ import (
 	"github.com/bep/simplecobra"
 	"github.com/gohugoio/hugo/config"
 	"github.com/gohugoio/hugo/hugolib"
+	"github.com/gohugoio/hugo/parser/pageparser"
 	"github.com/gohugoio/hugo/resources/page"
 	"github.com/gohugoio/hugo/resources/resource"
+	toml "github.com/pelletier/go-toml/v2"
+	"github.com/spf13/cobra"
 )
 
 // newListCommand creates a new list command and its subcommands.
@@ -86,6 +91,165 @@ func newListCommand() *listCommand {
 
 	}
 
+	listParams := func(cd *simplecobra.Commandeer, r *rootCommand) error {
+		cfg := config.New()
+		cfg.Set("buildDrafts", true)
+		cfg.Set("buildFuture", true)
+		cfg.Set("buildExpired", true)
+		h, err := r.Build(cd, hugolib.BuildCfg{SkipRender: true}, cfg)
+		if err != nil {
+			return err
+		}
+
+		workingDir := h.Conf.BaseConfig().WorkingDir
+		frontMatters := make(map[string]map[string]any)
+
+		var collect func(p page.Page) error
+		collect = func(p page.Page) error {
+			// Content files in page bundles are not in .Site.AllPages.
+			for _, r := range p.Resources().ByType("page") {
+				if err := collect(r.(page.Page)); err != nil {
+					return err
+				}
+			}
+			if p.File().IsZero() {
+				return nil
+			}
+			f, err := p.File().FileInfo().Meta().Open()
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			pf, err := pageparser.ParseFrontMatterAndContent(f)
+			if err != nil {
+				return fmt.Errorf("failed to parse front matter in %q: %w", p.File().Filename(), err)
+			}
+			filename := filepath.ToSlash(strings.TrimPrefix(p.File().Filename(), workingDir+string(os.PathSeparator)))
+			frontMatters[filename] = pf.FrontMatter
+			return nil
+		}
+
+		for _, p := range h.Pages() {
+			if err := collect(p); err != nil {
+				return err
+			}
+		}
+
+		// Collect the keys in a stable order to get stable examples.
+		filenames := make([]string, 0, len(frontMatters))
+		for filename := range frontMatters {
+			filenames = append(filenames, filename)
+		}
+		sort.Strings(filenames)
+
+		stats := make(map[string]*frontMatterKeyStats)
+		for _, filename := range filenames {
+			collectFrontMatterKeys(stats, "", frontMatters[filename], filename)
+		}
+
+		keys := make([]*frontMatterKeyStats, 0, len(stats))
+		for _, v := range stats {
+			keys = append(keys, v)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			if keys[i].count != keys[j].count {
+				return keys[i].count > keys[j].count
+			}
+			return keys[i].key < keys[j].key
+		})
+
+		writer := csv.NewWriter(r.Out)
+		defer writer.Flush()
+
+		writer.Write([]string{
+			"key",
+			"count",
+			"types",
+			"examples",
+		})
+
+		for _, k := range keys {
+			if err := writer.Write(k.record()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	var translationsLang string
+
+	listTranslations := func(cd *simplecobra.Commandeer, r *rootCommand) error {
+		h, err := r.Build(cd, hugolib.BuildCfg{SkipRender: true}, config.New())
+		if err != nil {
+			return err
+		}
+
+		lang := translationsLang
+		if lang == "" {
+			lang = h.Conf.DefaultContentLanguage()
+		}
+		var site page.Site
+		for _, s := range h.Sites {
+			if s.Lang() == lang {
+				site = s
+				break
+			}
+		}
+		if site == nil {
+			return fmt.Errorf("language %q not found", lang)
+		}
+
+		workingDir := h.Conf.BaseConfig().WorkingDir
+		relFilename := func(p page.Page) string {
+			if p == nil || p.File().IsZero() {
+				return ""
+			}
+			return filepath.ToSlash(strings.TrimPrefix(p.File().Filename(), workingDir+string(os.PathSeparator)))
+		}
+
+		writer := csv.NewWriter(r.Out)
+		defer writer.Flush()
+
+		writer.Write([]string{
+			"path",
+			"lang",
+			"wordCount",
+			"translationLang",
+			"translated",
+			"stale",
+			"lastmodDelta",
+			"translationWordCount",
+			"translationPath",
+		})
+
+		status := site.TranslationStatus(context.Background())
+		for _, ps := range status.Pages {
+			for _, t := range ps.Translations {
+				record := []string{
+					relFilename(ps.Page),
+					status.Lang,
+					strconv.Itoa(ps.WordCount),
+					t.Lang,
+					strconv.FormatBool(t.Translated),
+					strconv.FormatBool(t.Stale),
+					"",
+					"",
+					relFilename(t.Page),
+				}
+				if t.Translated {
+					record[6] = t.LastmodDelta.String()
+					record[7] = strconv.Itoa(t.WordCount)
+				}
+				if err := writer.Write(record); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
 	return &listCommand{
 		commands: []simplecobra.Commander{
 			&simpleCommand{
@@ -153,11 +317,136 @@ func newListCommand() *listCommand {
 					return list(cd, r, shouldInclude, "buildDrafts", true, "buildFuture", true, "buildExpired", true)
 				},
 			},
+			&simpleCommand{
+				name:  "params",
+				short: "List all front matter keys",
+				long: `List all of the front matter keys in your content files, including drafts, future and expired pages,
+with the number of files using them, the types of their values and some example files.
+
+Nested keys are listed with their full path, e.g. params.author, and
+keys in lists of maps with [], e.g. resources[].src.`,
+				run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
+					return listParams(cd, r)
+				},
+			},
+			&simpleCommand{
+				name:  "translations",
+				short: "List the translation status of all pages",
+				long: `List, per page in the source language, which languages have a translation,
+how much older the translation is than the source (lastmodDelta) and the word counts.
+
+The source language defaults to defaultContentLanguage.`,
+				withc: func(cmd *cobra.Command, r *rootCommand) {
+					cmd.Flags().StringVar(&translationsLang, "lang", "", "the source language")
+				},
+				run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
+					return listTranslations(cd, r)
+				},
+			},
 		},
 	}
 
 }
 
+// The maximum number of example files listed for a front matter key.
+const frontMatterKeyMaxExamples = 3
+
+type frontMatterKeyStats struct {
+	key      string
+	count    int
+	types    map[string]int
+	examples []string
+
+	// The last file counted, to count every file once.
+	lastFilename string
+}
+
+func (s *frontMatterKeyStats) record() []string {
+	types := make([]string, 0, len(s.types))
+	for t := range s.types {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		if s.types[types[i]] != s.types[types[j]] {
+			return s.types[types[i]] > s.types[types[j]]
+		}
+		return types[i] < types[j]
+	})
+	for i, t := range types {
+		types[i] = fmt.Sprintf("%s (%d)", t, s.types[t])
+	}
+
+	return []string{
+		s.key,
+		strconv.Itoa(s.count),
+		strings.Join(types, ", "),
+		strings.Join(s.examples, ", "),
+	}
+}
+
+// collectFrontMatterKeys adds the keys in m, prefixed with prefix, and the
+// keys of any nested maps to stats.
+func collectFrontMatterKeys(stats map[string]*frontMatterKeyStats, prefix string, m map[string]any, filename string) {
+	for k, v := range m {
+		key := prefix + strings.ToLower(k)
+		st, found := stats[key]
+		if !found {
+			st = &frontMatterKeyStats{key: key, types: make(map[string]int)}
+			stats[key] = st
+		}
+		st.types[frontMatterValueType(v)]++
+		if st.lastFilename != filename {
+			st.lastFilename = filename
+			st.count++
+			if len(st.examples) < frontMatterKeyMaxExamples {
+				st.examples = append(st.examples, filename)
+			}
+		}
+
+		switch vv := v.(type) {
+		case map[string]any:
+			collectFrontMatterKeys(stats, key+".", vv, filename)
+		case []any:
+			for _, e := range vv {
+				if em, ok := e.(map[string]any); ok {
+					collectFrontMatterKeys(stats, key+"[].", em, filename)
+				}
+			}
+		case []map[string]any:
+			for _, em := range vv {
+				collectFrontMatterKeys(stats, key+"[].", em, filename)
+			}
+		}
+	}
+}
+
+// frontMatterValueType returns the type of the front matter value v
+// as reported by hugo list params.
+func frontMatterValueType(v any) string {
+	switch vv := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "bool"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return "int"
+	case float32, float64:
+		return "float"
+	case time.Time, toml.LocalDate, toml.LocalDateTime, toml.LocalTime:
+		return "date"
+	case map[string]any:
+		return "map"
+	case []any:
+		return "array"
+	case []map[string]any:
+		return "array"
+	default:
+		return fmt.Sprintf("%T", vv)
+	}
+}
+
 type listCommand struct {
 	commands []simplecobra.Commander
 }
@@ -21,6 +21,8 @@ import (
 
 	"github.com/bep/simplecobra"
 	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/hugofs"
+	"github.com/gohugoio/hugo/modules"
 	"github.com/gohugoio/hugo/modules/npm"
 	"github.com/spf13/cobra"
 )
@@ -38,6 +40,7 @@ See https://gohugo.io/hugo-modules/ for more information.
 func newModCommands() *modCommands {
 	var (
 		clean   bool
+		strict  bool
 		pattern string
 		all     bool
 	)
@@ -106,10 +109,14 @@ so this may/will change in future versions of Hugo.
 			&simpleCommand{
 				name:  "verify",
 				short: "Verify dependencies.",
-				long:  `Verify checks that the dependencies of the current module, which are stored in a local downloaded source cache, have not been modified since being downloaded.`,
+				long: `Verify checks that the dependencies of the current module, which are stored in a local downloaded source cache, have not been modified since being downloaded.
+
+With --strict, it also checks that the versions and files of the modules in use, including vendored modules and
+components in the themes directory, match the hugo.lock file created by "hugo mod lock".`,
 				withc: func(cmd *cobra.Command, r *rootCommand) {
 					applyLocalFlagsBuildConfig(cmd, r)
 					cmd.Flags().BoolVarP(&clean, "clean", "", false, "delete module cache for dependencies that fail verification")
+					cmd.Flags().BoolVarP(&strict, "strict", "", false, "fail if the modules in use do not match hugo.lock")
 				},
 				run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
 					conf, err := r.ConfigFromProvider(r.configVersionID.Load(), flagsToCfg(cd, nil))
@@ -117,7 +124,36 @@ so this may/will change in future versions of Hugo.
 						return err
 					}
 					client := conf.configs.ModulesClient
-					return client.Verify(clean)
+					// Projects using only components in the themes directory
+					// have no Go modules to verify, but can still have a lockfile.
+					if !strict || client.GoModulesFilename != "" {
+						if err := client.Verify(clean); err != nil {
+							return err
+						}
+					}
+					if strict {
+						return client.VerifyLock()
+					}
+					return nil
+				},
+			},
+			&simpleCommand{
+				name:  "lock",
+				short: "Write the module lockfile.",
+				long: `Write the versions and file hashes of the modules in use to the hugo.lock file in the project root.
+
+Commit this file and set module.lock to "warn" or "error" to check it when building, or run
+"hugo mod verify --strict", so changes to themes and other modules can't silently alter the site.
+Run this command again after updating modules.`,
+				withc: func(cmd *cobra.Command, r *rootCommand) {
+					applyLocalFlagsBuildConfig(cmd, r)
+				},
+				run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
+					conf, err := r.ConfigFromProvider(r.configVersionID.Load(), flagsToCfg(cd, nil))
+					if err != nil {
+						return err
+					}
+					return conf.configs.ModulesClient.WriteLock()
 				},
 			},
 			&simpleCommand{
@@ -139,6 +175,30 @@ Note that for vendored modules, that is the version listed and not the one from
 					return client.Graph(os.Stdout)
 				},
 			},
+			&simpleCommand{
+				name:  "notices",
+				short: "Print a third-party notices report for the modules and npm dependencies in use.",
+				long: `Print a third-party notices report listing the modules and npm dependencies used by the build
+with their detected licenses and license texts.
+
+Detected licenses can be overridden and dependencies excluded in the module.notices configuration section.
+Set module.notices.filename to also publish the report during the build.
+`,
+				withc: func(cmd *cobra.Command, r *rootCommand) {
+					applyLocalFlagsBuildConfig(cmd, r)
+				},
+				run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
+					conf, err := r.ConfigFromProvider(r.configVersionID.Load(), flagsToCfg(cd, nil))
+					if err != nil {
+						return err
+					}
+					notices, err := modules.CollectNotices(hugofs.Os, conf.configs.LoadingInfo.BaseConfig.WorkingDir, conf.configs.Modules, conf.configs.Base.Module.Notices)
+					if err != nil {
+						return err
+					}
+					return modules.WriteNotices(os.Stdout, notices)
+				},
+			},
 			&simpleCommand{
 				name:  "clean",
 				short: "Delete the Hugo Module cache for the current project.",
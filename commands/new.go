@@ -18,11 +18,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/bep/simplecobra"
 	"github.com/gohugoio/hugo/common/htime"
+	"github.com/gohugoio/hugo/common/terminal"
 	"github.com/gohugoio/hugo/config"
 	"github.com/gohugoio/hugo/create"
 	"github.com/gohugoio/hugo/helpers"
@@ -37,6 +39,9 @@ func newNewCommand() *newCommand {
 		force       bool
 		contentType string
 		format      string
+		archetype   string
+		prompts     map[string]string
+		interactive bool
 	)
 
 	var c *newCommand
@@ -52,6 +57,11 @@ func newNewCommand() *newCommand {
 		You can also specify the kind with ` + "`-k KIND`" + `.
 		
 		If archetypes are provided in your theme or site, they will be used.
+		You can also specify the archetype with ` + "`--archetype NAME`" + `, e.g. one from a module,
+		or a URL to a single archetype file or a .zip archive of a bundle archetype.
+		
+		The prompts defined in the archetype front matter are asked for on the terminal,
+		unless set with ` + "`--prompt name=value`" + `.
 		
 		Ensure you run this within the root directory of your site.`,
 				run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
@@ -62,10 +72,23 @@ func newNewCommand() *newCommand {
 					if err != nil {
 						return err
 					}
-					return create.NewContent(h, contentType, args[0], force)
+					opts := create.Options{
+						Kind:       contentType,
+						TargetPath: args[0],
+						Force:      force,
+						Archetype:  archetype,
+						Prompts:    prompts,
+						Out:        r.Out,
+					}
+					if terminal.IsTerminal(os.Stdin) {
+						opts.In = os.Stdin
+					}
+					return create.NewContentWithOptions(h, opts)
 				},
 				withc: func(cmd *cobra.Command, r *rootCommand) {
 					cmd.Flags().StringVarP(&contentType, "kind", "k", "", "content type to create")
+					cmd.Flags().StringVar(&archetype, "archetype", "", "archetype to use, a name in the archetypes or a URL to an archetype file or .zip archive")
+					cmd.Flags().StringToStringVar(&prompts, "prompt", nil, "set the value of an archetype prompt, e.g. --prompt author=Jane")
 					cmd.Flags().String("editor", "", "edit new content with this editor, if provided")
 					cmd.Flags().BoolVarP(&force, "force", "f", false, "overwrite file if it already exists")
 					cmd.Flags().StringVar(&format, "format", "toml", "preferred file format (toml, yaml or json)")
@@ -79,7 +102,11 @@ func newNewCommand() *newCommand {
 				short: "Create a new site (skeleton)",
 				long: `Create a new site in the provided directory.
 The new site will have the correct structure, but no content or theme yet.
-Use ` + "`hugo new [contentPath]`" + ` to create new content.`,
+Use ` + "`hugo new [contentPath]`" + ` to create new content.
+
+With --interactive you are asked about the site title, config format, theme
+(installed as a Hugo Module), languages and CI provider, and the site is
+created with a configuration directory, example content and the CI config.`,
 				run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
 					if len(args) < 1 {
 						return errors.New("path needs to be provided")
@@ -99,6 +126,15 @@ Use ` + "`hugo new [contentPath]`" + ` to create new content.`,
 					}
 					sourceFs := conf.fs.Source
 
+					var answers newSiteAnswers
+					if interactive {
+						answers, err = askNewSite(os.Stdin, r.Out, format)
+						if err != nil {
+							return err
+						}
+						format = answers.Format
+					}
+
 					archeTypePath := filepath.Join(createpath, "archetypes")
 					dirs := []string{
 						archeTypePath,
@@ -137,19 +173,31 @@ Use ` + "`hugo new [contentPath]`" + ` to create new content.`,
 						}
 					}
 
-					c.newSiteCreateConfig(sourceFs, createpath, format)
+					if interactive {
+						if err := c.newSiteCreateInteractive(sourceFs, createpath, answers); err != nil {
+							return err
+						}
+					} else {
+						c.newSiteCreateConfig(sourceFs, createpath, format)
+					}
 
 					// Create a default archetype file.
 					helpers.SafeWriteToDisk(filepath.Join(archeTypePath, "default.md"),
 						strings.NewReader(create.DefaultArchetypeTemplateTemplate), sourceFs)
 
 					r.Printf("Congratulations! Your new Hugo site is created in %s.\n\n", createpath)
-					r.Println(c.newSiteNextStepsText())
+					if interactive {
+						r.Println(c.newSiteInteractiveNextStepsText(answers))
+					} else {
+						r.Println(c.newSiteNextStepsText())
+					}
 
 					return nil
 				},
 				withc: func(cmd *cobra.Command, r *rootCommand) {
 					cmd.Flags().BoolVarP(&force, "force", "f", false, "init inside non-empty directory")
+					cmd.Flags().StringVar(&format, "format", "toml", "preferred file format (toml, yaml or json)")
+					cmd.Flags().BoolVarP(&interactive, "interactive", "i", false, "ask about the theme, config format, languages and CI provider")
 				},
 			},
 			&simpleCommand{
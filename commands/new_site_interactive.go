@@ -0,0 +1,342 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gohugoio/hugo/common/htime"
+	"github.com/gohugoio/hugo/common/hugo"
+	"github.com/gohugoio/hugo/create"
+	"github.com/gohugoio/hugo/helpers"
+	"github.com/gohugoio/hugo/parser"
+	"github.com/gohugoio/hugo/parser/metadecoders"
+	"github.com/spf13/afero"
+)
+
+const (
+	newSiteCINone    = "none"
+	newSiteCIGitHub  = "github"
+	newSiteCIGitLab  = "gitlab"
+	newSiteCINetlify = "netlify"
+)
+
+var languageCodeRe = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]+)*$`)
+
+// newSiteAnswers holds the answers to the questions asked by hugo new site --interactive.
+type newSiteAnswers struct {
+	Title     string
+	Format    string
+	Theme     string
+	Languages []string
+	CI        string
+}
+
+func (a newSiteAnswers) multilingual() bool {
+	return len(a.Languages) > 1
+}
+
+// askNewSite asks the questions for an interactive new site.
+func askNewSite(in io.Reader, out io.Writer, format string) (newSiteAnswers, error) {
+	prompts := []create.Prompt{
+		{Name: "title", Message: "Site title", Default: "My New Hugo Site"},
+		{Name: "format", Message: "Config format", Default: format, Options: []string{"toml", "yaml", "json"}},
+		{Name: "theme", Message: "Theme module path, e.g. github.com/theNewDynamic/gohugo-theme-ananke, or none", Default: "none"},
+		{Name: "languages", Message: "Languages, comma separated, the first is the default", Default: "en"},
+		{Name: "ci", Message: "CI provider", Default: newSiteCINone, Options: []string{newSiteCINone, newSiteCIGitHub, newSiteCIGitLab, newSiteCINetlify}},
+	}
+
+	values, err := create.AskPrompts(prompts, nil, in, out)
+	if err != nil {
+		return newSiteAnswers{}, err
+	}
+
+	a := newSiteAnswers{
+		Title:  values["title"].(string),
+		Format: values["format"].(string),
+		CI:     values["ci"].(string),
+	}
+	if theme := values["theme"].(string); theme != "none" {
+		a.Theme = theme
+	}
+	for _, lang := range strings.Split(values["languages"].(string), ",") {
+		lang = strings.ToLower(strings.TrimSpace(lang))
+		if lang == "" {
+			continue
+		}
+		if !languageCodeRe.MatchString(lang) {
+			return a, fmt.Errorf("invalid language code %q", lang)
+		}
+		a.Languages = append(a.Languages, lang)
+	}
+	if len(a.Languages) == 0 {
+		a.Languages = []string{"en"}
+	}
+
+	return a, nil
+}
+
+// newSiteCreateInteractive creates the configuration tree, example content
+// and CI configuration in inpath as answered in a.
+func (c *newCommand) newSiteCreateInteractive(fs afero.Fs, inpath string, a newSiteAnswers) error {
+	format := metadecoders.FormatFromString(a.Format)
+	configDir := filepath.Join(inpath, "config", "_default")
+
+	writeConfig := func(name string, in any) error {
+		var buf bytes.Buffer
+		if err := parser.InterfaceToConfig(in, format, &buf); err != nil {
+			return err
+		}
+		return helpers.WriteToDisk(filepath.Join(configDir, name+"."+a.Format), &buf, fs)
+	}
+
+	siteConfig := map[string]any{
+		"baseURL":      "https://example.org/",
+		"title":        a.Title,
+		"languageCode": a.Languages[0],
+	}
+	if a.multilingual() {
+		siteConfig["defaultContentLanguage"] = a.Languages[0]
+	}
+	if err := writeConfig("hugo", siteConfig); err != nil {
+		return err
+	}
+
+	if a.multilingual() {
+		languages := make(map[string]any)
+		for i, lang := range a.Languages {
+			languages[lang] = map[string]any{
+				"languageCode": lang,
+				"weight":       i + 1,
+				"contentDir":   "content/" + lang,
+			}
+		}
+		if err := writeConfig("languages", languages); err != nil {
+			return err
+		}
+	}
+
+	if a.Theme != "" {
+		module := map[string]any{
+			"imports": []map[string]any{{"path": a.Theme}},
+		}
+		if err := writeConfig("module", module); err != nil {
+			return err
+		}
+		gomod := fmt.Sprintf("module %s\n\ngo 1.20\n", filepath.Base(inpath))
+		if err := helpers.WriteToDisk(filepath.Join(inpath, "go.mod"), strings.NewReader(gomod), fs); err != nil {
+			return err
+		}
+	}
+
+	for _, lang := range a.Languages {
+		contentDir := filepath.Join(inpath, "content")
+		if a.multilingual() {
+			contentDir = filepath.Join(contentDir, lang)
+		}
+		if err := c.newSiteCreateExampleContent(fs, contentDir, a); err != nil {
+			return err
+		}
+	}
+
+	return c.newSiteCreateCI(fs, inpath, a.CI)
+}
+
+func (c *newCommand) newSiteCreateExampleContent(fs afero.Fs, contentDir string, a newSiteAnswers) error {
+	format := metadecoders.FormatFromString(a.Format)
+
+	for _, v := range []struct {
+		filename    string
+		frontMatter map[string]any
+		content     string
+	}{
+		{
+			filename:    "_index.md",
+			frontMatter: map[string]any{"title": a.Title},
+			content:     "Welcome to your new Hugo site.\n",
+		},
+		{
+			filename:    filepath.Join("posts", "hello-world.md"),
+			frontMatter: map[string]any{"title": "Hello World", "date": htime.Now().Format(time.RFC3339), "draft": true},
+			content:     "This is an example post. Edit or remove it, and create new content with `hugo new content`.\n",
+		},
+	} {
+		var buf bytes.Buffer
+		if err := parser.InterfaceToFrontMatter(v.frontMatter, format, &buf); err != nil {
+			return err
+		}
+		buf.WriteString("\n" + v.content)
+		if err := helpers.WriteToDisk(filepath.Join(contentDir, v.filename), &buf, fs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *newCommand) newSiteCreateCI(fs afero.Fs, inpath, ci string) error {
+	var filename, content string
+	version := hugo.CurrentVersion.ReleaseVersion().String()
+
+	switch ci {
+	case newSiteCIGitHub:
+		filename = filepath.Join(".github", "workflows", "hugo.yaml")
+		content = strings.ReplaceAll(newSiteGitHubWorkflow, "HUGO_VERSION_PLACEHOLDER", version)
+	case newSiteCIGitLab:
+		filename = ".gitlab-ci.yml"
+		content = newSiteGitLabCI
+	case newSiteCINetlify:
+		filename = "netlify.toml"
+		content = strings.ReplaceAll(newSiteNetlifyConfig, "HUGO_VERSION_PLACEHOLDER", version)
+	default:
+		return nil
+	}
+
+	return helpers.WriteToDisk(filepath.Join(inpath, filename), strings.NewReader(content), fs)
+}
+
+func (c *newCommand) newSiteInteractiveNextStepsText(a newSiteAnswers) string {
+	var b strings.Builder
+
+	b.WriteString("Just a few more steps and you're ready to go:\n\n")
+
+	step := 1
+	if a.Theme != "" {
+		fmt.Fprintf(&b, "%d. Run \"hugo mod get\" to download the theme %s,\n   or just build the site, which downloads it when needed.\n", step, a.Theme)
+	} else {
+		fmt.Fprintf(&b, "%d. Add a theme as a module, see https://gohugo.io/hugo-modules/use-modules/,\n   or create your own with the \"hugo new theme <THEMENAME>\" command.\n", step)
+	}
+	step++
+	fmt.Fprintf(&b, "%d. Edit the example content in the content directory, the example post is a draft.\n", step)
+	step++
+	fmt.Fprintf(&b, "%d. Start the built-in live server via \"hugo server -D\".\n", step)
+	if a.CI != newSiteCINone {
+		step++
+		fmt.Fprintf(&b, "%d. Set the baseURL in config/_default/hugo.%s and push the site to deploy it with %s.\n", step, a.Format, a.CI)
+	}
+
+	b.WriteString("\nVisit https://gohugo.io/ for quickstart guide and full documentation.")
+
+	return b.String()
+}
+
+const newSiteGitHubWorkflow = `# Workflow for building and deploying a Hugo site to GitHub Pages
+name: Deploy Hugo site to Pages
+
+on:
+  # Runs on pushes targeting the default branch
+  push:
+    branches:
+      - main
+
+  # Allows you to run this workflow manually from the Actions tab
+  workflow_dispatch:
+
+# Sets permissions of the GITHUB_TOKEN to allow deployment to GitHub Pages
+permissions:
+  contents: read
+  pages: write
+  id-token: write
+
+# Allow only one concurrent deployment, skipping runs queued between the run in-progress and latest queued.
+concurrency:
+  group: "pages"
+  cancel-in-progress: false
+
+defaults:
+  run:
+    shell: bash
+
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    env:
+      HUGO_VERSION: HUGO_VERSION_PLACEHOLDER
+    steps:
+      - name: Install Hugo CLI
+        run: |
+          wget -O ${{ runner.temp }}/hugo.deb https://github.com/gohugoio/hugo/releases/download/v${HUGO_VERSION}/hugo_extended_${HUGO_VERSION}_linux-amd64.deb \
+          && sudo dpkg -i ${{ runner.temp }}/hugo.deb
+      - name: Checkout
+        uses: actions/checkout@v3
+        with:
+          submodules: recursive
+          fetch-depth: 0
+      - name: Setup Go
+        uses: actions/setup-go@v4
+        with:
+          go-version: stable
+      - name: Setup Pages
+        id: pages
+        uses: actions/configure-pages@v3
+      - name: Build with Hugo
+        env:
+          HUGO_ENVIRONMENT: production
+        run: |
+          hugo \
+            --gc \
+            --minify \
+            --baseURL "${{ steps.pages.outputs.base_url }}/"
+      - name: Upload artifact
+        uses: actions/upload-pages-artifact@v1
+        with:
+          path: ./public
+
+  deploy:
+    environment:
+      name: github-pages
+      url: ${{ steps.deployment.outputs.page_url }}
+    runs-on: ubuntu-latest
+    needs: build
+    steps:
+      - name: Deploy to GitHub Pages
+        id: deployment
+        uses: actions/deploy-pages@v2
+`
+
+const newSiteGitLabCI = `image: registry.gitlab.com/pages/hugo/hugo_extended:latest
+
+variables:
+  GIT_SUBMODULE_STRATEGY: recursive
+
+pages:
+  script:
+  - hugo --gc --minify
+  artifacts:
+    paths:
+    - public
+  rules:
+  - if: $CI_COMMIT_BRANCH == $CI_DEFAULT_BRANCH
+`
+
+const newSiteNetlifyConfig = `[build]
+  publish = "public"
+  command = "hugo --gc --minify"
+
+[build.environment]
+  HUGO_VERSION = "HUGO_VERSION_PLACEHOLDER"
+  HUGO_ENVIRONMENT = "production"
+
+[context.deploy-preview]
+  command = "hugo --gc --minify --buildFuture -b $DEPLOY_PRIME_URL"
+
+[context.branch-deploy]
+  command = "hugo --gc --minify -b $DEPLOY_PRIME_URL"
+`
@@ -466,6 +466,7 @@ type serverCommand struct {
 	disableLiveReload   bool
 	disableFastRender   bool
 	disableBrowserError bool
+	lang                string
 }
 
 func (c *serverCommand) Name() string {
@@ -537,6 +538,7 @@ of a second, you will be able to save and see your changes nearly instantly.`
 	cmd.Flags().BoolVar(&c.renderStaticToDisk, "renderStaticToDisk", false, "serve static files from disk and dynamic files from memory")
 	cmd.Flags().BoolVar(&c.disableFastRender, "disableFastRender", false, "enables full re-renders on changes")
 	cmd.Flags().BoolVar(&c.disableBrowserError, "disableBrowserError", false, "do not show build errors in the browser")
+	cmd.Flags().StringVar(&c.lang, "lang", "", "build and serve only this language")
 
 	cmd.Flags().String("memstats", "", "log memory usage to this file")
 	cmd.Flags().String("meminterval", "100ms", "interval to poll memory usage (requires --memstats), valid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".")
@@ -955,6 +957,9 @@ func (c *serverCommand) serve() error {
 			mu.HandleFunc(u.Path+"/livereload", livereload.Handler)
 		}
 		c.r.Printf("Web Server is available at %s (bind address %s)\n", serverURL, c.serverInterface)
+		if i == 0 && c.lang != "" && h != nil {
+			c.r.Printf("Serving language %q only, home page at %s\n", c.lang, h.Sites[0].Home().Permalink())
+		}
 		wg1.Go(func() error {
 			if c.tlsCertFile != "" && c.tlsKeyFile != "" {
 				err = srv.ServeTLS(listener, c.tlsCertFile, c.tlsKeyFile)
@@ -0,0 +1,91 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bep/simplecobra"
+	"github.com/gohugoio/hugo/hugofs"
+	"github.com/gohugoio/hugo/shard"
+	"github.com/spf13/cobra"
+)
+
+func newShardCommand() *shardCommand {
+	newMerge := func() simplecobra.Commander {
+		return &simpleCommand{
+			name:  "merge",
+			short: "Merge the publish directories of the shards of a build.",
+			long: `Merge the publish directories of all the shards of a build, e.g. built with
+hugo --shard 1/2 -d shard1 and hugo --shard 2/2 -d shard2 on different machines,
+into one directory ready to deploy.
+
+Files published by more than one shard must be identical, except JSON objects,
+e.g. the build.sriManifest, hugo_stats.json and the build.manifest, which are
+merged.`,
+			run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
+				if len(args) == 0 {
+					return errors.New("at least one shard directory must be provided")
+				}
+				destination, _ := cd.CobraCommand.Flags().GetString("destination")
+				if destination == "" {
+					return errors.New("the --destination flag is required")
+				}
+				n, err := shard.Merge(hugofs.Os, destination, args)
+				if err != nil {
+					return err
+				}
+				r.Printf("Merged %d shards (%d files) into %s\n", len(args), n, destination)
+				return nil
+			},
+			withc: func(cmd *cobra.Command, r *rootCommand) {
+				cmd.Args = cobra.MinimumNArgs(1)
+			},
+		}
+	}
+
+	return &shardCommand{
+		commands: []simplecobra.Commander{
+			newMerge(),
+		},
+	}
+}
+
+type shardCommand struct {
+	commands []simplecobra.Commander
+}
+
+func (c *shardCommand) Commands() []simplecobra.Commander {
+	return c.commands
+}
+
+func (c *shardCommand) Name() string {
+	return "shard"
+}
+
+func (c *shardCommand) Run(ctx context.Context, cd *simplecobra.Commandeer, args []string) error {
+	return nil
+}
+
+func (c *shardCommand) Init(cd *simplecobra.Commandeer) error {
+	cmd := cd.CobraCommand
+	cmd.Short = "Commands for sharded builds."
+	cmd.Long = `Commands for sharded builds, rendering a site across several machines with hugo --shard index/count.`
+	return nil
+}
+
+func (c *shardCommand) PreRun(cd, runner *simplecobra.Commandeer) error {
+	return nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/bep/simplecobra"
+	"github.com/gohugoio/hugo/common/paths"
+	"github.com/gohugoio/hugo/langs"
+	"github.com/gohugoio/hugo/parser/metadecoders"
+	"github.com/gohugoio/hugo/resources/page/pagemeta"
+)
+
+// The default directory of the front matter fixtures, relative to the working dir.
+const defaultMetaFixturesDir = "tests/meta"
+
+func newTestCommand() simplecobra.Commander {
+	newMeta := func() simplecobra.Commander {
+		return &simpleCommand{
+			name:  "meta",
+			use:   "meta [fixtures]",
+			short: "Test the front matter handling with fixtures",
+			long: `Run table driven tests of the front matter handling with the site's front
+matter config, e.g. to catch regressions when changing the date config chains.
+
+Each fixture holds the front matter and filename of a page and the dates, slug,
+URL and params expected to be resolved from them:
+
+	[[fixtures]]
+	name = "date from filename"
+	filename = "2023-04-05-my-post.md"
+	[fixtures.frontMatter]
+	title = "My Post"
+	[fixtures.expect]
+	date = 2023-04-05
+	slug = "my-post"
+
+The fixtures are read from the given TOML, YAML or JSON files or directories,
+by default from the files in tests/meta.
+The command fails if any fixture fails.`,
+			run: func(ctx context.Context, cd *simplecobra.Commandeer, r *rootCommand, args []string) error {
+				conf, err := r.ConfigFromProvider(r.configVersionID.Load(), flagsToCfg(cd, nil))
+				if err != nil {
+					return err
+				}
+				handler, err := pagemeta.NewFrontmatterHandler(r.logger, conf.configs.Base.Frontmatter)
+				if err != nil {
+					return err
+				}
+				loc := langs.GetLocation(conf.configs.LanguagesDefaultFirst[0])
+
+				if len(args) == 0 {
+					args = []string{defaultMetaFixturesDir}
+				}
+				filenames, err := metaFixtureFiles(conf.configs.Base.WorkingDir, args)
+				if err != nil {
+					return err
+				}
+
+				var count, failed int
+				for _, filename := range filenames {
+					b, err := os.ReadFile(filename)
+					if err != nil {
+						return err
+					}
+					m, err := metadecoders.Default.UnmarshalToMap(b, metadecoders.FormatFromString(filename))
+					if err != nil {
+						return fmt.Errorf("%s: %w", filename, err)
+					}
+					fixtures, err := pagemeta.DecodeFixtures(m)
+					if err != nil {
+						return fmt.Errorf("%s: %w", filename, err)
+					}
+					rel, _ := filepath.Rel(conf.configs.Base.WorkingDir, filename)
+					for _, fix := range fixtures {
+						count++
+						failures, err := handler.RunFixture(fix, loc)
+						if err != nil {
+							return fmt.Errorf("%s: %w", rel, err)
+						}
+						if len(failures) > 0 {
+							failed++
+						}
+						for _, f := range failures {
+							r.Printf("FAIL %s: %s\n", rel, f)
+						}
+					}
+				}
+
+				r.Printf("%d fixture(s), %d failed\n", count, failed)
+				if failed > 0 {
+					return fmt.Errorf("front matter tests: %d of %d fixture(s) failed", failed, count)
+				}
+				return nil
+			},
+		}
+	}
+
+	return &simpleCommand{
+		name:  "test",
+		short: "Test the site configuration",
+		long:  `Test the site configuration with table driven tests.`,
+		commands: []simplecobra.Commander{
+			newMeta(),
+		},
+	}
+}
+
+// metaFixtureFiles returns the TOML, YAML and JSON files in args, files or
+// directories relative to workingDir, sorted.
+func metaFixtureFiles(workingDir string, args []string) ([]string, error) {
+	var filenames []string
+	for _, arg := range args {
+		arg = paths.AbsPathify(workingDir, arg)
+		fi, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			filenames = append(filenames, arg)
+			continue
+		}
+		entries, err := os.ReadDir(arg)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			if e.IsDir() {
+				continue
+			}
+			switch metadecoders.FormatFromString(e.Name()) {
+			case metadecoders.TOML, metadecoders.YAML, metadecoders.JSON:
+				filenames = append(filenames, filepath.Join(arg, e.Name()))
+			}
+		}
+	}
+	sort.Strings(filenames)
+	return filenames, nil
+}
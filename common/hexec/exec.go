@@ -151,6 +151,18 @@ func (e *Exec) Npx(name string, arg ...any) (Runner, error) {
 	return e.New("npx", arg...)
 }
 
+// ForContext returns an Exec enforcing the security policy of the module
+// set in ctx, see security.Config.ForContext.
+func (e *Exec) ForContext(ctx context.Context) *Exec {
+	if len(e.sc.Modules) == 0 {
+		return e
+	}
+	return &Exec{
+		sc:          e.sc.ForContext(ctx),
+		baseEnviron: e.baseEnviron,
+	}
+}
+
 // Sec returns the security policies this Exec is configured with.
 func (e *Exec) Sec() security.Config {
 	return e.sc
@@ -0,0 +1,218 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hexternal runs external programs that process published output
+// after the build, either as a command or as an HTTP service.
+package hexternal
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gohugoio/hugo/common/hexec"
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/config"
+	"github.com/mitchellh/mapstructure"
+)
+
+// Config configures an external program, run either as a command or as
+// an HTTP service. It is embedded in the configuration of the features
+// using it, e.g. pdf and indexer.
+type Config struct {
+	// The output formats of the published files to process.
+	Formats []string
+
+	// The command and its arguments.
+	// The name of the command must be allowed in security.exec.allow.
+	Command []string
+
+	// The URL of a service, which is sent a POST request.
+	// The URL must be allowed by security.http.urls and the POST method by
+	// security.http.methods.
+	URL string
+
+	// Additional HTTP headers sent to the service, e.g. for authorization.
+	Headers map[string]string
+
+	// The timeout for one run, e.g. "60s".
+	Timeout string
+}
+
+// Enabled reports whether either Command or URL is set.
+func (c Config) Enabled() bool {
+	return len(c.Command) > 0 || c.URL != ""
+}
+
+// DecodeConfig decodes the config below key in cfg into c, a pointer to a
+// struct embedding Config with the mapstructure squash tag, and validates
+// the embedded Config.
+func DecodeConfig(cfg config.Provider, key string, c any, conf *Config) error {
+	m := cfg.GetStringMap(key)
+	if m == nil {
+		return nil
+	}
+	delete(m, maps.MergeStrategyKey)
+
+	if err := mapstructure.WeakDecode(m, c); err != nil {
+		return fmt.Errorf("failed to decode %s config: %w", key, err)
+	}
+
+	for i, f := range conf.Formats {
+		conf.Formats[i] = strings.ToLower(f)
+	}
+
+	if len(conf.Command) > 0 && conf.URL != "" {
+		return fmt.Errorf("%s: only one of command and url can be set", key)
+	}
+
+	if _, err := time.ParseDuration(conf.Timeout); err != nil {
+		return fmt.Errorf("%s: failed to parse timeout: %w", key, err)
+	}
+
+	return nil
+}
+
+// Runner runs the external program configured in a Config.
+type Runner struct {
+	name       string
+	conf       Config
+	timeout    time.Duration
+	exec       *hexec.Exec
+	httpClient *http.Client
+	formats    map[string]bool
+}
+
+// NewRunner creates a new Runner for conf.
+// The name, e.g. "PDF renderer", is used in error messages.
+// It returns nil if conf is not enabled.
+func NewRunner(name string, conf Config, exec *hexec.Exec) (*Runner, error) {
+	if !conf.Enabled() {
+		return nil, nil
+	}
+
+	timeout, err := time.ParseDuration(conf.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.URL != "" {
+		if err := exec.Sec().CheckAllowedHTTPURL(conf.URL); err != nil {
+			return nil, err
+		}
+		if err := exec.Sec().CheckAllowedHTTPMethod("POST"); err != nil {
+			return nil, err
+		}
+	}
+
+	r := &Runner{
+		name:       name,
+		conf:       conf,
+		timeout:    timeout,
+		exec:       exec,
+		httpClient: &http.Client{},
+		formats:    make(map[string]bool),
+	}
+	for _, f := range conf.Formats {
+		r.formats[f] = true
+	}
+
+	return r, nil
+}
+
+// HandlesFormat reports whether published files in the output format with
+// the given name should be processed.
+func (r *Runner) HandlesFormat(name string) bool {
+	return r.formats[name]
+}
+
+// Request is the input to one run.
+type Request struct {
+	// The command's stdin or the body of the POST request.
+	Body []byte
+
+	// The Content-Type of Body.
+	ContentType string
+
+	// Additional HTTP headers, e.g. Accept.
+	Header map[string]string
+
+	// Additional environment variables for the command, e.g. "FOO=bar".
+	Env []string
+}
+
+// Run runs the program with req and returns the command's stdout or the
+// body of the response.
+func (r *Runner) Run(req Request) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	if r.conf.URL != "" {
+		hreq, err := http.NewRequestWithContext(ctx, "POST", r.conf.URL, bytes.NewReader(req.Body))
+		if err != nil {
+			return nil, err
+		}
+		hreq.Header.Set("Content-Type", req.ContentType)
+		for k, v := range req.Header {
+			hreq.Header.Set(k, v)
+		}
+		for k, v := range r.conf.Headers {
+			hreq.Header.Set(k, v)
+		}
+		res, err := r.httpClient.Do(hreq)
+		if err != nil {
+			return nil, err
+		}
+		defer res.Body.Close()
+		b, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, err
+		}
+		if res.StatusCode < 200 || res.StatusCode > 299 {
+			if len(b) > 1024 {
+				b = b[:1024]
+			}
+			return nil, fmt.Errorf("%s responded with %s: %s", r.name, res.Status, strings.TrimSpace(string(b)))
+		}
+		return b, nil
+	}
+
+	var stdout, stderr bytes.Buffer
+	args := []any{
+		hexec.WithContext(ctx),
+		hexec.WithStdin(bytes.NewReader(req.Body)),
+		hexec.WithStdout(&stdout),
+		hexec.WithStderr(&stderr),
+		hexec.WithEnviron(req.Env),
+	}
+	for _, arg := range r.conf.Command[1:] {
+		args = append(args, arg)
+	}
+
+	cmd, err := r.exec.New(r.conf.Command[0], args...)
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Run(); err != nil {
+		if s := strings.TrimSpace(stderr.String()); s != "" {
+			return nil, fmt.Errorf("%w: %s", err, s)
+		}
+		return nil, err
+	}
+
+	return stdout.Bytes(), nil
+}
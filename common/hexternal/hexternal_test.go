@@ -0,0 +1,68 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hexternal
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/common/hexec"
+	"github.com/gohugoio/hugo/config/security"
+)
+
+func TestRunner(t *testing.T) {
+	c := qt.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		if r.Header.Get("Authorization") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, "no access")
+			return
+		}
+		fmt.Fprintf(w, "%s|%s|%s", r.Header.Get("Content-Type"), r.Header.Get("Accept"), b)
+	}))
+	defer srv.Close()
+
+	exec := hexec.New(security.DefaultConfig)
+
+	r, err := NewRunner("test service", Config{}, exec)
+	c.Assert(err, qt.IsNil)
+	c.Assert(r, qt.IsNil)
+
+	conf := Config{Formats: []string{"html"}, URL: srv.URL, Headers: map[string]string{"Authorization": "secret"}, Timeout: "10s"}
+	r, err = NewRunner("test service", conf, exec)
+	c.Assert(err, qt.IsNil)
+	c.Assert(r.HandlesFormat("html"), qt.IsTrue)
+	c.Assert(r.HandlesFormat("json"), qt.IsFalse)
+
+	b, err := r.Run(Request{Body: []byte("body"), ContentType: "text/plain", Header: map[string]string{"Accept": "text/csv"}})
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(b), qt.Equals, "text/plain|text/csv|body")
+
+	conf.Headers = nil
+	r, err = NewRunner("test service", conf, exec)
+	c.Assert(err, qt.IsNil)
+	_, err = r.Run(Request{Body: []byte("body")})
+	c.Assert(err, qt.ErrorMatches, "test service responded with 401 Unauthorized: no access")
+
+	sec := security.DefaultConfig
+	sec.HTTP.URLs = security.NewWhitelist("https://example.org")
+	_, err = NewRunner("test service", conf, hexec.New(sec))
+	c.Assert(err, qt.ErrorMatches, "(?s).*access denied.*")
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugo
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gohugoio/hugo/common/hexec"
+)
+
+// EnvInfo describes the Hugo build and the environment it runs in.
+// This is what's printed by hugo env --json.
+type EnvInfo struct {
+	Version    string `json:"version"`
+	CommitHash string `json:"commitHash,omitempty"`
+	BuildDate  string `json:"buildDate,omitempty"`
+	Extended   bool   `json:"extended"`
+
+	// The build tags Hugo was built with, e.g. extended.
+	BuildTags []string `json:"buildTags"`
+
+	GOOS      string `json:"goos"`
+	GOARCH    string `json:"goarch"`
+	GoVersion string `json:"goVersion"`
+
+	// The effective runtime settings, which may be set in the environment.
+	GOMAXPROCS int `json:"gomaxprocs"`
+	// The soft memory limit in bytes, math.MaxInt64 if not set.
+	GOMEMLIMIT int64 `json:"gomemlimit"`
+
+	// The dependencies on the format package="version", see GetDependencyList.
+	Dependencies []string `json:"dependencies"`
+
+	// The versions of the external tools found in $PATH and allowed by the
+	// security policy, keyed by binary name.
+	Tools map[string]string `json:"tools"`
+}
+
+// The external tools Hugo may use, and the arguments to print their version.
+var envTools = []struct {
+	name string
+	args []string
+}{
+	{"asciidoctor", []string{"--version"}},
+	{"babel", []string{"--version"}},
+	{"dart-sass-embedded", []string{"--version"}},
+	{"git", []string{"--version"}},
+	{"go", []string{"version"}},
+	{"node", []string{"--version"}},
+	{"npm", []string{"--version"}},
+	{"pandoc", []string{"--version"}},
+	{"postcss", []string{"--version"}},
+	{"rst2html", []string{"--version"}},
+	{"sass", []string{"--version"}},
+}
+
+// GetEnvInfo returns information about the Hugo build and the environment.
+// If allDependencies is set, the Go dependencies are included, else only the
+// non-Go dependencies, see GetDependencyListNonGo.
+// The external tools are run through ex, which enforces the security policy.
+func GetEnvInfo(allDependencies bool, ex *hexec.Exec) EnvInfo {
+	info := EnvInfo{
+		Version:    "v" + CurrentVersion.String(),
+		BuildDate:  buildDate,
+		Extended:   IsExtended,
+		BuildTags:  BuildTags(),
+		GOOS:       runtime.GOOS,
+		GOARCH:     runtime.GOARCH,
+		GoVersion:  runtime.Version(),
+		GOMAXPROCS: runtime.GOMAXPROCS(0),
+		GOMEMLIMIT: debug.SetMemoryLimit(-1),
+		Tools:      toolVersions(ex),
+	}
+
+	if bi := getBuildInfo(); bi != nil {
+		info.CommitHash = bi.Revision
+		if bi.RevisionTime != "" {
+			info.BuildDate = bi.RevisionTime
+		}
+	}
+
+	if allDependencies {
+		info.Dependencies = GetDependencyList()
+	} else {
+		info.Dependencies = GetDependencyListNonGo()
+	}
+	if info.Dependencies == nil {
+		info.Dependencies = []string{}
+	}
+
+	return info
+}
+
+// BuildTags returns the sorted build tags Hugo was built with.
+func BuildTags() []string {
+	tags := make(map[string]bool)
+	if IsExtended {
+		// Also set when the build info is not available, e.g. in tests.
+		tags["extended"] = true
+	}
+	if bi := getBuildInfo(); bi != nil {
+		for _, tag := range bi.Tags {
+			tags[tag] = true
+		}
+	}
+
+	list := make([]string, 0, len(tags))
+	for tag := range tags {
+		list = append(list, tag)
+	}
+	sort.Strings(list)
+
+	return list
+}
+
+// toolVersions returns the versions of the tools in envTools found in $PATH.
+// The tools are run through ex, so tools not allowed by the security policy
+// are skipped.
+func toolVersions(ex *hexec.Exec) map[string]string {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		versions = make(map[string]string)
+	)
+
+	for _, tool := range envTools {
+		if !hexec.InPath(tool.name) || ex.Sec().CheckAllowedExec(tool.name) != nil {
+			continue
+		}
+		wg.Add(1)
+		go func(name string, args []string) {
+			defer wg.Done()
+			version := toolVersion(ex, name, args)
+			mu.Lock()
+			versions[name] = version
+			mu.Unlock()
+		}(tool.name, tool.args)
+	}
+	wg.Wait()
+
+	return versions
+}
+
+// toolVersion returns the first line printed by the tool name when run with
+// args, or "unknown" if that fails.
+func toolVersion(ex *hexec.Exec, name string, args []string) string {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var out bytes.Buffer
+	argsv := []any{hexec.WithContext(ctx), hexec.WithStdout(&out)}
+	for _, arg := range args {
+		argsv = append(argsv, arg)
+	}
+	cmd, err := ex.New(name, argsv...)
+	if err != nil {
+		return "unknown"
+	}
+	if err := cmd.Run(); err != nil {
+		return "unknown"
+	}
+	for _, line := range strings.Split(out.String(), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+
+	return "unknown"
+}
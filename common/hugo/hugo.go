@@ -96,6 +96,41 @@ func (i HugoInfo) Deps() []*Dependency {
 	return i.deps
 }
 
+// Capabilities returns a map of optional features and whether they're available
+// in the current environment, so themes can check e.g. hugo.Capabilities.dartsass
+// before using a feature that depends on it. The keys are:
+//
+//   - extended: whether this is the extended build of Hugo.
+//   - dartsass: whether the Dart Sass binary is found in $PATH.
+//   - asciidoctor: whether the Asciidoctor binary is found in $PATH.
+//   - git: whether the Git binary is found in $PATH.
+func (i HugoInfo) Capabilities() map[string]bool {
+	return getCapabilities()
+}
+
+var (
+	capabilities     map[string]bool
+	capabilitiesInit sync.Once
+)
+
+func getCapabilities() map[string]bool {
+	capabilitiesInit.Do(func() {
+		capabilities = map[string]bool{
+			"extended":    IsExtended,
+			"dartsass":    DartSassBinaryName != "",
+			"asciidoctor": hexec.InPath("asciidoctor"),
+			"git":         hexec.InPath("git"),
+		}
+	})
+
+	// Return a copy so templates can't modify the shared map.
+	m := make(map[string]bool, len(capabilities))
+	for k, v := range capabilities {
+		m[k] = v
+	}
+	return m
+}
+
 // ConfigProvider represents the config options that are relevant for HugoInfo.
 type ConfigProvider interface {
 	Environment() string
@@ -167,6 +202,9 @@ type buildInfo struct {
 	GoOS   string
 	GoArch string
 
+	// The build tags set with -tags.
+	Tags []string
+
 	*debug.BuildInfo
 }
 
@@ -196,6 +234,12 @@ func getBuildInfo() *buildInfo {
 				bInfo.GoOS = s.Value
 			case "GOARCH":
 				bInfo.GoArch = s.Value
+			case "-tags":
+				for _, tag := range strings.Split(s.Value, ",") {
+					if tag = strings.TrimSpace(tag); tag != "" {
+						bInfo.Tags = append(bInfo.Tags, tag)
+					}
+				}
 			}
 		}
 
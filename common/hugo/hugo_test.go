@@ -18,6 +18,8 @@ import (
 	"testing"
 
 	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/common/hexec"
+	"github.com/gohugoio/hugo/config/security"
 )
 
 func TestHugoInfo(t *testing.T) {
@@ -41,6 +43,16 @@ func TestHugoInfo(t *testing.T) {
 	c.Assert(hugoInfo.IsProduction(), qt.Equals, true)
 	c.Assert(hugoInfo.IsExtended(), qt.Equals, IsExtended)
 
+	capabilities := hugoInfo.Capabilities()
+	c.Assert(capabilities["extended"], qt.Equals, IsExtended)
+	c.Assert(capabilities["dartsass"], qt.Equals, DartSassBinaryName != "")
+	for _, k := range []string{"asciidoctor", "git"} {
+		_, found := capabilities[k]
+		c.Assert(found, qt.IsTrue)
+	}
+	capabilities["git"] = !capabilities["git"]
+	c.Assert(hugoInfo.Capabilities()["git"], qt.Not(qt.Equals), capabilities["git"])
+
 	devHugoInfo := NewInfo(testConfig{environment: "development"}, nil)
 	c.Assert(devHugoInfo.IsProduction(), qt.Equals, false)
 }
@@ -57,3 +69,21 @@ func (c testConfig) Environment() string {
 func (c testConfig) WorkingDir() string {
 	return c.workingDir
 }
+
+func TestGetEnvInfo(t *testing.T) {
+	c := qt.New(t)
+
+	info := GetEnvInfo(false, hexec.New(security.DefaultConfig))
+	c.Assert(info.Version, qt.Equals, "v"+CurrentVersion.String())
+	c.Assert(info.Extended, qt.Equals, IsExtended)
+	c.Assert(info.GOMAXPROCS > 0, qt.IsTrue)
+	c.Assert(info.GOMEMLIMIT > 0, qt.IsTrue)
+	c.Assert(info.Tools, qt.Not(qt.IsNil))
+	c.Assert(info.Dependencies, qt.Not(qt.IsNil))
+
+	tags := BuildTags()
+	c.Assert(tags, qt.Not(qt.IsNil))
+	if IsExtended {
+		c.Assert(tags, qt.Contains, "extended")
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// ParamsSchema maps param keys, e.g. "rating" or "author.name", to their schema.
+type ParamsSchema map[string]ParamSchema
+
+// ParamSchema declares the type of a param and its default value.
+type ParamSchema struct {
+	// One of string, int, float, bool, date or slice.
+	Type string
+
+	// The value to use if the param is not set.
+	Default any
+
+	// Whether the param must be set.
+	Required bool
+}
+
+// DecodeParamsSchema decodes and validates the paramsSchema configuration in m.
+func DecodeParamsSchema(m map[string]any) (ParamsSchema, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+
+	s := make(ParamsSchema)
+	for k, v := range CleanConfigStringMap(m) {
+		var ps ParamSchema
+		if err := mapstructure.WeakDecode(v, &ps); err != nil {
+			return nil, fmt.Errorf("failed to decode paramsSchema %q: %w", k, err)
+		}
+		ps.Type = strings.ToLower(ps.Type)
+		if !paramTypes[ps.Type] {
+			return nil, fmt.Errorf("paramsSchema %q: unknown type %q", k, ps.Type)
+		}
+		if ps.Default != nil {
+			d, err := ToParamType(ps.Type, ps.Default)
+			if err != nil {
+				return nil, fmt.Errorf("paramsSchema %q: invalid default: %w", k, err)
+			}
+			ps.Default = d
+		}
+		s[strings.ToLower(k)] = ps
+	}
+
+	return s, nil
+}
+
+// Apply coerces the params in p to the types declared in s and sets the
+// default values of the params not set.
+// If checkRequired is set, it returns an error if a required param is not set.
+func (s ParamsSchema) Apply(p Params, checkRequired bool) error {
+	if len(s) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		ps := s[k]
+		path := strings.Split(k, ".")
+		v, _, _ := getNested(p, path)
+
+		if v == nil {
+			if ps.Default != nil {
+				if err := setNested(p, path, ps.Default); err != nil {
+					return fmt.Errorf("param %q: %w", k, err)
+				}
+			} else if ps.Required && checkRequired {
+				return fmt.Errorf("param %q is required", k)
+			}
+			continue
+		}
+
+		vv, err := ToParamType(ps.Type, v)
+		if err != nil {
+			return fmt.Errorf("param %q: %w", k, err)
+		}
+		if err := setNested(p, path, vv); err != nil {
+			return fmt.Errorf("param %q: %w", k, err)
+		}
+	}
+
+	return nil
+}
+
+func setNested(m map[string]any, path []string, v any) error {
+	for i, k := range path {
+		if i == len(path)-1 {
+			m[k] = v
+			return nil
+		}
+		switch mm := m[k].(type) {
+		case nil:
+			p := make(Params)
+			m[k] = p
+			m = p
+		case Params:
+			m = mm
+		case map[string]any:
+			m = mm
+		default:
+			return fmt.Errorf("%q is not a map", strings.Join(path[:i+1], "."))
+		}
+	}
+	return nil
+}
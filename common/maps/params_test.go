@@ -15,6 +15,7 @@ package maps
 
 import (
 	"testing"
+	"time"
 
 	qt "github.com/frankban/quicktest"
 )
@@ -168,3 +169,103 @@ func TestParamsIsZero(t *testing.T) {
 	c.Assert(Params{"_merge": "foo", "foo": "bar"}.IsZero(), qt.IsFalse)
 	c.Assert(Params{"_merge": "foo"}.IsZero(), qt.IsTrue)
 }
+
+func TestParamsTypedAccessors(t *testing.T) {
+	c := qt.New(t)
+
+	p := Params{
+		"title":  "My Title",
+		"rating": "4",
+		"score":  3.5,
+		"draft":  "true",
+		"date":   "2026-01-15",
+		"tags":   []string{"a", "b"},
+		"tag":    "c",
+		"author": Params{
+			"name": "Jo",
+		},
+	}
+
+	s, err := p.GetString("author.name")
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.Equals, "Jo")
+	s, err = p.GetString("Author.Name")
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.Equals, "Jo")
+	s, err = p.GetString("author.email", "n/a")
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.Equals, "n/a")
+	s, err = p.GetString("missing")
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.Equals, "")
+
+	i, err := p.GetInt("rating")
+	c.Assert(err, qt.IsNil)
+	c.Assert(i, qt.Equals, 4)
+	i, err = p.GetInt("missing", "7")
+	c.Assert(err, qt.IsNil)
+	c.Assert(i, qt.Equals, 7)
+	_, err = p.GetInt("title")
+	c.Assert(err, qt.ErrorMatches, `param "title": cannot convert My Title \(string\) to int`)
+
+	f, err := p.GetFloat("score")
+	c.Assert(err, qt.IsNil)
+	c.Assert(f, qt.Equals, 3.5)
+
+	b, err := p.GetBool("draft")
+	c.Assert(err, qt.IsNil)
+	c.Assert(b, qt.IsTrue)
+
+	d, err := p.GetDate("date")
+	c.Assert(err, qt.IsNil)
+	c.Assert(d, qt.Equals, time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC))
+
+	sl, err := p.GetSlice("tags")
+	c.Assert(err, qt.IsNil)
+	c.Assert(sl, qt.DeepEquals, []any{"a", "b"})
+	sl, err = p.GetSlice("tag")
+	c.Assert(err, qt.IsNil)
+	c.Assert(sl, qt.DeepEquals, []any{"c"})
+	sl, err = p.GetSlice("missing")
+	c.Assert(err, qt.IsNil)
+	c.Assert(sl, qt.IsNil)
+
+	c.Assert(p.Get("missing", "d"), qt.Equals, "d")
+	c.Assert(p.Get("title", "d"), qt.Equals, "My Title")
+}
+
+func TestParamsSchema(t *testing.T) {
+	c := qt.New(t)
+
+	s, err := DecodeParamsSchema(map[string]any{
+		"rating":      map[string]any{"type": "int", "default": "3"},
+		"author.name": map[string]any{"type": "string", "required": true},
+		"featured":    map[string]any{"type": "bool"},
+		"tags":        map[string]any{"type": "slice"},
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(s["rating"].Default, qt.Equals, 3)
+
+	p := Params{
+		"featured": "true",
+		"tags":     "a",
+		"author":   Params{"name": 42},
+	}
+	c.Assert(s.Apply(p, true), qt.IsNil)
+	c.Assert(p, qt.DeepEquals, Params{
+		"rating":   3,
+		"featured": true,
+		"tags":     []any{"a"},
+		"author":   Params{"name": "42"},
+	})
+
+	c.Assert(s.Apply(Params{}, true), qt.ErrorMatches, `param "author.name" is required`)
+	c.Assert(s.Apply(Params{}, false), qt.IsNil)
+	c.Assert(s.Apply(Params{"author": Params{"name": "Jo"}, "rating": "high"}, true), qt.ErrorMatches, `param "rating": cannot convert high \(string\) to int`)
+	c.Assert(s.Apply(Params{"author": "Jo"}, true), qt.ErrorMatches, `param "author.name" is required`)
+
+	_, err = DecodeParamsSchema(map[string]any{"rating": map[string]any{"type": "number"}})
+	c.Assert(err, qt.ErrorMatches, `paramsSchema "rating": unknown type "number"`)
+	_, err = DecodeParamsSchema(map[string]any{"rating": map[string]any{"type": "int", "default": "high"}})
+	c.Assert(err, qt.ErrorMatches, `paramsSchema "rating": invalid default: .*`)
+}
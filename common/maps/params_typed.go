@@ -0,0 +1,172 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/gohugoio/hugo/common/htime"
+	"github.com/spf13/cast"
+)
+
+// The param types supported by the typed accessors and ParamsSchema.
+const (
+	ParamTypeString = "string"
+	ParamTypeInt    = "int"
+	ParamTypeFloat  = "float"
+	ParamTypeBool   = "bool"
+	ParamTypeDate   = "date"
+	ParamTypeSlice  = "slice"
+)
+
+var paramTypes = map[string]bool{
+	ParamTypeString: true,
+	ParamTypeInt:    true,
+	ParamTypeFloat:  true,
+	ParamTypeBool:   true,
+	ParamTypeDate:   true,
+	ParamTypeSlice:  true,
+}
+
+// Get returns the value for the given key, e.g. "author.name", or the first
+// default value given if not found.
+// The key is case insensitive and may be a dot separated path into nested maps.
+func (p Params) Get(key string, defaultValue ...any) any {
+	v, _ := GetNestedParam(key, ".", p)
+	if v == nil && len(defaultValue) > 0 {
+		return defaultValue[0]
+	}
+	return v
+}
+
+// GetString returns the value for the given key as a string, see Get.
+// It returns an error if the value cannot be converted to a string.
+func (p Params) GetString(key string, defaultValue ...any) (string, error) {
+	v, err := p.getTyped(ParamTypeString, key, defaultValue)
+	if err != nil || v == nil {
+		return "", err
+	}
+	return v.(string), nil
+}
+
+// GetInt returns the value for the given key as an int, see Get.
+// It returns an error if the value cannot be converted to an int.
+func (p Params) GetInt(key string, defaultValue ...any) (int, error) {
+	v, err := p.getTyped(ParamTypeInt, key, defaultValue)
+	if err != nil || v == nil {
+		return 0, err
+	}
+	return v.(int), nil
+}
+
+// GetFloat returns the value for the given key as a float64, see Get.
+// It returns an error if the value cannot be converted to a float64.
+func (p Params) GetFloat(key string, defaultValue ...any) (float64, error) {
+	v, err := p.getTyped(ParamTypeFloat, key, defaultValue)
+	if err != nil || v == nil {
+		return 0, err
+	}
+	return v.(float64), nil
+}
+
+// GetBool returns the value for the given key as a bool, see Get.
+// It returns an error if the value cannot be converted to a bool.
+func (p Params) GetBool(key string, defaultValue ...any) (bool, error) {
+	v, err := p.getTyped(ParamTypeBool, key, defaultValue)
+	if err != nil || v == nil {
+		return false, err
+	}
+	return v.(bool), nil
+}
+
+// GetDate returns the value for the given key as a time.Time, see Get.
+// Dates without a time zone are assumed to be in UTC.
+// It returns an error if the value cannot be converted to a time.Time.
+func (p Params) GetDate(key string, defaultValue ...any) (time.Time, error) {
+	v, err := p.getTyped(ParamTypeDate, key, defaultValue)
+	if err != nil || v == nil {
+		return time.Time{}, err
+	}
+	return v.(time.Time), nil
+}
+
+// GetSlice returns the value for the given key as a slice, see Get.
+// A single value is returned as a slice with one element.
+func (p Params) GetSlice(key string, defaultValue ...any) ([]any, error) {
+	v, err := p.getTyped(ParamTypeSlice, key, defaultValue)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	return v.([]any), nil
+}
+
+func (p Params) getTyped(typ, key string, defaultValue []any) (any, error) {
+	v := p.Get(key, defaultValue...)
+	if v == nil {
+		return nil, nil
+	}
+	vv, err := ToParamType(typ, v)
+	if err != nil {
+		return nil, fmt.Errorf("param %q: %w", key, err)
+	}
+	return vv, nil
+}
+
+// ToParamType converts v to the given param type, one of string, int, float, bool, date or slice.
+func ToParamType(typ string, v any) (any, error) {
+	var (
+		vv  any
+		err error
+	)
+
+	switch typ {
+	case ParamTypeString:
+		vv, err = cast.ToStringE(v)
+	case ParamTypeInt:
+		vv, err = cast.ToIntE(v)
+	case ParamTypeFloat:
+		vv, err = cast.ToFloat64E(v)
+	case ParamTypeBool:
+		vv, err = cast.ToBoolE(v)
+	case ParamTypeDate:
+		vv, err = htime.ToTimeInDefaultLocationE(v, time.UTC)
+	case ParamTypeSlice:
+		vv = toSlice(v)
+	default:
+		return nil, fmt.Errorf("unknown param type %q", typ)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot convert %v (%T) to %s", v, v, typ)
+	}
+
+	return vv, nil
+}
+
+func toSlice(v any) []any {
+	if s, ok := v.([]any); ok {
+		return s
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return []any{v}
+	}
+	s := make([]any, rv.Len())
+	for i := range s {
+		s[i] = rv.Index(i).Interface()
+	}
+	return s
+}
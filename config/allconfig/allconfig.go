@@ -31,22 +31,44 @@ import (
 	"github.com/gohugoio/hugo/common/maps"
 	"github.com/gohugoio/hugo/common/urls"
 	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/config/internaltemplates"
 	"github.com/gohugoio/hugo/config/privacy"
 	"github.com/gohugoio/hugo/config/security"
+	"github.com/gohugoio/hugo/config/seo"
 	"github.com/gohugoio/hugo/config/services"
 	"github.com/gohugoio/hugo/deploy"
+	"github.com/gohugoio/hugo/featureflags"
+	"github.com/gohugoio/hugo/features"
 	"github.com/gohugoio/hugo/helpers"
+	"github.com/gohugoio/hugo/htmlaudit"
+	"github.com/gohugoio/hugo/indexer"
+	"github.com/gohugoio/hugo/indexnow"
+	"github.com/gohugoio/hugo/indieweb"
 	"github.com/gohugoio/hugo/langs"
+	"github.com/gohugoio/hugo/linkcheck"
+	"github.com/gohugoio/hugo/lint"
+	"github.com/gohugoio/hugo/llmstxt"
+	"github.com/gohugoio/hugo/machinetranslation"
 	"github.com/gohugoio/hugo/markup/markup_config"
 	"github.com/gohugoio/hugo/media"
 	"github.com/gohugoio/hugo/minifiers"
 	"github.com/gohugoio/hugo/modules"
 	"github.com/gohugoio/hugo/navigation"
 	"github.com/gohugoio/hugo/output"
+	"github.com/gohugoio/hugo/pdf"
+	"github.com/gohugoio/hugo/plugins"
+	"github.com/gohugoio/hugo/publishtargets"
+	"github.com/gohugoio/hugo/redirects"
 	"github.com/gohugoio/hugo/related"
 	"github.com/gohugoio/hugo/resources/images"
 	"github.com/gohugoio/hugo/resources/page"
 	"github.com/gohugoio/hugo/resources/page/pagemeta"
+	"github.com/gohugoio/hugo/resources/resource_transformers/tocss/sassconfig"
+	"github.com/gohugoio/hugo/searchindex"
+	"github.com/gohugoio/hugo/shard"
+	"github.com/gohugoio/hugo/transform/a11yinject"
+	"github.com/gohugoio/hugo/transform/externallinks"
+	"github.com/gohugoio/hugo/wellknown"
 	"github.com/spf13/afero"
 
 	xmaps "golang.org/x/exp/maps"
@@ -63,6 +85,15 @@ type InternalConfig struct {
 	Watch             bool
 	DisableLiveReload bool
 	LiveReloadPort    int
+
+	// Feature flag overrides set with --flag.
+	Flag map[string]string
+
+	// The site in the sites config selected with --site.
+	Site string
+
+	// The language selected with hugo server --lang.
+	Lang string
 }
 
 // All non-params config keys for language.
@@ -112,6 +143,9 @@ type Config struct {
 	// <docsmeta>{"identifiers": ["caches"] }</docsmeta>
 	Caches filecache.Configs `mapstructure:"-"`
 
+	// The remote cache shared between builds, e.g. on CI runners.
+	RemoteCache filecache.RemoteConfig `mapstructure:"-"`
+
 	// The markup configuration section contains markup-related configuration options.
 	// <docsmeta>{"identifiers": ["markup"] }</docsmeta>
 	Markup markup_config.Config `mapstructure:"-"`
@@ -129,6 +163,11 @@ type Config struct {
 	// This can be overridden in the front matter.
 	Outputs map[string][]string `mapstructure:"-"`
 
+	// The outputRules configuration section adjusts the output formats of the pages
+	// matching a target, e.g. a path glob and a language, in order.
+	// This can be overridden in the front matter.
+	OutputRules []page.PageMatcherOutputsConfig `mapstructure:"-"`
+
 	// The cascade configuration section contains the top level front matter cascade configuration options,
 	// a slice of page matcher and params to apply to those pages.
 	Cascade *config.ConfigNamespace[[]page.PageMatcherParamsConfig, map[page.PageMatcher]maps.Params] `mapstructure:"-"`
@@ -149,15 +188,27 @@ type Config struct {
 	// Minification configuration.
 	Minify minifiers.MinifyConfig `mapstructure:"-"`
 
+	// Dart Sass configuration: deprecations, warnings and importers.
+	Sass sassconfig.Config `mapstructure:"-"`
+
 	// Permalink configuration.
 	Permalinks map[string]string `mapstructure:"-"`
 
+	// Resource transformation plugins (WASM) keyed by name.
+	Plugins plugins.Config `mapstructure:"-"`
+
 	// Taxonomy configuration.
 	Taxonomies map[string]string `mapstructure:"-"`
 
+	// The order of the terms listed in the taxonomy pages, keyed by the plural taxonomy name.
+	TaxonomySort map[string]config.TaxonomySortConfig `mapstructure:"-"`
+
 	// Sitemap configuration.
 	Sitemap config.SitemapConfig `mapstructure:"-"`
 
+	// Automatic summary configuration.
+	Summary config.SummaryConfig `mapstructure:"-"`
+
 	// Related content configuration.
 	Related related.Config `mapstructure:"-"`
 
@@ -173,10 +224,71 @@ type Config struct {
 	// Services configuration.
 	Services services.Config `mapstructure:"-"`
 
+	// Redirects map configuration.
+	Redirects redirects.Config `mapstructure:"-"`
+
+	// Search index configuration used by the searchindex output format.
+	SearchIndex searchindex.Config `mapstructure:"-"`
+
+	// Content export configuration used by the llms and llmsfull output formats.
+	LLMSTxt llmstxt.Config `mapstructure:"-"`
+
+	// SEO metadata configuration used by the internal seo template.
+	SEO seo.Config `mapstructure:"-"`
+
+	// Disable or override the internal templates, e.g. _internal/disqus.html.
+	InternalTemplates internaltemplates.Config `mapstructure:"-"`
+
+	// Configuration for the files published below /.well-known/, e.g. security.txt.
+	WellKnown wellknown.Config `mapstructure:"-"`
+
+	// The policy applied to external links in HTML output, e.g. rel attributes.
+	ExternalLinks externallinks.Config `mapstructure:"-"`
+
+	// Skip link and landmark roles injected into HTML output.
+	Accessibility a11yinject.Config `mapstructure:"-"`
+
+	// Rendering of the print output format, or other HTML output formats, to PDF.
+	PDF pdf.Config `mapstructure:"-"`
+
+	// Webmention discovery and the static ActivityPub and WebFinger files.
+	IndieWeb indieweb.Config `mapstructure:"-"`
+
+	// The external search indexer fed with the published pages after the build.
+	Indexer indexer.Config `mapstructure:"-"`
+
+	// Notification of search engines about the pages changed in a build.
+	IndexNow indexnow.Config `mapstructure:"-"`
+
+	// Machine translation of the missing i18n strings, see hugo gen translations.
+	MachineTranslation machinetranslation.Config `mapstructure:"-"`
+
+	// Sections published to separate publish directories.
+	PublishTargets publishtargets.Config `mapstructure:"-"`
+
+	// Checking of the links in the published HTML files after the build.
+	CheckLinks linkcheck.Config `mapstructure:"-"`
+
+	// Audit of the published HTML files for structural and accessibility problems.
+	Audit htmlaudit.Config `mapstructure:"-"`
+
+	// Content lint rules used by hugo lint.
+	Lint lint.Config `mapstructure:"-"`
+
+	// Feature flags available in the templates as site.Flags.
+	Flags featureflags.Config `mapstructure:"-"`
+
+	// Enables or disables the features provided by the modules in use.
+	Features features.Config `mapstructure:"-"`
+
 	// User provided parameters.
 	// <docsmeta>{"refs": ["config:languages:params"] }</docsmeta>
 	Params maps.Params `mapstructure:"-"`
 
+	// Declares the types and default values of the page params.
+	// The page params are coerced and validated when the front matter is parsed.
+	ParamsSchema maps.ParamsSchema `mapstructure:"-"`
+
 	// The languages configuration sections maps a language code (a string) to a configuration object for that language.
 	Languages map[string]langs.LanguageConfig `mapstructure:"-"`
 
@@ -228,15 +340,17 @@ func (c Config) cloneForLang() *Config {
 
 func (c *Config) CompileConfig(logger loggers.Logger) error {
 	var transientErr error
-	s := c.Timeout
-	if _, err := strconv.Atoi(s); err == nil {
-		// A number, assume seconds.
-		s = s + "s"
-	}
-	timeout, err := time.ParseDuration(s)
+	timeout, err := parseTimeout(c.Timeout)
 	if err != nil {
 		return fmt.Errorf("failed to parse timeout: %s", err)
 	}
+	var renderTimeout time.Duration
+	if c.RenderTimeout != "" {
+		renderTimeout, err = parseTimeout(c.RenderTimeout)
+		if err != nil {
+			return fmt.Errorf("failed to parse renderTimeout: %s", err)
+		}
+	}
 	disabledKinds := make(map[string]bool)
 	for _, kind := range c.DisableKinds {
 		kind = strings.ToLower(kind)
@@ -267,13 +381,69 @@ func (c *Config) CompileConfig(logger loggers.Logger) error {
 		}
 	}
 
+	var outputRules []OutputRule
+	for i, rule := range c.OutputRules {
+		toFormats := func(names []string) output.Formats {
+			if names == nil {
+				return nil
+			}
+			formats := output.Formats{}
+			for _, name := range names {
+				if isRssDisabled && name == "rss" {
+					continue
+				}
+				f, found := outputFormats.GetByName(name)
+				if !found {
+					transientErr = fmt.Errorf("unknown output format %q in outputRules[%d]", name, i)
+					continue
+				}
+				formats = append(formats, f)
+			}
+			return formats
+		}
+		outputRules = append(outputRules, OutputRule{
+			Target:  rule.Target,
+			Outputs: toFormats(rule.Outputs),
+			Add:     toFormats(rule.Add),
+			Remove:  toFormats(rule.Remove),
+		})
+	}
+
 	disabledLangs := make(map[string]bool)
 	for _, lang := range c.DisableLanguages {
-		if lang == c.DefaultContentLanguage {
-			return fmt.Errorf("cannot disable default content language %q", lang)
-		}
 		disabledLangs[lang] = true
 	}
+	for lang, lc := range c.Languages {
+		if lc.Disabled {
+			disabledLangs[lang] = true
+		}
+	}
+	if c.Internal.Lang != "" {
+		// Build only the language selected with hugo server --lang,
+		// which may be another than the default content language.
+		if _, found := c.Languages[c.Internal.Lang]; !found {
+			return fmt.Errorf("language %q not found", c.Internal.Lang)
+		}
+		if disabledLangs[c.Internal.Lang] {
+			return fmt.Errorf("cannot serve disabled language %q", c.Internal.Lang)
+		}
+		for lang := range c.Languages {
+			if lang != c.Internal.Lang {
+				disabledLangs[lang] = true
+			}
+		}
+	} else if disabledLangs[c.DefaultContentLanguage] {
+		return fmt.Errorf("cannot disable default content language %q", c.DefaultContentLanguage)
+	}
+
+	c.TemplateMetricsFormat = strings.ToLower(c.TemplateMetricsFormat)
+	switch c.TemplateMetricsFormat {
+	case "":
+		c.TemplateMetricsFormat = "text"
+	case "text", "json":
+	default:
+		return fmt.Errorf("invalid templateMetricsFormat %q, must be one of text or json", c.TemplateMetricsFormat)
+	}
 
 	ignoredErrors := make(map[string]bool)
 	for _, err := range c.IgnoreErrors {
@@ -327,19 +497,31 @@ func (c *Config) CompileConfig(logger loggers.Logger) error {
 		}
 	}
 
+	if err := c.WellKnown.CompileConfig(clock); err != nil {
+		return err
+	}
+
+	shardConfig, err := shard.Decode(c.Shard, c.ShardBy)
+	if err != nil {
+		return err
+	}
+
 	c.C = &ConfigCompiled{
 		Timeout:           timeout,
+		RenderTimeout:     renderTimeout,
 		BaseURL:           baseURL,
 		BaseURLLiveReload: baseURL,
 		DisabledKinds:     disabledKinds,
 		DisabledLanguages: disabledLangs,
 		IgnoredErrors:     ignoredErrors,
 		KindOutputFormats: kindOutputFormats,
+		OutputRules:       outputRules,
 		CreateTitle:       helpers.GetTitleFunc(c.TitleCaseStyle),
 		IsUglyURLSection:  isUglyURL,
 		IgnoreFile:        ignoreFile,
 		MainSections:      c.MainSections,
 		Clock:             clock,
+		Shard:             shardConfig,
 		transientErr:      transientErr,
 	}
 
@@ -354,6 +536,15 @@ func (c *Config) CompileConfig(logger loggers.Logger) error {
 	return nil
 }
 
+// parseTimeout parses s as a duration; a plain number is interpreted as seconds.
+func parseTimeout(s string) (time.Duration, error) {
+	if _, err := strconv.Atoi(s); err == nil {
+		// A number, assume seconds.
+		s = s + "s"
+	}
+	return time.ParseDuration(s)
+}
+
 func (c *Config) IsKindEnabled(kind string) bool {
 	return !c.C.DisabledKinds[kind]
 }
@@ -365,9 +556,11 @@ func (c *Config) IsLangDisabled(lang string) bool {
 // ConfigCompiled holds values and functions that are derived from the config.
 type ConfigCompiled struct {
 	Timeout           time.Duration
+	RenderTimeout     time.Duration
 	BaseURL           urls.BaseURL
 	BaseURLLiveReload urls.BaseURL
 	KindOutputFormats map[string]output.Formats
+	OutputRules       []OutputRule
 	DisabledKinds     map[string]bool
 	DisabledLanguages map[string]bool
 	IgnoredErrors     map[string]bool
@@ -376,6 +569,7 @@ type ConfigCompiled struct {
 	IgnoreFile        func(filename string) bool
 	MainSections      []string
 	Clock             time.Time
+	Shard             shard.Config
 
 	// This is set to the last transient error found during config compilation.
 	// With themes/modules we compute the configuration in multiple passes, and
@@ -385,6 +579,36 @@ type ConfigCompiled struct {
 	mu sync.Mutex
 }
 
+// OutputRule is the compiled version of page.PageMatcherOutputsConfig.
+type OutputRule struct {
+	Target page.PageMatcher
+
+	// Nil if not set.
+	Outputs output.Formats
+	Add     output.Formats
+	Remove  output.Formats
+}
+
+// Apply applies r to formats, the output formats of a page matching
+// r.Target, and returns the result.
+func (r OutputRule) Apply(formats output.Formats) output.Formats {
+	if r.Outputs != nil {
+		formats = r.Outputs
+	}
+	var result output.Formats
+	for _, f := range formats {
+		if _, found := r.Remove.GetByName(f.Name); !found {
+			result = append(result, f)
+		}
+	}
+	for _, f := range r.Add {
+		if _, found := result.GetByName(f.Name); !found {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
 // This may be set after the config is compiled.
 func (c *ConfigCompiled) SetMainSectionsIfNotSet(sections []string) {
 	c.mu.Lock()
@@ -450,6 +674,13 @@ type RootConfig struct {
 	// <docsmeta>{"identifiers": ["Content", "Unicode"] }</docsmeta>
 	EnableEmoji bool
 
+	// Custom emoji tokens mapped to images in /assets, e.g. brandname = "images/brand.svg",
+	// which replaces :brandname: in Pages' Content with the inlined SVG.
+	// Other image types are rendered using an img element.
+	// This works independently of EnableEmoji.
+	// <docsmeta>{"identifiers": ["Content"] }</docsmeta>
+	EmojiTokens map[string]string
+
 	// THe main section(s) of the site.
 	// If not set, Hugo will try to guess this from the content.
 	MainSections []string
@@ -462,15 +693,42 @@ type RootConfig struct {
 	// <docsmeta>{"identifiers": ["Page"] }</docsmeta>
 	EnableGitInfo bool
 
+	// The number of commits to fetch at a time when enableGitInfo is set and
+	// the project is a shallow Git clone, as is the default in most CI
+	// environments, until the last commit of every file is found.
+	// If not set, files last changed before the fetched history get no Git info.
+	GitInfoFetchDepth int
+
 	// Enable to track, calculate and print metrics.
 	TemplateMetrics bool
 
 	// Enable to track, print and calculate metric hints.
 	TemplateMetricsHints bool
 
+	// If set to a number greater than 0, only this number of the most
+	// expensive templates (and page kinds) are printed in the template metrics.
+	TemplateMetricsLimit int
+
+	// The format of the template metrics, either text (default) or json.
+	TemplateMetricsFormat string
+
+	// If set, the JSON template metrics are written to this file, relative
+	// to the working directory, instead of to stdout.
+	TemplateMetricsFile string
+
 	// Enable to disable the build lock file.
 	NoBuildLock bool
 
+	// Render only a subset of the pages, on the form index/count, e.g. "2/8"
+	// to render the second of eight shards. All pages are still loaded, so
+	// references and list pages work as in a full build.
+	// See the shard merge command to merge the publish directories of the shards.
+	Shard string
+
+	// How to assign pages to shards, either hash (default), by a hash of their path,
+	// or section, by a hash of their top level section.
+	ShardBy string
+
 	// A list of error IDs to ignore.
 	IgnoreErrors []string
 
@@ -509,6 +767,9 @@ type RootConfig struct {
 	// Note that this currently only works for English, but you can provide your own title in the content file's front matter.
 	PluralizeListTitles bool
 
+	// Enable to fail the build if a term used in content has no definition in data/taxonomies/<taxonomy>/<term>.
+	StrictTaxonomyData bool
+
 	// Make all relative URLs absolute using the baseURL.
 	// <docsmeta>{"identifiers": ["baseURL"] }</docsmeta>
 	CanonifyURLs bool
@@ -522,6 +783,15 @@ type RootConfig struct {
 	// Whether to track and print unused templates during the build.
 	PrintUnusedTemplates bool
 
+	// Whether to track and print the internal templates referenced by the
+	// templates executed during the build.
+	PrintInternalTemplates bool
+
+	// Enable to build untrusted sites, e.g. in CI. This disables os/exec
+	// (e.g. PostCSS, Dart Sass and Go Modules), remote HTTP requests,
+	// os.Getenv, symbolic links and reading files outside of the mounts.
+	Safe bool
+
 	// URL to be used as a placeholder when a page reference cannot be found in ref or relref. Is used as-is.
 	RefLinksNotFoundURL string
 
@@ -545,6 +815,11 @@ type RootConfig struct {
 	// Timeout for generating page contents, specified as a duration or in milliseconds.
 	Timeout string
 
+	// Timeout for rendering a single page with its templates, specified as a duration or in seconds.
+	// When exceeded, the page render is aborted with the template stack and the shortcode being executed.
+	// Disabled by default.
+	RenderTimeout string
+
 	// The time zone (or location), e.g. Europe/Oslo, used to parse front matter dates without such information and in the time function.
 	TimeZone string
 
@@ -627,6 +902,9 @@ type Configs struct {
 	Modules       modules.Modules
 	ModulesClient *modules.Client
 
+	// The features provided by Hugo and the modules in use.
+	Features *features.Features
+
 	configLangs []config.AllProvider
 }
 
@@ -685,9 +963,28 @@ func (c *Configs) Init() error {
 		l.Module.Mounts = c.Base.Module.Mounts
 	}
 
+	var err error
+	c.Features, err = features.New(c.Base.Features, c.Modules)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// SiteNames returns the sorted names of the sites in the sites config.
+func (c *Configs) SiteNames() []string {
+	var names []string
+	for name := range c.LoadingInfo.Cfg.GetStringMap(sitesConfigKey) {
+		if name == maps.MergeStrategyKey {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 func (c Configs) ConfigLangs() []config.AllProvider {
 	return c.configLangs
 }
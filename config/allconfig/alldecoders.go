@@ -21,21 +21,42 @@ import (
 	"github.com/gohugoio/hugo/common/maps"
 	"github.com/gohugoio/hugo/common/types"
 	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/config/internaltemplates"
 	"github.com/gohugoio/hugo/config/privacy"
 	"github.com/gohugoio/hugo/config/security"
+	"github.com/gohugoio/hugo/config/seo"
 	"github.com/gohugoio/hugo/config/services"
 	"github.com/gohugoio/hugo/deploy"
+	"github.com/gohugoio/hugo/featureflags"
+	"github.com/gohugoio/hugo/features"
+	"github.com/gohugoio/hugo/htmlaudit"
+	"github.com/gohugoio/hugo/indexer"
+	"github.com/gohugoio/hugo/indexnow"
+	"github.com/gohugoio/hugo/indieweb"
 	"github.com/gohugoio/hugo/langs"
+	"github.com/gohugoio/hugo/linkcheck"
+	"github.com/gohugoio/hugo/lint"
+	"github.com/gohugoio/hugo/llmstxt"
+	"github.com/gohugoio/hugo/machinetranslation"
 	"github.com/gohugoio/hugo/markup/markup_config"
 	"github.com/gohugoio/hugo/media"
 	"github.com/gohugoio/hugo/minifiers"
 	"github.com/gohugoio/hugo/modules"
 	"github.com/gohugoio/hugo/navigation"
 	"github.com/gohugoio/hugo/output"
+	"github.com/gohugoio/hugo/pdf"
+	"github.com/gohugoio/hugo/plugins"
+	"github.com/gohugoio/hugo/publishtargets"
+	"github.com/gohugoio/hugo/redirects"
 	"github.com/gohugoio/hugo/related"
 	"github.com/gohugoio/hugo/resources/images"
 	"github.com/gohugoio/hugo/resources/page"
 	"github.com/gohugoio/hugo/resources/page/pagemeta"
+	"github.com/gohugoio/hugo/resources/resource_transformers/tocss/sassconfig"
+	"github.com/gohugoio/hugo/searchindex"
+	"github.com/gohugoio/hugo/transform/a11yinject"
+	"github.com/gohugoio/hugo/transform/externallinks"
+	"github.com/gohugoio/hugo/wellknown"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/afero"
 	"github.com/spf13/cast"
@@ -67,6 +88,11 @@ var allDecoderSetups = map[string]decodeWeight{
 			// This need to match with Lang which is always lower case.
 			p.c.RootConfig.DefaultContentLanguage = strings.ToLower(p.c.RootConfig.DefaultContentLanguage)
 
+			if p.c.RootConfig.Safe {
+				// Git info is read using os/exec.
+				p.c.RootConfig.EnableGitInfo = false
+			}
+
 			return nil
 		},
 	},
@@ -78,6 +104,14 @@ var allDecoderSetups = map[string]decodeWeight{
 			return err
 		},
 	},
+	"remotecache": {
+		key: "remotecache",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.RemoteCache, err = filecache.DecodeRemoteConfig(p.p)
+			return err
+		},
+	},
 	"caches": {
 		key: "caches",
 		decode: func(d decodeWeight, p decodeConfig) error {
@@ -130,6 +164,14 @@ var allDecoderSetups = map[string]decodeWeight{
 			return &c.Server
 		},
 	},
+	"plugins": {
+		key: "plugins",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.Plugins, err = plugins.DecodeConfig(p.p.Get(d.key))
+			return err
+		},
+	},
 	"minify": {
 		key: "minify",
 		decode: func(d decodeWeight, p decodeConfig) error {
@@ -138,6 +180,14 @@ var allDecoderSetups = map[string]decodeWeight{
 			return err
 		},
 	},
+	"sass": {
+		key: "sass",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.Sass, err = sassconfig.DecodeConfig(p.p)
+			return err
+		},
+	},
 	"mediaTypes": {
 		key: "mediaTypes",
 		decode: func(d decodeWeight, p decodeConfig) error {
@@ -168,6 +218,14 @@ var allDecoderSetups = map[string]decodeWeight{
 			return nil
 		},
 	},
+	"outputRules": {
+		key: "outputRules",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.OutputRules, err = page.DecodeOutputRules(p.p.Get(d.key))
+			return err
+		},
+	},
 	"outputFormats": {
 		key: "outputFormats",
 		decode: func(d decodeWeight, p decodeConfig) error {
@@ -195,6 +253,14 @@ var allDecoderSetups = map[string]decodeWeight{
 			return nil
 		},
 	},
+	"paramsschema": {
+		key: "paramsSchema",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.ParamsSchema, err = maps.DecodeParamsSchema(p.p.GetStringMap(d.key))
+			return err
+		},
+	},
 	"module": {
 		key: "module",
 		decode: func(d decodeWeight, p decodeConfig) error {
@@ -218,6 +284,14 @@ var allDecoderSetups = map[string]decodeWeight{
 			return err
 		},
 	},
+	"summary": {
+		key: "summary",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.Summary, err = config.DecodeSummary(config.SummaryConfig{}, p.p.GetStringMap(d.key))
+			return err
+		},
+	},
 	"taxonomies": {
 		key: "taxonomies",
 		decode: func(d decodeWeight, p decodeConfig) error {
@@ -225,6 +299,14 @@ var allDecoderSetups = map[string]decodeWeight{
 			return nil
 		},
 	},
+	"taxonomysort": {
+		key: "taxonomySort",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.TaxonomySort, err = config.DecodeTaxonomySort(p.p.GetStringMap(d.key))
+			return err
+		},
+	},
 	"related": {
 		key:    "related",
 		weight: 100, // This needs to be decoded after taxonomies.
@@ -314,6 +396,9 @@ var allDecoderSetups = map[string]decodeWeight{
 		decode: func(d decodeWeight, p decodeConfig) error {
 			var err error
 			p.c.Security, err = security.DecodeConfig(p.p)
+			if err == nil && p.p.GetBool("safe") {
+				p.c.Security = p.c.Security.Safe()
+			}
 			return err
 		},
 	},
@@ -325,6 +410,159 @@ var allDecoderSetups = map[string]decodeWeight{
 			return err
 		},
 	},
+	"redirects": {
+		key: "redirects",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.Redirects, err = redirects.DecodeConfig(p.p)
+			return err
+		},
+	},
+	"searchindex": {
+		key: "searchindex",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.SearchIndex, err = searchindex.DecodeConfig(p.p)
+			return err
+		},
+	},
+	"llmstxt": {
+		key: "llmstxt",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.LLMSTxt, err = llmstxt.DecodeConfig(p.p)
+			return err
+		},
+	},
+	"internaltemplates": {
+		key: "internaltemplates",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.InternalTemplates, err = internaltemplates.DecodeConfig(p.p)
+			return err
+		},
+	},
+	"seo": {
+		key: "seo",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.SEO, err = seo.DecodeConfig(p.p)
+			return err
+		},
+	},
+	"wellknown": {
+		key: "wellknown",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.WellKnown, err = wellknown.DecodeConfig(p.p)
+			return err
+		},
+	},
+	"externallinks": {
+		key: "externallinks",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.ExternalLinks, err = externallinks.DecodeConfig(p.p)
+			return err
+		},
+	},
+	"accessibility": {
+		key: "accessibility",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.Accessibility, err = a11yinject.DecodeConfig(p.p)
+			return err
+		},
+	},
+	"pdf": {
+		key: "pdf",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.PDF, err = pdf.DecodeConfig(p.p)
+			return err
+		},
+	},
+	"indieweb": {
+		key: "indieweb",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.IndieWeb, err = indieweb.DecodeConfig(p.p)
+			return err
+		},
+	},
+	"indexer": {
+		key: "indexer",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.Indexer, err = indexer.DecodeConfig(p.p)
+			return err
+		},
+	},
+	"publishtargets": {
+		key: "publishtargets",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.PublishTargets, err = publishtargets.DecodeConfig(p.p)
+			return err
+		},
+	},
+	"indexnow": {
+		key: "indexnow",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.IndexNow, err = indexnow.DecodeConfig(p.p)
+			return err
+		},
+	},
+	"machinetranslation": {
+		key: "machinetranslation",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.MachineTranslation, err = machinetranslation.DecodeConfig(p.p)
+			return err
+		},
+	},
+	"audit": {
+		key: "audit",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.Audit, err = htmlaudit.DecodeConfig(p.p)
+			return err
+		},
+	},
+	"lint": {
+		key: "lint",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.Lint, err = lint.DecodeConfig(p.p)
+			return err
+		},
+	},
+	"flags": {
+		key:    "flags",
+		weight: 100, // This needs to be decoded after internal.
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.Flags, err = featureflags.DecodeConfig(p.p, p.c.Internal.Flag)
+			return err
+		},
+	},
+	"features": {
+		key: "features",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.Features, err = features.DecodeConfig(p.p)
+			return err
+		},
+	},
+	"checklinks": {
+		key: "checklinks",
+		decode: func(d decodeWeight, p decodeConfig) error {
+			var err error
+			p.c.CheckLinks, err = linkcheck.DecodeConfig(p.p)
+			return err
+		},
+	},
 	"deployment": {
 		key: "deployment",
 		decode: func(d decodeWeight, p decodeConfig) error {
@@ -69,6 +69,10 @@ func (c ConfigLanguage) TemplateMetricsHints() bool {
 	return c.config.TemplateMetricsHints
 }
 
+func (c ConfigLanguage) TemplateMetricsLimit() int {
+	return c.config.TemplateMetricsLimit
+}
+
 func (c ConfigLanguage) IsLangDisabled(lang string) bool {
 	return c.config.C.DisabledLanguages[lang]
 }
@@ -120,6 +124,8 @@ func (c ConfigLanguage) GetConfigSection(s string) any {
 		return c.config.Frontmatter
 	case "caches":
 		return c.config.Caches
+	case "remoteCache":
+		return c.config.RemoteCache
 	case "markup":
 		return c.config.Markup
 	case "mediaTypes":
@@ -130,10 +136,18 @@ func (c ConfigLanguage) GetConfigSection(s string) any {
 		return c.config.Permalinks
 	case "minify":
 		return c.config.Minify
+	case "sass":
+		return c.config.Sass
+	case "plugins":
+		return c.config.Plugins
 	case "activeModules":
 		return c.m.Modules
 	case "deployment":
 		return c.config.Deployment
+	case "internalTemplates":
+		return c.config.InternalTemplates
+	case "publishTargets":
+		return c.config.PublishTargets
 	default:
 		panic("not implemented: " + s)
 	}
@@ -175,6 +189,17 @@ func (c ConfigLanguage) SummaryLength() int {
 	return c.config.SummaryLength
 }
 
+func (c ConfigLanguage) Summary() config.SummaryConfig {
+	sc := c.config.Summary
+	if sc.Unit == "" {
+		sc.Unit = config.SummaryUnitWords
+	}
+	if sc.Length == 0 {
+		sc.Length = c.config.SummaryLength
+	}
+	return sc
+}
+
 func (c ConfigLanguage) BuildExpired() bool {
 	return c.config.BuildExpired
 }
@@ -195,6 +220,14 @@ func (c ConfigLanguage) PrintUnusedTemplates() bool {
 	return c.config.PrintUnusedTemplates
 }
 
+func (c ConfigLanguage) PrintInternalTemplates() bool {
+	return c.config.PrintInternalTemplates
+}
+
+func (c ConfigLanguage) Safe() bool {
+	return c.config.Safe
+}
+
 func (c ConfigLanguage) EnableMissingTranslationPlaceholders() bool {
 	return c.config.EnableMissingTranslationPlaceholders
 }
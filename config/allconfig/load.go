@@ -49,7 +49,7 @@ func LoadConfig(d ConfigSourceDescriptor) (*Configs, error) {
 		d.Logger = loggers.NewErrorLogger()
 	}
 
-	l := &configLoader{ConfigSourceDescriptor: d, cfg: config.New()}
+	l := &configLoader{ConfigSourceDescriptor: d, cfg: config.New(), provenance: config.NewProvenance()}
 	// Make sure we always do this, even in error situations,
 	// as we have commands (e.g. "hugo mod init") that will
 	// use a partial configuration to do its job.
@@ -131,6 +131,9 @@ type configLoader struct {
 	BaseConfig config.BaseConfig
 	ConfigSourceDescriptor
 
+	// The configuration layers setting each key in cfg.
+	provenance config.Provenance
+
 	// collected
 	ModulesConfig      modules.ModulesConfig
 	ModulesConfigFiles []string
@@ -210,6 +213,12 @@ func (l configLoader) applyDefaultConfig() error {
 		"enableInlineShortcodes":               false,
 	}
 
+	for k, v := range defaultSettings {
+		if !l.cfg.IsSet(k) {
+			l.provenance.Record(config.ProvenanceDefault, maps.Params{k: v})
+		}
+	}
+
 	l.cfg.SetDefaults(defaultSettings)
 
 	return nil
@@ -232,9 +241,42 @@ func (l configLoader) cleanExternalConfig(cfg config.Provider) error {
 	return nil
 }
 
+// sitesConfigKey is the config section holding the sites in a multi-site
+// project, keyed by site name, e.g. [sites.docs].
+const sitesConfigKey = "sites"
+
+// applySiteConfig merges the config of the site selected with --site into the
+// root config, so the site's settings win over the shared settings.
+func (l configLoader) applySiteConfig(flags config.Provider) error {
+	if flags == nil {
+		return nil
+	}
+	name := strings.ToLower(flags.GetString("internal.site"))
+	if name == "" {
+		return nil
+	}
+
+	sites := l.cfg.GetStringMap(sitesConfigKey)
+	v, found := sites[name]
+	if !found || name == maps.MergeStrategyKey {
+		return fmt.Errorf("site %q not found in the %s config", name, sitesConfigKey)
+	}
+	m, err := maps.ToStringMapE(v)
+	if err != nil {
+		return fmt.Errorf("failed to decode the config for site %q: %w", name, err)
+	}
+
+	l.cfg.Set("", m)
+	l.provenance.Record(config.ProvenanceSitePrefix+name, m)
+
+	return nil
+}
+
 func (l configLoader) applyFlagsOverrides(cfg config.Provider) error {
 	for _, k := range cfg.Keys() {
-		l.cfg.Set(k, cfg.Get(k))
+		v := cfg.Get(k)
+		l.cfg.Set(k, v)
+		l.provenance.Record(config.ProvenanceFlags, maps.Params{k: v})
 	}
 	return nil
 }
@@ -249,9 +291,13 @@ func (l configLoader) applyOsEnvOverrides(environ []string) error {
 	// Extract all that start with the HUGO prefix.
 	// The delimiter is the following rune, usually "_".
 	const hugoEnvPrefix = "HUGO"
-	var hugoEnv []types.KeyValueStr
+	var (
+		hugoEnv []types.KeyValueStr
+		envKeys []string
+	)
 	for _, v := range environ {
 		key, val := config.SplitEnvVar(v)
+		envName := key
 		if strings.HasPrefix(key, hugoEnvPrefix) {
 			delimiterAndKey := strings.TrimPrefix(key, hugoEnvPrefix)
 			if len(delimiterAndKey) < 2 {
@@ -267,11 +313,12 @@ func (l configLoader) applyOsEnvOverrides(environ []string) error {
 				Key:   key,
 				Value: val,
 			})
+			envKeys = append(envKeys, envName)
 
 		}
 	}
 
-	for _, env := range hugoEnv {
+	for i, env := range hugoEnv {
 		existing, nestedKey, owner, err := maps.GetNestedParamFn(env.Key, delim, l.cfg.Get)
 		if err != nil {
 			return err
@@ -294,6 +341,8 @@ func (l configLoader) applyOsEnvOverrides(environ []string) error {
 			// The container does not exist yet.
 			l.cfg.Set(strings.ReplaceAll(env.Key, delim, "."), env.Value)
 		}
+
+		l.provenance.RecordKey(config.ProvenanceEnvPrefix+envKeys[i], strings.ReplaceAll(env.Key, delim, "."))
 	}
 
 	return nil
@@ -352,7 +401,7 @@ func (l *configLoader) loadConfigMain(d ConfigSourceDescriptor) (config.LoadConf
 
 	if d.ConfigDir != "" {
 		absConfigDir := paths.AbsPathify(l.BaseConfig.WorkingDir, d.ConfigDir)
-		dcfg, dirnames, err := config.LoadConfigFromDir(l.Fs, absConfigDir, l.Environment)
+		dcfg, dirnames, err := config.LoadConfigFromDir(l.Fs, absConfigDir, l.Environment, l.provenance)
 		if err == nil {
 			if len(dirnames) > 0 {
 				if err := l.normalizeCfg(dcfg); err != nil {
@@ -372,7 +421,12 @@ func (l *configLoader) loadConfigMain(d ConfigSourceDescriptor) (config.LoadConf
 		}
 	}
 
+	if err := l.applySiteConfig(d.Flags); err != nil {
+		return res, l.ModulesConfig, err
+	}
+
 	res.Cfg = l.cfg
+	res.Provenance = l.provenance
 
 	if err := l.applyDefaultConfig(); err != nil {
 		return res, l.ModulesConfig, err
@@ -445,7 +499,11 @@ func (l *configLoader) loadModules(configs *Configs) (modules.ModulesConfig, *mo
 
 				// Merge in the theme config using the configured
 				// merge strategy.
-				cfg.Merge("", tc.Cfg().Get(""))
+				themeCfg := tc.Cfg().Get("")
+				cfg.Merge("", themeCfg)
+				if m, ok := themeCfg.(maps.Params); ok {
+					l.provenance.RecordIfNotSet(config.ProvenanceModulePrefix+tc.Path(), m)
+				}
 
 			}
 		}
@@ -518,6 +576,7 @@ func (l configLoader) loadConfig(configName string) (string, error) {
 
 	// Set overwrites keys of the same name, recursively.
 	l.cfg.Set("", m)
+	l.provenance.Record(filename, m)
 
 	if err := l.normalizeCfg(l.cfg); err != nil {
 		return filename, err
@@ -5,6 +5,9 @@ import (
 	"path/filepath"
 	"testing"
 
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/config"
 	"github.com/spf13/afero"
 )
 
@@ -65,3 +68,111 @@ weight = 4
 		}
 	}
 }
+
+func TestLoadConfigProvenance(t *testing.T) {
+	c := qt.New(t)
+
+	fs := afero.NewMemMapFs()
+	writeFile := func(filename, content string) {
+		c.Assert(afero.WriteFile(fs, filepath.FromSlash(filename), []byte(content), 0666), qt.IsNil)
+	}
+
+	writeFile("/p/a.toml", `
+title = "Base"
+[params]
+color = "red"
+size = 1
+shape = "circle"
+`)
+	writeFile("/p/b.yaml", `
+title: Override
+params:
+  color: blue
+`)
+	writeFile("/p/config/production/params.toml", `size = 3`)
+
+	flags := config.New()
+	flags.Set("workingDir", filepath.FromSlash("/p"))
+
+	configs, err := LoadConfig(ConfigSourceDescriptor{
+		Fs:          fs,
+		Flags:       flags,
+		Filename:    "a.toml,b.yaml",
+		ConfigDir:   "config",
+		Environment: "production",
+		Environ:     []string{"HUGO_PARAMS_SHAPE=square"},
+	})
+	c.Assert(err, qt.IsNil)
+
+	prov := configs.LoadingInfo.Provenance
+	a, b := filepath.FromSlash("/p/a.toml"), filepath.FromSlash("/p/b.yaml")
+	params := filepath.FromSlash("/p/config/production/params.toml")
+
+	assertSource := func(key, source string, overridden ...string) {
+		c.Helper()
+		s, o := prov.Source(key)
+		c.Assert(s, qt.Equals, source, qt.Commentf(key))
+		c.Assert(o, qt.DeepEquals, overridden, qt.Commentf(key))
+	}
+
+	assertSource("title", b, a)
+	assertSource("params.color", b, a)
+	assertSource("params.size", params, a)
+	assertSource("params.shape", "env:HUGO_PARAMS_SHAPE", a)
+	assertSource("paginate", config.ProvenanceDefault)
+	assertSource("workingDir", config.ProvenanceFlags)
+
+	c.Assert(configs.Base.Params["color"], qt.Equals, "blue")
+	c.Assert(configs.Base.Params["size"], qt.Equals, int64(3))
+	c.Assert(configs.Base.Params["shape"], qt.Equals, "square")
+}
+
+func TestLoadConfigSites(t *testing.T) {
+	c := qt.New(t)
+
+	fs := afero.NewMemMapFs()
+	c.Assert(afero.WriteFile(fs, filepath.FromSlash("/p/hugo.toml"), []byte(`
+baseURL = "https://example.org/"
+title = "Shared"
+[params]
+color = "blue"
+size = 1
+[sites.docs]
+baseURL = "https://docs.example.org/"
+publishDir = "public/docs"
+[sites.blog]
+title = "Blog"
+[sites.blog.params]
+color = "red"
+`), 0666), qt.IsNil)
+
+	load := func(site string) (*Configs, error) {
+		flags := config.New()
+		flags.Set("workingDir", filepath.FromSlash("/p"))
+		flags.Set("internal", maps.Params{"site": site})
+		return LoadConfig(ConfigSourceDescriptor{Fs: fs, Flags: flags})
+	}
+
+	configs, err := load("")
+	c.Assert(err, qt.IsNil)
+	c.Assert(configs.Base.Title, qt.Equals, "Shared")
+	c.Assert(configs.SiteNames(), qt.DeepEquals, []string{"blog", "docs"})
+
+	configs, err = load("Blog")
+	c.Assert(err, qt.IsNil)
+	c.Assert(configs.Base.Title, qt.Equals, "Blog")
+	c.Assert(configs.Base.BaseURL, qt.Equals, "https://example.org/")
+	c.Assert(configs.Base.Params["color"], qt.Equals, "red")
+	c.Assert(configs.Base.Params["size"], qt.Equals, int64(1))
+	s, _ := configs.LoadingInfo.Provenance.Source("params.color")
+	c.Assert(s, qt.Equals, config.ProvenanceSitePrefix+"blog")
+
+	configs, err = load("docs")
+	c.Assert(err, qt.IsNil)
+	c.Assert(configs.Base.Title, qt.Equals, "Shared")
+	c.Assert(configs.Base.BaseURL, qt.Equals, "https://docs.example.org/")
+	c.Assert(configs.Base.Params["color"], qt.Equals, "blue")
+
+	_, err = load("nosuchsite")
+	c.Assert(err, qt.ErrorMatches, `.*site "nosuchsite" not found in the sites config`)
+}
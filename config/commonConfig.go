@@ -21,6 +21,7 @@ import (
 
 	"github.com/gobwas/glob"
 	"github.com/gohugoio/hugo/common/loggers"
+	"github.com/gohugoio/hugo/common/maps"
 	"github.com/gohugoio/hugo/common/types"
 
 	"github.com/gohugoio/hugo/common/herrors"
@@ -77,6 +78,9 @@ type LoadConfigResult struct {
 	Cfg         Provider
 	ConfigFiles []string
 	BaseConfig  BaseConfig
+
+	// The configuration layers setting each key in Cfg.
+	Provenance Provenance
 }
 
 var defaultBuild = BuildConfig{
@@ -116,12 +120,67 @@ type BuildConfig struct {
 	// file.
 	NoJSConfigInAssets bool
 
+	// If set, a JSON manifest with the Subresource Integrity hashes of all
+	// published fingerprinted resources, keyed by their path, is written
+	// to this file in the publish dir, e.g. "sri.json".
+	SRIManifest string
+
+	// If set, a JSON manifest listing all the files published in the build
+	// with their SHA-256 hash and provenance, e.g. the source and the
+	// templates of a page, is written to this file in the publish dir,
+	// e.g. "hugo_manifest.json".
+	Manifest string
+
 	// Can used to control how the resource cache gets evicted on rebuilds.
 	CacheBusters []CacheBuster
+
+	// When enabled, list pages (home, sections and taxonomies) are not
+	// re-rendered in server mode when only the content of regular pages
+	// changes, and not their summary or metadata, unless the list page uses
+	// the content of any of them (e.g. .Content or .WordCount).
+	// Changes to content used in cached partials re-render all list pages.
+	SkipUnaffectedListPages bool
+
+	// Source maps for chained asset transformations, e.g.
+	// ToCSS, PostCSS, minify and fingerprint.
+	SourceMaps SourceMapsConfig
+}
+
+// SourceMapsConfig configures the source maps published for chained asset
+// transformations. The transformations supporting source maps (ToCSS,
+// PostCSS, minify of CSS and JavaScript and js.Build) compose their maps,
+// and the transformations not changing the content (e.g. fingerprint)
+// keep them. The composed map is published next to the resource.
+type SourceMapsConfig struct {
+	// Enable source maps for all transformations supporting them.
+	Enable bool
+
+	// If set, source maps are only enabled in these environments, e.g. ["development"].
+	Environments []string
+
+	// Include the original sources in the source maps.
+	IncludeSources bool
+}
+
+// Enabled reports whether source maps are enabled in the given environment.
+func (c SourceMapsConfig) Enabled(environment string) bool {
+	if !c.Enable {
+		return false
+	}
+	if len(c.Environments) == 0 {
+		return true
+	}
+	for _, env := range c.Environments {
+		if strings.EqualFold(env, environment) {
+			return true
+		}
+	}
+	return false
 }
 
 func (b BuildConfig) clone() BuildConfig {
 	b.CacheBusters = append([]CacheBuster{}, b.CacheBusters...)
+	b.SourceMaps.Environments = append([]string(nil), b.SourceMaps.Environments...)
 	return b
 }
 
@@ -204,6 +263,114 @@ func DecodeSitemap(prototype SitemapConfig, input map[string]any) (SitemapConfig
 	return prototype, err
 }
 
+const (
+	// SummaryUnitWords truncates automatic summaries to whole sentences after a number of words.
+	SummaryUnitWords = "words"
+	// SummaryUnitSentences truncates automatic summaries after a number of sentences.
+	SummaryUnitSentences = "sentences"
+	// SummaryUnitCharacters truncates automatic summaries to whole words within a number of characters.
+	SummaryUnitCharacters = "characters"
+)
+
+// SummaryConfig configures the automatic summaries, i.e. the summaries of
+// pages without a summary divider or a summary in front matter.
+// This can be configured per language.
+type SummaryConfig struct {
+	// The unit of Length, one of words, sentences or characters. Default is words.
+	Unit string
+	// The summary length in Unit.
+	// If not set for words, the value of summaryLength is used.
+	Length int
+}
+
+func DecodeSummary(prototype SummaryConfig, input map[string]any) (SummaryConfig, error) {
+	if err := mapstructure.WeakDecode(input, &prototype); err != nil {
+		return prototype, err
+	}
+	prototype.Unit = strings.ToLower(prototype.Unit)
+	switch prototype.Unit {
+	case "":
+		prototype.Unit = SummaryUnitWords
+	case SummaryUnitWords, SummaryUnitSentences, SummaryUnitCharacters:
+	default:
+		return prototype, fmt.Errorf("summary: invalid unit %q, must be one of words, sentences or characters", prototype.Unit)
+	}
+	if prototype.Length < 0 || (prototype.Length == 0 && prototype.Unit != SummaryUnitWords) {
+		return prototype, fmt.Errorf("summary: invalid length %d for unit %q", prototype.Length, prototype.Unit)
+	}
+	return prototype, nil
+}
+
+const (
+	// TaxonomySortByTitle sorts the terms by title.
+	TaxonomySortByTitle = "title"
+	// TaxonomySortByCount sorts the terms by their number of pages.
+	TaxonomySortByCount = "count"
+	// TaxonomySortByDate sorts the terms by the most recent date of their pages.
+	TaxonomySortByDate = "date"
+	// TaxonomySortByParamPrefix sorts the terms by a param of the term page, e.g. params.rank.
+	TaxonomySortByParamPrefix = "params."
+)
+
+// TaxonomySortConfig configures the order of the terms listed in a taxonomy page.
+type TaxonomySortConfig struct {
+	// What to sort the terms by, one of title, count, date or params.<key>.
+	By string
+	// The sort order, asc or desc. Default is desc for count and date, else asc.
+	Order string
+}
+
+// Param returns the param key to sort by, if any.
+func (c TaxonomySortConfig) Param() string {
+	return strings.TrimPrefix(c.By, TaxonomySortByParamPrefix)
+}
+
+// Desc reports whether the terms are sorted in descending order.
+func (c TaxonomySortConfig) Desc() bool {
+	return c.Order == "desc"
+}
+
+// DecodeTaxonomySort decodes the taxonomySort config, keyed by the plural
+// taxonomy name. A string value is a shorthand for by, e.g. tags = "count".
+func DecodeTaxonomySort(input map[string]any) (map[string]TaxonomySortConfig, error) {
+	m := make(map[string]TaxonomySortConfig)
+	for k, v := range input {
+		if k == maps.MergeStrategyKey {
+			continue
+		}
+		var c TaxonomySortConfig
+		if s, ok := v.(string); ok {
+			c.By = s
+		} else if err := mapstructure.WeakDecode(v, &c); err != nil {
+			return nil, fmt.Errorf("taxonomySort: failed to decode %q: %w", k, err)
+		}
+
+		c.By = strings.ToLower(c.By)
+		switch {
+		case c.By == TaxonomySortByTitle, c.By == TaxonomySortByCount, c.By == TaxonomySortByDate:
+		case strings.HasPrefix(c.By, TaxonomySortByParamPrefix) && c.Param() != "":
+		default:
+			return nil, fmt.Errorf("taxonomySort: invalid by %q for %q, must be one of title, count, date or params.<key>", c.By, k)
+		}
+
+		c.Order = strings.ToLower(c.Order)
+		switch c.Order {
+		case "":
+			if c.By == TaxonomySortByCount || c.By == TaxonomySortByDate {
+				c.Order = "desc"
+			} else {
+				c.Order = "asc"
+			}
+		case "asc", "desc":
+		default:
+			return nil, fmt.Errorf("taxonomySort: invalid order %q for %q, must be asc or desc", c.Order, k)
+		}
+
+		m[strings.ToLower(k)] = c
+	}
+	return m, nil
+}
+
 // Config for the dev server.
 type Server struct {
 	Headers   []Headers
@@ -227,6 +394,9 @@ func (s *Server) CompileConfig(logger loggers.Logger) error {
 	return nil
 }
 
+// MatchHeaders returns the headers of the rules matching pattern, sorted by key.
+// For a key set by several rules, the values are in rule order, so the last
+// one wins when they're applied in order.
 func (s *Server) MatchHeaders(pattern string) []types.KeyValueStr {
 	if s.compiledHeaders == nil {
 		return nil
@@ -243,7 +413,7 @@ func (s *Server) MatchHeaders(pattern string) []types.KeyValueStr {
 		}
 	}
 
-	sort.Slice(matches, func(i, j int) bool {
+	sort.SliceStable(matches, func(i, j int) bool {
 		return matches[i].Key < matches[j].Key
 	})
 
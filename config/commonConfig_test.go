@@ -141,6 +141,33 @@ status = 301`,
 	}
 }
 
+func TestBuildConfigSourceMaps(t *testing.T) {
+	c := qt.New(t)
+
+	v := New()
+	b := DecodeBuildConfig(v)
+	c.Assert(b.SourceMaps.Enabled("development"), qt.Equals, false)
+
+	v.Set("build", map[string]any{
+		"sourceMaps": map[string]any{
+			"enable": true,
+		},
+	})
+	b = DecodeBuildConfig(v)
+	c.Assert(b.SourceMaps.Enabled("development"), qt.Equals, true)
+	c.Assert(b.SourceMaps.Enabled("production"), qt.Equals, true)
+
+	v.Set("build", map[string]any{
+		"sourceMaps": map[string]any{
+			"enable":       true,
+			"environments": []string{"Development"},
+		},
+	})
+	b = DecodeBuildConfig(v)
+	c.Assert(b.SourceMaps.Enabled("development"), qt.Equals, true)
+	c.Assert(b.SourceMaps.Enabled("production"), qt.Equals, false)
+}
+
 func TestBuildConfigCacheBusters(t *testing.T) {
 	c := qt.New(t)
 	cfg := New()
@@ -164,3 +191,48 @@ func TestBuildConfigCacheBusters(t *testing.T) {
 	c.Assert(m("json"), qt.IsTrue)
 
 }
+
+func TestDecodeSummary(t *testing.T) {
+	c := qt.New(t)
+
+	sc, err := DecodeSummary(SummaryConfig{}, nil)
+	c.Assert(err, qt.IsNil)
+	c.Assert(sc, qt.Equals, SummaryConfig{Unit: SummaryUnitWords})
+
+	sc, err = DecodeSummary(SummaryConfig{}, map[string]any{"unit": "Sentences", "length": "2"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(sc, qt.Equals, SummaryConfig{Unit: SummaryUnitSentences, Length: 2})
+
+	_, err = DecodeSummary(SummaryConfig{}, map[string]any{"unit": "paragraphs", "length": 2})
+	c.Assert(err, qt.ErrorMatches, `.*invalid unit "paragraphs".*`)
+
+	_, err = DecodeSummary(SummaryConfig{}, map[string]any{"unit": "characters"})
+	c.Assert(err, qt.ErrorMatches, `.*invalid length 0.*`)
+}
+
+func TestDecodeTaxonomySort(t *testing.T) {
+	c := qt.New(t)
+
+	m, err := DecodeTaxonomySort(map[string]any{
+		"tags":       "Count",
+		"categories": map[string]any{"by": "params.rank"},
+		"series":     map[string]any{"by": "date", "order": "ASC"},
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(m, qt.DeepEquals, map[string]TaxonomySortConfig{
+		"tags":       {By: TaxonomySortByCount, Order: "desc"},
+		"categories": {By: "params.rank", Order: "asc"},
+		"series":     {By: TaxonomySortByDate, Order: "asc"},
+	})
+	c.Assert(m["categories"].Param(), qt.Equals, "rank")
+	c.Assert(m["tags"].Desc(), qt.IsTrue)
+
+	_, err = DecodeTaxonomySort(map[string]any{"tags": "weight"})
+	c.Assert(err, qt.ErrorMatches, `.*invalid by "weight" for "tags".*`)
+
+	_, err = DecodeTaxonomySort(map[string]any{"tags": "params."})
+	c.Assert(err, qt.ErrorMatches, `.*invalid by "params." for "tags".*`)
+
+	_, err = DecodeTaxonomySort(map[string]any{"tags": map[string]any{"by": "count", "order": "random"}})
+	c.Assert(err, qt.ErrorMatches, `.*invalid order "random" for "tags".*`)
+}
@@ -116,7 +116,10 @@ func loadConfigFromFile(fs afero.Fs, filename string) (map[string]any, error) {
 	return m, nil
 }
 
-func LoadConfigFromDir(sourceFs afero.Fs, configDir, environment string) (Provider, []string, error) {
+// LoadConfigFromDir loads the configuration from the _default and environment
+// directories below configDir. The config files read are recorded in
+// provenance, which may be nil.
+func LoadConfigFromDir(sourceFs afero.Fs, configDir, environment string, provenance Provenance) (Provider, []string, error) {
 	defaultConfigDir := filepath.Join(configDir, "_default")
 	environmentConfigDir := filepath.Join(configDir, environment)
 	cfg := New()
@@ -196,6 +199,8 @@ func LoadConfigFromDir(sourceFs afero.Fs, configDir, environment string) (Provid
 			// Migrate menu => menus etc.
 			RenameKeys(root)
 
+			provenance.Record(path, root)
+
 			// Set will overwrite keys with the same name, recursively.
 			cfg.Set("", root)
 
@@ -47,6 +47,7 @@ type AllProvider interface {
 	DefaultContentLanguageInSubdir() bool
 	IsLangDisabled(string) bool
 	SummaryLength() int
+	Summary() SummaryConfig
 	Paginate() int
 	PaginatePath() string
 	BuildExpired() bool
@@ -54,9 +55,12 @@ type AllProvider interface {
 	BuildDrafts() bool
 	Running() bool
 	PrintUnusedTemplates() bool
+	PrintInternalTemplates() bool
+	Safe() bool
 	EnableMissingTranslationPlaceholders() bool
 	TemplateMetrics() bool
 	TemplateMetricsHints() bool
+	TemplateMetricsLimit() int
 	LogI18nWarnings() bool
 	CreateTitle(s string) string
 	IgnoreFile(s string) bool
@@ -328,7 +328,7 @@ func (c *defaultConfigProvider) determineMergeStrategy(params ...maps.KeyParams)
 	// This will be handled as a special case.
 	case "params":
 		strategy = maps.ParamsMergeStrategyDeep
-	case "outputformats", "mediatypes":
+	case "outputformats", "mediatypes", "plugins":
 		if prevIsRoot {
 			strategy = maps.ParamsMergeStrategyShallow
 		}
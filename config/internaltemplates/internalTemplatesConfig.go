@@ -0,0 +1,85 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package internaltemplates holds the configuration used to disable or
+// override Hugo's internal templates, e.g. _internal/disqus.html.
+package internaltemplates
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/config"
+	"github.com/mitchellh/mapstructure"
+)
+
+const internalTemplatesConfigKey = "internaltemplates"
+
+// Config holds the internal template settings keyed by the template name
+// without the _internal/ prefix and the extension, e.g. google_analytics.
+type Config map[string]Template
+
+// Template holds the settings for one internal template.
+type Template struct {
+	// Disable the template. Any use of it will render nothing.
+	Disable bool
+
+	// The name of a template in /layouts to use instead of the internal
+	// template, e.g. partials/opengraph.html.
+	Template string
+}
+
+// Get returns the settings for the internal template with the given name,
+// e.g. "_internal/disqus.html" or "disqus".
+func (c Config) Get(name string) (Template, bool) {
+	t, found := c[NormalizeName(name)]
+	return t, found
+}
+
+// NormalizeName normalizes the internal template name, e.g.
+// "_internal/Google_Analytics.html" to "google_analytics".
+func NormalizeName(name string) string {
+	name = strings.ToLower(strings.TrimPrefix(name, "_internal/"))
+	return strings.TrimSuffix(name, path.Ext(name))
+}
+
+// DecodeConfig creates an internal templates Config from a given Hugo configuration.
+func DecodeConfig(cfg config.Provider) (Config, error) {
+	c := make(Config)
+
+	m := cfg.GetStringMap(internalTemplatesConfigKey)
+	if m == nil {
+		return c, nil
+	}
+
+	for k, v := range m {
+		if k == maps.MergeStrategyKey {
+			continue
+		}
+		var t Template
+		if err := mapstructure.WeakDecode(v, &t); err != nil {
+			return nil, fmt.Errorf("internalTemplates.%s: %w", k, err)
+		}
+		if t.Disable && t.Template != "" {
+			return nil, fmt.Errorf("internalTemplates.%s: disable and template are mutually exclusive", k)
+		}
+		if t.Template != "" {
+			t.Template = strings.TrimPrefix(path.Clean(t.Template), "/")
+		}
+		c[NormalizeName(k)] = t
+	}
+
+	return c, nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internaltemplates
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/config"
+)
+
+func TestDecodeConfigFromTOML(t *testing.T) {
+	c := qt.New(t)
+
+	tomlConfig := `
+[internalTemplates]
+[internalTemplates.Google_Analytics]
+disable = true
+[internalTemplates.opengraph]
+template = "/partials/og.html"
+`
+	cfg, err := config.FromConfigString(tomlConfig, "toml")
+	c.Assert(err, qt.IsNil)
+
+	conf, err := DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+
+	c.Assert(conf, qt.HasLen, 2)
+	ga, found := conf.Get("_internal/google_analytics.html")
+	c.Assert(found, qt.IsTrue)
+	c.Assert(ga.Disable, qt.IsTrue)
+	og, found := conf.Get("opengraph")
+	c.Assert(found, qt.IsTrue)
+	c.Assert(og.Template, qt.Equals, "partials/og.html")
+	_, found = conf.Get("disqus")
+	c.Assert(found, qt.IsFalse)
+}
+
+func TestDecodeConfigDisableAndTemplate(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := config.New()
+	cfg.Set("internalTemplates", map[string]any{"disqus": map[string]any{"disable": true, "template": "partials/disqus.html"}})
+
+	_, err := DecodeConfig(cfg)
+	c.Assert(err, qt.ErrorMatches, ".*mutually exclusive.*")
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/maps"
+)
+
+const (
+	// ProvenanceDefault is the source of the Hugo defaults.
+	ProvenanceDefault = "default"
+	// ProvenanceFlags is the source of the command line flags.
+	ProvenanceFlags = "flags"
+	// ProvenanceEnvPrefix is the prefix of the source of OS environment
+	// variables, e.g. "env:HUGO_PARAMS_FOO".
+	ProvenanceEnvPrefix = "env:"
+	// ProvenanceModulePrefix is the prefix of the source of module and theme
+	// configuration, e.g. "module:mytheme".
+	ProvenanceModulePrefix = "module:"
+	// ProvenanceSitePrefix is the prefix of the source of the config of the
+	// site selected with --site, e.g. "site:docs".
+	ProvenanceSitePrefix = "site:"
+)
+
+// Provenance keeps track of the configuration layers setting each
+// configuration key, e.g. the config files, OS environment variables and
+// command line flags. The keys are the lower case dotted paths to the leaf
+// values, e.g. "params.author.name".
+// The layers are stored from least to most specific, so the last one wins.
+type Provenance map[string][]string
+
+// NewProvenance creates a new Provenance.
+func NewProvenance() Provenance {
+	return make(Provenance)
+}
+
+// Record records source as the layer setting all the leaf values in m.
+func (p Provenance) Record(source string, m map[string]any) {
+	if p == nil {
+		return
+	}
+	walkLeafKeys("", m, func(key string) {
+		p.RecordKey(source, key)
+	})
+}
+
+// RecordIfNotSet records source as the layer setting the leaf values in m
+// not already set by another layer, e.g. for defaults and theme configuration.
+func (p Provenance) RecordIfNotSet(source string, m map[string]any) {
+	if p == nil {
+		return
+	}
+	walkLeafKeys("", m, func(key string) {
+		if _, found := p[key]; !found {
+			p.RecordKey(source, key)
+		}
+	})
+}
+
+// RecordKey records source as the layer setting key, e.g. "params.author".
+func (p Provenance) RecordKey(source, key string) {
+	if p == nil {
+		return
+	}
+	key = strings.ToLower(key)
+	layers := p[key]
+	if len(layers) > 0 && layers[len(layers)-1] == source {
+		return
+	}
+	p[key] = append(layers, source)
+}
+
+// Source returns the layer that set key, i.e. the one that won, and the
+// layers it overrode. If key isn't tracked, but one of its ancestors is,
+// e.g. "params" for "params.author", the ancestor is used.
+func (p Provenance) Source(key string) (string, []string) {
+	key = strings.ToLower(key)
+	for {
+		if layers, found := p[key]; found {
+			winner := layers[len(layers)-1]
+			var overridden []string
+			seen := map[string]bool{winner: true}
+			for _, layer := range layers[:len(layers)-1] {
+				if !seen[layer] {
+					seen[layer] = true
+					overridden = append(overridden, layer)
+				}
+			}
+			return winner, overridden
+		}
+		i := strings.LastIndex(key, ".")
+		if i == -1 {
+			return "", nil
+		}
+		key = key[:i]
+	}
+}
+
+// LeafKeys returns the sorted dotted paths to the leaf values in m.
+func LeafKeys(m map[string]any) []string {
+	var keys []string
+	walkLeafKeys("", m, func(key string) {
+		keys = append(keys, strings.ToLower(key))
+	})
+	sort.Strings(keys)
+	return keys
+}
+
+func walkLeafKeys(prefix string, m map[string]any, fn func(key string)) {
+	for k, v := range m {
+		if k == maps.MergeStrategyKey {
+			continue
+		}
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		var vm map[string]any
+		switch vv := v.(type) {
+		case maps.Params:
+			vm = vv
+		case map[string]any:
+			vm = vv
+		}
+		if vm != nil && len(vm) > 0 {
+			walkLeafKeys(key, vm, fn)
+		} else {
+			fn(key)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/common/maps"
+)
+
+func TestProvenance(t *testing.T) {
+	c := qt.New(t)
+
+	p := NewProvenance()
+	p.Record(ProvenanceFlags, map[string]any{"baseURL": "https://example.org"})
+	p.Record("a.toml", map[string]any{
+		"baseURL": "https://example.com",
+		"params":  maps.Params{"Color": "red", "empty": maps.Params{}},
+	})
+	p.Record(ProvenanceFlags, map[string]any{"baseURL": "https://example.org"})
+	p.RecordIfNotSet("module:mytheme", map[string]any{
+		"params": map[string]any{"color": "blue", "size": 3},
+	})
+	p.RecordKey("env:HUGO_PARAMS_SIZE", "params.size")
+
+	source, overridden := p.Source("baseurl")
+	c.Assert(source, qt.Equals, ProvenanceFlags)
+	c.Assert(overridden, qt.DeepEquals, []string{"a.toml"})
+
+	source, overridden = p.Source("params.color")
+	c.Assert(source, qt.Equals, "a.toml")
+	c.Assert(overridden, qt.IsNil)
+
+	source, overridden = p.Source("params.size")
+	c.Assert(source, qt.Equals, "env:HUGO_PARAMS_SIZE")
+	c.Assert(overridden, qt.DeepEquals, []string{"module:mytheme"})
+
+	// Empty maps are leaf values.
+	source, _ = p.Source("params.empty.foo")
+	c.Assert(source, qt.Equals, "a.toml")
+
+	source, _ = p.Source("title")
+	c.Assert(source, qt.Equals, "")
+
+	c.Assert(LeafKeys(map[string]any{
+		"b": 1,
+		"a": maps.Params{"d": 2, "c": map[string]any{"E": 3}, maps.MergeStrategyKey: "deep"},
+	}), qt.DeepEquals, []string{"a.c.e", "a.d", "b"})
+}
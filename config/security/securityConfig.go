@@ -15,6 +15,7 @@ package security
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -71,6 +72,42 @@ type Config struct {
 
 	// Go templates related security config.
 	GoTemplates GoTemplates `json:"goTemplates"`
+
+	// Policies for the templates in a given module, see ModulePolicy.
+	Modules []ModulePolicy `json:"modules,omitempty"`
+
+	// The module the policy is checked for and its policy, if any, set by ForModule.
+	module       string
+	modulePolicy *ModulePolicy
+}
+
+// ModuleProject is the ModulePolicy path matching the project's own templates.
+const ModuleProject = "project"
+
+// ModulePolicy holds the policy for the templates in a module, e.g. to allow
+// a theme to run a program the project's templates may not.
+// The whitelists set replace the top level ones when the functions are called
+// from the module's templates, including the resource transformations started
+// by them. Where the caller isn't known, only the top level policy applies.
+type ModulePolicy struct {
+	// The module path, e.g. github.com/gohugoio/hugo-mod-pandoc, or "project"
+	// for the templates in the project.
+	Path string `json:"path"`
+
+	Exec  ModuleExec `json:"exec"`
+	Funcs Funcs      `json:"funcs"`
+	HTTP  ModuleHTTP `json:"http"`
+}
+
+// ModuleExec holds the os/exec policy for a module.
+type ModuleExec struct {
+	Allow Whitelist `json:"allow"`
+}
+
+// ModuleHTTP holds the remote HTTP policy for a module.
+type ModuleHTTP struct {
+	URLs    Whitelist `json:"urls"`
+	Methods Whitelist `json:"methods"`
 }
 
 // Exec holds os/exec policies.
@@ -105,6 +142,80 @@ type GoTemplates struct {
 	AllowActionJSTmpl bool
 }
 
+// Safe returns a copy of c restricted for building untrusted sites:
+// no os/exec, no remote HTTP, no os.Getenv and no inline shortcodes.
+func (c Config) Safe() Config {
+	c.Exec.Allow = NewWhitelist(acceptNoneKeyword)
+	c.Funcs.Getenv = NewWhitelist(acceptNoneKeyword)
+	c.HTTP.URLs = NewWhitelist(acceptNoneKeyword)
+	c.HTTP.Methods = NewWhitelist(acceptNoneKeyword)
+	c.EnableInlineShortcodes = false
+	c.Modules = nil
+	return c
+}
+
+// ForModule returns the policy for the templates in the module with the given path,
+// or "project" for the project's templates.
+func (c Config) ForModule(path string) Config {
+	c.module = path
+	for i, m := range c.Modules {
+		if m.Path != path {
+			continue
+		}
+		c.modulePolicy = &c.Modules[i]
+		if !m.Exec.Allow.IsZero() {
+			c.Exec.Allow = m.Exec.Allow
+		}
+		if !m.Funcs.Getenv.IsZero() {
+			c.Funcs.Getenv = m.Funcs.Getenv
+		}
+		if !m.HTTP.URLs.IsZero() {
+			c.HTTP.URLs = m.HTTP.URLs
+		}
+		if !m.HTTP.Methods.IsZero() {
+			c.HTTP.Methods = m.HTTP.Methods
+		}
+	}
+	return c
+}
+
+// policyPath returns the path to the whitelist policy checked for the current module.
+func (c Config) policyPath(path string, isSet func(m ModulePolicy) bool) string {
+	if c.modulePolicy != nil && isSet(*c.modulePolicy) {
+		return fmt.Sprintf("security.modules[%s].%s", c.module, strings.TrimPrefix(path, "security."))
+	}
+	return path
+}
+
+type moduleContextKey struct{}
+
+// ContextWithModule returns a copy of ctx with the module the executing
+// template or resource comes from, "project" for the project's own.
+func ContextWithModule(ctx context.Context, module string) context.Context {
+	return context.WithValue(ctx, moduleContextKey{}, module)
+}
+
+// ModuleFromContext returns the module set by ContextWithModule, or an empty
+// string if not known.
+func ModuleFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	if v, ok := ctx.Value(moduleContextKey{}).(string); ok {
+		return v
+	}
+	return ""
+}
+
+// ForContext returns the policy for the module set in ctx, see ForModule.
+// If the module is not known, only the top level policy applies.
+func (c Config) ForContext(ctx context.Context) Config {
+	if module := ModuleFromContext(ctx); module != "" {
+		return c.ForModule(module)
+	}
+	return c
+}
+
 // ToTOML converts c to TOML with [security] as the root.
 func (c Config) ToTOML() string {
 	sec := c.ToSecurityMap()
@@ -120,11 +231,7 @@ func (c Config) ToTOML() string {
 
 func (c Config) CheckAllowedExec(name string) error {
 	if !c.Exec.Allow.Accept(name) {
-		return &AccessDeniedError{
-			name:     name,
-			path:     "security.exec.allow",
-			policies: c.ToTOML(),
-		}
+		return c.accessDenied(name, "security.exec.allow", func(m ModulePolicy) bool { return !m.Exec.Allow.IsZero() })
 	}
 	return nil
 
@@ -132,37 +239,34 @@ func (c Config) CheckAllowedExec(name string) error {
 
 func (c Config) CheckAllowedGetEnv(name string) error {
 	if !c.Funcs.Getenv.Accept(name) {
-		return &AccessDeniedError{
-			name:     name,
-			path:     "security.funcs.getenv",
-			policies: c.ToTOML(),
-		}
+		return c.accessDenied(name, "security.funcs.getenv", func(m ModulePolicy) bool { return !m.Funcs.Getenv.IsZero() })
 	}
 	return nil
 }
 
 func (c Config) CheckAllowedHTTPURL(url string) error {
 	if !c.HTTP.URLs.Accept(url) {
-		return &AccessDeniedError{
-			name:     url,
-			path:     "security.http.urls",
-			policies: c.ToTOML(),
-		}
+		return c.accessDenied(url, "security.http.urls", func(m ModulePolicy) bool { return !m.HTTP.URLs.IsZero() })
 	}
 	return nil
 }
 
 func (c Config) CheckAllowedHTTPMethod(method string) error {
 	if !c.HTTP.Methods.Accept(method) {
-		return &AccessDeniedError{
-			name:     method,
-			path:     "security.http.method",
-			policies: c.ToTOML(),
-		}
+		return c.accessDenied(method, "security.http.method", func(m ModulePolicy) bool { return !m.HTTP.Methods.IsZero() })
 	}
 	return nil
 }
 
+func (c Config) accessDenied(name, path string, isSet func(m ModulePolicy) bool) error {
+	return &AccessDeniedError{
+		name:     name,
+		path:     c.policyPath(path, isSet),
+		module:   c.module,
+		policies: c.ToTOML(),
+	}
+}
+
 // ToSecurityMap converts c to a map with 'security' as the root key.
 func (c Config) ToSecurityMap() map[string]any {
 	// Take it to JSON and back to get proper casing etc.
@@ -230,10 +334,14 @@ func stringSliceToWhitelistHook() mapstructure.DecodeHookFuncType {
 type AccessDeniedError struct {
 	path     string
 	name     string
+	module   string
 	policies string
 }
 
 func (e *AccessDeniedError) Error() string {
+	if e.module != "" {
+		return fmt.Sprintf("access denied: %q is not whitelisted in policy %q for the templates in module %q; the current security configuration is:\n\n%s\n\n", e.name, e.path, e.module, e.policies)
+	}
 	return fmt.Sprintf("access denied: %q is not whitelisted in policy %q; the current security configuration is:\n\n%s\n\n", e.name, e.path, e.policies)
 }
 
@@ -14,6 +14,7 @@
 package security
 
 import (
+	"context"
 	"testing"
 
 	qt "github.com/frankban/quicktest"
@@ -170,3 +171,75 @@ func TestDecodeConfigDefault(t *testing.T) {
 	c.Assert(pc.Exec.OsEnv.Accept("MYSECRET"), qt.IsFalse)
 
 }
+
+func TestConfigSafe(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	pc := DefaultConfig.Safe()
+	c.Assert(pc.Exec.Allow.Accept("npx"), qt.IsFalse)
+	c.Assert(pc.Exec.Allow.Accept("dart-sass"), qt.IsFalse)
+	c.Assert(pc.Funcs.Getenv.Accept("HUGO_FOO"), qt.IsFalse)
+	c.Assert(pc.HTTP.URLs.Accept("https://example.org"), qt.IsFalse)
+	c.Assert(pc.HTTP.Methods.Accept("GET"), qt.IsFalse)
+	c.Assert(pc.EnableInlineShortcodes, qt.IsFalse)
+
+	// The default config is left untouched.
+	c.Assert(DefaultConfig.Exec.Allow.Accept("npx"), qt.IsTrue)
+}
+
+func TestConfigForModule(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	tomlConfig := `
+[security]
+[security.funcs]
+getenv=["^HUGO_"]
+[[security.modules]]
+path="github.com/gohugoio/mytheme"
+[security.modules.exec]
+allow=["^pandoc$"]
+[security.modules.funcs]
+getenv=["^MYTHEME_"]
+`
+
+	cfg, err := config.FromConfigString(tomlConfig, "toml")
+	c.Assert(err, qt.IsNil)
+
+	pc, err := DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(pc.Modules, qt.HasLen, 1)
+
+	// When the caller isn't known, only the top level policy applies.
+	c.Assert(pc.CheckAllowedExec("pandoc"), qt.Not(qt.IsNil))
+	c.Assert(pc.CheckAllowedExec("npx"), qt.IsNil)
+	c.Assert(pc.CheckAllowedGetEnv("MYTHEME_KEY"), qt.Not(qt.IsNil))
+	c.Assert(pc.CheckAllowedExec("rm"), qt.Not(qt.IsNil))
+	c.Assert(pc.ForContext(context.Background()).CheckAllowedExec("pandoc"), qt.Not(qt.IsNil))
+
+	ctx := ContextWithModule(context.Background(), "github.com/gohugoio/mytheme")
+	c.Assert(pc.ForContext(ctx).CheckAllowedExec("pandoc"), qt.IsNil)
+	c.Assert(pc.ForContext(ContextWithModule(ctx, "github.com/gohugoio/other")).CheckAllowedExec("pandoc"), qt.Not(qt.IsNil))
+
+	theme := pc.ForModule("github.com/gohugoio/mytheme")
+	c.Assert(theme.CheckAllowedExec("pandoc"), qt.IsNil)
+	c.Assert(theme.CheckAllowedExec("npx"), qt.Not(qt.IsNil))
+	c.Assert(theme.CheckAllowedGetEnv("MYTHEME_KEY"), qt.IsNil)
+	c.Assert(theme.CheckAllowedGetEnv("HUGO_FOO"), qt.Not(qt.IsNil))
+	// Not set in the module policy, so the top level policy applies.
+	c.Assert(theme.CheckAllowedHTTPMethod("GET"), qt.IsNil)
+
+	err = theme.CheckAllowedGetEnv("HUGO_FOO")
+	c.Assert(err, qt.ErrorMatches, `(?s)access denied: "HUGO_FOO" is not whitelisted in policy "security.modules\[github.com/gohugoio/mytheme\].funcs.getenv" for the templates in module "github.com/gohugoio/mytheme".*`)
+
+	project := pc.ForModule(ModuleProject)
+	c.Assert(project.CheckAllowedExec("pandoc"), qt.Not(qt.IsNil))
+	c.Assert(project.CheckAllowedGetEnv("MYTHEME_KEY"), qt.Not(qt.IsNil))
+	c.Assert(project.CheckAllowedGetEnv("HUGO_FOO"), qt.IsNil)
+
+	err = project.CheckAllowedGetEnv("MYTHEME_KEY")
+	c.Assert(err, qt.ErrorMatches, `(?s)access denied: "MYTHEME_KEY" is not whitelisted in policy "security.funcs.getenv" for the templates in module "project".*`)
+
+	c.Assert(pc.Safe().CheckAllowedExec("pandoc"), qt.Not(qt.IsNil))
+}
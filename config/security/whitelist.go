@@ -84,6 +84,11 @@ func NewWhitelist(patterns ...string) Whitelist {
 	return Whitelist{patterns: patternsr, patternsStrings: patternsStrings}
 }
 
+// IsZero reports whether w is not set.
+func (w Whitelist) IsZero() bool {
+	return !w.acceptNone && len(w.patterns) == 0
+}
+
 // Accept reports whether name is whitelisted.
 func (w Whitelist) Accept(name string) bool {
 	if w.acceptNone {
@@ -0,0 +1,197 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package seo holds the configuration for the SEO metadata (OpenGraph, Twitter cards and JSON-LD)
+// rendered by the _internal/seo.html template.
+package seo
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/langs"
+	"github.com/mitchellh/mapstructure"
+)
+
+const (
+	seoConfigKey = "seo"
+
+	// FrontMatterKey is the front matter (and cascade) key used to override the
+	// SEO settings per page.
+	FrontMatterKey = "seo"
+)
+
+// Config holds the site wide SEO configuration.
+// All settings can be overridden per page in front matter below the seo key,
+// including via cascade.
+type Config struct {
+	// The site name used in og:site_name. Defaults to the site title.
+	SiteName string
+
+	// The locale used in og:locale, e.g. en_US. Defaults to the language code.
+	Locale string
+
+	// Default images to use when the page has none.
+	Images []string
+
+	// Twitter card settings.
+	Twitter Twitter
+
+	// Facebook settings.
+	Facebook Facebook
+
+	// Schema.org JSON-LD settings.
+	JSONLD JSONLD
+
+	// The hreflang alternate links of translated pages.
+	Hreflang Hreflang
+}
+
+// Hreflang holds the settings for the hreflang alternate links of translated
+// pages, rendered in the sitemap, the RSS feeds and _internal/seo.html.
+type Hreflang struct {
+	// Disable the hreflang alternate links.
+	Disable bool
+
+	// The language of the page used as the x-default alternate, for the
+	// users whose language matches none of the alternates.
+	// Defaults to the defaultContentLanguage. Set to "none" to not render it.
+	XDefault string
+
+	// The hreflang codes per language, e.g. for a language targeting several
+	// regions, en = ["en-US", "en-GB"].
+	// Defaults to the language code of the language.
+	Locales map[string][]string
+}
+
+// Codes returns the hreflang codes of the language l.
+func (h Hreflang) Codes(l *langs.Language) []string {
+	if codes, found := h.Locales[l.Lang]; found {
+		return codes
+	}
+	return []string{l.LanguageCode()}
+}
+
+// Twitter holds the Twitter card settings.
+type Twitter struct {
+	// The card type, one of summary or summary_large_image.
+	// The default is summary_large_image if the page has an image, else summary.
+	Card string
+
+	// The Twitter username of the site, without the @.
+	Site string
+
+	// The Twitter username of the content creator, without the @.
+	Creator string
+}
+
+// Facebook holds the Facebook settings.
+type Facebook struct {
+	// The Facebook App ID.
+	AppID string
+
+	// Facebook admin IDs.
+	Admins []string
+}
+
+// JSONLD holds the schema.org JSON-LD settings.
+type JSONLD struct {
+	// Disable the JSON-LD output.
+	Disable bool
+
+	// The schema.org type for regular pages. Default is BlogPosting.
+	PageType string
+
+	// The schema.org type for list pages (home, sections etc.). Default is WebPage.
+	ListType string
+
+	// The publisher of the content.
+	Publisher Organization
+}
+
+// Organization represents a schema.org Organization.
+type Organization struct {
+	Name string
+	URL  string
+	Logo string
+}
+
+var validTwitterCards = map[string]bool{
+	"":                    true,
+	"summary":             true,
+	"summary_large_image": true,
+	"app":                 true,
+	"player":              true,
+}
+
+// DecodeConfig creates a SEO Config from a given Hugo configuration.
+func DecodeConfig(cfg config.Provider) (c Config, err error) {
+	c.JSONLD.PageType = "BlogPosting"
+	c.JSONLD.ListType = "WebPage"
+
+	m := cfg.GetStringMap(seoConfigKey)
+	if m == nil {
+		return
+	}
+
+	if err = mapstructure.WeakDecode(m, &c); err != nil {
+		return
+	}
+
+	c.Twitter.Card = strings.ToLower(c.Twitter.Card)
+	if !validTwitterCards[c.Twitter.Card] {
+		err = fmt.Errorf("seo: invalid twitter card type %q", c.Twitter.Card)
+		return
+	}
+	c.Twitter.Site = strings.TrimPrefix(c.Twitter.Site, "@")
+	c.Twitter.Creator = strings.TrimPrefix(c.Twitter.Creator, "@")
+
+	err = c.Hreflang.init(cfg)
+
+	return
+}
+
+var hreflangRe = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]{2,8})*$`)
+
+func (h *Hreflang) init(cfg config.Provider) error {
+	switch strings.ToLower(h.XDefault) {
+	case "":
+		h.XDefault = strings.ToLower(cfg.GetString("defaultContentLanguage"))
+		if h.XDefault == "" {
+			h.XDefault = "en"
+		}
+	case "none":
+		h.XDefault = ""
+	default:
+		h.XDefault = strings.ToLower(h.XDefault)
+	}
+
+	locales := make(map[string][]string)
+	for lang, codes := range h.Locales {
+		if lang == maps.MergeStrategyKey {
+			continue
+		}
+		for _, code := range codes {
+			if !hreflangRe.MatchString(code) {
+				return fmt.Errorf("seo: invalid hreflang code %q for language %q, must be a language code with optional region, e.g. en-GB", code, lang)
+			}
+		}
+		locales[strings.ToLower(lang)] = codes
+	}
+	h.Locales = locales
+
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seo
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/langs"
+)
+
+func TestDecodeConfigFromTOML(t *testing.T) {
+	c := qt.New(t)
+
+	tomlConfig := `
+[seo]
+siteName = "My Site"
+images = ["site.jpg"]
+[seo.twitter]
+card = "Summary"
+site = "@hugo"
+[seo.jsonld]
+pageType = "Article"
+[seo.jsonld.publisher]
+name = "Hugo Authors"
+`
+	cfg, err := config.FromConfigString(tomlConfig, "toml")
+	c.Assert(err, qt.IsNil)
+
+	conf, err := DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+
+	c.Assert(conf.SiteName, qt.Equals, "My Site")
+	c.Assert(conf.Images, qt.DeepEquals, []string{"site.jpg"})
+	c.Assert(conf.Twitter.Card, qt.Equals, "summary")
+	c.Assert(conf.Twitter.Site, qt.Equals, "hugo")
+	c.Assert(conf.JSONLD.PageType, qt.Equals, "Article")
+	c.Assert(conf.JSONLD.ListType, qt.Equals, "WebPage")
+	c.Assert(conf.JSONLD.Publisher.Name, qt.Equals, "Hugo Authors")
+}
+
+func TestDecodeConfigInvalidTwitterCard(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := config.New()
+	cfg.Set("seo", map[string]any{"twitter": map[string]any{"card": "huge"}})
+
+	_, err := DecodeConfig(cfg)
+	c.Assert(err, qt.ErrorMatches, ".*invalid twitter card type.*")
+}
+
+func TestDecodeConfigHreflang(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := config.New()
+	cfg.Set("defaultContentLanguage", "NN")
+	conf, err := DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Hreflang.XDefault, qt.Equals, "nn")
+
+	cfg.Set("seo", map[string]any{"hreflang": map[string]any{
+		"xDefault": "None",
+		"locales":  map[string]any{"EN": []string{"en-US", "en-GB"}},
+	}})
+	conf, err = DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Hreflang.XDefault, qt.Equals, "")
+	c.Assert(conf.Hreflang.Locales, qt.DeepEquals, map[string][]string{"en": {"en-US", "en-GB"}})
+
+	en, err := langs.NewLanguage("en", "en", "UTC", langs.LanguageConfig{})
+	c.Assert(err, qt.IsNil)
+	nn, err := langs.NewLanguage("nn", "en", "UTC", langs.LanguageConfig{LanguageCode: "nn-NO"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Hreflang.Codes(en), qt.DeepEquals, []string{"en-US", "en-GB"})
+	c.Assert(conf.Hreflang.Codes(nn), qt.DeepEquals, []string{"nn-NO"})
+
+	cfg.Set("seo", map[string]any{"hreflang": map[string]any{
+		"locales": map[string]any{"en": []string{"en_US"}},
+	}})
+	_, err = DecodeConfig(cfg)
+	c.Assert(err, qt.ErrorMatches, `seo: invalid hreflang code "en_US" for language "en".*`)
+}
@@ -14,6 +14,8 @@
 package services
 
 import (
+	"strings"
+
 	"github.com/gohugoio/hugo/config"
 	"github.com/mitchellh/mapstructure"
 )
@@ -33,6 +35,12 @@ type Config struct {
 	Instagram       Instagram
 	Twitter         Twitter
 	RSS             RSS
+
+	// The built-in Atom feeds, enabled by adding "atom" to outputs.
+	Atom Feed
+
+	// The built-in JSON Feed feeds, enabled by adding "jsonfeed" to outputs.
+	JSONFeed Feed
 }
 
 // Disqus holds the functional configuration settings related to the Disqus template.
@@ -74,6 +82,33 @@ type RSS struct {
 	Limit int
 }
 
+// Feed holds the configuration settings related to the built-in Atom and
+// JSON Feed templates.
+type Feed struct {
+	// Limit the number of pages. Defaults to the RSS limit.
+	Limit int
+
+	// Include the full content of the pages instead of the summary.
+	FullContent bool
+
+	// Limit the section feeds to these sections. Empty means all.
+	Sections []string
+}
+
+// RenderSection reports whether to render a feed for the section page in
+// the given section.
+func (f Feed) RenderSection(section string) bool {
+	if len(f.Sections) == 0 {
+		return true
+	}
+	for _, s := range f.Sections {
+		if strings.EqualFold(s, section) {
+			return true
+		}
+	}
+	return false
+}
+
 // DecodeConfig creates a services Config from a given Hugo configuration.
 func DecodeConfig(cfg config.Provider) (c Config, err error) {
 	m := cfg.GetStringMap(servicesConfigKey)
@@ -93,5 +128,12 @@ func DecodeConfig(cfg config.Provider) (c Config, err error) {
 		c.RSS.Limit = cfg.GetInt(rssLimitKey)
 	}
 
+	if c.Atom.Limit == 0 {
+		c.Atom.Limit = c.RSS.Limit
+	}
+	if c.JSONFeed.Limit == 0 {
+		c.JSONFeed.Limit = c.RSS.Limit
+	}
+
 	return
 }
@@ -36,6 +36,13 @@ id = "ga_id"
 disableInlineCSS = true
 [services.twitter]
 disableInlineCSS = true
+[services.rss]
+limit = 5
+[services.atom]
+fullContent = true
+sections = ["posts"]
+[services.jsonFeed]
+limit = 10
 `
 	cfg, err := config.FromConfigString(tomlConfig, "toml")
 	c.Assert(err, qt.IsNil)
@@ -48,6 +55,13 @@ disableInlineCSS = true
 	c.Assert(config.GoogleAnalytics.ID, qt.Equals, "ga_id")
 
 	c.Assert(config.Instagram.DisableInlineCSS, qt.Equals, true)
+
+	c.Assert(config.Atom.Limit, qt.Equals, 5)
+	c.Assert(config.Atom.FullContent, qt.Equals, true)
+	c.Assert(config.Atom.RenderSection("Posts"), qt.Equals, true)
+	c.Assert(config.Atom.RenderSection("docs"), qt.Equals, false)
+	c.Assert(config.JSONFeed.Limit, qt.Equals, 10)
+	c.Assert(config.JSONFeed.RenderSection("docs"), qt.Equals, true)
 }
 
 // Support old root-level GA settings etc.
@@ -50,15 +50,46 @@ draft: true
 `
 )
 
+// Options configures the content created by NewContentWithOptions.
+type Options struct {
+	// The content type, resolved from the target path if not set.
+	Kind string
+
+	// The path to the new content file, or the new bundle if the archetype is a directory.
+	TargetPath string
+
+	// Overwrite the content if it already exists.
+	Force bool
+
+	// The archetype to use instead of the one resolved from the kind, either
+	// a file or directory name in the archetypes (including those in modules)
+	// or a URL to a single archetype file or a .zip archive of a directory.
+	Archetype string
+
+	// The values for the prompts defined in the archetype front matter.
+	Prompts map[string]string
+
+	// In and Out are used to ask for the prompt values not set in Prompts.
+	// If In is nil, the prompt defaults are used.
+	In  io.Reader
+	Out io.Writer
+}
+
 // NewContent creates a new content file in h (or a full bundle if the archetype is a directory)
 // in targetPath.
 func NewContent(h *hugolib.HugoSites, kind, targetPath string, force bool) error {
+	return NewContentWithOptions(h, Options{Kind: kind, TargetPath: targetPath, Force: force})
+}
+
+// NewContentWithOptions creates new content in h as configured in opts.
+func NewContentWithOptions(h *hugolib.HugoSites, opts Options) error {
 	if h.BaseFs.Content.Dirs == nil {
 		return errors.New("no existing content directory configured for this project")
 	}
 
 	cf := hugolib.NewContentFactory(h)
 
+	kind, targetPath := opts.Kind, opts.TargetPath
 	if kind == "" {
 		var err error
 		kind, err = cf.SectionFromFilename(targetPath)
@@ -67,21 +98,32 @@ func NewContent(h *hugolib.HugoSites, kind, targetPath string, force bool) error
 		}
 	}
 
+	if opts.Out == nil {
+		opts.Out = io.Discard
+	}
+
 	b := &contentBuilder{
 		archeTypeFs: h.PathSpec.BaseFs.Archetypes.Fs,
 		sourceFs:    h.PathSpec.Fs.Source,
 		ps:          h.PathSpec,
 		h:           h,
 		cf:          cf,
+		opts:        opts,
 
 		kind:       kind,
 		targetPath: targetPath,
-		force:      force,
+		force:      opts.Force,
 	}
 
 	ext := paths.Ext(targetPath)
 
-	b.setArcheTypeFilenameToUse(ext)
+	if opts.Archetype != "" {
+		if err := b.setArcheTypeFromOption(ext); err != nil {
+			return err
+		}
+	} else {
+		b.setArcheTypeFilenameToUse(ext)
+	}
 
 	withBuildLock := func() (string, error) {
 		unlock, err := h.BaseFs.LockBuild()
@@ -123,9 +165,10 @@ type contentBuilder struct {
 	archeTypeFs afero.Fs
 	sourceFs    afero.Fs
 
-	ps *helpers.PathSpec
-	h  *hugolib.HugoSites
-	cf hugolib.ContentFactory
+	ps   *helpers.PathSpec
+	h    *hugolib.HugoSites
+	cf   hugolib.ContentFactory
+	opts Options
 
 	// Builder state
 	archetypeFilename string
@@ -142,6 +185,14 @@ func (b *contentBuilder) buildDir() error {
 		return err
 	}
 
+	var archetypeFilenames []string
+	for _, fi := range b.dirMap.contentFiles {
+		archetypeFilenames = append(archetypeFilenames, fi.Meta().Path)
+	}
+	if err := b.askPrompts(archetypeFilenames...); err != nil {
+		return err
+	}
+
 	var contentTargetFilenames []string
 	var baseDir string
 
@@ -220,6 +271,10 @@ func (b *contentBuilder) buildDir() error {
 }
 
 func (b *contentBuilder) buildFile() (string, error) {
+	if err := b.askPrompts(b.archetypeFilename); err != nil {
+		return "", err
+	}
+
 	contentPlaceholderAbsFilename, err := b.cf.CreateContentPlaceHolder(b.targetPath, b.force)
 	if err != nil {
 		return "", err
@@ -272,6 +327,76 @@ func (b *contentBuilder) setArcheTypeFilenameToUse(ext string) {
 
 }
 
+// setArcheTypeFromOption sets the archetype given in the options,
+// downloading it first if it's a URL.
+func (b *contentBuilder) setArcheTypeFromOption(ext string) error {
+	name := b.opts.Archetype
+
+	if isRemoteArchetype(name) {
+		fs, filename, err := fetchArchetype(b.h, name)
+		if err != nil {
+			return err
+		}
+		b.archeTypeFs = fs
+		name = filename
+	}
+
+	for _, p := range []string{name, name + ext} {
+		fi, err := b.archeTypeFs.Stat(p)
+		if err == nil {
+			b.archetypeFilename = p
+			b.isDir = fi.IsDir()
+			return nil
+		}
+	}
+
+	return fmt.Errorf("archetype %q not found", b.opts.Archetype)
+}
+
+// askPrompts asks for the values of the prompts defined in the given
+// archetype files, each prompt once.
+func (b *contentBuilder) askPrompts(archetypeFilenames ...string) error {
+	var prompts []Prompt
+	seen := make(map[string]bool)
+	for _, filename := range archetypeFilenames {
+		if filename == "" {
+			continue
+		}
+		source, err := afero.ReadFile(b.archeTypeFs, filename)
+		if err != nil {
+			return fmt.Errorf("failed to read archetype file %q: %w", filename, err)
+		}
+		pp, err := parsePrompts(string(source))
+		if err != nil {
+			return fmt.Errorf("%s: %w", filename, err)
+		}
+		for _, p := range pp {
+			if !seen[p.Name] {
+				seen[p.Name] = true
+				prompts = append(prompts, p)
+			}
+		}
+	}
+
+	for name := range b.opts.Prompts {
+		if !seen[name] {
+			return fmt.Errorf("prompt %q is not defined in the archetype", name)
+		}
+	}
+
+	if len(prompts) == 0 {
+		return nil
+	}
+
+	values, err := AskPrompts(prompts, b.opts.Prompts, b.opts.In, b.opts.Out)
+	if err != nil {
+		return err
+	}
+	b.cf = b.cf.WithPrompts(values)
+
+	return nil
+}
+
 func (b *contentBuilder) applyArcheType(contentFilename, archetypeFilename string) error {
 	p := b.h.GetContentPage(contentFilename)
 	if p == nil {
@@ -288,7 +413,12 @@ func (b *contentBuilder) applyArcheType(contentFilename, archetypeFilename strin
 		return b.cf.ApplyArchetypeTemplate(f, p, b.kind, DefaultArchetypeTemplateTemplate)
 	}
 
-	return b.cf.ApplyArchetypeFilename(f, p, b.kind, archetypeFilename)
+	templateSource, err := afero.ReadFile(b.archeTypeFs, archetypeFilename)
+	if err != nil {
+		return fmt.Errorf("failed to read archetype file %q: %w", archetypeFilename, err)
+	}
+
+	return b.cf.ApplyArchetypeTemplate(f, p, b.kind, stripPrompts(string(templateSource)))
 
 }
 
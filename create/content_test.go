@@ -14,7 +14,11 @@
 package create_test
 
 import (
+	"archive/zip"
+	"bytes"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
@@ -285,6 +289,116 @@ func TestNewContentForce(t *testing.T) {
 	c.Assert(create.NewContent(h, "my-bundle", "post/my-post", true), qt.IsNil)
 }
 
+func TestNewContentWithPrompts(t *testing.T) {
+	mm := afero.NewMemMapFs()
+	c := qt.New(t)
+
+	c.Assert(afero.WriteFile(mm, filepath.Join("archetypes", "recipe.md"), []byte(`---
+title: "{{ .Name }}"
+prompts:
+  - name: author
+  - name: level
+    options: [easy, hard]
+    default: easy
+---
+Author: {{ .Prompts.author }}|Level: {{ .Prompts.level }}|
+`), 0o755), qt.IsNil)
+
+	c.Assert(initFs(mm), qt.IsNil)
+	cfg, fs := newTestCfg(c, mm)
+
+	conf := testconfig.GetTestConfigs(fs.Source, cfg)
+	h, err := hugolib.NewHugoSites(deps.DepsCfg{Configs: conf, Fs: fs})
+	c.Assert(err, qt.IsNil)
+
+	c.Assert(create.NewContentWithOptions(h, create.Options{
+		TargetPath: "post/soup.md",
+		Archetype:  "recipe",
+		In:         strings.NewReader("Jane\n\n"),
+	}), qt.IsNil)
+	content := readFileFromFs(t, fs.Source, filepath.Join("content", "post/soup.md"))
+	cContains(c, content, `title: "soup"`, "Author: Jane|Level: easy|")
+	c.Assert(content, qt.Not(qt.Contains), "prompts")
+
+	c.Assert(create.NewContentWithOptions(h, create.Options{
+		TargetPath: "post/cake.md",
+		Archetype:  "recipe",
+		Prompts:    map[string]string{"author": "John", "level": "hard"},
+	}), qt.IsNil)
+	cContains(c, readFileFromFs(t, fs.Source, filepath.Join("content", "post/cake.md")), "Author: John|Level: hard|")
+
+	err = create.NewContentWithOptions(h, create.Options{TargetPath: "post/pie.md", Archetype: "recipe"})
+	c.Assert(err, qt.ErrorMatches, `no value for prompt "author"`)
+
+	err = create.NewContentWithOptions(h, create.Options{TargetPath: "post/pie.md", Archetype: "nosuch"})
+	c.Assert(err, qt.ErrorMatches, `archetype "nosuch" not found`)
+}
+
+func TestNewContentFromRemoteArchetype(t *testing.T) {
+	mm := afero.NewMemMapFs()
+	c := qt.New(t)
+
+	var zipb bytes.Buffer
+	zw := zip.NewWriter(&zipb)
+	for name, content := range map[string]string{
+		"recipe-main/index.md": `---
+title: "{{ .Name }}"
+prompts:
+  - name: author
+---
+Author: {{ .Prompts.author }}|Site Lang: {{ site.Language.Lang }}|
+`,
+		"recipe-main/index.nn.md":      `Author: {{ .Prompts.author }}|Site Lang: {{ site.Language.Lang }}|`,
+		"recipe-main/images/cover.png": "placeholder",
+		"recipe-main/data.json":        `{"servings": 4}`,
+	} {
+		w, err := zw.Create(name)
+		c.Assert(err, qt.IsNil)
+		_, err = w.Write([]byte(content))
+		c.Assert(err, qt.IsNil)
+	}
+	c.Assert(zw.Close(), qt.IsNil)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/recipe.zip":
+			w.Write(zipb.Bytes())
+		case "/note.md":
+			w.Write([]byte("---\ntitle: \"{{ .Name | upper }}\"\n---\n"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c.Assert(initFs(mm), qt.IsNil)
+	cfg, fs := newTestCfg(c, mm)
+
+	conf := testconfig.GetTestConfigs(fs.Source, cfg)
+	h, err := hugolib.NewHugoSites(deps.DepsCfg{Configs: conf, Fs: fs})
+	c.Assert(err, qt.IsNil)
+
+	c.Assert(create.NewContentWithOptions(h, create.Options{
+		TargetPath: "post/soup",
+		Archetype:  srv.URL + "/recipe.zip",
+		Prompts:    map[string]string{"author": "Jane"},
+	}), qt.IsNil)
+
+	cContains(c, readFileFromFs(t, fs.Source, filepath.Join("content", "post/soup/index.md")), `title: "soup"`, "Author: Jane|Site Lang: en|")
+	cContains(c, readFileFromFs(t, fs.Source, filepath.Join("content", "post/soup/index.nn.md")), "Author: Jane|Site Lang: nn|")
+	cContains(c, readFileFromFs(t, fs.Source, filepath.Join("content", "post/soup/images/cover.png")), "placeholder")
+	cContains(c, readFileFromFs(t, fs.Source, filepath.Join("content", "post/soup/data.json")), `"servings": 4`)
+
+	c.Assert(create.NewContentWithOptions(h, create.Options{
+		TargetPath: "notes/first.md",
+		Archetype:  srv.URL + "/note.md",
+	}), qt.IsNil)
+	cContains(c, readFileFromFs(t, fs.Source, filepath.Join("content", "notes/first.md")), `title: "FIRST"`)
+
+	err = create.NewContentWithOptions(h, create.Options{TargetPath: "notes/second.md", Archetype: srv.URL + "/missing.md"})
+	c.Assert(err, qt.ErrorMatches, `failed to fetch archetype .*: 404 Not Found`)
+}
+
 func initFs(fs afero.Fs) error {
 	perm := os.FileMode(0o755)
 	var err error
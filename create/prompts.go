@@ -0,0 +1,224 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package create
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/gohugoio/hugo/parser/metadecoders"
+	"github.com/mitchellh/mapstructure"
+)
+
+const promptsKey = "prompts"
+
+// Prompt is a value asked for when creating new content or sites, e.g.
+// defined in the prompts list in the archetype front matter and available
+// in the archetype template as .Prompts.name.
+type Prompt struct {
+	// The name of the value in .Prompts.
+	Name string
+
+	// The message shown, defaults to the name.
+	Message string
+
+	// The value used when nothing is entered.
+	Default string
+
+	// If set, the value must be one of these.
+	Options []string
+}
+
+func (p Prompt) isValid(v string) bool {
+	if len(p.Options) == 0 {
+		return true
+	}
+	for _, o := range p.Options {
+		if o == v {
+			return true
+		}
+	}
+	return false
+}
+
+func (p Prompt) String() string {
+	s := p.Message
+	if s == "" {
+		s = p.Name
+	}
+	if len(p.Options) > 0 {
+		s += " (" + strings.Join(p.Options, "/") + ")"
+	}
+	if p.Default != "" {
+		s += " [" + p.Default + "]"
+	}
+	return s + ": "
+}
+
+// The template actions are replaced before decoding the front matter,
+// so the prompts themselves cannot use them.
+var templateActionRe = regexp.MustCompile(`(?s){{.*?}}`)
+
+// archetypeFrontMatter returns the front matter block of the archetype
+// template source and its format, YAML and TOML only.
+func archetypeFrontMatter(source string) (start, end int, format metadecoders.Format) {
+	var delim string
+	switch {
+	case strings.HasPrefix(source, "---\n") || strings.HasPrefix(source, "---\r\n"):
+		delim, format = "---", metadecoders.YAML
+	case strings.HasPrefix(source, "+++\n") || strings.HasPrefix(source, "+++\r\n"):
+		delim, format = "+++", metadecoders.TOML
+	default:
+		return 0, 0, ""
+	}
+	start = strings.Index(source, "\n") + 1
+	end = strings.Index(source[start:], "\n"+delim)
+	if end == -1 {
+		return 0, 0, ""
+	}
+	return start, start + end + 1, format
+}
+
+// parsePrompts returns the prompts defined in the front matter of the
+// archetype template source.
+func parsePrompts(source string) ([]Prompt, error) {
+	start, end, format := archetypeFrontMatter(source)
+	if format == "" {
+		return nil, nil
+	}
+	fm := source[start:end]
+	if !strings.Contains(fm, promptsKey) {
+		return nil, nil
+	}
+
+	m, err := metadecoders.Default.UnmarshalToMap([]byte(templateActionRe.ReplaceAllString(fm, "0")), format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode archetype front matter: %w", err)
+	}
+	v, found := m[promptsKey]
+	if !found {
+		return nil, nil
+	}
+
+	var prompts []Prompt
+	if err := mapstructure.WeakDecode(v, &prompts); err != nil {
+		return nil, fmt.Errorf("failed to decode archetype prompts: %w", err)
+	}
+	for _, p := range prompts {
+		if p.Name == "" {
+			return nil, errors.New("archetype prompt without a name")
+		}
+		if p.Default != "" && !p.isValid(p.Default) {
+			return nil, fmt.Errorf("archetype prompt %q: default %q is not one of the options", p.Name, p.Default)
+		}
+	}
+
+	return prompts, nil
+}
+
+// stripPrompts removes the prompts from the front matter of the archetype
+// template source, so they do not end up in the new content file.
+func stripPrompts(source string) string {
+	start, end, format := archetypeFrontMatter(source)
+	if format == "" {
+		return source
+	}
+
+	lines := strings.SplitAfter(source[start:end], "\n")
+	var kept []string
+	inPrompts := false
+
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if format == metadecoders.YAML {
+			if strings.HasPrefix(line, promptsKey+":") {
+				inPrompts = true
+				continue
+			}
+			if inPrompts && (trimmed == "" || line[0] == ' ' || line[0] == '\t' || strings.HasPrefix(line, "-")) {
+				continue
+			}
+		} else {
+			if strings.HasPrefix(trimmed, "[") {
+				header := strings.TrimLeft(trimmed, "[")
+				inPrompts = header == promptsKey+"]" || header == promptsKey+"]]" || strings.HasPrefix(header, promptsKey+".")
+			} else if !inPrompts && strings.HasPrefix(strings.ReplaceAll(trimmed, " ", ""), promptsKey+"=") {
+				continue
+			}
+			if inPrompts {
+				continue
+			}
+		}
+		inPrompts = false
+		kept = append(kept, line)
+	}
+
+	return source[:start] + strings.Join(kept, "") + source[end:]
+}
+
+// AskPrompts returns the values of prompts, taken from values if set,
+// else read from in. If in is nil, the defaults are used.
+func AskPrompts(prompts []Prompt, values map[string]string, in io.Reader, out io.Writer) (map[string]any, error) {
+	result := make(map[string]any)
+	var r *bufio.Reader
+	if in != nil {
+		r = bufio.NewReader(in)
+	}
+
+	for _, p := range prompts {
+		if v, found := values[p.Name]; found {
+			if !p.isValid(v) {
+				return nil, fmt.Errorf("invalid value %q for prompt %q, must be one of %s", v, p.Name, strings.Join(p.Options, ", "))
+			}
+			result[p.Name] = v
+			continue
+		}
+
+		for {
+			var v string
+			var eof bool
+			if r != nil {
+				fmt.Fprint(out, p)
+				line, err := r.ReadString('\n')
+				if err != nil && err != io.EOF {
+					return nil, err
+				}
+				eof = err == io.EOF
+				v = strings.TrimSpace(line)
+			}
+			if v == "" {
+				v = p.Default
+			}
+			if v == "" && (r == nil || eof) {
+				return nil, fmt.Errorf("no value for prompt %q", p.Name)
+			}
+			if v != "" && p.isValid(v) {
+				result[p.Name] = v
+				break
+			}
+			if eof {
+				return nil, fmt.Errorf("invalid value %q for prompt %q, must be one of %s", v, p.Name, strings.Join(p.Options, ", "))
+			}
+			if v != "" {
+				fmt.Fprintf(out, "Must be one of %s\n", strings.Join(p.Options, ", "))
+			}
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package create
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+const yamlArchetype = `---
+title: "{{ replace .Name "-" " " | title }}"
+date: {{ .Date }}
+prompts:
+  - name: author
+    message: Author name
+  - name: level
+    options: [easy, hard]
+    default: easy
+draft: true
+---
+
+By {{ .Prompts.author }}.
+`
+
+const tomlArchetype = `+++
+title = "{{ .Name }}"
+[[prompts]]
+name = "author"
+[[prompts]]
+name = "level"
+options = ["easy", "hard"]
++++
+`
+
+func TestParsePrompts(t *testing.T) {
+	c := qt.New(t)
+
+	expect := []Prompt{
+		{Name: "author", Message: "Author name"},
+		{Name: "level", Options: []string{"easy", "hard"}, Default: "easy"},
+	}
+
+	prompts, err := parsePrompts(yamlArchetype)
+	c.Assert(err, qt.IsNil)
+	c.Assert(prompts, qt.DeepEquals, expect)
+
+	prompts, err = parsePrompts(tomlArchetype)
+	c.Assert(err, qt.IsNil)
+	c.Assert(prompts, qt.HasLen, 2)
+	c.Assert(prompts[1].Options, qt.DeepEquals, []string{"easy", "hard"})
+
+	prompts, err = parsePrompts("---\ntitle: foo\n---\n")
+	c.Assert(err, qt.IsNil)
+	c.Assert(prompts, qt.IsNil)
+
+	_, err = parsePrompts("---\nprompts:\n  - message: foo\n---\n")
+	c.Assert(err, qt.ErrorMatches, "archetype prompt without a name")
+}
+
+func TestStripPrompts(t *testing.T) {
+	c := qt.New(t)
+
+	c.Assert(stripPrompts(yamlArchetype), qt.Equals, `---
+title: "{{ replace .Name "-" " " | title }}"
+date: {{ .Date }}
+draft: true
+---
+
+By {{ .Prompts.author }}.
+`)
+
+	c.Assert(stripPrompts(tomlArchetype), qt.Equals, "+++\ntitle = \"{{ .Name }}\"\n+++\n")
+
+	noPrompts := "---\ntitle: foo\n---\nprompts: not front matter\n"
+	c.Assert(stripPrompts(noPrompts), qt.Equals, noPrompts)
+}
+
+func TestAskPrompts(t *testing.T) {
+	c := qt.New(t)
+
+	prompts, err := parsePrompts(yamlArchetype)
+	c.Assert(err, qt.IsNil)
+
+	var out bytes.Buffer
+	values, err := AskPrompts(prompts, nil, strings.NewReader("Jane\nmedium\nhard\n"), &out)
+	c.Assert(err, qt.IsNil)
+	c.Assert(values, qt.DeepEquals, map[string]any{"author": "Jane", "level": "hard"})
+	c.Assert(out.String(), qt.Equals, "Author name: level (easy/hard) [easy]: Must be one of easy, hard\nlevel (easy/hard) [easy]: ")
+
+	values, err = AskPrompts(prompts, map[string]string{"author": "John"}, nil, &out)
+	c.Assert(err, qt.IsNil)
+	c.Assert(values, qt.DeepEquals, map[string]any{"author": "John", "level": "easy"})
+
+	_, err = AskPrompts(prompts, nil, nil, &out)
+	c.Assert(err, qt.ErrorMatches, `no value for prompt "author"`)
+
+	_, err = AskPrompts(prompts, map[string]string{"author": "John", "level": "medium"}, nil, &out)
+	c.Assert(err, qt.ErrorMatches, `invalid value "medium" for prompt "level", must be one of easy, hard`)
+}
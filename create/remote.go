@@ -0,0 +1,168 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package create
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gohugoio/hugo/hugofs"
+	"github.com/gohugoio/hugo/hugofs/files"
+	"github.com/gohugoio/hugo/hugolib"
+	"github.com/spf13/afero"
+)
+
+// The directory in the in-memory filesystem the remote archetypes are stored in.
+const remoteArchetypeDir = "remote"
+
+const (
+	// The max size of a remote archetype, archive or file, and the max
+	// total size of the files extracted from an archive.
+	remoteArchetypeMaxSize = 32 << 20
+
+	// The max number of files extracted from an archive.
+	remoteArchetypeMaxFiles = 1000
+)
+
+func isRemoteArchetype(s string) bool {
+	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
+}
+
+// fetchArchetype downloads the archetype at rawURL into an in-memory
+// filesystem, either a single archetype file or, for a .zip archive, an
+// archetype directory. It returns the filesystem and the archetype filename.
+func fetchArchetype(h *hugolib.HugoSites, rawURL string) (afero.Fs, string, error) {
+	if err := h.Deps.ExecHelper.Sec().CheckAllowedHTTPURL(rawURL); err != nil {
+		return nil, "", err
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to parse archetype URL %q: %w", rawURL, err)
+	}
+
+	client := &http.Client{Timeout: time.Minute}
+	res, err := client.Get(rawURL)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to fetch archetype %q: %w", rawURL, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, "", fmt.Errorf("failed to fetch archetype %q: %s", rawURL, res.Status)
+	}
+
+	b, err := io.ReadAll(io.LimitReader(res.Body, remoteArchetypeMaxSize+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to fetch archetype %q: %w", rawURL, err)
+	}
+	if len(b) > remoteArchetypeMaxSize {
+		return nil, "", fmt.Errorf("archetype %q is larger than %d bytes", rawURL, remoteArchetypeMaxSize)
+	}
+
+	fs := afero.NewMemMapFs()
+	base := path.Base(u.Path)
+
+	if strings.EqualFold(path.Ext(base), ".zip") {
+		if err := unzipArchetype(fs, b); err != nil {
+			return nil, "", fmt.Errorf("failed to extract archetype %q: %w", rawURL, err)
+		}
+		return hugofs.NewBaseFileDecorator(fs), remoteArchetypeDir, nil
+	}
+
+	if !files.IsContentFile(base) {
+		return nil, "", fmt.Errorf("archetype %q must be a content file or a .zip archive", rawURL)
+	}
+
+	filename := filepath.Join(remoteArchetypeDir, base)
+	if err := afero.WriteFile(fs, filename, b, 0o666); err != nil {
+		return nil, "", err
+	}
+
+	return hugofs.NewBaseFileDecorator(fs), filename, nil
+}
+
+// unzipArchetype extracts the zip archive b into remoteArchetypeDir in fs.
+// If all files are inside one top level directory, e.g. in the archives
+// created by GitHub, that directory is removed from the paths.
+func unzipArchetype(fs afero.Fs, b []byte) error {
+	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		return err
+	}
+
+	var prefix string
+	for i, f := range zr.File {
+		dir, _, found := strings.Cut(f.Name, "/")
+		if !found {
+			prefix = ""
+			break
+		}
+		if i == 0 {
+			prefix = dir + "/"
+		} else if dir+"/" != prefix {
+			prefix = ""
+			break
+		}
+	}
+
+	var (
+		numFiles int
+		size     int64
+	)
+
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+
+		numFiles++
+		if numFiles > remoteArchetypeMaxFiles {
+			return fmt.Errorf("archive has more than %d files", remoteArchetypeMaxFiles)
+		}
+
+		filename := filepath.Join(remoteArchetypeDir, filepath.FromSlash(strings.TrimPrefix(f.Name, prefix)))
+		rel, err := filepath.Rel(remoteArchetypeDir, filename)
+		if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) || path.IsAbs(f.Name) {
+			return fmt.Errorf("invalid file name %q in archive", f.Name)
+		}
+
+		r, err := f.Open()
+		if err != nil {
+			return err
+		}
+		// Count the bytes actually extracted, the sizes in the archive
+		// headers may not be correct.
+		lr := &io.LimitedReader{R: r, N: remoteArchetypeMaxSize - size + 1}
+		err = afero.WriteReader(fs, filename, lr)
+		r.Close()
+		if err != nil {
+			return err
+		}
+		size = remoteArchetypeMaxSize + 1 - lr.N
+		if size > remoteArchetypeMaxSize {
+			return fmt.Errorf("archive is larger than %d bytes uncompressed", remoteArchetypeMaxSize)
+		}
+	}
+
+	return nil
+}
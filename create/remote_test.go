@@ -0,0 +1,65 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package create
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/spf13/afero"
+)
+
+func createTestZip(c *qt.C, files map[string]string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		c.Assert(err, qt.IsNil)
+		_, err = w.Write([]byte(content))
+		c.Assert(err, qt.IsNil)
+	}
+	c.Assert(zw.Close(), qt.IsNil)
+	return buf.Bytes()
+}
+
+func TestUnzipArchetype(t *testing.T) {
+	c := qt.New(t)
+
+	fs := afero.NewMemMapFs()
+	b := createTestZip(c, map[string]string{"repo-main/index.md": "index", "repo-main/images/a.png": "png"})
+	c.Assert(unzipArchetype(fs, b), qt.IsNil)
+	content, err := afero.ReadFile(fs, filepath.Join(remoteArchetypeDir, "images", "a.png"))
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(content), qt.Equals, "png")
+
+	for _, name := range []string{"../evil.md", "a/../../evil.md", "/evil.md", "a/.."} {
+		b := createTestZip(c, map[string]string{name: "evil", "b/index.md": "index"})
+		c.Assert(unzipArchetype(afero.NewMemMapFs(), b), qt.ErrorMatches, "invalid file name.*", qt.Commentf(name))
+	}
+
+	files := make(map[string]string)
+	for i := 0; i <= remoteArchetypeMaxFiles; i++ {
+		files[fmt.Sprintf("f%d.md", i)] = ""
+	}
+	c.Assert(unzipArchetype(afero.NewMemMapFs(), createTestZip(c, files)), qt.ErrorMatches, "archive has more than 1000 files")
+
+	large := strings.Repeat("a", remoteArchetypeMaxSize/2+1)
+	b = createTestZip(c, map[string]string{"a.md": large, "b.md": large})
+	c.Assert(unzipArchetype(afero.NewMemMapFs(), b), qt.ErrorMatches, "archive is larger than .* bytes uncompressed")
+}
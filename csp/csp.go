@@ -0,0 +1,420 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package csp generates a Content-Security-Policy for the published files
+// from the inline scripts and styles and the external origins they use.
+package csp
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// The directives in the generated policy, in output order.
+const (
+	DirectiveDefault    = "default-src"
+	DirectiveScript     = "script-src"
+	DirectiveStyle      = "style-src"
+	DirectiveImg        = "img-src"
+	DirectiveFont       = "font-src"
+	DirectiveMedia      = "media-src"
+	DirectiveFrame      = "frame-src"
+	DirectiveManifest   = "manifest-src"
+	DirectiveObject     = "object-src"
+	DirectiveBaseURI    = "base-uri"
+	DirectiveFormAction = "form-action"
+)
+
+var directives = []string{
+	DirectiveDefault,
+	DirectiveScript,
+	DirectiveStyle,
+	DirectiveImg,
+	DirectiveFont,
+	DirectiveMedia,
+	DirectiveFrame,
+	DirectiveManifest,
+	DirectiveObject,
+	DirectiveBaseURI,
+	DirectiveFormAction,
+}
+
+const (
+	sourceSelf         = "'self'"
+	sourceNone         = "'none'"
+	sourceUnsafeHashes = "'unsafe-hashes'"
+)
+
+// The script types that are executed by the browser; data blocks such as
+// application/ld+json are not subject to the policy.
+var scriptTypes = map[string]bool{
+	"":                       true,
+	"module":                 true,
+	"text/javascript":        true,
+	"application/javascript": true,
+}
+
+var (
+	cssURLRe    = regexp.MustCompile(`url\(\s*['"]?([^'")\s]+)['"]?\s*\)`)
+	cssImportRe = regexp.MustCompile(`@import\s+['"]([^'"]+)['"]`)
+
+	fontSuffixes = map[string]bool{
+		"woff":  true,
+		"woff2": true,
+		"ttf":   true,
+		"otf":   true,
+		"eot":   true,
+	}
+)
+
+// Collector collects the sources used in the published files.
+// It is not safe for concurrent use.
+type Collector struct {
+	self    string
+	sources map[string]map[string]bool
+}
+
+// NewCollector creates a new Collector. URLs with the same origin as
+// baseURL are allowed as 'self'.
+func NewCollector(baseURL string) *Collector {
+	self := Origin(baseURL)
+	if self == sourceSelf {
+		self = ""
+	}
+	return &Collector{self: self, sources: make(map[string]map[string]bool)}
+}
+
+func (c *Collector) add(directive, source string) {
+	if source == "" {
+		return
+	}
+	m, found := c.sources[directive]
+	if !found {
+		m = make(map[string]bool)
+		c.sources[directive] = m
+	}
+	m[source] = true
+}
+
+func (c *Collector) addURL(directive, s string) {
+	origin := Origin(s)
+	if origin == c.self {
+		origin = sourceSelf
+	}
+	c.add(directive, origin)
+}
+
+// AddFile collects the sources used in the published file filename.
+// HTML files are parsed for inline scripts and styles and external resources,
+// stylesheets for external fonts, images and imports. Other files are ignored.
+func (c *Collector) AddFile(filename string, b []byte) {
+	switch strings.ToLower(path.Ext(filename)) {
+	case ".html", ".htm":
+		c.AddHTML(b)
+	case ".css":
+		c.AddCSS(string(b))
+	}
+}
+
+// AddHTML collects the sources used in the HTML document b.
+func (c *Collector) AddHTML(b []byte) {
+	z := html.NewTokenizer(strings.NewReader(string(b)))
+
+	var (
+		inScript bool
+		inStyle  bool
+		inMedia  int
+	)
+
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			return
+		case html.TextToken:
+			text := string(z.Text())
+			if inScript && strings.TrimSpace(text) != "" {
+				c.add(DirectiveScript, Hash(text))
+			} else if inStyle && strings.TrimSpace(text) != "" {
+				c.add(DirectiveStyle, Hash(text))
+				c.AddCSS(text)
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			switch string(name) {
+			case "script":
+				inScript = false
+			case "style":
+				inStyle = false
+			case "video", "audio":
+				if inMedia > 0 {
+					inMedia--
+				}
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, _ := z.TagName()
+			tag := string(name)
+			attrs := attributes(z)
+			selfClosing := tt == html.SelfClosingTagToken
+
+			for k, v := range attrs {
+				switch {
+				case k == "style" && strings.TrimSpace(v) != "":
+					c.add(DirectiveStyle, sourceUnsafeHashes)
+					c.add(DirectiveStyle, Hash(v))
+				case strings.HasPrefix(k, "on") && strings.TrimSpace(v) != "":
+					c.add(DirectiveScript, sourceUnsafeHashes)
+					c.add(DirectiveScript, Hash(v))
+				}
+			}
+
+			switch tag {
+			case "script":
+				if src, found := attrs["src"]; found {
+					c.addURL(DirectiveScript, src)
+				} else if scriptTypes[strings.ToLower(attrs["type"])] && !selfClosing {
+					inScript = true
+				}
+			case "style":
+				inStyle = !selfClosing
+			case "link":
+				c.addLink(attrs)
+			case "img":
+				c.addURL(DirectiveImg, attrs["src"])
+				c.addSrcset(DirectiveImg, attrs["srcset"])
+			case "source":
+				if inMedia > 0 {
+					c.addURL(DirectiveMedia, attrs["src"])
+				} else {
+					c.addURL(DirectiveImg, attrs["src"])
+					c.addSrcset(DirectiveImg, attrs["srcset"])
+				}
+			case "video", "audio":
+				c.addURL(DirectiveMedia, attrs["src"])
+				if tag == "video" {
+					c.addURL(DirectiveImg, attrs["poster"])
+				}
+				if !selfClosing {
+					inMedia++
+				}
+			case "track":
+				c.addURL(DirectiveMedia, attrs["src"])
+			case "iframe":
+				c.addURL(DirectiveFrame, attrs["src"])
+			case "form":
+				c.addURL(DirectiveFormAction, attrs["action"])
+			}
+		}
+	}
+}
+
+func (c *Collector) addLink(attrs map[string]string) {
+	href := attrs["href"]
+	for _, rel := range strings.Fields(strings.ToLower(attrs["rel"])) {
+		switch rel {
+		case "stylesheet":
+			c.addURL(DirectiveStyle, href)
+		case "icon", "apple-touch-icon":
+			c.addURL(DirectiveImg, href)
+		case "manifest":
+			c.addURL(DirectiveManifest, href)
+		case "preload", "modulepreload":
+			switch attrs["as"] {
+			case "script":
+				c.addURL(DirectiveScript, href)
+			case "style":
+				c.addURL(DirectiveStyle, href)
+			case "font":
+				c.addURL(DirectiveFont, href)
+			case "image":
+				c.addURL(DirectiveImg, href)
+			default:
+				if rel == "modulepreload" {
+					c.addURL(DirectiveScript, href)
+				}
+			}
+		}
+	}
+}
+
+func (c *Collector) addSrcset(directive, srcset string) {
+	for _, candidate := range strings.Split(srcset, ",") {
+		if fields := strings.Fields(candidate); len(fields) > 0 {
+			c.addURL(directive, fields[0])
+		}
+	}
+}
+
+// AddCSS collects the external fonts, images and imports used in the stylesheet s.
+func (c *Collector) AddCSS(s string) {
+	for _, m := range cssImportRe.FindAllStringSubmatch(s, -1) {
+		c.addURL(DirectiveStyle, m[1])
+	}
+	for _, m := range cssURLRe.FindAllStringSubmatch(s, -1) {
+		u := m[1]
+		directive := DirectiveImg
+		if fontSuffixes[strings.TrimPrefix(strings.ToLower(path.Ext(stripQuery(u))), ".")] {
+			directive = DirectiveFont
+		}
+		c.addURL(directive, u)
+	}
+}
+
+func stripQuery(s string) string {
+	if i := strings.IndexAny(s, "?#"); i != -1 {
+		return s[:i]
+	}
+	return s
+}
+
+func attributes(z *html.Tokenizer) map[string]string {
+	attrs := make(map[string]string)
+	for {
+		k, v, more := z.TagAttr()
+		if len(k) > 0 {
+			attrs[string(k)] = string(v)
+		}
+		if !more {
+			return attrs
+		}
+	}
+}
+
+// Policy returns the policy allowing the collected sources.
+// All directives allow 'self', object-src is 'none'.
+func (c *Collector) Policy() Policy {
+	p := Policy{
+		DirectiveDefault: {sourceSelf},
+		DirectiveObject:  {sourceNone},
+		DirectiveBaseURI: {sourceSelf},
+	}
+
+	for directive, m := range c.sources {
+		sources := make([]string, 0, len(m))
+		for s := range m {
+			if s != sourceSelf {
+				sources = append(sources, s)
+			}
+		}
+		sort.Slice(sources, func(i, j int) bool {
+			// Keywords first, then the hosts and then the hashes.
+			ri, rj := sourceRank(sources[i]), sourceRank(sources[j])
+			if ri != rj {
+				return ri < rj
+			}
+			return sources[i] < sources[j]
+		})
+		p[directive] = append([]string{sourceSelf}, sources...)
+	}
+
+	return p
+}
+
+func sourceRank(s string) int {
+	switch {
+	case strings.HasPrefix(s, "'sha256-"):
+		return 2
+	case strings.HasPrefix(s, "'"):
+		return 0
+	default:
+		return 1
+	}
+}
+
+// Policy maps directives to their sources.
+type Policy map[string][]string
+
+// String returns p formatted as a Content-Security-Policy header value.
+func (p Policy) String() string {
+	var parts []string
+	for _, d := range directives {
+		if sources, found := p[d]; found {
+			parts = append(parts, d+" "+strings.Join(sources, " "))
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+// MetaTag returns p as a meta tag to use in the head of the HTML documents.
+func (p Policy) MetaTag() string {
+	// The sources are quoted with single quotes, which are fine in a double quoted attribute.
+	content := strings.NewReplacer("&", "&amp;", `"`, "&quot;").Replace(p.String())
+	return fmt.Sprintf(`<meta http-equiv="Content-Security-Policy" content="%s">`, content)
+}
+
+// ServerHeaders returns p as server.headers configuration for all files
+// served below basePath, e.g. "/" or "/docs/".
+func (p Policy) ServerHeaders(basePath string) map[string]any {
+	basePath = "/" + strings.Trim(basePath, "/") + "/"
+	if basePath == "//" {
+		basePath = "/"
+	}
+	return map[string]any{
+		"server": map[string]any{
+			"headers": []map[string]any{
+				{
+					"for": basePath + "**",
+					"values": map[string]any{
+						"Content-Security-Policy": p.String(),
+					},
+				},
+			},
+		},
+	}
+}
+
+// Hash returns the CSP hash source of the inline script or style s.
+func Hash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return "'sha256-" + base64.StdEncoding.EncodeToString(sum[:]) + "'"
+}
+
+// Origin returns the CSP source expression for the URL s, e.g.
+// https://cdn.example.org for https://cdn.example.org/lib.js, data: for a data URL
+// and 'self' for relative URLs.
+func Origin(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return ""
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return ""
+	}
+	switch u.Scheme {
+	case "":
+		if u.Host != "" {
+			// Protocol relative, use the scheme of the document.
+			return u.Host
+		}
+		return sourceSelf
+	case "http", "https", "ws", "wss":
+		if u.Host == "" {
+			return ""
+		}
+		return u.Scheme + "://" + u.Host
+	case "data", "blob":
+		return u.Scheme + ":"
+	default:
+		// E.g. mailto: and javascript: URLs.
+		return ""
+	}
+}
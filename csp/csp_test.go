@@ -0,0 +1,128 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csp
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestOrigin(t *testing.T) {
+	c := qt.New(t)
+
+	for _, test := range []struct {
+		in     string
+		expect string
+	}{
+		{"https://cdn.example.org/lib.js", "https://cdn.example.org"},
+		{"http://example.org:8080/a.png", "http://example.org:8080"},
+		{"//fonts.example.org/a.woff2", "fonts.example.org"},
+		{"/css/main.css", "'self'"},
+		{"main.css", "'self'"},
+		{"data:image/png;base64,abc", "data:"},
+		{"mailto:a@example.org", ""},
+		{"javascript:void(0)", ""},
+		{"", ""},
+	} {
+		c.Assert(Origin(test.in), qt.Equals, test.expect, qt.Commentf(test.in))
+	}
+}
+
+func TestHash(t *testing.T) {
+	c := qt.New(t)
+
+	// echo -n "alert('Hello, world.');" | openssl sha256 -binary | openssl base64
+	c.Assert(Hash("alert('Hello, world.');"), qt.Equals, "'sha256-qznLcsROx4GACP2dm0UCKCzCG+HiZ1guq6ZZDob/Tng='")
+}
+
+func TestCollector(t *testing.T) {
+	c := qt.New(t)
+
+	collector := NewCollector("https://example.org/docs/")
+	collector.AddFile("index.html", []byte(`<!DOCTYPE html>
+<html>
+<head>
+<link rel="stylesheet" href="https://example.org/docs/css/main.css">
+<link rel="stylesheet" href="https://fonts.example.com/css?family=Foo">
+<link rel="preload" href="https://fonts.example.com/foo.woff2" as="font">
+<link rel="icon" href="/favicon.ico">
+<script src="https://cdn.example.com/lib.js"></script>
+<script>alert('Hello, world.');</script>
+<script type="application/ld+json">{"@context": "https://schema.org"}</script>
+<style>body { background: url(https://img.example.com/bg.png); }</style>
+</head>
+<body>
+<img src="data:image/png;base64,abc" srcset="https://img.example.com/a.png 1x, /b.png 2x">
+<p style="color: red" onclick="go()">Hi</p>
+<video poster="https://img.example.com/poster.jpg"><source src="https://media.example.com/v.mp4"></video>
+<iframe src="https://www.youtube-nocookie.com/embed/abc"></iframe>
+<form action="https://forms.example.com/submit"></form>
+<a href="https://example.com/">Link</a>
+</body>
+</html>`))
+	collector.AddFile("css/main.css", []byte(`@import "https://fonts.example.net/more.css";
+@font-face { src: url("https://fonts.example.net/a.woff2?v=1") format("woff2"); }`))
+	collector.AddFile("main.js", []byte(`var a = "https://ignored.example.com";`))
+
+	policy := collector.Policy()
+
+	c.Assert(policy[DirectiveDefault], qt.DeepEquals, []string{"'self'"})
+	c.Assert(policy[DirectiveScript], qt.DeepEquals, []string{
+		"'self'",
+		"'unsafe-hashes'",
+		"https://cdn.example.com",
+		Hash("go()"),
+		Hash("alert('Hello, world.');"),
+	})
+	c.Assert(policy[DirectiveStyle], qt.DeepEquals, []string{
+		"'self'",
+		"'unsafe-hashes'",
+		"https://fonts.example.com",
+		"https://fonts.example.net",
+		Hash("color: red"),
+		Hash("body { background: url(https://img.example.com/bg.png); }"),
+	})
+	c.Assert(policy[DirectiveImg], qt.DeepEquals, []string{"'self'", "data:", "https://img.example.com"})
+	c.Assert(policy[DirectiveFont], qt.DeepEquals, []string{"'self'", "https://fonts.example.com", "https://fonts.example.net"})
+	c.Assert(policy[DirectiveMedia], qt.DeepEquals, []string{"'self'", "https://media.example.com"})
+	c.Assert(policy[DirectiveFrame], qt.DeepEquals, []string{"'self'", "https://www.youtube-nocookie.com"})
+	c.Assert(policy[DirectiveFormAction], qt.DeepEquals, []string{"'self'", "https://forms.example.com"})
+	c.Assert(policy[DirectiveObject], qt.DeepEquals, []string{"'none'"})
+}
+
+func TestPolicyFormat(t *testing.T) {
+	c := qt.New(t)
+
+	collector := NewCollector("https://example.org/")
+	collector.AddHTML([]byte(`<script src="https://cdn.example.com/lib.js"></script><img src="/a.png">`))
+	policy := collector.Policy()
+
+	const expect = "default-src 'self'; script-src 'self' https://cdn.example.com; img-src 'self'; object-src 'none'; base-uri 'self'"
+
+	c.Assert(policy.String(), qt.Equals, expect)
+	c.Assert(policy.MetaTag(), qt.Equals, `<meta http-equiv="Content-Security-Policy" content="`+expect+`">`)
+	c.Assert(policy.ServerHeaders("/docs"), qt.DeepEquals, map[string]any{
+		"server": map[string]any{
+			"headers": []map[string]any{
+				{
+					"for": "/docs/**",
+					"values": map[string]any{
+						"Content-Security-Policy": expect,
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,391 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nodeploy
+// +build !nodeploy
+
+package deploy
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/driver"
+	"gocloud.dev/gcerrors"
+)
+
+const (
+	// bunnyScheme is the URL scheme of Bunny Storage targets, e.g.
+	// bunny://<storage zone>?endpoint=ny.storage.bunnycdn.com.
+	bunnyScheme = "bunny"
+
+	bunnyDefaultEndpoint = "storage.bunnycdn.com"
+
+	// The environment variable holding the storage zone password.
+	bunnyStorageAccessKeyEnv = "BUNNY_STORAGE_ACCESS_KEY"
+
+	// The environment variable holding the account API key used to purge
+	// the pull zone cache.
+	bunnyAPIKeyEnv = "BUNNY_API_KEY"
+)
+
+// bunnyAPIURL is the base URL of the Bunny API. Variable for testing.
+var bunnyAPIURL = "https://api.bunny.net"
+
+func init() {
+	blob.DefaultURLMux().RegisterBucket(bunnyScheme, bunnyURLOpener{})
+}
+
+// sha256Checksum is the SHA-256 checksum of a remote file, provided via
+// ListObject.As by providers that don't provide an MD5 hash.
+type sha256Checksum []byte
+
+// remoteSHA256 returns the SHA-256 checksum of obj, if provided.
+func remoteSHA256(obj *blob.ListObject) ([]byte, bool) {
+	var sum sha256Checksum
+	if obj.As(&sum) && len(sum) > 0 {
+		return sum, true
+	}
+	return nil, false
+}
+
+type bunnyURLOpener struct{}
+
+// OpenBucketURL opens a Bunny Storage zone.
+// The storage zone password is read from the BUNNY_STORAGE_ACCESS_KEY
+// environment variable.
+func (bunnyURLOpener) OpenBucketURL(ctx context.Context, u *url.URL) (*blob.Bucket, error) {
+	accessKey := os.Getenv(bunnyStorageAccessKeyEnv)
+	if accessKey == "" {
+		return nil, fmt.Errorf("open bucket %v: %s is not set", u, bunnyStorageAccessKeyEnv)
+	}
+	endpoint := bunnyDefaultEndpoint
+	for k, v := range u.Query() {
+		switch k {
+		case "endpoint":
+			endpoint = v[0]
+		default:
+			return nil, fmt.Errorf("open bucket %v: invalid query parameter %q", u, k)
+		}
+	}
+	return openBunnyBucket(u.Host, endpoint, accessKey)
+}
+
+func openBunnyBucket(zone, endpoint, accessKey string) (*blob.Bucket, error) {
+	if zone == "" {
+		return nil, errors.New("bunny: storage zone name is required")
+	}
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	return blob.NewBucket(&bunnyBucket{
+		endpoint:  strings.TrimSuffix(endpoint, "/"),
+		zone:      zone,
+		accessKey: accessKey,
+		client:    &http.Client{},
+	}), nil
+}
+
+// bunnyBucket is a gocloud.dev blob driver for Bunny Storage, see
+// https://docs.bunny.net/reference/storage-api.
+// Bunny Storage doesn't store any metadata, so Cache-Control and
+// Content-Type headers must be configured on the pull zone.
+type bunnyBucket struct {
+	endpoint  string
+	zone      string
+	accessKey string
+	client    *http.Client
+}
+
+// bunnyObject is an object in a Bunny Storage directory listing.
+type bunnyObject struct {
+	ObjectName  string
+	Path        string
+	Length      int64
+	LastChanged string
+	IsDirectory bool
+	Checksum    string
+}
+
+func (o bunnyObject) modTime() time.Time {
+	t, _ := time.Parse("2006-01-02T15:04:05.999", o.LastChanged)
+	return t
+}
+
+type bunnyError struct {
+	status int
+	msg    string
+}
+
+func (e *bunnyError) Error() string {
+	if e.msg == "" {
+		return fmt.Sprintf("bunny: %s", http.StatusText(e.status))
+	}
+	return fmt.Sprintf("bunny: %s: %s", http.StatusText(e.status), e.msg)
+}
+
+func (b *bunnyBucket) objectURL(key string) string {
+	parts := strings.Split(key, "/")
+	for i, p := range parts {
+		parts[i] = url.PathEscape(p)
+	}
+	return b.endpoint + "/" + url.PathEscape(b.zone) + "/" + strings.Join(parts, "/")
+}
+
+func (b *bunnyBucket) do(ctx context.Context, method, key string, body io.Reader, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, b.objectURL(key), body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("AccessKey", b.accessKey)
+	res, err := b.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		defer res.Body.Close()
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return nil, &bunnyError{status: res.StatusCode, msg: strings.TrimSpace(string(msg))}
+	}
+	return res, nil
+}
+
+// list lists the directory dir, which must be empty or end with a slash.
+func (b *bunnyBucket) list(ctx context.Context, dir string) ([]bunnyObject, error) {
+	res, err := b.do(ctx, http.MethodGet, dir, nil, http.Header{"Accept": {"application/json"}})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	var objects []bunnyObject
+	if err := json.NewDecoder(res.Body).Decode(&objects); err != nil {
+		return nil, fmt.Errorf("bunny: failed to decode listing of %q: %w", dir, err)
+	}
+	return objects, nil
+}
+
+func (b *bunnyBucket) ErrorCode(err error) gcerrors.ErrorCode {
+	var berr *bunnyError
+	if !errors.As(err, &berr) {
+		return gcerrors.Unknown
+	}
+	switch berr.status {
+	case http.StatusNotFound:
+		return gcerrors.NotFound
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return gcerrors.PermissionDenied
+	case http.StatusBadRequest:
+		return gcerrors.InvalidArgument
+	default:
+		return gcerrors.Unknown
+	}
+}
+
+func (b *bunnyBucket) As(i any) bool { return false }
+
+func (b *bunnyBucket) ErrorAs(err error, i any) bool { return errors.As(err, i) }
+
+func (b *bunnyBucket) Attributes(ctx context.Context, key string) (*driver.Attributes, error) {
+	dir, name := path.Split(key)
+	objects, err := b.list(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range objects {
+		if !o.IsDirectory && o.ObjectName == name {
+			return &driver.Attributes{
+				ModTime: o.modTime(),
+				Size:    o.Length,
+			}, nil
+		}
+	}
+	return nil, &bunnyError{status: http.StatusNotFound, msg: key}
+}
+
+// ListPaged lists all the objects in one page.
+// Only flat listings are supported.
+func (b *bunnyBucket) ListPaged(ctx context.Context, opts *driver.ListOptions) (*driver.ListPage, error) {
+	if opts.Delimiter != "" {
+		return nil, errors.New("bunny: listing with a delimiter is not supported")
+	}
+
+	page := &driver.ListPage{}
+	var walk func(dir string) error
+	walk = func(dir string) error {
+		objects, err := b.list(ctx, dir)
+		if err != nil {
+			return err
+		}
+		for _, o := range objects {
+			key := dir + o.ObjectName
+			if o.IsDirectory {
+				if strings.HasPrefix(key+"/", opts.Prefix) || strings.HasPrefix(opts.Prefix, key+"/") {
+					if err := walk(key + "/"); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if !strings.HasPrefix(key, opts.Prefix) {
+				continue
+			}
+			sum, _ := hex.DecodeString(o.Checksum)
+			page.Objects = append(page.Objects, &driver.ListObject{
+				Key:     key,
+				ModTime: o.modTime(),
+				Size:    o.Length,
+				AsFunc: func(i any) bool {
+					p, ok := i.(*sha256Checksum)
+					if !ok {
+						return false
+					}
+					*p = sum
+					return true
+				},
+			})
+		}
+		return nil
+	}
+
+	if err := walk(""); err != nil {
+		return nil, err
+	}
+	sort.Slice(page.Objects, func(i, j int) bool { return page.Objects[i].Key < page.Objects[j].Key })
+	return page, nil
+}
+
+func (b *bunnyBucket) NewRangeReader(ctx context.Context, key string, offset, length int64, opts *driver.ReaderOptions) (driver.Reader, error) {
+	header := http.Header{}
+	if offset > 0 || length >= 0 {
+		r := fmt.Sprintf("bytes=%d-", offset)
+		if length >= 0 {
+			r += strconv.FormatInt(offset+length-1, 10)
+		}
+		header.Set("Range", r)
+	}
+	res, err := b.do(ctx, http.MethodGet, key, nil, header)
+	if err != nil {
+		return nil, err
+	}
+	attrs := &driver.ReaderAttributes{
+		ContentType: res.Header.Get("Content-Type"),
+		Size:        res.ContentLength,
+	}
+	attrs.ModTime, _ = http.ParseTime(res.Header.Get("Last-Modified"))
+	return &bunnyReader{ReadCloser: res.Body, attrs: attrs}, nil
+}
+
+type bunnyReader struct {
+	io.ReadCloser
+	attrs *driver.ReaderAttributes
+}
+
+func (r *bunnyReader) Attributes() *driver.ReaderAttributes { return r.attrs }
+
+func (r *bunnyReader) As(i any) bool { return false }
+
+func (b *bunnyBucket) NewTypedWriter(ctx context.Context, key, contentType string, opts *driver.WriterOptions) (driver.Writer, error) {
+	if opts.ContentEncoding != "" {
+		return nil, fmt.Errorf("bunny: %q: Content-Encoding %q is not supported", key, opts.ContentEncoding)
+	}
+	return &bunnyWriter{ctx: ctx, b: b, key: key}, nil
+}
+
+// bunnyWriter buffers the content and uploads it on Close.
+type bunnyWriter struct {
+	ctx context.Context
+	b   *bunnyBucket
+	key string
+	buf bytes.Buffer
+}
+
+func (w *bunnyWriter) Write(p []byte) (int, error) {
+	return w.buf.Write(p)
+}
+
+func (w *bunnyWriter) Close() error {
+	header := http.Header{"Content-Type": {"application/octet-stream"}}
+	res, err := w.b.do(w.ctx, http.MethodPut, w.key, &w.buf, header)
+	if err != nil {
+		return err
+	}
+	return res.Body.Close()
+}
+
+func (b *bunnyBucket) Copy(ctx context.Context, dstKey, srcKey string, opts *driver.CopyOptions) error {
+	r, err := b.NewRangeReader(ctx, srcKey, 0, -1, nil)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	w := &bunnyWriter{ctx: ctx, b: b, key: dstKey}
+	if _, err := io.Copy(w, r); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+func (b *bunnyBucket) Delete(ctx context.Context, key string) error {
+	res, err := b.do(ctx, http.MethodDelete, key, nil, nil)
+	if err != nil {
+		return err
+	}
+	return res.Body.Close()
+}
+
+func (b *bunnyBucket) SignedURL(ctx context.Context, key string, opts *driver.SignedURLOptions) (string, error) {
+	return "", errors.New("bunny: signed URLs are not supported")
+}
+
+func (b *bunnyBucket) Close() error { return nil }
+
+// PurgeBunnyCDN purges the cache of the Bunny pull zone with the given ID.
+// The account API key is read from the BUNNY_API_KEY environment variable.
+func PurgeBunnyCDN(ctx context.Context, pullZoneID string) error {
+	apiKey := os.Getenv(bunnyAPIKeyEnv)
+	if apiKey == "" {
+		return fmt.Errorf("%s is not set", bunnyAPIKeyEnv)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/pullzone/%s/purgeCache", bunnyAPIURL, url.PathEscape(pullZoneID)), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("AccessKey", apiKey)
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("Bunny API responded with %s: %s", res.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
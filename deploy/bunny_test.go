@@ -0,0 +1,228 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nodeploy
+// +build !nodeploy
+
+package deploy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gohugoio/hugo/media"
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+)
+
+// newFakeBunnyStorage starts a minimal Bunny Storage API server for the
+// storage zone "zone" with the access key "secret".
+func newFakeBunnyStorage(t *testing.T) (*httptest.Server, map[string]string) {
+	var mu sync.Mutex
+	files := make(map[string]string)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("AccessKey") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		key, ok := strings.CutPrefix(r.URL.Path, "/zone/")
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && (key == "" || strings.HasSuffix(key, "/")):
+			dirs := make(map[string]bool)
+			objects := []bunnyObject{}
+			for k, v := range files {
+				rest, ok := strings.CutPrefix(k, key)
+				if !ok {
+					continue
+				}
+				if dir, _, found := strings.Cut(rest, "/"); found {
+					if !dirs[dir] {
+						dirs[dir] = true
+						objects = append(objects, bunnyObject{ObjectName: dir, IsDirectory: true})
+					}
+					continue
+				}
+				sum := sha256.Sum256([]byte(v))
+				objects = append(objects, bunnyObject{
+					ObjectName:  rest,
+					Length:      int64(len(v)),
+					LastChanged: "2023-05-01T10:20:30.123",
+					Checksum:    strings.ToUpper(hex.EncodeToString(sum[:])),
+				})
+			}
+			sort.Slice(objects, func(i, j int) bool { return objects[i].ObjectName < objects[j].ObjectName })
+			json.NewEncoder(w).Encode(objects)
+		case r.Method == http.MethodGet:
+			v, found := files[key]
+			if !found {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			io.WriteString(w, v)
+		case r.Method == http.MethodPut:
+			b, _ := io.ReadAll(r.Body)
+			files[key] = string(b)
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodDelete:
+			if _, found := files[key]; !found {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			delete(files, key)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, files
+}
+
+func TestBunnyEndToEndSync(t *testing.T) {
+	ctx := context.Background()
+	srv, _ := newFakeBunnyStorage(t)
+
+	bucket, err := openBunnyBucket("zone", srv.URL, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bucket.Close()
+
+	fs := afero.NewMemMapFs()
+	local, err := initLocalFs(ctx, fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deployer := &Deployer{
+		localFs:    fs,
+		bucket:     bucket,
+		mediaTypes: media.DefaultTypes,
+		cfg:        DeployConfig{MaxDeletes: -1},
+	}
+
+	if err := deployer.Deploy(ctx); err != nil {
+		t.Fatalf("initial deploy: failed: %v", err)
+	}
+	wantSummary := deploySummary{NumLocal: 5, NumRemote: 0, NumUploads: 5, NumDeletes: 0}
+	if !cmp.Equal(deployer.summary, wantSummary) {
+		t.Errorf("initial deploy: got %v, want %v", deployer.summary, wantSummary)
+	}
+	if diff, err := verifyRemote(ctx, bucket, local); err != nil {
+		t.Errorf("initial deploy: failed to verify remote: %v", err)
+	} else if diff != "" {
+		t.Errorf("initial deploy: remote snapshot doesn't match expected:\n%v", diff)
+	}
+
+	// A repeat deployment shouldn't change anything.
+	if err := deployer.Deploy(ctx); err != nil {
+		t.Fatalf("no-op deploy: %v", err)
+	}
+	wantSummary = deploySummary{NumLocal: 5, NumRemote: 5, NumUploads: 0, NumDeletes: 0}
+	if !cmp.Equal(deployer.summary, wantSummary) {
+		t.Errorf("no-op deploy: got %v, want %v", deployer.summary, wantSummary)
+	}
+
+	// Modify a file without changing its size and delete another.
+	if err := afero.WriteFile(fs, "aaa", []byte("AAA"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.Remove("bbb"); err != nil {
+		t.Fatal(err)
+	}
+	if err := deployer.Deploy(ctx); err != nil {
+		t.Fatalf("deploy after changes: failed: %v", err)
+	}
+	wantSummary = deploySummary{NumLocal: 4, NumRemote: 5, NumUploads: 1, NumDeletes: 1}
+	if !cmp.Equal(deployer.summary, wantSummary) {
+		t.Errorf("deploy after changes: got %v, want %v", deployer.summary, wantSummary)
+	}
+	local[0].Contents = "AAA"
+	local = append(local[:1], local[2:]...)
+	if diff, err := verifyRemote(ctx, bucket, local); err != nil {
+		t.Errorf("deploy after changes: failed to verify remote: %v", err)
+	} else if diff != "" {
+		t.Errorf("deploy after changes: remote snapshot doesn't match expected:\n%v", diff)
+	}
+}
+
+func TestBunnyContentEncodingNotSupported(t *testing.T) {
+	ctx := context.Background()
+	srv, _ := newFakeBunnyStorage(t)
+
+	bucket, err := openBunnyBucket("zone", srv.URL, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bucket.Close()
+
+	fs := afero.NewMemMapFs()
+	if _, err := initLocalFs(ctx, fs); err != nil {
+		t.Fatal(err)
+	}
+	deployer := &Deployer{
+		localFs:    fs,
+		bucket:     bucket,
+		mediaTypes: media.DefaultTypes,
+		cfg:        DeployConfig{MaxDeletes: -1, Matchers: []*Matcher{{Pattern: "aaa", Gzip: true}}},
+	}
+	deployer.cfg.Matchers[0].re = regexp.MustCompile(deployer.cfg.Matchers[0].Pattern)
+
+	err = deployer.Deploy(ctx)
+	if err == nil || !strings.Contains(err.Error(), "Content-Encoding \"gzip\" is not supported") {
+		t.Errorf("expected Content-Encoding error, got %v", err)
+	}
+}
+
+func TestPurgeBunnyCDN(t *testing.T) {
+	var gotPath, gotKey string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath, gotKey = r.URL.Path, r.Header.Get("AccessKey")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	oldURL := bunnyAPIURL
+	bunnyAPIURL = srv.URL
+	defer func() { bunnyAPIURL = oldURL }()
+
+	t.Setenv(bunnyAPIKeyEnv, "")
+	if err := PurgeBunnyCDN(context.Background(), "123"); err == nil {
+		t.Fatal("expected error without API key")
+	}
+
+	t.Setenv(bunnyAPIKeyEnv, "apikey")
+	if err := PurgeBunnyCDN(context.Background(), "123"); err != nil {
+		t.Fatal(err)
+	}
+	if gotPath != "/pullzone/123/purgeCache" || gotKey != "apikey" {
+		t.Errorf("got path %q and key %q", gotPath, gotKey)
+	}
+}
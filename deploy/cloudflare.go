@@ -0,0 +1,138 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nodeploy
+// +build !nodeploy
+
+package deploy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"gocloud.dev/blob"
+	"gocloud.dev/blob/s3blob"
+)
+
+const (
+	// r2Scheme is the URL scheme of Cloudflare R2 targets, e.g.
+	// r2://<bucket>?account=<account ID>.
+	r2Scheme = "r2"
+
+	// The environment variables holding the R2 API token credentials.
+	// If not set, the default AWS credentials are used.
+	r2AccessKeyIDEnv     = "R2_ACCESS_KEY_ID"
+	r2SecretAccessKeyEnv = "R2_SECRET_ACCESS_KEY"
+
+	// The environment variable holding the API token used to purge the
+	// Cloudflare cache.
+	cloudflareAPITokenEnv = "CLOUDFLARE_API_TOKEN"
+)
+
+// cloudflareAPIURL is the base URL of the Cloudflare API. Variable for testing.
+var cloudflareAPIURL = "https://api.cloudflare.com/client/v4"
+
+func init() {
+	blob.DefaultURLMux().RegisterBucket(r2Scheme, r2URLOpener{})
+}
+
+type r2URLOpener struct{}
+
+// OpenBucketURL opens a Cloudflare R2 bucket using its S3 compatible API.
+// The account query parameter is required, the jurisdiction parameter
+// (e.g. eu) is optional.
+func (r2URLOpener) OpenBucketURL(ctx context.Context, u *url.URL) (*blob.Bucket, error) {
+	var account, jurisdiction string
+	for k, v := range u.Query() {
+		switch k {
+		case "account":
+			account = v[0]
+		case "jurisdiction":
+			jurisdiction = v[0]
+		default:
+			return nil, fmt.Errorf("open bucket %v: invalid query parameter %q", u, k)
+		}
+	}
+	if account == "" {
+		return nil, fmt.Errorf("open bucket %v: the account query parameter is required", u)
+	}
+
+	cfg := aws.Config{
+		Endpoint:         aws.String(r2Endpoint(account, jurisdiction)),
+		Region:           aws.String("auto"),
+		S3ForcePathStyle: aws.Bool(true),
+	}
+	if id := os.Getenv(r2AccessKeyIDEnv); id != "" {
+		cfg.Credentials = credentials.NewStaticCredentials(id, os.Getenv(r2SecretAccessKeyEnv), "")
+	}
+	sess, err := session.NewSessionWithOptions(session.Options{Config: cfg, SharedConfigState: session.SharedConfigEnable})
+	if err != nil {
+		return nil, err
+	}
+	return s3blob.OpenBucket(ctx, sess, u.Host, nil)
+}
+
+func r2Endpoint(account, jurisdiction string) string {
+	if jurisdiction != "" {
+		return fmt.Sprintf("https://%s.%s.r2.cloudflarestorage.com", account, jurisdiction)
+	}
+	return fmt.Sprintf("https://%s.r2.cloudflarestorage.com", account)
+}
+
+// PurgeCloudflareCDN purges everything from the Cloudflare cache of the zone
+// with the given ID.
+// The API token is read from the CLOUDFLARE_API_TOKEN environment variable.
+func PurgeCloudflareCDN(ctx context.Context, zoneID string) error {
+	token := os.Getenv(cloudflareAPITokenEnv)
+	if token == "" {
+		return fmt.Errorf("%s is not set", cloudflareAPITokenEnv)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/zones/%s/purge_cache", cloudflareAPIURL, url.PathEscape(zoneID)), strings.NewReader(`{"purge_everything":true}`))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	var result struct {
+		Success bool
+		Errors  []struct {
+			Code    int
+			Message string
+		}
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return fmt.Errorf("Cloudflare API responded with %s", res.Status)
+	}
+	if !result.Success {
+		var msgs []string
+		for _, e := range result.Errors {
+			msgs = append(msgs, fmt.Sprintf("%s (%d)", e.Message, e.Code))
+		}
+		return fmt.Errorf("Cloudflare API responded with %s: %s", res.Status, strings.Join(msgs, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nodeploy
+// +build !nodeploy
+
+package deploy
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gocloud.dev/blob"
+)
+
+func TestR2Endpoint(t *testing.T) {
+	if got, want := r2Endpoint("abc", ""), "https://abc.r2.cloudflarestorage.com"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got, want := r2Endpoint("abc", "eu"), "https://abc.eu.r2.cloudflarestorage.com"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestOpenR2BucketRequiresAccount(t *testing.T) {
+	_, err := blob.OpenBucket(context.Background(), "r2://mybucket")
+	if err == nil || !strings.Contains(err.Error(), "account query parameter is required") {
+		t.Errorf("expected missing account error, got %v", err)
+	}
+}
+
+func TestPurgeCloudflareCDN(t *testing.T) {
+	var gotPath, gotAuth, gotBody string
+	success := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		gotPath, gotAuth, gotBody = r.URL.Path, r.Header.Get("Authorization"), string(b)
+		if success {
+			io.WriteString(w, `{"success":true,"errors":[]}`)
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+		io.WriteString(w, `{"success":false,"errors":[{"code":10000,"message":"Authentication error"}]}`)
+	}))
+	defer srv.Close()
+
+	oldURL := cloudflareAPIURL
+	cloudflareAPIURL = srv.URL
+	defer func() { cloudflareAPIURL = oldURL }()
+
+	t.Setenv(cloudflareAPITokenEnv, "token")
+	if err := PurgeCloudflareCDN(context.Background(), "zone1"); err != nil {
+		t.Fatal(err)
+	}
+	if gotPath != "/zones/zone1/purge_cache" || gotAuth != "Bearer token" || gotBody != `{"purge_everything":true}` {
+		t.Errorf("got path %q, auth %q and body %q", gotPath, gotAuth, gotBody)
+	}
+
+	success = false
+	err := PurgeCloudflareCDN(context.Background(), "zone1")
+	if err == nil || !strings.Contains(err.Error(), "Authentication error (10000)") {
+		t.Errorf("expected authentication error, got %v", err)
+	}
+}
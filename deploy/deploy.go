@@ -21,6 +21,7 @@ import (
 	"compress/gzip"
 	"context"
 	"crypto/md5"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -62,6 +63,8 @@ type Deployer struct {
 
 	target *Target // the target to deploy to
 
+	out io.Writer // where to write the diff, defaults to os.Stdout
+
 	// For tests...
 	summary deploySummary // summary of latest Deploy results
 }
@@ -118,6 +121,10 @@ func (d *Deployer) openBucket(ctx context.Context) (*blob.Bucket, error) {
 
 // Deploy deploys the site to a target.
 func (d *Deployer) Deploy(ctx context.Context) error {
+	if d.cfg.Rollback {
+		return d.Rollback(ctx)
+	}
+
 	bucket, err := d.openBucket(ctx)
 	if err != nil {
 		return err
@@ -140,9 +147,26 @@ func (d *Deployer) Deploy(ctx context.Context) error {
 	d.summary.NumLocal = len(local)
 
 	// Load remote files from the target.
-	remote, err := walkRemote(ctx, bucket, include, exclude)
-	if err != nil {
-		return err
+	// With atomic deploys, these are the files of the current version,
+	// and the changes are applied to a new version.
+	var (
+		manifest      *deployManifest
+		remotePrefix  string
+		versionPrefix string
+	)
+	atomic := d.target != nil && d.target.Atomic
+	if atomic {
+		if manifest, err = readDeployManifest(ctx, bucket); err != nil {
+			return err
+		}
+		remotePrefix = manifest.Prefix
+	}
+	remote := map[string]*blob.ListObject{}
+	if !atomic || remotePrefix != "" {
+		remote, err = walkRemote(ctx, bucket, remotePrefix, include, exclude)
+		if err != nil {
+			return err
+		}
 	}
 	jww.INFO.Printf("Found %d remote files.\n", len(remote))
 	d.summary.NumRemote = len(remote)
@@ -151,6 +175,23 @@ func (d *Deployer) Deploy(ctx context.Context) error {
 	uploads, deletes := findDiffs(local, remote, d.cfg.Force)
 	d.summary.NumUploads = len(uploads)
 	d.summary.NumDeletes = len(deletes)
+
+	if d.cfg.Diff {
+		var targetName string
+		if d.target != nil {
+			targetName = d.target.Name
+		}
+		diff, err := newDiff(ctx, bucket, remotePrefix, targetName, local, remote, uploads, deletes)
+		if err != nil {
+			return err
+		}
+		out := d.out
+		if out == nil {
+			out = os.Stdout
+		}
+		return diff.Write(out, d.cfg.DiffFormat)
+	}
+
 	if len(uploads)+len(deletes) == 0 {
 		if !d.quiet {
 			jww.FEEDBACK.Println("No changes required.")
@@ -173,14 +214,31 @@ func (d *Deployer) Deploy(ctx context.Context) error {
 		}
 	}
 
-	// Order the uploads. They are organized in groups; all uploads in a group
-	// must be complete before moving on to the next group.
-	uploadGroups := applyOrdering(d.cfg.ordering, uploads)
-
 	nParallel := d.cfg.Workers
 	var errs []error
 	var errMu sync.Mutex // protects errs
 
+	var versionID string
+	if atomic {
+		previous := manifest.Current
+		versionID = manifest.newVersion(len(local))
+		versionPrefix = versionPrefixOf(versionID)
+		if d.cfg.DryRun {
+			if !d.quiet {
+				jww.FEEDBACK.Printf("[DRY RUN] Would deploy version %s\n", versionID)
+			}
+		} else {
+			jww.FEEDBACK.Printf("Deploying version %s...\n", versionID)
+			// Start with the unchanged files of the current version.
+			unchanged := unchangedPaths(local, remote, uploads)
+			errs = append(errs, d.copyUnchanged(ctx, bucket, versionPrefixOf(previous), versionPrefix, unchanged)...)
+		}
+	}
+
+	// Order the uploads. They are organized in groups; all uploads in a group
+	// must be complete before moving on to the next group.
+	uploadGroups := applyOrdering(d.cfg.ordering, uploads)
+
 	for _, uploads := range uploadGroups {
 		// Short-circuit for an empty group.
 		if len(uploads) == 0 {
@@ -199,7 +257,7 @@ func (d *Deployer) Deploy(ctx context.Context) error {
 
 			sem <- struct{}{}
 			go func(upload *fileToUpload) {
-				if err := doSingleUpload(ctx, bucket, upload); err != nil {
+				if err := doSingleUpload(ctx, bucket, versionPrefix, upload); err != nil {
 					errMu.Lock()
 					defer errMu.Unlock()
 					errs = append(errs, err)
@@ -213,7 +271,9 @@ func (d *Deployer) Deploy(ctx context.Context) error {
 		}
 	}
 
-	if d.cfg.MaxDeletes != -1 && len(deletes) > d.cfg.MaxDeletes {
+	if atomic {
+		// The deleted files are just not part of the new version.
+	} else if d.cfg.MaxDeletes != -1 && len(deletes) > d.cfg.MaxDeletes {
 		jww.WARN.Printf("Skipping %d deletes because it is more than --maxDeletes (%d). If this is expected, set --maxDeletes to a larger number, or -1 to disable this check.\n", len(deletes), d.cfg.MaxDeletes)
 		d.summary.NumDeletes = 0
 	} else {
@@ -254,35 +314,52 @@ func (d *Deployer) Deploy(ctx context.Context) error {
 		}
 		return errs[0]
 	}
+
+	if atomic && !d.cfg.DryRun {
+		// All files are uploaded, flip the pointer to the new version.
+		manifest.setCurrent(versionID)
+		if err := writeDeployManifest(ctx, bucket, manifest); err != nil {
+			return err
+		}
+		if err := d.pruneVersions(ctx, bucket, manifest); err != nil {
+			jww.WARN.Printf("Failed to delete old versions: %v\n", err)
+		}
+	}
+
 	if !d.quiet {
 		jww.FEEDBACK.Println("Success!")
 	}
 
+	return d.invalidateCDN(ctx)
+}
+
+// invalidateCDN invalidates the CDN caches listed in the deployment target.
+func (d *Deployer) invalidateCDN(ctx context.Context) error {
 	if d.cfg.InvalidateCDN {
-		if d.target.CloudFrontDistributionID != "" {
-			if d.cfg.DryRun {
-				if !d.quiet {
-					jww.FEEDBACK.Printf("[DRY RUN] Would invalidate CloudFront CDN with ID %s\n", d.target.CloudFrontDistributionID)
-				}
-			} else {
-				jww.FEEDBACK.Println("Invalidating CloudFront CDN...")
-				if err := InvalidateCloudFront(ctx, d.target.CloudFrontDistributionID); err != nil {
-					jww.FEEDBACK.Printf("Failed to invalidate CloudFront CDN: %v\n", err)
-					return err
-				}
+		cdns := []struct {
+			name       string
+			id         string
+			invalidate func(context.Context, string) error
+		}{
+			{"CloudFront CDN with ID", d.target.CloudFrontDistributionID, InvalidateCloudFront},
+			{"Google Cloud CDN with origin", d.target.GoogleCloudCDNOrigin, InvalidateGoogleCloudCDN},
+			{"Cloudflare cache of zone", d.target.CloudflareZoneID, PurgeCloudflareCDN},
+			{"Bunny CDN pull zone", d.target.BunnyPullZoneID, PurgeBunnyCDN},
+		}
+		for _, cdn := range cdns {
+			if cdn.id == "" {
+				continue
 			}
-		}
-		if d.target.GoogleCloudCDNOrigin != "" {
 			if d.cfg.DryRun {
 				if !d.quiet {
-					jww.FEEDBACK.Printf("[DRY RUN] Would invalidate Google Cloud CDN with origin %s\n", d.target.GoogleCloudCDNOrigin)
-				}
-			} else {
-				jww.FEEDBACK.Println("Invalidating Google Cloud CDN...")
-				if err := InvalidateGoogleCloudCDN(ctx, d.target.GoogleCloudCDNOrigin); err != nil {
-					jww.FEEDBACK.Printf("Failed to invalidate Google Cloud CDN: %v\n", err)
-					return err
+					jww.FEEDBACK.Printf("[DRY RUN] Would invalidate %s %s\n", cdn.name, cdn.id)
 				}
+				continue
+			}
+			jww.FEEDBACK.Printf("Invalidating %s %s...\n", cdn.name, cdn.id)
+			if err := cdn.invalidate(ctx, cdn.id); err != nil {
+				jww.FEEDBACK.Printf("Failed to invalidate %s %s: %v\n", cdn.name, cdn.id, err)
+				return err
 			}
 		}
 		jww.FEEDBACK.Println("Success!")
@@ -300,7 +377,8 @@ func summarizeChanges(uploads []*fileToUpload, deletes []string) string {
 }
 
 // doSingleUpload executes a single file upload.
-func doSingleUpload(ctx context.Context, bucket *blob.Bucket, upload *fileToUpload) error {
+// The file is stored with the given key prefix.
+func doSingleUpload(ctx context.Context, bucket *blob.Bucket, prefix string, upload *fileToUpload) error {
 	jww.INFO.Printf("Uploading %v...\n", upload)
 	opts := &blob.WriterOptions{
 		CacheControl:    upload.Local.CacheControl(),
@@ -308,7 +386,7 @@ func doSingleUpload(ctx context.Context, bucket *blob.Bucket, upload *fileToUplo
 		ContentType:     upload.Local.ContentType(),
 		Metadata:        map[string]string{metaMD5Hash: hex.EncodeToString(upload.Local.MD5())},
 	}
-	w, err := bucket.NewWriter(ctx, upload.Local.SlashPath, opts)
+	w, err := bucket.NewWriter(ctx, prefix+upload.Local.SlashPath, opts)
 	if err != nil {
 		return err
 	}
@@ -342,6 +420,7 @@ type localFile struct {
 	fs         afero.Fs
 	matcher    *Matcher
 	md5        []byte       // cache
+	sha256     []byte       // cache
 	gzipped    bytes.Buffer // cached of gzipped contents if gzipping
 	mediaTypes media.Types
 }
@@ -462,6 +541,24 @@ func (lf *localFile) MD5() []byte {
 	return lf.md5
 }
 
+// SHA256 returns a SHA-256 hash of the content to be uploaded.
+func (lf *localFile) SHA256() []byte {
+	if len(lf.sha256) > 0 {
+		return lf.sha256
+	}
+	h := sha256.New()
+	r, err := lf.Reader()
+	if err != nil {
+		return nil
+	}
+	defer r.Close()
+	if _, err := io.Copy(h, r); err != nil {
+		return nil
+	}
+	lf.sha256 = h.Sum(nil)
+	return lf.sha256
+}
+
 // knownHiddenDirectory checks if the specified name is a well known
 // hidden directory.
 func knownHiddenDirectory(name string) bool {
@@ -538,10 +635,11 @@ func walkLocal(fs afero.Fs, matchers []*Matcher, include, exclude glob.Glob, med
 	return retval, nil
 }
 
-// walkRemote walks the target bucket and returns a flat list.
-func walkRemote(ctx context.Context, bucket *blob.Bucket, include, exclude glob.Glob) (map[string]*blob.ListObject, error) {
+// walkRemote walks the target bucket below prefix and returns a flat list
+// keyed by the paths relative to prefix.
+func walkRemote(ctx context.Context, bucket *blob.Bucket, prefix string, include, exclude glob.Glob) (map[string]*blob.ListObject, error) {
 	retval := map[string]*blob.ListObject{}
-	iter := bucket.List(nil)
+	iter := bucket.List(&blob.ListOptions{Prefix: prefix})
 	for {
 		obj, err := iter.Next(ctx)
 		if err == io.EOF {
@@ -550,13 +648,14 @@ func walkRemote(ctx context.Context, bucket *blob.Bucket, include, exclude glob.
 		if err != nil {
 			return nil, err
 		}
+		path := strings.TrimPrefix(obj.Key, prefix)
 		// Check include/exclude matchers.
-		if include != nil && !include.Match(obj.Key) {
-			jww.INFO.Printf("  remote dropping %q due to include\n", obj.Key)
+		if include != nil && !include.Match(path) {
+			jww.INFO.Printf("  remote dropping %q due to include\n", path)
 			continue
 		}
-		if exclude != nil && exclude.Match(obj.Key) {
-			jww.INFO.Printf("  remote dropping %q due to exclude\n", obj.Key)
+		if exclude != nil && exclude.Match(path) {
+			jww.INFO.Printf("  remote dropping %q due to exclude\n", path)
 			continue
 		}
 		// If the remote didn't give us an MD5, use remote attributes MD5, if that doesn't exist compute one.
@@ -566,6 +665,12 @@ func walkRemote(ctx context.Context, bucket *blob.Bucket, include, exclude glob.
 		// via a multi-part upload.
 		// Although it's unfortunate to have to read the file, it's likely better
 		// than assuming a delta and re-uploading it.
+		// Some providers (e.g., Bunny Storage) give us a SHA-256 checksum
+		// instead, see findDiffs.
+		if _, ok := remoteSHA256(obj); ok {
+			retval[path] = obj
+			continue
+		}
 		if len(obj.MD5) == 0 {
 			var attrMD5 []byte
 			attrs, err := bucket.Attributes(ctx, obj.Key)
@@ -588,7 +693,7 @@ func walkRemote(ctx context.Context, bucket *blob.Bucket, include, exclude glob.
 				obj.MD5 = attrMD5
 			}
 		}
-		retval[obj.Key] = obj
+		retval[path] = obj
 	}
 	return retval, nil
 }
@@ -603,6 +708,7 @@ const (
 	reasonSize       uploadReason = "size differs"
 	reasonMD5Differs uploadReason = "md5 differs"
 	reasonMD5Missing uploadReason = "remote md5 missing"
+	reasonSHA256     uploadReason = "sha256 differs"
 )
 
 // fileToUpload represents a single local file that should be uploaded to
@@ -661,6 +767,9 @@ func findDiffs(localFiles map[string]*localFile, remoteFiles map[string]*blob.Li
 			} else if lf.UploadSize != remoteFile.Size {
 				upload = true
 				reason = reasonSize
+			} else if sum, ok := remoteSHA256(remoteFile); ok {
+				upload = !bytes.Equal(lf.SHA256(), sum)
+				reason = reasonSHA256
 			} else if len(remoteFile.MD5) == 0 {
 				// This shouldn't happen unless the remote didn't give us an MD5 hash
 				// from List, AND we failed to compute one by reading the remote file.
@@ -19,6 +19,7 @@ package deploy
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	"errors"
 
@@ -41,8 +42,16 @@ type DeployConfig struct {
 	Target string
 	// Show a confirm prompt before deploying.
 	Confirm bool
+	// Rollback makes the previous version of an atomic deployment target
+	// the current one.
+	Rollback bool
 	// DryRun will try the deployment without any remote changes.
 	DryRun bool
+	// Diff prints the files that would be uploaded, deleted and skipped
+	// without any remote changes.
+	Diff bool
+	// DiffFormat is the format of the Diff output, text (default) or json.
+	DiffFormat string
 	// Force will re-upload all files.
 	Force bool
 	// Invalidate the CDN cache listed in the deployment target.
@@ -65,6 +74,25 @@ type Target struct {
 	// invalidate when deploying this target.  It is specified as <project>/<origin>.
 	GoogleCloudCDNOrigin string
 
+	// CloudflareZoneID specifies the Cloudflare zone to purge when deploying
+	// this target, e.g. the zone serving an R2 bucket on a custom domain.
+	CloudflareZoneID string
+
+	// BunnyPullZoneID specifies the Bunny pull zone to purge when deploying
+	// this target.
+	BunnyPullZoneID string
+
+	// Atomic deploys the site to a new version below _versions/ in the
+	// bucket, e.g. _versions/20230501T102030Z/, and only updates the
+	// current version in _versions/manifest.json when all files are
+	// uploaded. The server or CDN in front of the bucket must serve the
+	// files below the prefix in the manifest.
+	Atomic bool
+
+	// KeepVersions is the number of versions of an atomic deployment to
+	// keep for rollbacks. Defaults to 5.
+	KeepVersions int
+
 	// Optional patterns of files to include/exclude for this target.
 	// Parsed using github.com/gobwas/glob.
 	Include string
@@ -144,6 +172,13 @@ func DecodeConfig(cfg config.Provider) (DeployConfig, error) {
 		dcfg.Workers = 10
 	}
 
+	dcfg.DiffFormat = strings.ToLower(dcfg.DiffFormat)
+	switch dcfg.DiffFormat {
+	case "", diffFormatText, diffFormatJSON:
+	default:
+		return dcfg, fmt.Errorf("invalid deployment.diffFormat %q, must be one of %q or %q", dcfg.DiffFormat, diffFormatText, diffFormatJSON)
+	}
+
 	for _, tgt := range dcfg.Targets {
 		if *tgt == (Target{}) {
 			return dcfg, errors.New("empty deployment target")
@@ -151,6 +186,14 @@ func DecodeConfig(cfg config.Provider) (DeployConfig, error) {
 		if err := tgt.parseIncludeExclude(); err != nil {
 			return dcfg, err
 		}
+		if tgt.Atomic {
+			if tgt.Include != "" || tgt.Exclude != "" {
+				return dcfg, fmt.Errorf("deployment target %q: include and exclude are not supported with atomic deploys", tgt.Name)
+			}
+			if tgt.KeepVersions == 0 {
+				tgt.KeepVersions = 5
+			}
+		}
 	}
 	var err error
 	for _, m := range dcfg.Matchers {
@@ -0,0 +1,246 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nodeploy
+// +build !nodeploy
+
+package deploy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gohugoio/hugo/common/htime"
+	jww "github.com/spf13/jwalterweatherman"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+)
+
+const (
+	// versionsDir is the directory in the bucket holding the site versions
+	// of atomic deployments, e.g. _versions/20230501T102030Z/index.html.
+	versionsDir = "_versions/"
+
+	// deployManifestKey is the key of the manifest pointing to the current
+	// version. The web server or CDN serving the bucket must use it to map
+	// requests to the current version's prefix.
+	deployManifestKey = versionsDir + "manifest.json"
+
+	versionIDLayout = "20060102T150405Z"
+)
+
+// deployManifest is the manifest of an atomic deployment target.
+type deployManifest struct {
+	// The ID of the version being served.
+	Current string `json:"current"`
+
+	// The key prefix of the current version, e.g. "_versions/20230501T102030Z/".
+	Prefix string `json:"prefix"`
+
+	// All the deployed versions, oldest first.
+	Versions []deployVersion `json:"versions"`
+}
+
+type deployVersion struct {
+	ID    string    `json:"id"`
+	Time  time.Time `json:"time"`
+	Files int       `json:"files"`
+}
+
+// versionPrefixOf returns the key prefix of the version with the given ID.
+func versionPrefixOf(id string) string {
+	if id == "" {
+		return ""
+	}
+	return versionsDir + id + "/"
+}
+
+// index returns the index of the version with the given ID, -1 if not found.
+func (m *deployManifest) index(id string) int {
+	for i, v := range m.Versions {
+		if v.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// setCurrent makes the version with the given ID the current one.
+func (m *deployManifest) setCurrent(id string) {
+	m.Current = id
+	m.Prefix = versionPrefixOf(id)
+}
+
+// newVersion adds a new version and returns its ID.
+func (m *deployManifest) newVersion(files int) string {
+	now := htime.Now().UTC()
+	id := now.Format(versionIDLayout)
+	for i := 2; m.index(id) != -1; i++ {
+		id = fmt.Sprintf("%s-%d", now.Format(versionIDLayout), i)
+	}
+	m.Versions = append(m.Versions, deployVersion{ID: id, Time: now, Files: files})
+	return id
+}
+
+func readDeployManifest(ctx context.Context, bucket *blob.Bucket) (*deployManifest, error) {
+	m := &deployManifest{}
+	b, err := bucket.ReadAll(ctx, deployManifestKey)
+	if err != nil {
+		if gcerrors.Code(err) == gcerrors.NotFound {
+			return m, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(b, m); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", deployManifestKey, err)
+	}
+	return m, nil
+}
+
+func writeDeployManifest(ctx context.Context, bucket *blob.Bucket, m *deployManifest) error {
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return bucket.WriteAll(ctx, deployManifestKey, b, &blob.WriterOptions{
+		ContentType:  "application/json",
+		CacheControl: "no-cache",
+	})
+}
+
+// copyUnchanged copies the files with the given paths from the version
+// prefix from to the version prefix to, in parallel.
+func (d *Deployer) copyUnchanged(ctx context.Context, bucket *blob.Bucket, from, to string, paths []string) []error {
+	errs := make(chan error, len(paths))
+	sem := make(chan struct{}, d.cfg.Workers)
+	for _, p := range paths {
+		sem <- struct{}{}
+		go func(p string) {
+			defer func() { <-sem }()
+			jww.INFO.Printf("Copying %s...\n", p)
+			if err := bucket.Copy(ctx, to+p, from+p, nil); err != nil {
+				errs <- err
+			}
+		}(p)
+	}
+	for n := d.cfg.Workers; n > 0; n-- {
+		sem <- struct{}{}
+	}
+	close(errs)
+
+	var result []error
+	for err := range errs {
+		result = append(result, err)
+	}
+	return result
+}
+
+// deleteVersion deletes all the files of the version with the given ID.
+func deleteVersion(ctx context.Context, bucket *blob.Bucket, id string) error {
+	iter := bucket.List(&blob.ListOptions{Prefix: versionPrefixOf(id)})
+	for {
+		obj, err := iter.Next(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := bucket.Delete(ctx, obj.Key); err != nil && gcerrors.Code(err) != gcerrors.NotFound {
+			return err
+		}
+	}
+}
+
+// pruneVersions deletes the oldest versions exceeding the target's
+// KeepVersions, never the current one.
+func (d *Deployer) pruneVersions(ctx context.Context, bucket *blob.Bucket, m *deployManifest) error {
+	keep := d.target.KeepVersions
+	if keep <= 0 || len(m.Versions) <= keep {
+		return nil
+	}
+	var kept []deployVersion
+	n := len(m.Versions) - keep
+	for _, v := range m.Versions {
+		if n > 0 && v.ID != m.Current {
+			jww.INFO.Printf("Deleting version %s...\n", v.ID)
+			if err := deleteVersion(ctx, bucket, v.ID); err != nil {
+				return err
+			}
+			n--
+			continue
+		}
+		kept = append(kept, v)
+	}
+	m.Versions = kept
+	return writeDeployManifest(ctx, bucket, m)
+}
+
+// Rollback makes the version deployed before the current one the current
+// version of an atomic deployment target.
+func (d *Deployer) Rollback(ctx context.Context) error {
+	if d.target == nil || !d.target.Atomic {
+		return errors.New("rollback requires a deployment target with atomic = true")
+	}
+	bucket, err := d.openBucket(ctx)
+	if err != nil {
+		return err
+	}
+	m, err := readDeployManifest(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	i := m.index(m.Current)
+	if i < 1 {
+		return errors.New("no previous version to roll back to")
+	}
+	prev := m.Versions[i-1].ID
+
+	if d.cfg.DryRun {
+		if !d.quiet {
+			jww.FEEDBACK.Printf("[DRY RUN] Would roll back from version %s to %s\n", m.Current, prev)
+		}
+		return nil
+	}
+
+	jww.FEEDBACK.Printf("Rolling back from version %s to %s...\n", m.Current, prev)
+	m.setCurrent(prev)
+	if err := writeDeployManifest(ctx, bucket, m); err != nil {
+		return err
+	}
+	if !d.quiet {
+		jww.FEEDBACK.Println("Success!")
+	}
+
+	return d.invalidateCDN(ctx)
+}
+
+// unchangedPaths returns the paths of the local files found in remote that
+// don't need to be uploaded.
+func unchangedPaths(local map[string]*localFile, remote map[string]*blob.ListObject, uploads []*fileToUpload) []string {
+	uploading := make(map[string]bool)
+	for _, u := range uploads {
+		uploading[u.Local.SlashPath] = true
+	}
+	var paths []string
+	for p := range local {
+		if _, found := remote[p]; found && !uploading[p] {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
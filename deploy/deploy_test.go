@@ -21,6 +21,7 @@ import (
 	"compress/gzip"
 	"context"
 	"crypto/md5"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -28,6 +29,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/gohugoio/hugo/media"
@@ -919,6 +921,199 @@ func TestCompression(t *testing.T) {
 	}
 }
 
+// TestDiff verifies that Diff reports the changes without applying them.
+func TestDiff(t *testing.T) {
+	ctx := context.Background()
+	tests := initFsTests(t)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			local, err := initLocalFs(ctx, test.fs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			deployer := &Deployer{
+				localFs:    test.fs,
+				bucket:     test.bucket,
+				mediaTypes: media.DefaultTypes,
+				cfg:        DeployConfig{MaxDeletes: -1},
+			}
+			if err := deployer.Deploy(ctx); err != nil {
+				t.Fatalf("initial deploy: failed: %v", err)
+			}
+
+			// Update [0], delete [1], add a file and set Cache-Control on subdir2/bbb.
+			updatefd := local[0]
+			updatefd.Contents = "new contents"
+			if err := writeFiles(test.fs, []*fileData{updatefd, {"zzz", "zzz"}}); err != nil {
+				t.Fatal(err)
+			}
+			if err := test.fs.Remove(local[1].Name); err != nil {
+				t.Fatal(err)
+			}
+			var out bytes.Buffer
+			deployer.out = &out
+			deployer.cfg.Diff = true
+			deployer.cfg.DiffFormat = "json"
+			deployer.cfg.Matchers = []*Matcher{{Pattern: "^subdir2/", CacheControl: "max-age=60", re: regexp.MustCompile("^subdir2/")}}
+
+			if err := deployer.Deploy(ctx); err != nil {
+				t.Fatalf("diff: failed: %v", err)
+			}
+
+			var diff Diff
+			if err := json.Unmarshal(out.Bytes(), &diff); err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, f := range diff.Files {
+				got = append(got, fmt.Sprintf("%s %s %s %v", f.Action, f.Path, f.Reason, f.Details))
+			}
+			want := []string{
+				"upload aaa size differs []",
+				"delete bbb  []",
+				"skip subdir/aaa  []",
+				"skip subdir/nested/aaa  []",
+				`skip subdir2/bbb metadata differs [Cache-Control: "" => "max-age=60"]`,
+				"upload zzz not found at target []",
+			}
+			if diff := cmp.Diff(got, want); diff != "" {
+				t.Errorf("unexpected diff files (-got +want):\n%s", diff)
+			}
+			if diff.Uploads != 2 || diff.Deletes != 1 || diff.Skips != 3 || diff.UploadSize != 15 || diff.DeleteSize != 3 {
+				t.Errorf("unexpected totals: %+v", diff)
+			}
+
+			// The remote should be unchanged.
+			if diff, err := verifyRemote(ctx, test.bucket, []*fileData{{"aaa", "aaa"}, local[1], local[2], local[3], local[4]}); err != nil {
+				t.Errorf("failed to verify remote: %v", err)
+			} else if diff != "" {
+				t.Errorf("remote snapshot doesn't match expected:\n%v", diff)
+			}
+
+			out.Reset()
+			deployer.cfg.DiffFormat = "text"
+			if err := deployer.Deploy(ctx); err != nil {
+				t.Fatalf("diff: failed: %v", err)
+			}
+			if s := out.String(); !strings.Contains(s, "2 file(s) to upload (15 B), 1 file(s) to delete (3 B), 3 file(s) unchanged.") {
+				t.Errorf("unexpected text diff:\n%s", s)
+			}
+		})
+	}
+}
+
+// TestAtomicDeploy verifies that atomic deploys upload to new versions and
+// that rollbacks restore the previous version.
+func TestAtomicDeploy(t *testing.T) {
+	ctx := context.Background()
+	tests := initFsTests(t)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			local, err := initLocalFs(ctx, test.fs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			deployer := &Deployer{
+				localFs:    test.fs,
+				bucket:     test.bucket,
+				mediaTypes: media.DefaultTypes,
+				target:     &Target{Name: "atomic", Atomic: true, KeepVersions: 2},
+				cfg:        DeployConfig{MaxDeletes: -1, Workers: 2},
+			}
+
+			// verifyCurrent verifies that the current version matches want.
+			verifyCurrent := func(want []*fileData) *deployManifest {
+				t.Helper()
+				m, err := readDeployManifest(ctx, test.bucket)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if m.Prefix == "" {
+					t.Fatal("no current version")
+				}
+				var got []*fileData
+				iter := test.bucket.List(&blob.ListOptions{Prefix: m.Prefix})
+				for {
+					obj, err := iter.Next(ctx)
+					if err == io.EOF {
+						break
+					}
+					if err != nil {
+						t.Fatal(err)
+					}
+					b, err := test.bucket.ReadAll(ctx, obj.Key)
+					if err != nil {
+						t.Fatal(err)
+					}
+					got = append(got, &fileData{strings.TrimPrefix(obj.Key, m.Prefix), string(b)})
+				}
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("current version %s doesn't match expected (-got +want):\n%s", m.Current, diff)
+				}
+				return m
+			}
+
+			if err := deployer.Deploy(ctx); err != nil {
+				t.Fatalf("initial deploy: failed: %v", err)
+			}
+			v1 := verifyCurrent(local)
+			want := append([]*fileData(nil), local...)
+
+			// Update [0] and delete [1].
+			local[0] = &fileData{local[0].Name, "new contents"}
+			if err := writeFiles(test.fs, local[:1]); err != nil {
+				t.Fatal(err)
+			}
+			if err := test.fs.Remove(local[1].Name); err != nil {
+				t.Fatal(err)
+			}
+			local = append(local[:1], local[2:]...)
+			if err := deployer.Deploy(ctx); err != nil {
+				t.Fatalf("second deploy: failed: %v", err)
+			}
+			wantSummary := deploySummary{NumLocal: 4, NumRemote: 5, NumUploads: 1, NumDeletes: 1}
+			if !cmp.Equal(deployer.summary, wantSummary) {
+				t.Errorf("second deploy: got %v, want %v", deployer.summary, wantSummary)
+			}
+			v2 := verifyCurrent(local)
+			if v2.Current == v1.Current {
+				t.Fatalf("expected a new version, got %s", v2.Current)
+			}
+
+			// The first version is untouched.
+			b, err := test.bucket.ReadAll(ctx, v1.Prefix+want[0].Name)
+			if err != nil || string(b) != want[0].Contents {
+				t.Errorf("first version was modified: %q, %v", b, err)
+			}
+
+			// A third version prunes the first.
+			if err := writeFiles(test.fs, []*fileData{{"zzz", "zzz"}}); err != nil {
+				t.Fatal(err)
+			}
+			if err := deployer.Deploy(ctx); err != nil {
+				t.Fatalf("third deploy: failed: %v", err)
+			}
+			v3 := verifyCurrent(append(append([]*fileData(nil), local...), &fileData{"zzz", "zzz"}))
+			if len(v3.Versions) != 2 || v3.Versions[0].ID != v2.Current {
+				t.Errorf("expected versions %s and %s, got %v", v2.Current, v3.Current, v3.Versions)
+			}
+			if exists, _ := test.bucket.Exists(ctx, v1.Prefix+want[0].Name); exists {
+				t.Error("expected the first version to be deleted")
+			}
+
+			// Roll back to the second version.
+			deployer.cfg.Rollback = true
+			if err := deployer.Deploy(ctx); err != nil {
+				t.Fatalf("rollback: failed: %v", err)
+			}
+			verifyCurrent(local)
+			if err := deployer.Deploy(ctx); err == nil || !strings.Contains(err.Error(), "no previous version") {
+				t.Errorf("expected no previous version error, got %v", err)
+			}
+		})
+	}
+}
+
 // TestMatching verifies that matchers match correctly, and that the Force
 // attribute for matcher works.
 func TestMatching(t *testing.T) {
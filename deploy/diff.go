@@ -0,0 +1,201 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !nodeploy
+// +build !nodeploy
+
+package deploy
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/dustin/go-humanize"
+	"gocloud.dev/blob"
+)
+
+const (
+	diffFormatText = "text"
+	diffFormatJSON = "json"
+
+	diffActionUpload = "upload"
+	diffActionDelete = "delete"
+	diffActionSkip   = "skip"
+
+	// reasonMetadata is reported for skipped files with remote headers that
+	// differ from the local ones. Such files are only re-uploaded with
+	// --force or a matcher with force set.
+	reasonMetadata uploadReason = "metadata differs"
+)
+
+// Diff describes the changes a deployment would apply to the target.
+type Diff struct {
+	Target string `json:"target"`
+
+	Uploads    int    `json:"uploads"`
+	UploadSize uint64 `json:"uploadSize"`
+	Deletes    int    `json:"deletes"`
+	DeleteSize uint64 `json:"deleteSize"`
+	Skips      int    `json:"skips"`
+
+	Files []DiffFile `json:"files"`
+}
+
+// DiffFile describes what a deployment would do with a file.
+type DiffFile struct {
+	// The slash separated path of the file.
+	Path string `json:"path"`
+
+	// One of upload, delete or skip.
+	Action string `json:"action"`
+
+	// Why the file would be uploaded, e.g. "md5 differs", or why a skipped
+	// file differs, e.g. "metadata differs".
+	Reason string `json:"reason,omitempty"`
+
+	// Details about the reason, e.g. the differing headers.
+	Details []string `json:"details,omitempty"`
+
+	// The size of the content to upload and of the remote file.
+	// Nil if the file doesn't exist locally or remotely.
+	LocalSize  *int64 `json:"localSize,omitempty"`
+	RemoteSize *int64 `json:"remoteSize,omitempty"`
+
+	// The hex encoded MD5 hashes of the content to upload and of the remote
+	// file, if known.
+	LocalMD5  string `json:"localMD5,omitempty"`
+	RemoteMD5 string `json:"remoteMD5,omitempty"`
+}
+
+// newDiff creates a Diff from the results of findDiffs.
+// For files that don't need to be uploaded, the remote headers are fetched
+// and compared to the local ones. The remote files are stored below prefix.
+func newDiff(ctx context.Context, bucket *blob.Bucket, prefix, target string, local map[string]*localFile, remote map[string]*blob.ListObject, uploads []*fileToUpload, deletes []string) (*Diff, error) {
+	diff := &Diff{Target: target, Files: []DiffFile{}}
+
+	uploading := make(map[string]*fileToUpload)
+	for _, u := range uploads {
+		uploading[u.Local.SlashPath] = u
+	}
+
+	for path, lf := range local {
+		size := lf.UploadSize
+		f := DiffFile{
+			Path:      path,
+			LocalSize: &size,
+			LocalMD5:  hex.EncodeToString(lf.MD5()),
+		}
+		if rf, ok := remote[path]; ok {
+			size := rf.Size
+			f.RemoteSize = &size
+			f.RemoteMD5 = hex.EncodeToString(rf.MD5)
+		}
+		if u, ok := uploading[path]; ok {
+			f.Action = diffActionUpload
+			f.Reason = string(u.Reason)
+			diff.Uploads++
+			diff.UploadSize += uint64(lf.UploadSize)
+		} else {
+			f.Action = diffActionSkip
+			diff.Skips++
+			// Providers giving us a SHA-256 checksum don't store any headers.
+			if _, ok := remoteSHA256(remote[path]); !ok {
+				attrs, err := bucket.Attributes(ctx, prefix+path)
+				if err != nil {
+					return nil, err
+				}
+				if details := metadataDiffs(lf, attrs); len(details) > 0 {
+					f.Reason = string(reasonMetadata)
+					f.Details = details
+				}
+			}
+		}
+		diff.Files = append(diff.Files, f)
+	}
+
+	for _, path := range deletes {
+		f := DiffFile{Path: path, Action: diffActionDelete}
+		if rf, ok := remote[path]; ok {
+			size := rf.Size
+			f.RemoteSize = &size
+			f.RemoteMD5 = hex.EncodeToString(rf.MD5)
+			diff.DeleteSize += uint64(rf.Size)
+		}
+		diff.Deletes++
+		diff.Files = append(diff.Files, f)
+	}
+
+	sort.Slice(diff.Files, func(i, j int) bool { return diff.Files[i].Path < diff.Files[j].Path })
+
+	return diff, nil
+}
+
+// metadataDiffs returns the headers of the remote file that differ from
+// the ones configured for lf.
+// An empty local Content-Type is inferred on upload and never reported.
+func metadataDiffs(lf *localFile, attrs *blob.Attributes) []string {
+	var diffs []string
+	check := func(name, local, remote string) {
+		if local != remote {
+			diffs = append(diffs, fmt.Sprintf("%s: %q => %q", name, remote, local))
+		}
+	}
+	check("Cache-Control", lf.CacheControl(), attrs.CacheControl)
+	check("Content-Encoding", lf.ContentEncoding(), attrs.ContentEncoding)
+	if ct := lf.ContentType(); ct != "" && !strings.EqualFold(strings.Split(attrs.ContentType, ";")[0], strings.Split(ct, ";")[0]) {
+		diffs = append(diffs, fmt.Sprintf("%s: %q => %q", "Content-Type", attrs.ContentType, ct))
+	}
+	return diffs
+}
+
+// Write writes the diff to w in the given format, text or json.
+func (d *Diff) Write(w io.Writer, format string) error {
+	switch format {
+	case diffFormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(d)
+	case diffFormatText, "":
+	default:
+		return fmt.Errorf("invalid diff format %q, must be one of %q or %q", format, diffFormatText, diffFormatJSON)
+	}
+
+	size := func(s *int64) string {
+		if s == nil {
+			return "-"
+		}
+		return humanize.Bytes(uint64(*s))
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ACTION\tPATH\tLOCAL\tREMOTE\tREASON")
+	for _, f := range d.Files {
+		reason := f.Reason
+		if len(f.Details) > 0 {
+			reason += " (" + strings.Join(f.Details, ", ") + ")"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", f.Action, f.Path, size(f.LocalSize), size(f.RemoteSize), reason)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "\n%d file(s) to upload (%s), %d file(s) to delete (%s), %d file(s) unchanged.\n",
+		d.Uploads, humanize.Bytes(d.UploadSize), d.Deletes, humanize.Bytes(d.DeleteSize), d.Skips)
+	return err
+}
@@ -11,12 +11,14 @@ import (
 
 	"github.com/gohugoio/hugo/common/hexec"
 	"github.com/gohugoio/hugo/common/loggers"
+	"github.com/gohugoio/hugo/common/paths"
 	"github.com/gohugoio/hugo/config"
 	"github.com/gohugoio/hugo/config/allconfig"
 	"github.com/gohugoio/hugo/config/security"
 	"github.com/gohugoio/hugo/helpers"
 	"github.com/gohugoio/hugo/hugofs"
 	"github.com/gohugoio/hugo/media"
+	"github.com/gohugoio/hugo/publishtargets"
 	"github.com/gohugoio/hugo/resources/page"
 	"github.com/gohugoio/hugo/resources/postpub"
 
@@ -141,7 +143,7 @@ func (d *Deps) Init() error {
 	}
 
 	if d.Metrics == nil && d.Conf.TemplateMetrics() {
-		d.Metrics = metrics.NewProvider(d.Conf.TemplateMetricsHints())
+		d.Metrics = metrics.NewProvider(d.Conf.TemplateMetricsHints(), d.Conf.TemplateMetricsLimit())
 	}
 
 	if d.ExecHelper == nil {
@@ -149,6 +151,10 @@ func (d *Deps) Init() error {
 	}
 
 	if d.PathSpec == nil {
+		if err := d.initPublishTargets(); err != nil {
+			return err
+		}
+
 		hashBytesReceiverFunc := func(name string, match bool) {
 			if !match {
 				return
@@ -162,6 +168,16 @@ func (d *Deps) Init() error {
 			ext := strings.TrimPrefix(filepath.Ext(name), ".")
 			return mediaTypes.IsTextSuffix(ext)
 		}
+		if d.Conf.GetConfigSection("build").(config.BuildConfig).Manifest != "" {
+			// Track the published files for the build manifest.
+			tracked := hugofs.WalkFilesystems(d.Fs.PublishDir, func(fs afero.Fs) bool {
+				_, ok := fs.(hugofs.CreatedFilenamesReporter)
+				return ok
+			})
+			if !tracked {
+				d.Fs.PublishDir = hugofs.NewCreateCountingFs(d.Fs.PublishDir)
+			}
+		}
 		d.Fs.PublishDir = hugofs.NewHasBytesReceiver(d.Fs.PublishDir, hashBytesSHouldCheck, hashBytesReceiverFunc, []byte(postpub.PostProcessPrefix))
 		pathSpec, err := helpers.NewPathSpec(d.Fs, d.Conf, d.Log)
 		if err != nil {
@@ -204,6 +220,43 @@ func (d *Deps) Init() error {
 	return nil
 }
 
+// initPublishTargets routes the files of the sections in publishTargets to
+// their publish directories.
+// In server mode, all files are served from the publish directory.
+func (d *Deps) initPublishTargets() error {
+	conf := d.Conf.GetConfigSection("publishTargets").(publishtargets.Config)
+	if len(conf) == 0 || d.Conf.Running() {
+		return nil
+	}
+	if _, ok := d.Fs.PublishDir.(*publishtargets.Fs); ok {
+		return nil
+	}
+
+	workingDir := d.Conf.BaseConfig().WorkingDir
+	publishDir := paths.AbsPathify(workingDir, d.Conf.BaseConfig().PublishDir)
+	fss := make(map[string]afero.Fs)
+	for name, t := range conf {
+		dir := paths.AbsPathify(workingDir, t.Dir)
+		if dir == publishDir {
+			return fmt.Errorf("publishTargets.%s: dir %q is the publishDir", name, t.Dir)
+		}
+		fss[name] = afero.NewBasePathFs(d.Fs.Source, dir)
+	}
+
+	var languages []string
+	for _, l := range d.Conf.Languages() {
+		languages = append(languages, l.Lang)
+	}
+	r := publishtargets.NewRouter(conf, languages)
+
+	if d.Fs.PublishDirStatic == d.Fs.PublishDir {
+		d.Fs.PublishDirStatic = publishtargets.NewFs(d.Fs.PublishDirStatic, fss, r)
+	}
+	d.Fs.PublishDir = publishtargets.NewFs(d.Fs.PublishDir, fss, r)
+
+	return nil
+}
+
 func (d *Deps) Compile(prototype *Deps) error {
 	var err error
 	if prototype == nil {
@@ -340,6 +393,26 @@ type BuildState struct {
 	// A set of ilenames in /public that
 	// contains a post-processing prefix.
 	filenamesWithPostPrefix map[string]bool
+
+	// The source files changed since the last build.
+	changedPaths []string
+}
+
+// SetChangedPaths sets the paths of the source files changed since the last
+// build, relative to the project and the component folder, e.g.
+// "data/weather.toml". This is empty on full builds.
+func (b *BuildState) SetChangedPaths(paths []string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.changedPaths = paths
+}
+
+// ChangedPaths returns the paths of the source files changed since the last
+// build, see SetChangedPaths.
+func (b *BuildState) ChangedPaths() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.changedPaths
 }
 
 func (b *BuildState) AddFilenameWithPostPrefix(filename string) {
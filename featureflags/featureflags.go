@@ -0,0 +1,178 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package featureflags provides the feature flags defined in the site config,
+// available in the templates as site.Flags.
+package featureflags
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/parser/metadecoders"
+)
+
+const flagsConfigKey = "flags"
+
+// Config holds the feature flags keyed by their lower case name.
+// The type of a flag, bool, string, int or float, is the type of its value.
+type Config map[string]any
+
+// DecodeConfig creates a Config from a given Hugo configuration, with the
+// flags in overrides, e.g. set with --flag, parsed to the type of the
+// configured value.
+func DecodeConfig(cfg config.Provider, overrides map[string]string) (Config, error) {
+	c := make(Config)
+
+	for k, v := range cfg.GetStringMap(flagsConfigKey) {
+		if k == maps.MergeStrategyKey {
+			continue
+		}
+		switch vv := v.(type) {
+		case bool, string:
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+			v = int64FromAny(vv)
+		case float32:
+			v = float64(vv)
+		case float64:
+		default:
+			return nil, fmt.Errorf("flags: invalid type %T for flag %q, must be one of bool, string, int or float", v, k)
+		}
+		c[strings.ToLower(k)] = v
+	}
+
+	for k, s := range overrides {
+		k = strings.ToLower(k)
+		v, found := c[k]
+		if !found {
+			return nil, fmt.Errorf("flags: unknown flag %q", k)
+		}
+		vv, err := metadecoders.Default.UnmarshalStringTo(s, v)
+		if err != nil {
+			return nil, fmt.Errorf("flags: invalid value %q for flag %q: %w", s, k, err)
+		}
+		c[k] = vv
+	}
+
+	return c, nil
+}
+
+func int64FromAny(v any) int64 {
+	switch vv := v.(type) {
+	case int:
+		return int64(vv)
+	case int8:
+		return int64(vv)
+	case int16:
+		return int64(vv)
+	case int32:
+		return int64(vv)
+	case int64:
+		return vv
+	case uint:
+		return int64(vv)
+	case uint8:
+		return int64(vv)
+	case uint16:
+		return int64(vv)
+	case uint32:
+		return int64(vv)
+	case uint64:
+		return int64(vv)
+	}
+	return 0
+}
+
+// Flags provides the feature flags to the templates and tracks their usage,
+// so flags not used anymore can be found.
+type Flags struct {
+	conf Config
+
+	mu   sync.Mutex
+	used map[string]bool
+}
+
+// New creates a new Flags from conf.
+func New(conf Config) *Flags {
+	return &Flags{conf: conf, used: make(map[string]bool)}
+}
+
+func (f *Flags) lookup(name string) (any, error) {
+	name = strings.ToLower(name)
+	v, found := f.conf[name]
+	if !found {
+		return nil, fmt.Errorf("feature flag %q is not defined in the flags config", name)
+	}
+	f.mu.Lock()
+	f.used[name] = true
+	f.mu.Unlock()
+	return v, nil
+}
+
+// Get returns the value of the flag name.
+// It is an error to get a flag not defined in the config.
+func (f *Flags) Get(name string) (any, error) {
+	return f.lookup(name)
+}
+
+// Enabled reports whether the bool flag name is true.
+func (f *Flags) Enabled(name string) (bool, error) {
+	v, err := f.lookup(name)
+	if err != nil {
+		return false, err
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("feature flag %q is a %T, not a bool", strings.ToLower(name), v)
+	}
+	return b, nil
+}
+
+// IsDefined reports whether the flag name is defined in the config.
+// This does not count as a usage of the flag.
+func (f *Flags) IsDefined(name string) bool {
+	_, found := f.conf[strings.ToLower(name)]
+	return found
+}
+
+// Len returns the number of flags defined.
+func (f *Flags) Len() int {
+	return len(f.conf)
+}
+
+// Unused returns the sorted names of the flags not used in any build so far.
+func (f *Flags) Unused() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var unused []string
+	for name := range f.conf {
+		if !f.used[name] {
+			unused = append(unused, name)
+		}
+	}
+	sort.Strings(unused)
+	return unused
+}
+
+// StatsString returns a summary of the flags usage for the build report.
+func (f *Flags) StatsString() string {
+	unused := f.Unused()
+	if len(unused) == 0 {
+		return fmt.Sprintf("Feature flags: %d, all used", f.Len())
+	}
+	return fmt.Sprintf("Feature flags: %d, unused: %s", f.Len(), strings.Join(unused, ", "))
+}
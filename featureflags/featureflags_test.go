@@ -0,0 +1,77 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package featureflags
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/config"
+)
+
+func TestDecodeConfig(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := config.New()
+	cfg.Set("flags", map[string]any{
+		"newNav":   false,
+		"theme":    "dark",
+		"maxItems": 10,
+		"ratio":    0.5,
+	})
+
+	conf, err := DecodeConfig(cfg, nil)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf, qt.DeepEquals, Config{"newnav": false, "theme": "dark", "maxitems": int64(10), "ratio": 0.5})
+
+	conf, err = DecodeConfig(cfg, map[string]string{"newNav": "true", "maxitems": "20", "theme": "light"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf["newnav"], qt.Equals, true)
+	c.Assert(conf["maxitems"], qt.Equals, int64(20))
+	c.Assert(conf["theme"], qt.Equals, "light")
+
+	_, err = DecodeConfig(cfg, map[string]string{"nosuchflag": "true"})
+	c.Assert(err, qt.ErrorMatches, `flags: unknown flag "nosuchflag"`)
+
+	_, err = DecodeConfig(cfg, map[string]string{"maxitems": "many"})
+	c.Assert(err, qt.ErrorMatches, `flags: invalid value "many" for flag "maxitems".*`)
+
+	cfg.Set("flags", map[string]any{"list": []any{"a"}})
+	_, err = DecodeConfig(cfg, nil)
+	c.Assert(err, qt.ErrorMatches, `flags: invalid type .* for flag "list".*`)
+}
+
+func TestFlags(t *testing.T) {
+	c := qt.New(t)
+
+	f := New(Config{"newnav": true, "theme": "dark", "oldfooter": false})
+
+	b, err := f.Enabled("newNav")
+	c.Assert(err, qt.IsNil)
+	c.Assert(b, qt.IsTrue)
+
+	v, err := f.Get("theme")
+	c.Assert(err, qt.IsNil)
+	c.Assert(v, qt.Equals, "dark")
+
+	_, err = f.Get("nosuchflag")
+	c.Assert(err, qt.ErrorMatches, `feature flag "nosuchflag" is not defined.*`)
+
+	_, err = f.Enabled("theme")
+	c.Assert(err, qt.ErrorMatches, `feature flag "theme" is a string, not a bool`)
+
+	c.Assert(f.IsDefined("oldFooter"), qt.IsTrue)
+	c.Assert(f.Unused(), qt.DeepEquals, []string{"oldfooter"})
+	c.Assert(f.StatsString(), qt.Equals, "Feature flags: 3, unused: oldfooter")
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package featureflags_test
+
+import (
+	"bytes"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+const flagsFiles = `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404", "section", "page"]
+[flags]
+newNav = false
+theme = "dark"
+maxItems = 3
+oldFooter = true
+-- layouts/index.html --
+NewNav: {{ site.Flags.Enabled "newNav" }}|Theme: {{ site.Flags.Get "theme" }}|MaxItems: {{ site.Flags.Get "maxItems" }}|
+`
+
+func TestFlags(t *testing.T) {
+	t.Parallel()
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: flagsFiles,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html", "NewNav: false|Theme: dark|MaxItems: 3|")
+
+	var buf bytes.Buffer
+	b.H.PrintProcessingStats(&buf)
+	b.Assert(buf.String(), qt.Contains, "Feature flags: 4, unused: oldfooter")
+}
+
+func TestFlagsEnvOverride(t *testing.T) {
+	t.Parallel()
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: flagsFiles,
+			Environ:     []string{"HUGO_FLAGS_NEWNAV=true", "HUGO_FLAGS_MAXITEMS=5"},
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html", "NewNav: true|Theme: dark|MaxItems: 5|")
+}
+
+func TestFlagsUndefined(t *testing.T) {
+	t.Parallel()
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: flagsFiles + "{{ site.Flags.Enabled \"noSuchFlag\" }}\n",
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `feature flag "nosuchflag" is not defined`)
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package features resolves the features provided and required by Hugo and
+// the modules in use, available in the templates as site.Features.
+package features
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/hugo"
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/modules"
+)
+
+const featuresConfigKey = "features"
+
+// ProviderHugo is the provider of the features built into Hugo.
+const ProviderHugo = "hugo"
+
+// Config holds the features enabled or disabled in the site config,
+// keyed by their lower case name.
+type Config map[string]bool
+
+// DecodeConfig creates a Config from a given Hugo configuration.
+func DecodeConfig(cfg config.Provider) (Config, error) {
+	c := make(Config)
+
+	for k, v := range cfg.GetStringMap(featuresConfigKey) {
+		if k == maps.MergeStrategyKey {
+			continue
+		}
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("features: invalid value %v for feature %q, must be true or false", v, k)
+		}
+		c[strings.ToLower(k)] = b
+	}
+
+	return c, nil
+}
+
+// hugoFeatures returns the features provided by the running Hugo binary.
+func hugoFeatures() []string {
+	var names []string
+	if hugo.IsExtended {
+		names = append(names, "extended")
+	}
+	return names
+}
+
+type feature struct {
+	provider string
+	enabled  bool
+}
+
+// Features holds the features provided by Hugo and the modules in use.
+// The zero value has no features.
+type Features struct {
+	m map[string]feature
+}
+
+// New resolves the features provided by Hugo and mods, applies the site
+// config in conf and checks that the features required by mods are
+// provided and enabled.
+// The modules are expected in order of precedence, the project first.
+func New(conf Config, mods modules.Modules) (*Features, error) {
+	f := &Features{m: make(map[string]feature)}
+
+	for _, name := range hugoFeatures() {
+		f.m[name] = feature{provider: ProviderHugo, enabled: true}
+	}
+
+	for _, mod := range mods {
+		for _, name := range mod.Config().Features.Provides {
+			if _, found := f.m[name]; !found {
+				f.m[name] = feature{provider: mod.Path(), enabled: true}
+			}
+		}
+	}
+
+	for name, enabled := range conf {
+		ff, found := f.m[name]
+		if !found {
+			return nil, fmt.Errorf("features: %q is not provided by Hugo or any module in use", name)
+		}
+		if ff.provider == ProviderHugo {
+			return nil, fmt.Errorf("features: %q is provided by Hugo and cannot be disabled", name)
+		}
+		ff.enabled = enabled
+		f.m[name] = ff
+	}
+
+	for _, mod := range mods {
+		for _, name := range mod.Config().Features.Requires {
+			ff, found := f.m[name]
+			if !found {
+				return nil, fmt.Errorf("module %q requires feature %q, which is not provided by this Hugo binary or any module in use", mod.Path(), name)
+			}
+			if !ff.enabled {
+				return nil, fmt.Errorf("module %q requires feature %q, which is disabled in the site config", mod.Path(), name)
+			}
+		}
+	}
+
+	return f, nil
+}
+
+// Enabled reports whether the feature name is provided and enabled.
+func (f *Features) Enabled(name string) bool {
+	return f.m[strings.ToLower(name)].enabled
+}
+
+// Provider returns the path of the module providing the feature name,
+// "hugo" for features built into Hugo, or an empty string if not provided.
+// Features disabled in the site config still have a provider.
+func (f *Features) Provider(name string) string {
+	return f.m[strings.ToLower(name)].provider
+}
+
+// List returns the sorted names of the enabled features.
+func (f *Features) List() []string {
+	var names []string
+	for name, ff := range f.m {
+		if ff.enabled {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
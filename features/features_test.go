@@ -0,0 +1,48 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package features
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/config"
+)
+
+func TestDecodeConfig(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := config.New()
+	cfg.Set("features", map[string]any{
+		"Search":    true,
+		"dark-mode": false,
+	})
+
+	conf, err := DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf, qt.DeepEquals, Config{"search": true, "dark-mode": false})
+
+	cfg.Set("features", map[string]any{"search": "yes"})
+	_, err = DecodeConfig(cfg)
+	c.Assert(err, qt.ErrorMatches, `features: invalid value yes for feature "search", must be true or false`)
+}
+
+func TestFeaturesZero(t *testing.T) {
+	c := qt.New(t)
+
+	f := &Features{}
+	c.Assert(f.Enabled("search"), qt.IsFalse)
+	c.Assert(f.Provider("search"), qt.Equals, "")
+	c.Assert(f.List(), qt.HasLen, 0)
+}
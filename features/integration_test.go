@@ -0,0 +1,109 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package features_test
+
+import (
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+const featuresFiles = `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404", "section", "page"]
+theme = ["mytheme", "mysearch"]
+[features]
+dark-mode = false
+-- themes/mytheme/hugo.toml --
+[module.features]
+provides = ["Dark-Mode", "comments"]
+requires = ["search"]
+-- themes/mysearch/hugo.toml --
+[module.features]
+provides = ["search"]
+-- layouts/index.html --
+Search: {{ site.Features.Enabled "search" }}|Provider: {{ site.Features.Provider "search" }}|
+DarkMode: {{ site.Features.Enabled "dark-mode" }}|Provider: {{ site.Features.Provider "dark-mode" }}|
+None: {{ site.Features.Enabled "none" }}|
+List: {{ delimit (where site.Features.List "." "ne" "extended") "," }}|
+`
+
+func TestFeatures(t *testing.T) {
+	t.Parallel()
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: featuresFiles,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html",
+		"Search: true|Provider: mysearch|",
+		"DarkMode: false|Provider: mytheme|",
+		"None: false|",
+		"List: comments,search|",
+	)
+}
+
+func TestFeaturesRequirementNotProvided(t *testing.T) {
+	t.Parallel()
+
+	files := strings.Replace(featuresFiles, `theme = ["mytheme", "mysearch"]`, `theme = ["mytheme"]`, 1)
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `module "mytheme" requires feature "search", which is not provided by this Hugo binary or any module in use`)
+}
+
+func TestFeaturesRequirementDisabled(t *testing.T) {
+	t.Parallel()
+
+	files := strings.Replace(featuresFiles, `dark-mode = false`, `search = false`, 1)
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `module "mytheme" requires feature "search", which is disabled in the site config`)
+}
+
+func TestFeaturesNotProvided(t *testing.T) {
+	t.Parallel()
+
+	files := strings.Replace(featuresFiles, `dark-mode = false`, `no-such-feature = false`, 1)
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `features: "no-such-feature" is not provided by Hugo or any module in use`)
+}
@@ -178,24 +178,51 @@ func TotalWords(s string) int {
 	return n
 }
 
+// TruncateSummary truncates the plain text s, with words being its fields,
+// to an automatic summary as configured in the summary config.
+// It also returns whether it is truncated.
+func (c *ContentSpec) TruncateSummary(s string, words []string, isCJKLanguage bool) (string, bool) {
+	sc := c.Cfg.Summary()
+	switch sc.Unit {
+	case config.SummaryUnitSentences:
+		return truncateToSentences(s, sc.Length)
+	case config.SummaryUnitCharacters:
+		return truncateToCharacters(s, sc.Length, isCJKLanguage)
+	default:
+		if isCJKLanguage {
+			return c.TruncateWordsByRune(words)
+		}
+		return c.TruncateWordsToWholeSentence(s)
+	}
+}
+
+// summaryWordsLength returns the configured summary length in words.
+func (c *ContentSpec) summaryWordsLength() int {
+	if sc := c.Cfg.Summary(); sc.Unit == config.SummaryUnitWords && sc.Length > 0 {
+		return sc.Length
+	}
+	return c.Cfg.SummaryLength()
+}
+
 // TruncateWordsByRune truncates words by runes.
 func (c *ContentSpec) TruncateWordsByRune(in []string) (string, bool) {
+	summaryLength := c.summaryWordsLength()
 	words := make([]string, len(in))
 	copy(words, in)
 
 	count := 0
 	for index, word := range words {
-		if count >= c.Cfg.SummaryLength() {
+		if count >= summaryLength {
 			return strings.Join(words[:index], " "), true
 		}
 		runeCount := utf8.RuneCountInString(word)
 		if len(word) == runeCount {
 			count++
-		} else if count+runeCount < c.Cfg.SummaryLength() {
+		} else if count+runeCount < summaryLength {
 			count += runeCount
 		} else {
 			for ri := range word {
-				if count >= c.Cfg.SummaryLength() {
+				if count >= summaryLength {
 					truncatedWords := append(words[:index], word[:ri])
 					return strings.Join(truncatedWords, " "), true
 				}
@@ -211,6 +238,7 @@ func (c *ContentSpec) TruncateWordsByRune(in []string) (string, bool) {
 // limited by max number of words. It also returns whether it is truncated.
 func (c *ContentSpec) TruncateWordsToWholeSentence(s string) (string, bool) {
 	var (
+		summaryLength = c.summaryWordsLength()
 		wordCount     = 0
 		lastWordIndex = -1
 	)
@@ -220,7 +248,7 @@ func (c *ContentSpec) TruncateWordsToWholeSentence(s string) (string, bool) {
 			wordCount++
 			lastWordIndex = i
 
-			if wordCount >= c.Cfg.SummaryLength() {
+			if wordCount >= summaryLength {
 				break
 			}
 
@@ -247,6 +275,108 @@ func (c *ContentSpec) TruncateWordsToWholeSentence(s string) (string, bool) {
 	return strings.TrimSpace(s[:endIndex]), endIndex < len(s)
 }
 
+// truncateToSentences truncates s after n sentences.
+func truncateToSentences(s string, n int) (string, bool) {
+	count := 0
+	for i, r := range s {
+		if !isSentenceTerminator(r) {
+			continue
+		}
+		end := i + utf8.RuneLen(r)
+		// Include any closing quotes or brackets.
+		for end < len(s) {
+			r2, size := utf8.DecodeRuneInString(s[end:])
+			if !isClosingPunctuation(r2) {
+				break
+			}
+			end += size
+		}
+		// Unless this is a CJK full stop, a sentence needs to be followed by space,
+		// e.g. not the dot in 3.14.
+		if end < len(s) && r < utf8.RuneSelf {
+			if r2, _ := utf8.DecodeRuneInString(s[end:]); !unicode.IsSpace(r2) {
+				continue
+			}
+		}
+		count++
+		if count >= n {
+			if strings.TrimSpace(s[end:]) == "" {
+				return s, false
+			}
+			return strings.TrimSpace(s[:end]), true
+		}
+	}
+
+	return s, false
+}
+
+// truncateToCharacters truncates s to at most n characters.
+// Unless this is a CJK language, s is truncated at the last whole word.
+// HTML entities, e.g. &amp;, are counted as one character and never split.
+func truncateToCharacters(s string, n int, isCJKLanguage bool) (string, bool) {
+	count := 0
+	lastSpace := -1
+	for i := 0; i < len(s); {
+		if count >= n {
+			if strings.TrimSpace(s[i:]) == "" {
+				return s, false
+			}
+			end := i
+			if r, _ := utf8.DecodeRuneInString(s[i:]); !isCJKLanguage && !unicode.IsSpace(r) && lastSpace > 0 {
+				end = lastSpace
+			}
+			return strings.TrimSpace(s[:end]), true
+		}
+		count++
+		if s[i] == '&' {
+			if size := htmlEntityLen(s[i:]); size > 0 {
+				i += size
+				continue
+			}
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if unicode.IsSpace(r) {
+			lastSpace = i
+		}
+		i += size
+	}
+
+	return s, false
+}
+
+// htmlEntityLen returns the length of the HTML entity s starts with, 0 if none.
+func htmlEntityLen(s string) int {
+	for i := 1; i < len(s) && i < 32; i++ {
+		c := s[i]
+		if c == ';' {
+			if i == 1 {
+				return 0
+			}
+			return i + 1
+		}
+		if !(c == '#' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+			return 0
+		}
+	}
+	return 0
+}
+
+func isSentenceTerminator(r rune) bool {
+	switch r {
+	case '.', '?', '!', '。', '？', '！':
+		return true
+	}
+	return false
+}
+
+func isClosingPunctuation(r rune) bool {
+	switch r {
+	case '"', '\'', ')', ']', '”', '’', '」', '』', '）':
+		return true
+	}
+	return false
+}
+
 // TrimShortHTML removes the <p>/</p> tags from HTML input in the situation
 // where said tags are the only <p> tags in the input and enclose the content
 // of the input (whitespace excluded).
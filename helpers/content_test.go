@@ -134,6 +134,46 @@ func TestTruncateWordsByRune(t *testing.T) {
 	}
 }
 
+func TestTruncateSummary(t *testing.T) {
+	c := qt.New(t)
+
+	type test struct {
+		unit      string
+		length    int
+		cjk       bool
+		input     string
+		expected  string
+		truncated bool
+	}
+	data := []test{
+		{"sentences", 1, false, "To be. Or not to be.", "To be.", true},
+		{"sentences", 2, false, "To be. Or not to be.", "To be. Or not to be.", false},
+		{"sentences", 1, false, "Pi is 3.14. Or so they say!", "Pi is 3.14.", true},
+		{"sentences", 1, false, `He said "Stop!" and left.`, `He said "Stop!"`, true},
+		{"sentences", 2, true, "这是中文。全中文！还有。", "这是中文。全中文！", true},
+		{"sentences", 3, false, "No end", "No end", false},
+		{"characters", 10, false, "Hello world and more", "Hello", true},
+		{"characters", 11, false, "Hello world and more", "Hello world", true},
+		{"characters", 20, false, "Hello world and more", "Hello world and more", false},
+		{"characters", 3, false, "Supercalifragilistic", "Sup", true},
+		{"characters", 11, false, "Tom &amp; Jerry show", "Tom &amp; Jerry", true},
+		{"characters", 6, false, "Tom &amp; Jerry", "Tom &amp;", true},
+		{"characters", 5, false, "R&D is fun", "R&D", true},
+		{"characters", 4, true, "这是中文，全中文。", "这是中文", true},
+		{"words", 3, false, "To be. Or not to be.", "To be. Or not to be.", false},
+		{"words", 1, false, "To be. Or not to be.", "To be.", true},
+	}
+
+	for i, d := range data {
+		cfg := config.New()
+		cfg.Set("summary", map[string]any{"unit": d.unit, "length": d.length})
+		spec := newTestContentSpec(cfg)
+		output, truncated := spec.TruncateSummary(d.input, strings.Fields(d.input), d.cjk)
+		c.Assert(output, qt.Equals, d.expected, qt.Commentf("test %d", i))
+		c.Assert(truncated, qt.Equals, d.truncated, qt.Commentf("test %d", i))
+	}
+}
+
 func TestExtractTOCNormalContent(t *testing.T) {
 	content := []byte("<nav>\n<ul>\nTOC<li><a href=\"#")
 
@@ -0,0 +1,373 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package htmlaudit checks the published HTML for structural and
+// accessibility problems, e.g. duplicate ids and images without alt text.
+package htmlaudit
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/config"
+	"github.com/mitchellh/mapstructure"
+	"golang.org/x/net/html"
+)
+
+const auditConfigKey = "audit"
+
+// The severities of the findings, in increasing order.
+const (
+	SeverityWarning = "warning"
+	SeverityError   = "error"
+
+	// SeverityNone, as failOn, never fails the build.
+	SeverityNone = "none"
+)
+
+// The rules checked.
+const (
+	RuleDuplicateID    = "duplicate-id"
+	RuleImgAlt         = "img-alt"
+	RuleHeadingOrder   = "heading-order"
+	RuleInvalidNesting = "invalid-nesting"
+)
+
+var severities = map[string]string{
+	RuleDuplicateID:    SeverityError,
+	RuleImgAlt:         SeverityError,
+	RuleHeadingOrder:   SeverityWarning,
+	RuleInvalidNesting: SeverityError,
+}
+
+// Config configures the HTML audit.
+type Config struct {
+	// Enable the audit of the published HTML files.
+	Enable bool
+
+	// The report written after the build, relative to the working dir.
+	// Defaults to hugo_audit.json. Set to an empty string to only log a summary.
+	Report string
+
+	// The lowest severity of the findings that fails the build:
+	// error (default), warning or none.
+	FailOn string
+
+	// The rules to skip, e.g. ["heading-order"].
+	Disable []string
+
+	// The output formats of the pages to audit. Defaults to ["html"].
+	Formats []string
+}
+
+func newDefaultConfig() Config {
+	return Config{
+		Report:  "hugo_audit.json",
+		FailOn:  SeverityError,
+		Formats: []string{"html"},
+	}
+}
+
+// DecodeConfig creates a Config from a given Hugo configuration.
+func DecodeConfig(cfg config.Provider) (Config, error) {
+	c := newDefaultConfig()
+
+	m := cfg.GetStringMap(auditConfigKey)
+	if m == nil {
+		return c, nil
+	}
+	delete(m, maps.MergeStrategyKey)
+
+	if err := mapstructure.WeakDecode(m, &c); err != nil {
+		return c, fmt.Errorf("failed to decode audit config: %w", err)
+	}
+
+	c.FailOn = strings.ToLower(c.FailOn)
+	switch c.FailOn {
+	case SeverityError, SeverityWarning, SeverityNone:
+	default:
+		return c, fmt.Errorf("audit: invalid failOn %q, must be one of %q, %q or %q", c.FailOn, SeverityError, SeverityWarning, SeverityNone)
+	}
+
+	for i, rule := range c.Disable {
+		rule = strings.ToLower(rule)
+		if _, found := severities[rule]; !found {
+			return c, fmt.Errorf("audit: unknown rule %q in disable", rule)
+		}
+		c.Disable[i] = rule
+	}
+
+	for i, f := range c.Formats {
+		c.Formats[i] = strings.ToLower(f)
+	}
+
+	return c, nil
+}
+
+// Fails reports whether a finding with the given severity fails the build.
+func (c Config) Fails(severity string) bool {
+	switch c.FailOn {
+	case SeverityWarning:
+		return true
+	case SeverityError:
+		return severity == SeverityError
+	default:
+		return false
+	}
+}
+
+// Finding is a problem found in a published HTML file.
+type Finding struct {
+	// The published file relative to the publish directory, e.g. "docs/intro/index.html".
+	File string `json:"file"`
+
+	// The position in the published file.
+	Line   int `json:"line"`
+	Column int `json:"column"`
+
+	// The source of the page, e.g. the content file, if any.
+	Source string `json:"source,omitempty"`
+
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%q: %s: %s (%s)", fmt.Sprintf("%s:%d:%d", f.File, f.Line, f.Column), f.Severity, f.Message, f.Rule)
+}
+
+// Report is the audit report.
+type Report struct {
+	// The number of findings by severity.
+	Summary map[string]int `json:"summary"`
+
+	Findings []Finding `json:"findings"`
+}
+
+// NewReport creates a Report of findings, sorted by file and position.
+func NewReport(findings []Finding) Report {
+	r := Report{
+		Summary:  map[string]int{SeverityError: 0, SeverityWarning: 0},
+		Findings: append([]Finding{}, findings...),
+	}
+	sort.SliceStable(r.Findings, func(i, j int) bool {
+		fi, fj := r.Findings[i], r.Findings[j]
+		if fi.File != fj.File {
+			return fi.File < fj.File
+		}
+		if fi.Line != fj.Line {
+			return fi.Line < fj.Line
+		}
+		return fi.Column < fj.Column
+	})
+	for _, f := range r.Findings {
+		r.Summary[f.Severity]++
+	}
+	return r
+}
+
+// Auditor audits HTML documents.
+type Auditor struct {
+	conf     Config
+	disabled map[string]bool
+	formats  map[string]bool
+}
+
+// New creates a new Auditor for the given config.
+// It returns nil if the audit is not enabled.
+func New(conf Config) *Auditor {
+	if !conf.Enable {
+		return nil
+	}
+	a := &Auditor{
+		conf:     conf,
+		disabled: make(map[string]bool),
+		formats:  make(map[string]bool),
+	}
+	for _, rule := range conf.Disable {
+		a.disabled[rule] = true
+	}
+	for _, f := range conf.Formats {
+		a.formats[f] = true
+	}
+	return a
+}
+
+// Config returns the audit config.
+func (a *Auditor) Config() Config {
+	return a.conf
+}
+
+// HandlesFormat reports whether pages in the output format with the given
+// name should be audited.
+func (a *Auditor) HandlesFormat(name string) bool {
+	return a.formats[name]
+}
+
+// blockElements closes an open p element when started.
+var blockElements = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true,
+	"details": true, "div": true, "dl": true, "fieldset": true,
+	"figcaption": true, "figure": true, "footer": true, "form": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"header": true, "hr": true, "main": true, "nav": true, "ol": true,
+	"pre": true, "section": true, "table": true, "ul": true,
+}
+
+var voidElements = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true,
+	"hr": true, "img": true, "input": true, "link": true, "meta": true,
+	"source": true, "track": true, "wbr": true,
+}
+
+// interactiveElements can not contain each other.
+var interactiveElements = map[string]bool{
+	"a": true, "button": true,
+}
+
+// Audit checks the HTML document b published to file and returns the findings.
+func (a *Auditor) Audit(file string, b []byte) []Finding {
+	var (
+		findings []Finding
+		offset   int
+		ids      = make(map[string]int)
+		stack    []string
+		lastH    int
+	)
+
+	add := func(start int, rule, format string, args ...any) {
+		if a.disabled[rule] {
+			return
+		}
+		line, col := position(b, start)
+		findings = append(findings, Finding{
+			File:     file,
+			Line:     line,
+			Column:   col,
+			Rule:     rule,
+			Severity: severities[rule],
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	// open returns the index of the innermost open element with the given name.
+	open := func(name string) int {
+		for i := len(stack) - 1; i >= 0; i-- {
+			if stack[i] == name {
+				return i
+			}
+		}
+		return -1
+	}
+
+	z := html.NewTokenizer(bytes.NewReader(b))
+	for {
+		tt := z.Next()
+		start := offset
+		offset += len(z.Raw())
+
+		switch tt {
+		case html.ErrorToken:
+			return findings
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			tag := string(name)
+			attrs := make(map[string]string)
+			for hasAttr {
+				var key, val []byte
+				key, val, hasAttr = z.TagAttr()
+				attrs[string(key)] = string(val)
+			}
+
+			if id, found := attrs["id"]; found && id != "" {
+				if line, found := ids[id]; found {
+					add(start, RuleDuplicateID, "duplicate id %q, first used on line %d", id, line)
+				} else {
+					ids[id], _ = position(b, start)
+				}
+			}
+
+			if tag == "img" {
+				if _, found := attrs["alt"]; !found {
+					add(start, RuleImgAlt, "img %q has no alt attribute, use alt=\"\" for decorative images", attrs["src"])
+				}
+			}
+
+			if len(tag) == 2 && tag[0] == 'h' && tag[1] >= '1' && tag[1] <= '6' {
+				level := int(tag[1] - '0')
+				if lastH > 0 && level > lastH+1 {
+					add(start, RuleHeadingOrder, "heading level skipped, h%d follows h%d", level, lastH)
+				}
+				lastH = level
+			}
+
+			// Implied end tags.
+			if blockElements[tag] || tag == "p" {
+				if i := open("p"); i != -1 && !containsAny(stack[i+1:], "button", "table") {
+					if tag != "p" {
+						add(start, RuleInvalidNesting, "<%s> inside <p>", tag)
+					}
+					stack = stack[:i]
+				}
+			}
+			if tag == "li" {
+				if i := open("li"); i != -1 && !containsAny(stack[i+1:], "ul", "ol", "menu") {
+					stack = stack[:i]
+				}
+				if len(stack) == 0 || !containsAny(stack[len(stack)-1:], "ul", "ol", "menu") {
+					add(start, RuleInvalidNesting, "<li> outside of <ul>, <ol> or <menu>")
+				}
+			}
+			if interactiveElements[tag] {
+				for i := len(stack) - 1; i >= 0; i-- {
+					if interactiveElements[stack[i]] {
+						add(start, RuleInvalidNesting, "<%s> inside <%s>", tag, stack[i])
+						break
+					}
+				}
+			}
+
+			if tt == html.StartTagToken && !voidElements[tag] {
+				stack = append(stack, tag)
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			if i := open(string(name)); i != -1 {
+				stack = stack[:i]
+			}
+		}
+	}
+}
+
+func containsAny(stack []string, names ...string) bool {
+	for _, s := range stack {
+		for _, name := range names {
+			if s == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// position returns the line and column, starting at 1, of offset in b.
+func position(b []byte, offset int) (int, int) {
+	before := b[:offset]
+	line := bytes.Count(before, []byte("\n")) + 1
+	col := len([]rune(string(before[bytes.LastIndexByte(before, '\n')+1:]))) + 1
+	return line, col
+}
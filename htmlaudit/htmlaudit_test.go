@@ -0,0 +1,129 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htmlaudit
+
+import (
+	"fmt"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/config"
+)
+
+func TestDecodeConfig(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := config.New()
+	conf, err := DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Enable, qt.IsFalse)
+	c.Assert(conf.Report, qt.Equals, "hugo_audit.json")
+	c.Assert(conf.FailOn, qt.Equals, SeverityError)
+	c.Assert(conf.Formats, qt.DeepEquals, []string{"html"})
+
+	cfg.Set("audit", map[string]any{
+		"enable":  true,
+		"failOn":  "Warning",
+		"disable": []string{"Heading-Order"},
+	})
+	conf, err = DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Enable, qt.IsTrue)
+	c.Assert(conf.FailOn, qt.Equals, SeverityWarning)
+	c.Assert(conf.Disable, qt.DeepEquals, []string{RuleHeadingOrder})
+
+	for _, test := range []struct {
+		m      map[string]any
+		expect string
+	}{
+		{map[string]any{"failOn": "info"}, `audit: invalid failOn "info".*`},
+		{map[string]any{"disable": []string{"color-contrast"}}, `audit: unknown rule "color-contrast" in disable`},
+	} {
+		cfg = config.New()
+		cfg.Set("audit", test.m)
+		_, err = DecodeConfig(cfg)
+		c.Assert(err, qt.ErrorMatches, test.expect)
+	}
+}
+
+func TestConfigFails(t *testing.T) {
+	c := qt.New(t)
+
+	c.Assert(Config{FailOn: SeverityError}.Fails(SeverityError), qt.IsTrue)
+	c.Assert(Config{FailOn: SeverityError}.Fails(SeverityWarning), qt.IsFalse)
+	c.Assert(Config{FailOn: SeverityWarning}.Fails(SeverityWarning), qt.IsTrue)
+	c.Assert(Config{FailOn: SeverityNone}.Fails(SeverityError), qt.IsFalse)
+}
+
+func TestAudit(t *testing.T) {
+	c := qt.New(t)
+
+	a := New(Config{Enable: true})
+
+	audit := func(s string) []string {
+		var result []string
+		for _, f := range a.Audit("index.html", []byte(s)) {
+			result = append(result, fmt.Sprintf("%d:%d %s %s: %s", f.Line, f.Column, f.Severity, f.Rule, f.Message))
+		}
+		return result
+	}
+
+	c.Assert(audit(`<!DOCTYPE html>
+<html><head><script>if (a < b) { document.write("<p><div>") }</script></head>
+<body>
+<h1 id="title">Title</h1>
+<h2>Intro</h2>
+<p>Text<p>More text</p>
+<ul><li>One<li>Two</ul>
+<img src="deco.png" alt="">
+<p><button>Click</button></p>
+<table><tr><td><p>Cell</td></tr></table>
+<br/>
+</body></html>`), qt.IsNil)
+
+	c.Assert(audit(`<h1 id="a">Title</h1>
+<h3 id="a">Sub</h3>
+<p>Text <img src="p.png"> <div>Block</div></p>
+<li>Stray</li>
+<a href="/a/"><a href="/b/">B</a></a>
+<a href="/c/"><button>C</button></a>`), qt.DeepEquals, []string{
+		`2:1 error duplicate-id: duplicate id "a", first used on line 1`,
+		`2:1 warning heading-order: heading level skipped, h3 follows h1`,
+		`3:9 error img-alt: img "p.png" has no alt attribute, use alt="" for decorative images`,
+		`3:27 error invalid-nesting: <div> inside <p>`,
+		`4:1 error invalid-nesting: <li> outside of <ul>, <ol> or <menu>`,
+		`5:15 error invalid-nesting: <a> inside <a>`,
+		`6:15 error invalid-nesting: <button> inside <a>`,
+	})
+
+	a = New(Config{Enable: true, Disable: []string{RuleHeadingOrder, RuleImgAlt}})
+	c.Assert(audit(`<h1>Title</h1><h4>Sub</h4><img src="p.png">`), qt.IsNil)
+
+	c.Assert(New(Config{}), qt.IsNil)
+}
+
+func TestNewReport(t *testing.T) {
+	c := qt.New(t)
+
+	r := NewReport([]Finding{
+		{File: "b.html", Line: 1, Severity: SeverityError},
+		{File: "a.html", Line: 2, Severity: SeverityWarning},
+		{File: "a.html", Line: 1, Severity: SeverityError},
+	})
+
+	c.Assert(r.Summary, qt.DeepEquals, map[string]int{SeverityError: 2, SeverityWarning: 1})
+	c.Assert(r.Findings[0].File, qt.Equals, "a.html")
+	c.Assert(r.Findings[0].Line, qt.Equals, 1)
+	c.Assert(r.Findings[2].File, qt.Equals, "b.html")
+}
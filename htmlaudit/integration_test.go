@@ -0,0 +1,102 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htmlaudit_test
+
+import (
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+const auditFiles = `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+[audit]
+enable = true
+failOn = "FAILON"
+-- layouts/_default/single.html --
+<h1 id="title">{{ .Title }}</h1>
+{{ .Content }}
+-- layouts/_default/list.html --
+<h1>Home</h1><img src="/logo.png">
+-- content/p1.md --
+---
+title: P1
+---
+## Title {#title}
+-- content/p2.md --
+---
+title: P2
+---
+#### Deep
+`
+
+func TestAudit(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.ReplaceAll(auditFiles, "FAILON", "error"),
+		},
+	).BuildE()
+
+	c.Assert(err, qt.ErrorMatches, "logged 2 error\\(s\\)")
+	b.AssertLogContains(`"p1/index.html:2:1": error: duplicate id "title", first used on line 1 (duplicate-id)`)
+	b.AssertLogContains(`"index.html:1:14": error: img "/logo.png" has no alt attribute`)
+	b.AssertLogContains(`audit: found 2 error(s) and 1 warning(s), see hugo_audit.json`)
+
+	b.AssertFileContent("hugo_audit.json",
+		`"summary": {
+    "error": 2,
+    "warning": 1
+  }`,
+		`"file": "p2/index.html"`,
+		`"source": "/content/p2.md"`,
+		`"rule": "heading-order"`,
+		`"severity": "warning"`,
+	)
+}
+
+func TestAuditFailOnNone(t *testing.T) {
+	t.Parallel()
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.ReplaceAll(auditFiles, "FAILON", "none"),
+		},
+	).Build()
+
+	b.AssertLogContains(`audit: found 2 error(s) and 1 warning(s)`)
+	b.AssertFileContent("hugo_audit.json", `"rule": "img-alt"`)
+}
+
+func TestAuditInvalidConfig(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	_, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.ReplaceAll(auditFiles, "FAILON", "info"),
+		},
+	).BuildE()
+
+	c.Assert(err, qt.ErrorMatches, `.*audit: invalid failOn "info".*`)
+}
@@ -33,8 +33,14 @@ type DuplicatesReporter interface {
 	ReportDuplicates() string
 }
 
+// CreatedFilenamesReporter reports the filenames of the created files.
+type CreatedFilenamesReporter interface {
+	CreatedFilenames() []string
+}
+
 var (
-	_ FilesystemUnwrapper = (*createCountingFs)(nil)
+	_ FilesystemUnwrapper      = (*createCountingFs)(nil)
+	_ CreatedFilenamesReporter = (*createCountingFs)(nil)
 )
 
 func NewCreateCountingFs(fs afero.Fs) afero.Fs {
@@ -67,6 +73,21 @@ func (c *createCountingFs) ReportDuplicates() string {
 	return strings.Join(dupes, ", ")
 }
 
+// CreatedFilenames returns the sorted filenames of the files created
+// since the last Reset.
+func (c *createCountingFs) CreatedFilenames() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	filenames := make([]string, 0, len(c.fileCount))
+	for k := range c.fileCount {
+		filenames = append(filenames, k)
+	}
+	sort.Strings(filenames)
+
+	return filenames
+}
+
 // createCountingFs counts filenames of created files or files opened
 // for writing.
 type createCountingFs struct {
@@ -82,6 +82,9 @@ type FileMeta struct {
 
 	// Include only files or directories that match.
 	InclusionFilter *glob.FilenameFilter
+
+	// Renames the files in the mount, e.g. README.md to _index.md.
+	Renamer *glob.FilenameRenamer
 }
 
 func (m *FileMeta) Copy() *FileMeta {
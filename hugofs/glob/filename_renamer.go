@@ -0,0 +1,72 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glob
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// FilenameRenamer renames files by their base name, e.g. README.md to _index.md.
+type FilenameRenamer struct {
+	rules []renameRule
+}
+
+type renameRule struct {
+	re          *regexp.Regexp
+	replacement string
+}
+
+// NewFilenameRenamer creates a new FilenameRenamer from rules on the form
+// "pattern -> replacement", where pattern is a regular expression matched
+// against the base name and replacement may refer to its submatches, e.g. $1.
+// It returns nil if no rules are given.
+func NewFilenameRenamer(rules []string) (*FilenameRenamer, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	r := &FilenameRenamer{}
+	for _, rule := range rules {
+		pattern, replacement, found := strings.Cut(rule, "->")
+		pattern, replacement = strings.TrimSpace(pattern), strings.TrimSpace(replacement)
+		if !found || pattern == "" || replacement == "" {
+			return nil, fmt.Errorf(`invalid rename rule %q, must be on the form "pattern -> replacement"`, rule)
+		}
+		if strings.ContainsAny(replacement, `/\`) {
+			return nil, fmt.Errorf("invalid rename rule %q, the replacement must be a file name, not a path", rule)
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rename rule %q: %w", rule, err)
+		}
+		r.rules = append(r.rules, renameRule{re: re, replacement: replacement})
+	}
+	return r, nil
+}
+
+// Rename returns the new name for the base name, using the first matching rule.
+// Only the matched part of the name is replaced, so anchor the pattern to
+// replace all of it. It returns name unchanged if no rule matches.
+func (r *FilenameRenamer) Rename(name string) string {
+	if r == nil {
+		return name
+	}
+	for _, rule := range r.rules {
+		if rule.re.MatchString(name) {
+			return rule.re.ReplaceAllString(name, rule.replacement)
+		}
+	}
+	return name
+}
@@ -0,0 +1,44 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package glob
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestFilenameRenamer(t *testing.T) {
+	c := qt.New(t)
+
+	r, err := NewFilenameRenamer([]string{"^README.md$ -> _index.md", `^(.*)\.markdown$->$1.md`, "^README -> index.md"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(r.Rename("README.md"), qt.Equals, "_index.md")
+	c.Assert(r.Rename("post.markdown"), qt.Equals, "post.md")
+	// Only the matched part is replaced.
+	c.Assert(r.Rename("README.txt"), qt.Equals, "index.md.txt")
+	c.Assert(r.Rename("other.md"), qt.Equals, "other.md")
+
+	var nilRenamer *FilenameRenamer
+	c.Assert(nilRenamer.Rename("README.md"), qt.Equals, "README.md")
+
+	r, err = NewFilenameRenamer(nil)
+	c.Assert(err, qt.IsNil)
+	c.Assert(r, qt.IsNil)
+
+	for _, rule := range []string{"README.md", "README.md ->", "[ -> a.md", "a.md -> b/c.md"} {
+		_, err = NewFilenameRenamer([]string{rule})
+		c.Assert(err, qt.IsNotNil, qt.Commentf(rule))
+	}
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/gohugoio/hugo/common/herrors"
 	"github.com/gohugoio/hugo/hugofs/files"
+	"github.com/gohugoio/hugo/hugofs/glob"
 
 	radix "github.com/armon/go-radix"
 	"github.com/spf13/afero"
@@ -393,6 +394,8 @@ func (fs *RootMappingFs) collectDirEntries(prefix string) ([]os.FileInfo, error)
 				continue
 			}
 
+			renameFile(fi, rm.Meta.Renamer)
+
 			if fi.IsDir() {
 				name := fi.Name()
 				if seen[name] {
@@ -635,6 +638,7 @@ func (f *rootMappingFile) Readdir(count int) ([]os.FileInfo, error) {
 			fim := decorateFileInfo(fi, f.fs, nil, "", "", f.meta)
 			meta := fim.Meta()
 			if f.meta.InclusionFilter.Match(strings.TrimPrefix(meta.Filename, meta.SourceRoot), fim.IsDir()) {
+				renameFile(fim, f.meta.Renamer)
 				result = append(result, fim)
 			}
 		}
@@ -651,3 +655,14 @@ func (f *rootMappingFile) Readdirnames(count int) ([]string, error) {
 	}
 	return fileInfosToNames(dirs), nil
 }
+
+// renameFile sets the name of the file fi as given by renamer, which will
+// be used when walking the filesystem.
+// Note that the file can not be looked up by its new name.
+func renameFile(fi os.FileInfo, renamer *glob.FilenameRenamer) {
+	if renamer == nil || fi.IsDir() {
+		return
+	}
+	meta := fi.(FileMetaInfo).Meta()
+	meta.Name = renamer.Rename(fi.Name())
+}
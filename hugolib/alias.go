@@ -28,6 +28,7 @@ import (
 
 	"github.com/gohugoio/hugo/output"
 	"github.com/gohugoio/hugo/publisher"
+	"github.com/gohugoio/hugo/resources"
 	"github.com/gohugoio/hugo/resources/page"
 	"github.com/gohugoio/hugo/tpl"
 )
@@ -103,9 +104,20 @@ func (s *Site) publishDestAlias(allowRoot bool, path, permalink string, outputFo
 		pd.AbsURLPath = s.absURLPath(targetPath)
 	}
 
+	s.addToBuildManifest(targetPath, resources.BuildManifestTypeAlias, p, nil)
+
 	return s.publisher.Publish(pd)
 }
 
+// redirectFrom returns the path the alias a is served from, as used in the redirects map.
+func (s *Site) redirectFrom(a string) string {
+	from := path.Clean("/" + filepath.ToSlash(a))
+	if from != "/" && !strings.HasSuffix(from, ".html") {
+		from += "/"
+	}
+	return s.PathSpec.PrependBasePath(from, true)
+}
+
 func (a aliasHandler) targetPathAlias(src string) (string, error) {
 	originalAlias := src
 	if len(src) <= 0 {
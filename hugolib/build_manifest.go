@@ -0,0 +1,257 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/hugo"
+	"github.com/gohugoio/hugo/hugofs"
+	"github.com/gohugoio/hugo/hugofs/files"
+	"github.com/gohugoio/hugo/identity"
+	"github.com/gohugoio/hugo/resources"
+	"github.com/gohugoio/hugo/resources/page"
+	"github.com/gohugoio/hugo/tpl"
+	"github.com/spf13/afero"
+)
+
+// buildManifest is the JSON document written to build.manifest.
+type buildManifest struct {
+	HugoVersion string                         `json:"hugoVersion"`
+	Environment string                         `json:"environment"`
+	Files       []resources.BuildManifestEntry `json:"files"`
+}
+
+// readBuildManifest reads the build manifest written by the previous build
+// from the publish dir.
+// It returns nil if build.manifest is not set or the file does not exist.
+func (h *HugoSites) readBuildManifest() (*buildManifest, error) {
+	if h.ResourceSpec == nil || h.ResourceSpec.BuildManifest == nil {
+		return nil, nil
+	}
+	b, err := afero.ReadFile(h.BaseFs.PublishFs, filepath.Clean(h.ResourceSpec.BuildConfig().Manifest))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var m buildManifest
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse the previous build manifest: %w", err)
+	}
+	return &m, nil
+}
+
+// writeBuildManifest writes the files published in the build with their
+// hashes and provenance to the file set in build.manifest, if set.
+// It returns the manifest written, nil if not set.
+func (h *HugoSites) writeBuildManifest() (*buildManifest, error) {
+	if h.ResourceSpec == nil || h.ResourceSpec.BuildManifest == nil {
+		return nil, nil
+	}
+	filename := h.ResourceSpec.BuildConfig().Manifest
+	manifestPath := resources.BuildManifestPath(filename)
+
+	entries := h.ResourceSpec.BuildManifest.Entries()
+
+	// Include the files published without any known provenance,
+	// e.g. the SRI manifest or the .well-known files.
+	hugofs.WalkFilesystems(h.Fs.PublishDir, func(fs afero.Fs) bool {
+		if r, ok := fs.(hugofs.CreatedFilenamesReporter); ok {
+			for _, filename := range r.CreatedFilenames() {
+				p := resources.BuildManifestPath(filename)
+				if _, found := entries[p]; !found {
+					entries[p] = resources.BuildManifestEntry{Path: p}
+				}
+			}
+			return true
+		}
+		return false
+	})
+	delete(entries, manifestPath)
+
+	m := buildManifest{
+		HugoVersion: hugo.CurrentVersion.String(),
+		Environment: h.Configs.Base.Environment,
+		Files:       make([]resources.BuildManifestEntry, 0, len(entries)),
+	}
+
+	for p, e := range entries {
+		sum, size, err := hashFile(h.BaseFs.PublishFs, filepath.FromSlash(p))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		e.SHA256, e.Size = sum, size
+		m.Files = append(m.Files, e)
+	}
+
+	static, err := h.staticBuildManifestEntries()
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range static {
+		if _, found := entries[e.Path]; !found && e.Path != manifestPath {
+			m.Files = append(m.Files, e)
+		}
+	}
+
+	sort.Slice(m.Files, func(i, j int) bool { return m.Files[i].Path < m.Files[j].Path })
+
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := afero.WriteFile(h.BaseFs.PublishFs, filepath.Clean(filename), b, 0666); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// staticBuildManifestEntries returns the entries of the static files, which
+// are copied to the publish dir as is.
+func (h *HugoSites) staticBuildManifestEntries() ([]resources.BuildManifestEntry, error) {
+	var entries []resources.BuildManifestEntry
+	for _, sfs := range h.BaseFs.SourceFilesystems.Static {
+		err := afero.Walk(sfs.Fs, "", func(filename string, fi os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if fi.IsDir() {
+				return nil
+			}
+			sum, size, err := hashFile(sfs.Fs, filename)
+			if err != nil {
+				return err
+			}
+			source := path.Join(files.ComponentFolderStatic, filepath.ToSlash(filename))
+			if mfi, ok := fi.(hugofs.FileMetaInfo); ok {
+				if component, rel := h.BaseFs.MakePathRelative(mfi.Meta().Filename); rel != "" {
+					source = path.Join(component, filepath.ToSlash(rel))
+				}
+			}
+			entries = append(entries, resources.BuildManifestEntry{
+				Path:   resources.BuildManifestPath(filepath.Join(sfs.PublishFolder, filename)),
+				SHA256: sum,
+				Size:   size,
+				Type:   resources.BuildManifestTypeStatic,
+				Source: source,
+			})
+			return nil
+		})
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+func hashFile(fs afero.Fs, filename string) (string, int64, error) {
+	f, err := fs.Open(filename)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	size, err := io.Copy(hash, f)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+// addToBuildManifest records the provenance of the page p published to
+// targetPath with the given type and template, which may be nil.
+func (s *Site) addToBuildManifest(targetPath, typ string, p page.Page, templ tpl.Template) {
+	m := s.ResourceSpec.BuildManifest
+	if m == nil {
+		return
+	}
+	e := resources.BuildManifestEntry{
+		Path: targetPath,
+		Type: typ,
+	}
+	if p != nil {
+		e.Source = s.sourcePath(p)
+		if typ == resources.BuildManifestTypePage {
+			if ps, ok := p.(*pageState); ok {
+				e.OutputFormat = ps.outputFormat().Name
+			}
+		}
+	}
+	if templ != nil {
+		e.Templates = templateChain(templ)
+	}
+	m.Add(e)
+}
+
+// sourcePath returns the path of the file backing p relative to the project,
+// e.g. "content/posts/p1.md", or an empty string if p has no file.
+func (s *Site) sourcePath(p page.Page) string {
+	f := p.File()
+	if f.IsZero() {
+		return ""
+	}
+	component, rel := s.BaseFs.MakePathRelative(f.Filename())
+	if rel == "" {
+		return ""
+	}
+	return path.Join(component, filepath.ToSlash(rel))
+}
+
+// templateChain returns the names of the templates used when executing
+// templ: templ first, followed by its base template and the partials
+// it includes, sorted. Partials with names not known until execution are
+// not included.
+func templateChain(templ tpl.Template) []string {
+	name := templ.Name()
+	chain := []string{name}
+	seen := map[string]bool{strings.ToLower(name): true}
+
+	var rest []string
+	var collect func(ids identity.Identities)
+	collect = func(ids identity.Identities) {
+		for _, v := range ids {
+			id, ok := v.GetIdentity().(identity.PathIdentity)
+			if !ok || id.Type != files.ComponentFolderLayouts || seen[id.Path] {
+				continue
+			}
+			seen[id.Path] = true
+			rest = append(rest, id.Path)
+			if p, ok := v.(identity.IdentitiesProvider); ok {
+				collect(p.GetIdentities())
+			}
+		}
+	}
+	if p, ok := templ.(identity.IdentitiesProvider); ok {
+		collect(p.GetIdentities())
+	}
+
+	sort.Strings(rest)
+	return append(chain, rest...)
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/resources"
+)
+
+func TestBuildManifest(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+[build]
+manifest = "hugo_manifest.json"
+sriManifest = "sri.json"
+-- static/robots.txt --
+User-agent: *
+-- assets/css/main.css --
+body { color: red; }
+-- layouts/_default/baseof.html --
+{{ block "main" . }}{{ end }}{{ partial "footer.html" . }}
+-- layouts/_default/single.html --
+{{ define "main" }}Single: {{ .Title }}|{{ $css := resources.Get "css/main.css" | minify | fingerprint }}{{ $css.RelPermalink }}{{ end }}
+-- layouts/_default/list.html --
+List: {{ .Title }}
+-- layouts/partials/footer.html --
+Footer
+-- content/posts/p1.md --
+---
+title: P1
+aliases: ["/old/"]
+---
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	var m buildManifest
+	b.Assert(json.Unmarshal([]byte(b.FileContent("public/hugo_manifest.json")), &m), qt.IsNil)
+
+	entries := make(map[string]resources.BuildManifestEntry)
+	for _, e := range m.Files {
+		entries[e.Path] = e
+	}
+
+	p1 := entries["posts/p1/index.html"]
+	b.Assert(p1.Type, qt.Equals, resources.BuildManifestTypePage)
+	b.Assert(p1.Source, qt.Equals, "content/posts/p1.md")
+	b.Assert(p1.OutputFormat, qt.Equals, "html")
+	b.Assert(p1.Templates, qt.DeepEquals, []string{"_default/single.html", "_default/baseof.html", "partials/footer.html"})
+	content := b.FileContent("public/posts/p1/index.html")
+	sum := sha256.Sum256([]byte(content))
+	b.Assert(p1.SHA256, qt.Equals, hex.EncodeToString(sum[:]))
+	b.Assert(p1.Size, qt.Equals, int64(len(content)))
+
+	alias := entries["old/index.html"]
+	b.Assert(alias.Type, qt.Equals, resources.BuildManifestTypeAlias)
+	b.Assert(alias.Source, qt.Equals, "content/posts/p1.md")
+
+	var css resources.BuildManifestEntry
+	for _, e := range m.Files {
+		if e.Type == resources.BuildManifestTypeResource {
+			css = e
+		}
+	}
+	b.Assert(css.Source, qt.Equals, "assets/css/main.css")
+	b.Assert(css.Transformations, qt.DeepEquals, []string{"minify", "fingerprint"})
+
+	static := entries["robots.txt"]
+	b.Assert(static.Type, qt.Equals, resources.BuildManifestTypeStatic)
+	b.Assert(static.Source, qt.Equals, "static/robots.txt")
+	b.Assert(static.Size, qt.Equals, int64(len("User-agent: *")))
+
+	// Files published without known provenance.
+	b.Assert(entries["sri.json"].SHA256, qt.Not(qt.Equals), "")
+	_, found := entries["hugo_manifest.json"]
+	b.Assert(found, qt.IsFalse)
+	b.Assert(m.HugoVersion, qt.Not(qt.Equals), "")
+}
@@ -151,6 +151,96 @@ weight = 2
 
 	})
 
+	t.Run("disabled language", func(t *testing.T) {
+		t.Parallel()
+
+		files := `
+-- hugo.toml --
+baseURL = "https://example.com"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "page", "section"]
+defaultContentLanguage = "en"
+defaultContentLanguageInSubdir = true
+[languages.en]
+weight = 1
+[languages.sv]
+weight = 2
+-- config/preview/languages.toml --
+[sv]
+disabled = true
+-- layouts/index.html --
+Home: {{ .Language.Lang }}|{{ site.IsMultiLingual }}|{{ .RelPermalink }}|
+`
+		b := NewIntegrationTestBuilder(
+			IntegrationTestConfig{
+				T:           t,
+				TxtarString: files,
+				Environ:     []string{"HUGO_ENVIRONMENT=preview"},
+			},
+		).Build()
+
+		b.Assert(len(b.H.Sites), qt.Equals, 1)
+		b.AssertFileContent("public/en/index.html", "Home: en|true|/en/|")
+		b.AssertDestinationExists("sv/index.html", false)
+		b.AssertFileContent("public/index.html", "/en/")
+	})
+
+	t.Run("serve one language", func(t *testing.T) {
+		t.Parallel()
+
+		files := `
+-- hugo.toml --
+baseURL = "https://example.com"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "section"]
+defaultContentLanguage = "en"
+[languages.en]
+weight = 1
+[languages.sv]
+weight = 2
+[languages.de]
+weight = 3
+-- content/p1.en.md --
+---
+title: "P1 en"
+---
+-- content/p1.sv.md --
+---
+title: "P1 sv"
+---
+{{< ref path="/p1" lang="en" >}}|{{< relref path="/p1" lang="de" >}}|
+-- layouts/_default/single.html --
+{{ .Title }}|{{ .RelPermalink }}|{{ len .Translations }}|{{ .Content }}
+-- layouts/index.html --
+Home: {{ .Language.Lang }}|{{ .RelPermalink }}|
+`
+		cfg := config.New()
+		cfg.Set("internal", map[string]any{"lang": "sv"})
+		b := NewIntegrationTestBuilder(
+			IntegrationTestConfig{
+				T:           t,
+				TxtarString: files,
+				BaseCfg:     cfg,
+			},
+		).Build()
+
+		b.Assert(len(b.H.Sites), qt.Equals, 1)
+		b.AssertFileContent("public/sv/p1/index.html", "P1 sv|/sv/p1/|0|<p>https://example.com/|/de/|</p>")
+		b.AssertFileContent("public/sv/index.html", "Home: sv|/sv/|")
+		b.AssertDestinationExists("index.html", false)
+		b.AssertDestinationExists("en/index.html", false)
+
+		cfg = config.New()
+		cfg.Set("internal", map[string]any{"lang": "fi"})
+		_, err := NewIntegrationTestBuilder(
+			IntegrationTestConfig{
+				T:           t,
+				TxtarString: files,
+				BaseCfg:     cfg,
+			},
+		).BuildE()
+		b.Assert(err, qt.IsNotNil)
+		b.Assert(err.Error(), qt.Contains, `language "fi" not found`)
+	})
+
 	t.Run("no internal config from outside", func(t *testing.T) {
 		t.Parallel()
 
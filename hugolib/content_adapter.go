@@ -0,0 +1,335 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/common/paths"
+	"github.com/gohugoio/hugo/hugofs"
+	"github.com/gohugoio/hugo/hugofs/files"
+	"github.com/spf13/afero"
+	"github.com/spf13/cast"
+)
+
+// contentAdapterFilename is the name of the Go template that can be placed in
+// any content directory to add pages and page resources to it, typically
+// from data files or remote APIs.
+const contentAdapterFilename = "_content.gotmpl"
+
+func isContentAdapter(fi hugofs.FileMetaInfo) bool {
+	return !fi.IsDir() && fi.Name() == contentAdapterFilename
+}
+
+// contentAdapterFile is a content adapter template to be executed by the
+// site for its language.
+type contentAdapterFile struct {
+	hugofs.FileMetaInfo
+}
+
+// contentAdapter is the context passed to a content adapter template.
+type contentAdapter struct {
+	s *Site
+
+	// The directory of the content adapter template, relative to the content root.
+	dir string
+
+	pages     []*contentAdapterPage
+	pagesMap  map[string]*contentAdapterPage
+	resources []*contentAdapterResource
+}
+
+type contentAdapterPage struct {
+	path        string
+	ext         string
+	frontMatter map[string]any
+	content     string
+	resources   []*contentAdapterResource
+}
+
+type contentAdapterResource struct {
+	path        string
+	frontMatter map[string]any
+	content     string
+}
+
+// AddPage adds a page to the content directory of the adapter.
+// The path is required and is relative to the directory of the content adapter.
+// The content is either a string or a map with the keys value and mediaType
+// (defaults to text/markdown). All other keys are used as front matter.
+func (c *contentAdapter) AddPage(v any) (string, error) {
+	m, err := toContentAdapterMap(v)
+	if err != nil {
+		return "", fmt.Errorf("AddPage: %w", err)
+	}
+	p, err := c.getPath(m)
+	if err != nil {
+		return "", fmt.Errorf("AddPage: %w", err)
+	}
+
+	if _, found := c.pagesMap[p]; found {
+		return "", fmt.Errorf("AddPage: page with path %q already added", p)
+	}
+
+	content, mediaType, err := c.getContent(m)
+	if err != nil {
+		return "", fmt.Errorf("AddPage: %w", err)
+	}
+
+	ext := "md"
+	if mediaType != "" {
+		mt, found := c.s.conf.MediaTypes.Config.GetByType(mediaType)
+		if !found || mt.FirstSuffix.Suffix == "" {
+			return "", fmt.Errorf("AddPage: unknown media type %q", mediaType)
+		}
+		ext = mt.FirstSuffix.Suffix
+	}
+
+	pp := &contentAdapterPage{
+		path:        p,
+		ext:         ext,
+		frontMatter: m,
+		content:     content,
+	}
+	c.pages = append(c.pages, pp)
+	c.pagesMap[p] = pp
+
+	return "", nil
+}
+
+// AddResource adds a resource to one of the pages added with AddPage.
+// The path is required and is relative to the directory of the content adapter,
+// e.g. "books/the-hobbit/cover.txt" for a page added with the path "books/the-hobbit".
+// The content is either a string or a map with the key value.
+// The name, title and params keys are applied as in the page's resources front matter.
+func (c *contentAdapter) AddResource(v any) (string, error) {
+	m, err := toContentAdapterMap(v)
+	if err != nil {
+		return "", fmt.Errorf("AddResource: %w", err)
+	}
+	p, err := c.getPath(m)
+	if err != nil {
+		return "", fmt.Errorf("AddResource: %w", err)
+	}
+
+	content, _, err := c.getContent(m)
+	if err != nil {
+		return "", fmt.Errorf("AddResource: %w", err)
+	}
+
+	c.resources = append(c.resources, &contentAdapterResource{
+		path:        p,
+		frontMatter: m,
+		content:     content,
+	})
+
+	return "", nil
+}
+
+// toContentAdapterMap returns a shallow copy of v with lower case keys.
+func toContentAdapterMap(v any) (map[string]any, error) {
+	m, err := maps.ToStringMapE(v)
+	if err != nil {
+		return nil, err
+	}
+	mm := make(map[string]any, len(m))
+	for k, v := range m {
+		mm[strings.ToLower(k)] = v
+	}
+	return mm, nil
+}
+
+func (c *contentAdapter) getPath(m map[string]any) (string, error) {
+	v, found := m["path"]
+	if !found {
+		return "", errors.New("path is required")
+	}
+	delete(m, "path")
+
+	p, err := cast.ToStringE(v)
+	if err != nil {
+		return "", fmt.Errorf("invalid path: %w", err)
+	}
+	p = strings.Trim(path.Clean("/"+filepath.ToSlash(p)), "/")
+	if p == "" {
+		return "", fmt.Errorf("invalid path %q", v)
+	}
+
+	return p, nil
+}
+
+func (c *contentAdapter) getContent(m map[string]any) (content, mediaType string, err error) {
+	v, found := m["content"]
+	if !found {
+		return
+	}
+	delete(m, "content")
+
+	switch vv := v.(type) {
+	case map[string]any:
+		vv, err = toContentAdapterMap(vv)
+		if err != nil {
+			return
+		}
+		content, err = cast.ToStringE(vv["value"])
+		if err != nil {
+			return
+		}
+		mediaType, err = cast.ToStringE(vv["mediatype"])
+	default:
+		content, err = cast.ToStringE(v)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("invalid content: %w", err)
+	}
+
+	return
+}
+
+// assemble attaches the resources to their pages.
+func (c *contentAdapter) assemble() error {
+	for _, r := range c.resources {
+		var owner *contentAdapterPage
+		for dir := path.Dir(r.path); dir != "."; dir = path.Dir(dir) {
+			if p, found := c.pagesMap[dir]; found {
+				owner = p
+				break
+			}
+		}
+		if owner == nil {
+			return fmt.Errorf("AddResource: no page added for resource with path %q", r.path)
+		}
+
+		if len(r.frontMatter) > 0 {
+			rm := make(map[string]any)
+			for k, v := range r.frontMatter {
+				rm[k] = v
+			}
+			rm["src"] = strings.TrimPrefix(r.path, owner.path+"/")
+			resources, _ := owner.frontMatter["resources"].([]any)
+			owner.frontMatter["resources"] = append(resources, rm)
+		}
+
+		owner.resources = append(owner.resources, r)
+	}
+
+	return nil
+}
+
+// executeContentAdapter executes the content adapter template in fi and adds
+// the resulting pages and resources to the page map.
+func (m *pageMap) executeContentAdapter(fi hugofs.FileMetaInfo) error {
+	s := m.s
+	meta := fi.Meta()
+
+	c := &contentAdapter{
+		s:        s,
+		dir:      filepath.ToSlash(filepath.Dir(meta.Path)),
+		pagesMap: make(map[string]*contentAdapterPage),
+	}
+
+	f, err := meta.Open()
+	if err != nil {
+		return err
+	}
+	b, err := io.ReadAll(f)
+	f.Close()
+	if err != nil {
+		return err
+	}
+
+	errMsg := fmt.Sprintf("failed to execute content adapter %q", meta.Filename)
+
+	templ, err := s.TextTmpl().Parse(meta.Filename, string(b))
+	if err != nil {
+		return fmt.Errorf("%s: %w", errMsg, err)
+	}
+
+	if err := s.Tmpl().ExecuteWithContext(context.Background(), templ, io.Discard, c); err != nil {
+		return fmt.Errorf("%s: %w", errMsg, err)
+	}
+
+	if err := c.assemble(); err != nil {
+		return fmt.Errorf("%s: %w", errMsg, err)
+	}
+
+	fs := afero.NewMemMapFs()
+
+	newFileMetaInfo := func(rel string, classifier files.ContentClass, content []byte) (hugofs.FileMetaInfo, error) {
+		filename := filepath.FromSlash(path.Join(c.dir, rel))
+		if err := afero.WriteFile(fs, filename, content, 0o666); err != nil {
+			return nil, err
+		}
+		fi, err := fs.Stat(filename)
+		if err != nil {
+			return nil, err
+		}
+
+		fm := meta.Copy()
+		fm.Name = path.Base(rel)
+		fm.Path = filename
+		fm.PathWalk = filename
+		fm.Filename = filepath.Join(filepath.Dir(meta.Filename), filepath.FromSlash(rel))
+		fm.OriginalFilename = fm.Filename
+		fm.Classifier = classifier
+		fm.TranslationBaseNameWithExt = fm.Name
+		fm.TranslationBaseName = paths.Filename(fm.Name)
+		fm.Translations = nil
+		fm.Fs = fs
+		fm.OpenFunc = func() (afero.File, error) {
+			return fs.Open(filename)
+		}
+		fm.JoinStatFunc = nil
+
+		return hugofs.NewFileMetaInfo(fi, fm), nil
+	}
+
+	for _, p := range c.pages {
+		fm, err := json.Marshal(p.frontMatter)
+		if err != nil {
+			return fmt.Errorf("%s: failed to marshal front matter for page %q: %w", errMsg, p.path, err)
+		}
+		content := append(fm, '\n')
+		content = append(content, p.content...)
+
+		header, err := newFileMetaInfo(path.Join(p.path, "index."+p.ext), files.ContentClassLeaf, content)
+		if err != nil {
+			return err
+		}
+
+		var resources []hugofs.FileMetaInfo
+		for _, r := range p.resources {
+			fim, err := newFileMetaInfo(r.path, files.ContentClassFile, []byte(r.content))
+			if err != nil {
+				return err
+			}
+			resources = append(resources, fim)
+		}
+
+		if err := m.AddFilesBundle(header, resources...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestContentAdapter(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.com/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+-- data/books.yaml --
+- title: The Hobbit
+  author: Tolkien
+  date: 1937-09-21
+  summary: "In a hole in the ground there lived a **hobbit**."
+- title: Dune
+  author: Herbert
+  date: 1965-08-01
+  summary: "A beginning is the time for taking the most delicate care."
+-- content/books/_index.md --
+---
+title: Books
+---
+-- content/books/_content.gotmpl --
+{{ range site.Data.books }}
+  {{ $path := .title | urlize }}
+  {{ $.AddPage (dict "path" $path "title" .title "date" .date "author" .author "content" .summary) }}
+  {{ $.AddResource (dict "path" (printf "%s/cover.txt" $path) "name" "cover" "content" (printf "Cover of %s" .title)) }}
+{{ end }}
+{{ $.AddPage (dict "path" "about" "title" "About" "content" (dict "mediaType" "text/html" "value" "<p>About the <em>books</em>.</p>")) }}
+-- content/books/existing.md --
+---
+title: Existing
+date: 2020-01-01
+---
+-- layouts/_default/single.html --
+Title: {{ .Title }}|Author: {{ .Params.author }}|Date: {{ .Date.Format "2006-01-02" }}|Section: {{ .Section }}|Kind: {{ .Kind }}|
+Content: {{ .Content }}|
+Cover: {{ with .Resources.GetMatch "cover" }}{{ .Content }}|{{ .RelPermalink }}{{ end }}|
+-- layouts/_default/list.html --
+Pages: {{ range .Pages.ByTitle }}{{ .Title }}:{{ .RelPermalink }}|{{ end }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/books/index.html", "Pages: About:/books/about/|Dune:/books/dune/|Existing:/books/existing/|The Hobbit:/books/the-hobbit/|")
+	b.AssertFileContent("public/books/the-hobbit/index.html",
+		"Title: The Hobbit|Author: Tolkien|Date: 1937-09-21|Section: books|Kind: page|",
+		"Content: <p>In a hole in the ground there lived a <strong>hobbit</strong>.</p>\n|",
+		"Cover: Cover of The Hobbit|/books/the-hobbit/cover.txt|",
+	)
+	b.AssertFileContent("public/books/the-hobbit/cover.txt", "Cover of The Hobbit")
+	b.AssertFileContent("public/books/about/index.html", "Content: <p>About the <em>books</em>.</p>|")
+	b.AssertDestinationExists("books/_content.gotmpl", false)
+}
+
+func TestContentAdapterErrors(t *testing.T) {
+	t.Parallel()
+
+	filesTemplate := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+-- content/docs/_content.gotmpl --
+ADAPTER
+-- layouts/_default/single.html --
+{{ .Title }}
+-- layouts/_default/list.html --
+{{ .Title }}
+`
+
+	for _, test := range []struct {
+		name    string
+		adapter string
+		expect  string
+	}{
+		{"No path", `{{ $.AddPage (dict "title" "P1") }}`, "AddPage: path is required"},
+		{"Duplicate", `{{ $.AddPage (dict "path" "p1") }}{{ $.AddPage (dict "path" "p1/") }}`, `AddPage: page with path "p1" already added`},
+		{"Media type", `{{ $.AddPage (dict "path" "p1" "content" (dict "value" "foo" "mediaType" "text/nope")) }}`, `unknown media type "text/nope"`},
+		{"Resource without page", `{{ $.AddResource (dict "path" "p1/data.json" "content" "{}") }}`, `no page added for resource with path "p1/data.json"`},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			files := strings.Replace(filesTemplate, "ADAPTER", test.adapter, 1)
+			b, err := NewIntegrationTestBuilder(
+				IntegrationTestConfig{
+					T:           t,
+					TxtarString: files,
+				},
+			).BuildE()
+
+			b.Assert(err, qt.IsNotNil)
+			b.Assert(err.Error(), qt.Contains, test.expect)
+		})
+	}
+}
+
+func TestContentAdapterRebuild(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+-- content/docs/_index.md --
+---
+title: Docs
+---
+-- content/docs/_content.gotmpl --
+{{ $.AddPage (dict "path" "p1" "title" "P1" "content" "Content 1.") }}
+-- layouts/_default/single.html --
+{{ .Title }}|{{ .Content }}
+-- layouts/_default/list.html --
+Pages: {{ range .Pages }}{{ .Title }}|{{ end }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			Running:     true,
+		},
+	).Build()
+
+	b.AssertFileContent("public/docs/p1/index.html", "P1|<p>Content 1.</p>")
+
+	b.EditFileReplace("content/docs/_content.gotmpl", func(s string) string {
+		return strings.Replace(s, "Content 1.", "Content 1 edited.", 1)
+	}).Build()
+
+	b.AssertFileContent("public/docs/p1/index.html", "P1|<p>Content 1 edited.</p>")
+	b.AssertFileContent("public/docs/index.html", "Pages: P1|")
+}
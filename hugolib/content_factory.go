@@ -39,6 +39,16 @@ type ContentFactory struct {
 	// to replace any shortcode with a temporary placeholder.
 	shortcodeReplacerPre  *strings.Replacer
 	shortcodeReplacerPost *strings.Replacer
+
+	// The values for the prompts defined in the archetype.
+	prompts map[string]any
+}
+
+// WithPrompts returns a copy of f with the given prompt values,
+// available in the archetype templates as .Prompts.
+func (f ContentFactory) WithPrompts(values map[string]any) ContentFactory {
+	f.prompts = values
+	return f
 }
 
 // ApplyArchetypeFilename archetypeFilename to w as a template using the given Page p as the foundation for the data context.
@@ -71,10 +81,11 @@ func (f ContentFactory) ApplyArchetypeTemplate(w io.Writer, p page.Page, archety
 	}
 
 	d := &archetypeFileData{
-		Type: archetypeKind,
-		Date: htime.Now().Format(time.RFC3339),
-		Page: p,
-		File: p.File(),
+		Type:    archetypeKind,
+		Date:    htime.Now().Format(time.RFC3339),
+		Page:    p,
+		File:    p.File(),
+		Prompts: f.prompts,
 	}
 
 	templateSource = f.shortcodeReplacerPre.Replace(templateSource)
@@ -167,6 +178,9 @@ type archetypeFileData struct {
 	// The temporary page. Note that only the file path information is relevant at this stage.
 	Page page.Page
 
+	// The values entered for the prompts defined in the archetype front matter.
+	Prompts map[string]any
+
 	// File is the same as Page.File, embedded here for historic reasons.
 	// TODO(bep) make this a method.
 	source.File
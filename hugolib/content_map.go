@@ -19,6 +19,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gohugoio/hugo/helpers"
 
@@ -271,6 +272,10 @@ type contentBundleViewInfo struct {
 	termOrigin string
 	weight     int
 	ref        *contentNode
+
+	// Set for terms when the site taxonomies are created.
+	pageCount int
+	lastDate  time.Time
 }
 
 func (c *contentBundleViewInfo) kind() string {
@@ -16,12 +16,18 @@ package hugolib
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/gobwas/glob"
 	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/compare"
+	"github.com/gohugoio/hugo/config"
 
 	"github.com/gohugoio/hugo/common/types"
 	"github.com/gohugoio/hugo/resources"
@@ -32,6 +38,7 @@ import (
 	"github.com/gohugoio/hugo/parser/pageparser"
 	"github.com/gohugoio/hugo/resources/page"
 	"github.com/gohugoio/hugo/resources/resource"
+	"github.com/gohugoio/hugo/resources/resource_factories/create"
 	"github.com/spf13/cast"
 
 	"github.com/gohugoio/hugo/common/para"
@@ -148,7 +155,7 @@ func (m *pageMap) newPageFromContentNode(n *contentNode, parentBucket *pagesMapB
 
 	parseResult, err := pageparser.Parse(
 		r,
-		pageparser.Config{EnableEmoji: s.conf.EnableEmoji},
+		pageparser.Config{EnableEmoji: s.conf.EnableEmoji || len(s.conf.EmojiTokens) > 0},
 	)
 	if err != nil {
 		return nil, err
@@ -231,21 +238,6 @@ func (m *pageMap) newResource(fim hugofs.FileMetaInfo, owner *pageState) (resour
 	if owner == nil {
 		panic("owner is nil")
 	}
-	// TODO(bep) consolidate with multihost logic + clean up
-	outputFormats := owner.m.outputFormats()
-	seen := make(map[string]bool)
-	var targetBasePaths []string
-	// Make sure bundled resources are published to all of the output formats'
-	// sub paths.
-	for _, f := range outputFormats {
-		p := f.Path
-		if seen[p] {
-			continue
-		}
-		seen[p] = true
-		targetBasePaths = append(targetBasePaths, p)
-
-	}
 
 	meta := fim.Meta()
 	r := func() (hugio.ReadSeekCloser, error) {
@@ -260,11 +252,70 @@ func (m *pageMap) newResource(fim hugofs.FileMetaInfo, owner *pageState) (resour
 			OpenReadSeekCloser: r,
 			FileInfo:           fim,
 			RelTargetFilename:  target,
-			TargetBasePaths:    targetBasePaths,
+			TargetBasePaths:    resourceTargetBasePaths(owner),
+			LazyPublish:        !owner.m.buildConfig.PublishResources,
+		})
+}
+
+// newRemoteResource fetches the resource at uri and bundles it with owner.
+func (m *pageMap) newRemoteResource(uri string, owner *pageState) (resource.Resource, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL for resource %q: %w", uri, err)
+	}
+
+	rr, err := create.New(owner.s.ResourceSpec).FromRemote(context.Background(), uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	if rr == nil {
+		return nil, fmt.Errorf("remote resource %q not found", uri)
+	}
+	rsc, ok := rr.(resource.ReadSeekCloserResource)
+	if !ok {
+		return nil, fmt.Errorf("remote resource %q cannot be read", uri)
+	}
+
+	target := path.Base(u.Path)
+	if target == "/" || target == "." {
+		target = "resource"
+	}
+	if path.Ext(target) == "" {
+		target += rsc.MediaType().FirstSuffix.FullSuffix
+	}
+
+	return owner.s.ResourceSpec.New(
+		resources.ResourceSourceDescriptor{
+			TargetPaths:        owner.getTargetPaths,
+			OpenReadSeekCloser: rsc.ReadSeekCloser,
+			MediaType:          rsc.MediaType(),
+			RelTargetFilename:  target,
+			TargetBasePaths:    resourceTargetBasePaths(owner),
 			LazyPublish:        !owner.m.buildConfig.PublishResources,
 		})
 }
 
+// resourceTargetBasePaths returns the base paths to publish the resources
+// bundled with owner to.
+func resourceTargetBasePaths(owner *pageState) []string {
+	// TODO(bep) consolidate with multihost logic + clean up
+	outputFormats := owner.m.outputFormats()
+	seen := make(map[string]bool)
+	var targetBasePaths []string
+	// Make sure bundled resources are published to all of the output formats'
+	// sub paths.
+	for _, f := range outputFormats {
+		p := f.Path
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+		targetBasePaths = append(targetBasePaths, p)
+
+	}
+	return targetBasePaths
+}
+
 func (m *pageMap) createSiteTaxonomies() error {
 	m.s.taxonomies = make(page.TaxonomyList)
 	var walkErr error
@@ -282,11 +333,18 @@ func (m *pageMap) createSiteTaxonomies() error {
 				walkErr = fmt.Errorf("missing taxonomy: %s", viewName.plural)
 				return true
 			}
+			t.pageCount = 0
+			t.lastDate = time.Time{}
 			m.taxonomyEntries.WalkPrefix(s, func(ss string, v any) bool {
 				b2 := v.(*contentNode)
 				info := b2.viewInfo
 				taxonomy[info.termKey] = append(taxonomy[info.termKey], page.NewWeightedPage(info.weight, info.ref.p, n.p))
 
+				t.pageCount++
+				if d := info.ref.p.Date(); d.After(t.lastDate) {
+					t.lastDate = d
+				}
+
 				return false
 			})
 		}
@@ -422,7 +480,62 @@ func (m *pageMap) assembleResources(s string, p *pageState, parentBucket *pagesM
 		return false
 	})
 
-	return err
+	if err != nil {
+		return err
+	}
+
+	return m.assembleRemoteResources(p)
+}
+
+// isRemoteResourceSrc reports whether src in the resources front matter
+// is a remote URL. Only https URLs are fetched.
+func isRemoteResourceSrc(src string) bool {
+	return strings.HasPrefix(src, "https://")
+}
+
+// assembleRemoteResources fetches the resources declared in front matter with
+// a remote URL as src and adds them to p's resources.
+// The src in the metadata is replaced with the name of the fetched resource,
+// so name, title and params are applied as for the bundled resources.
+func (m *pageMap) assembleRemoteResources(p *pageState) error {
+	var metadata []map[string]any
+	for i, meta := range p.m.resourcesMetadata {
+		src := cast.ToString(meta["src"])
+		if strings.HasPrefix(src, "http://") {
+			return p.wrapError(fmt.Errorf("resource %q: remote resources must use https", src))
+		}
+		if !isRemoteResourceSrc(src) {
+			continue
+		}
+
+		r, err := m.newRemoteResource(src, p)
+		if err != nil {
+			return p.wrapError(fmt.Errorf("failed to fetch resource %q: %w", src, err))
+		}
+		for _, rr := range p.resources {
+			if rr.Name() == r.Name() {
+				return p.wrapError(fmt.Errorf("resource %q has the same name as another resource: %q", src, r.Name()))
+			}
+		}
+		p.resources = append(p.resources, r)
+
+		if metadata == nil {
+			metadata = make([]map[string]any, len(p.m.resourcesMetadata))
+			copy(metadata, p.m.resourcesMetadata)
+		}
+		mm := make(map[string]any, len(meta))
+		for k, v := range meta {
+			mm[k] = v
+		}
+		mm["src"] = glob.QuoteMeta(r.Name())
+		metadata[i] = mm
+	}
+
+	if metadata != nil {
+		p.m.resourcesMetadata = metadata
+	}
+
+	return nil
 }
 
 func (m *pageMap) assembleSections() error {
@@ -530,6 +643,12 @@ func (m *pageMap) assembleTaxonomies() error {
 		}
 		parentBucket := parent.p.bucket
 
+		if kind == page.KindTerm && m.s.conf.StrictTaxonomyData {
+			if err = m.s.checkTermData(sections); err != nil {
+				return true
+			}
+		}
+
 		if n.fi != nil {
 			n.p, err = m.newPageFromContentNode(n, parent.p.bucket, nil)
 			if err != nil {
@@ -848,12 +967,84 @@ func (b *pagesMapBucket) getTaxonomies() page.Pages {
 			pas = append(pas, c.p)
 		})
 		page.SortByDefault(pas)
+		if conf, found := ref.m.s.conf.TaxonomySort[ref.n.viewInfo.name.plural]; found {
+			sortTerms(pas, conf)
+		}
 		b.sections = pas
 	})
 
 	return b.sections
 }
 
+// sortTerms sorts the term pages in terms, already in the default order,
+// by the metrics set when the site taxonomies were created or by a param.
+// Ties keep the default order, terms without the param go last.
+func sortTerms(terms page.Pages, conf config.TaxonomySortConfig) {
+	viewInfo := func(p page.Page) *contentBundleViewInfo {
+		return p.(*pageState).treeRef.n.viewInfo
+	}
+
+	var (
+		cmp     func(p1, p2 page.Page) int
+		missing = func(p page.Page) bool { return false }
+	)
+
+	switch conf.By {
+	case config.TaxonomySortByTitle:
+		cmp = func(p1, p2 page.Page) int {
+			return compare.Strings(p1.Title(), p2.Title())
+		}
+	case config.TaxonomySortByCount:
+		cmp = func(p1, p2 page.Page) int {
+			return viewInfo(p1).pageCount - viewInfo(p2).pageCount
+		}
+	case config.TaxonomySortByDate:
+		cmp = func(p1, p2 page.Page) int {
+			d1, d2 := viewInfo(p1).lastDate, viewInfo(p2).lastDate
+			switch {
+			case d1.Before(d2):
+				return -1
+			case d1.After(d2):
+				return 1
+			}
+			return 0
+		}
+	default:
+		key := conf.Param()
+		missing = func(p page.Page) bool {
+			v, _ := p.Param(key)
+			return v == nil
+		}
+		cmp = func(p1, p2 page.Page) int {
+			v1, _ := p1.Param(key)
+			v2, _ := p2.Param(key)
+			if f1, err := cast.ToFloat64E(v1); err == nil {
+				if f2, err := cast.ToFloat64E(v2); err == nil {
+					switch {
+					case f1 < f2:
+						return -1
+					case f1 > f2:
+						return 1
+					}
+					return 0
+				}
+			}
+			return compare.Strings(cast.ToString(v1), cast.ToString(v2))
+		}
+	}
+
+	sort.SliceStable(terms, func(i, j int) bool {
+		p1, p2 := terms[i], terms[j]
+		if m1, m2 := missing(p1), missing(p2); m1 || m2 {
+			return !m1 && m2
+		}
+		if conf.Desc() {
+			return cmp(p1, p2) > 0
+		}
+		return cmp(p1, p2) < 0
+	})
+}
+
 func (b *pagesMapBucket) getTaxonomyEntries() page.Pages {
 	var pas page.Pages
 	ref := b.owner.treeRef
@@ -0,0 +1,89 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestDevOnly(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+-- content/p1.md --
+---
+title: "P1"
+---
+-- content/notes.md --
+---
+title: "Notes"
+devOnly: true
+---
+-- content/internal/_index.md --
+---
+title: "Internal"
+cascade:
+  devOnly: true
+---
+-- content/internal/debug.md --
+---
+title: "Debug"
+---
+-- content/internal/debug/data.json --
+{}
+-- layouts/index.html --
+Pages: {{ range site.Pages }}{{ .Title }}|{{ end }}
+{{ partial "_dev/debug.html" . }}
+{{ partial "footer.html" . }}
+-- layouts/_default/single.html --
+Single: {{ .Title }}
+-- layouts/_default/list.html --
+List: {{ .Title }}
+-- layouts/partials/_dev/debug.html --
+Debug panel: {{ len site.Pages }}
+-- layouts/partials/footer.html --
+Footer
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContentExact("public/index.html", "Pages: |P1|\n", "Footer")
+	b.Assert(b.FileContent("public/index.html"), qt.Not(qt.Contains), "Debug panel")
+	b.AssertDestinationExists("public/notes/index.html", false)
+	b.AssertDestinationExists("public/internal/index.html", false)
+	b.AssertDestinationExists("public/internal/debug/index.html", false)
+	b.AssertDestinationExists("public/internal/debug/data.json", false)
+
+	b = NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			Environ:     []string{"HUGO_ENVIRONMENT=development"},
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html", "Notes|", "Internal|", "Debug|", "Debug panel: ", "Footer")
+	b.AssertFileContent("public/notes/index.html", "Single: Notes")
+	b.AssertFileContent("public/internal/debug/index.html", "Single: Debug")
+}
@@ -14,6 +14,7 @@
 package hugolib
 
 import (
+	"strings"
 	"testing"
 
 	qt "github.com/frankban/quicktest"
@@ -167,3 +168,169 @@ func TestEmbeddedPaginationTemplate(t *testing.T) {
 	variant = `{{ template "_internal/pagination.html" (dict "page" . "format" "terse") }}`
 	test(variant, expectedOutputTerseFormat)
 }
+
+func TestInternalTemplatesSEO(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+title = "My Site"
+languageCode = "en-US"
+disableKinds = ["taxonomy", "term", "sitemap", "RSS"]
+[seo]
+images = ["site.jpg"]
+[seo.twitter]
+site = "@hugo"
+[seo.facebook]
+appID = "1234"
+[seo.jsonld.publisher]
+name = "Hugo Authors"
+logo = "logo.png"
+[[cascade]]
+[cascade._target]
+path = "/docs/**"
+[cascade.seo]
+type = "book"
+[cascade.seo.twitter]
+creator = "@docwriter"
+-- content/posts/p1.md --
+---
+title: "P1"
+description: "P1 description."
+date: 2021-02-26T18:02:00Z
+images: ["p1.jpg"]
+seo:
+  title: "P1 SEO title"
+  author: "Jane Doe"
+---
+-- content/docs/d1.md --
+---
+title: "D1"
+---
+Some doc content.
+-- layouts/_default/single.html --
+{{ template "_internal/seo.html" . }}
+-- layouts/_default/list.html --
+{{ template "_internal/seo.html" . }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/posts/p1/index.html",
+		`<meta property="og:title" content="P1 SEO title" />`,
+		`<meta property="og:description" content="P1 description." />`,
+		`<meta property="og:type" content="article" />`,
+		`<meta property="og:site_name" content="My Site" />`,
+		`<meta property="og:locale" content="en_US" />`,
+		`<meta property="og:image" content="https://example.org/p1.jpg" />`,
+		`<meta property="fb:app_id" content="1234" />`,
+		`<meta name="twitter:card" content="summary_large_image" />`,
+		`<meta name="twitter:site" content="@hugo" />`,
+		`"@type":"BlogPosting"`,
+		`"author":{"@type":"Person","name":"Jane Doe"}`,
+		`"datePublished":"2021-02-26T18:02:00+00:00"`,
+		`"headline":"P1 SEO title"`,
+		`"publisher":{"@type":"Organization","logo":{"@type":"ImageObject","url":"https://example.org/logo.png"},"name":"Hugo Authors"}`,
+	)
+
+	b.AssertFileContent("public/docs/d1/index.html",
+		`<meta property="og:type" content="book" />`,
+		`<meta property="og:description" content="Some doc content." />`,
+		`<meta property="og:image" content="https://example.org/site.jpg" />`,
+		`<meta name="twitter:creator" content="@docwriter" />`,
+	)
+
+	b.AssertFileContent("public/index.html",
+		`<meta property="og:type" content="website" />`,
+		`"@type":"WebPage"`,
+		`"name":"My Site"`,
+	)
+}
+
+func TestEmbeddedTemplatesHreflang(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "robotsTXT", "404"]
+defaultContentLanguage = "en"
+[languages]
+[languages.en]
+weight = 1
+[languages.nn]
+weight = 2
+languageCode = "nn-NO"
+[languages.de]
+weight = 3
+[seo.hreflang]
+[seo.hreflang.locales]
+en = ["en-US", "en-GB"]
+-- content/posts/p1.en.md --
+---
+title: "P1"
+---
+-- content/posts/p1.nn.md --
+---
+title: "P1 nn"
+---
+-- content/posts/p2.nn.md --
+---
+title: "P2 nn"
+---
+-- layouts/_default/single.html --
+{{ .Title }}|{{ template "_internal/hreflang.html" . }}
+-- layouts/_default/list.html --
+{{ template "_internal/hreflang.html" . }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/nn/posts/p1/index.html", `
+<link rel="alternate" hreflang="en-US" href="https://example.org/posts/p1/" />
+<link rel="alternate" hreflang="en-GB" href="https://example.org/posts/p1/" />
+<link rel="alternate" hreflang="nn-NO" href="https://example.org/nn/posts/p1/" />
+<link rel="alternate" hreflang="x-default" href="https://example.org/posts/p1/" />
+`)
+	b.Assert(strings.TrimSpace(b.FileContent("public/nn/posts/p2/index.html")), qt.Equals, "P2 nn|")
+
+	b.AssertFileContent("public/en/sitemap.xml", `
+hreflang="en-GB"
+href="https://example.org/posts/p1/"
+hreflang="nn-NO"
+href="https://example.org/nn/posts/p1/"
+hreflang="x-default"
+`)
+
+	b.AssertFileContent("public/nn/posts/index.xml", `<atom:link href="https://example.org/posts/index.xml" rel="alternate" hreflang="en-US" type="application/rss+xml" />`)
+
+	b = NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.Replace(files, "[seo.hreflang]", "[seo.hreflang]\nxDefault = \"none\"", 1),
+		},
+	).Build()
+
+	b.Assert(b.FileContent("public/nn/posts/p1/index.html"), qt.Not(qt.Contains), "x-default")
+
+	b = NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.Replace(files, "[seo.hreflang]", "[seo.hreflang]\ndisable = true", 1),
+		},
+	).Build()
+
+	b.Assert(b.FileContent("public/nn/posts/p1/index.html"), qt.Not(qt.Contains), "hreflang")
+	b.Assert(b.FileContent("public/en/sitemap.xml"), qt.Not(qt.Contains), "hreflang")
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestAtomAndJSONFeed(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.com/"
+title = "Feeds"
+disableKinds = ["taxonomy", "term", "sitemap", "robotsTXT", "404"]
+[outputs]
+home = ["html", "rss", "atom", "jsonfeed"]
+section = ["html", "rss", "atom", "jsonfeed"]
+[services.rss]
+limit = 3
+[services.atom]
+sections = ["posts"]
+[services.jsonFeed]
+fullContent = true
+sections = ["posts", "docs"]
+-- content/posts/p1.md --
+---
+title: "Post 1"
+date: 2023-01-01
+lastmod: 2023-01-05
+tags: ["a", "b"]
+summary: "Summary *1*"
+---
+Content **1**.
+-- content/posts/p2.md --
+---
+title: "Post 2"
+date: 2023-01-02
+---
+Content 2.
+-- content/posts/p3.md --
+---
+title: "Post 3"
+date: 2023-01-03
+---
+Content 3.
+-- content/docs/d1.md --
+---
+title: "Doc 1"
+---
+Doc.
+-- layouts/_default/single.html --
+{{ .Content }}
+-- layouts/_default/list.html --
+{{ range .AlternativeOutputFormats }}{{ .Name }}|{{ .RelPermalink }}|{{ end }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/atom.xml",
+		`<feed xmlns="http://www.w3.org/2005/Atom" xml:lang="en">`,
+		`<title>Feeds</title>`,
+		`<link href="https://example.com/" rel="alternate" type="text/html" />`,
+		`<link href="https://example.com/atom.xml" rel="self" type="application/atom+xml" />`,
+		`<title>Post 3</title>`,
+		`<published>2023-01-01T00:00:00+00:00</published>`,
+		`<updated>2023-01-05T00:00:00+00:00</updated>`,
+		`<summary type="html">Summary &lt;em&gt;1&lt;/em&gt;</summary>`,
+	)
+	b.AssertFileContent("public/posts/atom.xml", "<title>Posts on Feeds</title>")
+
+	// Limit defaults to the RSS limit.
+	b.Assert(strings.Count(b.FileContent("public/atom.xml"), "<entry>"), qt.Equals, 3)
+
+	b.AssertFileContent("public/feed.json",
+		`"version": "https://jsonfeed.org/version/1.1"`,
+		`"feed_url": "https://example.com/feed.json"`,
+		`"home_page_url": "https://example.com/"`,
+		`"content_html": "\u003cp\u003eContent 3.\u003c/p\u003e\n"`,
+		`"date_published": "2023-01-03T00:00:00+00:00"`,
+	)
+	b.AssertFileContent("public/posts/feed.json", `"title": "Posts on Feeds"`, `"tags": [
+        "a",
+        "b"
+      ]`)
+	b.AssertFileContent("public/docs/feed.json", `"title": "Doc 1"`)
+
+	// The Atom feed is only enabled for the posts section.
+	b.AssertFileContent("public/posts/index.html", "atom|/posts/atom.xml|", "jsonfeed|/posts/feed.json|")
+	b.AssertFileContent("public/docs/index.html", "jsonfeed|/docs/feed.json|")
+	b.AssertDestinationExists("public/docs/atom.xml", false)
+	b.Assert(b.FileContent("public/docs/index.html"), qt.Not(qt.Contains), "atom")
+}
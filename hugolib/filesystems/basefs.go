@@ -242,6 +242,10 @@ type SourceFilesystems struct {
 	// The work folder (may be a composite of project and theme components).
 	Work afero.Fs
 
+	// All the mounted component folders (content, layouts, static etc.)
+	// using the target paths, e.g. "layouts/index.html".
+	Mounts afero.Fs
+
 	// When in multihost we have one static filesystem per language. The sync
 	// static files is currently done outside of the Hugo build (where there is
 	// a concept of a site per language).
@@ -395,6 +399,10 @@ func (d *SourceFilesystem) MakePathRelative(filename string) (string, bool) {
 			if mp := meta.Path; mp != "" {
 				rel = filepath.Join(mp, rel)
 			}
+			if meta.Renamer != nil {
+				dir, name := filepath.Split(rel)
+				rel = filepath.Join(dir, meta.Renamer.Rename(name))
+			}
 			return strings.TrimPrefix(rel, filePathSeparator), true
 		}
 	}
@@ -589,6 +597,13 @@ func (b *sourceFilesystemsBuilder) Build() (*SourceFilesystems, error) {
 	b.result.Content = b.newSourceFilesystem(files.ComponentFolderContent, contentFs, contentDirs)
 
 	b.result.Work = afero.NewReadOnlyFs(b.theBigFs.overlayFull)
+	b.result.Mounts = afero.NewReadOnlyFs(overlayfs.New(overlayfs.Options{
+		Fss: []afero.Fs{
+			b.theBigFs.overlayMounts,
+			b.theBigFs.overlayMountsContent,
+			b.theBigFs.overlayMountsStatic,
+		},
+	}))
 
 	// Create static filesystem(s)
 	ms := make(map[string]*SourceFilesystem)
@@ -620,8 +635,14 @@ func (b *sourceFilesystemsBuilder) createMainOverlayFs(p *paths.Paths) (*filesys
 		}
 	}
 
+	sourceProject := b.sourceFs
+	if b.p.Cfg.Safe() {
+		// Do not follow any symbolic links when building untrusted sites.
+		sourceProject = hugofs.NewNoSymlinkFs(b.sourceFs, b.logger, false)
+	}
+
 	collector := &filesystemsCollector{
-		sourceProject:     b.sourceFs,
+		sourceProject:     sourceProject,
 		sourceModules:     hugofs.NewNoSymlinkFs(b.sourceFs, b.logger, false),
 		overlayDirs:       make(map[string][]hugofs.FileMetaInfo),
 		staticPerLanguage: staticFsMap,
@@ -712,6 +733,11 @@ func (b *sourceFilesystemsBuilder) createOverlayFs(
 				return err
 			}
 
+			renamer, err := glob.NewFilenameRenamer(types.ToStringSlicePreserveString(mount.Rename))
+			if err != nil {
+				return fmt.Errorf("module %q: mount %q: %w", md.Module.Path(), mount.Source, err)
+			}
+
 			base, filename := absPathify(mount.Source)
 
 			rm := hugofs.RootMapping{
@@ -725,11 +751,16 @@ func (b *sourceFilesystemsBuilder) createOverlayFs(
 					Weight:          mountWeight,
 					Classifier:      files.ContentClassContent,
 					InclusionFilter: inclusionFilter,
+					Renamer:         renamer,
 				},
 			}
 
 			isContentMount := b.isContentMount(mount)
 
+			if renamer != nil && !isContentMount {
+				return fmt.Errorf("module %q: mount %q: rename is only supported for content mounts", md.Module.Path(), mount.Source)
+			}
+
 			lang := mount.Lang
 			if lang == "" && isContentMount {
 				lang = b.p.Cfg.DefaultContentLanguage()
@@ -750,7 +781,7 @@ func (b *sourceFilesystemsBuilder) createOverlayFs(
 		if !md.isMainProject {
 			modBase = collector.sourceModules
 		}
-		sourceStatic := hugofs.NewNoSymlinkFs(modBase, b.logger, true)
+		sourceStatic := hugofs.NewNoSymlinkFs(modBase, b.logger, !b.p.Cfg.Safe())
 
 		rmfs, err := hugofs.NewRootMappingFs(modBase, fromTo...)
 		if err != nil {
@@ -14,10 +14,24 @@
 package hugolib
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/bep/gitmap"
+	"github.com/gohugoio/hugo/cache/filecache"
+	"github.com/gohugoio/hugo/common/hexec"
+	"github.com/gohugoio/hugo/common/loggers"
+	"github.com/gohugoio/hugo/common/para"
 	"github.com/gohugoio/hugo/config"
 	"github.com/gohugoio/hugo/resources/page"
 	"github.com/gohugoio/hugo/source"
@@ -26,6 +40,29 @@ import (
 type gitInfo struct {
 	contentDir string
 	repo       *gitmap.GitRepo
+
+	// The HEAD commit hash the Git info was read for.
+	head string
+	// Whether the Git info was read from the file cache.
+	fromCache bool
+	// Whether the repository is a shallow clone.
+	shallow bool
+}
+
+// statsString returns a summary of the Git info for the build report.
+func (g *gitInfo) statsString() string {
+	source := "git log"
+	if g.fromCache {
+		source = "cache"
+	}
+	head := g.head
+	if len(head) > 7 {
+		head = head[:7]
+	}
+	if g.shallow {
+		source += " (shallow clone)"
+	}
+	return fmt.Sprintf("Git info: %d files at %s from %s", len(g.repo.Files), head, source)
 }
 
 func (g *gitInfo) forPage(p page.Page) source.GitInfo {
@@ -38,13 +75,323 @@ func (g *gitInfo) forPage(p page.Page) source.GitInfo {
 	return source.NewGitInfo(*gi)
 }
 
-func newGitInfo(conf config.AllProvider) (*gitInfo, error) {
+// newGitInfo reads the Git info for the project in the working dir.
+// Reading the full Git log can be slow in big repositories, so the result
+// is stored in cache keyed by the HEAD commit, if cache is set.
+//
+// In a shallow clone, fetchDepth more commits are fetched at a time, if set,
+// until the last commit of every file is found.
+func newGitInfo(conf config.AllProvider, fetchDepth int, cache *filecache.Cache, logger loggers.Logger) (*gitInfo, error) {
 	workingDir := conf.BaseConfig().WorkingDir
 
-	gitRepo, err := gitmap.Map(workingDir, "")
+	head, err := gitHead(workingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	shallow, err := gitIsShallow(workingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &gitInfo{head: head, shallow: shallow}
+
+	// The result for a shallow clone depends on the history fetched,
+	// so don't cache it.
+	if cache == nil || shallow {
+		g.repo, err = readGitRepo(workingDir, head, fetchDepth, logger)
+		if err != nil {
+			return nil, err
+		}
+		g.contentDir = g.repo.TopLevelAbsPath
+		return g, nil
+	}
+
+	g.fromCache = true
+
+	_, err = cache.ReadOrCreate("gitinfo_"+head,
+		func(info filecache.ItemInfo, r io.ReadSeeker) error {
+			return json.NewDecoder(r).Decode(&g.repo)
+		},
+		func(info filecache.ItemInfo, w io.WriteCloser) error {
+			defer w.Close()
+			g.fromCache = false
+			gitRepo, err := readGitRepo(workingDir, head, fetchDepth, logger)
+			if err != nil {
+				return err
+			}
+			g.repo = gitRepo
+			return json.NewEncoder(w).Encode(gitRepo)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The repository may have been moved since it was cached.
+	if g.fromCache {
+		topLevel, err := gitTopLevel(workingDir)
+		if err != nil {
+			return nil, err
+		}
+		g.repo.TopLevelAbsPath = topLevel
+	}
+
+	g.contentDir = g.repo.TopLevelAbsPath
+
+	return g, nil
+}
+
+// The maximum number of times to fetch more history in a shallow clone.
+const gitMaxFetches = 10
+
+// readGitRepo reads the Git info for all files in the repository in dir at
+// the given revision.
+//
+// In a shallow clone, the files last changed before the fetched history are
+// all attributed to the shallow boundary commits. More history is fetched
+// for these if fetchDepth is set, else they're left without Git info.
+func readGitRepo(dir, revision string, fetchDepth int, logger loggers.Logger) (*gitmap.GitRepo, error) {
+	topLevel, err := gitTopLevel(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for fetches := 0; ; fetches++ {
+		files, err := gitLog(topLevel, revision)
+		if err != nil {
+			return nil, err
+		}
+
+		boundary, err := gitShallowBoundary(topLevel)
+		if err != nil {
+			return nil, err
+		}
+
+		var unknown []string
+		for filename, gi := range files {
+			if boundary[gi.Hash] {
+				unknown = append(unknown, filename)
+			}
+		}
+
+		if len(unknown) == 0 {
+			return &gitmap.GitRepo{TopLevelAbsPath: topLevel, Files: files}, nil
+		}
+
+		if fetchDepth > 0 && fetches < gitMaxFetches {
+			logger.Infof("Git info: shallow clone, fetching %d more commits", fetchDepth)
+			_, err = git("-C", topLevel, "fetch", "--quiet", "--deepen="+strconv.Itoa(fetchDepth))
+			if err == nil {
+				continue
+			}
+			logger.Warnf("Git info: failed to fetch more history: %s", err)
+		}
+
+		for _, filename := range unknown {
+			delete(files, filename)
+		}
+
+		logger.Warnf("Git info: %d files were last changed before the history available in this shallow clone and have no Git info. Set gitInfoFetchDepth to fetch more history when needed, or clone with full history (e.g. fetch-depth: 0 with actions/checkout).", len(unknown))
+
+		return &gitmap.GitRepo{TopLevelAbsPath: topLevel, Files: files}, nil
+	}
+}
+
+// gitLog maps the files in the repository with the given top-level dir to
+// the last commit changing them at revision.
+// The log is read in parallel for every top-level directory in the repository.
+func gitLog(topLevel, revision string) (gitmap.GitMap, error) {
+	out, err := git("-C", topLevel, "ls-tree", "-z", revision)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		dirs  [][]string
+		files []string
+	)
+	for _, entry := range strings.Split(strings.TrimSuffix(out, "\x00"), "\x00") {
+		// <mode> SP <type> SP <object> TAB <file>
+		info, name, found := strings.Cut(entry, "\t")
+		if !found {
+			continue
+		}
+		switch strings.Fields(info)[1] {
+		case "tree":
+			dirs = append(dirs, []string{name})
+		case "blob":
+			files = append(files, name)
+		}
+	}
+	if len(files) > 0 {
+		dirs = append(dirs, files)
+	}
+
+	var (
+		mu sync.Mutex
+		m  = make(gitmap.GitMap)
+	)
+
+	r, _ := para.New(config.GetNumWorkerMultiplier()).Start(context.Background())
+	for _, pathspecs := range dirs {
+		pathspecs := pathspecs
+		r.Run(func() error {
+			mm, err := gitLogPaths(topLevel, revision, pathspecs)
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			for k, v := range mm {
+				m[k] = v
+			}
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	if err := r.Wait(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// gitLogPaths is gitmap.Map limited to the given paths.
+func gitLogPaths(topLevel, revision string, pathspecs []string) (gitmap.GitMap, error) {
+	args := []string{
+		"-c", "diff.renames=0", "-c", "log.showSignature=0", "--literal-pathspecs", "-C", topLevel,
+		"log", "--name-only", "--no-merges", "--full-history",
+		"--format=format:%x1e%H%x1f%h%x1f%s%x1f%aN%x1f%aE%x1f%ai%x1f%ci",
+		revision, "--",
+	}
+	out, err := git(append(args, pathspecs...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(gitmap.GitMap)
+
+	out = strings.Trim(out, "\n\x1e'")
+	if out == "" {
+		return m, nil
+	}
+
+	for _, entry := range strings.Split(out, "\x1e") {
+		lines := strings.Split(entry, "\n")
+		gi, err := toGitInfo(lines[0])
+		if err != nil {
+			return nil, err
+		}
+		for _, filename := range lines[1:] {
+			filename = strings.TrimSpace(filename)
+			if filename == "" {
+				continue
+			}
+			if _, found := m[filename]; !found {
+				m[filename] = gi
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func toGitInfo(entry string) (*gitmap.GitInfo, error) {
+	items := strings.Split(entry, "\x1f")
+	if len(items) != 7 {
+		return nil, fmt.Errorf("invalid Git log entry: %q", entry)
+	}
+	const layout = "2006-01-02 15:04:05 -0700"
+	authorDate, err := time.Parse(layout, items[5])
+	if err != nil {
+		return nil, err
+	}
+	commitDate, err := time.Parse(layout, items[6])
+	if err != nil {
+		return nil, err
+	}
+	return &gitmap.GitInfo{
+		Hash:            items[0],
+		AbbreviatedHash: items[1],
+		Subject:         items[2],
+		AuthorName:      items[3],
+		AuthorEmail:     items[4],
+		AuthorDate:      authorDate,
+		CommitDate:      commitDate,
+	}, nil
+}
+
+// gitIsShallow reports whether the repository in dir is a shallow clone.
+func gitIsShallow(dir string) (bool, error) {
+	out, err := git("-C", dir, "rev-parse", "--is-shallow-repository")
+	if err != nil {
+		return false, err
+	}
+	return strings.TrimSpace(out) == "true", nil
+}
+
+// gitShallowBoundary returns the commits at the boundary of the history in
+// the shallow clone with the given top-level dir, if any.
+// Git doesn't know the parents of these commits, so every file in them is
+// listed as changed.
+func gitShallowBoundary(topLevel string) (map[string]bool, error) {
+	out, err := git("-C", topLevel, "rev-parse", "--git-path", "shallow")
 	if err != nil {
 		return nil, err
 	}
+	filename := strings.TrimSpace(out)
+	if !filepath.IsAbs(filename) {
+		filename = filepath.Join(topLevel, filename)
+	}
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	boundary := make(map[string]bool)
+	for _, hash := range strings.Fields(string(b)) {
+		boundary[hash] = true
+	}
+	return boundary, nil
+}
+
+// gitHead returns the commit hash of HEAD in the repository in dir.
+func gitHead(dir string) (string, error) {
+	out, err := git("-C", dir, "rev-parse", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out), nil
+}
 
-	return &gitInfo{contentDir: gitRepo.TopLevelAbsPath, repo: gitRepo}, nil
+// gitTopLevel returns the absolute path of the top-level directory of the
+// repository in dir, see gitmap.GitRepo.TopLevelAbsPath.
+func gitTopLevel(dir string) (string, error) {
+	out, err := git("-C", dir, "rev-parse", "--show-cdup")
+	if err != nil {
+		return "", err
+	}
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(filepath.Join(absDir, strings.TrimSpace(out))), nil
+}
+
+func git(args ...string) (string, error) {
+	cmd, err := hexec.SafeCommand("git", args...)
+	if err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return "", gitmap.GitNotFound
+		}
+		return "", err
+	}
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("git %s: %s", strings.Join(args, " "), strings.TrimSpace(string(out)))
+	}
+	return string(out), nil
 }
@@ -0,0 +1,161 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/common/hexec"
+)
+
+func TestGitInfoCache(t *testing.T) {
+	if !hexec.InPath("git") {
+		t.Skip("git not found")
+	}
+
+	t.Parallel()
+	c := qt.New(t)
+
+	dir := t.TempDir()
+	runGit := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-C", dir, "-c", "user.name=Hugo", "-c", "user.email=hugo@example.org"}, args...)...)
+		out, err := cmd.CombinedOutput()
+		c.Assert(err, qt.IsNil, qt.Commentf(string(out)))
+	}
+	writeFile := func(name, content string) {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+		c.Assert(os.MkdirAll(filepath.Dir(filename), 0777), qt.IsNil)
+		c.Assert(os.WriteFile(filename, []byte(content), 0666), qt.IsNil)
+	}
+
+	runGit("init", "-q")
+	writeFile("hugo.toml", "enableGitInfo = true\ncacheDir = "+strconv.Quote(filepath.ToSlash(t.TempDir()))+"\n")
+	writeFile("content/p1.md", "---\ntitle: p1\n---\n")
+	writeFile("layouts/_default/single.html", "{{ .GitInfo.Subject }}|{{ .GitInfo.AbbreviatedHash }}")
+	runGit("add", "-A")
+	runGit("commit", "-q", "-m", "First commit")
+
+	build := func() *IntegrationTestBuilder {
+		return NewIntegrationTestBuilder(
+			IntegrationTestConfig{
+				T:          t,
+				NeedsOsFS:  true,
+				WorkingDir: dir,
+			},
+		).Build()
+	}
+
+	gitInfoStats := func(b *IntegrationTestBuilder) string {
+		var sb strings.Builder
+		b.H.PrintProcessingStats(&sb)
+		return sb.String()
+	}
+
+	b := build()
+	b.AssertFileContent("public/p1/index.html", "First commit|")
+	b.Assert(gitInfoStats(b), qt.Contains, "Git info: 3 files at")
+	b.Assert(gitInfoStats(b), qt.Contains, "from git log")
+
+	b = build()
+	b.AssertFileContent("public/p1/index.html", "First commit|")
+	b.Assert(gitInfoStats(b), qt.Contains, "from cache")
+
+	writeFile("content/p1.md", "---\ntitle: p1 edited\n---\n")
+	runGit("commit", "-q", "-am", "Second commit")
+
+	b = build()
+	b.AssertFileContent("public/p1/index.html", "Second commit|")
+	b.Assert(gitInfoStats(b), qt.Contains, "from git log")
+}
+
+func TestGitInfoShallowClone(t *testing.T) {
+	if !hexec.InPath("git") {
+		t.Skip("git not found")
+	}
+
+	t.Parallel()
+	c := qt.New(t)
+
+	runGit := func(dir string, args ...string) {
+		cmd := exec.Command("git", append([]string{"-C", dir, "-c", "user.name=Hugo", "-c", "user.email=hugo@example.org"}, args...)...)
+		out, err := cmd.CombinedOutput()
+		c.Assert(err, qt.IsNil, qt.Commentf(string(out)))
+	}
+
+	origin := t.TempDir()
+	writeFile := func(name, content string) {
+		filename := filepath.Join(origin, filepath.FromSlash(name))
+		c.Assert(os.MkdirAll(filepath.Dir(filename), 0777), qt.IsNil)
+		c.Assert(os.WriteFile(filename, []byte(content), 0666), qt.IsNil)
+	}
+
+	runGit(origin, "init", "-q")
+	writeFile("hugo.toml", "enableGitInfo = true\ncacheDir = "+strconv.Quote(filepath.ToSlash(t.TempDir()))+"\n")
+	writeFile("layouts/_default/single.html", "Subject: {{ .GitInfo.Subject }}|")
+	writeFile("content/old.md", "---\ntitle: old\n---\n")
+	writeFile("content/docs/old.md", "---\ntitle: docs old\n---\n")
+	runGit(origin, "add", "-A")
+	runGit(origin, "commit", "-q", "-m", "First commit")
+	writeFile("content/p2.md", "---\ntitle: p2\n---\n")
+	runGit(origin, "add", "-A")
+	runGit(origin, "commit", "-q", "-m", "Second commit")
+	writeFile("content/docs/new.md", "---\ntitle: docs new\n---\n")
+	runGit(origin, "add", "-A")
+	runGit(origin, "commit", "-q", "-m", "Third commit")
+
+	clone := func(fetchDepth int) string {
+		dir := filepath.Join(t.TempDir(), "clone")
+		runGit(origin, "clone", "-q", "--depth", "2", "file://"+filepath.ToSlash(origin), dir)
+		if fetchDepth > 0 {
+			f, err := os.OpenFile(filepath.Join(dir, "hugo.toml"), os.O_APPEND|os.O_WRONLY, 0666)
+			c.Assert(err, qt.IsNil)
+			_, err = f.WriteString("gitInfoFetchDepth = " + strconv.Itoa(fetchDepth) + "\n")
+			c.Assert(err, qt.IsNil)
+			c.Assert(f.Close(), qt.IsNil)
+		}
+		return dir
+	}
+
+	build := func(dir string) *IntegrationTestBuilder {
+		return NewIntegrationTestBuilder(
+			IntegrationTestConfig{
+				T:          t,
+				NeedsOsFS:  true,
+				WorkingDir: dir,
+			},
+		).Build()
+	}
+
+	// The files in the boundary commit of the shallow clone have no Git info.
+	b := build(clone(0))
+	b.AssertFileContent("public/docs/new/index.html", "Subject: Third commit|")
+	b.AssertFileContent("public/p2/index.html", "Subject: |")
+	b.AssertFileContent("public/old/index.html", "Subject: |")
+	b.AssertFileContent("public/docs/old/index.html", "Subject: |")
+	b.AssertLogContains("5 files were last changed before the history available in this shallow clone")
+
+	// Fetch more history until all files are found.
+	b = build(clone(1))
+	b.AssertFileContent("public/docs/new/index.html", "Subject: Third commit|")
+	b.AssertFileContent("public/p2/index.html", "Subject: Second commit|")
+	b.AssertFileContent("public/old/index.html", "Subject: First commit|")
+	b.AssertFileContent("public/docs/old/index.html", "Subject: First commit|")
+	b.Assert(b.logBuff.String(), qt.Not(qt.Contains), "shallow clone")
+}
@@ -14,6 +14,7 @@
 package hugolib
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -24,7 +25,11 @@ import (
 	"sync/atomic"
 
 	"github.com/gohugoio/hugo/config/allconfig"
+	"github.com/gohugoio/hugo/featureflags"
+	"github.com/gohugoio/hugo/hugofs/files"
 	"github.com/gohugoio/hugo/hugofs/glob"
+	"github.com/gohugoio/hugo/indexnow"
+	"github.com/gohugoio/hugo/metrics"
 
 	"github.com/fsnotify/fsnotify"
 
@@ -32,8 +37,12 @@ import (
 
 	radix "github.com/armon/go-radix"
 
+	"github.com/gohugoio/hugo/media"
+	"github.com/gohugoio/hugo/modules"
 	"github.com/gohugoio/hugo/output"
 	"github.com/gohugoio/hugo/parser/metadecoders"
+	"github.com/gohugoio/hugo/publisher"
+	"github.com/gohugoio/hugo/redirects"
 
 	"github.com/gohugoio/hugo/common/hugo"
 	"github.com/gohugoio/hugo/common/para"
@@ -71,6 +80,27 @@ type HugoSites struct {
 	gitInfo       *gitInfo
 	codeownerInfo *codeownerInfo
 
+	// Rendering of published HTML to PDF.
+	pdf pdfState
+
+	// The external search indexer.
+	indexer indexerState
+
+	// The submission of the changed pages to IndexNow, nil if not enabled.
+	indexNow *indexnow.Submitter
+
+	// Checking of the links in the published HTML.
+	linkCheck linkCheckState
+
+	// The audit of the published HTML.
+	audit auditState
+
+	// The feature flags, shared by all sites.
+	flags *featureflags.Flags
+
+	// The file cache sizes, set after each full build when a cache has a maxSize.
+	cacheGauges *metrics.Gauges
+
 	// As loaded from the /data dirs
 	data map[string]any
 
@@ -86,6 +116,15 @@ type HugoSites struct {
 
 	init *hugoSitesInit
 
+	// Used to skip re-rendering of unaffected list pages in server mode.
+	listRender listRenderState
+
+	// Caches the parsed bibliography files used to render citations.
+	bibliographies bibliographyCache
+
+	// Tracks the pages included in other pages' content to detect circular includes.
+	includes includeGraph
+
 	workers    *para.Workers
 	numWorkers int
 
@@ -248,8 +287,11 @@ func (h *HugoSites) pickOneAndLogTheRest(errors []error) error {
 	return errors[i]
 }
 
+// isMultiLingual reports whether more than one language is configured.
+// The disabled languages count, so building a subset of the languages
+// keeps the URLs of the full site.
 func (h *HugoSites) isMultiLingual() bool {
-	return len(h.Sites) > 1
+	return len(h.Configs.Languages) > 1
 }
 
 // TODO(bep) consolidate
@@ -274,6 +316,18 @@ func (h *HugoSites) PrintProcessingStats(w io.Writer) {
 		stats[i] = h.Sites[i].PathSpec.ProcessingStats
 	}
 	helpers.ProcessingStatsTable(w, stats...)
+
+	if h.gitInfo != nil {
+		fmt.Fprintf(w, "\n%s\n", h.gitInfo.statsString())
+	}
+
+	if h.flags.Len() > 0 {
+		fmt.Fprintf(w, "\n%s\n", h.flags.StatsString())
+	}
+
+	if h.cacheGauges != nil {
+		fmt.Fprintf(w, "\n%s\n", cacheGaugesStatsString(h.cacheGauges))
+	}
 }
 
 // GetContentPage finds a Page with content given the absolute filename.
@@ -299,7 +353,7 @@ func (h *HugoSites) GetContentPage(filename string) page.Page {
 
 func (h *HugoSites) loadGitInfo() error {
 	if h.Configs.Base.EnableGitInfo {
-		gi, err := newGitInfo(h.Conf)
+		gi, err := newGitInfo(h.Conf, h.Configs.Base.GitInfoFetchDepth, h.ResourceSpec.FileCaches.GitInfoCache(), h.Log)
 		if err != nil {
 			h.Log.Errorln("Failed to read Git log:", err)
 		} else {
@@ -347,6 +401,7 @@ func (h *HugoSites) reset(config *BuildCfg) {
 	}
 
 	h.init.Reset()
+	h.includes.reset()
 }
 
 // resetLogs resets the log counters etc. Used to do a new build on the same sites.
@@ -404,6 +459,11 @@ type BuildCfg struct {
 	// Set when the buildlock is already acquired (e.g. the archetype content builder).
 	NoBuildLock bool
 
+	// The regular pages changed in this build without changes to their
+	// summary or metadata, see build.skipUnaffectedListPages.
+	leafChanges     map[string]bool
+	leafChangesInit bool
+
 	testCounters *testCounters
 }
 
@@ -493,6 +553,130 @@ func (h *HugoSites) renderCrossSitesRobotsTXT() error {
 	return s.renderAndWritePage(&s.PathSpec.ProcessingStats.Pages, "Robots Txt", "robots.txt", p, templ)
 }
 
+// renderIndieWeb renders the configured ActivityPub and WebFinger files, once
+// per host in multihost mode.
+func (h *HugoSites) renderIndieWeb() error {
+	if !h.Configs.IsMultihost {
+		return h.Sites[0].renderIndieWeb("")
+	}
+	for _, s := range h.Sites {
+		if err := s.renderIndieWeb(s.Language().Lang); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderWellKnown renders the configured /.well-known/ files, once per
+// host in multihost mode.
+func (h *HugoSites) renderWellKnown() error {
+	if !h.Configs.IsMultihost {
+		return h.Sites[0].renderWellKnown("")
+	}
+	for _, s := range h.Sites {
+		if err := s.renderWellKnown(s.Language().Lang); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderRedirects validates and publishes the redirects map of all the
+// aliases rendered, if configured.
+// In multihost mode, a redirects map is published for each language.
+func (h *HugoSites) renderRedirects() error {
+	conf := h.Configs.Base.Redirects
+	if conf.IsZero() {
+		return nil
+	}
+
+	if !h.Configs.IsMultihost {
+		return h.Sites[0].publishRedirects(conf, "", h.Sites...)
+	}
+	for _, s := range h.Sites {
+		if err := s.publishRedirects(conf, s.Language().Lang, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// plainTextFormat is used to publish generated text files that are not backed
+// by any of the configured output formats.
+var plainTextFormat = output.Format{
+	Name:        "plaintext",
+	MediaType:   media.Builtin.TextType,
+	IsPlainText: true,
+}
+
+func (s *Site) publishRedirects(conf redirects.Config, dir string, sites ...*Site) error {
+	var all []redirects.Redirect
+	pages := make(map[string]string)
+	for _, ss := range sites {
+		all = append(all, ss.redirects...)
+		for _, p := range ss.Pages() {
+			for _, of := range p.OutputFormats() {
+				if of.Format.IsHTML {
+					pages[of.RelPermalink()] = p.(*pageState).pathOrTitle()
+				}
+			}
+		}
+	}
+
+	all, err := redirects.Validate(all, pages)
+	if err != nil {
+		return err
+	}
+
+	for _, format := range conf.Formats {
+		var b bytes.Buffer
+		if err := redirects.Write(&b, format, all); err != nil {
+			return err
+		}
+		outputFormat := plainTextFormat
+		if format == redirects.FormatJSON {
+			outputFormat = output.JSONFormat
+		}
+		if err := s.publisher.Publish(publisher.Descriptor{
+			Src:          &b,
+			TargetPath:   filepath.Join(dir, redirects.Filename(format)),
+			StatCounter:  &s.PathSpec.ProcessingStats.Files,
+			OutputFormat: outputFormat,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// renderThirdPartyNotices publishes the third-party notices report if configured.
+func (h *HugoSites) renderThirdPartyNotices() error {
+	conf := h.Configs.Base.Module.Notices
+	if conf.Filename == "" {
+		return nil
+	}
+
+	notices, err := modules.CollectNotices(h.Fs.Source, h.Configs.LoadingInfo.BaseConfig.WorkingDir, h.Configs.Modules, conf)
+	if err != nil {
+		return err
+	}
+
+	var b bytes.Buffer
+	if err := modules.WriteNotices(&b, notices); err != nil {
+		return err
+	}
+
+	s := h.Sites[0]
+
+	return s.publisher.Publish(publisher.Descriptor{
+		Src:          &b,
+		TargetPath:   filepath.FromSlash(conf.Filename),
+		StatCounter:  &s.PathSpec.ProcessingStats.Files,
+		OutputFormat: plainTextFormat,
+	})
+}
+
 func (h *HugoSites) removePageByFilename(filename string) {
 	h.getContentMaps().withMaps(func(m *pageMap) error {
 		m.deleteBundleMatching(func(b *contentNode) bool {
@@ -716,6 +900,16 @@ func (h *HugoSites) resetPageStateFromEvents(idset identity.Identities) {
 		}
 
 		for _, s := range p.shortcodeState.shortcodes {
+			for id := range idset {
+				if pid, ok := id.(identity.PathIdentity); ok && pid.Type == files.ComponentFolderAssets && s.usesAsset(pid.Path) {
+					for _, po := range p.pageOutputs {
+						if po.cp != nil {
+							po.cp.Reset()
+						}
+					}
+					return false
+				}
+			}
 			for _, templ := range s.templs {
 				sid := templ.(identity.Manager)
 				for id := range idset {
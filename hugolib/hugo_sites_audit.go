@@ -0,0 +1,122 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gohugoio/hugo/htmlaudit"
+	"github.com/spf13/afero"
+)
+
+// auditState keeps track of the published HTML files to audit.
+type auditState struct {
+	auditor *htmlaudit.Auditor
+
+	mu sync.Mutex
+	// The target filenames rendered since the last audit, mapped to the
+	// source of their page, if any.
+	changed map[string]string
+	// The findings of all the audited files, keyed by target filename.
+	// Kept between rebuilds to write a complete report.
+	findings map[string][]htmlaudit.Finding
+}
+
+// addAuditTarget registers the page p published to targetPath in the given
+// output format for auditing, if enabled for that format.
+func (h *HugoSites) addAuditTarget(p *pageState, format, targetPath string) {
+	if h.audit.auditor == nil || !h.audit.auditor.HandlesFormat(format) {
+		return
+	}
+	var source string
+	if !p.File().IsZero() {
+		source = p.File().Filename()
+	}
+	h.audit.mu.Lock()
+	h.audit.changed[targetPath] = source
+	h.audit.mu.Unlock()
+}
+
+// runAudit audits the HTML files published in this build, logs the findings
+// failing the build and writes the report of all the audited files.
+func (h *HugoSites) runAudit() error {
+	a := h.audit.auditor
+	if a == nil {
+		return nil
+	}
+	defer h.timeTrack(time.Now(), "audit")
+
+	h.audit.mu.Lock()
+	defer h.audit.mu.Unlock()
+
+	changed := h.audit.changed
+	h.audit.changed = make(map[string]string)
+	if len(changed) == 0 {
+		return nil
+	}
+
+	var changedFindings []htmlaudit.Finding
+	for targetPath, source := range changed {
+		b, err := afero.ReadFile(h.BaseFs.PublishFs, targetPath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				delete(h.audit.findings, targetPath)
+				continue
+			}
+			return err
+		}
+		findings := a.Audit(strings.TrimPrefix(filepath.ToSlash(targetPath), "/"), b)
+		for i := range findings {
+			findings[i].Source = source
+		}
+		h.audit.findings[targetPath] = findings
+		changedFindings = append(changedFindings, findings...)
+	}
+
+	conf := a.Config()
+	for _, f := range htmlaudit.NewReport(changedFindings).Findings {
+		if conf.Fails(f.Severity) {
+			h.Log.Errorln(f)
+		}
+	}
+
+	var all []htmlaudit.Finding
+	for _, findings := range h.audit.findings {
+		all = append(all, findings...)
+	}
+	report := htmlaudit.NewReport(all)
+
+	errors, warnings := report.Summary[htmlaudit.SeverityError], report.Summary[htmlaudit.SeverityWarning]
+	if conf.Report != "" {
+		js, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := afero.WriteFile(h.Fs.WorkingDirWritable, filepath.Clean(conf.Report), js, 0666); err != nil {
+			return err
+		}
+		if errors+warnings > 0 {
+			h.Log.Warnf("audit: found %d error(s) and %d warning(s), see %s", errors, warnings, conf.Report)
+		}
+	} else if errors+warnings > 0 {
+		h.Log.Warnf("audit: found %d error(s) and %d warning(s)", errors, warnings)
+	}
+
+	return nil
+}
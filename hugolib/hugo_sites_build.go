@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 	"time"
@@ -30,6 +31,7 @@ import (
 	"github.com/gohugoio/hugo/common/para"
 	"github.com/gohugoio/hugo/config"
 	"github.com/gohugoio/hugo/resources/postpub"
+	"github.com/gohugoio/hugo/shard"
 
 	"github.com/spf13/afero"
 
@@ -143,14 +145,57 @@ func (h *HugoSites) Build(config BuildCfg, events ...fsnotify.Event) error {
 		if err := h.postProcess(); err != nil {
 			h.SendError(fmt.Errorf("postProcess: %w", err))
 		}
+		if err := h.renderPDFs(); err != nil {
+			h.SendError(fmt.Errorf("renderPDFs: %w", err))
+		}
+		if err := h.runIndexer(); err != nil {
+			h.SendError(fmt.Errorf("indexer: %w", err))
+		}
+		if err := h.runLinkCheck(); err != nil {
+			h.SendError(fmt.Errorf("checkLinks: %w", err))
+		}
+		if err := h.runAudit(); err != nil {
+			h.SendError(fmt.Errorf("audit: %w", err))
+		}
+		if !conf.SkipRender {
+			prevManifest, err := h.readBuildManifest()
+			if err != nil {
+				h.SendError(fmt.Errorf("buildManifest: %w", err))
+			}
+			manifest, err := h.writeBuildManifest()
+			if err != nil {
+				h.SendError(fmt.Errorf("buildManifest: %w", err))
+			} else if err := h.runIndexNow(prevManifest, manifest); err != nil {
+				h.SendError(fmt.Errorf("indexNow: %w", err))
+			}
+		}
+		if len(events) == 0 {
+			// We need a full build to know which cache entries are in use.
+			if err := h.pruneCachesToMaxSize(); err != nil {
+				h.SendError(fmt.Errorf("pruneCaches: %w", err))
+			}
+		}
 	}
 
 	if h.Metrics != nil {
 		var b bytes.Buffer
-		h.Metrics.WriteMetrics(&b)
+		if h.Configs.Base.TemplateMetricsFormat == "json" {
+			// Keep the JSON out of the log stream so it can be parsed.
+			if err := h.Metrics.WriteMetricsJSON(&b); err != nil {
+				h.SendError(fmt.Errorf("failed to write template metrics: %w", err))
+			} else if filename := h.Configs.Base.TemplateMetricsFile; filename != "" {
+				if err := afero.WriteFile(h.Fs.WorkingDirWritable, filepath.Clean(filename), b.Bytes(), 0666); err != nil {
+					h.SendError(fmt.Errorf("failed to write template metrics: %w", err))
+				}
+			} else {
+				os.Stdout.Write(b.Bytes())
+			}
+		} else {
+			h.Metrics.WriteMetrics(&b)
 
-		h.Log.Printf("\nTemplate Metrics:\n\n")
-		h.Log.Println(b.String())
+			h.Log.Printf("\nTemplate Metrics:\n\n")
+			h.Log.Println(b.String())
+		}
 	}
 
 	h.StopErrorCollector()
@@ -210,6 +255,8 @@ func (h *HugoSites) process(config *BuildCfg, init func(config *BuildCfg) error,
 
 	firstSite := h.Sites[0]
 
+	h.BuildState.SetChangedPaths(nil)
+
 	if len(events) > 0 {
 		// This is a rebuild
 		return firstSite.processPartial(config, init, events)
@@ -300,13 +347,28 @@ func (h *HugoSites) render(config *BuildCfg) error {
 		}
 	}
 
-	if !config.SkipRender {
+	if !config.SkipRender && h.Configs.Base.C.Shard.IsPrimary() {
 		if err := h.renderCrossSitesSitemap(); err != nil {
 			return err
 		}
 		if err := h.renderCrossSitesRobotsTXT(); err != nil {
 			return err
 		}
+		if err := h.renderWellKnown(); err != nil {
+			return err
+		}
+		if err := h.renderIndieWeb(); err != nil {
+			return err
+		}
+		if err := h.renderIndexNowKey(); err != nil {
+			return err
+		}
+		if err := h.renderRedirects(); err != nil {
+			return err
+		}
+		if err := h.renderThirdPartyNotices(); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -321,6 +383,14 @@ func (h *HugoSites) postProcess() error {
 		return err
 	}
 
+	if err := h.writeSRIManifest(); err != nil {
+		return err
+	}
+
+	if err := h.writeShardManifest(); err != nil {
+		return err
+	}
+
 	// This will only be set when js.Build have been triggered with
 	// imports that resolves to the project or a module.
 	// Write a jsconfig.json file to the project's /asset directory
@@ -431,6 +501,35 @@ func (h *HugoSites) postProcess() error {
 	return g.Wait()
 }
 
+// writeSRIManifest writes the integrity hashes of the published fingerprinted
+// resources to the file set in build.sriManifest, if set.
+func (h *HugoSites) writeSRIManifest() error {
+	if h.ResourceSpec == nil {
+		return nil
+	}
+	filename := h.ResourceSpec.BuildConfig().SRIManifest
+	if filename == "" {
+		return nil
+	}
+
+	b, err := json.MarshalIndent(h.ResourceSpec.IntegrityManifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return afero.WriteFile(h.BaseFs.PublishFs, filepath.Clean(filename), b, 0666)
+}
+
+// writeShardManifest writes the manifest used to merge the publish
+// directories of the shards, if only a shard of the site is rendered.
+func (h *HugoSites) writeShardManifest() error {
+	c := h.Configs.Base.C.Shard
+	if !c.Enabled() {
+		return nil
+	}
+	return shard.WriteManifest(h.BaseFs.PublishFs, "", shard.NewManifest(c))
+}
+
 type publishStats struct {
 	CSSClasses string `json:"cssClasses"`
 }
@@ -464,18 +563,15 @@ func (h *HugoSites) writeBuildStats() error {
 
 	filename := filepath.Join(h.Configs.LoadingInfo.BaseConfig.WorkingDir, hugoStatsName)
 
-	if existingContent, err := afero.ReadFile(hugofs.Os, filename); err == nil {
-		// Check if the content has changed.
-		if bytes.Equal(existingContent, js) {
-			return nil
+	// Check if the content has changed.
+	existingContent, err := afero.ReadFile(hugofs.Os, filename)
+	if err != nil || !bytes.Equal(existingContent, js) {
+		// Make sure it's always written to the OS fs.
+		if err := afero.WriteFile(hugofs.Os, filename, js, 0666); err != nil {
+			return err
 		}
 	}
 
-	// Make sure it's always written to the OS fs.
-	if err := afero.WriteFile(hugofs.Os, filename, js, 0666); err != nil {
-		return err
-	}
-
 	// Write to the destination as well if it's a in-memory fs.
 	if !hugofs.IsOsFs(h.Fs.Source) {
 		if err := afero.WriteFile(h.Fs.WorkingDirWritable, filename, js, 0666); err != nil {
@@ -646,3 +646,39 @@ title: "A page"
 
 	b.CreateSites().BuildFail(BuildCfg{})
 }
+
+func TestSiteBuildRenderTimeout(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap"]
+renderTimeout = "200ms"
+-- content/p1.md --
+---
+title: "P1"
+---
+
+{{< slow >}}
+-- layouts/_default/single.html --
+{{ .Content }}
+-- layouts/_default/list.html --
+List.
+-- layouts/shortcodes/slow.html --
+{{ partial "loop.html" . }}
+-- layouts/partials/loop.html --
+{{ range seq 1000 }}{{ range seq 1000 }}{{ range seq 1000 }}{{ $x := add 1 1 }}{{ end }}{{ end }}{{ end }}
+`
+
+	b, err := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `render of page "/content/p1.md" exceeded renderTimeout (200ms)`)
+	b.Assert(err.Error(), qt.Contains, `template stack: _default/single.html > shortcodes/slow.html > partials/loop.html`)
+	b.Assert(err.Error(), qt.Contains, `executing shortcode "slow" at "/content/p1.md:5:1"`)
+}
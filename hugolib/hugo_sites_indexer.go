@@ -0,0 +1,176 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"context"
+	"html"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gohugoio/hugo/common/hugo"
+	"github.com/gohugoio/hugo/helpers"
+	"github.com/gohugoio/hugo/identity"
+	"github.com/gohugoio/hugo/indexer"
+	"github.com/gohugoio/hugo/tpl"
+	"github.com/spf13/afero"
+	"github.com/spf13/cast"
+)
+
+// indexerState keeps track of the published pages to feed to the indexer.
+type indexerState struct {
+	indexer *indexer.Indexer
+
+	mu sync.Mutex
+	// All the published documents, keyed by target filename.
+	documents map[string]indexer.Document
+	// The target filenames rendered since the last indexer run.
+	changed map[string]bool
+	// The hashes of the documents and their published files sent in the
+	// last indexer runs, keyed by target filename.
+	hashes map[string]string
+	// Whether the indexer has been run with all the documents.
+	ranFull bool
+}
+
+// addIndexerDocument registers the page p published to targetPath in the
+// given output format for indexing, if enabled for that format.
+func (h *HugoSites) addIndexerDocument(p *pageState, format, targetPath string) {
+	if h.indexer.indexer == nil || !h.indexer.indexer.HandlesFormat(format) {
+		return
+	}
+
+	d := indexer.Document{
+		File:         strings.TrimPrefix(filepath.ToSlash(targetPath), "/"),
+		Permalink:    p.Permalink(),
+		RelPermalink: p.RelPermalink(),
+		Title:        p.Title(),
+		Description:  p.Description(),
+		Summary:      strings.TrimSpace(html.UnescapeString(tpl.StripHTML(string(p.Summary(context.Background()))))),
+		Lang:         p.Language().Lang,
+		Kind:         p.Kind(),
+		Section:      p.Section(),
+		Tags:         cast.ToStringSlice(p.Params()["tags"]),
+		Keywords:     p.Keywords(),
+		Weight:       p.Weight(),
+	}
+	if of := p.OutputFormats().Get(format); of != nil {
+		d.Permalink = of.Permalink()
+		d.RelPermalink = of.RelPermalink()
+	}
+	if date := p.Date(); !date.IsZero() {
+		d.Date = &date
+	}
+	if lastmod := p.Lastmod(); !lastmod.IsZero() {
+		d.Lastmod = &lastmod
+	}
+
+	h.indexer.mu.Lock()
+	h.indexer.documents[targetPath] = d
+	h.indexer.changed[targetPath] = true
+	h.indexer.mu.Unlock()
+}
+
+// runIndexer feeds the documents published in this build to the indexer,
+// or all the documents if this is the first build or incremental indexing
+// is disabled.
+func (h *HugoSites) runIndexer() error {
+	idx := h.indexer.indexer
+	if idx == nil {
+		return nil
+	}
+	defer h.timeTrack(time.Now(), "runIndexer")
+
+	h.indexer.mu.Lock()
+	changed := h.indexer.changed
+	h.indexer.changed = make(map[string]bool)
+	full := !h.indexer.ranFull || !idx.Config().Incremental
+	h.indexer.mu.Unlock()
+
+	// A rebuild in server mode may render pages that haven't changed, so
+	// compare the published files with what was sent in the previous run.
+	hashes := make(map[string]string)
+	var documents []indexer.Document
+	for k := range changed {
+		d := h.indexer.documents[k]
+		b, err := afero.ReadFile(h.BaseFs.PublishFs, k)
+		if err != nil {
+			return err
+		}
+		hashes[k] = identity.HashString(d, helpers.MD5String(string(b)))
+		if !full && hashes[k] == h.indexer.hashes[k] {
+			continue
+		}
+		documents = append(documents, d)
+	}
+	if full {
+		documents = documents[:0]
+		for _, d := range h.indexer.documents {
+			documents = append(documents, d)
+		}
+	}
+
+	if !full && len(documents) == 0 {
+		return nil
+	}
+
+	sort.Slice(documents, func(i, j int) bool {
+		return documents[i].File < documents[j].File
+	})
+
+	bcfg := h.Conf.BaseConfig()
+	publishDir := bcfg.PublishDir
+	if !filepath.IsAbs(publishDir) {
+		publishDir = filepath.Join(bcfg.WorkingDir, publishDir)
+	}
+
+	payload := indexer.Payload{
+		Full:       full,
+		PublishDir: publishDir,
+		Documents:  documents,
+	}
+
+	if err := idx.Run(payload, hugo.GetExecEnviron(bcfg.WorkingDir, h.Conf, nil)); err != nil {
+		// Retry these in the next run.
+		h.indexer.mu.Lock()
+		for k := range changed {
+			h.indexer.changed[k] = true
+		}
+		h.indexer.mu.Unlock()
+
+		switch idx.Config().OnError {
+		case indexer.OnErrorWarn:
+			h.Log.Warnf("indexer: %s", err)
+		case indexer.OnErrorIgnore:
+			h.Log.Infof("indexer: %s", err)
+		default:
+			return err
+		}
+		return nil
+	}
+
+	h.indexer.mu.Lock()
+	h.indexer.ranFull = h.indexer.ranFull || full
+	for k, v := range hashes {
+		h.indexer.hashes[k] = v
+	}
+	h.indexer.mu.Unlock()
+
+	h.Log.Infof("Indexed %d pages", len(documents))
+
+	return nil
+}
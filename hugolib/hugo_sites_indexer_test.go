@@ -0,0 +1,168 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/indexer"
+)
+
+const indexerTestFiles = `
+-- hugo.toml --
+baseURL = "https://example.com/"
+disableKinds = ["taxonomy", "term", "sitemap", "robotsTXT", "404", "rss"]
+INDEXERCONFIG
+-- content/docs/intro.md --
+---
+title: "Intro"
+date: 2023-05-01
+tags: ["start"]
+---
+Introduction.
+-- content/blog/post.md --
+---
+title: "Post"
+---
+Post content.
+-- layouts/_default/single.html --
+{{ .Title }}|{{ .Content }}
+-- layouts/_default/list.html --
+List: {{ .Title }}
+`
+
+func TestIndexerService(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu       sync.Mutex
+		payloads []indexer.Payload
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var p indexer.Payload
+		if err := json.NewDecoder(r.Body).Decode(&p); err != nil || r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		payloads = append(payloads, p)
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	files := strings.Replace(indexerTestFiles, "INDEXERCONFIG", fmt.Sprintf(`
+[indexer]
+url = %q
+[indexer.headers]
+Authorization = "Bearer secret"
+`, srv.URL), 1)
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			Running:     true,
+		},
+	).Build()
+
+	b.Assert(payloads, qt.HasLen, 1)
+	p := payloads[0]
+	b.Assert(p.Full, qt.IsTrue)
+	var files1 []string
+	for _, d := range p.Documents {
+		files1 = append(files1, d.File)
+	}
+	b.Assert(files1, qt.DeepEquals, []string{"blog/index.html", "blog/post/index.html", "docs/index.html", "docs/intro/index.html", "index.html"})
+	intro := p.Documents[3]
+	b.Assert(intro.Title, qt.Equals, "Intro")
+	b.Assert(intro.Permalink, qt.Equals, "https://example.com/docs/intro/")
+	b.Assert(intro.Kind, qt.Equals, "page")
+	b.Assert(intro.Section, qt.Equals, "docs")
+	b.Assert(intro.Summary, qt.Equals, "Introduction.")
+	b.Assert(intro.Tags, qt.DeepEquals, []string{"start"})
+	b.Assert(intro.Date.Format("2006-01-02"), qt.Equals, "2023-05-01")
+
+	b.EditFileReplace("content/blog/post.md", func(s string) string { return strings.Replace(s, "Post content.", "Post edited.", 1) }).Build()
+
+	b.Assert(payloads, qt.HasLen, 2)
+	p = payloads[1]
+	b.Assert(p.Full, qt.IsFalse)
+	var files2 []string
+	for _, d := range p.Documents {
+		files2 = append(files2, d.File)
+		if d.File == "blog/post/index.html" {
+			b.Assert(d.Summary, qt.Equals, "Post edited.")
+		}
+	}
+	b.Assert(files2, qt.Contains, "blog/post/index.html")
+	b.Assert(files2, qt.Not(qt.Contains), "docs/intro/index.html")
+}
+
+func TestIndexerOnError(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "index unavailable", http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	for _, onError := range []string{"fail", "warn"} {
+		files := strings.Replace(indexerTestFiles, "INDEXERCONFIG", fmt.Sprintf(`
+[indexer]
+url = %q
+onError = %q
+`, srv.URL, onError), 1)
+
+		b, err := NewIntegrationTestBuilder(
+			IntegrationTestConfig{
+				T:           t,
+				TxtarString: files,
+			},
+		).BuildE()
+
+		if onError == "fail" {
+			b.Assert(err, qt.IsNotNil)
+			b.Assert(err.Error(), qt.Contains, "indexer API responded with 503 Service Unavailable: index unavailable")
+		} else {
+			b.Assert(err, qt.IsNil)
+			b.AssertLogContains("indexer: indexer API responded with 503")
+		}
+	}
+}
+
+func TestIndexerCommandNotAllowed(t *testing.T) {
+	t.Parallel()
+
+	files := strings.Replace(indexerTestFiles, "INDEXERCONFIG", `
+[indexer]
+command = ["pagefind", "--site", "public"]
+`, 1)
+
+	b, err := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `access denied: "pagefind" is not whitelisted in policy "security.exec.allow"`)
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gohugoio/hugo/publisher"
+	"github.com/gohugoio/hugo/resources"
+)
+
+// renderIndexNowKey publishes the IndexNow key file, once per host in
+// multihost mode, unless hosted elsewhere.
+func (h *HugoSites) renderIndexNowKey() error {
+	if h.indexNow == nil {
+		return nil
+	}
+	filename := h.indexNow.Config().KeyFilename()
+	if filename == "" {
+		return nil
+	}
+	sites := h.Sites[:1]
+	if h.Configs.IsMultihost {
+		sites = h.Sites
+	}
+	for _, s := range sites {
+		var basePath string
+		if h.Configs.IsMultihost {
+			basePath = s.Language().Lang
+		}
+		pd := publisher.Descriptor{
+			Src:          strings.NewReader(h.indexNow.Config().Key),
+			TargetPath:   path.Join(basePath, filename),
+			StatCounter:  &s.PathSpec.ProcessingStats.Files,
+			OutputFormat: plainTextFormat,
+		}
+		if err := s.publisher.Publish(pd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runIndexNow submits the URLs of the pages added, changed or removed since
+// the previous build, as recorded in the build manifests prev and cur, to
+// the IndexNow endpoints and pings the sitemaps.
+func (h *HugoSites) runIndexNow(prev, cur *buildManifest) error {
+	s := h.indexNow
+	if s == nil || cur == nil || h.Configs.Base.Internal.Running {
+		return nil
+	}
+	if prev == nil {
+		h.Log.Infoln("indexNow: no previous build manifest found, nothing to submit")
+		return nil
+	}
+	defer h.timeTrack(time.Now(), "runIndexNow")
+
+	pages := func(m *buildManifest) map[string]resources.BuildManifestEntry {
+		entries := make(map[string]resources.BuildManifestEntry)
+		for _, e := range m.Files {
+			if e.Type == resources.BuildManifestTypePage && s.HandlesFormat(e.OutputFormat) {
+				entries[e.Path] = e
+			}
+		}
+		return entries
+	}
+	prevPages, curPages := pages(prev), pages(cur)
+
+	var urls []string
+	for p, e := range curPages {
+		if pe, found := prevPages[p]; !found || pe.SHA256 != e.SHA256 {
+			urls = append(urls, h.publishPathToURL(p))
+		}
+	}
+	for p := range prevPages {
+		if _, found := curPages[p]; !found {
+			urls = append(urls, h.publishPathToURL(p))
+		}
+	}
+	if len(urls) == 0 {
+		h.Log.Infoln("indexNow: no changed pages to submit")
+		return nil
+	}
+
+	reqs, err := s.Requests(urls, h.sitemapURLs(cur), h.indexNowKeyLocation)
+	if err != nil {
+		return err
+	}
+
+	if s.Config().DryRun {
+		for _, r := range reqs {
+			h.Log.Printf("indexNow: dry run: %s %s\n  %s", r.Method, r.URL, strings.Join(r.URLs, "\n  "))
+		}
+		return nil
+	}
+
+	if err := s.Send(reqs); err != nil {
+		return err
+	}
+	h.Log.Infof("indexNow: submitted %d changed URLs", len(urls))
+
+	return nil
+}
+
+// publishPathToURL returns the permalink of the page published to p,
+// a path relative to the publish dir as used in the build manifest.
+func (h *HugoSites) publishPathToURL(p string) string {
+	s := h.Sites[0]
+	if h.Configs.IsMultihost {
+		for _, ss := range h.Sites {
+			if prefix := ss.Language().Lang + "/"; strings.HasPrefix(p, prefix) {
+				s, p = ss, strings.TrimPrefix(p, prefix)
+				break
+			}
+		}
+	}
+	u := s.PathSpec.AbsURL(p, false)
+	if strings.HasSuffix(u, "/index.html") {
+		u = strings.TrimSuffix(u, "index.html")
+	}
+	return u
+}
+
+// sitemapURLs returns the URLs of the sitemaps published in the build
+// recorded in m, one per host in multihost mode.
+func (h *HugoSites) sitemapURLs(m *buildManifest) []string {
+	published := make(map[string]bool)
+	for _, e := range m.Files {
+		published[e.Path] = true
+	}
+	var urls []string
+	for i, s := range h.Sites {
+		filename := s.conf.Sitemap.Filename
+		if filename == "" {
+			filename = "sitemap.xml"
+		}
+		p := filename
+		if h.Configs.IsMultihost {
+			p = path.Join(s.Language().Lang, filename)
+		} else if i > 0 {
+			break
+		}
+		if published[p] {
+			urls = append(urls, s.PathSpec.AbsURL(filename, false))
+		}
+	}
+	return urls
+}
+
+// indexNowKeyLocation returns the URL of the key file published for host.
+func (h *HugoSites) indexNowKeyLocation(host string) string {
+	filename := h.indexNow.Config().KeyFilename()
+	for _, s := range h.Sites {
+		if u, err := url.Parse(s.PathSpec.AbsURL("/", false)); err == nil && u.Host == host {
+			return s.PathSpec.AbsURL(filename, false)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,175 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+const indexNowTestFiles = `
+-- hugo.toml --
+baseURL = "https://example.org/blog/"
+disableKinds = ["taxonomy", "term", "rss", "robotsTXT", "404"]
+[build]
+manifest = "hugo_manifest.json"
+[indexNow]
+enable = true
+key = "0123456789abcdef"
+interval = "0s"
+INDEXNOWCONFIG
+-- layouts/_default/single.html --
+Single: {{ .Title }}|{{ .Content }}
+-- layouts/_default/list.html --
+List: {{ .Title }}
+-- content/posts/p1.md --
+---
+title: P1
+---
+P1 CONTENT
+-- content/posts/p2.md --
+---
+title: P2
+---
+P2.
+`
+
+func TestIndexNow(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		Method string
+		Path   string
+		Body   map[string]any
+	}
+
+	var (
+		mu       sync.Mutex
+		requests []request
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := request{Method: r.Method, Path: r.URL.RequestURI()}
+		if r.Method == "POST" {
+			if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+		mu.Lock()
+		requests = append(requests, req)
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	files := strings.Replace(indexNowTestFiles, "INDEXNOWCONFIG", fmt.Sprintf(`
+endpoints = [%q]
+pingURLs = [%q]
+`, srv.URL+"/indexnow", srv.URL+"/ping?sitemap={sitemap}"), 1)
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/0123456789abcdef.txt", "0123456789abcdef")
+	// No previous manifest.
+	b.Assert(requests, qt.HasLen, 0)
+
+	manifest := b.FileContent("public/hugo_manifest.json")
+	files = strings.Replace(files, "P1 CONTENT", "P1 EDITED", 1)
+	files = strings.Replace(files, "-- content/posts/p2.md --", "-- content/posts/p3.md --", 1)
+	files += "-- public/hugo_manifest.json --\n" + manifest
+
+	NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.Assert(requests, qt.HasLen, 2)
+	b.Assert(requests[0].Method, qt.Equals, "POST")
+	b.Assert(requests[0].Path, qt.Equals, "/indexnow")
+	b.Assert(requests[0].Body, qt.DeepEquals, map[string]any{
+		"host":        "example.org",
+		"key":         "0123456789abcdef",
+		"keyLocation": "https://example.org/blog/0123456789abcdef.txt",
+		"urlList": []any{
+			"https://example.org/blog/posts/p1/",
+			"https://example.org/blog/posts/p2/",
+			"https://example.org/blog/posts/p3/",
+		},
+	})
+	b.Assert(requests[1], qt.DeepEquals, request{
+		Method: "GET",
+		Path:   "/ping?sitemap=https%3A%2F%2Fexample.org%2Fblog%2Fsitemap.xml",
+	})
+}
+
+func TestIndexNowDryRun(t *testing.T) {
+	t.Parallel()
+
+	files := strings.Replace(indexNowTestFiles, "INDEXNOWCONFIG", `
+dryRun = true
+keyLocation = "https://example.org/key.txt"
+`, 1)
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertDestinationExists("0123456789abcdef.txt", false)
+
+	manifest := b.FileContent("public/hugo_manifest.json")
+	files = strings.Replace(files, "P1 CONTENT", "P1 EDITED", 1)
+	files += "-- public/hugo_manifest.json --\n" + manifest
+
+	b = NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertLogContains("indexNow: dry run: POST https://api.indexnow.org/indexnow\n  https://example.org/blog/posts/p1/")
+}
+
+func TestIndexNowRequiresBuildManifest(t *testing.T) {
+	t.Parallel()
+
+	files := strings.Replace(indexNowTestFiles, `manifest = "hugo_manifest.json"`, "", 1)
+	files = strings.Replace(files, "INDEXNOWCONFIG", "", 1)
+
+	b, err := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.ErrorMatches, ".*indexNow: build.manifest must be set to detect the changed pages")
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gohugoio/hugo/linkcheck"
+	"github.com/gohugoio/hugo/publishtargets"
+)
+
+// linkCheckState keeps track of the published HTML files to check the links in.
+type linkCheckState struct {
+	checker *linkcheck.Checker
+
+	mu sync.Mutex
+	// The documents rendered since the last check, keyed by target filename.
+	documents map[string]linkcheck.Document
+}
+
+func (h *HugoSites) newLinkChecker() (*linkcheck.Checker, error) {
+	conf := h.Configs.Base.CheckLinks
+	targets := h.Configs.Base.PublishTargets
+	if !conf.Enable && len(targets) == 0 {
+		return nil, nil
+	}
+	if h.Configs.Base.C.Shard.Enabled() {
+		// The pages in the other shards are not published in this build.
+		h.Log.Warnln("checkLinks: links are not checked in sharded builds, check them in a build of the complete site")
+		return nil, nil
+	}
+	if h.Configs.Base.Internal.Running && !h.Configs.Base.Internal.DisableLiveReload {
+		conf.Ignore = append(conf.Ignore[:len(conf.Ignore):len(conf.Ignore)], `/livereload\.js`)
+	}
+
+	opts := linkcheck.Options{
+		PublishFs: h.BaseFs.PublishFs,
+		SourceFs:  h.Fs.Source,
+		Exists:    h.isPublishedStaticFile,
+		Exec:      h.ExecHelper,
+		Cache:     h.ResourceSpec.FileCaches.LinkCheckCache(),
+	}
+	if len(targets) > 0 {
+		var languages []string
+		for _, l := range h.Configs.Languages {
+			languages = append(languages, l.Lang)
+		}
+		opts.Visibility = publishtargets.NewRouter(targets, languages).Visibility
+	}
+	for _, s := range h.Sites {
+		var dir string
+		if h.Configs.IsMultihost {
+			dir = s.Language().Lang
+		}
+		opts.Sites = append(opts.Sites, linkcheck.Site{BaseURL: s.PathSpec.Cfg.BaseURL().String(), Dir: dir})
+	}
+
+	return linkcheck.New(conf, opts)
+}
+
+// isPublishedStaticFile reports whether filename, relative to the publish dir,
+// is a static file, which may not be copied yet when the links are checked.
+func (h *HugoSites) isPublishedStaticFile(filename string) bool {
+	filename = filepath.FromSlash(filename)
+	for _, sfs := range h.BaseFs.SourceFilesystems.Static {
+		rel := filename
+		if sfs.PublishFolder != "" {
+			if !strings.HasPrefix(rel, sfs.PublishFolder+string(filepath.Separator)) {
+				continue
+			}
+			rel = strings.TrimPrefix(rel, sfs.PublishFolder+string(filepath.Separator))
+		}
+		if fi, err := sfs.Fs.Stat(rel); err == nil && !fi.IsDir() {
+			return true
+		}
+	}
+	return false
+}
+
+// addLinkCheckDocument registers the page p published to targetPath in the
+// given HTML output format for link checking, if enabled.
+func (h *HugoSites) addLinkCheckDocument(p *pageState, format, targetPath string) {
+	if h.linkCheck.checker == nil {
+		return
+	}
+	of := p.OutputFormats().Get(format)
+	if of == nil || !of.Format.IsHTML {
+		return
+	}
+
+	d := linkcheck.Document{
+		File: strings.TrimPrefix(filepath.ToSlash(targetPath), "/"),
+		URL:  of.Permalink(),
+	}
+	if !p.File().IsZero() {
+		d.Source = p.File().Filename()
+	}
+
+	h.linkCheck.mu.Lock()
+	h.linkCheck.documents[targetPath] = d
+	h.linkCheck.mu.Unlock()
+}
+
+// runLinkCheck checks the links in the HTML files published in this build
+// and logs the broken ones.
+func (h *HugoSites) runLinkCheck() error {
+	c := h.linkCheck.checker
+	if c == nil {
+		return nil
+	}
+	defer h.timeTrack(time.Now(), "checkLinks")
+
+	h.linkCheck.mu.Lock()
+	documents := h.linkCheck.documents
+	h.linkCheck.documents = make(map[string]linkcheck.Document)
+	h.linkCheck.mu.Unlock()
+
+	if len(documents) == 0 {
+		return nil
+	}
+
+	docs := make([]linkcheck.Document, 0, len(documents))
+	for _, d := range documents {
+		docs = append(docs, d)
+	}
+	sort.Slice(docs, func(i, j int) bool {
+		return docs[i].File < docs[j].File
+	})
+
+	broken, err := c.Check(docs)
+	if err != nil {
+		return err
+	}
+
+	for _, b := range broken {
+		if c.Config().ErrorLevel == linkcheck.ErrorLevelWarning {
+			h.Log.Warnln(b)
+		} else {
+			h.Log.Errorln(b)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/gohugoio/hugo/langs/i18n"
+	"github.com/gohugoio/hugo/machinetranslation"
+)
+
+// TranslationReviews returns the i18n strings and content pages of the
+// default content language missing in each of the other languages.
+// If t is set, the missing strings are machine translated with it; provider
+// is the name used in the review files.
+func (h *HugoSites) TranslationReviews(ctx context.Context, t machinetranslation.Translator, provider string) ([]machinetranslation.Review, error) {
+	messages, err := i18n.LoadMessages(h.Deps)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceLang := h.Conf.DefaultContentLanguage()
+	var source *Site
+	for _, s := range h.Sites {
+		if s.Language().Lang == sourceLang {
+			source = s
+			break
+		}
+	}
+	if source == nil {
+		return nil, nil
+	}
+
+	var reviews []machinetranslation.Review
+	for _, s := range h.Sites {
+		lang := s.Language().Lang
+		if s == source {
+			continue
+		}
+
+		r := machinetranslation.Review{SourceLang: sourceLang, Lang: lang}
+
+		r.Messages = machinetranslation.MissingMessages(messages[strings.ToLower(sourceLang)], messages[strings.ToLower(lang)])
+		if t != nil && len(r.Messages) > 0 {
+			if r.Messages, err = machinetranslation.TranslateMessages(ctx, t, r.Messages, sourceLang, lang); err != nil {
+				return nil, err
+			}
+			r.Translated = provider
+		}
+
+		for _, p := range source.RegularPages() {
+			if p.File().IsZero() {
+				continue
+			}
+			translated := false
+			for _, tp := range p.Translations() {
+				if tp.Language().Lang == lang {
+					translated = true
+					break
+				}
+			}
+			if !translated {
+				r.UntranslatedPages = append(r.UntranslatedPages, p.File().Path())
+			}
+		}
+		sort.Strings(r.UntranslatedPages)
+
+		reviews = append(reviews, r)
+	}
+
+	return reviews, nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"context"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/go-i18n/v2/i18n"
+)
+
+type testMachineTranslator struct{}
+
+func (testMachineTranslator) Translate(ctx context.Context, texts []string, from, to string) ([]string, error) {
+	translated := make([]string, len(texts))
+	for i, s := range texts {
+		translated[i] = to + ": " + s
+	}
+	return translated, nil
+}
+
+func TestTranslationReviews(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+defaultContentLanguage = "en"
+theme = "mytheme"
+[languages.en]
+weight = 1
+[languages.nn]
+weight = 2
+[languages.de]
+weight = 3
+-- i18n/en.toml --
+[home]
+other = "Home"
+[readingTime]
+one = "One minute"
+other = "{{ .Count }} minutes"
+-- i18n/nn.toml --
+[home]
+other = "Heim"
+-- themes/mytheme/i18n/de.toml --
+[home]
+other = "Startseite"
+-- layouts/_default/single.html --
+{{ .Title }}
+-- layouts/_default/list.html --
+List
+-- content/p1.md --
+---
+title: P1
+---
+-- content/p2.md --
+---
+title: P2
+---
+-- content/p2.nn.md --
+---
+title: P2 NN
+---
+-- content/p3.de.md --
+---
+title: P3 DE
+---
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	reviews, err := b.H.TranslationReviews(context.Background(), testMachineTranslator{}, "test")
+	b.Assert(err, qt.IsNil)
+	b.Assert(reviews, qt.HasLen, 2)
+
+	nn := reviews[0]
+	b.Assert(nn.Lang, qt.Equals, "nn")
+	b.Assert(nn.SourceLang, qt.Equals, "en")
+	b.Assert(nn.Translated, qt.Equals, "test")
+	b.Assert(nn.Messages, qt.DeepEquals, []*i18n.Message{{ID: "readingTime", One: "nn: One minute", Other: "nn: {{ .Count }} minutes"}})
+	b.Assert(nn.UntranslatedPages, qt.DeepEquals, []string{"p1.md"})
+
+	de := reviews[1]
+	b.Assert(de.Lang, qt.Equals, "de")
+	// The home string is in the theme.
+	b.Assert(de.Messages, qt.HasLen, 1)
+	b.Assert(de.UntranslatedPages, qt.DeepEquals, []string{"p1.md", "p2.md"})
+
+	reviews, err = b.H.TranslationReviews(context.Background(), nil, "")
+	b.Assert(err, qt.IsNil)
+	b.Assert(reviews[0].Translated, qt.Equals, "")
+	b.Assert(reviews[0].Messages[0].Other, qt.Equals, "{{ .Count }} minutes")
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gohugoio/hugo/common/para"
+	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/pdf"
+	"github.com/spf13/afero"
+)
+
+// pdfState keeps track of the published HTML files to render to PDF.
+type pdfState struct {
+	renderer *pdf.Renderer
+
+	mu sync.Mutex
+	// The target filenames rendered since the last PDF rendering.
+	targets map[string]bool
+}
+
+// addPDFTarget registers the HTML file published to targetPath in the given
+// output format for PDF rendering, if enabled for that format.
+func (h *HugoSites) addPDFTarget(format, targetPath string) {
+	if h.pdf.renderer == nil || !h.pdf.renderer.HandlesFormat(format) {
+		return
+	}
+	h.pdf.mu.Lock()
+	h.pdf.targets[targetPath] = true
+	h.pdf.mu.Unlock()
+}
+
+// renderPDFs renders the HTML files published in this build to PDF, next to
+// the HTML files.
+func (h *HugoSites) renderPDFs() error {
+	if h.pdf.renderer == nil {
+		return nil
+	}
+	defer h.timeTrack(time.Now(), "renderPDFs")
+
+	h.pdf.mu.Lock()
+	targets := h.pdf.targets
+	h.pdf.targets = make(map[string]bool)
+	h.pdf.mu.Unlock()
+
+	if len(targets) == 0 {
+		return nil
+	}
+
+	fs := h.BaseFs.PublishFs
+
+	r, _ := para.New(config.GetNumWorkerMultiplier()).Start(context.Background())
+	for filename := range targets {
+		filename := filename
+		r.Run(func() error {
+			src, err := afero.ReadFile(fs, filename)
+			if err != nil {
+				return err
+			}
+			b, err := h.pdf.renderer.Render(src)
+			if err != nil {
+				return fmt.Errorf("failed to render %q to PDF: %w", filepath.ToSlash(filename), err)
+			}
+			return afero.WriteFile(fs, strings.TrimSuffix(filename, filepath.Ext(filename))+".pdf", b, 0666)
+		})
+	}
+
+	if err := r.Wait(); err != nil {
+		return err
+	}
+
+	h.Log.Infof("Rendered %d PDF files", len(targets))
+
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+const pdfTestFiles = `
+-- hugo.toml --
+baseURL = "https://example.com/"
+disableKinds = ["taxonomy", "term", "sitemap", "robotsTXT", "404", "rss"]
+[outputs]
+page = ["html", "print"]
+PDFCONFIG
+-- content/docs/intro.md --
+---
+title: "Intro"
+---
+Introduction.
+-- content/blog/post.md --
+---
+title: "Post"
+outputs: ["html"]
+---
+Post.
+-- layouts/_default/single.html --
+HTML: {{ .Title }}|{{ with .OutputFormats.Get "print" }}{{ .RelPermalink }}{{ end }}
+-- layouts/_default/single.print.html --
+<html><head><title>{{ .Title }}</title></head><body>{{ .Content }}</body></html>
+`
+
+func TestPDFService(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		fmt.Fprintf(w, "%%PDF %s|%s|%s", r.Method, r.Header.Get("X-Hugo-Pdf-Page-Size"), b)
+	}))
+	defer srv.Close()
+
+	files := strings.Replace(pdfTestFiles, "PDFCONFIG", fmt.Sprintf(`
+[pdf]
+url = %q
+pageSize = "A4"
+footer = "{page}"
+`, srv.URL), 1)
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/docs/intro/index.html", "HTML: Intro|/docs/intro/print.html")
+	b.AssertFileContent("public/docs/intro/print.html", "<title>Intro</title></head>")
+	b.AssertFileContent("public/docs/intro/print.pdf",
+		`%PDF POST|A4|<html><head><title>Intro</title><style>@page { size: A4; @bottom-center { content: counter(page); } }</style></head>`,
+		"<p>Introduction.</p>",
+	)
+	b.AssertDestinationExists("public/docs/intro/index.pdf", false)
+	b.AssertDestinationExists("public/blog/post/print.pdf", false)
+}
+
+func TestPDFCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("needs cat")
+	}
+	t.Parallel()
+
+	files := strings.Replace(pdfTestFiles, "PDFCONFIG", `
+[pdf]
+command = ["cat", "-"]
+formats = ["html", "print"]
+[security.exec]
+allow = ["^cat$"]
+`, 1)
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/docs/intro/print.pdf", "<html><head><title>Intro</title></head>")
+	b.AssertFileContent("public/docs/intro/index.pdf", "HTML: Intro|")
+	b.AssertFileContent("public/blog/post/index.pdf", "HTML: Post|")
+}
+
+func TestPDFCommandNotAllowed(t *testing.T) {
+	t.Parallel()
+
+	files := strings.Replace(pdfTestFiles, "PDFCONFIG", `
+[pdf]
+command = ["weasyprint", "-", "-"]
+`, 1)
+
+	b, err := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `failed to render "/docs/intro/print.html" to PDF`)
+	b.Assert(err.Error(), qt.Contains, `access denied: "weasyprint" is not whitelisted in policy "security.exec.allow"`)
+}
@@ -14,6 +14,7 @@
 package hugolib
 
 import (
+	"strings"
 	"testing"
 
 	qt "github.com/frankban/quicktest"
@@ -314,3 +315,199 @@ P5 changed content
 P6 changed content
 `)
 }
+
+func TestRebuildSitemapAndRSSIncremental(t *testing.T) {
+	files := `
+-- config.toml --
+baseURL = "https://example.com"
+disableKinds = ["taxonomy", "term"]
+-- layouts/_default/list.html --
+List.
+-- layouts/_default/single.html --
+Single: {{ .Content }}
+-- layouts/_default/list.xml --
+RSS: {{ now.UnixNano }}|{{ range .Pages }}{{ .Title }}|{{ end }}
+-- layouts/_default/sitemap.xml --
+Sitemap: {{ now.UnixNano }}|{{ range .Data.Pages }}{{ .Permalink }}|{{ end }}
+-- content/blog/p1.md --
+---
+title: "P1"
+---
+P1 content.
+-- content/docs/p2.md --
+---
+title: "P2"
+---
+P2 content.
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			Running:     true,
+		},
+	).Build()
+
+	b.AssertFileContent("public/blog/index.xml", "|P1|")
+	b.AssertFileContent("public/sitemap.xml", "https://example.com/blog/p1/|")
+
+	homeRSS := b.FileContent("public/index.xml")
+	blogRSS := b.FileContent("public/blog/index.xml")
+	docsRSS := b.FileContent("public/docs/index.xml")
+	sitemap := b.FileContent("public/sitemap.xml")
+
+	// Content only change in blog: The sitemap and the docs feed are left as is.
+	b.EditFileReplace("content/blog/p1.md", func(s string) string { return strings.Replace(s, "P1 content.", "P1 edited.", 1) }).Build()
+
+	b.AssertFileContent("public/blog/p1/index.html", "P1 edited.")
+	b.Assert(b.FileContent("public/sitemap.xml"), qt.Equals, sitemap)
+	b.Assert(b.FileContent("public/docs/index.xml"), qt.Equals, docsRSS)
+	b.Assert(b.FileContent("public/blog/index.xml"), qt.Not(qt.Equals), blogRSS)
+	b.Assert(b.FileContent("public/index.xml"), qt.Not(qt.Equals), homeRSS)
+
+	// Adding a page changes the sitemap.
+	b.AddFiles("content/docs/p3.md", "---\ntitle: \"P3\"\n---\nP3 content.").Build()
+
+	b.AssertFileContent("public/sitemap.xml", "https://example.com/docs/p3/|")
+	b.AssertFileContent("public/docs/index.xml", "|P2|P3|")
+
+	// Template changes re-render all.
+	sitemap = b.FileContent("public/sitemap.xml")
+	docsRSS = b.FileContent("public/docs/index.xml")
+	b.EditFileReplace("layouts/_default/single.html", func(s string) string { return "Edited " + s }).Build()
+
+	b.Assert(b.FileContent("public/sitemap.xml"), qt.Not(qt.Equals), sitemap)
+	b.Assert(b.FileContent("public/docs/index.xml"), qt.Not(qt.Equals), docsRSS)
+}
+
+func TestRebuildSkipUnaffectedListPages(t *testing.T) {
+	files := `
+-- config.toml --
+baseURL = "https://example.com"
+disableKinds = ["taxonomy", "term", "sitemap", "RSS"]
+[build]
+skipUnaffectedListPages = true
+-- layouts/index.html --
+Home: {{ now.UnixNano }}|{{ range site.RegularPages }}{{ .Title }}|{{ end }}
+-- layouts/_default/list.html --
+List: {{ now.UnixNano }}|{{ range .Pages }}{{ .Title }}: {{ .Summary }}|{{ end }}
+-- layouts/news/list.html --
+News: {{ now.UnixNano }}|{{ range .Pages }}{{ .Content }}|{{ end }}
+-- layouts/_default/single.html --
+Single: {{ .Content }}
+-- content/blog/p1.md --
+---
+title: "P1"
+---
+P1 summary.
+<!--more-->
+P1 content.
+-- content/news/n1.md --
+---
+title: "N1"
+---
+N1 summary.
+<!--more-->
+N1 content.
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			Running:     true,
+		},
+	).Build()
+
+	b.AssertFileContent("public/blog/index.html", "P1: <p>P1 summary.</p>")
+
+	home := b.FileContent("public/index.html")
+	blog := b.FileContent("public/blog/index.html")
+	news := b.FileContent("public/news/index.html")
+
+	// Change to content after the summary.
+	b.EditFileReplace("content/blog/p1.md", func(s string) string { return strings.Replace(s, "P1 content.", "P1 edited.", 1) }).Build()
+
+	b.AssertFileContent("public/blog/p1/index.html", "P1 edited.")
+	b.Assert(b.FileContent("public/index.html"), qt.Equals, home)
+	b.Assert(b.FileContent("public/blog/index.html"), qt.Equals, blog)
+	b.Assert(b.FileContent("public/news/index.html"), qt.Equals, news)
+
+	// The news list page uses the content of its pages.
+	b.EditFileReplace("content/news/n1.md", func(s string) string { return strings.Replace(s, "N1 content.", "N1 edited.", 1) }).Build()
+
+	b.AssertFileContent("public/news/index.html", "N1 edited.")
+	b.Assert(b.FileContent("public/index.html"), qt.Equals, home)
+	b.Assert(b.FileContent("public/blog/index.html"), qt.Equals, blog)
+
+	// Change to the summary.
+	b.EditFileReplace("content/blog/p1.md", func(s string) string { return strings.Replace(s, "P1 summary.", "P1 new summary.", 1) }).Build()
+
+	b.AssertFileContent("public/blog/index.html", "P1: <p>P1 new summary.</p>")
+	b.Assert(b.FileContent("public/index.html"), qt.Not(qt.Equals), home)
+
+	// Change to the title.
+	home = b.FileContent("public/index.html")
+	b.EditFileReplace("content/blog/p1.md", func(s string) string { return strings.Replace(s, `title: "P1"`, `title: "P1 new"`, 1) }).Build()
+
+	b.AssertFileContent("public/index.html", "|P1 new|")
+	b.AssertFileContent("public/blog/index.html", "P1 new: ")
+}
+
+func TestRebuildSkipUnaffectedListPagesCachedPartialAndRender(t *testing.T) {
+	files := `
+-- config.toml --
+baseURL = "https://example.com"
+disableKinds = ["taxonomy", "term", "sitemap", "RSS"]
+[build]
+skipUnaffectedListPages = true
+-- layouts/index.html --
+Home: {{ partialCached "latest.html" . }}|
+-- layouts/_default/list.html --
+List: {{ partialCached "latest.html" . }}|{{ range .Pages }}{{ .Render "li" }}|{{ end }}
+-- layouts/partials/latest.html --
+{{ with site.GetPage "/blog/p1" }}Latest: {{ .Content }}{{ end }}
+-- layouts/_default/li.html --
+Li: {{ .Content }}
+-- layouts/_default/single.html --
+Single: {{ .Content }}
+-- content/blog/p1.md --
+---
+title: "P1"
+---
+P1 summary.
+<!--more-->
+P1 content.
+-- content/docs/d1.md --
+---
+title: "D1"
+---
+D1 summary.
+<!--more-->
+D1 content.
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			Running:     true,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html", "Home: Latest: <p>P1 summary.</p>\n<p>P1 content.</p>")
+	b.AssertFileContent("public/docs/index.html", "List: Latest: <p>P1 summary.</p>\n<p>P1 content.</p>", "Li: <p>D1 summary.</p>\n<p>D1 content.</p>")
+
+	// The cached partial is shared by all the list pages.
+	b.EditFileReplace("content/blog/p1.md", func(s string) string { return strings.Replace(s, "P1 content.", "P1 edited.", 1) }).Build()
+
+	b.AssertFileContent("public/index.html", "Home: Latest: <p>P1 summary.</p>\n<p>P1 edited.</p>")
+	b.AssertFileContent("public/blog/index.html", "List: Latest: <p>P1 summary.</p>\n<p>P1 edited.</p>", "Li: <p>P1 summary.</p>\n<p>P1 edited.</p>")
+	b.AssertFileContent("public/docs/index.html", "List: Latest: <p>P1 summary.</p>\n<p>P1 edited.</p>")
+
+	// The content is used in the layout passed to Render.
+	b.EditFileReplace("content/docs/d1.md", func(s string) string { return strings.Replace(s, "D1 content.", "D1 edited.", 1) }).Build()
+
+	b.AssertFileContent("public/docs/index.html", "Li: <p>D1 summary.</p>\n<p>D1 edited.</p>")
+}
@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/gohugoio/hugo/common/loggers"
@@ -117,3 +118,83 @@ Resources: [js/include.js]
 `)
 
 }
+
+func TestMountFiltersRename(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+[module]
+[[module.imports]]
+path = "upstream"
+[[module.imports.mounts]]
+source = "docs"
+target = "content/docs"
+includeFiles = "/en/**"
+rename = ["^README.md$ -> _index.md", '^(.*)\.markdown$ -> $1.md']
+-- themes/upstream/docs/en/README.md --
+---
+title: "Docs"
+---
+-- themes/upstream/docs/en/guide/README.md --
+---
+title: "Guide"
+---
+-- themes/upstream/docs/en/guide/install.markdown --
+---
+title: "Install"
+---
+-- themes/upstream/docs/fr/README.md --
+---
+title: "Docs FR"
+---
+-- layouts/_default/list.html --
+List: {{ .Title }}|{{ .File.LogicalName }}|{{ range .Pages }}{{ .RelPermalink }}|{{ end }}
+-- layouts/_default/single.html --
+Single: {{ .Title }}|{{ .File.LogicalName }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			Running:     true,
+		},
+	).Build()
+
+	b.AssertFileContent("public/docs/en/index.html", "List: Docs|_index.md|/docs/en/guide/|")
+	b.AssertFileContent("public/docs/en/guide/index.html", "List: Guide|_index.md|/docs/en/guide/install/|")
+	b.AssertFileContent("public/docs/en/guide/install/index.html", "Single: Install|install.md")
+	b.AssertDestinationExists("public/docs/fr/index.html", false)
+
+	b.EditFileReplace("themes/upstream/docs/en/guide/README.md", func(s string) string { return strings.Replace(s, "Guide", "Guide Edited", 1) }).Build()
+
+	b.AssertFileContent("public/docs/en/guide/index.html", "List: Guide Edited|_index.md|")
+}
+
+func TestMountFiltersRenameNotContent(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+[module]
+[[module.mounts]]
+source = "layouts"
+target = "layouts"
+rename = "^README.md$ -> _index.md"
+-- layouts/index.html --
+Home.
+`
+
+	b, err := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, "rename is only supported for content mounts")
+}
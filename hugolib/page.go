@@ -316,6 +316,7 @@ func (p *pageState) Pages() page.Pages {
 // RawContent returns the un-rendered source content without
 // any leading front matter.
 func (p *pageState) RawContent() string {
+	p.trackRawContent()
 	if p.source.parsed == nil {
 		return ""
 	}
@@ -790,7 +791,16 @@ Loop:
 			rn.AddShortcode(currShortcode)
 
 		case it.Type == pageparser.TypeEmoji:
-			if emoji := helpers.Emoji(it.ValStr(result.Input())); emoji != nil {
+			val := it.ValStr(result.Input())
+			if sc, err := s.newEmojiTokenShortcode(ordinal, val); err != nil {
+				return fail(err, it)
+			} else if sc != nil {
+				sc.pos = it.Pos()
+				sc.length = len(val)
+				ordinal++
+				s.shortcodes = append(s.shortcodes, sc)
+				rn.AddShortcode(sc)
+			} else if emoji := p.s.emoji(val); emoji != nil {
 				rn.AddReplacement(emoji, it)
 			} else {
 				rn.AddBytes(it)
@@ -0,0 +1,144 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gohugoio/hugo/hugofs/files"
+	"github.com/gohugoio/hugo/identity"
+	"github.com/gohugoio/hugo/markup/citations"
+	"github.com/gohugoio/hugo/resources/resource"
+	"github.com/spf13/afero"
+	"github.com/spf13/cast"
+)
+
+// bibliographyCache caches the parsed bibliography files, shared by all sites.
+type bibliographyCache struct {
+	mu sync.Mutex
+	// Keyed by the filename in the assets filesystem or by the page resource.
+	files map[any]bibliographyFile
+}
+
+type bibliographyFile struct {
+	modTime time.Time
+	size    int64
+	entries []*citations.Entry
+}
+
+func (c *bibliographyCache) getOrCreate(key any, valid func(f bibliographyFile) bool, create func() (bibliographyFile, error)) (bibliographyFile, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if f, found := c.files[key]; found && valid(f) {
+		return f, nil
+	}
+	f, err := create()
+	if err != nil {
+		return f, err
+	}
+	if c.files == nil {
+		c.files = make(map[any]bibliographyFile)
+	}
+	c.files[key] = f
+	return f, nil
+}
+
+// getAsset gets the entries in the bibliography file with the given name in fs.
+func (c *bibliographyCache) getAsset(fs afero.Fs, name string) ([]*citations.Entry, error) {
+	fi, err := fs.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := c.getOrCreate(name, func(f bibliographyFile) bool {
+		return f.modTime.Equal(fi.ModTime()) && f.size == fi.Size()
+	}, func() (bibliographyFile, error) {
+		r, err := fs.Open(name)
+		if err != nil {
+			return bibliographyFile{}, err
+		}
+		defer r.Close()
+		entries, err := citations.Decode(name, r)
+		return bibliographyFile{modTime: fi.ModTime(), size: fi.Size(), entries: entries}, err
+	})
+	return f.entries, err
+}
+
+// getResource gets the entries in the bibliography page resource r.
+// Page resources are recreated when changed, so r is used as the cache key.
+func (c *bibliographyCache) getResource(r resource.Resource) ([]*citations.Entry, error) {
+	rr, ok := r.(resource.ReadSeekCloserResource)
+	if !ok {
+		return nil, fmt.Errorf("resource %q can not be read", r.Name())
+	}
+	f, err := c.getOrCreate(r, func(bibliographyFile) bool {
+		return true
+	}, func() (bibliographyFile, error) {
+		rc, err := rr.ReadSeekCloser()
+		if err != nil {
+			return bibliographyFile{}, err
+		}
+		defer rc.Close()
+		entries, err := citations.Decode(r.Name(), rc)
+		return bibliographyFile{entries: entries}, err
+	})
+	return f.entries, err
+}
+
+// bibliography returns the bibliography used to render the citations in p:
+// the files in markup.goldmark.extensions.citations.bibliography and in the
+// bibliography front matter parameter, the latter either page resources or
+// files in the assets directory. The entries in later files take precedence.
+// It also returns the identities of the asset files to track changes.
+func (p *pageState) bibliography() (*citations.Bibliography, []identity.Provider, error) {
+	var (
+		cache = &p.s.h.bibliographies
+		fs    = p.s.BaseFs.Assets.Fs
+		bib   = citations.NewBibliography()
+		ids   []identity.Provider
+	)
+
+	addAsset := func(name string) error {
+		entries, err := cache.getAsset(fs, name)
+		if err != nil {
+			return fmt.Errorf("failed to load bibliography %q: %w", name, err)
+		}
+		bib.Add(entries...)
+		ids = append(ids, identity.NewPathIdentity(files.ComponentFolderAssets, name))
+		return nil
+	}
+
+	for _, name := range p.s.ContentSpec.Converters.GetMarkupConfig().Goldmark.Extensions.Citations.Bibliography {
+		if err := addAsset(name); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, name := range cast.ToStringSlice(p.Params()["bibliography"]) {
+		if r := p.Resources().GetMatch(name); r != nil {
+			entries, err := cache.getResource(r)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to load bibliography %q: %w", name, err)
+			}
+			bib.Add(entries...)
+			continue
+		}
+		if err := addAsset(name); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return bib, ids, nil
+}
@@ -13,7 +13,11 @@
 
 package hugolib
 
-import "testing"
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
 
 // #10794
 func TestFragmentsAndToCCrossSiteAccess(t *testing.T) {
@@ -110,3 +114,50 @@ Fragments: {{ .Fragments.Identifiers }}|
 	b.AssertFileContent("public/p2/index.html", "Fragments: [heading-p2-1 heading-p2-2]|")
 
 }
+
+func TestPlainFastAndHeadings(t *testing.T) {
+	files := `
+-- hugo.toml --
+baseURL = "https://example.com"
+disableKinds = ["taxonomy", "term", "home"]
+-- content/p1.md --
+---
+title: "P1"
+---
+
+## Heading 1
+
+Some **bold** text.{{< sc >}}
+
+### Heading 1.1
+
+## Heading 2
+-- content/p2.html --
+---
+title: "P2"
+---
+<p>HTML content.</p>
+-- layouts/shortcodes/sc.html --
+<span>shortcode</span>
+-- layouts/_default/single.html --
+PlainFast: {{ .PlainFast }}|
+Headings: {{ range .Headings }}{{ .Level }}:{{ range .Headings }}{{ .ID }}:{{ .Level }}:{{ .Title }}{{ range .Headings }}[{{ .ID }}:{{ .Level }}]{{ end }}|{{ end }}{{ end }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			TxtarString: files,
+			T:           t,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		"PlainFast: Heading 1\nSome bold text.\nHeading 1.1\nHeading 2|",
+		// No level 1 heading, so the top level heading is empty.
+		"Headings: 0:heading-1:2:Heading 1[heading-11:3]|heading-2:2:Heading 2|",
+	)
+	// Extracted from the parsed Markdown, so without the shortcode output.
+	b.Assert(b.FileContent("public/p1/index.html"), qt.Not(qt.Contains), "shortcode")
+	// Falls back to Plain for non-Goldmark content.
+	b.AssertFileContent("public/p2/index.html", "PlainFast: HTML content.\n|")
+}
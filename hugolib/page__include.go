@@ -0,0 +1,157 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"html/template"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cast"
+)
+
+// includeGraph keeps track of which pages include which in their content,
+// used to detect circular includes. It is reset on every build.
+type includeGraph struct {
+	mu    sync.Mutex
+	edges map[*pageState][]*pageState
+}
+
+// add records that from includes to and returns an error if to
+// (directly or indirectly) includes from.
+func (g *includeGraph) add(from, to *pageState) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if chain := g.path(to, from, make(map[*pageState]bool)); chain != nil {
+		paths := make([]string, 0, len(chain)+1)
+		paths = append(paths, from.Path())
+		for _, p := range chain {
+			paths = append(paths, p.Path())
+		}
+		return fmt.Errorf("circular include: %s", strings.Join(paths, " -> "))
+	}
+
+	for _, p := range g.edges[from] {
+		if p == to {
+			return nil
+		}
+	}
+	if g.edges == nil {
+		g.edges = make(map[*pageState][]*pageState)
+	}
+	g.edges[from] = append(g.edges[from], to)
+
+	return nil
+}
+
+// path returns the include chain from from to to, or nil if there is none.
+func (g *includeGraph) path(from, to *pageState, seen map[*pageState]bool) []*pageState {
+	if from == to {
+		return []*pageState{to}
+	}
+	if seen[from] {
+		return nil
+	}
+	seen[from] = true
+	for _, p := range g.edges[from] {
+		if chain := g.path(p, to, seen); chain != nil {
+			return append([]*pageState{from}, chain...)
+		}
+	}
+	return nil
+}
+
+func (g *includeGraph) reset() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.edges = nil
+}
+
+var includeHeadingRe = regexp.MustCompile(`<h([1-6])[\s>]`)
+
+// include returns the rendered content of the page with the given reference,
+// optionally limited to the section below the heading given as a fragment,
+// e.g. "/docs/install#requirements".
+func (p *pageState) include(ctx context.Context, ref string) (template.HTML, error) {
+	path, fragment, _ := strings.Cut(ref, "#")
+	if path == "" {
+		return "", fmt.Errorf("include: missing page in %q", ref)
+	}
+
+	v, err := p.s.getPageNew(p, path)
+	if err != nil {
+		return "", fmt.Errorf("include: %w", err)
+	}
+	if v == nil {
+		return "", fmt.Errorf("include: page %q not found", path)
+	}
+	target, ok := v.(*pageState)
+	if !ok {
+		return "", fmt.Errorf("include: page %q not found", path)
+	}
+
+	if err := p.s.h.includes.add(p, target); err != nil {
+		return "", err
+	}
+
+	// Re-render this page when the included page changes.
+	p.addDependency(target)
+
+	c, err := target.Content(ctx)
+	if err != nil {
+		return "", err
+	}
+	content := cast.ToString(c)
+
+	if fragment == "" {
+		return template.HTML(content), nil
+	}
+
+	section, found := contentSection(content, fragment)
+	if !found {
+		return "", fmt.Errorf("include: heading %q not found in %q", fragment, target.Path())
+	}
+
+	return template.HTML(section), nil
+}
+
+// contentSection returns the part of content starting with the heading with
+// the given id up to the next heading of the same or a higher level.
+func contentSection(content, id string) (string, bool) {
+	re, err := regexp.Compile(`<h([1-6])[^>]*\sid="?` + regexp.QuoteMeta(html.EscapeString(id)) + `"?[\s>]`)
+	if err != nil {
+		return "", false
+	}
+	loc := re.FindStringSubmatchIndex(content)
+	if loc == nil {
+		return "", false
+	}
+	start := loc[0]
+	level, _ := strconv.Atoi(content[loc[2]:loc[3]])
+
+	rest := content[loc[1]:]
+	for _, m := range includeHeadingRe.FindAllStringSubmatchIndex(rest, -1) {
+		if l, _ := strconv.Atoi(rest[m[2]:m[3]]); l <= level {
+			return strings.TrimSpace(content[start:loc[1]+m[0]]) + "\n", true
+		}
+	}
+
+	return content[start:], true
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+const includeFiles = `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "home", "rss", "sitemap"]
+-- layouts/_default/single.html --
+{{ .Content }}
+-- layouts/_default/list.html --
+{{ .Content }}
+-- content/snippets/install.md --
+---
+title: "Install"
+---
+
+## Requirements
+
+Go 1.20.
+
+### Optional
+
+Dart Sass.
+
+## Steps
+
+Run **go install**.
+-- content/docs/p1.md --
+---
+title: "p1"
+---
+
+Before.
+
+{{< include "/snippets/install" >}}
+
+After.
+-- content/docs/p2.md --
+---
+title: "p2"
+---
+
+{{< include "../snippets/install#requirements" >}}
+`
+
+func TestInclude(t *testing.T) {
+	t.Parallel()
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: includeFiles,
+		},
+	).Build()
+
+	b.AssertFileContent("public/docs/p1/index.html",
+		"<p>Before.</p>\n<h2 id=\"requirements\">Requirements</h2>",
+		"<p>Run <strong>go install</strong>.</p>\n\n<p>After.</p>",
+	)
+
+	b.AssertFileContent("public/docs/p2/index.html",
+		"<h2 id=\"requirements\">Requirements</h2>\n<p>Go 1.20.</p>\n<h3 id=\"optional\">Optional</h3>\n<p>Dart Sass.</p>",
+	)
+
+	content := b.FileContent("public/docs/p2/index.html")
+	b.Assert(content, qt.Not(qt.Contains), "Steps")
+}
+
+func TestIncludeErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name    string
+		replace func(s string) string
+		expect  string
+	}{
+		{
+			"page not found",
+			func(s string) string {
+				return strings.Replace(s, `"/snippets/install"`, `"/snippets/foo"`, 1)
+			},
+			`include: page "/snippets/foo" not found`,
+		},
+		{
+			"heading not found",
+			func(s string) string {
+				return strings.Replace(s, `#requirements`, `#foo`, 1)
+			},
+			`include: heading "foo" not found in "snippets/install.md"`,
+		},
+		{
+			"self",
+			func(s string) string {
+				return strings.Replace(s, `"/snippets/install"`, `"/docs/p1"`, 1)
+			},
+			`circular include: docs/p1.md -> docs/p1.md`,
+		},
+		{
+			"circular",
+			func(s string) string {
+				s = strings.Replace(s, `{{< include "../snippets/install#requirements" >}}`, "", 1)
+				return strings.Replace(s, "Dart Sass.", `{{< include "/docs/p1" >}}`, 1)
+			},
+			`circular include: `,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := NewIntegrationTestBuilder(
+				IntegrationTestConfig{
+					T:           t,
+					TxtarString: test.replace(includeFiles),
+				},
+			).BuildE()
+
+			b.Assert(err, qt.IsNotNil)
+			b.Assert(err.Error(), qt.Contains, test.expect)
+		})
+	}
+}
+
+func TestIncludeRebuild(t *testing.T) {
+	t.Parallel()
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: includeFiles,
+			Running:     true,
+		},
+	).Build()
+
+	b.AssertFileContent("public/docs/p1/index.html", "<p>Go 1.20.</p>")
+	b.AssertFileContent("public/docs/p2/index.html", "<p>Go 1.20.</p>")
+
+	b.EditFileReplace("content/snippets/install.md", func(s string) string {
+		return strings.Replace(s, "Go 1.20.", "Go 1.21.", 1)
+	}).Build()
+
+	b.AssertFileContent("public/docs/p1/index.html", "<p>Go 1.21.</p>")
+	b.AssertFileContent("public/docs/p2/index.html", "<p>Go 1.21.</p>")
+}
@@ -64,6 +64,7 @@ type pageMeta struct {
 	standalone bool
 
 	draft       bool // Only published when running with -D flag
+	devOnly     bool // Only published in the development environment
 	buildConfig pagemeta.BuildConfig
 
 	bundleType files.ContentClass
@@ -331,11 +332,56 @@ func (pm *pageMeta) mergeBucketCascades(b1, b2 *pagesMapBucket) {
 	}
 }
 
+// termData returns the term definition in data/taxonomies/<taxonomy>/<term>, if any.
+func (pm *pageMeta) termData() (map[string]any, error) {
+	if len(pm.sections) != 2 {
+		return nil, nil
+	}
+	taxonomy, term := pm.sections[0], pm.sections[1]
+
+	v := pm.s.lookupTermData(taxonomy, term)
+	if v == nil {
+		return nil, nil
+	}
+
+	m, err := maps.ToStringMapE(v)
+	if err != nil {
+		return nil, fmt.Errorf("data/taxonomies/%s/%s: %w", taxonomy, term, err)
+	}
+
+	return m, nil
+}
+
+// checkTermData returns an error if the term in sections has no definition
+// in data/taxonomies/<taxonomy>/<term>.
+func (s *Site) checkTermData(sections []string) error {
+	if len(sections) != 2 {
+		return nil
+	}
+	taxonomy, term := sections[0], sections[1]
+	if s.lookupTermData(taxonomy, term) == nil {
+		return fmt.Errorf("term %q in taxonomy %q has no definition in data/taxonomies/%s/%s", term, taxonomy, taxonomy, term)
+	}
+	return nil
+}
+
+func (s *Site) lookupTermData(taxonomy, term string) any {
+	var v any = s.h.Data()
+	for _, key := range []string{"taxonomies", taxonomy, term} {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = m[key]
+	}
+	return v
+}
+
 func (pm *pageMeta) setMetadata(parentBucket *pagesMapBucket, p *pageState, frontmatter map[string]any) error {
 	pm.params = make(maps.Params)
 
-	if frontmatter == nil && (parentBucket == nil || parentBucket.cascade == nil) {
-		return nil
+	if frontmatter == nil && (parentBucket == nil || parentBucket.cascade == nil) && pm.kind != page.KindTerm {
+		return pm.applyParamsSchema(p)
 	}
 
 	if frontmatter != nil {
@@ -367,6 +413,20 @@ func (pm *pageMeta) setMetadata(parentBucket *pagesMapBucket, p *pageState, fron
 		cascade = parentBucket.cascade
 	}
 
+	// The term data is more specific than any cascade, so apply it first.
+	if pm.kind == page.KindTerm {
+		termData, err := pm.termData()
+		if err != nil {
+			return err
+		}
+		for k, v := range termData {
+			k = strings.ToLower(k)
+			if _, found := frontmatter[k]; !found {
+				frontmatter[k] = v
+			}
+		}
+	}
+
 	for m, v := range cascade {
 		if !m.Matches(p) {
 			continue
@@ -503,6 +563,9 @@ func (pm *pageMeta) setMetadata(parentBucket *pagesMapBucket, p *pageState, fron
 		case "draft":
 			draft = new(bool)
 			*draft = cast.ToBool(v)
+		case "devonly":
+			pm.devOnly = cast.ToBool(v)
+			pm.params[loki] = pm.devOnly
 		case "layout":
 			pm.layout = cast.ToString(v)
 			pm.params[loki] = pm.layout
@@ -531,6 +594,12 @@ func (pm *pageMeta) setMetadata(parentBucket *pagesMapBucket, p *pageState, fron
 		case "iscjklanguage":
 			isCJKLanguage = new(bool)
 			*isCJKLanguage = cast.ToBool(v)
+		case "recurrence":
+			rule := cast.ToString(v)
+			if _, err := pagemeta.ParseRecurrence(rule); err != nil {
+				return err
+			}
+			pm.params[loki] = rule
 		case "translationkey":
 			pm.translationKey = cast.ToString(v)
 			pm.params[loki] = pm.translationKey
@@ -624,7 +693,13 @@ func (pm *pageMeta) setMetadata(parentBucket *pagesMapBucket, p *pageState, fron
 
 	pm.params["iscjklanguage"] = p.m.isCJKLanguage
 
-	return nil
+	return pm.applyParamsSchema(p)
+}
+
+// applyParamsSchema coerces the params to the types declared in the paramsSchema config
+// and sets their default values. Required params are only checked for content files.
+func (pm *pageMeta) applyParamsSchema(p *pageState) error {
+	return pm.s.conf.ParamsSchema.Apply(pm.params, !p.File().IsZero())
 }
 
 func (p *pageMeta) noListAlways() bool {
@@ -750,6 +825,7 @@ func (p *pageMeta) newContentConverter(ps *pageState, markup string) (converter.
 			DocumentID:   id,
 			DocumentName: path,
 			Filename:     filename,
+			Bibliography: ps.bibliography,
 		},
 	)
 	if err != nil {
@@ -764,7 +840,39 @@ func (m *pageMeta) outputFormats() output.Formats {
 	if len(m.configuredOutputFormats) > 0 {
 		return m.configuredOutputFormats
 	}
-	return m.s.conf.C.KindOutputFormats[m.Kind()]
+	formats := m.s.conf.C.KindOutputFormats[m.Kind()]
+	for _, rule := range m.s.conf.C.OutputRules {
+		if rule.Target.MatchesValues(m.Kind(), m.Lang(), m.Pathc(), func() string { return m.s.Hugo().Environment }) {
+			formats = rule.Apply(formats)
+		}
+	}
+	if m.Kind() != page.KindSection {
+		return formats
+	}
+
+	// The Atom and JSON Feed feeds can be limited to some sections.
+	services := m.s.conf.Services
+	var filtered output.Formats
+	for i, f := range formats {
+		var render bool
+		switch f.Name {
+		case output.AtomFormat.Name:
+			render = services.Atom.RenderSection(m.Section())
+		case output.JSONFeedFormat.Name:
+			render = services.JSONFeed.RenderSection(m.Section())
+		default:
+			render = true
+		}
+		if !render && filtered == nil {
+			filtered = append(output.Formats{}, formats[:i]...)
+		} else if filtered != nil && render {
+			filtered = append(filtered, f)
+		}
+	}
+	if filtered == nil {
+		return formats
+	}
+	return filtered
 }
 
 func (p *pageMeta) Slug() string {
@@ -69,6 +69,39 @@ func (p *pagePaginator) Paginate(seq any, options ...any) (*page.Pager, error) {
 	return p.current, nil
 }
 
+func (p *pagePaginator) PaginateGroups(groups any, options ...any) (*page.Pager, error) {
+	var initErr error
+	p.init.Do(func() {
+		var groupPagerSizes any
+		if len(options) > 1 {
+			groupPagerSizes = options[1]
+			options = options[:1]
+		}
+
+		pagerSize, err := page.ResolvePagerSize(p.source.s.Conf, options...)
+		if err != nil {
+			initErr = err
+			return
+		}
+
+		pd := p.source.targetPathDescriptor
+		pd.Type = p.source.outputFormat()
+		paginator, err := page.PaginateGroups(pd, groups, pagerSize, groupPagerSizes)
+		if err != nil {
+			initErr = err
+			return
+		}
+
+		p.current = paginator.Pagers()[0]
+	})
+
+	if initErr != nil {
+		return nil, initErr
+	}
+
+	return p.current, nil
+}
+
 func (p *pagePaginator) Paginator(options ...any) (*page.Pager, error) {
 	defer herrors.Recover()
 
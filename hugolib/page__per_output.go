@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"html/template"
+	"regexp"
 	"strings"
 	"sync"
 	"unicode/utf8"
@@ -32,6 +33,9 @@ import (
 	"github.com/spf13/cast"
 
 	"github.com/gohugoio/hugo/markup/converter/hooks"
+	"github.com/gohugoio/hugo/markup/goldmark/blockquotes"
+	"github.com/gohugoio/hugo/markup/goldmark/goldmark_config"
+	"github.com/gohugoio/hugo/markup/goldmark/tables"
 	"github.com/gohugoio/hugo/markup/highlight/chromalexers"
 	"github.com/gohugoio/hugo/markup/tableofcontents"
 
@@ -136,6 +140,9 @@ func newPageContentOutput(p *pageState, po *pageOutput) (*pageContentOutput, err
 				// Store away the parse result for later use.
 				createAndSetToC(parseResult)
 				cp.astDoc = parseResult.Doc()
+				if ptp, ok := parseResult.(converter.PlainTextProvider); ok {
+					cp.plainFastProvider = ptp
+				}
 
 				return nil
 			}
@@ -316,10 +323,15 @@ type pageContentOutput struct {
 	// For Goldmark we split Parse and Render.
 	astDoc any
 
+	// Set if the converter extracts the plain text from astDoc.
+	plainFastProvider converter.PlainTextProvider
+	plainFastInit     sync.Once
+
 	truncated bool
 
 	plainWords     []string
 	plain          string
+	plainFast      string
 	fuzzyWordCount int
 	wordCount      int
 	readingTime    int
@@ -342,46 +354,68 @@ func (p *pageContentOutput) Reset() {
 	p.renderHooks = &renderHooks{}
 }
 
+// initContent runs init, which initializes the content of the page, and
+// records that the content is used by the page rendered in ctx.
+func (p *pageContentOutput) initContent(ctx context.Context, init *lazy.Init) {
+	p.p.trackContentRef(ctx)
+	p.p.s.initInit(ctx, init, p.p)
+}
+
 func (p *pageContentOutput) Fragments(ctx context.Context) *tableofcontents.Fragments {
-	p.p.s.initInit(ctx, p.initToC, p.p)
+	p.initContent(ctx, p.initToC)
 	if p.tableOfContents == nil {
 		return tableofcontents.Empty
 	}
 	return p.tableOfContents
 }
 
+func (p *pageContentOutput) Headings(ctx context.Context) tableofcontents.Headings {
+	return p.Fragments(ctx).Headings
+}
+
 func (p *pageContentOutput) TableOfContents(ctx context.Context) template.HTML {
-	p.p.s.initInit(ctx, p.initToC, p.p)
+	p.initContent(ctx, p.initToC)
 	return p.tableOfContentsHTML
 }
 
 func (p *pageContentOutput) Content(ctx context.Context) (any, error) {
-	p.p.s.initInit(ctx, p.initMain, p.p)
+	p.initContent(ctx, p.initMain)
 	return p.content, nil
 }
 
 func (p *pageContentOutput) FuzzyWordCount(ctx context.Context) int {
-	p.p.s.initInit(ctx, p.initPlain, p.p)
+	p.initContent(ctx, p.initPlain)
 	return p.fuzzyWordCount
 }
 
 func (p *pageContentOutput) Len(ctx context.Context) int {
-	p.p.s.initInit(ctx, p.initMain, p.p)
+	p.initContent(ctx, p.initMain)
 	return len(p.content)
 }
 
 func (p *pageContentOutput) Plain(ctx context.Context) string {
-	p.p.s.initInit(ctx, p.initPlain, p.p)
+	p.initContent(ctx, p.initPlain)
 	return p.plain
 }
 
 func (p *pageContentOutput) PlainWords(ctx context.Context) []string {
-	p.p.s.initInit(ctx, p.initPlain, p.p)
+	p.initContent(ctx, p.initPlain)
 	return p.plainWords
 }
 
+func (p *pageContentOutput) PlainFast(ctx context.Context) string {
+	p.initContent(ctx, p.initToC)
+	if p.plainFastProvider == nil {
+		return p.Plain(ctx)
+	}
+	p.plainFastInit.Do(func() {
+		p.plainFast = strings.TrimSpace(shortcodePlaceholderRe.ReplaceAllString(string(p.plainFastProvider.PlainText()), ""))
+	})
+	return p.plainFast
+}
+
 func (p *pageContentOutput) ReadingTime(ctx context.Context) int {
-	p.p.s.initInit(ctx, p.initPlain, p.p)
+	p.initContent(ctx, p.initPlain)
 	return p.readingTime
 }
 
@@ -402,7 +436,7 @@ func (p *pageContentOutput) Truncated(ctx context.Context) bool {
 }
 
 func (p *pageContentOutput) WordCount(ctx context.Context) int {
-	p.p.s.initInit(ctx, p.initPlain, p.p)
+	p.initContent(ctx, p.initPlain)
 	return p.wordCount
 }
 
@@ -596,22 +630,49 @@ func (p *pageContentOutput) initRenderHooks() error {
 		var renderCacheMu sync.Mutex
 
 		resolvePosition := func(ctx any) text.Position {
-			var offset int
+			input := p.p.source.parsed.Input()
+
+			// Block elements are reported on the line of the opening delimiter.
+			// This is in line with how we report on shortcodes.
+			delimiterPos := func(inner string) text.Position {
+				pos := p.p.posFromInput(input, bytes.Index(input, []byte(inner)))
+				if pos.LineNumber > 0 {
+					pos.LineNumber = pos.LineNumber - 1
+				}
+				return pos
+			}
 
 			switch v := ctx.(type) {
 			case hooks.CodeblockContext:
-				offset = bytes.Index(p.p.source.parsed.Input(), []byte(v.Inner()))
-			}
-
-			pos := p.p.posFromInput(p.p.source.parsed.Input(), offset)
-
-			if pos.LineNumber > 0 {
-				// Move up to the code fence delimiter.
-				// This is in line with how we report on shortcodes.
-				pos.LineNumber = pos.LineNumber - 1
+				return delimiterPos(v.Inner())
+			case hooks.BlockquoteContext:
+				return p.p.posFromInput(input, blockquoteOffset(input, v.Ordinal()))
+			case hooks.PassthroughContext:
+				if v.Type() == "block" {
+					return delimiterPos(v.Inner())
+				}
+				return p.p.posFromInput(input, bytes.Index(input, []byte(v.Inner())))
+			case hooks.TableContext:
+				return p.p.posFromInput(input, tableOffset(input, v.Ordinal()))
+			case hooks.FootnoteRefContext:
+				return p.p.posFromInput(input, footnoteRefOffset(input, v.Label(), v.RefIndex()))
+			case hooks.CitationContext:
+				var offset int
+				if citations := v.Citations(); len(citations) > 0 {
+					offset = bytes.Index(input, []byte("@"+citations[0].Key))
+				}
+				return p.p.posFromInput(input, offset)
+			case hooks.LinkContext:
+				// This is approximate, the first occurrence of the destination,
+				// or, for autolinks, the link text, in the source.
+				offset := bytes.Index(input, []byte(v.Destination()))
+				if offset == -1 && v.PlainText() != "" {
+					offset = bytes.Index(input, []byte(v.PlainText()))
+				}
+				return p.p.posFromInput(input, offset)
 			}
 
-			return pos
+			return p.p.posFromInput(input, 0)
 		}
 
 		p.renderHooks.getRenderer = func(tp hooks.RendererType, id any) any {
@@ -635,6 +696,28 @@ func (p *pageContentOutput) initRenderHooks() error {
 				layoutDescriptor.Kind = "render-image"
 			case hooks.HeadingRendererType:
 				layoutDescriptor.Kind = "render-heading"
+			case hooks.FAQRendererType:
+				layoutDescriptor.Kind = "render-faq"
+			case hooks.BlockquoteRendererType:
+				layoutDescriptor.Kind = "render-blockquote"
+				if id != nil {
+					layoutDescriptor.KindVariants = id.(string)
+				}
+			case hooks.PassthroughRendererType:
+				layoutDescriptor.Kind = "render-passthrough"
+				if id != nil {
+					layoutDescriptor.KindVariants = id.(string)
+				}
+			case hooks.TableRendererType:
+				layoutDescriptor.Kind = "render-table"
+			case hooks.FootnoteRefRendererType:
+				layoutDescriptor.Kind = "render-footnote-ref"
+			case hooks.FootnotesRendererType:
+				layoutDescriptor.Kind = "render-footnotes"
+			case hooks.CitationRendererType:
+				layoutDescriptor.Kind = "render-citation"
+			case hooks.BibliographyRendererType:
+				layoutDescriptor.Kind = "render-bibliography"
 			case hooks.CodeBlockRendererType:
 				layoutDescriptor.Kind = "render-codeblock"
 				if id != nil {
@@ -679,10 +762,40 @@ func (p *pageContentOutput) initRenderHooks() error {
 					}
 				}
 			}
+			if !found1 && tp == hooks.ImageRendererType && p.p.s.conf.Imaging.Config.Imaging.Picture != "" {
+				// No user provided template for images, use the built-in picture template.
+				templ, found1 = p.p.s.Tmpl().Lookup("_internal/_markup/render-image-picture.html")
+			}
+			if !found1 && tp == hooks.BlockquoteRendererType && id == blockquotes.BlockquoteTypeAlert {
+				// No user provided template for alerts, use the built-in one.
+				templ, found1 = p.p.s.Tmpl().Lookup("_internal/_markup/render-blockquote-alert.html")
+			}
+			if !found1 && tp == hooks.PassthroughRendererType && p.p.renderMath() {
+				// No user provided template for passthrough elements, render them as math.
+				templ, found1 = p.p.s.Tmpl().Lookup("_internal/_markup/render-passthrough-math.html")
+			}
+			if !found1 && tp == hooks.CodeBlockRendererType && id != nil {
+				if _, ok := p.p.s.ContentSpec.Converters.GetMarkupConfig().Diagrams.Engine(id.(string)); ok {
+					// No user provided template for a diagram language, render it to SVG with the configured engine.
+					templ, found1 = p.p.s.Tmpl().Lookup("_internal/_markup/render-codeblock-diagram.html")
+				}
+			}
+			if !found1 && tp == hooks.TableRendererType {
+				if opts := p.p.tableOptions(); !opts.IsZero() {
+					// No user provided template for tables, render them with the built-in table options.
+					r := tables.NewRenderer(opts)
+					renderCache[key] = r
+					return r
+				}
+			}
 			if !found1 {
 				if tp == hooks.CodeBlockRendererType {
 					// No user provided tempplate for code blocks, so we use the native Go code version -- which is also faster.
-					r := p.p.s.ContentSpec.Converters.GetHighlighter()
+					r := p.p.s.ContentSpec.Converters.GetHighlighterForOutputFormat(p.f.Name)
+					if p.p.reusePageOutputContent() && len(p.p.s.ContentSpec.Converters.GetMarkupConfig().Highlight.OutputFormats) > 0 {
+						// Some output formats may be highlighted with other options.
+						p.p.pageOutputTemplateVariationsState.Store(2)
+					}
 					renderCache[key] = r
 					return r
 				}
@@ -703,6 +816,106 @@ func (p *pageContentOutput) initRenderHooks() error {
 	return nil
 }
 
+// tableOptions returns the built-in table options for p, which can be set per
+// page or section with the tableOptions front matter parameter.
+func (p *pageState) tableOptions() goldmark_config.TableOptions {
+	opts := p.s.ContentSpec.Converters.GetMarkupConfig().Goldmark.Extensions.TableOptions
+	if v, found := p.Params()["tableoptions"]; found {
+		if err := mapstructure.WeakDecode(v, &opts); err != nil {
+			p.s.Log.Errorf("Failed to decode tableOptions for page %q: %s", p.pathOrTitle(), err)
+		}
+	}
+	return opts
+}
+
+// renderMath reports whether to render passthrough elements as math when
+// there's no render-passthrough template, which can be set per page or
+// section with the renderMath front matter parameter.
+func (p *pageState) renderMath() bool {
+	if v, found := p.Params()["rendermath"]; found {
+		return cast.ToBool(v)
+	}
+	return p.s.ContentSpec.Converters.GetMarkupConfig().Goldmark.Extensions.Passthrough.RenderMath
+}
+
+// blockquoteOffset returns the approximate offset of the start of the
+// blockquote with the given ordinal in input, -1 if not found.
+func blockquoteOffset(input []byte, ordinal int) int {
+	var (
+		offset  int
+		inQuote bool
+		count   int
+	)
+	for _, line := range bytes.SplitAfter(input, []byte("\n")) {
+		isQuote := bytes.HasPrefix(bytes.TrimLeft(line, " \t"), []byte(">"))
+		if isQuote && !inQuote {
+			if count == ordinal {
+				return offset + bytes.IndexByte(line, '>')
+			}
+			count++
+		}
+		inQuote = isQuote
+		offset += len(line)
+	}
+	return -1
+}
+
+// tableOffset returns the offset of the header row of the table with the
+// given zero-based ordinal, found by its delimiter row, e.g. "|---|:-:|".
+func tableOffset(input []byte, ordinal int) int {
+	var (
+		offset, prev int
+		count        int
+		inTable      bool
+	)
+	for _, line := range bytes.SplitAfter(input, []byte("\n")) {
+		// A thematic break, e.g. "---", has no pipes.
+		isDelimiter := bytes.IndexByte(line, '|') != -1 && tableDelimiterRowRe.Match(line)
+		if isDelimiter && !inTable {
+			if count == ordinal {
+				return prev
+			}
+			count++
+		}
+		if isDelimiter {
+			inTable = true
+		} else if len(bytes.TrimSpace(line)) == 0 {
+			inTable = false
+		}
+		prev = offset
+		offset += len(line)
+	}
+	return -1
+}
+
+var tableDelimiterRowRe = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?\s*$`)
+
+// footnoteRefOffset returns the offset of the reference with the given
+// zero-based index to the footnote with the given label, skipping the
+// footnote definition, e.g. "[^1]: Text.".
+func footnoteRefOffset(input []byte, label string, refIndex int) int {
+	var (
+		ref    = []byte("[^" + label + "]")
+		offset int
+		count  int
+	)
+	for {
+		i := bytes.Index(input[offset:], ref)
+		if i == -1 {
+			return -1
+		}
+		offset += i
+		end := offset + len(ref)
+		if end >= len(input) || input[end] != ':' {
+			if count == refIndex {
+				return offset
+			}
+			count++
+		}
+		offset = end
+	}
+}
+
 func (p *pageContentOutput) setAutoSummary() error {
 	if p.p.source.hasSummaryDivider || p.p.m.summary != "" {
 		return nil
@@ -711,11 +924,7 @@ func (p *pageContentOutput) setAutoSummary() error {
 	var summary string
 	var truncated bool
 
-	if p.p.m.isCJKLanguage {
-		summary, truncated = p.p.s.ContentSpec.TruncateWordsByRune(p.plainWords)
-	} else {
-		summary, truncated = p.p.s.ContentSpec.TruncateWordsToWholeSentence(p.plain)
-	}
+	summary, truncated = p.p.s.ContentSpec.TruncateSummary(p.plain, p.plainWords, p.p.m.isCJKLanguage)
 	p.summary = template.HTML(summary)
 
 	p.truncated = truncated
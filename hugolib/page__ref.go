@@ -65,6 +65,10 @@ func (p pageRef) decodeRefArgs(args map[string]any) (refArgs, *Site, error) {
 		}
 
 		if !found {
+			if p.p.s.conf.IsLangDisabled(ra.Lang) {
+				// Not built, see disabledLangLink.
+				return ra, nil, nil
+			}
 			p.p.s.siteRefLinker.logNotFound(ra.Path, fmt.Sprintf("no site found with lang %q", ra.Lang), nil, text.Position{})
 			return ra, nil, nil
 		}
@@ -80,6 +84,9 @@ func (p pageRef) ref(argsm map[string]any, source any) (string, error) {
 	}
 
 	if s == nil {
+		if p.p.s.conf.IsLangDisabled(args.Lang) {
+			return p.p.s.disabledLangLink(args.Lang, false), nil
+		}
 		return p.p.s.siteRefLinker.notFoundURL, nil
 	}
 
@@ -97,6 +104,9 @@ func (p pageRef) relRef(argsm map[string]any, source any) (string, error) {
 	}
 
 	if s == nil {
+		if p.p.s.conf.IsLangDisabled(args.Lang) {
+			return p.p.s.disabledLangLink(args.Lang, true), nil
+		}
 		return p.p.s.siteRefLinker.notFoundURL, nil
 	}
 
@@ -112,3 +122,20 @@ type refArgs struct {
 	Lang         string
 	OutputFormat string
 }
+
+// disabledLangLink returns the link to the home page of the disabled language
+// lang, the fallback for references to pages in a language not built,
+// e.g. with hugo server --lang.
+func (s *Site) disabledLangLink(lang string, relative bool) string {
+	if s.h.Conf.IsMultihost() {
+		return s.h.Configs.LanguageConfigMap[lang].C.BaseURL.String()
+	}
+	var prefix string
+	if lang != s.conf.DefaultContentLanguage || s.conf.DefaultContentLanguageInSubdir {
+		prefix = lang
+	}
+	if relative {
+		return s.PathSpec.RelURL(prefix+"/", false)
+	}
+	return s.PathSpec.AbsURL(prefix+"/", false)
+}
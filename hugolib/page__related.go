@@ -0,0 +1,108 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/hexec"
+	"github.com/gohugoio/hugo/helpers"
+	"github.com/gohugoio/hugo/related"
+	"github.com/spf13/cast"
+)
+
+var _ related.VectorProvider = (*pageState)(nil)
+
+// RelatedText is for internal use by the related content feature.
+func (p *pageState) RelatedText(ctx context.Context) string {
+	return p.Title() + "\n" + p.PlainFast(ctx)
+}
+
+// RelatedVector is for internal use by the related content feature.
+func (p *pageState) RelatedVector(ctx context.Context, cfg related.IndexConfig) ([]float64, error) {
+	switch cfg.Provider {
+	case related.ProviderImport:
+		v, err := p.Param(cfg.Name)
+		if err != nil || v == nil {
+			return nil, err
+		}
+		vector, err := cast.ToSliceE(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: related index %q: %w", p.pathOrTitle(), cfg.Name, err)
+		}
+		floats := make([]float64, len(vector))
+		for i, f := range vector {
+			floats[i], err = cast.ToFloat64E(f)
+			if err != nil {
+				return nil, fmt.Errorf("%s: related index %q: %w", p.pathOrTitle(), cfg.Name, err)
+			}
+		}
+		return floats, nil
+	case related.ProviderExec:
+		return p.s.relatedEmbedding(ctx, cfg, p.RelatedText(ctx))
+	}
+	return nil, nil
+}
+
+// relatedEmbedding computes the embedding vector for text using the
+// configured command, caching the result in the embeddings file cache.
+func (s *Site) relatedEmbedding(ctx context.Context, cfg related.IndexConfig, text string) ([]float64, error) {
+	key := helpers.MD5String(strings.Join(append([]string{cfg.Model, text}, cfg.Command...), "\x00"))
+	errMsg := fmt.Sprintf("related index %q: failed to create embedding", cfg.Name)
+
+	var v []float64
+	_, b, err := s.ResourceSpec.FileCaches.EmbeddingsCache().GetOrCreateBytes(key, func() ([]byte, error) {
+		var stdout bytes.Buffer
+		args := []any{
+			hexec.WithContext(ctx),
+			hexec.WithStdin(strings.NewReader(text)),
+			hexec.WithStdout(&stdout),
+			hexec.WithEnviron([]string{"HUGO_RELATED_MODEL=" + cfg.Model}),
+		}
+		for _, arg := range cfg.Command[1:] {
+			args = append(args, arg)
+		}
+
+		cmd, err := s.ExecHelper.New(cfg.Command[0], args...)
+		if err != nil {
+			return nil, err
+		}
+		if err := cmd.Run(); err != nil {
+			return nil, err
+		}
+
+		// Validate before it gets cached.
+		if err := json.Unmarshal(stdout.Bytes(), &v); err != nil {
+			return nil, fmt.Errorf("command must write a JSON array of numbers to stdout: %w", err)
+		}
+
+		return stdout.Bytes(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errMsg, err)
+	}
+
+	if v == nil {
+		// From cache.
+		if err := json.Unmarshal(b, &v); err != nil {
+			return nil, fmt.Errorf("%s: %w", errMsg, err)
+		}
+	}
+
+	return v, nil
+}
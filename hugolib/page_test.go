@@ -1985,3 +1985,116 @@ title: "p2"
 	b.Assert(identity.HashString(p1), qt.Not(qt.Equals), identity.HashString(p2))
 	b.Assert(identity.HashString(sites[0]), qt.Not(qt.Equals), identity.HashString(sites[1]))
 }
+
+func TestSummaryConfigPerLanguage(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "section", "rss", "sitemap", "404"]
+defaultContentLanguage = "en"
+summaryLength = 4
+[languages.en]
+weight = 1
+[languages.nn]
+weight = 2
+[languages.nn.summary]
+unit = "sentences"
+length = 1
+[languages.sv]
+weight = 3
+[languages.sv.summary]
+unit = "characters"
+length = 12
+-- content/p1.md --
+---
+title: p1
+---
+First sentence here. Second sentence here. Third sentence here.
+-- content/p1.nn.md --
+---
+title: p1
+---
+First sentence here. Second sentence here. Third sentence here.
+-- content/p1.sv.md --
+---
+title: p1
+---
+Tom &amp; Jerry are friends.
+-- layouts/_default/single.html --
+Summary: {{ .Summary }}|Truncated: {{ .Truncated }}|
+-- layouts/index.html --
+Home.
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html", "Summary: First sentence here. Second sentence here.|Truncated: true|")
+	b.AssertFileContent("public/nn/p1/index.html", "Summary: First sentence here.|Truncated: true|")
+	b.AssertFileContent("public/sv/p1/index.html", "Summary: Tom &amp; Jerry|Truncated: true|")
+}
+
+func TestPageParamsTypedAndSchema(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap"]
+[params]
+maxItems = "5"
+[paramsSchema.rating]
+type = "int"
+default = 1
+[paramsSchema.featured]
+type = "bool"
+[paramsSchema."author.name"]
+type = "string"
+required = true
+-- content/p1.md --
+---
+title: p1
+rating: "4"
+featured: "true"
+published: 2026-01-15
+author:
+  name: Jo
+tags: [a, b]
+---
+-- content/p2.md --
+---
+title: p2
+author:
+  name: 42
+---
+-- layouts/_default/single.html --
+Rating: {{ .Params.rating }}|{{ printf "%T" .Params.rating }}|Featured: {{ printf "%T" .Params.featured }}|Author: {{ .Params.GetString "author.name" }}|
+Color: {{ .Params.GetString "color" "red" }}|Tags: {{ .Params.GetSlice "tags" }}|Year: {{ (.Params.GetDate "published" "2020-01-01").Year }}|
+MaxItems: {{ add (site.Params.GetInt "maxItems") 1 }}|
+-- layouts/index.html --
+Home: {{ .Params.rating }}|
+`
+
+	b := NewIntegrationTestBuilder(IntegrationTestConfig{T: t, TxtarString: files}).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		"Rating: 4|int|Featured: bool|Author: Jo|",
+		"Color: red|Tags: [a b]|Year: 2026|",
+		"MaxItems: 6|",
+	)
+	b.AssertFileContent("public/p2/index.html", "Rating: 1|int|Featured: &lt;nil&gt;|Author: 42|", "Year: 2020|")
+	b.AssertFileContent("public/index.html", "Home: 1|")
+
+	b, err := NewIntegrationTestBuilder(IntegrationTestConfig{T: t, TxtarString: strings.Replace(files, "  name: 42", "  email: jo@example.org", 1)}).BuildE()
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `p2.md`)
+	b.Assert(err.Error(), qt.Contains, `param "author.name" is required`)
+
+	b, err = NewIntegrationTestBuilder(IntegrationTestConfig{T: t, TxtarString: strings.Replace(files, `rating: "4"`, `rating: "high"`, 1)}).BuildE()
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `param "rating": cannot convert high (string) to int`)
+}
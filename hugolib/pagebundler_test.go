@@ -16,6 +16,8 @@ package hugolib
 import (
 	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
 	"path/filepath"
@@ -1345,3 +1347,117 @@ func TestPageBundlerHome(t *testing.T) {
 Title: Home|First Resource: data.json|Content: <p>Hook Len Page Resources 1</p>
 `)
 }
+
+// newRemoteResourcesTestServer starts a TLS server with handler and makes the
+// default HTTP transport trust it for the duration of the test.
+// Tests using it must not run in parallel.
+func newRemoteResourcesTestServer(t *testing.T, handler http.Handler) *httptest.Server {
+	ts := httptest.NewTLSServer(handler)
+	transport := http.DefaultTransport
+	http.DefaultTransport = ts.Client().Transport
+	t.Cleanup(func() {
+		http.DefaultTransport = transport
+		ts.Close()
+	})
+	return ts
+}
+
+func TestPageBundlerRemoteResources(t *testing.T) {
+	ts := newRemoteResourcesTestServer(t, http.FileServer(http.Dir("testdata/")))
+
+	files := fmt.Sprintf(`
+-- config.toml --
+baseURL = "https://example.com"
+disableKinds = ["taxonomy", "term"]
+-- content/post/index.md --
+---
+title: "Post"
+resources:
+- src: "%[1]s/sunset.jpg"
+  name: "header"
+  title: "Sunset"
+  params:
+    credits: "Bep"
+- src: "%[1]s/fruits.json"
+- src: "*.json"
+  title: "JSON"
+---
+-- content/post/local.txt --
+Local.
+-- layouts/_default/single.html --
+{{ range .Resources }}{{ .Name }}|{{ .Title }}|{{ .ResourceType }}|{{ .RelPermalink }}|{{ .Params.credits }}
+{{ end }}
+{{ with .Resources.GetMatch "header" }}{{ with .Resize "20x" }}Resized: {{ .Width }}|{{ .RelPermalink }}{{ end }}{{ end }}
+`, ts.URL)
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/post/index.html",
+		"fruits.json|JSON|application|/post/fruits.json|",
+		"header|Sunset|image|/post/sunset.jpg|Bep",
+		"local.txt|local.txt|text|/post/local.txt|",
+		"Resized: 20|/post/sunset_hu",
+	)
+	b.AssertDestinationExists("post/sunset.jpg", true)
+}
+
+func TestPageBundlerRemoteResourcesNotFound(t *testing.T) {
+	ts := newRemoteResourcesTestServer(t, http.NotFoundHandler())
+
+	files := fmt.Sprintf(`
+-- config.toml --
+baseURL = "https://example.com"
+disableKinds = ["taxonomy", "term"]
+-- content/post/index.md --
+---
+title: "Post"
+resources:
+- src: "%s/missing.jpg"
+---
+-- layouts/_default/single.html --
+{{ range .Resources }}{{ .Name }}{{ end }}
+`, ts.URL)
+
+	b, err := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, "missing.jpg\" not found")
+}
+
+func TestPageBundlerRemoteResourcesHTTP(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.com"
+disableKinds = ["taxonomy", "term"]
+-- content/post/index.md --
+---
+title: "Post"
+resources:
+- src: "http://example.org/sunset.jpg"
+---
+-- layouts/_default/single.html --
+{{ range .Resources }}{{ .Name }}{{ end }}
+`
+
+	b, err := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `"http://example.org/sunset.jpg": remote resources must use https`)
+}
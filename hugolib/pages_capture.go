@@ -333,22 +333,18 @@ func (c *pagesCollector) collectDir(dirname string, partial bool, inFilter func(
 			c.tracker.add(path, btype)
 		}
 
-		if btype == bundleBranch {
-			if err := c.handleBundleBranch(readdir); err != nil {
-				return err
-			}
-			// A branch bundle is only this directory level, so keep walking.
-			return nil
-		} else if btype == bundleLeaf {
-			if err := c.handleBundleLeaf(dir, path, readdir); err != nil {
-				return err
-			}
-
-			return nil
+		var adapters []hugofs.FileMetaInfo
+		readdir, adapters = c.splitContentAdapters(readdir)
+		if err := c.handleDir(btype, dir, path, readdir); err != nil {
+			return err
 		}
 
-		if err := c.handleFiles(readdir...); err != nil {
-			return err
+		// Content adapters are handled after the other content in the
+		// directory to make sure any section is created first.
+		for _, fi := range adapters {
+			if err := c.proc.Process(contentAdapterFile{fi}); err != nil {
+				return err
+			}
 		}
 
 		return nil
@@ -491,6 +487,53 @@ func (c *pagesCollector) collectDir(dirname string, partial bool, inFilter func(
 	return w.Walk()
 }
 
+func (c *pagesCollector) handleDir(
+	btype bundleDirType,
+	dir hugofs.FileMetaInfo,
+	path string,
+	readdir []hugofs.FileMetaInfo) error {
+	if btype == bundleBranch {
+		if err := c.handleBundleBranch(readdir); err != nil {
+			return err
+		}
+		// A branch bundle is only this directory level, so keep walking.
+		return nil
+	} else if btype == bundleLeaf {
+		if err := c.handleBundleLeaf(dir, path, readdir); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	if err := c.handleFiles(readdir...); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// splitContentAdapters splits readdir into the content adapters and the rest.
+func (c *pagesCollector) splitContentAdapters(readdir []hugofs.FileMetaInfo) (rest, adapters []hugofs.FileMetaInfo) {
+	for _, fi := range readdir {
+		if isContentAdapter(fi) {
+			adapters = append(adapters, fi)
+		}
+	}
+	if adapters == nil {
+		return readdir, nil
+	}
+
+	rest = make([]hugofs.FileMetaInfo, 0, len(readdir)-len(adapters))
+	for _, fi := range readdir {
+		if !isContentAdapter(fi) {
+			rest = append(rest, fi)
+		}
+	}
+
+	return rest, adapters
+}
+
 func (c *pagesCollector) handleBundleBranch(readdir []hugofs.FileMetaInfo) error {
 	// Maps bundles to its language.
 	bundles := pageBundles{}
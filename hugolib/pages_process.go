@@ -60,6 +60,8 @@ func (proc *pagesProcessor) Process(item any) error {
 		for _, vv := range v {
 			proc.getProcFromFi(vv.header).Process(vv)
 		}
+	case contentAdapterFile:
+		proc.getProcFromFi(v).Process(v)
 	case hugofs.FileMetaInfo:
 		proc.getProcFromFi(v).Process(v)
 	default:
@@ -173,6 +175,13 @@ func (p *sitePagesProcessor) doProcess(item any) error {
 		if err := m.AddFilesBundle(v.header, v.resources...); err != nil {
 			return err
 		}
+	case contentAdapterFile:
+		if p.shouldSkip(v) {
+			return nil
+		}
+		if err := m.executeContentAdapter(v); err != nil {
+			return err
+		}
 	case hugofs.FileMetaInfo:
 		if p.shouldSkip(v) {
 			return nil
@@ -16,6 +16,7 @@ package hugolib
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	qt "github.com/frankban/quicktest"
@@ -162,3 +163,176 @@ Len Pag: {{ len $pag.Pages }}
 
 	b.AssertFileContent("public/index.html", "Len: 0", "Len Pag: 0")
 }
+
+func TestPaginateGroups(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.com/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+-- content/blog/_index.md --
+---
+title: Blog
+---
+-- content/blog/p1.md --
+---
+title: P1
+date: 2024-01-01
+---
+-- content/blog/p2.md --
+---
+title: P2
+date: 2024-02-01
+---
+-- content/blog/p3.md --
+---
+title: P3
+date: 2024-03-01
+---
+-- content/blog/p4.md --
+---
+title: P4
+date: 2023-01-01
+---
+-- layouts/_default/single.html --
+{{ .Title }}
+-- layouts/_default/list.html --
+{{ $pag := .PaginateGroups (.Pages.GroupByDate "2006") 2 }}
+Key: {{ $pag.Key }}|Number: {{ $pag.PageNumber }}/{{ $pag.TotalPages }}|URL: {{ $pag.URL }}|
+Pages: {{ range $pag.Pages }}{{ .Title }}|{{ end }}
+Next: {{ with $pag.Next }}{{ .URL }}{{ end }}|
+Groups: {{ range $pag.GroupPagers }}{{ .Key }}:{{ .URL }}|{{ end }}
+`
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/blog/index.html",
+		"Key: 2024|Number: 1/2|URL: /blog/2024/|",
+		"Pages: P3|P2|",
+		"Next: /blog/2024/page/2/|",
+		"Groups: 2024:/blog/2024/|2023:/blog/2023/|",
+	)
+	b.AssertFileContent("public/blog/2024/index.html",
+		"Key: 2024|Number: 1/2|URL: /blog/2024/|",
+		"Pages: P3|P2|",
+	)
+	b.AssertFileContent("public/blog/2024/page/2/index.html",
+		"Key: 2024|Number: 2/2|URL: /blog/2024/page/2/|",
+		"Pages: P1|",
+		"Next: |",
+	)
+	b.AssertFileContent("public/blog/2023/index.html",
+		"Key: 2023|Number: 1/1|URL: /blog/2023/|",
+		"Pages: P4|",
+	)
+	b.AssertFileContent("public/blog/2024/page/1/index.html", "https://example.com/blog/2024/")
+	b.AssertDestinationExists("blog/2023/index.html", true)
+	b.AssertDestinationExists("blog/page/2/index.html", false)
+}
+
+func TestPaginateGroupsInvalid(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+-- content/p1.md --
+-- layouts/index.html --
+{{ $pag := .PaginateGroups site.RegularPages }}
+`
+	b, err := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, "expected page groups")
+}
+
+func TestPaginateGroupsPagerSizePerGroup(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.com/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+-- content/blog/_index.md --
+---
+title: Blog
+---
+-- content/blog/p1.md --
+---
+title: P1
+date: 2024-01-01
+---
+-- content/blog/p2.md --
+---
+title: P2
+date: 2024-02-01
+---
+-- content/blog/p3.md --
+---
+title: P3
+date: 2024-03-01
+---
+-- content/blog/p4.md --
+---
+title: P4
+date: 2023-01-01
+---
+-- content/blog/p5.md --
+---
+title: P5
+date: 2023-02-01
+---
+-- layouts/_default/single.html --
+{{ .Title }}
+-- layouts/_default/list.html --
+{{ $pag := .PaginateGroups (.Pages.GroupByDate "2006") 2 (dict "2024" 1) }}
+Key: {{ $pag.Key }}|Number: {{ $pag.PageNumber }}/{{ $pag.TotalPages }}|
+Pages: {{ range $pag.Pages }}{{ .Title }}|{{ end }}
+`
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/blog/2024/page/3/index.html",
+		"Key: 2024|Number: 3/3|",
+		"Pages: P1|",
+	)
+	b.AssertFileContent("public/blog/2023/index.html",
+		"Key: 2023|Number: 1/1|",
+		"Pages: P5|P4|",
+	)
+	b.AssertDestinationExists("blog/2023/page/2/index.html", false)
+
+	for _, test := range []struct {
+		sizes  string
+		expect string
+	}{
+		{`(dict "2022" 1)`, `no page group with key "2022"`},
+		{`(dict "2024" 0)`, `pager size for group "2024" must be a positive integer`},
+		{`"2024"`, "group pager sizes must be a map"},
+	} {
+		files := strings.Replace(files, `(dict "2024" 1)`, test.sizes, 1)
+		b, err := NewIntegrationTestBuilder(
+			IntegrationTestConfig{
+				T:           t,
+				TxtarString: files,
+			},
+		).BuildE()
+
+		b.Assert(err, qt.IsNotNil)
+		b.Assert(err.Error(), qt.Contains, test.expect)
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/redirects"
+	"github.com/gohugoio/hugo/resources"
+	"github.com/gohugoio/hugo/resources/page"
+)
+
+// PermalinkChange is a page published to another URL than in a previous
+// version of the site.
+type PermalinkChange struct {
+	// The content file of the page relative to the project, e.g. "content/posts/p1.md".
+	Source string
+
+	// The previous URL of the page relative to the site root,
+	// ready to be added to the page's aliases.
+	Alias string
+
+	// The redirect from the previous URL to the current one.
+	Redirect redirects.Redirect
+}
+
+// PermalinkChangesFromPatterns returns the pages with a URL other than the
+// one they would get with the given permalinks configuration, e.g. the one in
+// use before the permalinks configuration was changed.
+func (h *HugoSites) PermalinkChangesFromPatterns(permalinks map[string]string) ([]PermalinkChange, error) {
+	permalinks = maps.CleanConfigStringMapString(permalinks)
+
+	var changes []PermalinkChange
+	for _, s := range h.Sites {
+		expander, err := page.NewPermalinkExpander(s.PathSpec.URLize, permalinks)
+		if err != nil {
+			return nil, err
+		}
+
+		c, err := s.permalinkChanges(func(p *pageState, desc page.TargetPathDescriptor) (string, bool, error) {
+			// See createTargetPathDescriptor.
+			desc.ExpandedPermalink = ""
+			if p.Kind() == page.KindPage || p.Kind() == page.KindTerm {
+				opath, err := expander.Expand(p.Section(), p)
+				if err != nil {
+					return "", false, err
+				}
+				if opath != "" {
+					opath, _ = url.QueryUnescape(opath)
+					desc.ExpandedPermalink = opath
+				}
+			}
+			return page.CreateTargetPaths(desc).Link, true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, c...)
+	}
+
+	return sortPermalinkChanges(changes), nil
+}
+
+// PermalinkChangesFromManifest returns the pages with a URL other than the
+// one recorded in the build manifest of a previous build, see build.manifest.
+func (h *HugoSites) PermalinkChangesFromManifest(r io.Reader) ([]PermalinkChange, error) {
+	var m buildManifest
+	if err := json.NewDecoder(r).Decode(&m); err != nil {
+		return nil, fmt.Errorf("failed to decode build manifest: %w", err)
+	}
+
+	previous := make(map[string]string)
+	for _, e := range m.Files {
+		if e.Type == resources.BuildManifestTypePage && e.Source != "" {
+			previous[e.Source+"|"+strings.ToLower(e.OutputFormat)] = e.Path
+		}
+	}
+
+	var changes []PermalinkChange
+	for _, s := range h.Sites {
+		lang := s.Language().Lang
+		c, err := s.permalinkChanges(func(p *pageState, desc page.TargetPathDescriptor) (string, bool, error) {
+			filename, found := previous[s.sourcePath(p)+"|"+strings.ToLower(desc.Type.Name)]
+			if !found {
+				return "", false, nil
+			}
+			link := "/" + filename
+			if s.h.Configs.IsMultihost {
+				// The links are relative to the language root.
+				link = strings.TrimPrefix(link, "/"+lang)
+			}
+			link = strings.TrimSuffix(link, "index.html")
+			if link == "" {
+				link = "/"
+			}
+			return link, true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, c...)
+	}
+
+	return sortPermalinkChanges(changes), nil
+}
+
+// permalinkChanges compares the link of the main HTML output format of every
+// page in s with the previous link returned by previousLink, which returns
+// false if it is not known.
+// Pages with a previous link now used by another page are skipped.
+func (s *Site) permalinkChanges(previousLink func(p *pageState, desc page.TargetPathDescriptor) (string, bool, error)) ([]PermalinkChange, error) {
+	type candidate struct {
+		p    *pageState
+		link string
+		of   page.OutputFormat
+	}
+
+	current := make(map[string]bool)
+	var candidates []candidate
+
+	for _, p := range s.Pages() {
+		ps := p.(*pageState)
+		for _, of := range p.OutputFormats() {
+			if !of.Format.IsHTML {
+				continue
+			}
+			desc := ps.targetPathDescriptor
+			desc.Type = of.Format
+			link := page.CreateTargetPaths(desc).Link
+			current[link] = true
+
+			previous, found, err := previousLink(ps, desc)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", ps.pathOrTitle(), err)
+			}
+			if found && previous != link {
+				candidates = append(candidates, candidate{p: ps, link: previous, of: of})
+			}
+			break
+		}
+	}
+
+	var changes []PermalinkChange
+	for _, c := range candidates {
+		if current[c.link] {
+			continue
+		}
+		changes = append(changes, PermalinkChange{
+			Source: s.sourcePath(c.p),
+			Alias:  c.link,
+			Redirect: redirects.Redirect{
+				From:   s.redirectFrom(c.link),
+				To:     c.of.RelPermalink(),
+				Status: s.h.Configs.Base.Redirects.Status,
+				Source: c.p.pathOrTitle(),
+			},
+		})
+	}
+
+	return changes, nil
+}
+
+func sortPermalinkChanges(changes []PermalinkChange) []PermalinkChange {
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Source != changes[j].Source {
+			return changes[i].Source < changes[j].Source
+		}
+		return changes[i].Alias < changes[j].Alias
+	})
+	return changes
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/redirects"
+)
+
+const permalinkChangesFiles = `
+-- hugo.toml --
+baseURL = "https://example.org/blog/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+[build]
+manifest = "hugo_manifest.json"
+[permalinks]
+posts = "PATTERN"
+-- layouts/_default/single.html --
+Single: {{ .Title }}
+-- layouts/_default/list.html --
+List: {{ .Title }}
+-- content/posts/p1.md --
+---
+title: P1
+date: 2023-04-05
+---
+-- content/posts/p2.md --
+---
+title: P2
+date: 2023-05-06
+url: /fixed/
+---
+-- content/docs/d1.md --
+---
+title: D1
+---
+`
+
+func TestPermalinkChangesFromPatterns(t *testing.T) {
+	t.Parallel()
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.Replace(permalinkChangesFiles, "PATTERN", "/:year/:month/:slug/", 1),
+		},
+	).Build()
+
+	b.AssertFileContent("public/2023/04/p1/index.html", "Single: P1")
+
+	changes, err := b.H.PermalinkChangesFromPatterns(map[string]string{"Posts": "/posts/:title/"})
+	b.Assert(err, qt.IsNil)
+	b.Assert(changes, qt.DeepEquals, []PermalinkChange{
+		{
+			Source: "content/posts/p1.md",
+			Alias:  "/posts/p1/",
+			Redirect: redirects.Redirect{
+				From:   "/blog/posts/p1/",
+				To:     "/blog/2023/04/p1/",
+				Status: 301,
+				Source: b.H.Sites[0].getPage("/posts/p1").File().Filename(),
+			},
+		},
+	})
+
+	changes, err = b.H.PermalinkChangesFromPatterns(nil)
+	b.Assert(err, qt.IsNil)
+	b.Assert(changes, qt.HasLen, 1)
+	b.Assert(changes[0].Alias, qt.Equals, "/posts/p1/")
+
+	_, err = b.H.PermalinkChangesFromPatterns(map[string]string{"posts": "/:foo/"})
+	b.Assert(err, qt.Not(qt.IsNil))
+}
+
+func TestPermalinkChangesFromManifest(t *testing.T) {
+	t.Parallel()
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.Replace(permalinkChangesFiles, "PATTERN", "/:year/:slug/", 1),
+		},
+	).Build()
+
+	manifest := b.FileContent("public/hugo_manifest.json")
+
+	b = NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.Replace(permalinkChangesFiles, "PATTERN", "/:year/:month/:slug/", 1),
+		},
+	).Build()
+
+	changes, err := b.H.PermalinkChangesFromManifest(strings.NewReader(manifest))
+	b.Assert(err, qt.IsNil)
+	b.Assert(changes, qt.HasLen, 1)
+	b.Assert(changes[0].Source, qt.Equals, "content/posts/p1.md")
+	b.Assert(changes[0].Alias, qt.Equals, "/2023/p1/")
+	b.Assert(changes[0].Redirect.From, qt.Equals, "/blog/2023/p1/")
+	b.Assert(changes[0].Redirect.To, qt.Equals, "/blog/2023/04/p1/")
+
+	_, err = b.H.PermalinkChangesFromManifest(strings.NewReader("{"))
+	b.Assert(err, qt.ErrorMatches, "failed to decode build manifest.*")
+}
@@ -13,7 +13,52 @@
 
 package hugolib
 
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/gohugoio/hugo/metrics"
+)
+
 // GC requires a build first and must run on it's own. It is not thread safe.
 func (h *HugoSites) GC() (int, error) {
 	return h.Deps.ResourceSpec.FileCaches.Prune()
 }
+
+// pruneCachesToMaxSize prunes the file caches grown above their maxSize
+// and updates the cache size gauges. It requires a full build.
+func (h *HugoSites) pruneCachesToMaxSize() error {
+	if h.cacheGauges == nil {
+		return nil
+	}
+	caches := h.Deps.ResourceSpec.FileCaches
+	count, err := caches.PruneToMaxSize()
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		h.Log.Infof("Pruned %d file cache entries to stay below the configured maxSize", count)
+	}
+	return caches.UpdateGauges(h.cacheGauges)
+}
+
+// cacheGaugesStatsString returns the file cache sizes for the build report.
+func cacheGaugesStatsString(g *metrics.Gauges) string {
+	var names []string
+	values := g.Values()
+	for k := range values {
+		if strings.HasSuffix(k, ".size") {
+			names = append(names, strings.TrimSuffix(strings.TrimPrefix(k, "filecache."), ".size"))
+		}
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString("File caches:")
+	for _, name := range names {
+		fmt.Fprintf(&b, " %s %s (%d files)", name, humanize.Bytes(uint64(values["filecache."+name+".size"])), int(values["filecache."+name+".files"]))
+	}
+	return b.String()
+}
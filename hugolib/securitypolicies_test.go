@@ -18,6 +18,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"runtime"
+	"strings"
 	"testing"
 
 	qt "github.com/frankban/quicktest"
@@ -221,3 +222,64 @@ urls="none"
 	})
 
 }
+
+func TestSecurityPoliciesPerModule(t *testing.T) {
+	t.Setenv("MYTHEME_KEY", "secret")
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "page", "section", "rss", "sitemap"]
+theme = "mytheme"
+[[security.modules]]
+path = "mytheme"
+[security.modules.funcs]
+getenv = ["^MYTHEME_"]
+-- themes/mytheme/layouts/partials/key.html --
+{{ os.Getenv "MYTHEME_KEY" }}
+-- layouts/index.html --
+Key: {{ partial "key.html" . }}|
+`
+
+	b := NewIntegrationTestBuilder(IntegrationTestConfig{T: t, TxtarString: files}).Build()
+	b.AssertFileContent("public/index.html", "Key: secret\n|")
+
+	files = strings.Replace(files, `Key: {{ partial "key.html" . }}|`, `Key: {{ os.Getenv "MYTHEME_KEY" }}|`, 1)
+
+	b, err := NewIntegrationTestBuilder(IntegrationTestConfig{T: t, TxtarString: files}).BuildE()
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err, qt.ErrorMatches, `(?s).*"MYTHEME_KEY" is not whitelisted in policy "security\.funcs\.getenv" for the templates in module "project".*`)
+}
+
+func TestSecurityPoliciesPerModuleNotShared(t *testing.T) {
+	t.Setenv("MODA_KEY", "secret")
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "page", "section", "rss", "sitemap"]
+theme = ["moda", "modb"]
+[[security.modules]]
+path = "moda"
+[security.modules.funcs]
+getenv = ["^MODA_"]
+-- themes/moda/layouts/partials/a.html --
+{{ os.Getenv "MODA_KEY" }}
+-- themes/modb/layouts/partials/b.html --
+{{ os.Getenv "MODA_KEY" }}
+-- layouts/index.html --
+A: {{ partial "a.html" . }}|
+`
+
+	b := NewIntegrationTestBuilder(IntegrationTestConfig{T: t, TxtarString: files}).Build()
+	b.AssertFileContent("public/index.html", "A: secret\n|")
+
+	// Module modb has no policy of its own, so the top level policy applies.
+	filesb := strings.Replace(files, `A: {{ partial "a.html" . }}|`, `B: {{ partial "b.html" . }}|`, 1)
+	b, err := NewIntegrationTestBuilder(IntegrationTestConfig{T: t, TxtarString: filesb}).BuildE()
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err, qt.ErrorMatches, `(?s).*"MODA_KEY" is not whitelisted in policy "security\.funcs\.getenv" for the templates in module "modb".*`)
+
+	filesp := strings.Replace(files, `A: {{ partial "a.html" . }}|`, `P: {{ os.Getenv "MODA_KEY" }}|`, 1)
+	b, err = NewIntegrationTestBuilder(IntegrationTestConfig{T: t, TxtarString: filesp}).BuildE()
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err, qt.ErrorMatches, `(?s).*"MODA_KEY" is not whitelisted in policy "security\.funcs\.getenv" for the templates in module "project".*`)
+}
@@ -26,8 +26,6 @@ import (
 	"strings"
 	"sync"
 
-	"github.com/gohugoio/hugo/helpers"
-
 	"errors"
 
 	"github.com/gohugoio/hugo/common/herrors"
@@ -126,6 +124,18 @@ func (scp *ShortcodeWithPage) RelRef(args map[string]any) (string, error) {
 	return scp.Page.RelRefFrom(args, scp)
 }
 
+// Include returns the rendered content of the page with the given path,
+// resolved relative to the current page. With a fragment, e.g. "install#requirements",
+// only the section below the heading with that ID is returned.
+// Including a page that in turn includes the current page is an error.
+func (scp *ShortcodeWithPage) Include(ctx context.Context, ref string) (template.HTML, error) {
+	p, ok := mustUnwrapPage(scp.Page).(*pageState)
+	if !ok {
+		return "", fmt.Errorf("include: page not found")
+	}
+	return p.include(ctx, ref)
+}
+
 // Scratch returns a scratch-pad scoped for this shortcode. This can be used
 // as a temporary storage for variables, counters etc.
 func (scp *ShortcodeWithPage) Scratch() *maps.Scratch {
@@ -185,6 +195,9 @@ func (scp *ShortcodeWithPage) page() page.Page {
 // Note - this value must not contain any markup syntax
 const shortcodePlaceholderPrefix = "HAHAHUGOSHORTCODE"
 
+// shortcodePlaceholderRe matches the placeholders created by createShortcodePlaceholder.
+var shortcodePlaceholderRe = regexp.MustCompile(shortcodePlaceholderPrefix + `\S*?HBHB`)
+
 func createShortcodePlaceholder(id string, ordinal int) string {
 	return shortcodePlaceholderPrefix + id + strconv.Itoa(ordinal) + "HBHB"
 }
@@ -635,7 +648,14 @@ Loop:
 			// TODO(bep) avoid the duplication of these "text cases", to prevent
 			// more of #6504 in the future.
 			val := currItem.ValStr(source)
-			if emoji := helpers.Emoji(val); emoji != nil {
+			if tsc, err := s.newEmojiTokenShortcode(nestedOrdinal, val); err != nil {
+				return sc, fmt.Errorf("%s: %w", errorPrefix, err)
+			} else if tsc != nil {
+				tsc.pos = currItem.Pos()
+				tsc.length = len(val)
+				nestedOrdinal++
+				sc.inner = append(sc.inner, tsc)
+			} else if emoji := s.s.emoji(val); emoji != nil {
 				sc.inner = append(sc.inner, string(emoji))
 			} else {
 				sc.inner = append(sc.inner, val)
@@ -701,6 +721,51 @@ Loop:
 	return sc, nil
 }
 
+// emojiTokenShortcodeName is the built-in shortcode rendering the custom
+// emoji tokens configured in emojiTokens. It can be overridden in the project.
+const emojiTokenShortcodeName = "emoji-token"
+
+// newEmojiTokenShortcode creates a shortcode rendering the emoji token,
+// e.g. ":brandname:", or nil if no such custom emoji token is configured.
+func (s *shortcodeHandler) newEmojiTokenShortcode(ordinal int, token string) (*shortcode, error) {
+	src, found := s.s.conf.EmojiTokens[strings.ToLower(strings.Trim(token, ":"))]
+	if !found {
+		return nil, nil
+	}
+
+	templs := s.s.Tmpl().LookupVariants(emojiTokenShortcodeName)
+	if templs == nil {
+		return nil, fmt.Errorf("template for shortcode %q not found", emojiTokenShortcodeName)
+	}
+
+	return &shortcode{
+		name:        emojiTokenShortcodeName,
+		params:      map[string]any{"token": token, "src": src},
+		ordinal:     ordinal,
+		placeholder: createShortcodePlaceholder("s", ordinal),
+		info:        templs[0].(tpl.Info),
+		templs:      templs,
+	}, nil
+}
+
+// usesAsset reports whether sc, or any of its inner shortcodes, renders an
+// emoji token from the given file in /assets.
+func (sc *shortcode) usesAsset(filename string) bool {
+	if sc.name == emojiTokenShortcodeName {
+		if params, ok := sc.params.(map[string]any); ok {
+			if src, ok := params["src"].(string); ok && strings.TrimPrefix(path.Clean(src), "/") == filename {
+				return true
+			}
+		}
+	}
+	for _, inner := range sc.inner {
+		if isc, ok := inner.(*shortcode); ok && isc.usesAsset(filename) {
+			return true
+		}
+	}
+	return false
+}
+
 // Replace prefixed shortcode tokens with the real content.
 // Note: This function will rewrite the input slice.
 func expandShortcodeTokens(
@@ -754,6 +819,10 @@ func renderShortcodeWithPage(ctx context.Context, h tpl.TemplateHandler, tmpl tp
 	buffer := bp.GetBuffer()
 	defer bp.PutBuffer(buffer)
 
+	if stack := tpl.GetRenderStackFromContext(ctx); stack != nil {
+		defer stack.EnterShortcode(data.Name, data.Position)()
+	}
+
 	err := h.ExecuteWithContext(ctx, tmpl, buffer, data)
 	if err != nil {
 		return "", fmt.Errorf("failed to process shortcode: %w", err)
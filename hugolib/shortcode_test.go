@@ -1314,3 +1314,79 @@ Hello.
 	b.AssertFileContent("public/p1/index.html", "<span style=\"color:#a6e22e\">Hello.</span>")
 
 }
+
+func TestEmojiTokens(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "section", "rss", "sitemap", "404"]
+[emojiTokens]
+brandName = "images/brand.svg"
+logo = "images/logo.png"
+-- assets/images/brand.svg --
+<svg class="brand"></svg>
+-- assets/images/logo.png --
+iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNkYPhfDwAChwGA60e6kgAAAABJRU5ErkJggg==
+-- content/p1.md --
+---
+title: p1
+---
+Made by :brandname: and :logo:, not :smile: nor :unknown:.
+
+{{< inner >}}Inner :brandname:{{< /inner >}}
+-- layouts/shortcodes/inner.html --
+<div>{{ .Inner }}</div>
+-- layouts/_default/single.html --
+{{ .Content }}
+-- layouts/index.html --
+Home.
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			Running:     true,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		`<p>Made by <svg class="brand"></svg> and <img class="emoji" src="/images/logo.png" alt=":logo:">, not :smile: nor :unknown:.</p>`,
+		`<div>Inner <svg class="brand"></svg></div>`,
+	)
+
+	b.EditFileReplace("assets/images/brand.svg", func(s string) string { return strings.ReplaceAll(s, "brand", "brand-v2") }).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		`<p>Made by <svg class="brand-v2"></svg> and`,
+		`<div>Inner <svg class="brand-v2"></svg></div>`,
+	)
+}
+
+func TestEmojiTokensNotFound(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+[emojiTokens]
+brand = "images/brand.svg"
+-- content/p1.md --
+---
+title: p1
+---
+Made by :brand:.
+-- layouts/_default/single.html --
+{{ .Content }}
+`
+
+	b, err := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.AssertLogContains(`emoji token :brand:: resource "images/brand.svg" not found in /assets`)
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/gohugoio/hugo/common/herrors"
 	"github.com/gohugoio/hugo/common/htime"
 	"github.com/gohugoio/hugo/common/hugio"
+	"github.com/gohugoio/hugo/common/hugo"
 	"github.com/gohugoio/hugo/common/types"
 	"golang.org/x/text/unicode/norm"
 
@@ -49,6 +50,7 @@ import (
 	"github.com/gohugoio/hugo/common/text"
 
 	"github.com/gohugoio/hugo/publisher"
+	"github.com/gohugoio/hugo/resources"
 
 	"github.com/gohugoio/hugo/langs"
 
@@ -234,7 +236,7 @@ func (s *Site) initRenderFormats() {
 	formats := output.Formats{}
 	rssDisabled := !s.conf.IsKindEnabled("rss")
 	s.pageMap.pageTrees.WalkRenderable(func(s string, n *contentNode) bool {
-		for _, f := range n.p.m.configuredOutputFormats {
+		for _, f := range n.p.m.outputFormats() {
 			if rssDisabled && f.Name == "rss" {
 				// legacy
 				continue
@@ -389,6 +391,14 @@ func (s *siteRefLinker) refLink(ref string, source any, relative bool, outputFor
 	return link, nil
 }
 
+// emoji returns the emoji for the given key, e.g. ":smile:", if enabled.
+func (s *Site) emoji(key string) []byte {
+	if !s.conf.EnableEmoji {
+		return nil
+	}
+	return helpers.Emoji(key)
+}
+
 func (s *Site) watching() bool {
 	return s.h != nil && s.h.Configs.Base.Internal.Watch
 }
@@ -396,6 +406,10 @@ func (s *Site) watching() bool {
 type whatChanged struct {
 	source bool
 	files  map[string]bool
+
+	// Set when all the changes are to content files (not content adapters),
+	// which allows the sitemap and the RSS feeds to be rendered incrementally.
+	contentOnly bool
 }
 
 // RegisterMediaTypes will register the Site's media types in the mime
@@ -506,6 +520,9 @@ func (s *Site) processPartial(config *BuildCfg, init func(config *BuildCfg) erro
 		dataChanged bool
 		i18nChanged bool
 
+		// Set if anything but content files changed.
+		otherChanged bool
+
 		sourceFilesChanged = make(map[string]bool)
 
 		// prevent spamming the log on changes
@@ -515,17 +532,35 @@ func (s *Site) processPartial(config *BuildCfg, init func(config *BuildCfg) erro
 	var cacheBusters []func(string) bool
 	bcfg := s.conf.Build
 
+	addCacheBuster := func(p string) {
+		g, err := bcfg.MatchCacheBuster(s.Log, p)
+		if err == nil && g != nil {
+			cacheBusters = append(cacheBusters, g)
+		}
+	}
+
+	var changedPaths []string
 	for _, ev := range events {
 		component, relFilename := s.BaseFs.MakePathRelative(ev.Name)
 		if relFilename != "" {
 			p := hglob.NormalizePath(path.Join(component, relFilename))
-			g, err := bcfg.MatchCacheBuster(s.Log, p)
-			if err == nil && g != nil {
-				cacheBusters = append(cacheBusters, g)
+			changedPaths = append(changedPaths, p)
+			addCacheBuster(p)
+		}
+
+		// Files imported by e.g. js.Build, possibly outside of /assets,
+		// bust the cache as if the resource's source file was changed.
+		if dependents := s.h.ResourceSpec.ResourceCache.DependentsOf(ev.Name); len(dependents) > 0 {
+			logger.Println("Resource dependency changed", ev)
+			for _, p := range dependents {
+				addCacheBuster(p)
 			}
 		}
 
 		id, found := s.eventToIdentity(ev)
+		if !found || id.Type != files.ComponentFolderContent || filepath.Base(ev.Name) == contentAdapterFilename {
+			otherChanged = true
+		}
 		if found {
 			changeIdentities[id] = id
 
@@ -556,12 +591,15 @@ func (s *Site) processPartial(config *BuildCfg, init func(config *BuildCfg) erro
 	}
 
 	changed := &whatChanged{
-		source: len(sourceChanged) > 0,
-		files:  sourceFilesChanged,
+		source:      len(sourceChanged) > 0,
+		files:       sourceFilesChanged,
+		contentOnly: !otherChanged && !config.ErrRecovery,
 	}
 
 	config.whatChanged = changed
 
+	h.BuildState.SetChangedPaths(changedPaths)
+
 	if err := init(config); err != nil {
 		return err
 	}
@@ -683,8 +721,13 @@ func (s *Site) render(ctx *siteRenderContext) (err error) {
 		return
 	}
 
+	if !s.conf.C.Shard.IsPrimary() {
+		// The site wide outputs are rendered by the first shard.
+		return
+	}
+
 	if ctx.outIdx == 0 {
-		if err = s.renderSitemap(); err != nil {
+		if err = s.renderSitemap(ctx); err != nil {
 			return
 		}
 
@@ -1039,6 +1082,11 @@ func (s *Site) renderAndWritePage(statCounter *uint64, name string, targetPath s
 
 	of := p.outputFormat()
 	ctx := tpl.SetPageInContext(context.Background(), p)
+	if timeout := s.conf.C.RenderTimeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = tpl.SetRenderTimeoutInContext(ctx, p.pathOrTitle(), timeout)
+		defer cancel()
+	}
 
 	if err := s.renderForTemplate(ctx, p.Kind(), of.Name, p, renderBuffer, templ); err != nil {
 		return err
@@ -1066,6 +1114,9 @@ func (s *Site) renderAndWritePage(statCounter *uint64, name string, targetPath s
 			pd.AbsURLPath = s.absURLPath(targetPath)
 		}
 
+		pd.ExternalLinks = s.externalLinks
+		pd.Accessibility = s.a11yInject
+
 		if s.watching() && s.conf.Internal.Running && !s.conf.Internal.DisableLiveReload {
 			pd.LiveReloadBaseURL = s.Conf.BaseURLLiveReload().URL()
 		}
@@ -1077,6 +1128,8 @@ func (s *Site) renderAndWritePage(statCounter *uint64, name string, targetPath s
 
 	}
 
+	s.addToBuildManifest(targetPath, resources.BuildManifestTypePage, p, templ)
+
 	return s.publisher.Publish(pd)
 }
 
@@ -1106,6 +1159,38 @@ func (hr hookRendererTemplate) RenderCodeblock(cctx context.Context, w hugio.Fle
 	return hr.templateHandler.ExecuteWithContext(cctx, hr.templ, w, ctx)
 }
 
+func (hr hookRendererTemplate) RenderFAQ(cctx context.Context, w io.Writer, ctx hooks.FAQContext) error {
+	return hr.templateHandler.ExecuteWithContext(cctx, hr.templ, w, ctx)
+}
+
+func (hr hookRendererTemplate) RenderBlockquote(cctx context.Context, w io.Writer, ctx hooks.BlockquoteContext) error {
+	return hr.templateHandler.ExecuteWithContext(cctx, hr.templ, w, ctx)
+}
+
+func (hr hookRendererTemplate) RenderPassthrough(cctx context.Context, w io.Writer, ctx hooks.PassthroughContext) error {
+	return hr.templateHandler.ExecuteWithContext(cctx, hr.templ, w, ctx)
+}
+
+func (hr hookRendererTemplate) RenderTable(cctx context.Context, w io.Writer, ctx hooks.TableContext) error {
+	return hr.templateHandler.ExecuteWithContext(cctx, hr.templ, w, ctx)
+}
+
+func (hr hookRendererTemplate) RenderFootnoteRef(cctx context.Context, w io.Writer, ctx hooks.FootnoteRefContext) error {
+	return hr.templateHandler.ExecuteWithContext(cctx, hr.templ, w, ctx)
+}
+
+func (hr hookRendererTemplate) RenderFootnotes(cctx context.Context, w io.Writer, ctx hooks.FootnotesContext) error {
+	return hr.templateHandler.ExecuteWithContext(cctx, hr.templ, w, ctx)
+}
+
+func (hr hookRendererTemplate) RenderCitation(cctx context.Context, w io.Writer, ctx hooks.CitationContext) error {
+	return hr.templateHandler.ExecuteWithContext(cctx, hr.templ, w, ctx)
+}
+
+func (hr hookRendererTemplate) RenderBibliography(cctx context.Context, w io.Writer, ctx hooks.BibliographyContext) error {
+	return hr.templateHandler.ExecuteWithContext(cctx, hr.templ, w, ctx)
+}
+
 func (hr hookRendererTemplate) ResolvePosition(ctx any) text.Position {
 	return hr.resolvePosition(ctx)
 }
@@ -1210,6 +1295,10 @@ func (s *Site) newPage(
 }
 
 func (s *Site) shouldBuild(p page.Page) bool {
+	if ps, ok := p.(*pageState); ok && ps.m.devOnly && s.Conf.Environment() != hugo.EnvironmentDevelopment {
+		// Never publish development only content outside of development.
+		return false
+	}
 	return shouldBuild(s.Conf.BuildFuture(), s.Conf.BuildExpired(),
 		s.Conf.BuildDrafts(), p.Draft(), p.PublishDate(), p.ExpiryDate())
 }
@@ -29,20 +29,32 @@ import (
 	"github.com/gohugoio/hugo/config"
 	"github.com/gohugoio/hugo/config/allconfig"
 	"github.com/gohugoio/hugo/deps"
+	"github.com/gohugoio/hugo/featureflags"
+	"github.com/gohugoio/hugo/features"
 	"github.com/gohugoio/hugo/helpers"
+	"github.com/gohugoio/hugo/htmlaudit"
 	"github.com/gohugoio/hugo/identity"
+	"github.com/gohugoio/hugo/indexer"
+	"github.com/gohugoio/hugo/indexnow"
 	"github.com/gohugoio/hugo/langs"
 	"github.com/gohugoio/hugo/langs/i18n"
 	"github.com/gohugoio/hugo/lazy"
+	"github.com/gohugoio/hugo/linkcheck"
+	"github.com/gohugoio/hugo/metrics"
 	"github.com/gohugoio/hugo/modules"
 	"github.com/gohugoio/hugo/navigation"
 	"github.com/gohugoio/hugo/output"
+	"github.com/gohugoio/hugo/pdf"
 	"github.com/gohugoio/hugo/publisher"
+	"github.com/gohugoio/hugo/redirects"
 	"github.com/gohugoio/hugo/resources/page"
 	"github.com/gohugoio/hugo/resources/page/pagemeta"
 	"github.com/gohugoio/hugo/resources/resource"
 	"github.com/gohugoio/hugo/tpl"
 	"github.com/gohugoio/hugo/tpl/tplimpl"
+	"github.com/gohugoio/hugo/transform"
+	"github.com/gohugoio/hugo/transform/a11yinject"
+	"github.com/gohugoio/hugo/transform/externallinks"
 )
 
 var (
@@ -77,6 +89,12 @@ type Site struct {
 	publisher          publisher.Publisher
 	frontmatterHandler pagemeta.FrontMatterHandler
 
+	// Applies the external links policy to HTML output, nil if not configured.
+	externalLinks transform.Transformer
+
+	// Injects the skip link and landmark roles into HTML output, nil if not enabled.
+	a11yInject transform.Transformer
+
 	// We render each site for all the relevant output formats in serial with
 	// this rendering context pointing to the current one.
 	rc *siteRenderingContext
@@ -87,6 +105,12 @@ type Site struct {
 	// This slice will be sorted.
 	renderFormats output.Formats
 
+	// The aliases rendered, used to build the redirects map.
+	redirects []redirects.Redirect
+
+	// Fingerprints of the sitemaps and RSS feeds rendered, used in server mode.
+	listFingerprints listFingerprints
+
 	// Lazily loaded site dependencies
 	init *siteInit
 }
@@ -99,6 +123,13 @@ func (s *Site) Debug() {
 // NewHugoSites creates HugoSites from the given config.
 func NewHugoSites(cfg deps.DepsCfg) (*HugoSites, error) {
 	conf := cfg.Configs.GetFirstLanguageConfig()
+	for _, confp := range cfg.Configs.ConfigLangs() {
+		// The default content language is not built with hugo server --lang.
+		if !confp.IsLangDisabled(confp.Language().Lang) {
+			conf = confp
+			break
+		}
+	}
 
 	logger := cfg.Logger
 	if logger == nil {
@@ -106,6 +137,10 @@ func NewHugoSites(cfg deps.DepsCfg) (*HugoSites, error) {
 	}
 	ignorableLogger := loggers.NewIgnorableLogger(logger, conf.IgnoredErrors())
 
+	if err := cfg.Configs.ModulesClient.EnforceLock(cfg.Configs.Modules); err != nil {
+		return nil, err
+	}
+
 	firstSiteDeps := &deps.Deps{
 		Fs:                  cfg.Fs,
 		Log:                 ignorableLogger,
@@ -120,7 +155,7 @@ func NewHugoSites(cfg deps.DepsCfg) (*HugoSites, error) {
 	confm := cfg.Configs
 	var sites []*Site
 
-	for i, confp := range confm.ConfigLangs() {
+	for _, confp := range confm.ConfigLangs() {
 		language := confp.Language()
 		if confp.IsLangDisabled(language.Lang) {
 			continue
@@ -144,7 +179,7 @@ func NewHugoSites(cfg deps.DepsCfg) (*HugoSites, error) {
 			frontmatterHandler: frontmatterHandler,
 		}
 
-		if i == 0 {
+		if len(sites) == 0 {
 			firstSiteDeps.Site = s
 			s.Deps = firstSiteDeps
 		} else {
@@ -182,6 +217,14 @@ func NewHugoSites(cfg deps.DepsCfg) (*HugoSites, error) {
 		}
 
 		s.publisher = pub
+		s.externalLinks, err = externallinks.New(conf.ExternalLinks, conf.C.BaseURL.URL())
+		if err != nil {
+			return nil, err
+		}
+		s.a11yInject, err = a11yinject.New(conf.Accessibility)
+		if err != nil {
+			return nil, err
+		}
 		s.relatedDocsHandler = page.NewRelatedDocsHandler(s.conf.Related)
 		// Site deps end.
 
@@ -275,6 +318,37 @@ func newHugoSitesNew(cfg deps.DepsCfg, d *deps.Deps, sites []*Site) (*HugoSites,
 		}
 	}
 
+	pdfRenderer, err := pdf.New(h.Configs.Base.PDF, h.ExecHelper)
+	if err != nil {
+		return nil, err
+	}
+	h.pdf = pdfState{renderer: pdfRenderer, targets: make(map[string]bool)}
+
+	idx, err := indexer.New(h.Configs.Base.Indexer, h.ExecHelper)
+	if err != nil {
+		return nil, err
+	}
+	h.indexer = indexerState{indexer: idx, documents: make(map[string]indexer.Document), changed: make(map[string]bool), hashes: make(map[string]string)}
+
+	if h.indexNow, err = indexnow.New(h.Configs.Base.IndexNow, h.ExecHelper); err != nil {
+		return nil, err
+	}
+	if h.indexNow != nil && h.Configs.Base.Build.Manifest == "" {
+		return nil, errors.New("indexNow: build.manifest must be set to detect the changed pages")
+	}
+
+	linkChecker, err := h.newLinkChecker()
+	if err != nil {
+		return nil, err
+	}
+	h.linkCheck = linkCheckState{checker: linkChecker, documents: make(map[string]linkcheck.Document)}
+
+	h.flags = featureflags.New(h.Configs.Base.Flags)
+	if h.Deps.ResourceSpec.FileCaches.HasMaxSize() {
+		h.cacheGauges = metrics.NewGauges()
+	}
+	h.audit = auditState{auditor: htmlaudit.New(h.Configs.Base.Audit), changed: make(map[string]string), findings: make(map[string][]htmlaudit.Finding)}
+
 	h.fatalErrorHandler = &fatalErrorHandler{
 		h:     h,
 		donec: make(chan bool),
@@ -356,6 +430,8 @@ func (s *Site) Config() page.SiteConfig {
 	return page.SiteConfig{
 		Privacy:  s.conf.Privacy,
 		Services: s.conf.Services,
+		SEO:      s.conf.SEO,
+		IndieWeb: s.conf.IndieWeb,
 	}
 }
 
@@ -436,6 +512,22 @@ func (s *Site) Data() map[string]any {
 	return s.s.h.Data()
 }
 
+// Flags returns the feature flags defined in the site config.
+func (s *Site) Flags() *featureflags.Flags {
+	return s.h.flags
+}
+
+// Features returns the features provided by Hugo and the modules in use.
+func (s *Site) Features() *features.Features {
+	return s.h.Configs.Features
+}
+
+// TranslationStatus returns the translation status of the regular pages in this site
+// compared to the other languages.
+func (s *Site) TranslationStatus(ctx context.Context) *page.TranslationStatus {
+	return page.NewTranslationStatus(ctx, s.Lang(), s.RegularPages(), s.Languages())
+}
+
 func (s *Site) BuildDrafts() bool {
 	return s.conf.BuildDrafts
 }
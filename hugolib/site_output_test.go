@@ -647,3 +647,147 @@ WordCount: {{ .WordCount }}
 	b.AssertFileContent("public/outputs-empty/index.html", "HTML:", "Word1. Word2.")
 	b.AssertFileContent("public/outputs-string/index.html", "O1:", "Word1. Word2.")
 }
+
+func TestOutputRules(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.com/"
+disableKinds = ["taxonomy", "term", "sitemap", "robotsTXT", "404", "rss"]
+defaultContentLanguage = "en"
+[languages.en]
+weight = 1
+[languages.de]
+weight = 2
+[outputFormats.openapi]
+mediaType = "application/json"
+baseName = "openapi"
+isPlainText = true
+[outputFormats.print]
+mediaType = "text/html"
+path = "print"
+[outputs]
+page = ["html", "print"]
+[[outputRules]]
+add = ["openapi"]
+[outputRules._target]
+path = "/api/**"
+[[outputRules]]
+remove = ["print"]
+[outputRules._target]
+path = "/docs/**"
+lang = "de"
+[[outputRules]]
+outputs = ["print"]
+[outputRules._target]
+path = "/archive/**"
+kind = "page"
+-- content/api/v1.md --
+---
+title: "API v1"
+---
+-- content/docs/d1.md --
+---
+title: "Doc 1"
+---
+-- content/docs/d1.de.md --
+---
+title: "Doc 1 DE"
+---
+-- content/archive/a1.md --
+---
+title: "Archive 1"
+---
+-- content/archive/a2.md --
+---
+title: "Archive 2"
+outputs: ["html"]
+---
+-- layouts/_default/single.html --
+HTML: {{ .Title }}|{{ range .OutputFormats }}{{ .Name }}:{{ .RelPermalink }}|{{ end }}
+-- layouts/_default/single.print.html --
+Print: {{ .Title }}
+-- layouts/_default/single.openapi.json --
+{"title": {{ .Title | jsonify }}}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/api/v1/index.html", "HTML: API v1|html:/api/v1/|print:/print/api/v1/|openapi:/api/v1/openapi.json|")
+	b.AssertFileContent("public/api/v1/openapi.json", `{"title": "API v1"}`)
+	b.AssertFileContent("public/docs/d1/index.html", "HTML: Doc 1|html:/docs/d1/|print:/print/docs/d1/|")
+	b.AssertFileContent("public/print/docs/d1/index.html", "Print: Doc 1")
+	b.AssertFileContent("public/de/docs/d1/index.html", "HTML: Doc 1 DE|html:/de/docs/d1/|\n")
+	b.AssertDestinationExists("public/de/print/docs/d1/index.html", false)
+	b.AssertFileContent("public/print/archive/a1/index.html", "Print: Archive 1")
+	b.AssertDestinationExists("public/archive/a1/index.html", false)
+
+	// Front matter wins.
+	b.AssertFileContent("public/archive/a2/index.html", "HTML: Archive 2|html:/archive/a2/|\n")
+}
+
+func TestOutputRulesBuiltinPrintFormat(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.com/"
+disableKinds = ["taxonomy", "term", "sitemap", "robotsTXT", "404", "rss"]
+[[outputRules]]
+add = ["print"]
+[outputRules._target]
+path = "/docs/**"
+-- content/docs/d1.md --
+---
+title: "Doc 1"
+---
+-- content/blog/b1.md --
+---
+title: "Blog 1"
+---
+-- layouts/_default/single.html --
+HTML: {{ .Title }}|{{ range .OutputFormats }}{{ .Name }}:{{ .RelPermalink }}|{{ end }}
+-- layouts/_default/single.print.html --
+Print: {{ .Title }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/docs/d1/index.html", "HTML: Doc 1|html:/docs/d1/|print:/docs/d1/print.html|")
+	b.AssertFileContent("public/docs/d1/print.html", "Print: Doc 1")
+	b.AssertFileContent("public/blog/b1/index.html", "HTML: Blog 1|html:/blog/b1/|\n")
+	b.AssertDestinationExists("public/blog/b1/print.html", false)
+}
+
+func TestOutputRulesUnknownFormat(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+[[outputRules]]
+add = ["openapi"]
+[outputRules._target]
+path = "/api/**"
+`
+
+	b, err := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `unknown output format "openapi" in outputRules[0]`)
+}
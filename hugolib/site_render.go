@@ -14,13 +14,24 @@
 package hugolib
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"html"
 	"path"
+	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gohugoio/hugo/output/layouts"
+	"github.com/spf13/cast"
+
+	bp "github.com/gohugoio/hugo/bufferpool"
+	"github.com/gohugoio/hugo/indieweb"
+	"github.com/gohugoio/hugo/llmstxt"
+	"github.com/gohugoio/hugo/markup/tableofcontents"
+	"github.com/gohugoio/hugo/searchindex"
 
 	"github.com/gohugoio/hugo/config"
 	"github.com/gohugoio/hugo/tpl"
@@ -28,6 +39,9 @@ import (
 	"errors"
 
 	"github.com/gohugoio/hugo/output"
+	"github.com/gohugoio/hugo/publisher"
+	"github.com/gohugoio/hugo/redirects"
+	"github.com/gohugoio/hugo/wellknown"
 
 	"github.com/gohugoio/hugo/resources/page"
 	"github.com/gohugoio/hugo/resources/page/pagemeta"
@@ -58,6 +72,12 @@ func (s siteRenderContext) renderSingletonPages() bool {
 	return s.sitesOutIdx == 0
 }
 
+// ownsPage reports whether p is rendered in this build when only a shard
+// of the site is rendered, see the shard config.
+func (s *Site) ownsPage(p *pageState) bool {
+	return s.conf.C.Shard.Owns(p.RelPermalink(), p.Section())
+}
+
 // renderPages renders pages each corresponding to a markdown file.
 // TODO(bep np doc
 func (s *Site) renderPages(ctx *siteRenderContext) error {
@@ -78,9 +98,12 @@ func (s *Site) renderPages(ctx *siteRenderContext) error {
 
 	cfg := ctx.cfg
 
+	// This needs to be resolved before any page gets rendered.
+	s.h.leafChanges(cfg)
+
 	s.pageMap.pageTrees.Walk(func(ss string, n *contentNode) bool {
 
-		if cfg.shouldRender(n.p) {
+		if cfg.shouldRender(n.p) && s.ownsPage(n.p) && !s.h.canSkipListRender(cfg, n.p) {
 			select {
 			case <-s.h.Done():
 				return true
@@ -125,6 +148,24 @@ func pageRenderer(
 			continue
 		}
 
+		if s.rc.Format.Name == output.SearchIndexFormat.Name {
+			// The search index is built in Go and not from templates.
+			if err := s.renderSearchIndex(p); err != nil {
+				results <- err
+			}
+			continue
+		}
+
+		if s.rc.Format.Name == output.LLMSFormat.Name || s.rc.Format.Name == output.LLMSFullFormat.Name {
+			// The llms.txt exports are built in Go and not from templates.
+			if err := s.renderLLMSTxt(p); err != nil {
+				results <- err
+			}
+			continue
+		}
+
+		s.h.startListRender(p)
+
 		templ, found, err := p.resolveTemplate()
 		if err != nil {
 			s.SendError(p.errorf(err, "failed to resolve template"))
@@ -138,8 +179,22 @@ func pageRenderer(
 
 		targetPath := p.targetPaths().TargetFilename
 
+		if s.rc.Format.Name == output.RSSFormat.Name && s.canSkipListRender(ctx.cfg, targetPath, p.rssPages(), true) {
+			continue
+		}
+
+		var start time.Time
+		if s.Metrics != nil {
+			start = time.Now()
+		}
+
 		if err := s.renderAndWritePage(&s.PathSpec.ProcessingStats.Pages, "page "+p.Title(), targetPath, p, templ); err != nil {
 			results <- err
+		} else {
+			s.h.addPDFTarget(s.rc.Format.Name, targetPath)
+			s.h.addIndexerDocument(p, s.rc.Format.Name, targetPath)
+			s.h.addLinkCheckDocument(p, s.rc.Format.Name, targetPath)
+			s.h.addAuditTarget(p, s.rc.Format.Name, targetPath)
 		}
 
 		if p.paginator != nil && p.paginator.current != nil {
@@ -147,6 +202,12 @@ func pageRenderer(
 				results <- err
 			}
 		}
+
+		if s.Metrics != nil {
+			s.Metrics.MeasurePageSince(p.Kind(), s.rc.Format.Name, start)
+		}
+
+		s.h.setListSignature(p)
 	}
 }
 
@@ -196,6 +257,20 @@ func (s *Site) renderPaginator(p *pageState, templ tpl.Template) error {
 		panic(fmt.Sprintf("invalid paginator state for %q", p.pathOrTitle()))
 	}
 
+	if groupPagers := p.paginator.current.GroupPagers(); groupPagers != nil {
+		// The first pager of the first group is already rendered to the page itself.
+		for _, first := range groupPagers {
+			for _, current := range first.Pagers() {
+				p.paginator.current = current
+				if err := s.renderPaginatorPage(p, templ, d, current); err != nil {
+					return err
+				}
+			}
+		}
+		p.paginator.current = groupPagers[0]
+		return nil
+	}
+
 	if f.IsHTML {
 		// Write alias for page 1
 		d.Addends = fmt.Sprintf("/%s/%d", paginatePath, 1)
@@ -225,6 +300,36 @@ func (s *Site) renderPaginator(p *pageState, templ tpl.Template) error {
 	return nil
 }
 
+// renderPaginatorPage renders the given pager created by PaginateGroups below
+// its group path, e.g. /blog/2024/page/2/.
+func (s *Site) renderPaginatorPage(p *pageState, templ tpl.Template, d page.TargetPathDescriptor, current *page.Pager) error {
+	paginatePath := s.conf.PaginatePath
+	groupPath := current.GroupPath()
+
+	if current.PageNumber() == 1 {
+		d.Addends = "/" + groupPath
+	} else {
+		d.Addends = fmt.Sprintf("/%s/%s/%d", groupPath, paginatePath, current.PageNumber())
+	}
+
+	targetPaths := page.CreateTargetPaths(d)
+
+	if current.PageNumber() == 1 && d.Type.IsHTML {
+		// Write alias for page 1
+		aliasDescriptor := d
+		aliasDescriptor.Addends = fmt.Sprintf("/%s/%s/%d", groupPath, paginatePath, 1)
+		aliasTargetPaths := page.CreateTargetPaths(aliasDescriptor)
+		if err := s.writeDestAlias(aliasTargetPaths.TargetFilename, targetPaths.PermalinkForOutputFormat(s.PathSpec, d.Type), d.Type, p); err != nil {
+			return err
+		}
+	}
+
+	return s.renderAndWritePage(
+		&s.PathSpec.ProcessingStats.PaginatorPages,
+		p.Title(),
+		targetPaths.TargetFilename, p, templ)
+}
+
 func (s *Site) render404() error {
 	p, err := newPageStandalone(&pageMeta{
 		s:    s,
@@ -263,7 +368,7 @@ func (s *Site) render404() error {
 	return s.renderAndWritePage(&s.PathSpec.ProcessingStats.Pages, "404 page", targetPath, p, templ)
 }
 
-func (s *Site) renderSitemap() error {
+func (s *Site) renderSitemap(rc *siteRenderContext) error {
 	p, err := newPageStandalone(&pageMeta{
 		s:    s,
 		kind: kindSitemap,
@@ -288,6 +393,10 @@ func (s *Site) renderSitemap() error {
 		return errors.New("failed to create targetPath for sitemap")
 	}
 
+	if s.canSkipListRender(rc.cfg, targetPath, p.Pages(), false) {
+		return nil
+	}
+
 	templ := s.lookupLayouts("sitemap.xml", "_default/sitemap.xml", "_internal/_default/sitemap.xml")
 
 	return s.renderAndWriteXML(ctx, &s.PathSpec.ProcessingStats.Sitemaps, "sitemap", targetPath, p, templ)
@@ -319,9 +428,257 @@ func (s *Site) renderRobotsTXT() error {
 	return s.renderAndWritePage(&s.PathSpec.ProcessingStats.Pages, "Robots Txt", p.targetPaths().TargetFilename, p, templ)
 }
 
+// renderSearchIndex renders the search index for the pages below p.
+func (s *Site) renderSearchIndex(p *pageState) error {
+	var pages page.Pages
+	switch p.Kind() {
+	case page.KindHome:
+		pages = s.RegularPages()
+	case page.KindSection:
+		pages = p.RegularPagesRecursive()
+	case page.KindPage:
+		pages = page.Pages{p}
+	default:
+		pages = p.RegularPages()
+	}
+
+	ctx := context.Background()
+	indexer := searchindex.NewIndexer(s.conf.SearchIndex)
+	entries := make([]searchindex.Entry, 0, len(pages))
+
+	for _, pp := range pages {
+		e := searchindex.Entry{
+			Title:     pp.Title(),
+			Summary:   strings.TrimSpace(html.UnescapeString(tpl.StripHTML(string(pp.Summary(ctx))))),
+			Permalink: pp.Permalink(),
+			Tags:      cast.ToStringSlice(pp.Params()["tags"]),
+		}
+		headings := pp.Fragments(ctx).Headings.FilterBy(func(h *tableofcontents.Heading) bool {
+			return h.Title != ""
+		})
+		for _, h := range headings {
+			e.Headings = append(e.Headings, h.Title)
+		}
+		entries = append(entries, indexer.Index(e))
+	}
+
+	b := bp.GetBuffer()
+	defer bp.PutBuffer(b)
+
+	if err := indexer.Write(b, entries); err != nil {
+		return p.errorf(err, "failed to write search index")
+	}
+
+	pd := publisher.Descriptor{
+		Src:          b,
+		TargetPath:   p.targetPaths().TargetFilename,
+		StatCounter:  &s.PathSpec.ProcessingStats.Pages,
+		OutputFormat: s.rc.Format,
+	}
+
+	return s.publisher.Publish(pd)
+}
+
+// renderLLMSTxt renders the llms.txt export of the pages below p.
+func (s *Site) renderLLMSTxt(p *pageState) error {
+	var pages page.Pages
+	switch p.Kind() {
+	case page.KindHome:
+		pages = s.RegularPages()
+	case page.KindSection:
+		pages = p.RegularPagesRecursive()
+	case page.KindPage:
+		pages = page.Pages{p}
+	default:
+		pages = p.RegularPages()
+	}
+
+	ctx := context.Background()
+	conf := s.conf.LLMSTxt
+	full := s.rc.Format.Name == output.LLMSFullFormat.Name
+
+	doc := llmstxt.Document{
+		Title:       p.Title(),
+		Description: conf.Description,
+	}
+	if p.IsHome() {
+		doc.Title = s.Title()
+	}
+	if doc.Description == "" {
+		doc.Description = p.Description()
+	}
+
+	// Group the pages by section, keeping the pages in the root section
+	// first and without a heading.
+	sectionIndex := make(map[page.Page]int)
+	doc.Sections = []llmstxt.Section{{}}
+	for _, pp := range pages {
+		if !conf.Includes(pp.Pathc()) {
+			continue
+		}
+		e := llmstxt.Entry{
+			Title:       pp.LinkTitle(),
+			Description: pp.Description(),
+			Permalink:   pp.Permalink(),
+		}
+		if full {
+			if conf.Content == llmstxt.ContentPlain {
+				e.Content = pp.Plain(ctx)
+			} else {
+				e.Content = pp.RawContent()
+			}
+		}
+
+		section := pp.CurrentSection()
+		i := 0
+		if !section.IsHome() && section != p {
+			var found bool
+			if i, found = sectionIndex[section]; !found {
+				i = len(doc.Sections)
+				sectionIndex[section] = i
+				doc.Sections = append(doc.Sections, llmstxt.Section{Title: section.LinkTitle()})
+			}
+		}
+		doc.Sections[i].Entries = append(doc.Sections[i].Entries, e)
+	}
+
+	b := bp.GetBuffer()
+	defer bp.PutBuffer(b)
+
+	write := llmstxt.WriteIndex
+	if full {
+		write = llmstxt.WriteFull
+	}
+	if err := write(b, doc); err != nil {
+		return p.errorf(err, "failed to write llms.txt")
+	}
+
+	pd := publisher.Descriptor{
+		Src:          b,
+		TargetPath:   p.targetPaths().TargetFilename,
+		StatCounter:  &s.PathSpec.ProcessingStats.Pages,
+		OutputFormat: s.rc.Format,
+	}
+
+	return s.publisher.Publish(pd)
+}
+
+// renderWellKnown publishes the files configured in the wellKnown config section
+// below basePath.
+func (s *Site) renderWellKnown(basePath string) error {
+	conf := s.conf.WellKnown
+	if conf.IsZero() {
+		return nil
+	}
+
+	files, warnings, err := conf.Files(s.conf.C.Clock)
+	if err != nil {
+		return err
+	}
+	for _, w := range warnings {
+		s.Log.Warnln(w)
+	}
+
+	for _, f := range files {
+		outputFormat := output.RobotsTxtFormat
+		if f.IsJSON {
+			outputFormat = output.JSONFormat
+		}
+		pd := publisher.Descriptor{
+			Src:          bytes.NewReader(f.Content),
+			TargetPath:   filepath.Join(basePath, wellknown.Dir, f.Name),
+			StatCounter:  &s.PathSpec.ProcessingStats.Files,
+			OutputFormat: outputFormat,
+		}
+		if err := s.publisher.Publish(pd); err != nil {
+			return err
+		}
+	}
+
+	if conf.ChangePassword.URL != "" {
+		html, found := s.conf.OutputFormats.Config.GetByName("html")
+		if !found {
+			return nil
+		}
+		target := s.PathSpec.AbsURL(conf.ChangePassword.URL, false)
+		if err := s.writeDestAlias(path.Join(basePath, wellknown.Dir, wellknown.ChangePasswordName), target, html, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// renderIndieWeb publishes the ActivityPub and WebFinger files configured in
+// the indieWeb config section below basePath.
+func (s *Site) renderIndieWeb(basePath string) error {
+	conf := s.conf.IndieWeb
+	if !conf.ActivityPub.Enabled() {
+		return nil
+	}
+
+	ctx := context.Background()
+	posts := make(map[string][]indieweb.Post)
+	for _, p := range s.RegularPages().ByDate().Reverse() {
+		if !conf.ActivityPub.RenderSection(p.Section()) {
+			continue
+		}
+
+		authors := cast.ToStringSlice(p.Params()["authors"])
+		if len(authors) == 0 {
+			if author := cast.ToString(p.Params()["author"]); author != "" {
+				authors = []string{author}
+			} else {
+				authors = []string{conf.ActivityPub.DefaultAuthor}
+			}
+		}
+
+		content, err := p.Content(ctx)
+		if err != nil {
+			return err
+		}
+		post := indieweb.Post{
+			Permalink: p.Permalink(),
+			Title:     p.Title(),
+			Summary:   string(p.Summary(ctx)),
+			Content:   cast.ToString(content),
+			Published: p.PublishDate(),
+			Updated:   p.Lastmod(),
+			Tags:      cast.ToStringSlice(p.Params()["tags"]),
+		}
+		if post.Published.IsZero() {
+			post.Published = p.Date()
+		}
+		for _, author := range authors {
+			author = strings.ToLower(author)
+			posts[author] = append(posts[author], post)
+		}
+	}
+
+	files, err := conf.ActivityPubFiles(s.PathSpec.AbsURL("/", false), posts)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		pd := publisher.Descriptor{
+			Src:          bytes.NewReader(f.Content),
+			TargetPath:   filepath.Join(basePath, filepath.FromSlash(f.Path)),
+			StatCounter:  &s.PathSpec.ProcessingStats.Files,
+			OutputFormat: output.JSONFormat,
+		}
+		if err := s.publisher.Publish(pd); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // renderAliases renders shell pages that simply have a redirect in the header.
 func (s *Site) renderAliases() error {
 	var err error
+	s.redirects = nil
 	s.pageMap.pageTrees.WalkLinkable(func(ss string, n *contentNode) bool {
 		p := n.p
 		if len(p.Aliases()) == 0 {
@@ -362,6 +719,21 @@ func (s *Site) renderAliases() error {
 					a += ".html"
 				}
 
+				if !s.h.Configs.Base.Redirects.IsZero() {
+					s.redirects = append(s.redirects, redirects.Redirect{
+						From:   s.redirectFrom(a),
+						To:     of.RelPermalink(),
+						Status: s.h.Configs.Base.Redirects.Status,
+						Source: p.pathOrTitle(),
+					})
+				}
+
+				if !s.ownsPage(p) {
+					// The redirects above are collected for all pages,
+					// the alias files are written by the page's shard.
+					continue
+				}
+
 				lang := p.Language().Lang
 
 				if s.h.Configs.IsMultihost && !strings.HasPrefix(a, "/"+lang) {
@@ -388,6 +760,10 @@ func (s *Site) renderMainLanguageRedirect() error {
 		// No need for a redirect
 		return nil
 	}
+	if s.conf.IsLangDisabled(s.conf.DefaultContentLanguage) {
+		// Nothing to redirect to.
+		return nil
+	}
 
 	html, found := s.conf.OutputFormats.Config.GetByName("html")
 	if found {
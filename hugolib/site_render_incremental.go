@@ -0,0 +1,299 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sync"
+
+	"github.com/gohugoio/hugo/output"
+	"github.com/gohugoio/hugo/resources/page"
+	"github.com/gohugoio/hugo/tpl"
+)
+
+// listFingerprints holds a fingerprint of the pages listed in the sitemaps and
+// RSS feeds rendered in the previous build, keyed by target path.
+// This is used in server mode to avoid re-rendering these on every change.
+type listFingerprints struct {
+	mu sync.Mutex
+	m  map[string]uint64
+}
+
+// set stores the fingerprint for targetPath and reports whether it is
+// unchanged since the previous build.
+func (f *listFingerprints) set(targetPath string, fingerprint uint64) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.m == nil {
+		f.m = make(map[string]uint64)
+	}
+	prev, found := f.m[targetPath]
+	f.m[targetPath] = fingerprint
+	return found && prev == fingerprint
+}
+
+// canSkipListRender reports whether the sitemap or RSS feed in targetPath,
+// listing pages, can be kept as rendered in the previous build.
+// This is only the case in server mode when only content files have changed
+// and the listing of the pages is unchanged. If withContent is set, a change
+// to the content file of any of the listed pages will also trigger a re-render.
+func (s *Site) canSkipListRender(cfg *BuildCfg, targetPath string, pages page.Pages, withContent bool) bool {
+	if !s.watching() {
+		return false
+	}
+
+	changed := cfg.whatChanged == nil || !cfg.whatChanged.contentOnly
+
+	h := fnv.New64a()
+	for _, p := range pages {
+		if withContent && !changed {
+			if f := p.File(); !f.IsZero() && cfg.whatChanged.files[f.Filename()] {
+				changed = true
+			}
+		}
+
+		sm := p.Sitemap()
+		fmt.Fprintf(h, "%s|%s|%d|%d|%s|%f|%s", p.Permalink(), p.Title(), p.Date().UnixNano(), p.Lastmod().UnixNano(), sm.ChangeFreq, sm.Priority, sm.Filename)
+		for _, t := range p.Translations() {
+			fmt.Fprintf(h, "|%s", t.Permalink())
+		}
+		fmt.Fprintln(h)
+	}
+
+	unchanged := s.listFingerprints.set(targetPath, h.Sum64())
+
+	return unchanged && !changed
+}
+
+// rssPages returns the pages that may be listed in the RSS feed of p.
+func (p *pageState) rssPages() page.Pages {
+	pages := page.Pages{p}
+	switch p.Kind() {
+	case page.KindHome:
+		pages = append(pages, p.s.Pages()...)
+	case page.KindSection:
+		pages = append(pages, p.Pages()...)
+		pages = append(pages, p.RegularPagesRecursive()...)
+	default:
+		pages = append(pages, p.Pages()...)
+	}
+	return pages
+}
+
+// listRenderState is used in server mode to skip re-rendering of list pages
+// not affected by changes to regular pages, see build.skipUnaffectedListPages.
+type listRenderState struct {
+	mu sync.Mutex
+
+	// The regular pages whose content was used when rendering a list page,
+	// keyed by list page.
+	contentRefs map[string]map[string]bool
+
+	// The summary and metadata signature of each regular page rendered.
+	signatures map[string]uint64
+
+	// Regular pages whose raw content has been used, or whose content has
+	// been used in a cached partial, which may be shared by several pages.
+	// We cannot tell by which list pages, so changes to these always
+	// re-render the list pages.
+	untrackedContentUsed map[string]bool
+}
+
+// listRenderKey returns the key used for list page p.
+func listRenderKey(p *pageState) string {
+	return p.Lang() + "|" + p.Kind() + "|" + p.RelPermalink()
+}
+
+// leafRenderKey returns the key used for regular page p, which is
+// stable across rebuilds.
+func leafRenderKey(p *pageState) string {
+	return p.Lang() + "|" + p.File().Filename()
+}
+
+func (h *HugoSites) skipUnaffectedListPages() bool {
+	return h.Configs.Base.Build.SkipUnaffectedListPages && h.Configs.Base.Internal.Watch
+}
+
+// trackContentRef records that the content of p is used by the page
+// being rendered in ctx, if that is a list page.
+func (p *pageState) trackContentRef(ctx context.Context) {
+	if !p.IsPage() || p.File().IsZero() || !p.s.h.skipUnaffectedListPages() {
+		return
+	}
+	if tpl.GetIsInCachedPartialFromContext(ctx) {
+		p.trackUntrackedContent()
+		return
+	}
+	v, _ := unwrapPage(tpl.GetPageFromContext(ctx))
+	rp, ok := v.(*pageState)
+	if !ok || !rp.IsNode() {
+		return
+	}
+
+	st := &p.s.h.listRender
+	key := listRenderKey(rp)
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.contentRefs[key] == nil {
+		return
+	}
+	st.contentRefs[key][leafRenderKey(p)] = true
+}
+
+// trackRawContent records that the raw content of p has been used.
+func (p *pageState) trackRawContent() {
+	if !p.IsPage() || p.File().IsZero() || !p.s.h.skipUnaffectedListPages() {
+		return
+	}
+	p.trackUntrackedContent()
+}
+
+// trackUntrackedContent records that the content of p has been used by
+// pages we cannot track.
+func (p *pageState) trackUntrackedContent() {
+	st := &p.s.h.listRender
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.untrackedContentUsed == nil {
+		st.untrackedContentUsed = make(map[string]bool)
+	}
+	st.untrackedContentUsed[leafRenderKey(p)] = true
+}
+
+// listSignature returns a hash of the summary and metadata of p, i.e.
+// what's typically used to list p on a list page.
+func (p *pageState) listSignature() uint64 {
+	ctx := context.Background()
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s|%s|%s|%s|%s|%s|%s|%d|%d|%d|%d|%d|%t|%t|%s|%v",
+		p.Title(), p.LinkTitle(), p.Description(), p.Type(), p.Layout(), p.Permalink(), p.Summary(ctx),
+		p.Date().UnixNano(), p.Lastmod().UnixNano(), p.PublishDate().UnixNano(), p.ExpiryDate().UnixNano(), p.Weight(),
+		p.Draft(), p.Truncated(ctx), p.Keywords(), p.Params())
+	for _, r := range p.Resources() {
+		fmt.Fprintf(h, "|%s|%s", r.Name(), r.Title())
+	}
+	return h.Sum64()
+}
+
+// setListSignature stores the signature of the regular page p after it has been rendered.
+func (h *HugoSites) setListSignature(p *pageState) {
+	if !p.IsPage() || p.File().IsZero() || !h.skipUnaffectedListPages() {
+		return
+	}
+	sig := p.listSignature()
+	st := &h.listRender
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.signatures == nil {
+		st.signatures = make(map[string]uint64)
+	}
+	st.signatures[leafRenderKey(p)] = sig
+}
+
+// startListRender is called before list page p is rendered to start
+// tracking the content it uses.
+func (h *HugoSites) startListRender(p *pageState) {
+	if !p.IsNode() || !h.skipUnaffectedListPages() {
+		return
+	}
+	st := &h.listRender
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.contentRefs == nil {
+		st.contentRefs = make(map[string]map[string]bool)
+	}
+	if st.contentRefs[listRenderKey(p)] == nil {
+		st.contentRefs[listRenderKey(p)] = make(map[string]bool)
+	}
+}
+
+// canSkipListRender reports whether the list page p is unaffected by the
+// changes in this build and can be left as rendered in a previous build.
+func (h *HugoSites) canSkipListRender(cfg *BuildCfg, p *pageState) bool {
+	switch p.s.rc.Format.Name {
+	case output.SearchIndexFormat.Name, output.LLMSFormat.Name, output.LLMSFullFormat.Name:
+		// These are built from the content of the pages.
+		return false
+	}
+	if !p.IsNode() {
+		return false
+	}
+	changes := h.leafChanges(cfg)
+	if changes == nil {
+		return false
+	}
+	st := &h.listRender
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	refs, found := st.contentRefs[listRenderKey(p)]
+	if !found {
+		// Not rendered before.
+		return false
+	}
+	for k := range changes {
+		if refs[k] {
+			return false
+		}
+	}
+	return true
+}
+
+// leafChanges returns the regular pages changed in this build without
+// any change to their summary or metadata.
+// It returns nil if anything else changed.
+func (h *HugoSites) leafChanges(cfg *BuildCfg) map[string]bool {
+	if cfg.leafChangesInit {
+		return cfg.leafChanges
+	}
+	cfg.leafChangesInit = true
+
+	if !h.skipUnaffectedListPages() || cfg.whatChanged == nil || !cfg.whatChanged.contentOnly || len(cfg.whatChanged.files) == 0 {
+		return nil
+	}
+
+	var changed []*pageState
+	filenames := make(map[string]bool)
+	for _, s := range h.Sites {
+		for _, p := range s.RegularPages() {
+			if f := p.File(); !f.IsZero() && cfg.whatChanged.files[f.Filename()] {
+				changed = append(changed, p.(*pageState))
+				filenames[f.Filename()] = true
+			}
+		}
+	}
+	if len(filenames) != len(cfg.whatChanged.files) {
+		// Some of the changed files are not regular pages, or have been removed.
+		return nil
+	}
+
+	changes := make(map[string]bool)
+	for _, p := range changed {
+		key := leafRenderKey(p)
+		sig := p.listSignature()
+		h.listRender.mu.Lock()
+		prev, found := h.listRender.signatures[key]
+		untrackedContentUsed := h.listRender.untrackedContentUsed[key]
+		h.listRender.mu.Unlock()
+		if !found || prev != sig || untrackedContentUsed {
+			return nil
+		}
+		changes[key] = true
+	}
+
+	cfg.leafChanges = changes
+
+	return changes
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugolib
+
+import "testing"
+
+func TestSiteTranslationStatus(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap"]
+defaultContentLanguage = "en"
+[languages]
+[languages.en]
+weight = 1
+[languages.nn]
+weight = 2
+[languages.sv]
+weight = 3
+-- content/p1.en.md --
+---
+title: "P1"
+lastmod: 2024-02-01
+---
+One two three four.
+-- content/p1.nn.md --
+---
+title: "P1 NN"
+lastmod: 2024-01-02
+---
+Ein to.
+-- content/p1.sv.md --
+---
+title: "P1 SV"
+lastmod: 2024-03-01
+---
+Ett två tre.
+-- content/p2.en.md --
+---
+title: "P2"
+lastmod: 2024-01-01
+---
+Only English here.
+-- layouts/index.html --
+{{ with .Site.TranslationStatus }}
+Lang: {{ .Lang }}|
+{{ range .Pages }}{{ .Page.Title }}: words: {{ .WordCount }}|missing: {{ .Missing }}|stale: {{ .Stale }}|{{ range .Translations }}{{ .Lang }}:{{ .Translated }}:{{ .WordCount }}:{{ .LastmodDelta }}:{{ .Stale }}|{{ end }}
+{{ end }}
+{{ range .Languages }}{{ .Lang }}: total: {{ .Total }}|translated: {{ .Translated }}|missing: {{ .Missing }}|stale: {{ .Stale }}|missingWords: {{ .MissingWords }}|percent: {{ .Percent }}|
+{{ end }}
+{{ end }}
+-- layouts/_default/single.html --
+{{ .Title }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html",
+		"Lang: en|",
+		"P1: words: 4|missing: []|stale: [nn]|nn:true:2:720h0m0s:true|sv:true:3:-696h0m0s:false|",
+		"P2: words: 3|missing: [nn sv]|stale: []|nn:false:0:0s:false|sv:false:0:0s:false|",
+		"nn: total: 2|translated: 1|missing: 1|stale: 1|missingWords: 3|percent: 50|",
+		"sv: total: 2|translated: 1|missing: 1|stale: 0|missingWords: 3|percent: 50|",
+	)
+
+	b.AssertFileContent("public/nn/index.html",
+		"Lang: nn|",
+		"P1 NN: words: 2|missing: []|stale: []|en:true:4:-720h0m0s:false|sv:true:3:-1416h0m0s:false|",
+	)
+}
@@ -735,3 +735,180 @@ tags_weight: 40
 
 	b.AssertFileContent("public/index.html", `:/p1/|/p3/|/p2/|:`)
 }
+
+func TestTaxonomiesTermData(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["RSS", "sitemap", "robotsTXT", "404"]
+-- data/taxonomies/tags/go.yaml --
+title: The Go Language
+icon: gopher.svg
+description: From data.
+-- data/taxonomies/tags/hugo.toml --
+icon = "hugo.svg"
+-- content/tags/go/_index.md --
+---
+description: From front matter.
+---
+-- content/p1.md --
+---
+title: P1
+tags: ['Go', 'Hugo']
+---
+-- layouts/_default/single.html --
+{{ .Title }}
+-- layouts/_default/list.html --
+{{ .Title }}
+-- layouts/_default/term.html --
+Title: {{ .Title }}|Icon: {{ .Params.icon }}|Description: {{ .Description }}|
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/tags/go/index.html", "Title: The Go Language|Icon: gopher.svg|Description: From front matter.|")
+	b.AssertFileContent("public/tags/hugo/index.html", "Title: Hugo|Icon: hugo.svg|Description: |")
+
+	files = strings.Replace(files, `disableKinds`, "strictTaxonomyData = true\ndisableKinds", 1)
+	files = strings.Replace(files, `tags: ['Go', 'Hugo']`, `tags: ['Go', 'Hugo', 'Rust']`, 1)
+
+	b, err := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `term "rust" in taxonomy "tags" has no definition in data/taxonomies/tags/rust`)
+}
+
+func TestTaxonomiesTermDataAndCascade(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["RSS", "sitemap", "robotsTXT", "404"]
+-- data/taxonomies/tags/go.yaml --
+icon: gopher.svg
+color: blue
+-- content/tags/_index.md --
+---
+title: Tags
+cascade:
+  icon: default.svg
+  color: gray
+  shape: circle
+---
+-- content/tags/go/_index.md --
+---
+color: green
+---
+-- content/p1.md --
+---
+title: P1
+tags: ['Go', 'Hugo']
+---
+-- layouts/_default/single.html --
+{{ .Title }}
+-- layouts/_default/list.html --
+{{ .Title }}
+-- layouts/_default/term.html --
+Icon: {{ .Params.icon }}|Color: {{ .Params.color }}|Shape: {{ .Params.shape }}|
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	// Front matter wins over term data, which wins over the cascade.
+	b.AssertFileContent("public/tags/go/index.html", "Icon: gopher.svg|Color: green|Shape: circle|")
+	b.AssertFileContent("public/tags/hugo/index.html", "Icon: default.svg|Color: gray|Shape: circle|")
+}
+
+func TestTaxonomySort(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["RSS", "sitemap", "robotsTXT", "404"]
+[taxonomies]
+tag = "tags"
+category = "categories"
+series = "series"
+[taxonomySort]
+tags = "count"
+categories = "date"
+[taxonomySort.series]
+by = "params.rank"
+-- content/p1.md --
+---
+title: P1
+date: 2023-01-01
+tags: ['a', 'b', 'c']
+categories: ['x']
+series: ['s1', 's2', 's3']
+---
+-- content/p2.md --
+---
+title: P2
+date: 2023-03-01
+tags: ['b', 'c']
+categories: ['y']
+---
+-- content/p3.md --
+---
+title: P3
+date: 2023-02-01
+tags: ['b']
+categories: ['x', 'z']
+---
+-- content/series/s1/_index.md --
+---
+rank: 10
+---
+-- content/series/s3/_index.md --
+---
+rank: 2
+---
+-- layouts/_default/single.html --
+{{ .Title }}
+-- layouts/_default/list.html --
+{{ .Title }}
+-- layouts/_default/terms.html --
+{{ range .Pages }}{{ .Data.Term }}|{{ end }}
+`
+
+	b := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/tags/index.html", "b|c|a|")
+	b.AssertFileContent("public/categories/index.html", "y|x|z|")
+	// Terms without the param go last.
+	b.AssertFileContent("public/series/index.html", "s3|s1|s2|")
+
+	files = strings.Replace(files, `tags = "count"`, `tags = "weight"`, 1)
+
+	_, err := NewIntegrationTestBuilder(
+		IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `taxonomySort: invalid by "weight" for "tags"`)
+}
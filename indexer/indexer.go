@@ -0,0 +1,162 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package indexer feeds the published pages to an external search indexer,
+// e.g. Pagefind or Typesense, after the build.
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gohugoio/hugo/common/hexec"
+	"github.com/gohugoio/hugo/common/hexternal"
+	"github.com/gohugoio/hugo/config"
+)
+
+const (
+	indexerConfigKey = "indexer"
+
+	// OnErrorFail fails the build when the indexer fails.
+	OnErrorFail = "fail"
+	// OnErrorWarn logs a warning when the indexer fails.
+	OnErrorWarn = "warn"
+	// OnErrorIgnore ignores indexer failures.
+	OnErrorIgnore = "ignore"
+)
+
+// Config configures the external search indexer run after the build.
+// It is enabled when either Command or URL is set.
+//
+// The Formats default to ["html"].
+//
+// A Command, e.g. ["pagefind", "--site", "public"], receives the Payload as
+// JSON on stdin. The HUGO_INDEXER_FULL environment variable is set to "true"
+// when all the pages are included.
+//
+// An indexer API at URL receives the Payload as JSON in the body of a POST
+// request.
+type Config struct {
+	hexternal.Config `mapstructure:",squash"`
+
+	// Whether to only send the pages rendered in a rebuild in server mode.
+	// Defaults to true.
+	Incremental bool
+
+	// What to do when the indexer fails: fail (default), warn or ignore.
+	OnError string
+}
+
+func newDefaultConfig() Config {
+	return Config{
+		Config: hexternal.Config{
+			Formats: []string{"html"},
+			Timeout: "60s",
+		},
+		Incremental: true,
+		OnError:     OnErrorFail,
+	}
+}
+
+// DecodeConfig creates a Config from a given Hugo configuration.
+func DecodeConfig(cfg config.Provider) (Config, error) {
+	c := newDefaultConfig()
+
+	if err := hexternal.DecodeConfig(cfg, indexerConfigKey, &c, &c.Config); err != nil {
+		return c, err
+	}
+
+	c.OnError = strings.ToLower(c.OnError)
+	switch c.OnError {
+	case OnErrorFail, OnErrorWarn, OnErrorIgnore:
+	default:
+		return c, fmt.Errorf("indexer: invalid onError %q, must be one of %q, %q or %q", c.OnError, OnErrorFail, OnErrorWarn, OnErrorIgnore)
+	}
+
+	return c, nil
+}
+
+// Payload is sent to the indexer.
+type Payload struct {
+	// Whether Documents holds all the pages, or only the pages rendered in
+	// a rebuild.
+	Full bool `json:"full"`
+
+	// The absolute path to the publish directory.
+	PublishDir string `json:"publishDir"`
+
+	Documents []Document `json:"documents"`
+}
+
+// Document is a published page.
+type Document struct {
+	// The published file relative to the publish directory, e.g. "docs/intro/index.html".
+	File string `json:"file"`
+
+	Permalink    string     `json:"permalink"`
+	RelPermalink string     `json:"relPermalink"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description,omitempty"`
+	Summary      string     `json:"summary,omitempty"`
+	Lang         string     `json:"lang"`
+	Kind         string     `json:"kind"`
+	Section      string     `json:"section,omitempty"`
+	Date         *time.Time `json:"date,omitempty"`
+	Lastmod      *time.Time `json:"lastmod,omitempty"`
+	Tags         []string   `json:"tags,omitempty"`
+	Keywords     []string   `json:"keywords,omitempty"`
+	Weight       int        `json:"weight,omitempty"`
+}
+
+// Indexer runs the configured indexer.
+type Indexer struct {
+	*hexternal.Runner
+	conf Config
+}
+
+// New creates a new Indexer for the given config.
+// It returns nil if the indexer is not enabled.
+func New(conf Config, exec *hexec.Exec) (*Indexer, error) {
+	runner, err := hexternal.NewRunner("indexer API", conf.Config, exec)
+	if err != nil || runner == nil {
+		return nil, err
+	}
+	return &Indexer{Runner: runner, conf: conf}, nil
+}
+
+// Config returns the indexer config.
+func (idx *Indexer) Config() Config {
+	return idx.conf
+}
+
+// Run sends p to the indexer.
+// env is the environment passed to the indexer command.
+func (idx *Indexer) Run(p Payload, env []string) error {
+	if p.Documents == nil {
+		p.Documents = []Document{}
+	}
+	b, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	_, err = idx.Runner.Run(hexternal.Request{
+		Body:        b,
+		ContentType: "application/json",
+		Env:         append(env, fmt.Sprintf("HUGO_INDEXER_FULL=%t", p.Full)),
+	})
+
+	return err
+}
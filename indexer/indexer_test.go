@@ -0,0 +1,61 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/config"
+)
+
+func TestDecodeConfig(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := config.New()
+	conf, err := DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Enabled(), qt.IsFalse)
+	c.Assert(conf.Formats, qt.DeepEquals, []string{"html"})
+	c.Assert(conf.Incremental, qt.IsTrue)
+	c.Assert(conf.OnError, qt.Equals, OnErrorFail)
+	c.Assert(conf.Timeout, qt.Equals, "60s")
+
+	cfg.Set("indexer", map[string]any{
+		"command":     []string{"pagefind", "--site", "public"},
+		"formats":     []string{"HTML", "amp"},
+		"incremental": false,
+		"onError":     "Warn",
+	})
+	conf, err = DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Enabled(), qt.IsTrue)
+	c.Assert(conf.Formats, qt.DeepEquals, []string{"html", "amp"})
+	c.Assert(conf.Incremental, qt.IsFalse)
+	c.Assert(conf.OnError, qt.Equals, OnErrorWarn)
+
+	for _, test := range []struct {
+		m      map[string]any
+		expect string
+	}{
+		{map[string]any{"command": []string{"pagefind"}, "url": "http://localhost:8108"}, "indexer: only one of command and url can be set"},
+		{map[string]any{"onError": "retry"}, `indexer: invalid onError "retry".*`},
+		{map[string]any{"timeout": "forever"}, "indexer: failed to parse timeout.*"},
+	} {
+		cfg = config.New()
+		cfg.Set("indexer", test.m)
+		_, err = DecodeConfig(cfg)
+		c.Assert(err, qt.ErrorMatches, test.expect)
+	}
+}
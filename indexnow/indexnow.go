@@ -0,0 +1,360 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package indexnow notifies search engines about the pages added, changed or
+// removed in a build, using the IndexNow protocol and sitemap pings.
+package indexnow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gohugoio/hugo/common/hexec"
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/config"
+	"github.com/mitchellh/mapstructure"
+)
+
+const (
+	indexNowConfigKey = "indexnow"
+
+	// DefaultEndpoint is the shared IndexNow endpoint, which forwards the
+	// submitted URLs to all the participating search engines.
+	DefaultEndpoint = "https://api.indexnow.org/indexnow"
+
+	// SitemapPlaceholder is replaced with the query escaped sitemap URL in
+	// the ping URLs.
+	SitemapPlaceholder = "{sitemap}"
+
+	// maxBatchSize is the maximum number of URLs in one IndexNow request.
+	maxBatchSize = 10000
+)
+
+var keyRe = regexp.MustCompile(`^[a-zA-Z0-9-]{8,128}$`)
+
+// Config configures the notification of search engines after the build.
+type Config struct {
+	// Whether to submit the changed URLs after the build.
+	// The changes are computed from the previous build manifest, so
+	// build.manifest must be set.
+	Enable bool
+
+	// The IndexNow key, 8 to 128 characters of a-z, A-Z, 0-9 and dashes.
+	Key string
+
+	// The URL of the key file.
+	// If not set, Hugo publishes the key file as <key>.txt to the root of
+	// each host.
+	KeyLocation string
+
+	// The IndexNow endpoints. Defaults to ["https://api.indexnow.org/indexnow"].
+	// The endpoints must be allowed by the security.http policies.
+	Endpoints []string
+
+	// URLs requested with GET for each sitemap when there are changes, with
+	// {sitemap} replaced by the sitemap URL, e.g.
+	// "https://example.com/ping?sitemap={sitemap}".
+	// The URLs must be allowed by the security.http policies.
+	PingURLs []string
+
+	// The output formats of the pages to submit. Defaults to ["html"].
+	Formats []string
+
+	// The maximum number of URLs in one request. Defaults to 10000, which is
+	// also the maximum allowed by IndexNow.
+	BatchSize int
+
+	// The minimum time between two requests. Defaults to 1s.
+	Interval string
+
+	// The timeout for one request. Defaults to 30s.
+	Timeout string
+
+	// Whether to log the requests instead of sending them.
+	DryRun bool
+}
+
+func newDefaultConfig() Config {
+	return Config{
+		Endpoints: []string{DefaultEndpoint},
+		Formats:   []string{"html"},
+		BatchSize: maxBatchSize,
+		Interval:  "1s",
+		Timeout:   "30s",
+	}
+}
+
+// DecodeConfig creates a Config from a given Hugo configuration.
+func DecodeConfig(cfg config.Provider) (Config, error) {
+	c := newDefaultConfig()
+
+	m := cfg.GetStringMap(indexNowConfigKey)
+	if m == nil {
+		return c, nil
+	}
+	delete(m, maps.MergeStrategyKey)
+
+	if err := mapstructure.WeakDecode(m, &c); err != nil {
+		return c, fmt.Errorf("failed to decode indexNow config: %w", err)
+	}
+
+	for i, f := range c.Formats {
+		c.Formats[i] = strings.ToLower(f)
+	}
+
+	if !c.Enable {
+		return c, nil
+	}
+
+	if !keyRe.MatchString(c.Key) {
+		return c, errors.New("indexNow: key must be 8 to 128 characters of a-z, A-Z, 0-9 and dashes")
+	}
+	if c.BatchSize <= 0 || c.BatchSize > maxBatchSize {
+		return c, fmt.Errorf("indexNow: batchSize must be between 1 and %d", maxBatchSize)
+	}
+	for _, u := range c.PingURLs {
+		if !strings.Contains(u, SitemapPlaceholder) {
+			return c, fmt.Errorf("indexNow: ping URL %q must contain %s", u, SitemapPlaceholder)
+		}
+	}
+	if _, err := time.ParseDuration(c.Interval); err != nil {
+		return c, fmt.Errorf("indexNow: failed to parse interval: %w", err)
+	}
+	if _, err := time.ParseDuration(c.Timeout); err != nil {
+		return c, fmt.Errorf("indexNow: failed to parse timeout: %w", err)
+	}
+
+	return c, nil
+}
+
+// KeyFilename returns the name of the key file published by Hugo, or an
+// empty string if the key file is hosted elsewhere.
+func (c Config) KeyFilename() string {
+	if !c.Enable || c.KeyLocation != "" {
+		return ""
+	}
+	return c.Key + ".txt"
+}
+
+// Request is a request to an IndexNow endpoint or a sitemap ping URL.
+type Request struct {
+	Method string
+	URL    string
+
+	// The JSON body, nil for a ping.
+	Body []byte
+
+	// The submitted URLs, or the sitemap URL for a ping.
+	URLs []string
+}
+
+// Submitter sends the changed URLs to the configured endpoints.
+type Submitter struct {
+	conf       Config
+	interval   time.Duration
+	timeout    time.Duration
+	httpClient *http.Client
+	formats    map[string]bool
+
+	// Used in tests.
+	sleep func(time.Duration)
+}
+
+// New creates a new Submitter for the given config.
+// It returns nil if not enabled.
+func New(conf Config, exec *hexec.Exec) (*Submitter, error) {
+	if !conf.Enable {
+		return nil, nil
+	}
+
+	interval, err := time.ParseDuration(conf.Interval)
+	if err != nil {
+		return nil, err
+	}
+	timeout, err := time.ParseDuration(conf.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if !conf.DryRun {
+		for _, u := range conf.Endpoints {
+			if err := exec.Sec().CheckAllowedHTTPURL(u); err != nil {
+				return nil, err
+			}
+		}
+		if len(conf.Endpoints) > 0 {
+			if err := exec.Sec().CheckAllowedHTTPMethod("POST"); err != nil {
+				return nil, err
+			}
+		}
+		for _, u := range conf.PingURLs {
+			if err := exec.Sec().CheckAllowedHTTPURL(strings.ReplaceAll(u, SitemapPlaceholder, "")); err != nil {
+				return nil, err
+			}
+		}
+		if len(conf.PingURLs) > 0 {
+			if err := exec.Sec().CheckAllowedHTTPMethod("GET"); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	s := &Submitter{
+		conf:       conf,
+		interval:   interval,
+		timeout:    timeout,
+		httpClient: &http.Client{},
+		formats:    make(map[string]bool),
+		sleep:      time.Sleep,
+	}
+	for _, f := range conf.Formats {
+		s.formats[f] = true
+	}
+
+	return s, nil
+}
+
+// Config returns the config.
+func (s *Submitter) Config() Config {
+	return s.conf
+}
+
+// HandlesFormat reports whether pages in the output format with the given
+// name should be submitted.
+func (s *Submitter) HandlesFormat(name string) bool {
+	return s.formats[name]
+}
+
+type submission struct {
+	Host        string   `json:"host"`
+	Key         string   `json:"key"`
+	KeyLocation string   `json:"keyLocation,omitempty"`
+	URLList     []string `json:"urlList"`
+}
+
+// Requests returns the requests submitting urls to each endpoint, grouped by
+// host in batches of at most BatchSize URLs, followed by a ping of each of
+// the sitemaps to each ping URL.
+// keyLocation returns the URL of the key file published for the given host
+// and is used when KeyLocation is not set in the config.
+func (s *Submitter) Requests(urls, sitemaps []string, keyLocation func(host string) string) ([]Request, error) {
+	if len(urls) == 0 {
+		return nil, nil
+	}
+
+	byHost := make(map[string][]string)
+	var hosts []string
+	for _, u := range urls {
+		pu, err := url.Parse(u)
+		if err != nil {
+			return nil, err
+		}
+		if pu.Host == "" {
+			return nil, fmt.Errorf("URL %q is not absolute, check baseURL", u)
+		}
+		if _, found := byHost[pu.Host]; !found {
+			hosts = append(hosts, pu.Host)
+		}
+		byHost[pu.Host] = append(byHost[pu.Host], u)
+	}
+	sort.Strings(hosts)
+
+	var reqs []Request
+	for _, host := range hosts {
+		hostURLs := byHost[host]
+		sort.Strings(hostURLs)
+		loc := s.conf.KeyLocation
+		if loc == "" && keyLocation != nil {
+			loc = keyLocation(host)
+		}
+		for i := 0; i < len(hostURLs); i += s.conf.BatchSize {
+			end := i + s.conf.BatchSize
+			if end > len(hostURLs) {
+				end = len(hostURLs)
+			}
+			batch := hostURLs[i:end]
+			b, err := json.Marshal(submission{Host: host, Key: s.conf.Key, KeyLocation: loc, URLList: batch})
+			if err != nil {
+				return nil, err
+			}
+			for _, endpoint := range s.conf.Endpoints {
+				reqs = append(reqs, Request{Method: "POST", URL: endpoint, Body: b, URLs: batch})
+			}
+		}
+	}
+
+	for _, sitemap := range sitemaps {
+		for _, u := range s.conf.PingURLs {
+			reqs = append(reqs, Request{
+				Method: "GET",
+				URL:    strings.ReplaceAll(u, SitemapPlaceholder, url.QueryEscape(sitemap)),
+				URLs:   []string{sitemap},
+			})
+		}
+	}
+
+	return reqs, nil
+}
+
+// Send sends reqs in order, waiting Interval between two requests.
+// It continues with the remaining requests when one fails and returns the
+// first error.
+func (s *Submitter) Send(reqs []Request) error {
+	var firstErr error
+	for i, r := range reqs {
+		if i > 0 && s.interval > 0 {
+			s.sleep(s.interval)
+		}
+		if err := s.send(r); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (s *Submitter) send(r Request) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	var body io.Reader
+	if r.Body != nil {
+		body = bytes.NewReader(r.Body)
+	}
+	req, err := http.NewRequestWithContext(ctx, r.Method, r.URL, body)
+	if err != nil {
+		return err
+	}
+	if r.Body != nil {
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	}
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		b, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("%s %s responded with %s: %s", r.Method, r.URL, res.Status, strings.TrimSpace(string(b)))
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnow
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/common/hexec"
+	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/config/security"
+)
+
+func TestDecodeConfig(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := config.New()
+	conf, err := DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Enable, qt.IsFalse)
+	c.Assert(conf.Endpoints, qt.DeepEquals, []string{DefaultEndpoint})
+	c.Assert(conf.Formats, qt.DeepEquals, []string{"html"})
+	c.Assert(conf.BatchSize, qt.Equals, 10000)
+	c.Assert(conf.KeyFilename(), qt.Equals, "")
+
+	cfg.Set("indexNow", map[string]any{
+		"enable":    true,
+		"key":       "0123456789abcdef",
+		"formats":   []string{"HTML", "amp"},
+		"batchSize": 2,
+		"pingURLs":  []string{"https://example.com/ping?sitemap={sitemap}"},
+	})
+	conf, err = DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Enable, qt.IsTrue)
+	c.Assert(conf.Formats, qt.DeepEquals, []string{"html", "amp"})
+	c.Assert(conf.BatchSize, qt.Equals, 2)
+	c.Assert(conf.KeyFilename(), qt.Equals, "0123456789abcdef.txt")
+
+	for _, test := range []struct {
+		m      map[string]any
+		expect string
+	}{
+		{map[string]any{"enable": true}, "indexNow: key must be.*"},
+		{map[string]any{"enable": true, "key": "short"}, "indexNow: key must be.*"},
+		{map[string]any{"enable": true, "key": "0123456789abcdef", "batchSize": 10001}, "indexNow: batchSize must be between 1 and 10000"},
+		{map[string]any{"enable": true, "key": "0123456789abcdef", "pingURLs": []string{"https://example.com/ping"}}, `indexNow: ping URL "https://example.com/ping" must contain {sitemap}`},
+		{map[string]any{"enable": true, "key": "0123456789abcdef", "interval": "often"}, "indexNow: failed to parse interval.*"},
+	} {
+		cfg = config.New()
+		cfg.Set("indexNow", test.m)
+		_, err = DecodeConfig(cfg)
+		c.Assert(err, qt.ErrorMatches, test.expect)
+	}
+}
+
+func TestRequests(t *testing.T) {
+	c := qt.New(t)
+
+	conf := newDefaultConfig()
+	conf.Enable = true
+	conf.Key = "0123456789abcdef"
+	conf.BatchSize = 2
+	conf.Endpoints = []string{"https://a.example.com/indexnow", "https://b.example.com/indexnow"}
+	conf.PingURLs = []string{"https://example.com/ping?sitemap={sitemap}"}
+	conf.DryRun = true
+
+	s, err := New(conf, hexec.New(security.DefaultConfig))
+	c.Assert(err, qt.IsNil)
+
+	reqs, err := s.Requests(nil, []string{"https://example.org/sitemap.xml"}, nil)
+	c.Assert(err, qt.IsNil)
+	c.Assert(reqs, qt.HasLen, 0)
+
+	urls := []string{
+		"https://example.org/c/",
+		"https://example.org/a/",
+		"https://example.org/b/",
+		"https://example.net/",
+	}
+	keyLocation := func(host string) string {
+		return "https://" + host + "/blog/0123456789abcdef.txt"
+	}
+	reqs, err = s.Requests(urls, []string{"https://example.org/sitemap.xml"}, keyLocation)
+	c.Assert(err, qt.IsNil)
+	// One batch for example.net, two for example.org, sent to two endpoints, and one ping.
+	c.Assert(reqs, qt.HasLen, 7)
+
+	var sub submission
+	c.Assert(json.Unmarshal(reqs[0].Body, &sub), qt.IsNil)
+	c.Assert(sub, qt.DeepEquals, submission{
+		Host:        "example.net",
+		Key:         "0123456789abcdef",
+		KeyLocation: "https://example.net/blog/0123456789abcdef.txt",
+		URLList:     []string{"https://example.net/"},
+	})
+	c.Assert(reqs[1].URL, qt.Equals, "https://b.example.com/indexnow")
+	c.Assert(reqs[2].URLs, qt.DeepEquals, []string{"https://example.org/a/", "https://example.org/b/"})
+	c.Assert(reqs[4].URLs, qt.DeepEquals, []string{"https://example.org/c/"})
+	c.Assert(reqs[6], qt.DeepEquals, Request{
+		Method: "GET",
+		URL:    "https://example.com/ping?sitemap=https%3A%2F%2Fexample.org%2Fsitemap.xml",
+		URLs:   []string{"https://example.org/sitemap.xml"},
+	})
+
+	_, err = s.Requests([]string{"/a/"}, nil, nil)
+	c.Assert(err, qt.ErrorMatches, `URL "/a/" is not absolute, check baseURL`)
+}
+
+func TestSend(t *testing.T) {
+	c := qt.New(t)
+
+	var (
+		mu       sync.Mutex
+		received []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		received = append(received, r.Method+" "+r.URL.String()+" "+string(b))
+		mu.Unlock()
+		if r.URL.Path == "/fail" {
+			http.Error(w, "invalid key", http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	conf := newDefaultConfig()
+	conf.Enable = true
+	conf.Key = "0123456789abcdef"
+	conf.Endpoints = []string{srv.URL + "/indexnow"}
+	conf.PingURLs = []string{srv.URL + "/ping?sitemap={sitemap}"}
+
+	s, err := New(conf, hexec.New(security.DefaultConfig))
+	c.Assert(err, qt.IsNil)
+	var slept []time.Duration
+	s.sleep = func(d time.Duration) { slept = append(slept, d) }
+
+	reqs, err := s.Requests([]string{"https://example.org/a/"}, []string{"https://example.org/sitemap.xml"}, nil)
+	c.Assert(err, qt.IsNil)
+	c.Assert(s.Send(reqs), qt.IsNil)
+	c.Assert(received, qt.DeepEquals, []string{
+		`POST /indexnow {"host":"example.org","key":"0123456789abcdef","urlList":["https://example.org/a/"]}`,
+		"GET /ping?sitemap=https%3A%2F%2Fexample.org%2Fsitemap.xml ",
+	})
+	c.Assert(slept, qt.DeepEquals, []time.Duration{time.Second})
+
+	received = nil
+	reqs = append([]Request{{Method: "POST", URL: srv.URL + "/fail", Body: []byte("{}")}}, reqs...)
+	c.Assert(s.Send(reqs), qt.ErrorMatches, `POST .*/fail responded with 403 Forbidden: invalid key`)
+	c.Assert(received, qt.HasLen, 3)
+}
+
+func TestNewSecurity(t *testing.T) {
+	c := qt.New(t)
+
+	conf := newDefaultConfig()
+	conf.Enable = true
+	conf.Key = "0123456789abcdef"
+
+	sc := security.DefaultConfig
+	sc.HTTP.URLs = security.NewWhitelist("^https://example\\.com/")
+
+	_, err := New(conf, hexec.New(sc))
+	c.Assert(err, qt.ErrorMatches, `(?s)access denied: "https://api.indexnow.org/indexnow" is not whitelisted.*`)
+
+	conf.DryRun = true
+	s, err := New(conf, hexec.New(sc))
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.Not(qt.IsNil))
+
+	conf.Enable = false
+	s, err = New(conf, hexec.New(sc))
+	c.Assert(err, qt.IsNil)
+	c.Assert(s, qt.IsNil)
+}
@@ -0,0 +1,179 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package indieweb provides configuration and generation of the static
+// files needed for IndieWeb and Fediverse interop: Webmention endpoint
+// discovery, ActivityPub actors and outboxes, and WebFinger.
+package indieweb
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/config"
+	"github.com/mitchellh/mapstructure"
+)
+
+const indieWebConfigKey = "indieweb"
+
+// Config configures the IndieWeb and Fediverse support.
+type Config struct {
+	// Webmention endpoint discovery, rendered by the _internal/indieweb.html
+	// template.
+	Webmention Webmention
+
+	// ActivityPub actors and outboxes, published below /activitypub/, and the
+	// WebFinger file for the default author, published as
+	// /.well-known/webfinger.
+	ActivityPub ActivityPub
+}
+
+// Webmention holds the Webmention settings.
+type Webmention struct {
+	// The Webmention endpoint, e.g. https://webmention.io/example.org/webmention.
+	Endpoint string
+
+	// The optional Pingback endpoint, e.g. https://webmention.io/example.org/xmlrpc.
+	Pingback string
+}
+
+// ActivityPub holds the ActivityPub settings.
+// It is enabled when at least one author is configured.
+type ActivityPub struct {
+	// The authors keyed by their user name, e.g. jane for @jane@example.org.
+	Authors map[string]Author
+
+	// The user name of the author of pages without an author or authors
+	// front matter param, and the one published in the WebFinger file.
+	// Defaults to the first author by user name.
+	DefaultAuthor string
+
+	// The domain used in the WebFinger account, e.g. example.org.
+	// Defaults to the host in baseURL.
+	Domain string
+
+	// The sections to include pages from in the outboxes. Empty means all.
+	Sections []string
+
+	// The maximum number of pages in an outbox. Defaults to 20.
+	OutboxLimit int
+
+	// The ActivityStreams object type of the pages, Article (default) or Note.
+	ObjectType string
+}
+
+// Author is an ActivityPub actor.
+type Author struct {
+	// The display name.
+	Name string
+
+	// A short biography, HTML is allowed.
+	Summary string
+
+	// The avatar image URL, relative to baseURL or absolute.
+	Icon string
+
+	// The profile page URL, relative to baseURL or absolute. Defaults to
+	// the home page.
+	URL string
+
+	// The inbox URL of a service handling incoming activities for this
+	// static actor, e.g. a bridge. Optional.
+	Inbox string
+
+	// The PEM encoded public key used to verify signed activities. Optional.
+	PublicKey string
+}
+
+// IsZero returns whether nothing is configured.
+func (c Config) IsZero() bool {
+	return c.Webmention.Endpoint == "" && c.Webmention.Pingback == "" && !c.ActivityPub.Enabled()
+}
+
+// Enabled reports whether ActivityPub is enabled.
+func (c ActivityPub) Enabled() bool {
+	return len(c.Authors) > 0
+}
+
+// AuthorNames returns the user names of the authors, sorted.
+func (c ActivityPub) AuthorNames() []string {
+	names := make([]string, 0, len(c.Authors))
+	for k := range c.Authors {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RenderSection reports whether to include the pages in the given section in
+// the outboxes.
+func (c ActivityPub) RenderSection(section string) bool {
+	if len(c.Sections) == 0 {
+		return true
+	}
+	for _, s := range c.Sections {
+		if strings.EqualFold(s, section) {
+			return true
+		}
+	}
+	return false
+}
+
+// DecodeConfig creates a Config from a given Hugo configuration.
+func DecodeConfig(cfg config.Provider) (c Config, err error) {
+	c.ActivityPub.OutboxLimit = 20
+	c.ActivityPub.ObjectType = "Article"
+
+	m := cfg.GetStringMap(indieWebConfigKey)
+	if m == nil {
+		return
+	}
+	delete(m, maps.MergeStrategyKey)
+	if apm, ok := m["activitypub"].(maps.Params); ok {
+		delete(apm, maps.MergeStrategyKey)
+		if authors, ok := apm["authors"].(maps.Params); ok {
+			delete(authors, maps.MergeStrategyKey)
+		}
+	}
+
+	err = mapstructure.WeakDecode(m, &c)
+	if err != nil {
+		return c, fmt.Errorf("failed to decode indieWeb config: %w", err)
+	}
+
+	ap := &c.ActivityPub
+	if !ap.Enabled() {
+		return
+	}
+
+	if ap.DefaultAuthor == "" {
+		ap.DefaultAuthor = ap.AuthorNames()[0]
+	}
+	ap.DefaultAuthor = strings.ToLower(ap.DefaultAuthor)
+	if _, found := ap.Authors[ap.DefaultAuthor]; !found {
+		return c, fmt.Errorf("indieWeb.activityPub: defaultAuthor %q is not one of the authors", ap.DefaultAuthor)
+	}
+
+	switch strings.ToLower(ap.ObjectType) {
+	case "article":
+		ap.ObjectType = "Article"
+	case "note":
+		ap.ObjectType = "Note"
+	default:
+		return c, fmt.Errorf("indieWeb.activityPub: invalid objectType %q, must be one of Article or Note", ap.ObjectType)
+	}
+
+	return
+}
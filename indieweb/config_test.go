@@ -0,0 +1,71 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indieweb
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/config"
+)
+
+func TestDecodeConfig(t *testing.T) {
+	c := qt.New(t)
+
+	conf, err := DecodeConfig(config.New())
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.IsZero(), qt.IsTrue)
+	c.Assert(conf.ActivityPub.OutboxLimit, qt.Equals, 20)
+
+	tomlConfig := `
+[indieWeb.activityPub]
+objectType = "note"
+sections = ["posts"]
+[indieWeb.activityPub.authors.zoe]
+name = "Zoe"
+[indieWeb.activityPub.authors.bob]
+name = "Bob"
+`
+	cfg, err := config.FromConfigString(tomlConfig, "toml")
+	c.Assert(err, qt.IsNil)
+	conf, err = DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.IsZero(), qt.IsFalse)
+	c.Assert(conf.ActivityPub.AuthorNames(), qt.DeepEquals, []string{"bob", "zoe"})
+	c.Assert(conf.ActivityPub.DefaultAuthor, qt.Equals, "bob")
+	c.Assert(conf.ActivityPub.ObjectType, qt.Equals, "Note")
+	c.Assert(conf.ActivityPub.RenderSection("Posts"), qt.IsTrue)
+	c.Assert(conf.ActivityPub.RenderSection("docs"), qt.IsFalse)
+
+	cfg.Set("indieWeb", map[string]any{"activityPub": map[string]any{"objectType": "Video"}})
+	_, err = DecodeConfig(cfg)
+	c.Assert(err, qt.ErrorMatches, `indieWeb.activityPub: invalid objectType "Video".*`)
+}
+
+func TestActivityPubFilesDomain(t *testing.T) {
+	c := qt.New(t)
+
+	conf := Config{ActivityPub: ActivityPub{Authors: map[string]Author{"jane": {}}, DefaultAuthor: "jane"}}
+
+	_, err := conf.ActivityPubFiles("/", nil)
+	c.Assert(err, qt.ErrorMatches, ".*domain must be set.*")
+
+	conf.ActivityPub.Domain = "example.org"
+	files, err := conf.ActivityPubFiles("/", nil)
+	c.Assert(err, qt.IsNil)
+	c.Assert(files, qt.HasLen, 3)
+	c.Assert(files[2].Path, qt.Equals, WebFingerPath)
+	c.Assert(string(files[2].Content), qt.Contains, `"subject": "acct:jane@example.org"`)
+	c.Assert(string(files[1].Content), qt.Contains, `"orderedItems": []`)
+}
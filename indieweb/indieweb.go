@@ -0,0 +1,217 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indieweb
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	// ActivityPubDir is the directory the actors and outboxes are published
+	// to, relative to the publish root, e.g. /activitypub/jane/actor.json.
+	ActivityPubDir = "activitypub"
+
+	// WebFingerPath is the path the WebFinger file is published to, relative
+	// to the publish root.
+	WebFingerPath = ".well-known/webfinger"
+
+	activityStreamsContext = "https://www.w3.org/ns/activitystreams"
+	securityContext        = "https://w3id.org/security/v1"
+	publicCollection       = "https://www.w3.org/ns/activitystreams#Public"
+)
+
+// ActorPath returns the path of the actor file for the author with the given
+// user name, relative to the publish root.
+func ActorPath(name string) string {
+	return path.Join(ActivityPubDir, name, "actor.json")
+}
+
+// OutboxPath returns the path of the outbox file for the author with the
+// given user name, relative to the publish root.
+func OutboxPath(name string) string {
+	return path.Join(ActivityPubDir, name, "outbox.json")
+}
+
+// File is a file to publish.
+type File struct {
+	// The path relative to the publish root.
+	Path string
+
+	Content []byte
+}
+
+// Post is a page published in an outbox.
+type Post struct {
+	Permalink string
+	Title     string
+
+	// The summary and the content as HTML.
+	Summary string
+	Content string
+
+	Published time.Time
+	Updated   time.Time
+	Tags      []string
+}
+
+// ActivityPubFiles creates the actor and outbox files for the configured
+// authors and the WebFinger file for the default author.
+// baseURL is the absolute base URL of the site with a trailing slash,
+// posts the pages of each author, newest first.
+func (c Config) ActivityPubFiles(baseURL string, posts map[string][]Post) ([]File, error) {
+	ap := c.ActivityPub
+	if !ap.Enabled() {
+		return nil, nil
+	}
+
+	abs := func(s string) string {
+		if s == "" {
+			return ""
+		}
+		if u, err := url.Parse(s); err == nil && u.IsAbs() {
+			return s
+		}
+		return baseURL + strings.TrimPrefix(s, "/")
+	}
+
+	domain := ap.Domain
+	if domain == "" {
+		u, err := url.Parse(baseURL)
+		if err != nil || u.Host == "" {
+			return nil, fmt.Errorf("indieWeb.activityPub: domain must be set when baseURL %q has no host", baseURL)
+		}
+		domain = u.Hostname()
+	}
+
+	var files []File
+	add := func(p string, v any) error {
+		b, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		files = append(files, File{Path: p, Content: b})
+		return nil
+	}
+
+	for _, name := range ap.AuthorNames() {
+		a := ap.Authors[name]
+		actorURL := abs(ActorPath(name))
+		outboxURL := abs(OutboxPath(name))
+		profileURL := abs(a.URL)
+		if profileURL == "" {
+			profileURL = baseURL
+		}
+
+		actor := map[string]any{
+			"@context":          []string{activityStreamsContext, securityContext},
+			"id":                actorURL,
+			"type":              "Person",
+			"preferredUsername": name,
+			"name":              a.Name,
+			"url":               profileURL,
+			"outbox":            outboxURL,
+		}
+		if a.Summary != "" {
+			actor["summary"] = a.Summary
+		}
+		if a.Icon != "" {
+			actor["icon"] = map[string]any{"type": "Image", "url": abs(a.Icon)}
+		}
+		if a.Inbox != "" {
+			actor["inbox"] = abs(a.Inbox)
+		}
+		if a.PublicKey != "" {
+			actor["publicKey"] = map[string]any{
+				"id":           actorURL + "#main-key",
+				"owner":        actorURL,
+				"publicKeyPem": a.PublicKey,
+			}
+		}
+		if err := add(ActorPath(name), actor); err != nil {
+			return nil, err
+		}
+
+		authorPosts := posts[name]
+		if ap.OutboxLimit > 0 && len(authorPosts) > ap.OutboxLimit {
+			authorPosts = authorPosts[:ap.OutboxLimit]
+		}
+		items := make([]any, 0, len(authorPosts))
+		for _, p := range authorPosts {
+			object := map[string]any{
+				"id":           p.Permalink,
+				"type":         ap.ObjectType,
+				"attributedTo": actorURL,
+				"url":          p.Permalink,
+				"to":           []string{publicCollection},
+				"published":    p.Published.Format(time.RFC3339),
+			}
+			if ap.ObjectType == "Article" {
+				object["name"] = p.Title
+				object["summary"] = p.Summary
+				object["content"] = p.Content
+			} else {
+				object["content"] = p.Summary
+			}
+			if !p.Updated.IsZero() && p.Updated.After(p.Published) {
+				object["updated"] = p.Updated.Format(time.RFC3339)
+			}
+			if len(p.Tags) > 0 {
+				var tags []any
+				for _, t := range p.Tags {
+					tags = append(tags, map[string]any{"type": "Hashtag", "name": "#" + strings.ReplaceAll(t, " ", "")})
+				}
+				object["tag"] = tags
+			}
+			items = append(items, map[string]any{
+				"id":        p.Permalink + "#create",
+				"type":      "Create",
+				"actor":     actorURL,
+				"published": object["published"],
+				"to":        []string{publicCollection},
+				"object":    object,
+			})
+		}
+		outbox := map[string]any{
+			"@context":     activityStreamsContext,
+			"id":           outboxURL,
+			"type":         "OrderedCollection",
+			"totalItems":   len(items),
+			"orderedItems": items,
+		}
+		if err := add(OutboxPath(name), outbox); err != nil {
+			return nil, err
+		}
+
+		if name == ap.DefaultAuthor {
+			webfinger := map[string]any{
+				"subject": fmt.Sprintf("acct:%s@%s", name, domain),
+				"aliases": []string{actorURL, profileURL},
+				"links": []any{
+					map[string]any{"rel": "self", "type": "application/activity+json", "href": actorURL},
+					map[string]any{"rel": "http://webfinger.net/rel/profile-page", "type": "text/html", "href": profileURL},
+				},
+			}
+			if err := add(WebFingerPath, webfinger); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return files, nil
+}
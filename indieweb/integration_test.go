@@ -0,0 +1,144 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indieweb_test
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestIndieWeb(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "sitemap", "RSS"]
+[indieWeb.webmention]
+endpoint = "https://webmention.io/example.org/webmention"
+[indieWeb.activityPub]
+defaultAuthor = "jane"
+sections = ["posts"]
+outboxLimit = 2
+[indieWeb.activityPub.authors.jane]
+name = "Jane Doe"
+summary = "Writes about Hugo."
+icon = "/images/jane.png"
+[indieWeb.activityPub.authors.john]
+name = "John Doe"
+url = "/about/john/"
+-- content/posts/p1.md --
+---
+title: "Post 1"
+date: 2023-05-01
+tags: ["hugo", "static sites"]
+---
+Post 1 content.
+-- content/posts/p2.md --
+---
+title: "Post 2"
+date: 2023-05-02
+author: "John"
+---
+Post 2 content.
+-- content/posts/p3.md --
+---
+title: "Post 3"
+date: 2023-05-03
+authors: ["jane", "john"]
+---
+Post 3 content.
+-- content/posts/p4.md --
+---
+title: "Post 4"
+date: 2023-04-01
+---
+Post 4 content.
+-- content/about.md --
+---
+title: "About"
+date: 2023-06-01
+---
+-- layouts/index.html --
+{{ template "_internal/indieweb.html" . }}
+-- layouts/_default/single.html --
+{{ template "_internal/indieweb.html" . }}
+-- layouts/_default/list.html --
+List.
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html",
+		`<link rel="webmention" href="https://webmention.io/example.org/webmention" />`,
+		`<link rel="alternate" type="application/activity+json" href="https://example.org/activitypub/jane/actor.json" />`,
+	)
+	b.AssertFileContent("public/posts/p3/index.html",
+		`href="https://example.org/activitypub/jane/actor.json"`,
+		`href="https://example.org/activitypub/john/actor.json"`,
+	)
+	b.Assert(b.FileContent("public/posts/p2/index.html"), qt.Not(qt.Contains), "jane")
+
+	b.AssertFileContent("public/activitypub/jane/actor.json",
+		`"id": "https://example.org/activitypub/jane/actor.json"`,
+		`"type": "Person"`,
+		`"preferredUsername": "jane"`,
+		`"url": "https://example.org/images/jane.png"`,
+		`"outbox": "https://example.org/activitypub/jane/outbox.json"`,
+	)
+	b.AssertFileContent("public/activitypub/john/actor.json", `"url": "https://example.org/about/john/"`)
+
+	jane := b.FileContent("public/activitypub/jane/outbox.json")
+	b.Assert(jane, qt.Contains, `"totalItems": 2`)
+	b.Assert(jane, qt.Contains, `"id": "https://example.org/posts/p3/#create"`)
+	b.Assert(jane, qt.Contains, `"name": "#staticsites"`)
+	b.Assert(jane, qt.Not(qt.Contains), "p4")
+	b.Assert(jane, qt.Not(qt.Contains), "about")
+	b.AssertFileContent("public/activitypub/john/outbox.json", `"totalItems": 2`, "posts/p2/", "posts/p3/")
+
+	b.AssertFileContent("public/.well-known/webfinger",
+		`"subject": "acct:jane@example.org"`,
+		`"href": "https://example.org/activitypub/jane/actor.json"`,
+	)
+}
+
+func TestIndieWebInvalidDefaultAuthor(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+[indieWeb.activityPub]
+defaultAuthor = "jack"
+[indieWeb.activityPub.authors.jane]
+name = "Jane Doe"
+`
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `defaultAuthor "jack" is not one of the authors`)
+}
@@ -39,6 +39,10 @@ type LanguageConfig struct {
 	// The language weight. When set to a non-zero value, this will
 	// be the main sort criteria for the language.
 	Weight int
+
+	// Set to true to skip building this language, e.g. in the config of
+	// an environment used for deploy previews.
+	Disabled bool
 }
 
 func DecodeConfig(m map[string]any) (map[string]LanguageConfig, error) {
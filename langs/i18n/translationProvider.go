@@ -46,8 +46,6 @@ func NewTranslationProvider() *TranslationProvider {
 
 // Update updates the i18n func in the provided Deps.
 func (tp *TranslationProvider) NewResource(dst *deps.Deps) error {
-	spec := source.NewSourceSpec(dst.PathSpec, nil, nil)
-
 	var defaultLangTag, err = language.Parse(dst.Conf.DefaultContentLanguage())
 	if err != nil {
 		defaultLangTag = language.English
@@ -59,9 +57,30 @@ func (tp *TranslationProvider) NewResource(dst *deps.Deps) error {
 	bundle.RegisterUnmarshalFunc("yml", yaml.Unmarshal)
 	bundle.RegisterUnmarshalFunc("json", json.Unmarshal)
 
+	if err := walkTranslationFiles(dst, func(file source.File) error {
+		return addTranslationFile(bundle, file)
+	}); err != nil {
+		return err
+	}
+
+	tp.t = NewTranslator(bundle, dst.Conf, dst.Log)
+
+	dst.Translate = tp.t.Func(dst.Conf.Language().Lang)
+
+	return nil
+
+}
+
+const artificialLangTagPrefix = "art-x-"
+
+// walkTranslationFiles calls fn for each translation file in the i18n dirs,
+// the most important last.
+func walkTranslationFiles(d *deps.Deps, fn func(file source.File) error) error {
+	spec := source.NewSourceSpec(d.PathSpec, nil, nil)
+
 	// The source dirs are ordered so the most important comes first. Since this is a
 	// last key win situation, we have to reverse the iteration order.
-	dirs := dst.BaseFs.I18n.Dirs
+	dirs := d.BaseFs.I18n.Dirs
 	for i := len(dirs) - 1; i >= 0; i-- {
 		dir := dirs[i]
 		src := spec.NewFilesystemFromFileMetaInfo(dir)
@@ -70,22 +89,56 @@ func (tp *TranslationProvider) NewResource(dst *deps.Deps) error {
 			return err
 		}
 		for _, file := range files {
-			if err := addTranslationFile(bundle, file); err != nil {
+			if err := fn(file); err != nil {
 				return err
 			}
 		}
 	}
+	return nil
+}
+
+// LoadMessages loads the messages in the i18n dirs, keyed by the lower case
+// language code and the message ID.
+func LoadMessages(d *deps.Deps) (map[string]map[string]*i18n.Message, error) {
+	unmarshalFuncs := map[string]i18n.UnmarshalFunc{
+		"toml": toml.Unmarshal,
+		"yaml": yaml.Unmarshal,
+		"yml":  yaml.Unmarshal,
+		"json": json.Unmarshal,
+	}
+	messages := make(map[string]map[string]*i18n.Message)
 
-	tp.t = NewTranslator(bundle, dst.Conf, dst.Log)
+	err := walkTranslationFiles(d, func(r source.File) error {
+		f, err := r.FileInfo().Meta().Open()
+		if err != nil {
+			return fmt.Errorf("failed to open translations file %q:: %w", r.LogicalName(), err)
+		}
+		b := helpers.ReaderToBytes(f)
+		f.Close()
 
-	dst.Translate = tp.t.Func(dst.Conf.Language().Lang)
+		name := r.LogicalName()
+		mf, err := i18n.ParseMessageFileBytes(b, name, unmarshalFuncs)
+		if err != nil {
+			// See addTranslationFile.
+			mf, err = i18n.ParseMessageFileBytes(b, artificialLangTagPrefix+name, unmarshalFuncs)
+			if err != nil {
+				return errWithFileContext(fmt.Errorf("failed to load translations: %w", err), r)
+			}
+		}
 
-	return nil
+		lang := strings.ToLower(paths.Filename(name))
+		if messages[lang] == nil {
+			messages[lang] = make(map[string]*i18n.Message)
+		}
+		for _, m := range mf.Messages {
+			messages[lang][m.ID] = m
+		}
+		return nil
+	})
 
+	return messages, err
 }
 
-const artificialLangTagPrefix = "art-x-"
-
 func addTranslationFile(bundle *i18n.Bundle, r source.File) error {
 	f, err := r.FileInfo().Meta().Open()
 	if err != nil {
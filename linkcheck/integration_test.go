@@ -0,0 +1,127 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linkcheck_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+const linkCheckFiles = `
+-- hugo.toml --
+baseURL = "https://example.org/docs/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+[checkLinks]
+enable = true
+external = EXTERNAL
+errorLevel = "ERRORLEVEL"
+ignore = ["^/docs/ignored/"]
+-- static/files/manual.pdf --
+PDF
+-- layouts/_default/single.html --
+<h1 id="title">{{ .Title }}</h1>{{ .Content }}
+-- layouts/_default/list.html --
+<a href="{{ "p1/" | relURL }}">P1</a><a href="p2/">P2</a><a href="/docs/p1/#install">Install</a><a name="top-anchor"></a><a href="/docs/nope/">Nope</a>
+-- content/p1.md --
+---
+title: P1
+---
+## Install
+
+[Home](/docs/)
+[Self](#install)
+[P2](../p2/)
+[Manual](/docs/files/manual.pdf)
+[Mail](mailto:someone@example.org)
+[Ignored](/docs/ignored/)
+-- content/p2.md --
+---
+title: P2
+---
+[Missing](/docs/missing/)
+
+[Bad anchor](/docs/p1/#uninstall)
+[Dir](/docs/p1)
+[Top](/docs/#top-anchor)
+[External](EXTERNALURL/ok)
+[Broken external](EXTERNALURL/notfound)
+`
+
+func linkCheckTestFiles(external bool, errorLevel, externalURL string) string {
+	return strings.NewReplacer(
+		"EXTERNALURL", externalURL,
+		"EXTERNAL", fmt.Sprint(external),
+		"ERRORLEVEL", errorLevel,
+	).Replace(linkCheckFiles)
+}
+
+func TestCheckLinks(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ok" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: linkCheckTestFiles(true, "error", ts.URL),
+		},
+	).BuildE()
+
+	c.Assert(err, qt.ErrorMatches, "logged 4 error\\(s\\)")
+	b.AssertLogContains(`content/p2.md:4:11": broken link "/docs/missing/": not found`)
+	// Not in the content, reported in the published file.
+	b.AssertLogContains(`"index.html:1:120": broken link "/docs/nope/": not found`)
+	b.AssertLogContains(`content/p2.md:6:14": broken link "/docs/p1/#uninstall": anchor "uninstall" not found`)
+	b.AssertLogContains(fmt.Sprintf(`content/p2.md:10:19": broken link "%s/notfound": status 404`, ts.URL))
+}
+
+func TestCheckLinksWarning(t *testing.T) {
+	t.Parallel()
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: linkCheckTestFiles(false, "warning", "https://example.com"),
+		},
+	).Build()
+
+	b.AssertLogContains(`broken link "/docs/missing/": not found`)
+	b.AssertLogContains(`broken link "/docs/p1/#uninstall": anchor "uninstall" not found`)
+}
+
+func TestCheckLinksInvalidConfig(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	_, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: linkCheckTestFiles(false, "fatal", ""),
+		},
+	).BuildE()
+
+	c.Assert(err, qt.ErrorMatches, `.*checkLinks: invalid errorLevel "fatal".*`)
+}
@@ -0,0 +1,651 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package linkcheck checks the links in the published HTML files after the
+// build: internal links, their anchors and, optionally, external URLs.
+package linkcheck
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gohugoio/hugo/cache/filecache"
+	"github.com/gohugoio/hugo/common/hexec"
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/common/text"
+	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/helpers"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/afero"
+	"golang.org/x/net/html"
+)
+
+const (
+	checkLinksConfigKey = "checkLinks"
+
+	// ErrorLevelError logs the broken links as errors, failing the build.
+	ErrorLevelError = "error"
+	// ErrorLevelWarning logs the broken links as warnings.
+	ErrorLevelWarning = "warning"
+)
+
+// Config configures the link checker.
+type Config struct {
+	// Enable checking the links in the published HTML files.
+	// Can also be enabled with the --checkLinks flag.
+	Enable bool
+
+	// Whether to check that the anchors of internal links, e.g. /docs/#install,
+	// exist in the target page. Defaults to true.
+	Anchors bool
+
+	// Whether to check the external URLs. Defaults to false.
+	// The URLs found to work are cached in the linkcheck file cache.
+	// The URLs must be allowed by the security.http policies.
+	External bool
+
+	// The maximum number of external URLs checked concurrently. Defaults to 8.
+	Concurrency int
+
+	// The timeout for checking one external URL. Defaults to 10s.
+	Timeout string
+
+	// Regular expressions matched against the links to skip,
+	// e.g. "^https://twitter\\.com/".
+	Ignore []string
+
+	// How to report the broken links: error (default), which fails the
+	// build, or warning.
+	ErrorLevel string
+}
+
+func newDefaultConfig() Config {
+	return Config{
+		Anchors:     true,
+		Concurrency: 8,
+		Timeout:     "10s",
+		ErrorLevel:  ErrorLevelError,
+	}
+}
+
+// DecodeConfig creates a Config from a given Hugo configuration.
+func DecodeConfig(cfg config.Provider) (Config, error) {
+	c := newDefaultConfig()
+
+	m := cfg.GetStringMap(checkLinksConfigKey)
+	if m == nil {
+		return c, nil
+	}
+	delete(m, maps.MergeStrategyKey)
+
+	if err := mapstructure.WeakDecode(m, &c); err != nil {
+		return c, fmt.Errorf("failed to decode checkLinks config: %w", err)
+	}
+
+	c.ErrorLevel = strings.ToLower(c.ErrorLevel)
+	switch c.ErrorLevel {
+	case ErrorLevelError, ErrorLevelWarning:
+	default:
+		return c, fmt.Errorf("checkLinks: invalid errorLevel %q, must be one of %q or %q", c.ErrorLevel, ErrorLevelError, ErrorLevelWarning)
+	}
+
+	if c.Concurrency < 1 {
+		return c, fmt.Errorf("checkLinks: invalid concurrency %d, must be at least 1", c.Concurrency)
+	}
+
+	if _, err := time.ParseDuration(c.Timeout); err != nil {
+		return c, fmt.Errorf("checkLinks: failed to parse timeout: %w", err)
+	}
+
+	for _, s := range c.Ignore {
+		if _, err := regexp.Compile(s); err != nil {
+			return c, fmt.Errorf("checkLinks: invalid ignore pattern: %w", err)
+		}
+	}
+
+	return c, nil
+}
+
+// Site is a site with its published files below Dir in the publish directory.
+type Site struct {
+	// The base URL of the site, e.g. "https://example.org/docs/".
+	BaseURL string
+
+	// The directory of the site relative to the publish directory,
+	// e.g. "fr" in a multihost setup. Empty for the publish directory itself.
+	Dir string
+}
+
+// Document is a published HTML file to check.
+type Document struct {
+	// The published file relative to the publish directory, e.g. "docs/intro/index.html".
+	File string
+
+	// The URL of the published file, used to resolve relative links.
+	URL string
+
+	// The filename of the source of the document, e.g. the content file.
+	// Used to report the position of the broken links.
+	// If not set, or if the link is not found in the source, the
+	// position in the published file is reported.
+	Source string
+}
+
+// Broken is a broken link.
+type Broken struct {
+	// The position of the link in the source or the published file.
+	Position text.Position
+
+	// The link as written.
+	Link string
+
+	// Why the link is broken, e.g. "not found".
+	Reason string
+}
+
+func (b Broken) String() string {
+	return fmt.Sprintf("%s: broken link %q: %s", b.Position, b.Link, b.Reason)
+}
+
+// Options holds the filesystems and services used by the Checker.
+type Options struct {
+	// The sites published in the build.
+	Sites []Site
+
+	// The filesystem with the published files.
+	PublishFs afero.Fs
+
+	// The filesystem to read the sources of the documents from.
+	SourceFs afero.Fs
+
+	// Exists reports whether the file, relative to the publish directory,
+	// is published but may not yet be in PublishFs, e.g. a static file.
+	// May be nil.
+	Exists func(filename string) bool
+
+	// Used to check the external URLs against the security policies.
+	Exec *hexec.Exec
+
+	// The cache of the external URLs found to work. May be nil.
+	Cache *filecache.Cache
+
+	// Visibility returns why a link from the file from to the file to, both
+	// relative to the publish directory, is not allowed, or an empty string
+	// if it is. May be nil.
+	// If set, the links are checked against it even if link checking is not
+	// enabled.
+	Visibility func(from, to string) string
+}
+
+// Checker checks the links in published HTML files.
+type Checker struct {
+	conf    Config
+	opts    Options
+	timeout time.Duration
+	ignore  []*regexp.Regexp
+	sites   []site
+
+	httpClient *http.Client
+
+	// The external URLs found to work in this process.
+	mu         sync.Mutex
+	externalOK map[string]bool
+}
+
+type site struct {
+	baseURL *url.URL
+	dir     string
+}
+
+// New creates a new Checker for the given config.
+// It returns nil if link checking is not enabled and opts.Visibility is nil.
+func New(conf Config, opts Options) (*Checker, error) {
+	if !conf.Enable && opts.Visibility == nil {
+		return nil, nil
+	}
+
+	timeout, err := time.ParseDuration(conf.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Checker{
+		conf:       conf,
+		opts:       opts,
+		timeout:    timeout,
+		httpClient: &http.Client{},
+		externalOK: make(map[string]bool),
+	}
+
+	for _, s := range conf.Ignore {
+		re, err := regexp.Compile(s)
+		if err != nil {
+			return nil, err
+		}
+		c.ignore = append(c.ignore, re)
+	}
+
+	for _, s := range opts.Sites {
+		u, err := url.Parse(s.BaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("checkLinks: invalid base URL %q: %w", s.BaseURL, err)
+		}
+		if !strings.HasSuffix(u.Path, "/") {
+			u.Path += "/"
+		}
+		c.sites = append(c.sites, site{baseURL: u, dir: s.Dir})
+	}
+
+	return c, nil
+}
+
+// Config returns the link checker config.
+func (c *Checker) Config() Config {
+	return c.conf
+}
+
+// link is a link found in a document.
+type link struct {
+	doc  Document
+	raw  string
+	url  *url.URL
+	file string // The target file for internal links.
+}
+
+// Check checks the links in docs and returns the broken ones, sorted by position.
+func (c *Checker) Check(docs []Document) ([]Broken, error) {
+	r := &run{
+		Checker:   c,
+		published: make(map[string][]byte),
+		anchors:   make(map[string]map[string]bool),
+		sources:   make(map[string][]byte),
+	}
+
+	var (
+		broken   []Broken
+		external = make(map[string][]link)
+	)
+
+	for _, doc := range docs {
+		b, err := afero.ReadFile(c.opts.PublishFs, doc.File)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		r.published[doc.File] = b
+		links, anchors := parse(b)
+		r.anchors[doc.File] = anchors
+
+		base, err := url.Parse(doc.URL)
+		if err != nil {
+			return nil, fmt.Errorf("checkLinks: invalid URL %q of %q: %w", doc.URL, doc.File, err)
+		}
+
+		for _, raw := range links {
+			if c.ignored(raw) {
+				continue
+			}
+			u, err := url.Parse(strings.TrimSpace(raw))
+			if err != nil {
+				broken = append(broken, r.broken(link{doc: doc, raw: raw}, "invalid URL"))
+				continue
+			}
+			u = base.ResolveReference(u)
+			if u.Scheme != "http" && u.Scheme != "https" {
+				// E.g. mailto: and tel:.
+				continue
+			}
+			l := link{doc: doc, raw: raw, url: u}
+			if file, ok := c.internalFile(u); ok {
+				l.file = file
+				if c.opts.Visibility != nil {
+					if reason := c.opts.Visibility(doc.File, file); reason != "" {
+						broken = append(broken, r.broken(l, reason))
+						continue
+					}
+				}
+				if !c.conf.Enable {
+					continue
+				}
+				if reason := r.checkInternal(l); reason != "" {
+					broken = append(broken, r.broken(l, reason))
+				}
+				continue
+			}
+			if c.conf.Enable && c.conf.External {
+				key := externalKey(u)
+				external[key] = append(external[key], l)
+			}
+		}
+	}
+
+	broken = append(broken, r.checkExternal(external)...)
+
+	sort.SliceStable(broken, func(i, j int) bool {
+		pi, pj := broken[i].Position, broken[j].Position
+		if pi.Filename != pj.Filename {
+			return pi.Filename < pj.Filename
+		}
+		if pi.LineNumber != pj.LineNumber {
+			return pi.LineNumber < pj.LineNumber
+		}
+		return pi.ColumnNumber < pj.ColumnNumber
+	})
+
+	return broken, nil
+}
+
+func (c *Checker) ignored(raw string) bool {
+	for _, re := range c.ignore {
+		if re.MatchString(raw) {
+			return true
+		}
+	}
+	return false
+}
+
+// internalFile returns the file below the publish directory for u
+// if u points into one of the sites.
+func (c *Checker) internalFile(u *url.URL) (string, bool) {
+	for _, s := range c.sites {
+		if !strings.EqualFold(u.Host, s.baseURL.Host) || !strings.HasPrefix(u.Path, s.baseURL.Path) {
+			continue
+		}
+		rel := strings.TrimPrefix(u.Path, s.baseURL.Path)
+		if rel == "" || strings.HasSuffix(rel, "/") {
+			rel += "index.html"
+		}
+		return path.Join(s.dir, rel), true
+	}
+	return "", false
+}
+
+// externalKey returns the URL to request for u, without the fragment,
+// which isn't sent to the server.
+func externalKey(u *url.URL) string {
+	u2 := *u
+	u2.Fragment = ""
+	u2.RawFragment = ""
+	return u2.String()
+}
+
+// run holds the state of one Check.
+type run struct {
+	*Checker
+
+	mu        sync.Mutex
+	published map[string][]byte
+	anchors   map[string]map[string]bool
+	sources   map[string][]byte
+}
+
+func (r *run) checkInternal(l link) string {
+	file := l.file
+	if !r.exists(file) {
+		// A link to a directory without a trailing slash is redirected
+		// by most servers.
+		if path.Ext(file) == "" && r.exists(path.Join(file, "index.html")) {
+			file = path.Join(file, "index.html")
+		} else {
+			return "not found"
+		}
+	}
+
+	if !r.conf.Anchors || l.url.Fragment == "" || l.url.Fragment == "top" {
+		return ""
+	}
+	if ext := path.Ext(file); ext != ".html" && ext != ".htm" {
+		return ""
+	}
+
+	anchors, found := r.anchors[file]
+	if !found {
+		b, err := afero.ReadFile(r.opts.PublishFs, file)
+		if err == nil {
+			_, anchors = parse(b)
+		}
+		r.anchors[file] = anchors
+	}
+	if !anchors[l.url.Fragment] {
+		return fmt.Sprintf("anchor %q not found", l.url.Fragment)
+	}
+
+	return ""
+}
+
+func (r *run) exists(file string) bool {
+	if _, found := r.anchors[file]; found {
+		return true
+	}
+	if fi, err := r.opts.PublishFs.Stat(file); err == nil && !fi.IsDir() {
+		return true
+	}
+	return r.opts.Exists != nil && r.opts.Exists(file)
+}
+
+func (r *run) checkExternal(external map[string][]link) []Broken {
+	keys := make([]string, 0, len(external))
+	for k := range external {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var (
+		mu     sync.Mutex
+		broken []Broken
+		wg     sync.WaitGroup
+		sem    = make(chan struct{}, r.conf.Concurrency)
+	)
+
+	for _, k := range keys {
+		k := k
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			reason := r.checkExternalURL(k)
+			if reason == "" {
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, l := range external[k] {
+				broken = append(broken, r.broken(l, reason))
+			}
+		}()
+	}
+	wg.Wait()
+
+	return broken
+}
+
+// checkExternalURL requests u and returns why it failed, or an empty string.
+func (r *run) checkExternalURL(u string) string {
+	if r.opts.Exec != nil {
+		if err := r.opts.Exec.Sec().CheckAllowedHTTPURL(u); err != nil {
+			// Not allowed to check.
+			return ""
+		}
+		if err := r.opts.Exec.Sec().CheckAllowedHTTPMethod("GET"); err != nil {
+			return ""
+		}
+	}
+
+	r.Checker.mu.Lock()
+	ok := r.externalOK[u]
+	r.Checker.mu.Unlock()
+	if ok {
+		return ""
+	}
+
+	request := func() ([]byte, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("User-Agent", "Hugo link checker")
+		res, err := r.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer res.Body.Close()
+		io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16))
+		if res.StatusCode >= 400 {
+			return nil, fmt.Errorf("status %d", res.StatusCode)
+		}
+		return []byte("ok"), nil
+	}
+
+	var err error
+	if r.opts.Cache != nil {
+		_, _, err = r.opts.Cache.GetOrCreateBytes("linkcheck_"+helpers.MD5String(u), request)
+	} else {
+		_, err = request()
+	}
+	if err != nil {
+		return err.Error()
+	}
+
+	r.Checker.mu.Lock()
+	r.externalOK[u] = true
+	r.Checker.mu.Unlock()
+
+	return ""
+}
+
+// broken creates a Broken for l, positioned in the source of the document
+// if the link is found there, else in the published file.
+func (r *run) broken(l link, reason string) Broken {
+	b := Broken{Link: l.raw, Reason: reason}
+	if l.doc.Source != "" {
+		if src, err := r.source(l.doc.Source); err == nil {
+			if pos, found := position(src, l.raw); found {
+				pos.Filename = l.doc.Source
+				b.Position = pos
+				return b
+			}
+		}
+	}
+	r.mu.Lock()
+	published := r.published[l.doc.File]
+	r.mu.Unlock()
+	b.Position, _ = position(published, l.raw)
+	b.Position.Filename = l.doc.File
+	return b
+}
+
+func (r *run) source(filename string) ([]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if b, found := r.sources[filename]; found {
+		return b, nil
+	}
+	if r.opts.SourceFs == nil {
+		return nil, os.ErrNotExist
+	}
+	b, err := afero.ReadFile(r.opts.SourceFs, filename)
+	if err != nil {
+		return nil, err
+	}
+	r.sources[filename] = b
+	return b, nil
+}
+
+// position returns the position of the first occurrence of s in b.
+func position(b []byte, s string) (text.Position, bool) {
+	pos := text.Position{Offset: -1}
+	idx := bytes.Index(b, []byte(s))
+	if s == "" || idx == -1 {
+		return pos, false
+	}
+	before := b[:idx]
+	pos.Offset = idx
+	pos.LineNumber = bytes.Count(before, []byte("\n")) + 1
+	pos.ColumnNumber = len([]rune(string(before[bytes.LastIndexByte(before, '\n')+1:]))) + 1
+	return pos, true
+}
+
+// linkAttributes maps the elements with links to check to their link attribute.
+var linkAttributes = map[string]string{
+	"a":      "href",
+	"area":   "href",
+	"link":   "href",
+	"img":    "src",
+	"script": "src",
+	"iframe": "src",
+	"source": "src",
+	"video":  "src",
+	"audio":  "src",
+	"track":  "src",
+	"embed":  "src",
+}
+
+// parse returns the links and the anchors, the id and the name attributes
+// of a elements, in the HTML document b.
+func parse(b []byte) ([]string, map[string]bool) {
+	var links []string
+	anchors := make(map[string]bool)
+
+	z := html.NewTokenizer(bytes.NewReader(b))
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			return links, anchors
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			tag := string(name)
+			linkAttr := linkAttributes[tag]
+			var rel string
+			var href string
+			for hasAttr {
+				var key, val []byte
+				key, val, hasAttr = z.TagAttr()
+				k := string(key)
+				switch {
+				case k == "id", k == "name" && tag == "a":
+					anchors[string(val)] = true
+				case k == "rel":
+					rel = strings.ToLower(string(val))
+				case k == linkAttr:
+					href = string(val)
+				}
+			}
+			if href == "" || href == "#" {
+				continue
+			}
+			if tag == "link" && (strings.Contains(rel, "preconnect") || strings.Contains(rel, "dns-prefetch")) {
+				continue
+			}
+			links = append(links, href)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linkcheck
+
+import (
+	"net/url"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/config"
+	"github.com/spf13/afero"
+)
+
+func TestDecodeConfig(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := config.New()
+	conf, err := DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Enable, qt.IsFalse)
+	c.Assert(conf.Anchors, qt.IsTrue)
+	c.Assert(conf.External, qt.IsFalse)
+	c.Assert(conf.Concurrency, qt.Equals, 8)
+	c.Assert(conf.ErrorLevel, qt.Equals, ErrorLevelError)
+
+	cfg.Set("checkLinks", map[string]any{
+		"enable":     true,
+		"external":   true,
+		"errorLevel": "WARNING",
+		"ignore":     []string{"^https://twitter\\.com/"},
+	})
+	conf, err = DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Enable, qt.IsTrue)
+	c.Assert(conf.External, qt.IsTrue)
+	c.Assert(conf.ErrorLevel, qt.Equals, ErrorLevelWarning)
+	c.Assert(conf.Ignore, qt.DeepEquals, []string{"^https://twitter\\.com/"})
+
+	for _, test := range []struct {
+		m      map[string]any
+		expect string
+	}{
+		{map[string]any{"errorLevel": "fatal"}, `checkLinks: invalid errorLevel "fatal".*`},
+		{map[string]any{"concurrency": 0}, `checkLinks: invalid concurrency 0.*`},
+		{map[string]any{"timeout": "forever"}, "checkLinks: failed to parse timeout.*"},
+		{map[string]any{"ignore": []string{"("}}, "checkLinks: invalid ignore pattern.*"},
+	} {
+		cfg = config.New()
+		cfg.Set("checkLinks", test.m)
+		_, err = DecodeConfig(cfg)
+		c.Assert(err, qt.ErrorMatches, test.expect)
+	}
+}
+
+func TestParse(t *testing.T) {
+	c := qt.New(t)
+
+	links, anchors := parse([]byte(`<html><head><link rel="stylesheet" href="/main.css"><link rel="preconnect" href="https://fonts.example.org">
+<script src="/app.js"></script></head>
+<body><h2 id="intro">Intro</h2><a name="legacy"></a><a href="#">Top</a><a href="/docs/?a=1&amp;b=2#intro">Docs</a><img src="pix.png"><span name="nope"></span></body></html>`))
+
+	c.Assert(links, qt.DeepEquals, []string{"/main.css", "/app.js", "/docs/?a=1&b=2#intro", "pix.png"})
+	c.Assert(anchors, qt.DeepEquals, map[string]bool{"intro": true, "legacy": true})
+}
+
+func TestPosition(t *testing.T) {
+	c := qt.New(t)
+
+	pos, found := position([]byte("line 1\nsee [the ä](/a/)\n"), "/a/")
+	c.Assert(found, qt.IsTrue)
+	c.Assert(pos.LineNumber, qt.Equals, 2)
+	c.Assert(pos.ColumnNumber, qt.Equals, 13)
+
+	_, found = position([]byte("line 1"), "/a/")
+	c.Assert(found, qt.IsFalse)
+}
+
+func TestCheckerInternalFile(t *testing.T) {
+	c := qt.New(t)
+
+	checker, err := New(Config{Enable: true, Timeout: "1s"}, Options{
+		Sites: []Site{
+			{BaseURL: "https://example.org/docs", Dir: ""},
+			{BaseURL: "https://example.fr/", Dir: "fr"},
+		},
+		PublishFs: afero.NewMemMapFs(),
+	})
+	c.Assert(err, qt.IsNil)
+
+	for _, test := range []struct {
+		u      string
+		expect string
+		ok     bool
+	}{
+		{"https://example.org/docs/", "index.html", true},
+		{"https://example.org/docs/a/b/", "a/b/index.html", true},
+		{"https://EXAMPLE.org/docs/a.pdf", "a.pdf", true},
+		{"https://example.fr/a/", "fr/a/index.html", true},
+		{"https://example.org/blog/", "", false},
+		{"https://example.com/docs/", "", false},
+	} {
+		u := mustParseURL(c, test.u)
+		file, ok := checker.internalFile(u)
+		c.Assert(ok, qt.Equals, test.ok, qt.Commentf(test.u))
+		c.Assert(file, qt.Equals, test.expect, qt.Commentf(test.u))
+	}
+
+	checker, err = New(Config{}, Options{})
+	c.Assert(err, qt.IsNil)
+	c.Assert(checker, qt.IsNil)
+}
+
+func mustParseURL(c *qt.C, s string) *url.URL {
+	u, err := url.Parse(s)
+	c.Assert(err, qt.IsNil)
+	return u
+}
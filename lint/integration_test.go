@@ -0,0 +1,109 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint_test
+
+import (
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+	"github.com/gohugoio/hugo/lint"
+	"github.com/gohugoio/hugo/resources/page"
+)
+
+// noTodo is a custom rule reporting the TODO markers in the raw content.
+type noTodo struct{}
+
+func (noTodo) Name() string        { return "no-todo" }
+func (noTodo) Description() string { return "The content has a TODO marker." }
+func (noTodo) Severity() string    { return lint.SeverityError }
+
+func (noTodo) Check(p page.Page, opts lint.Options) ([]string, error) {
+	marker := opts.String("marker", "TODO")
+	if n := strings.Count(p.RawContent(), marker); n > 0 {
+		return []string{strings.Repeat(marker+" ", n)}, nil
+	}
+	return nil, nil
+}
+
+func TestLint(t *testing.T) {
+	lint.Register(noTodo{})
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+buildDrafts = true
+buildFuture = true
+[lint.rules.missing-cover]
+severity = "warning"
+include = ["blog"]
+[lint.rules.no-todo]
+marker = "FIXME"
+[lint.sections.docs.rules.title-length]
+max = 10
+severity = "error"
+-- content/_index.md --
+---
+title: Home
+description: The home page.
+---
+-- content/blog/p1.md --
+---
+title: Blog post
+description: A blog post.
+lastmod: 2001-02-03
+---
+FIXME
+-- content/blog/p2/index.md --
+---
+title: Blog post with a cover
+description: A blog post with a cover.
+draft: true
+publishDate: 2099-01-01
+---
+-- content/blog/p2/cover.png --
+-- content/docs/d1.md --
+---
+title: Documentation page
+images: ["/images/d1.png"]
+---
+TODO
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	findings, err := lint.New(b.H.Configs.Base.Lint, b.H.Configs.Base.WorkingDir).Lint(b.H.Pages())
+	b.Assert(err, qt.IsNil)
+
+	var got []string
+	for _, f := range findings {
+		got = append(got, f.String())
+	}
+
+	b.Assert(got, qt.DeepEquals, []string{
+		"content/blog/p1.md: warning: missing cover image (missing-cover)",
+		"content/blog/p1.md: error: FIXME  (no-todo)",
+		"content/blog/p1.md: warning: lastmod 2001-02-03 is older than 365 days (stale-lastmod)",
+		"content/blog/p2/index.md: warning: draft with future publishDate 2099-01-01, it will not be published on that date (future-draft)",
+		"content/docs/d1.md: warning: missing description (missing-description)",
+		"content/docs/d1.md: error: title has 18 characters, expected at most 10 (title-length)",
+	})
+}
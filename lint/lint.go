@@ -0,0 +1,355 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lint checks the content of a site with pluggable rules, e.g.
+// pages without a description, used by hugo lint.
+package lint
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/resources/page"
+	"github.com/spf13/cast"
+)
+
+const lintConfigKey = "lint"
+
+// The severities of the findings.
+const (
+	SeverityWarning = "warning"
+	SeverityError   = "error"
+
+	// SeverityNone disables a rule.
+	SeverityNone = "none"
+)
+
+// Rule is a content lint rule.
+// Custom rules can be added with Register.
+type Rule interface {
+	// Name returns the name of the rule used in the config and in the
+	// findings, e.g. "missing-description".
+	Name() string
+
+	// Description returns a short description of the rule.
+	Description() string
+
+	// Severity returns the default severity of the rule.
+	// Rules returning SeverityNone must be enabled in the config.
+	Severity() string
+
+	// Check checks the page p and returns a message for every problem found.
+	Check(p page.Page, opts Options) ([]string, error)
+}
+
+var (
+	rulesMu sync.RWMutex
+	rules   = make(map[string]Rule)
+)
+
+// Register registers the rule r, replacing any rule with the same name.
+func Register(r Rule) {
+	rulesMu.Lock()
+	defer rulesMu.Unlock()
+	rules[r.Name()] = r
+}
+
+// Rules returns the registered rules sorted by name.
+func Rules() []Rule {
+	rulesMu.RLock()
+	defer rulesMu.RUnlock()
+	var rs []Rule
+	for _, r := range rules {
+		rs = append(rs, r)
+	}
+	sort.Slice(rs, func(i, j int) bool { return rs[i].Name() < rs[j].Name() })
+	return rs
+}
+
+func getRule(name string) (Rule, bool) {
+	rulesMu.RLock()
+	defer rulesMu.RUnlock()
+	r, found := rules[name]
+	return r, found
+}
+
+// Options holds the rule specific options in a rule config, e.g. max for title-length.
+type Options maps.Params
+
+// Int returns the int option key, or def if not set.
+func (o Options) Int(key string, def int) (int, error) {
+	v, found := o[strings.ToLower(key)]
+	if !found {
+		return def, nil
+	}
+	i, err := cast.ToIntE(v)
+	if err != nil {
+		return def, fmt.Errorf("invalid option %q: %w", key, err)
+	}
+	return i, nil
+}
+
+// String returns the string option key, or def if not set.
+func (o Options) String(key, def string) string {
+	v, found := o[strings.ToLower(key)]
+	if !found {
+		return def
+	}
+	return cast.ToString(v)
+}
+
+// Strings returns the string slice option key, or def if not set.
+func (o Options) Strings(key string, def []string) []string {
+	v, found := o[strings.ToLower(key)]
+	if !found {
+		return def
+	}
+	return cast.ToStringSlice(v)
+}
+
+// Config configures hugo lint.
+type Config struct {
+	// The rule configs keyed by rule name.
+	Rules map[string]RuleConfig
+
+	// Rule configs overriding Rules for the pages in a section, keyed by
+	// section and rule name.
+	Sections map[string]map[string]RuleConfig
+}
+
+// RuleConfig configures a rule.
+type RuleConfig struct {
+	// The severity of the problems found: error, warning or none to disable
+	// the rule. Defaults to the severity of the rule.
+	Severity string
+
+	// If set, the rule only checks the pages in these sections.
+	Include []string
+
+	// The rule specific options, e.g. max for title-length.
+	Options Options
+}
+
+// merge returns c with the values set in o.
+func (c RuleConfig) merge(o RuleConfig) RuleConfig {
+	if o.Severity != "" {
+		c.Severity = o.Severity
+	}
+	if o.Include != nil {
+		c.Include = o.Include
+	}
+	opts := make(Options)
+	for k, v := range c.Options {
+		opts[k] = v
+	}
+	for k, v := range o.Options {
+		opts[k] = v
+	}
+	c.Options = opts
+	return c
+}
+
+// DecodeConfig creates a Config from a given Hugo configuration.
+func DecodeConfig(cfg config.Provider) (Config, error) {
+	c := Config{
+		Rules:    make(map[string]RuleConfig),
+		Sections: make(map[string]map[string]RuleConfig),
+	}
+
+	m := cfg.GetStringMap(lintConfigKey)
+	if m == nil {
+		return c, nil
+	}
+
+	for k, v := range m {
+		switch strings.ToLower(k) {
+		case "rules":
+			rcs, err := decodeRuleConfigs(v)
+			if err != nil {
+				return c, err
+			}
+			c.Rules = rcs
+		case "sections":
+			sm, err := maps.ToStringMapE(v)
+			if err != nil {
+				return c, fmt.Errorf("lint: failed to decode sections: %w", err)
+			}
+			for section, vv := range sm {
+				if section == maps.MergeStrategyKey {
+					continue
+				}
+				sm2, err := maps.ToStringMapE(vv)
+				if err != nil {
+					return c, fmt.Errorf("lint: failed to decode section %q: %w", section, err)
+				}
+				rcs, err := decodeRuleConfigs(sm2["rules"])
+				if err != nil {
+					return c, err
+				}
+				c.Sections[strings.ToLower(section)] = rcs
+			}
+		}
+	}
+
+	return c, nil
+}
+
+func decodeRuleConfigs(v any) (map[string]RuleConfig, error) {
+	rcs := make(map[string]RuleConfig)
+	if v == nil {
+		return rcs, nil
+	}
+	m, err := maps.ToStringMapE(v)
+	if err != nil {
+		return nil, fmt.Errorf("lint: failed to decode rules: %w", err)
+	}
+	for name, vv := range m {
+		if name == maps.MergeStrategyKey {
+			continue
+		}
+		name = strings.ToLower(name)
+		if _, found := getRule(name); !found {
+			return nil, fmt.Errorf("lint: unknown rule %q", name)
+		}
+		rm, err := maps.ToStringMapE(vv)
+		if err != nil {
+			return nil, fmt.Errorf("lint: failed to decode rule %q: %w", name, err)
+		}
+		rc := RuleConfig{Options: make(Options)}
+		for k, vvv := range rm {
+			switch strings.ToLower(k) {
+			case "severity":
+				rc.Severity = strings.ToLower(cast.ToString(vvv))
+				switch rc.Severity {
+				case SeverityError, SeverityWarning, SeverityNone:
+				default:
+					return nil, fmt.Errorf("lint: invalid severity %q for rule %q, must be one of %q, %q or %q", rc.Severity, name, SeverityError, SeverityWarning, SeverityNone)
+				}
+			case "include":
+				rc.Include = cast.ToStringSlice(vvv)
+				for i, s := range rc.Include {
+					rc.Include[i] = strings.ToLower(s)
+				}
+			case maps.MergeStrategyKey:
+			default:
+				rc.Options[strings.ToLower(k)] = vvv
+			}
+		}
+		rcs[name] = rc
+	}
+	return rcs, nil
+}
+
+// Finding is a problem found in a content file.
+type Finding struct {
+	// The content file relative to the working dir, e.g. "content/posts/p1.md".
+	File string `json:"file"`
+
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s: %s (%s)", f.File, f.Severity, f.Message, f.Rule)
+}
+
+// Linter checks pages with the registered rules.
+type Linter struct {
+	conf       Config
+	workingDir string
+}
+
+// New creates a new Linter for the given config.
+// The content files in the findings are made relative to workingDir.
+func New(conf Config, workingDir string) *Linter {
+	return &Linter{conf: conf, workingDir: workingDir}
+}
+
+// ruleConfig returns the effective config of the rule r for the pages in section.
+func (l *Linter) ruleConfig(r Rule, section string) RuleConfig {
+	c := RuleConfig{Severity: r.Severity()}.merge(l.conf.Rules[r.Name()])
+	if sc, found := l.conf.Sections[strings.ToLower(section)][r.Name()]; found {
+		c = c.merge(sc)
+	}
+	return c
+}
+
+// Lint checks the pages backed by a content file and returns the findings,
+// sorted by file and rule.
+func (l *Linter) Lint(pages page.Pages) ([]Finding, error) {
+	var findings []Finding
+	rs := Rules()
+
+	for _, p := range pages {
+		if p.File().IsZero() {
+			continue
+		}
+		section := p.Section()
+		filename := l.relFilename(p.File().Filename())
+
+		for _, r := range rs {
+			conf := l.ruleConfig(r, section)
+			if conf.Severity == SeverityNone {
+				continue
+			}
+			if conf.Include != nil && !includes(conf.Include, strings.ToLower(section)) {
+				continue
+			}
+			messages, err := r.Check(p, conf.Options)
+			if err != nil {
+				return nil, fmt.Errorf("lint: rule %q: %s: %w", r.Name(), filename, err)
+			}
+			for _, m := range messages {
+				findings = append(findings, Finding{
+					File:     filename,
+					Rule:     r.Name(),
+					Severity: conf.Severity,
+					Message:  m,
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		fi, fj := findings[i], findings[j]
+		if fi.File != fj.File {
+			return fi.File < fj.File
+		}
+		return fi.Rule < fj.Rule
+	})
+
+	return findings, nil
+}
+
+func (l *Linter) relFilename(filename string) string {
+	if rel, err := filepath.Rel(l.workingDir, filename); err == nil && !strings.HasPrefix(rel, "..") {
+		filename = rel
+	}
+	return filepath.ToSlash(strings.TrimPrefix(filename, string(os.PathSeparator)))
+}
+
+func includes(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/config"
+)
+
+func TestDecodeConfig(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := config.New()
+	conf, err := DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Rules, qt.HasLen, 0)
+
+	cfg.Set("lint", map[string]any{
+		"rules": map[string]any{
+			"Title-Length": map[string]any{"max": 50, "severity": "Error"},
+			"missing-cover": map[string]any{
+				"severity": "warning",
+				"include":  []string{"Blog"},
+			},
+		},
+		"sections": map[string]any{
+			"docs": map[string]any{
+				"rules": map[string]any{
+					"title-length": map[string]any{"maxDays": 30},
+				},
+			},
+		},
+	})
+	conf, err = DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Rules[RuleTitleLength], qt.DeepEquals, RuleConfig{Severity: SeverityError, Options: Options{"max": 50}})
+	c.Assert(conf.Rules[RuleMissingCover].Include, qt.DeepEquals, []string{"blog"})
+	c.Assert(conf.Sections["docs"][RuleTitleLength].Options, qt.DeepEquals, Options{"maxdays": 30})
+
+	for _, test := range []struct {
+		m      map[string]any
+		expect string
+	}{
+		{map[string]any{"rules": map[string]any{"spelling": map[string]any{}}}, `lint: unknown rule "spelling"`},
+		{map[string]any{"rules": map[string]any{"title-length": map[string]any{"severity": "fatal"}}}, `lint: invalid severity "fatal" for rule "title-length".*`},
+	} {
+		cfg = config.New()
+		cfg.Set("lint", test.m)
+		_, err = DecodeConfig(cfg)
+		c.Assert(err, qt.ErrorMatches, test.expect)
+	}
+}
+
+func TestRuleConfig(t *testing.T) {
+	c := qt.New(t)
+
+	l := New(Config{
+		Rules: map[string]RuleConfig{
+			RuleTitleLength: {Options: Options{"max": 50, "min": 5}},
+		},
+		Sections: map[string]map[string]RuleConfig{
+			"docs": {RuleTitleLength: {Severity: SeverityError, Options: Options{"max": 30}}},
+		},
+	}, "")
+
+	r, _ := getRule(RuleTitleLength)
+	c.Assert(l.ruleConfig(r, "blog"), qt.DeepEquals, RuleConfig{Severity: SeverityWarning, Options: Options{"max": 50, "min": 5}})
+	c.Assert(l.ruleConfig(r, "Docs"), qt.DeepEquals, RuleConfig{Severity: SeverityError, Options: Options{"max": 30, "min": 5}})
+
+	r, _ = getRule(RuleMissingCover)
+	c.Assert(l.ruleConfig(r, "blog").Severity, qt.Equals, SeverityNone)
+}
+
+func TestOptions(t *testing.T) {
+	c := qt.New(t)
+
+	opts := Options{"max": "42", "param": "cover", "resources": []any{"*hero*"}, "min": "many"}
+
+	i, err := opts.Int("Max", 70)
+	c.Assert(err, qt.IsNil)
+	c.Assert(i, qt.Equals, 42)
+	i, err = opts.Int("limit", 70)
+	c.Assert(err, qt.IsNil)
+	c.Assert(i, qt.Equals, 70)
+	_, err = opts.Int("min", 1)
+	c.Assert(err, qt.ErrorMatches, `invalid option "min".*`)
+
+	c.Assert(opts.String("param", "images"), qt.Equals, "cover")
+	c.Assert(opts.String("name", "images"), qt.Equals, "images")
+	c.Assert(opts.Strings("resources", nil), qt.DeepEquals, []string{"*hero*"})
+}
+
+func TestWrite(t *testing.T) {
+	c := qt.New(t)
+
+	findings := []Finding{
+		{File: "content/p1.md", Rule: RuleMissingDescription, Severity: SeverityError, Message: "missing description"},
+		{File: "content/p2.md", Rule: RuleTitleLength, Severity: SeverityWarning, Message: "missing title"},
+	}
+
+	var buf bytes.Buffer
+	c.Assert(Write(&buf, FormatText, "0.1.0", findings), qt.IsNil)
+	c.Assert(buf.String(), qt.Equals, `content/p1.md: error: missing description (missing-description)
+content/p2.md: warning: missing title (title-length)
+`)
+
+	buf.Reset()
+	c.Assert(Write(&buf, FormatJSON, "0.1.0", findings), qt.IsNil)
+	var report Report
+	c.Assert(json.Unmarshal(buf.Bytes(), &report), qt.IsNil)
+	c.Assert(report.Summary, qt.DeepEquals, map[string]int{SeverityError: 1, SeverityWarning: 1})
+	c.Assert(report.Findings, qt.DeepEquals, findings)
+
+	buf.Reset()
+	c.Assert(Write(&buf, FormatSARIF, "0.1.0", findings), qt.IsNil)
+	var log sarifLog
+	c.Assert(json.Unmarshal(buf.Bytes(), &log), qt.IsNil)
+	c.Assert(log.Version, qt.Equals, "2.1.0")
+	c.Assert(log.Runs, qt.HasLen, 1)
+	run := log.Runs[0]
+	c.Assert(run.Tool.Driver.Version, qt.Equals, "0.1.0")
+	c.Assert(run.Results, qt.HasLen, 2)
+	c.Assert(run.Results[0].Level, qt.Equals, "error")
+	c.Assert(run.Results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI, qt.Equals, "content/p1.md")
+	c.Assert(run.Tool.Driver.Rules[run.Results[1].RuleIndex].ID, qt.Equals, RuleTitleLength)
+
+	c.Assert(Write(&buf, "xml", "", findings), qt.ErrorMatches, `invalid format "xml".*`)
+}
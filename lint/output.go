@@ -0,0 +1,173 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// The output formats supported by Write.
+const (
+	FormatText  = "text"
+	FormatJSON  = "json"
+	FormatSARIF = "sarif"
+)
+
+// Report is the lint report written in the JSON format.
+type Report struct {
+	// The number of findings by severity.
+	Summary map[string]int `json:"summary"`
+
+	Findings []Finding `json:"findings"`
+}
+
+// NewReport creates a Report of findings.
+func NewReport(findings []Finding) Report {
+	r := Report{
+		Summary:  map[string]int{SeverityError: 0, SeverityWarning: 0},
+		Findings: findings,
+	}
+	if r.Findings == nil {
+		r.Findings = []Finding{}
+	}
+	for _, f := range findings {
+		r.Summary[f.Severity]++
+	}
+	return r
+}
+
+// Write writes the findings to w in the given format, one of text, json or sarif.
+// version is the Hugo version reported in the SARIF output.
+func Write(w io.Writer, format, version string, findings []Finding) error {
+	switch format {
+	case FormatText:
+		for _, f := range findings {
+			if _, err := fmt.Fprintln(w, f); err != nil {
+				return err
+			}
+		}
+		return nil
+	case FormatJSON:
+		return writeJSON(w, NewReport(findings))
+	case FormatSARIF:
+		return writeJSON(w, newSARIFLog(version, findings))
+	default:
+		return fmt.Errorf("invalid format %q, must be one of %s, %s or %s", format, FormatText, FormatJSON, FormatSARIF)
+	}
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// The subset of SARIF 2.1.0 needed to annotate the content files in CI,
+// e.g. in GitHub code scanning.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+func newSARIFLog(version string, findings []Finding) sarifLog {
+	driver := sarifDriver{
+		Name:           "hugo",
+		Version:        version,
+		InformationURI: "https://gohugo.io/commands/hugo_lint/",
+		Rules:          []sarifRule{},
+	}
+	ruleIndex := make(map[string]int)
+	for i, r := range Rules() {
+		ruleIndex[r.Name()] = i
+		driver.Rules = append(driver.Rules, sarifRule{ID: r.Name(), ShortDescription: sarifMessage{Text: r.Description()}})
+	}
+
+	results := []sarifResult{}
+	for _, f := range findings {
+		results = append(results, sarifResult{
+			RuleID:    f.Rule,
+			RuleIndex: ruleIndex[f.Rule],
+			Level:     f.Severity,
+			Message:   sarifMessage{Text: f.Message},
+			Locations: []sarifLocation{
+				{
+					PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{URI: f.File},
+						// The rules check the front matter, report the first line.
+						Region: sarifRegion{StartLine: 1},
+					},
+				},
+			},
+		})
+	}
+
+	return sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{{Tool: sarifTool{Driver: driver}, Results: results}},
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gohugoio/hugo/common/htime"
+	"github.com/gohugoio/hugo/resources/page"
+	"github.com/gohugoio/hugo/resources/resource"
+	"github.com/spf13/cast"
+)
+
+// The built-in rules.
+const (
+	RuleMissingDescription = "missing-description"
+	RuleTitleLength        = "title-length"
+	RuleStaleLastmod       = "stale-lastmod"
+	RuleMissingCover       = "missing-cover"
+	RuleFutureDraft        = "future-draft"
+)
+
+func init() {
+	Register(missingDescription{})
+	Register(titleLength{})
+	Register(staleLastmod{})
+	Register(missingCover{})
+	Register(futureDraft{})
+}
+
+type missingDescription struct{}
+
+func (missingDescription) Name() string        { return RuleMissingDescription }
+func (missingDescription) Description() string { return "The page has no description." }
+func (missingDescription) Severity() string    { return SeverityWarning }
+
+func (missingDescription) Check(p page.Page, opts Options) ([]string, error) {
+	if p.Description() != "" {
+		return nil, nil
+	}
+	return []string{"missing description"}, nil
+}
+
+// titleLength checks the title length in characters, between the options
+// min (default 1) and max (default 70).
+type titleLength struct{}
+
+func (titleLength) Name() string        { return RuleTitleLength }
+func (titleLength) Description() string { return "The page title is too short or too long." }
+func (titleLength) Severity() string    { return SeverityWarning }
+
+func (titleLength) Check(p page.Page, opts Options) ([]string, error) {
+	min, err := opts.Int("min", 1)
+	if err != nil {
+		return nil, err
+	}
+	max, err := opts.Int("max", 70)
+	if err != nil {
+		return nil, err
+	}
+	n := utf8.RuneCountInString(p.Title())
+	switch {
+	case n == 0 && min > 0:
+		return []string{"missing title"}, nil
+	case n < min:
+		return []string{fmt.Sprintf("title has %d characters, expected at least %d", n, min)}, nil
+	case max > 0 && n > max:
+		return []string{fmt.Sprintf("title has %d characters, expected at most %d", n, max)}, nil
+	}
+	return nil, nil
+}
+
+// staleLastmod checks that the last modification date is not older than
+// the option maxDays (default 365) days.
+type staleLastmod struct{}
+
+func (staleLastmod) Name() string        { return RuleStaleLastmod }
+func (staleLastmod) Description() string { return "The page has not been modified for a long time." }
+func (staleLastmod) Severity() string    { return SeverityWarning }
+
+func (staleLastmod) Check(p page.Page, opts Options) ([]string, error) {
+	maxDays, err := opts.Int("maxDays", 365)
+	if err != nil {
+		return nil, err
+	}
+	lastmod := p.Lastmod()
+	if lastmod.IsZero() {
+		return nil, nil
+	}
+	if htime.Now().Sub(lastmod) > time.Duration(maxDays)*24*time.Hour {
+		return []string{fmt.Sprintf("lastmod %s is older than %d days", lastmod.Format("2006-01-02"), maxDays)}, nil
+	}
+	return nil, nil
+}
+
+// missingCover checks that the page has a cover image, either in the front
+// matter param set in the option param (default images) or as a page
+// resource matching one of the option resources.
+// It is disabled by default.
+type missingCover struct{}
+
+func (missingCover) Name() string        { return RuleMissingCover }
+func (missingCover) Description() string { return "The page has no cover image." }
+func (missingCover) Severity() string    { return SeverityNone }
+
+func (missingCover) Check(p page.Page, opts Options) ([]string, error) {
+	param := opts.String("param", "images")
+	if v := p.Params()[strings.ToLower(param)]; v != nil {
+		if s, err := cast.ToStringSliceE(v); err != nil || len(s) > 0 {
+			return nil, nil
+		}
+	}
+	for _, pattern := range opts.Strings("resources", []string{"*feature*", "*cover*", "*thumbnail*"}) {
+		if r := p.Resources().GetMatch(pattern); r != nil && r.ResourceType() == "image" {
+			return nil, nil
+		}
+	}
+	return []string{"missing cover image"}, nil
+}
+
+type futureDraft struct{}
+
+func (futureDraft) Name() string { return RuleFutureDraft }
+func (futureDraft) Description() string {
+	return "The page is a draft with a future publish date and will not be published on that date."
+}
+func (futureDraft) Severity() string { return SeverityWarning }
+
+func (futureDraft) Check(p page.Page, opts Options) ([]string, error) {
+	if !p.Draft() || !resource.IsFuture(p) {
+		return nil, nil
+	}
+	return []string{fmt.Sprintf("draft with future publishDate %s, it will not be published on that date", p.PublishDate().Format("2006-01-02"))}, nil
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package llmstxt_test
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestLLMSTxtOutputFormats(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+title = "My Docs"
+disableKinds = ["taxonomy", "term", "sitemap", "RSS"]
+[outputs]
+home = ["html", "llms", "llmsfull"]
+section = ["html", "llmsfull"]
+[llmsTxt]
+description = "Documentation for My Project."
+exclude = ["/docs/internal/**"]
+-- content/about.md --
+---
+title: "About"
+---
+About **us**.
+-- content/docs/_index.md --
+---
+title: "Documentation"
+description: "All the docs."
+---
+-- content/docs/install.md --
+---
+title: "Install"
+description: "How to install."
+weight: 1
+---
+## Linux
+
+Run {{< cmd >}}.
+-- content/docs/internal/secret.md --
+---
+title: "Secret"
+---
+Secret stuff.
+-- content/docs/config/_index.md --
+---
+title: "Configuration"
+---
+-- content/docs/config/options.md --
+---
+title: "Options"
+---
+All the options.
+-- layouts/shortcodes/cmd.html --
+install.sh
+-- layouts/index.html --
+Home.
+-- layouts/_default/list.html --
+List.
+-- layouts/_default/single.html --
+Single.
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/llms.txt", `# My Docs
+
+> Documentation for My Project.
+
+- [About](https://example.org/about/)
+
+## Documentation
+
+- [Install](https://example.org/docs/install/): How to install.
+
+## Configuration
+
+- [Options](https://example.org/docs/config/options/)
+`)
+	b.Assert(b.FileContent("public/llms.txt"), qt.Not(qt.Contains), "Secret")
+
+	b.AssertFileContent("public/llms-full.txt", `
+## About
+
+URL: https://example.org/about/
+
+About **us**.
+`, `
+## Install
+
+URL: https://example.org/docs/install/
+
+## Linux
+
+Run {{< cmd >}}.
+`)
+
+	b.AssertFileContent("public/docs/llms-full.txt", `# Documentation
+
+> Documentation for My Project.
+
+---
+
+## Install
+`, "## Options")
+	b.Assert(b.FileContent("public/docs/llms-full.txt"), qt.Not(qt.Contains), "About")
+	b.AssertDestinationExists("public/docs/llms.txt", false)
+}
+
+func TestLLMSTxtPlainContent(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+title = "My Docs"
+disableKinds = ["taxonomy", "term", "sitemap", "RSS", "section"]
+[outputs]
+home = ["html", "llmsfull"]
+[llmsTxt]
+content = "plain"
+-- content/p1.md --
+---
+title: "P1"
+---
+Some **bold** text with {{< cmd >}}.
+-- layouts/shortcodes/cmd.html --
+<code>install.sh</code>
+-- layouts/index.html --
+Home.
+-- layouts/_default/single.html --
+Single.
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/llms-full.txt", "URL: https://example.org/p1/\n\nSome bold text with install.sh.\n")
+}
@@ -0,0 +1,201 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package llmstxt builds the plain text content exports published with the
+// llms and llmsfull output formats, see https://llmstxt.org/.
+package llmstxt
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/hugofs/glob"
+	"github.com/mitchellh/mapstructure"
+)
+
+const (
+	llmsTxtConfigKey = "llmstxt"
+
+	// ContentRaw exports the raw content of the pages, usually Markdown.
+	ContentRaw = "raw"
+	// ContentPlain exports the rendered content of the pages as plain text.
+	ContentPlain = "plain"
+)
+
+// DefaultConfig is the default llms.txt config.
+var DefaultConfig = Config{
+	Content: ContentRaw,
+}
+
+// Config configures the llms.txt exports.
+// This can be configured per language.
+type Config struct {
+	// The description shown below the title. Defaults to the description
+	// of the home page or section.
+	Description string
+
+	// Glob patterns matching the paths of the pages to export, e.g. "/docs/**".
+	// Empty means all pages.
+	Include []string
+
+	// Glob patterns matching the paths of the pages to leave out.
+	// This takes precedence over Include.
+	Exclude []string
+
+	// The content exported in llms-full.txt, raw or plain.
+	Content string
+}
+
+// DecodeConfig creates a llms.txt Config from a given Hugo configuration.
+func DecodeConfig(cfg config.Provider) (Config, error) {
+	c := DefaultConfig
+
+	m := cfg.GetStringMap(llmsTxtConfigKey)
+	if m == nil {
+		return c, nil
+	}
+
+	if err := mapstructure.WeakDecode(m, &c); err != nil {
+		return c, fmt.Errorf("failed to decode llmsTxt config: %w", err)
+	}
+
+	for _, pattern := range append(append([]string(nil), c.Include...), c.Exclude...) {
+		if _, err := glob.GetGlob(pattern); err != nil {
+			return c, fmt.Errorf("llmsTxt: invalid glob pattern %q: %w", pattern, err)
+		}
+	}
+
+	c.Content = strings.ToLower(c.Content)
+	if c.Content != ContentRaw && c.Content != ContentPlain {
+		return c, fmt.Errorf("llmsTxt: invalid content %q, must be one of %q or %q", c.Content, ContentRaw, ContentPlain)
+	}
+
+	return c, nil
+}
+
+// Includes reports whether to export the page with the given path.
+func (c Config) Includes(path string) bool {
+	path = strings.ToLower(filepath.ToSlash(path))
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	match := func(patterns []string) bool {
+		for _, pattern := range patterns {
+			g, err := glob.GetGlob(pattern)
+			if err == nil && g.Match(path) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if match(c.Exclude) {
+		return false
+	}
+
+	return len(c.Include) == 0 || match(c.Include)
+}
+
+// Document is a llms.txt document.
+type Document struct {
+	Title       string
+	Description string
+	Sections    []Section
+}
+
+// Section is a group of pages, e.g. the pages in a content section.
+type Section struct {
+	// The section heading, none if empty.
+	Title string
+
+	Entries []Entry
+}
+
+// Entry is an exported page.
+type Entry struct {
+	Title       string
+	Description string
+	Permalink   string
+
+	// Only used in llms-full.txt.
+	Content string
+}
+
+// WriteIndex writes doc to w as an index linking to the pages.
+func WriteIndex(w io.Writer, doc Document) error {
+	ew := &errWriter{w: w}
+
+	writeHeader(ew, doc)
+	for _, s := range doc.Sections {
+		if len(s.Entries) == 0 {
+			continue
+		}
+		if s.Title != "" {
+			ew.printf("\n## %s\n", s.Title)
+		}
+		ew.printf("\n")
+		for _, e := range s.Entries {
+			ew.printf("- [%s](%s)", e.Title, e.Permalink)
+			if e.Description != "" {
+				ew.printf(": %s", oneLine(e.Description))
+			}
+			ew.printf("\n")
+		}
+	}
+
+	return ew.err
+}
+
+// WriteFull writes doc to w with the full content of the pages.
+func WriteFull(w io.Writer, doc Document) error {
+	ew := &errWriter{w: w}
+
+	writeHeader(ew, doc)
+	for _, s := range doc.Sections {
+		for _, e := range s.Entries {
+			ew.printf("\n---\n\n## %s\n\nURL: %s\n", e.Title, e.Permalink)
+			if content := strings.TrimSpace(e.Content); content != "" {
+				ew.printf("\n%s\n", content)
+			}
+		}
+	}
+
+	return ew.err
+}
+
+func writeHeader(ew *errWriter, doc Document) {
+	ew.printf("# %s\n", doc.Title)
+	if doc.Description != "" {
+		ew.printf("\n> %s\n", oneLine(doc.Description))
+	}
+}
+
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (ew *errWriter) printf(format string, args ...any) {
+	if ew.err != nil {
+		return
+	}
+	_, ew.err = fmt.Fprintf(ew.w, format, args...)
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package llmstxt
+
+import (
+	"bytes"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/config"
+)
+
+func TestDecodeConfig(t *testing.T) {
+	c := qt.New(t)
+
+	tomlConfig := `
+[llmsTxt]
+content = "Plain"
+include = ["/docs/**"]
+exclude = ["/docs/internal/**"]
+`
+	cfg, err := config.FromConfigString(tomlConfig, "toml")
+	c.Assert(err, qt.IsNil)
+
+	conf, err := DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Content, qt.Equals, ContentPlain)
+	c.Assert(conf.Includes("docs/p1.md"), qt.IsTrue)
+	c.Assert(conf.Includes("/Docs/sub/p2.md"), qt.IsTrue)
+	c.Assert(conf.Includes("docs/internal/p3.md"), qt.IsFalse)
+	c.Assert(conf.Includes("blog/p4.md"), qt.IsFalse)
+
+	conf, err = DecodeConfig(config.New())
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Content, qt.Equals, ContentRaw)
+	c.Assert(conf.Includes("blog/p4.md"), qt.IsTrue)
+
+	cfg = config.New()
+	cfg.Set("llmsTxt", map[string]any{"content": "html"})
+	_, err = DecodeConfig(cfg)
+	c.Assert(err, qt.ErrorMatches, ".*invalid content.*")
+
+	cfg = config.New()
+	cfg.Set("llmsTxt", map[string]any{"exclude": []string{"/a/[b"}})
+	_, err = DecodeConfig(cfg)
+	c.Assert(err, qt.ErrorMatches, ".*invalid glob pattern.*")
+}
+
+func TestWrite(t *testing.T) {
+	c := qt.New(t)
+
+	doc := Document{
+		Title:       "My Site",
+		Description: "All about\nmy site.",
+		Sections: []Section{
+			{Entries: []Entry{{Title: "About", Permalink: "https://example.org/about/", Content: "About me.\n"}}},
+			{Title: "Docs", Entries: []Entry{
+				{Title: "Install", Description: "How to install.", Permalink: "https://example.org/docs/install/", Content: "## Linux\n\nRun it."},
+				{Title: "Empty", Permalink: "https://example.org/docs/empty/"},
+			}},
+		},
+	}
+
+	var b bytes.Buffer
+	c.Assert(WriteIndex(&b, doc), qt.IsNil)
+	c.Assert(b.String(), qt.Equals, `# My Site
+
+> All about my site.
+
+- [About](https://example.org/about/)
+
+## Docs
+
+- [Install](https://example.org/docs/install/): How to install.
+- [Empty](https://example.org/docs/empty/)
+`)
+
+	b.Reset()
+	c.Assert(WriteFull(&b, doc), qt.IsNil)
+	c.Assert(b.String(), qt.Equals, `# My Site
+
+> All about my site.
+
+---
+
+## About
+
+URL: https://example.org/about/
+
+About me.
+
+---
+
+## Install
+
+URL: https://example.org/docs/install/
+
+## Linux
+
+Run it.
+
+---
+
+## Empty
+
+URL: https://example.org/docs/empty/
+`)
+}
@@ -0,0 +1,283 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package machinetranslation bootstraps the translation of multilingual sites:
+// it machine translates the missing i18n strings and flags the content pages
+// without a translation, and writes the results to a review directory instead
+// of publishing them.
+package machinetranslation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gohugoio/go-i18n/v2/i18n"
+	"github.com/gohugoio/hugo/common/hexec"
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/config"
+	"github.com/mitchellh/mapstructure"
+)
+
+const (
+	machineTranslationConfigKey = "machinetranslation"
+
+	// The maximum number of texts in one request.
+	batchSize = 50
+)
+
+// Config configures the machine translation.
+type Config struct {
+	// The translation provider, deepl or google.
+	// If not set, the missing translations are flagged, but not translated.
+	Provider string
+
+	// The API key of the provider. Set it with the
+	// HUGO_MACHINETRANSLATION_APIKEY environment variable to keep it out of
+	// the site config.
+	APIKey string
+
+	// The API endpoint, if not the provider's default.
+	// The endpoint must be allowed by the security.http policies.
+	Endpoint string
+
+	// Maps Hugo language codes to the provider's language codes when they
+	// differ, e.g. {en = "EN-GB"} for DeepL.
+	Languages map[string]string
+
+	// The directory, relative to the working directory, to write the results
+	// to for review. Defaults to "_translations".
+	ReviewDir string
+
+	// The timeout for one request. Defaults to 30s.
+	Timeout string
+}
+
+// DecodeConfig creates a Config from a given Hugo configuration.
+func DecodeConfig(cfg config.Provider) (Config, error) {
+	c := Config{
+		ReviewDir: "_translations",
+		Timeout:   "30s",
+	}
+
+	m := cfg.GetStringMap(machineTranslationConfigKey)
+	if m == nil {
+		return c, nil
+	}
+	delete(m, maps.MergeStrategyKey)
+
+	if err := mapstructure.WeakDecode(m, &c); err != nil {
+		return c, fmt.Errorf("failed to decode machineTranslation config: %w", err)
+	}
+
+	c.Provider = strings.ToLower(c.Provider)
+	if c.Provider != "" {
+		if _, found := providers[c.Provider]; !found {
+			return c, fmt.Errorf("machineTranslation: unknown provider %q, must be one of %s", c.Provider, strings.Join(providerNames(), ", "))
+		}
+	}
+	languages := make(map[string]string)
+	for k, v := range c.Languages {
+		languages[strings.ToLower(k)] = v
+	}
+	c.Languages = languages
+	if c.ReviewDir == "" {
+		return c, errors.New("machineTranslation: reviewDir must be set")
+	}
+	if _, err := time.ParseDuration(c.Timeout); err != nil {
+		return c, fmt.Errorf("machineTranslation: failed to parse timeout: %w", err)
+	}
+
+	return c, nil
+}
+
+// Translator translates texts between languages.
+type Translator interface {
+	// Translate translates texts from the language from to the language to,
+	// both Hugo language codes, and returns the translations in the same
+	// order. The texts are XML fragments where the elements must be kept as is.
+	Translate(ctx context.Context, texts []string, from, to string) ([]string, error)
+}
+
+// httpTranslator is a Translator calling a provider's HTTP API.
+type httpTranslator interface {
+	Translator
+
+	// The URL requested.
+	endpoint() string
+}
+
+type provider func(conf Config, client *http.Client) httpTranslator
+
+var providers = map[string]provider{
+	"deepl":  newDeepL,
+	"google": newGoogle,
+}
+
+func providerNames() []string {
+	var names []string
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// New creates the Translator of the configured provider.
+// It returns nil if no provider is configured.
+func New(conf Config, exec *hexec.Exec) (Translator, error) {
+	if conf.Provider == "" {
+		return nil, nil
+	}
+	p, found := providers[conf.Provider]
+	if !found {
+		return nil, fmt.Errorf("machineTranslation: unknown provider %q", conf.Provider)
+	}
+	if conf.APIKey == "" {
+		return nil, fmt.Errorf("machineTranslation: apiKey must be set for provider %q", conf.Provider)
+	}
+
+	timeout, err := time.ParseDuration(conf.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	t := p(conf, &http.Client{Timeout: timeout})
+	if err := exec.Sec().CheckAllowedHTTPURL(t.endpoint()); err != nil {
+		return nil, err
+	}
+	if err := exec.Sec().CheckAllowedHTTPMethod("POST"); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// languageCode returns the provider's code for the Hugo language lang.
+func (c Config) languageCode(lang string) string {
+	if code, found := c.Languages[strings.ToLower(lang)]; found {
+		return code
+	}
+	return lang
+}
+
+// Template actions, e.g. {{ .Count }}, must not be translated.
+var actionRe = regexp.MustCompile(`{{.*?}}`)
+
+// protect escapes s as XML with the template actions replaced by empty
+// elements, and returns it with the replaced actions.
+func protect(s string) (string, []string) {
+	var (
+		sb      strings.Builder
+		actions []string
+		last    int
+	)
+	for _, loc := range actionRe.FindAllStringIndex(s, -1) {
+		sb.WriteString(html.EscapeString(s[last:loc[0]]))
+		fmt.Fprintf(&sb, `<x id="%d"/>`, len(actions))
+		actions = append(actions, s[loc[0]:loc[1]])
+		last = loc[1]
+	}
+	sb.WriteString(html.EscapeString(s[last:]))
+	return sb.String(), actions
+}
+
+var placeholderRe = regexp.MustCompile(`<x id="(\d+)"\s*/?>(?:</x>)?`)
+
+// restore reverses protect for the translated s.
+func restore(s string, actions []string) string {
+	return placeholderRe.ReplaceAllStringFunc(html.UnescapeString(s), func(m string) string {
+		i, _ := strconv.Atoi(placeholderRe.FindStringSubmatch(m)[1])
+		if i < len(actions) {
+			return actions[i]
+		}
+		return m
+	})
+}
+
+// TranslateMessages translates the plural forms of messages from the
+// language from to the language to, in batches, and returns the translated
+// messages.
+func TranslateMessages(ctx context.Context, t Translator, messages []*i18n.Message, from, to string) ([]*i18n.Message, error) {
+	type ref struct {
+		form    *string
+		actions []string
+	}
+	var (
+		texts []string
+		refs  []ref
+	)
+
+	translated := make([]*i18n.Message, len(messages))
+	for i, m := range messages {
+		tm := &i18n.Message{ID: m.ID, Description: m.Description, LeftDelim: m.LeftDelim, RightDelim: m.RightDelim}
+		for _, f := range [][2]*string{
+			{&m.Zero, &tm.Zero},
+			{&m.One, &tm.One},
+			{&m.Two, &tm.Two},
+			{&m.Few, &tm.Few},
+			{&m.Many, &tm.Many},
+			{&m.Other, &tm.Other},
+		} {
+			if *f[0] == "" {
+				continue
+			}
+			text, actions := protect(*f[0])
+			texts = append(texts, text)
+			refs = append(refs, ref{form: f[1], actions: actions})
+		}
+		translated[i] = tm
+	}
+
+	for i := 0; i < len(texts); i += batchSize {
+		end := i + batchSize
+		if end > len(texts) {
+			end = len(texts)
+		}
+		result, err := t.Translate(ctx, texts[i:end], from, to)
+		if err != nil {
+			return nil, err
+		}
+		if len(result) != end-i {
+			return nil, fmt.Errorf("machineTranslation: got %d translations for %d texts", len(result), end-i)
+		}
+		for j, r := range result {
+			ref := refs[i+j]
+			*ref.form = restore(r, ref.actions)
+		}
+	}
+
+	return translated, nil
+}
+
+// MissingMessages returns the messages in source with no message with the
+// same ID in target, sorted by ID.
+func MissingMessages(source, target map[string]*i18n.Message) []*i18n.Message {
+	var missing []*i18n.Message
+	for id, m := range source {
+		if _, found := target[id]; !found {
+			missing = append(missing, m)
+		}
+	}
+	sort.Slice(missing, func(i, j int) bool {
+		return missing[i].ID < missing[j].ID
+	})
+	return missing
+}
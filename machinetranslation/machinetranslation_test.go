@@ -0,0 +1,225 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machinetranslation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/go-i18n/v2/i18n"
+	"github.com/gohugoio/hugo/common/hexec"
+	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/config/security"
+	"github.com/spf13/afero"
+)
+
+func TestDecodeConfig(t *testing.T) {
+	c := qt.New(t)
+
+	conf, err := DecodeConfig(config.New())
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Provider, qt.Equals, "")
+	c.Assert(conf.ReviewDir, qt.Equals, "_translations")
+
+	cfg := config.New()
+	cfg.Set("machineTranslation", map[string]any{
+		"provider":  "DeepL",
+		"apiKey":    "secret",
+		"languages": map[string]any{"EN": "EN-GB"},
+	})
+	conf, err = DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Provider, qt.Equals, "deepl")
+	c.Assert(conf.languageCode("en"), qt.Equals, "EN-GB")
+	c.Assert(conf.languageCode("nn"), qt.Equals, "nn")
+
+	for _, test := range []struct {
+		m      map[string]any
+		expect string
+	}{
+		{map[string]any{"provider": "foo"}, `machineTranslation: unknown provider "foo", must be one of deepl, google`},
+		{map[string]any{"reviewDir": ""}, "machineTranslation: reviewDir must be set"},
+		{map[string]any{"timeout": "foo"}, "machineTranslation: failed to parse timeout.*"},
+	} {
+		cfg = config.New()
+		cfg.Set("machineTranslation", test.m)
+		_, err = DecodeConfig(cfg)
+		c.Assert(err, qt.ErrorMatches, test.expect)
+	}
+}
+
+func TestNew(t *testing.T) {
+	c := qt.New(t)
+
+	exec := hexec.New(security.DefaultConfig)
+
+	tr, err := New(Config{}, exec)
+	c.Assert(err, qt.IsNil)
+	c.Assert(tr, qt.IsNil)
+
+	_, err = New(Config{Provider: "deepl", Timeout: "1s"}, exec)
+	c.Assert(err, qt.ErrorMatches, `machineTranslation: apiKey must be set for provider "deepl"`)
+
+	tr, err = New(Config{Provider: "deepl", APIKey: "secret:fx", Timeout: "1s"}, exec)
+	c.Assert(err, qt.IsNil)
+	c.Assert(tr.(httpTranslator).endpoint(), qt.Equals, deepLFreeEndpoint)
+
+	sec := security.DefaultConfig
+	sec.HTTP.URLs = security.NewWhitelist("https://example.org")
+	_, err = New(Config{Provider: "google", APIKey: "secret", Timeout: "1s"}, hexec.New(sec))
+	c.Assert(err, qt.ErrorMatches, "(?s).*access denied.*")
+}
+
+func TestProtect(t *testing.T) {
+	c := qt.New(t)
+
+	s, actions := protect(`{{ .Count }} <b>minutes</b> & {{- .Unit -}}`)
+	c.Assert(s, qt.Equals, `<x id="0"/> &lt;b&gt;minutes&lt;/b&gt; &amp; <x id="1"/>`)
+	c.Assert(actions, qt.DeepEquals, []string{"{{ .Count }}", "{{- .Unit -}}"})
+
+	c.Assert(restore(`<x id="1"></x> &amp; <x id="0" /> &lt;b&gt;Minuten&lt;/b&gt;`, actions), qt.Equals, `{{- .Unit -}} & {{ .Count }} <b>Minuten</b>`)
+}
+
+type testTranslator struct {
+	calls int
+}
+
+func (t *testTranslator) Translate(ctx context.Context, texts []string, from, to string) ([]string, error) {
+	t.calls++
+	translated := make([]string, len(texts))
+	for i, s := range texts {
+		translated[i] = fmt.Sprintf("%s-%s: %s", from, to, s)
+	}
+	return translated, nil
+}
+
+func TestTranslateMessages(t *testing.T) {
+	c := qt.New(t)
+
+	var messages []*i18n.Message
+	for i := 0; i < 30; i++ {
+		messages = append(messages, &i18n.Message{ID: fmt.Sprintf("m%d", i), One: "one", Other: "{{ .Count }} others"})
+	}
+	messages = append(messages, &i18n.Message{ID: "home", Description: "The home link", Other: "home"})
+
+	tr := &testTranslator{}
+	translated, err := TranslateMessages(context.Background(), tr, messages, "en", "nn")
+	c.Assert(err, qt.IsNil)
+	c.Assert(tr.calls, qt.Equals, 2)
+	c.Assert(translated, qt.HasLen, 31)
+	c.Assert(translated[0], qt.DeepEquals, &i18n.Message{ID: "m0", One: "en-nn: one", Other: "en-nn: {{ .Count }} others"})
+	c.Assert(translated[30], qt.DeepEquals, &i18n.Message{ID: "home", Description: "The home link", Other: "en-nn: home"})
+	// The source messages are left alone.
+	c.Assert(messages[0].One, qt.Equals, "one")
+}
+
+func TestMissingMessages(t *testing.T) {
+	c := qt.New(t)
+
+	source := map[string]*i18n.Message{"b": {ID: "b"}, "a": {ID: "a"}, "c": {ID: "c"}}
+	target := map[string]*i18n.Message{"b": {ID: "b"}}
+
+	c.Assert(MissingMessages(source, target), qt.DeepEquals, []*i18n.Message{{ID: "a"}, {ID: "c"}})
+	c.Assert(MissingMessages(source, source), qt.HasLen, 0)
+}
+
+func TestProviders(t *testing.T) {
+	c := qt.New(t)
+
+	var (
+		gotHeader http.Header
+		gotQuery  string
+		gotBody   map[string]any
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader, gotQuery = r.Header, r.URL.RawQuery
+		gotBody = nil
+		json.NewDecoder(r.Body).Decode(&gotBody)
+		switch r.URL.Path {
+		case "/deepl":
+			fmt.Fprint(w, `{"translations": [{"detected_source_language": "EN", "text": "Hallo"}]}`)
+		case "/google":
+			fmt.Fprint(w, `{"data": {"translations": [{"translatedText": "Hei"}]}}`)
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, "Wrong key")
+		}
+	}))
+	defer srv.Close()
+
+	conf := Config{APIKey: "secret", Languages: map[string]string{"en": "EN-GB"}}
+	ctx := context.Background()
+
+	conf.Endpoint = srv.URL + "/deepl"
+	res, err := newDeepL(conf, srv.Client()).Translate(ctx, []string{"Hello"}, "nn", "en")
+	c.Assert(err, qt.IsNil)
+	c.Assert(res, qt.DeepEquals, []string{"Hallo"})
+	c.Assert(gotHeader.Get("Authorization"), qt.Equals, "DeepL-Auth-Key secret")
+	c.Assert(gotBody, qt.DeepEquals, map[string]any{"text": []any{"Hello"}, "source_lang": "NN", "target_lang": "EN-GB", "tag_handling": "xml"})
+
+	conf.Endpoint = srv.URL + "/google"
+	res, err = newGoogle(conf, srv.Client()).Translate(ctx, []string{"Hello"}, "en", "nn")
+	c.Assert(err, qt.IsNil)
+	c.Assert(res, qt.DeepEquals, []string{"Hei"})
+	c.Assert(gotQuery, qt.Equals, "key=secret")
+	c.Assert(gotBody, qt.DeepEquals, map[string]any{"q": []any{"Hello"}, "source": "EN-GB", "target": "nn", "format": "html"})
+
+	conf.Endpoint = srv.URL + "/other"
+	_, err = newGoogle(conf, srv.Client()).Translate(ctx, []string{"Hello"}, "en", "nn")
+	c.Assert(err, qt.ErrorMatches, `machineTranslation: http://[^?]*/other: 403 Forbidden: Wrong key`)
+}
+
+func TestWriteReviews(t *testing.T) {
+	c := qt.New(t)
+
+	fs := afero.NewMemMapFs()
+	err := WriteReviews(fs, "/review", []Review{
+		{
+			SourceLang:        "en",
+			Lang:              "nn",
+			Messages:          []*i18n.Message{{ID: "readingTime", One: "Eitt minutt", Other: "{{ .Count }} minutt"}},
+			Translated:        "deepl",
+			UntranslatedPages: []string{"posts/p1.md"},
+		},
+		{
+			SourceLang: "en",
+			Lang:       "de",
+			Messages:   []*i18n.Message{{ID: "home", Other: "Home"}},
+		},
+	})
+	c.Assert(err, qt.IsNil)
+
+	b, err := afero.ReadFile(fs, "/review/i18n/nn.toml")
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(b), qt.Equals, `# Machine translated from "en" by deepl.
+# Review and move them to i18n/nn.toml.
+
+[readingTime]
+one = 'Eitt minutt'
+other = '{{ .Count }} minutt'
+`)
+
+	b, err = afero.ReadFile(fs, "/review/i18n/de.toml")
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(b), qt.Contains, "# Missing translations, in \"en\".\n")
+
+	b, err = afero.ReadFile(fs, "/review/untranslated.csv")
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(b), qt.Equals, "lang,path\nnn,posts/p1.md\n")
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machinetranslation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	deepLEndpoint     = "https://api.deepl.com/v2/translate"
+	deepLFreeEndpoint = "https://api-free.deepl.com/v2/translate"
+	googleEndpoint    = "https://translation.googleapis.com/language/translate/v2"
+)
+
+func postJSON(ctx context.Context, client *http.Client, u string, header http.Header, body, result any) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", u, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// The query may hold the API key, keep it out of the errors.
+	eu := *req.URL
+	eu.RawQuery = ""
+
+	resp, err := client.Do(req)
+	if err != nil {
+		var uerr *url.Error
+		if errors.As(err, &uerr) {
+			uerr.URL = eu.String()
+		}
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("machineTranslation: %s: %s: %s", eu.String(), resp.Status, bytes.TrimSpace(msg))
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// deepL uses the DeepL API, see https://www.deepl.com/docs-api.
+type deepL struct {
+	conf   Config
+	client *http.Client
+}
+
+func newDeepL(conf Config, client *http.Client) httpTranslator {
+	return &deepL{conf: conf, client: client}
+}
+
+func (t *deepL) endpoint() string {
+	if t.conf.Endpoint != "" {
+		return t.conf.Endpoint
+	}
+	// The keys of the free plan end with :fx.
+	if strings.HasSuffix(t.conf.APIKey, ":fx") {
+		return deepLFreeEndpoint
+	}
+	return deepLEndpoint
+}
+
+func (t *deepL) Translate(ctx context.Context, texts []string, from, to string) ([]string, error) {
+	body := map[string]any{
+		"text":         texts,
+		"source_lang":  strings.ToUpper(t.conf.languageCode(from)),
+		"target_lang":  strings.ToUpper(t.conf.languageCode(to)),
+		"tag_handling": "xml",
+	}
+	var result struct {
+		Translations []struct {
+			Text string `json:"text"`
+		} `json:"translations"`
+	}
+	header := http.Header{"Authorization": {"DeepL-Auth-Key " + t.conf.APIKey}}
+	if err := postJSON(ctx, t.client, t.endpoint(), header, body, &result); err != nil {
+		return nil, err
+	}
+
+	translated := make([]string, len(result.Translations))
+	for i, tr := range result.Translations {
+		translated[i] = tr.Text
+	}
+	return translated, nil
+}
+
+// google uses the Google Cloud Translation API (Basic),
+// see https://cloud.google.com/translate/docs/reference/rest/v2/translate.
+type google struct {
+	conf   Config
+	client *http.Client
+}
+
+func newGoogle(conf Config, client *http.Client) httpTranslator {
+	return &google{conf: conf, client: client}
+}
+
+func (t *google) endpoint() string {
+	if t.conf.Endpoint != "" {
+		return t.conf.Endpoint
+	}
+	return googleEndpoint
+}
+
+func (t *google) Translate(ctx context.Context, texts []string, from, to string) ([]string, error) {
+	body := map[string]any{
+		"q":      texts,
+		"source": t.conf.languageCode(from),
+		"target": t.conf.languageCode(to),
+		"format": "html",
+	}
+	var result struct {
+		Data struct {
+			Translations []struct {
+				TranslatedText string `json:"translatedText"`
+			} `json:"translations"`
+		} `json:"data"`
+	}
+	u := t.endpoint() + "?key=" + url.QueryEscape(t.conf.APIKey)
+	if err := postJSON(ctx, t.client, u, nil, body, &result); err != nil {
+		return nil, err
+	}
+
+	translated := make([]string, len(result.Data.Translations))
+	for i, tr := range result.Data.Translations {
+		translated[i] = tr.TranslatedText
+	}
+	return translated, nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machinetranslation
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"path/filepath"
+
+	"github.com/gohugoio/go-i18n/v2/i18n"
+	toml "github.com/pelletier/go-toml/v2"
+	"github.com/spf13/afero"
+)
+
+// UntranslatedPagesFilename is the name of the file in the review directory
+// listing the content pages without a translation.
+const UntranslatedPagesFilename = "untranslated.csv"
+
+// Review holds the missing translations of one language.
+type Review struct {
+	// The source language, usually the default content language.
+	SourceLang string
+
+	// The target language.
+	Lang string
+
+	// The i18n messages missing in Lang, machine translated if Translated is set,
+	// else in the source language.
+	Messages []*i18n.Message
+
+	// The provider the messages were translated with, empty if not translated.
+	Translated string
+
+	// The paths of the content files in the source language without a
+	// translation to Lang, relative to the content directory.
+	UntranslatedPages []string
+}
+
+// I18nFilename returns the filename of the i18n messages of r, relative to
+// the review directory.
+func (r Review) I18nFilename() string {
+	return filepath.Join("i18n", r.Lang+".toml")
+}
+
+// WriteReviews writes the i18n messages of each review to
+// i18n/<lang>.toml and the untranslated pages of all reviews to
+// untranslated.csv in dir.
+// Files from an earlier run are overwritten.
+func WriteReviews(fs afero.Fs, dir string, reviews []Review) error {
+	var (
+		pages   bytes.Buffer
+		hasPage bool
+	)
+	w := csv.NewWriter(&pages)
+	w.Write([]string{"lang", "path"})
+
+	for _, r := range reviews {
+		for _, p := range r.UntranslatedPages {
+			w.Write([]string{r.Lang, p})
+			hasPage = true
+		}
+		if len(r.Messages) == 0 {
+			continue
+		}
+		b, err := marshalMessages(r)
+		if err != nil {
+			return err
+		}
+		if err := writeFile(fs, filepath.Join(dir, r.I18nFilename()), b); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return err
+	}
+	if !hasPage {
+		return nil
+	}
+	return writeFile(fs, filepath.Join(dir, UntranslatedPagesFilename), pages.Bytes())
+}
+
+func marshalMessages(r Review) ([]byte, error) {
+	m := make(map[string]map[string]string)
+	for _, msg := range r.Messages {
+		mm := make(map[string]string)
+		for k, v := range map[string]string{
+			"description": msg.Description,
+			"zero":        msg.Zero,
+			"one":         msg.One,
+			"two":         msg.Two,
+			"few":         msg.Few,
+			"many":        msg.Many,
+			"other":       msg.Other,
+		} {
+			if v != "" {
+				mm[k] = v
+			}
+		}
+		m[msg.ID] = mm
+	}
+
+	var buf bytes.Buffer
+	if r.Translated != "" {
+		fmt.Fprintf(&buf, "# Machine translated from %q by %s.\n", r.SourceLang, r.Translated)
+	} else {
+		fmt.Fprintf(&buf, "# Missing translations, in %q.\n", r.SourceLang)
+	}
+	fmt.Fprintf(&buf, "# Review and move them to i18n/%s.toml.\n\n", r.Lang)
+	if err := toml.NewEncoder(&buf).Encode(m); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeFile(fs afero.Fs, filename string, b []byte) error {
+	if err := fs.MkdirAll(filepath.Dir(filename), 0777); err != nil {
+		return err
+	}
+	return afero.WriteFile(fs, filename, b, 0666)
+}
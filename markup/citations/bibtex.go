@@ -0,0 +1,492 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package citations
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ParseBibTeX parses the entries in the BibTeX source b.
+// @string macros are expanded and @comment and @preamble entries are skipped.
+// LaTeX markup in the values is reduced to plain text, e.g. {\"o} to ö.
+func ParseBibTeX(b []byte) ([]*Entry, error) {
+	p := &bibtexParser{src: string(b), macros: make(map[string]string)}
+	for k, v := range bibtexMonths {
+		p.macros[k] = v
+	}
+	return p.parse()
+}
+
+var bibtexMonths = map[string]string{
+	"jan": "January", "feb": "February", "mar": "March", "apr": "April",
+	"may": "May", "jun": "June", "jul": "July", "aug": "August",
+	"sep": "September", "oct": "October", "nov": "November", "dec": "December",
+}
+
+type bibtexParser struct {
+	src    string
+	pos    int
+	macros map[string]string
+}
+
+func (p *bibtexParser) parse() ([]*Entry, error) {
+	var entries []*Entry
+	for {
+		i := strings.IndexByte(p.src[p.pos:], '@')
+		if i == -1 {
+			return entries, nil
+		}
+		p.pos += i + 1
+
+		typ := strings.ToLower(p.identifier())
+		p.skipSpace()
+		if p.eof() || (p.peek() != '{' && p.peek() != '(') {
+			// Not an entry, e.g. an email address in a comment.
+			continue
+		}
+		open := p.next()
+		closing := byte('}')
+		if open == '(' {
+			closing = ')'
+		}
+
+		switch typ {
+		case "comment", "preamble":
+			if _, err := p.balanced(open, closing); err != nil {
+				return nil, err
+			}
+		case "string":
+			fields, err := p.fields(closing)
+			if err != nil {
+				return nil, err
+			}
+			for _, f := range fields {
+				p.macros[f[0]] = f[1]
+			}
+		default:
+			p.skipSpace()
+			key := strings.TrimSpace(p.until(",", string(closing)))
+			if key == "" {
+				return nil, p.errorf("missing citation key in @%s", typ)
+			}
+			if !p.eof() && p.peek() == ',' {
+				p.pos++
+			}
+			fields, err := p.fields(closing)
+			if err != nil {
+				return nil, fmt.Errorf("@%s{%s: %w", typ, key, err)
+			}
+			entries = append(entries, newBibTeXEntry(typ, key, fields))
+		}
+	}
+}
+
+// fields parses name = value pairs until closing.
+// The names are returned in lower case, the values as is, without the outer delimiters.
+func (p *bibtexParser) fields(closing byte) ([][2]string, error) {
+	var fields [][2]string
+	for {
+		p.skipSpace()
+		if p.eof() {
+			return nil, p.errorf("unexpected end of input")
+		}
+		if p.peek() == closing {
+			p.pos++
+			return fields, nil
+		}
+		if p.peek() == ',' {
+			p.pos++
+			continue
+		}
+		name := strings.ToLower(p.identifier())
+		if name == "" {
+			return nil, p.errorf("expected a field name, got %q", p.peek())
+		}
+		p.skipSpace()
+		if p.eof() || p.peek() != '=' {
+			return nil, p.errorf("expected = after field %q", name)
+		}
+		p.pos++
+		value, err := p.value(closing)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, [2]string{name, value})
+	}
+}
+
+// value parses a possibly # concatenated value.
+func (p *bibtexParser) value(closing byte) (string, error) {
+	var sb strings.Builder
+	for {
+		p.skipSpace()
+		if p.eof() {
+			return "", p.errorf("unexpected end of input")
+		}
+		switch c := p.peek(); {
+		case c == '{':
+			p.pos++
+			s, err := p.balanced('{', '}')
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(s)
+		case c == '"':
+			p.pos++
+			s, err := p.quoted()
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(s)
+		default:
+			s := p.identifier()
+			if s == "" {
+				return "", p.errorf("expected a value, got %q", c)
+			}
+			if v, found := p.macros[strings.ToLower(s)]; found {
+				s = v
+			}
+			sb.WriteString(s)
+		}
+		p.skipSpace()
+		if p.eof() || p.peek() != '#' {
+			return sb.String(), nil
+		}
+		p.pos++
+	}
+}
+
+// balanced returns the text until the closing delimiter matching the one just read,
+// excluding it.
+func (p *bibtexParser) balanced(open, closing byte) (string, error) {
+	start := p.pos
+	depth := 1
+	for ; p.pos < len(p.src); p.pos++ {
+		switch p.src[p.pos] {
+		case '\\':
+			p.pos++
+		case open:
+			depth++
+		case closing:
+			depth--
+			if depth == 0 {
+				s := p.src[start:p.pos]
+				p.pos++
+				return s, nil
+			}
+		}
+	}
+	return "", p.errorf("unbalanced %q", open)
+}
+
+func (p *bibtexParser) quoted() (string, error) {
+	start := p.pos
+	depth := 0
+	for ; p.pos < len(p.src); p.pos++ {
+		switch p.src[p.pos] {
+		case '\\':
+			p.pos++
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case '"':
+			if depth == 0 {
+				s := p.src[start:p.pos]
+				p.pos++
+				return s, nil
+			}
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
+
+func (p *bibtexParser) identifier() string {
+	start := p.pos
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if unicode.IsSpace(rune(c)) || strings.IndexByte(`{}(),="#@%`, c) != -1 {
+			break
+		}
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+func (p *bibtexParser) until(chars ...string) string {
+	start := p.pos
+	for p.pos < len(p.src) {
+		for _, c := range chars {
+			if strings.HasPrefix(p.src[p.pos:], c) {
+				return p.src[start:p.pos]
+			}
+		}
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+func (p *bibtexParser) skipSpace() {
+	for p.pos < len(p.src) && unicode.IsSpace(rune(p.src[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *bibtexParser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *bibtexParser) peek() byte {
+	return p.src[p.pos]
+}
+
+func (p *bibtexParser) next() byte {
+	c := p.src[p.pos]
+	p.pos++
+	return c
+}
+
+func (p *bibtexParser) errorf(format string, args ...any) error {
+	pos := p.pos
+	if pos > len(p.src) {
+		pos = len(p.src)
+	}
+	line := strings.Count(p.src[:pos], "\n") + 1
+	return fmt.Errorf("line %d: %s", line, fmt.Sprintf(format, args...))
+}
+
+func newBibTeXEntry(typ, key string, fields [][2]string) *Entry {
+	e := &Entry{ID: key, Type: typ, Fields: make(map[string]string)}
+	for _, f := range fields {
+		name, raw := f[0], f[1]
+		var value string
+		if name == "url" || name == "doi" {
+			// Keep these as is, e.g. ~ and -- are common in URLs.
+			value = strings.TrimSpace(strings.NewReplacer("{", "", "}", "", `\_`, "_", `\%`, "%", `\&`, "&", `\#`, "#").Replace(raw))
+		} else {
+			value = latexToText(raw)
+		}
+		e.Fields[name] = value
+		switch name {
+		case "title":
+			e.Title = value
+		case "author":
+			e.Authors = parseBibTeXNames(raw)
+		case "year":
+			e.Year = value
+		case "date":
+			if e.Year == "" && len(value) >= 4 {
+				e.Year = value[:4]
+			}
+		case "journal", "journaltitle", "booktitle":
+			if e.ContainerTitle == "" {
+				e.ContainerTitle = value
+			}
+		case "publisher":
+			e.Publisher = value
+		case "volume":
+			e.Volume = value
+		case "number", "issue":
+			e.Issue = value
+		case "pages":
+			e.Pages = value
+		case "url":
+			e.URL = value
+		case "doi":
+			e.DOI = value
+		}
+	}
+	if e.Publisher == "" {
+		e.Publisher = e.Fields["institution"]
+	}
+	return e
+}
+
+// parseBibTeXNames parses an and separated list of names, each on the form
+// "First Last", "Last, First" or "{Organization Name}".
+func parseBibTeXNames(s string) []Name {
+	var names []Name
+	for _, part := range splitAtDepth0(s, " and ") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if isBraced(part) {
+			names = append(names, Name{Family: latexToText(part)})
+			continue
+		}
+		if comma := splitAtDepth0(part, ","); len(comma) > 1 {
+			names = append(names, Name{
+				Family: latexToText(strings.TrimSpace(comma[0])),
+				Given:  latexToText(strings.TrimSpace(comma[len(comma)-1])),
+			})
+			continue
+		}
+		words := splitAtDepth0(part, " ")
+		if len(words) == 1 {
+			names = append(names, Name{Family: latexToText(words[0])})
+			continue
+		}
+		// The family name starts at the first lower case word (von part), if any, else it's the last word.
+		i := len(words) - 1
+		for j := 1; j < len(words)-1; j++ {
+			if w := words[j]; w != "" && unicode.IsLower(rune(w[0])) {
+				i = j
+				break
+			}
+		}
+		names = append(names, Name{
+			Family: latexToText(strings.Join(words[i:], " ")),
+			Given:  latexToText(strings.Join(words[:i], " ")),
+		})
+	}
+	return names
+}
+
+// isBraced reports whether all of s is in one pair of braces, e.g. {World Health Organization}.
+func isBraced(s string) bool {
+	if !strings.HasPrefix(s, "{") || !strings.HasSuffix(s, "}") {
+		return false
+	}
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 && i < len(s)-1 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// splitAtDepth0 splits s at sep outside of braces, case insensitively.
+func splitAtDepth0(s, sep string) []string {
+	var (
+		parts []string
+		depth int
+		start int
+	)
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		default:
+			if depth == 0 && i+len(sep) <= len(s) && strings.EqualFold(s[i:i+len(sep)], sep) {
+				if part := s[start:i]; strings.TrimSpace(part) != "" {
+					parts = append(parts, part)
+				}
+				i += len(sep) - 1
+				start = i + 1
+			}
+		}
+	}
+	if part := s[start:]; strings.TrimSpace(part) != "" {
+		parts = append(parts, part)
+	}
+	return parts
+}
+
+var latexAccents = map[byte]map[byte]string{
+	'"':  {'a': "ä", 'e': "ë", 'i': "ï", 'o': "ö", 'u': "ü", 'y': "ÿ", 'A': "Ä", 'E': "Ë", 'I': "Ï", 'O': "Ö", 'U': "Ü"},
+	'\'': {'a': "á", 'e': "é", 'i': "í", 'o': "ó", 'u': "ú", 'y': "ý", 'c': "ć", 'n': "ń", 's': "ś", 'z': "ź", 'A': "Á", 'E': "É", 'I': "Í", 'O': "Ó", 'U': "Ú", 'C': "Ć", 'S': "Ś", 'Z': "Ź"},
+	'`':  {'a': "à", 'e': "è", 'i': "ì", 'o': "ò", 'u': "ù", 'A': "À", 'E': "È", 'I': "Ì", 'O': "Ò", 'U': "Ù"},
+	'^':  {'a': "â", 'e': "ê", 'i': "î", 'o': "ô", 'u': "û", 'A': "Â", 'E': "Ê", 'I': "Î", 'O': "Ô", 'U': "Û"},
+	'~':  {'a': "ã", 'n': "ñ", 'o': "õ", 'A': "Ã", 'N': "Ñ", 'O': "Õ"},
+	'c':  {'c': "ç", 's': "ş", 'C': "Ç", 'S': "Ş"},
+	'v':  {'c': "č", 's': "š", 'z': "ž", 'r': "ř", 'e': "ě", 'C': "Č", 'S': "Š", 'Z': "Ž", 'R': "Ř"},
+}
+
+var latexSymbols = map[string]string{
+	"ss": "ß", "o": "ø", "O": "Ø", "aa": "å", "AA": "Å", "ae": "æ", "AE": "Æ",
+	"l": "ł", "L": "Ł", "i": "ı", "oe": "œ", "OE": "Œ",
+}
+
+// latexToText reduces the LaTeX markup in s to plain text.
+func latexToText(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '{', '}':
+		case '~':
+			sb.WriteRune(' ')
+		case '-':
+			if strings.HasPrefix(s[i:], "---") {
+				sb.WriteString("—")
+				i += 2
+			} else if strings.HasPrefix(s[i:], "--") {
+				sb.WriteString("–")
+				i++
+			} else {
+				sb.WriteByte(c)
+			}
+		case '\\':
+			if i+1 >= len(s) {
+				continue
+			}
+			i++
+			next := s[i]
+			if accents, ok := latexAccents[next]; ok && (!isLetter(next) || i+1 < len(s) && !isLetter(s[i+1])) {
+				// E.g. \"o, \"{o}, \c{c} or \c c.
+				j := i + 1
+				for j < len(s) && (s[j] == '{' || s[j] == ' ') {
+					j++
+				}
+				if j < len(s) {
+					if r, ok := accents[s[j]]; ok {
+						sb.WriteString(r)
+						i = j
+						continue
+					}
+				}
+				if !isLetter(next) {
+					continue
+				}
+			}
+			if !isLetter(next) {
+				// An escaped character, e.g. \&.
+				sb.WriteByte(next)
+				continue
+			}
+			j := i
+			for j < len(s) && isLetter(s[j]) {
+				j++
+			}
+			cmd := s[i:j]
+			if r, ok := latexSymbols[cmd]; ok {
+				sb.WriteString(r)
+			}
+			// Drop other commands, e.g. \emph, but keep their arguments.
+			for j < len(s) && s[j] == ' ' {
+				j++
+			}
+			i = j - 1
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return strings.Join(strings.Fields(sb.String()), " ")
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
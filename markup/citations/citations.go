@@ -0,0 +1,119 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package citations provides bibliography entries read from BibTeX and CSL JSON
+// files, used to render citations in Markdown.
+package citations
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// Entry is a bibliography entry.
+type Entry struct {
+	// The citation key, e.g. knuth1984.
+	ID string
+	// The entry type as given in the source, e.g. article or article-journal.
+	Type string
+
+	Title   string
+	Authors []Name
+	// The year of publication, may be empty.
+	Year string
+	// The journal or book title.
+	ContainerTitle string
+	Publisher      string
+	Volume         string
+	Issue          string
+	Pages          string
+	URL            string
+	DOI            string
+
+	// All fields with a string value, keyed by their lower case name.
+	Fields map[string]string
+}
+
+// Name is a person's name, or the name of an organization in Family.
+type Name struct {
+	Family string
+	Given  string
+}
+
+func (n Name) String() string {
+	if n.Given == "" {
+		return n.Family
+	}
+	return n.Given + " " + n.Family
+}
+
+// Bibliography holds entries by their ID.
+type Bibliography struct {
+	entries map[string]*Entry
+}
+
+// NewBibliography creates a new Bibliography with the given entries.
+func NewBibliography(entries ...*Entry) *Bibliography {
+	b := &Bibliography{entries: make(map[string]*Entry)}
+	b.Add(entries...)
+	return b
+}
+
+// Add adds the given entries, replacing any existing entries with the same ID.
+func (b *Bibliography) Add(entries ...*Entry) {
+	for _, e := range entries {
+		b.entries[e.ID] = e
+	}
+}
+
+// Get gets the entry with the given ID.
+func (b *Bibliography) Get(id string) (*Entry, bool) {
+	if b == nil {
+		return nil, false
+	}
+	e, found := b.entries[id]
+	return e, found
+}
+
+// Len returns the number of entries.
+func (b *Bibliography) Len() int {
+	if b == nil {
+		return 0
+	}
+	return len(b.entries)
+}
+
+// Decode decodes the entries in r, using the extension of filename to
+// determine the format, .bib for BibTeX or .json for CSL JSON.
+func Decode(filename string, r io.Reader) ([]*Entry, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*Entry
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".bib", ".bibtex":
+		entries, err = ParseBibTeX(b)
+	case ".json":
+		entries, err = ParseCSLJSON(b)
+	default:
+		return nil, fmt.Errorf("unsupported bibliography format %q, must be .bib or .json", filepath.Ext(filename))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %q: %w", filename, err)
+	}
+	return entries, nil
+}
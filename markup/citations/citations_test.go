@@ -0,0 +1,149 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package citations
+
+import (
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestParseBibTeX(t *testing.T) {
+	c := qt.New(t)
+
+	entries, err := ParseBibTeX([]byte(`
+This is a comment with an email, me@example.org.
+
+@string{tcj = "The Computer Journal"}
+
+@comment{ @article{ignored, title = {Ignored}} }
+
+@article{knuth1984,
+  author  = {Knuth, Donald E.},
+  title   = {Literate {P}rogramming},
+  journal = tcj,
+  year    = 1984,
+  month   = may,
+  volume  = {27},
+  number  = {2},
+  pages   = {97--111},
+  doi     = {10.1093/comjnl/27.2.97},
+}
+
+@book(kr1988,
+  author = "Brian W. Kernighan and Dennis M. Ritchie",
+  title = "The {C} Programming Language",
+  publisher = {Prentice Hall},
+  year = {1988}
+)
+
+@misc{who,
+  author = {{World Health Organization} and Ludwig van Beethoven and Gödel, Kurt and Erd{\H o}s, Paul and Schr{\"o}dinger, Erwin},
+  title = {Caf\'{e} \& Cr\c{c}pes, 50\% off},
+  url = {https://example.org/~who/a--b},
+}
+`))
+	c.Assert(err, qt.IsNil)
+	c.Assert(entries, qt.HasLen, 3)
+
+	knuth := entries[0]
+	c.Assert(knuth.ID, qt.Equals, "knuth1984")
+	c.Assert(knuth.Type, qt.Equals, "article")
+	c.Assert(knuth.Title, qt.Equals, "Literate Programming")
+	c.Assert(knuth.Authors, qt.DeepEquals, []Name{{Family: "Knuth", Given: "Donald E."}})
+	c.Assert(knuth.ContainerTitle, qt.Equals, "The Computer Journal")
+	c.Assert(knuth.Year, qt.Equals, "1984")
+	c.Assert(knuth.Fields["month"], qt.Equals, "May")
+	c.Assert(knuth.Pages, qt.Equals, "97–111")
+	c.Assert(knuth.DOI, qt.Equals, "10.1093/comjnl/27.2.97")
+	c.Assert(knuth.Label(), qt.Equals, "Knuth 1984")
+	c.Assert(knuth.Reference(), qt.Equals, "Knuth, D. E. (1984). Literate Programming. The Computer Journal, 27(2), 97–111.")
+
+	kr := entries[1]
+	c.Assert(kr.Authors, qt.DeepEquals, []Name{{Family: "Kernighan", Given: "Brian W."}, {Family: "Ritchie", Given: "Dennis M."}})
+	c.Assert(kr.Title, qt.Equals, "The C Programming Language")
+	c.Assert(kr.Label(), qt.Equals, "Kernighan and Ritchie 1988")
+	c.Assert(kr.Reference(), qt.Equals, "Kernighan, B. W., & Ritchie, D. M. (1988). The C Programming Language. Prentice Hall.")
+
+	who := entries[2]
+	c.Assert(who.Authors, qt.DeepEquals, []Name{
+		{Family: "World Health Organization"},
+		{Family: "van Beethoven", Given: "Ludwig"},
+		{Family: "Gödel", Given: "Kurt"},
+		{Family: "Erdos", Given: "Paul"},
+		{Family: "Schrödinger", Given: "Erwin"},
+	})
+	c.Assert(who.Title, qt.Equals, "Café & Crçpes, 50% off")
+	c.Assert(who.URL, qt.Equals, "https://example.org/~who/a--b")
+	c.Assert(who.Label(), qt.Equals, "World Health Organization et al.")
+	c.Assert(who.Reference(), qt.Equals, "World Health Organization, van Beethoven, L., Gödel, K., Erdos, P., & Schrödinger, E. (n.d.). Café & Crçpes, 50% off.")
+}
+
+func TestParseBibTeXErrors(t *testing.T) {
+	c := qt.New(t)
+
+	_, err := ParseBibTeX([]byte("@article{a,\n title = {Unbalanced\n"))
+	c.Assert(err, qt.ErrorMatches, `@article{a: line 3: unbalanced '{'`)
+
+	_, err = ParseBibTeX([]byte("@article{a, title}"))
+	c.Assert(err, qt.ErrorMatches, `@article{a: line 1: expected = after field "title"`)
+}
+
+func TestParseCSLJSON(t *testing.T) {
+	c := qt.New(t)
+
+	entries, err := Decode("refs.json", strings.NewReader(`[
+  {
+    "id": "lamport1978",
+    "type": "article-journal",
+    "title": "Time, clocks, and the ordering of events in a distributed system",
+    "author": [{"family": "Lamport", "given": "Leslie"}],
+    "issued": {"date-parts": [[1978, 7]]},
+    "container-title": "Communications of the ACM",
+    "volume": "21",
+    "issue": 7,
+    "page": "558-565",
+    "DOI": "10.1145/359545.359563"
+  },
+  {
+    "id": 42,
+    "title": "Report",
+    "author": [{"literal": "ACME Corp."}, {"family": "Hugo", "given": "Victor"}, {"family": "Gogh", "given": "Vincent", "non-dropping-particle": "van"}],
+    "issued": {"raw": "2020-01-01"}
+  }
+]`))
+	c.Assert(err, qt.IsNil)
+	c.Assert(entries, qt.HasLen, 2)
+
+	e := entries[0]
+	c.Assert(e.ID, qt.Equals, "lamport1978")
+	c.Assert(e.Type, qt.Equals, "article-journal")
+	c.Assert(e.Year, qt.Equals, "1978")
+	c.Assert(e.Issue, qt.Equals, "7")
+	c.Assert(e.DOI, qt.Equals, "10.1145/359545.359563")
+	c.Assert(e.Reference(), qt.Equals, "Lamport, L. (1978). Time, clocks, and the ordering of events in a distributed system. Communications of the ACM, 21(7), 558–565.")
+
+	e = entries[1]
+	c.Assert(e.ID, qt.Equals, "42")
+	c.Assert(e.Year, qt.Equals, "2020")
+	c.Assert(e.Label(), qt.Equals, "ACME Corp. et al. 2020")
+	c.Assert(e.Authors[2].String(), qt.Equals, "Vincent van Gogh")
+
+	_, err = Decode("refs.json", strings.NewReader(`[{"title": "No ID"}]`))
+	c.Assert(err, qt.ErrorMatches, `failed to decode "refs.json": item 0: missing id`)
+
+	_, err = Decode("refs.yaml", strings.NewReader(``))
+	c.Assert(err, qt.ErrorMatches, `unsupported bibliography format ".yaml", must be .bib or .json`)
+}
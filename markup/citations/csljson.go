@@ -0,0 +1,106 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package citations
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cast"
+)
+
+// ParseCSLJSON parses the entries in the CSL JSON source b,
+// as exported by e.g. Zotero.
+func ParseCSLJSON(b []byte) ([]*Entry, error) {
+	var items []map[string]any
+	if err := json.Unmarshal(b, &items); err != nil {
+		return nil, err
+	}
+
+	entries := make([]*Entry, 0, len(items))
+	for i, item := range items {
+		id := cast.ToString(item["id"])
+		if id == "" {
+			return nil, fmt.Errorf("item %d: missing id", i)
+		}
+		e := &Entry{ID: id, Fields: make(map[string]string)}
+		for k, v := range item {
+			k = strings.ToLower(k)
+			if s, err := cast.ToStringE(v); err == nil {
+				e.Fields[k] = s
+			}
+			switch k {
+			case "type":
+				e.Type = cast.ToString(v)
+			case "title":
+				e.Title = cast.ToString(v)
+			case "author":
+				e.Authors = cslNames(v)
+			case "issued":
+				e.Year = cslYear(v)
+			case "container-title":
+				e.ContainerTitle = cast.ToString(v)
+			case "publisher":
+				e.Publisher = cast.ToString(v)
+			case "volume":
+				e.Volume = cast.ToString(v)
+			case "issue":
+				e.Issue = cast.ToString(v)
+			case "page":
+				e.Pages = strings.Replace(cast.ToString(v), "-", "–", 1)
+			case "url":
+				e.URL = cast.ToString(v)
+			case "doi":
+				e.DOI = cast.ToString(v)
+			}
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+func cslNames(v any) []Name {
+	var names []Name
+	for _, n := range cast.ToSlice(v) {
+		m := cast.ToStringMap(n)
+		if literal := cast.ToString(m["literal"]); literal != "" {
+			names = append(names, Name{Family: literal})
+			continue
+		}
+		family := cast.ToString(m["family"])
+		if particle := cast.ToString(m["non-dropping-particle"]); particle != "" {
+			family = particle + " " + family
+		}
+		names = append(names, Name{Family: family, Given: cast.ToString(m["given"])})
+	}
+	return names
+}
+
+// cslYear returns the year in a CSL date, e.g. {"date-parts": [[2023, 5, 1]]}.
+func cslYear(v any) string {
+	m := cast.ToStringMap(v)
+	if parts := cast.ToSlice(m["date-parts"]); len(parts) > 0 {
+		if first := cast.ToSlice(parts[0]); len(first) > 0 {
+			return cast.ToString(first[0])
+		}
+	}
+	for _, k := range []string{"raw", "literal"} {
+		if s := cast.ToString(m[k]); len(s) >= 4 {
+			return s[:4]
+		}
+	}
+	return ""
+}
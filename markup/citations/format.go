@@ -0,0 +1,117 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package citations
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ShortAuthors returns the family names of the authors in the short form
+// used in author-date citations, e.g. "Knuth", "Kernighan and Ritchie" or
+// "Gamma et al.". If there are no authors, the title is returned.
+func (e *Entry) ShortAuthors() string {
+	switch len(e.Authors) {
+	case 0:
+		return e.Title
+	case 1:
+		return e.Authors[0].Family
+	case 2:
+		return e.Authors[0].Family + " and " + e.Authors[1].Family
+	default:
+		return e.Authors[0].Family + " et al."
+	}
+}
+
+// Label returns the author-date label used in citations, e.g. "Knuth 1984".
+func (e *Entry) Label() string {
+	if e.Year == "" {
+		return e.ShortAuthors()
+	}
+	return e.ShortAuthors() + " " + e.Year
+}
+
+// Reference returns the entry formatted as a plain text reference in
+// author-date style, excluding the DOI and URL, e.g.
+// "Knuth, D. E. (1984). Literate programming. The Computer Journal, 27(2), 97–111."
+func (e *Entry) Reference() string {
+	var sb strings.Builder
+
+	if len(e.Authors) > 0 {
+		for i, a := range e.Authors {
+			if i > 0 {
+				if i == len(e.Authors)-1 {
+					sb.WriteString(", & ")
+				} else {
+					sb.WriteString(", ")
+				}
+			}
+			sb.WriteString(a.Family)
+			if initials := initials(a.Given); initials != "" {
+				sb.WriteString(", " + initials)
+			}
+		}
+		sb.WriteByte(' ')
+	}
+
+	if e.Year != "" {
+		sb.WriteString("(" + e.Year + "). ")
+	} else if sb.Len() > 0 {
+		sb.WriteString("(n.d.). ")
+	}
+
+	if e.Title != "" {
+		sb.WriteString(withPeriod(e.Title) + " ")
+	}
+
+	if e.ContainerTitle != "" {
+		sb.WriteString(e.ContainerTitle)
+		if e.Volume != "" {
+			sb.WriteString(", " + e.Volume)
+			if e.Issue != "" {
+				sb.WriteString("(" + e.Issue + ")")
+			}
+		}
+		if e.Pages != "" {
+			sb.WriteString(", " + e.Pages)
+		}
+		sb.WriteString(". ")
+	}
+
+	if e.Publisher != "" {
+		sb.WriteString(withPeriod(e.Publisher) + " ")
+	}
+
+	return strings.TrimSpace(sb.String())
+}
+
+// initials returns the initials of the given names, e.g. "D. E." for "Donald Ervin".
+func initials(given string) string {
+	var parts []string
+	for _, name := range strings.Fields(given) {
+		r, _ := utf8.DecodeRuneInString(name)
+		if unicode.IsLetter(r) {
+			parts = append(parts, string(unicode.ToUpper(r))+".")
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func withPeriod(s string) string {
+	if strings.HasSuffix(s, ".") || strings.HasSuffix(s, "?") || strings.HasSuffix(s, "!") {
+		return s
+	}
+	return s + "."
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/gohugoio/hugo/common/loggers"
 	"github.com/gohugoio/hugo/config"
 	"github.com/gohugoio/hugo/identity"
+	"github.com/gohugoio/hugo/markup/citations"
 	"github.com/gohugoio/hugo/markup/converter/hooks"
 	"github.com/gohugoio/hugo/markup/highlight"
 	"github.com/gohugoio/hugo/markup/markup_config"
@@ -121,6 +122,12 @@ type TableOfContentsProvider interface {
 	TableOfContents() *tableofcontents.Fragments
 }
 
+// PlainTextProvider provides the content as plain text extracted from the
+// parsed document, i.e. without rendering it to HTML first.
+type PlainTextProvider interface {
+	PlainText() []byte
+}
+
 // AnchorNameSanitizer tells how a converter sanitizes anchor names.
 type AnchorNameSanitizer interface {
 	SanitizeAnchorName(s string) string
@@ -140,6 +147,10 @@ type DocumentContext struct {
 	DocumentID   string
 	DocumentName string
 	Filename     string
+
+	// Bibliography returns the bibliography used to render citations and
+	// the identities of the files it was loaded from. May be nil.
+	Bibliography func() (*citations.Bibliography, []identity.Provider, error)
 }
 
 // RenderContext holds contextual information about the content to render.
@@ -21,6 +21,7 @@ import (
 	"github.com/gohugoio/hugo/common/text"
 	"github.com/gohugoio/hugo/common/types/hstring"
 	"github.com/gohugoio/hugo/identity"
+	"github.com/gohugoio/hugo/markup/citations"
 	"github.com/gohugoio/hugo/markup/internal/attributes"
 )
 
@@ -47,6 +48,17 @@ type LinkContext interface {
 
 	// The plain variant of Text.
 	PlainText() string
+
+	// Zero-based ordinal for all the links in the current document.
+	// For images, this is the ordinal for all the images in the current document.
+	Ordinal() int
+
+	// The closest heading before the link in the current document,
+	// nil if there is none.
+	Heading() *HeadingInfo
+
+	// The approximate position of the link in the source document.
+	text.Positioner
 }
 
 // ImageLinkContext is the context passed to a image link render hook.
@@ -56,9 +68,16 @@ type ImageLinkContext interface {
 	// Returns true if this is a standalone image and the config option
 	// markup.goldmark.parser.wrapStandAloneImageWithinParagraph is disabled.
 	IsBlock() bool
+}
 
-	// Zero-based ordinal for all the images in the current document.
-	Ordinal() int
+// HeadingInfo holds information about a heading in a Markdown document.
+type HeadingInfo struct {
+	// Level is the level of the heading (i.e. 1 for top-level, 2 for sub-level, etc.).
+	Level int
+	// Anchor is the HTML id assigned to the heading.
+	Anchor string
+	// PlainText is the heading text without any markup.
+	PlainText string
 }
 
 // CodeblockContext is the context passed to a code block render hook.
@@ -73,8 +92,16 @@ type CodeblockContext interface {
 	Type() string
 
 	// The text between the code fences.
+	// With the diff or annotations option set, any diff markers and
+	// annotations are removed.
 	Inner() string
 
+	// The lines in Inner with their diff status and notes.
+	Lines() []CodeblockLine
+
+	// The collapsible regions, only set with the annotations option.
+	Regions() []CodeblockRegion
+
 	// Zero-based ordinal for all code blocks in the current document.
 	Ordinal() int
 
@@ -82,6 +109,35 @@ type CodeblockContext interface {
 	Page() any
 }
 
+// Diff status of a code block line.
+const (
+	CodeblockLineAdded   = "added"
+	CodeblockLineRemoved = "removed"
+)
+
+// CodeblockLine is a line in a code block.
+type CodeblockLine struct {
+	// The 1-based line number.
+	Number int
+	// The line without any diff marker or annotations.
+	Text string
+	// The diff status of the line, "added", "removed" or empty.
+	Diff string
+	// Notes anchored to this line, e.g. from a [!note text] annotation.
+	Notes []string
+}
+
+// CodeblockRegion is a region of lines in a code block marked with
+// [!region title] and [!endregion] annotations, e.g. to be rendered collapsed.
+type CodeblockRegion struct {
+	// The region title, may be empty.
+	Title string
+	// The first and last 1-based line numbers in the region, inclusive.
+	// Regions can be nested.
+	Start int
+	End   int
+}
+
 type AttributesOptionsSliceProvider interface {
 	AttributesSlice() []attributes.Attribute
 	OptionsSlice() []attributes.Attribute
@@ -126,6 +182,291 @@ type HeadingRenderer interface {
 	identity.Provider
 }
 
+// FAQContext contains accessors to all attributes that a FAQRenderer
+// can use to render a definition list as frequently asked questions.
+type FAQContext interface {
+	// Page is the page containing the FAQ.
+	Page() any
+	// Items are the questions and their answers in the FAQ.
+	Items() []FAQItem
+	// Ordinal is the zero-based index of the FAQ on the page.
+	Ordinal() int
+
+	// Attributes (e.g. CSS classes)
+	AttributesProvider
+}
+
+// FAQItem is a question and its answer.
+type FAQItem struct {
+	// Question is the rendered (HTML) question, i.e. the definition term.
+	Question hstring.RenderedString
+	// Answer is the rendered (HTML) answer, i.e. the definition description(s).
+	Answer hstring.RenderedString
+	// PlainQuestion is the unrendered version of Question.
+	PlainQuestion string
+	// PlainAnswer is the unrendered version of Answer.
+	PlainAnswer string
+}
+
+// FAQRenderer describes a uniquely identifiable rendering hook.
+type FAQRenderer interface {
+	// RenderFAQ writes the rendered content to w using the data in ctx.
+	RenderFAQ(cctx context.Context, w io.Writer, ctx FAQContext) error
+	identity.Provider
+}
+
+// BlockquoteContext is the context passed to a blockquote render hook.
+type BlockquoteContext interface {
+	// Page is the page containing the blockquote.
+	Page() any
+
+	// Zero-based ordinal for all the blockquotes in the current document.
+	Ordinal() int
+
+	// The blockquote type, "alert" for GitHub alerts and Obsidian callouts,
+	// else "regular".
+	Type() string
+
+	// The alert type in lower case, e.g. "note" for "> [!NOTE]".
+	// Empty if this is not an alert.
+	AlertType() string
+
+	// The alert title, e.g. "Title" for the Obsidian callout "> [!tip] Title".
+	AlertTitle() string
+
+	// The alert sign, "+" or "-" for foldable Obsidian callouts, e.g. "> [!faq]- Title".
+	AlertSign() string
+
+	// The rendered (HTML) text, excluding the alert marker.
+	Text() hstring.RenderedString
+
+	// Attributes (e.g. CSS classes)
+	AttributesProvider
+	text.Positioner
+}
+
+// BlockquoteRenderer describes a uniquely identifiable rendering hook.
+type BlockquoteRenderer interface {
+	// RenderBlockquote writes the rendered content to w using the data in ctx.
+	RenderBlockquote(cctx context.Context, w io.Writer, ctx BlockquoteContext) error
+	identity.Provider
+}
+
+// PassthroughContext is the context passed to a passthrough render hook.
+type PassthroughContext interface {
+	// Page is the page containing the passthrough element.
+	Page() any
+
+	// Zero-based ordinal for all the passthrough elements in the current document.
+	Ordinal() int
+
+	// The passthrough element type, "inline" or "block".
+	Type() string
+
+	// The raw text between the delimiters.
+	Inner() string
+
+	// Attributes (e.g. CSS classes), only set for block elements.
+	AttributesProvider
+	text.Positioner
+}
+
+// PassthroughRenderer describes a uniquely identifiable rendering hook.
+type PassthroughRenderer interface {
+	// RenderPassthrough writes the rendered content to w using the data in ctx.
+	RenderPassthrough(cctx context.Context, w io.Writer, ctx PassthroughContext) error
+	identity.Provider
+}
+
+// FootnoteRefContext is the context passed to a footnote reference render hook.
+type FootnoteRefContext interface {
+	// Page is the page containing the footnote reference.
+	Page() any
+
+	// The one-based index of the footnote, e.g. 1 for the first footnote.
+	Index() int
+
+	// The footnote label, e.g. "note" for "[^note]".
+	Label() string
+
+	// The zero-based index of this reference among the references to the footnote.
+	RefIndex() int
+
+	// The number of references to the footnote.
+	RefCount() int
+
+	// The element ID of this reference, e.g. "fnref:1".
+	ID() string
+
+	// The element ID of the footnote, e.g. "fn:1".
+	FootnoteID() string
+
+	text.Positioner
+}
+
+// FootnoteRefRenderer describes a uniquely identifiable rendering hook.
+type FootnoteRefRenderer interface {
+	// RenderFootnoteRef writes the rendered content to w using the data in ctx.
+	RenderFootnoteRef(cctx context.Context, w io.Writer, ctx FootnoteRefContext) error
+	identity.Provider
+}
+
+// FootnotesContext is the context passed to a footnotes render hook,
+// which renders the list of footnotes at the end of the document.
+type FootnotesContext interface {
+	// Page is the page containing the footnotes.
+	Page() any
+
+	// The footnotes in the order they are first referenced.
+	Footnotes() []Footnote
+
+	// Attributes (e.g. CSS classes)
+	AttributesProvider
+}
+
+// Footnote is a footnote in the list of footnotes.
+type Footnote struct {
+	// The one-based index of the footnote.
+	Index int
+	// The footnote label, e.g. "note" for "[^note]".
+	Label string
+	// The element ID of the footnote, e.g. "fn:1".
+	ID string
+	// The element IDs of the references to the footnote, e.g. "fnref:1".
+	RefIDs []string
+	// The rendered (HTML) footnote text.
+	Text hstring.RenderedString
+}
+
+// FootnotesRenderer describes a uniquely identifiable rendering hook.
+type FootnotesRenderer interface {
+	// RenderFootnotes writes the rendered content to w using the data in ctx.
+	RenderFootnotes(cctx context.Context, w io.Writer, ctx FootnotesContext) error
+	identity.Provider
+}
+
+// CitationContext is the context passed to a citation render hook,
+// e.g. for "[see @knuth1984, p. 97; @lamport1994]".
+type CitationContext interface {
+	// Page is the page containing the citation.
+	Page() any
+
+	// Zero-based ordinal for all the citations in the current document.
+	Ordinal() int
+
+	// The cited entries, in the order given.
+	Citations() []Citation
+
+	text.Positioner
+}
+
+// Citation is a reference to a bibliography entry in a citation.
+type Citation struct {
+	// The citation key, e.g. "knuth1984".
+	Key string
+	// The text before the key, e.g. "see".
+	Prefix string
+	// The text after the key, e.g. "p. 97".
+	Suffix string
+	// The one-based number of the entry, in the order the entries are first cited in the document.
+	Number int
+	// The bibliography entry.
+	Entry *citations.Entry
+}
+
+// CitationRenderer describes a uniquely identifiable rendering hook.
+type CitationRenderer interface {
+	// RenderCitation writes the rendered content to w using the data in ctx.
+	RenderCitation(cctx context.Context, w io.Writer, ctx CitationContext) error
+	identity.Provider
+}
+
+// BibliographyContext is the context passed to a bibliography render hook,
+// which renders the entries cited in the document at the end of the document.
+type BibliographyContext interface {
+	// Page is the page containing the bibliography.
+	Page() any
+
+	// The cited entries, in the order they are first cited.
+	Entries() []*citations.Entry
+}
+
+// BibliographyRenderer describes a uniquely identifiable rendering hook.
+type BibliographyRenderer interface {
+	// RenderBibliography writes the rendered content to w using the data in ctx.
+	RenderBibliography(cctx context.Context, w io.Writer, ctx BibliographyContext) error
+	identity.Provider
+}
+
+// TableContext is the context passed to a table render hook.
+type TableContext interface {
+	// Page is the page containing the table.
+	Page() any
+
+	// Zero-based ordinal for all the tables in the current document.
+	Ordinal() int
+
+	// The header rows.
+	THead() []TableRow
+
+	// The body rows.
+	TBody() []TableRow
+
+	// The columns, with their alignment and type.
+	Columns() []TableColumn
+
+	// Attributes (e.g. CSS classes)
+	AttributesProvider
+	text.Positioner
+}
+
+// Column alignments.
+const (
+	TableAlignLeft   = "left"
+	TableAlignCenter = "center"
+	TableAlignRight  = "right"
+)
+
+// Column types.
+const (
+	// TableColumnNumber is the type of a column where all the non-empty body cells are numbers.
+	TableColumnNumber = "number"
+	// TableColumnText is the type of all other columns.
+	TableColumnText = "text"
+)
+
+// TableRow is a row in a table.
+type TableRow []TableCell
+
+// TableCell is a cell in a table.
+type TableCell struct {
+	// The rendered (HTML) cell content.
+	Text hstring.RenderedString
+	// The unrendered cell content.
+	PlainText string
+	// The alignment, "left", "center", "right" or empty if not set.
+	Alignment string
+}
+
+// TableColumn describes a column in a table.
+type TableColumn struct {
+	// The zero-based column index.
+	Index int
+	// The unrendered content of the last header cell in the column.
+	Title string
+	// The alignment, "left", "center", "right" or empty if not set.
+	Alignment string
+	// The column type, "number" or "text".
+	Type string
+}
+
+// TableRenderer describes a uniquely identifiable rendering hook.
+type TableRenderer interface {
+	// RenderTable writes the rendered content to w using the data in ctx.
+	RenderTable(cctx context.Context, w io.Writer, ctx TableContext) error
+	identity.Provider
+}
+
 // ElementPositionResolver provides a way to resolve the start Position
 // of a markdown element in the original source document.
 // This may be both slow and approximate, so should only be
@@ -141,6 +482,14 @@ const (
 	ImageRendererType
 	HeadingRendererType
 	CodeBlockRendererType
+	FAQRendererType
+	BlockquoteRendererType
+	PassthroughRendererType
+	FootnoteRefRendererType
+	FootnotesRendererType
+	CitationRendererType
+	BibliographyRendererType
+	TableRendererType
 )
 
 type GetRendererFunc func(t RendererType, id any) any
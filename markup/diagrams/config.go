@@ -0,0 +1,50 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diagrams holds the configuration for diagrams rendered to SVG at build time.
+package diagrams
+
+import "strings"
+
+// EngineGoat is the name of the embedded diagram engine.
+const EngineGoat = "goat"
+
+// DefaultConfig holds the default diagrams configuration.
+// No external engines are configured by default.
+var DefaultConfig = Config{}
+
+// Config configures the diagram engines used to render code blocks to SVG.
+type Config struct {
+	// Maps a code block language, e.g. mermaid, to the engine rendering it.
+	Languages map[string]Engine
+}
+
+// Engine configures an external command rendering diagrams to SVG.
+// The diagram source is written to the command's stdin and the SVG is read from its stdout.
+type Engine struct {
+	// The command to run, e.g. mmdc.
+	// This must be allowed in security.exec.allow.
+	Command string
+
+	// The arguments passed to Command.
+	Args []string
+}
+
+// Engine returns the configured engine for the given code block language.
+func (c Config) Engine(lang string) (Engine, bool) {
+	e, found := c.Languages[strings.ToLower(lang)]
+	if !found || e.Command == "" {
+		return Engine{}, false
+	}
+	return e, true
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockquotes_test
+
+import (
+	"testing"
+
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+const blockquotesContent = `
+-- config.toml --
+[markup.goldmark.parser.attribute]
+block = true
+-- content/p1.md --
+---
+title: "p1"
+---
+> A regular *blockquote*.
+
+> [!NOTE]
+> Useful information.
+
+> [!tip] Custom *title*
+> Some tip.
+{.c1}
+
+> [!faq]- Foldable
+> The answer.
+
+> [!WARNING]
+>
+> Separate paragraph.
+-- layouts/_default/single.html --
+{{ .Content }}
+`
+
+func TestBlockquoteDefault(t *testing.T) {
+	t.Parallel()
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: blockquotesContent,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		"<blockquote>\n<p>A regular <em>blockquote</em>.</p>\n</blockquote>",
+		"<div class=\"markdown-alert markdown-alert-note\">\n<p class=\"markdown-alert-title\">Note</p>\n<p>Useful information.</p>\n\n</div>",
+		"<div class=\"markdown-alert markdown-alert-tip c1\">\n<p class=\"markdown-alert-title\">Custom *title*</p>\n<p>Some tip.</p>",
+		"<details class=\"markdown-alert markdown-alert-faq\">\n<summary class=\"markdown-alert-title\">Foldable</summary>\n<p>The answer.</p>",
+		"<p class=\"markdown-alert-title\">Warning</p>\n<p>Separate paragraph.</p>",
+	)
+}
+
+func TestBlockquoteHook(t *testing.T) {
+	t.Parallel()
+
+	files := blockquotesContent + `
+-- layouts/_default/_markup/render-blockquote.html --
+{{ .Ordinal }}|{{ .Type }}|{{ .AlertType }}|{{ .AlertTitle }}|{{ .AlertSign }}|{{ .Attributes.class }}|{{ .Position.LineNumber }}|{{ .Text | safeHTML }}|
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		"0|regular|||||4|<p>A regular <em>blockquote</em>.</p>\n|",
+		"1|alert|note||||6|<p>Useful information.</p>\n|",
+		"2|alert|tip|Custom *title*||c1|9|<p>Some tip.</p>\n|",
+		"3|alert|faq|Foldable|-||13|<p>The answer.</p>\n|",
+		"4|alert|warning||||16|<p>Separate paragraph.</p>\n|",
+	)
+}
+
+func TestBlockquoteHookAlertVariant(t *testing.T) {
+	t.Parallel()
+
+	files := blockquotesContent + `
+-- layouts/_default/_markup/render-blockquote-alert.html --
+alert:{{ .AlertType }}|
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		"<blockquote>\n<p>A regular <em>blockquote</em>.</p>\n</blockquote>",
+		"alert:note|",
+		"alert:warning|",
+	)
+}
@@ -0,0 +1,202 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockquotes
+
+import (
+	"sync"
+
+	"github.com/gohugoio/hugo/common/herrors"
+	htext "github.com/gohugoio/hugo/common/text"
+	"github.com/gohugoio/hugo/common/types/hstring"
+	"github.com/gohugoio/hugo/markup/converter/hooks"
+	"github.com/gohugoio/hugo/markup/goldmark/internal/render"
+	"github.com/gohugoio/hugo/markup/internal/attributes"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/util"
+)
+
+type (
+	blockquotesExtension struct{}
+	htmlRenderer         struct{}
+)
+
+// New returns a goldmark extension rendering blockquotes with render hooks.
+func New() goldmark.Extender {
+	return &blockquotesExtension{}
+}
+
+func (e *blockquotesExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithASTTransformers(
+			// Run after the block attributes are set.
+			util.Prioritized(&Transformer{}, 200),
+		),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(newHTMLRenderer(), 100),
+	))
+}
+
+func newHTMLRenderer() renderer.NodeRenderer {
+	return &htmlRenderer{}
+}
+
+func (r *htmlRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(KindBlockquote, r.renderBlockquote)
+}
+
+func (r *htmlRenderer) renderBlockquote(w util.BufWriter, src []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	n := node.(*blockquote)
+
+	var (
+		br hooks.BlockquoteRenderer
+		h  any
+	)
+	ctx, ok := w.(*render.Context)
+	if ok {
+		h = ctx.RenderContext().GetRenderer(hooks.BlockquoteRendererType, n.typ)
+		if h != nil {
+			br = h.(hooks.BlockquoteRenderer)
+		}
+	}
+
+	if br == nil {
+		renderBlockquoteDefault(w, n, entering)
+		return ast.WalkContinue, nil
+	}
+
+	if entering {
+		// Store the current pos so we can capture the rendered text.
+		ctx.PushPos(ctx.Buffer.Len())
+		return ast.WalkContinue, nil
+	}
+
+	pos := ctx.PopPos()
+	text := ctx.Buffer.Bytes()[pos:]
+	ctx.Buffer.Truncate(pos)
+
+	bqctx := &blockquoteContext{
+		page:             ctx.DocumentContext().Document,
+		ordinal:          n.ordinal,
+		typ:              n.typ,
+		alertType:        n.alertType,
+		alertTitle:       n.alertTitle,
+		alertSign:        n.alertSign,
+		text:             hstring.RenderedString(text),
+		AttributesHolder: attributes.New(n.Attributes(), attributes.AttributesOwnerGeneral),
+	}
+
+	bqctx.createPos = func() htext.Position {
+		if resolver, ok := h.(hooks.ElementPositionResolver); ok {
+			return resolver.ResolvePosition(bqctx)
+		}
+		return htext.Position{
+			Filename:     ctx.DocumentContext().Filename,
+			LineNumber:   1,
+			ColumnNumber: 1,
+		}
+	}
+
+	err := br.RenderBlockquote(
+		ctx.RenderContext().Ctx,
+		w,
+		bqctx,
+	)
+
+	ctx.AddIdentity(br)
+
+	if err != nil {
+		return ast.WalkContinue, herrors.NewFileErrorFromPos(err, bqctx.Position())
+	}
+
+	return ast.WalkContinue, nil
+}
+
+// renderBlockquoteDefault renders n as a blockquote, keeping any alert marker.
+func renderBlockquoteDefault(w util.BufWriter, n *blockquote, entering bool) {
+	if entering {
+		// This is how Goldmark renders blockquotes.
+		if n.Attributes() != nil {
+			_, _ = w.WriteString("<blockquote")
+			html.RenderAttributes(w, n, html.BlockquoteAttributeFilter)
+			_ = w.WriteByte('>')
+		} else {
+			_, _ = w.WriteString("<blockquote>\n")
+		}
+		if n.marker != nil {
+			_, _ = w.WriteString("<p>")
+			_, _ = w.Write(util.EscapeHTML(n.marker))
+			_, _ = w.WriteString("</p>\n")
+		}
+	} else {
+		_, _ = w.WriteString("</blockquote>\n")
+	}
+}
+
+type blockquoteContext struct {
+	page       any
+	ordinal    int
+	typ        string
+	alertType  string
+	alertTitle string
+	alertSign  string
+	text       hstring.RenderedString
+
+	// This is only used in error situations and is expensive to create,
+	// to delay creation until needed.
+	pos       htext.Position
+	posInit   sync.Once
+	createPos func() htext.Position
+
+	*attributes.AttributesHolder
+}
+
+func (c *blockquoteContext) Page() any {
+	return c.page
+}
+
+func (c *blockquoteContext) Ordinal() int {
+	return c.ordinal
+}
+
+func (c *blockquoteContext) Type() string {
+	return c.typ
+}
+
+func (c *blockquoteContext) AlertType() string {
+	return c.alertType
+}
+
+func (c *blockquoteContext) AlertTitle() string {
+	return c.alertTitle
+}
+
+func (c *blockquoteContext) AlertSign() string {
+	return c.alertSign
+}
+
+func (c *blockquoteContext) Text() hstring.RenderedString {
+	return c.text
+}
+
+func (c *blockquoteContext) Position() htext.Position {
+	c.posInit.Do(func() {
+		c.pos = c.createPos()
+	})
+	return c.pos
+}
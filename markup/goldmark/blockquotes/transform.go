@@ -0,0 +1,121 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package blockquotes renders blockquotes, including GitHub style alerts and
+// Obsidian callouts, with render hooks.
+package blockquotes
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// KindBlockquote is the kind of a blockquote that may be rendered with a hook.
+var KindBlockquote = ast.NewNodeKind("HugoBlockquote")
+
+const (
+	// BlockquoteTypeRegular is the type of a regular blockquote.
+	BlockquoteTypeRegular = "regular"
+	// BlockquoteTypeAlert is the type of a GitHub alert or an Obsidian callout.
+	BlockquoteTypeAlert = "alert"
+)
+
+type blockquote struct {
+	ast.BaseBlock
+	ordinal int
+
+	typ        string
+	alertType  string
+	alertTitle string
+	alertSign  string
+
+	// The alert marker line, e.g. "[!NOTE]", removed from the first paragraph.
+	marker []byte
+}
+
+func (*blockquote) Kind() ast.NodeKind { return KindBlockquote }
+
+func (n *blockquote) Dump(src []byte, level int) {
+	ast.DumpHelper(n, src, level, map[string]string{"Type": n.typ, "AlertType": n.alertType}, nil)
+}
+
+// Matches the first line of an alert, e.g.
+// "[!NOTE]" (GitHub), "[!tip] Title" and "[!faq]- Foldable title" (Obsidian).
+var alertRe = regexp.MustCompile(`^\[!([A-Za-z][\w-]*)\]([+-])?(?:[ \t]+(.*?))?[ \t]*$`)
+
+// Transformer replaces blockquotes with nodes that can be rendered by hooks.
+type Transformer struct{}
+
+// Transform transforms the provided Markdown AST.
+func (t *Transformer) Transform(doc *ast.Document, reader text.Reader, pctx parser.Context) {
+	var blockquotes []*ast.Blockquote
+	ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if n, ok := node.(*ast.Blockquote); ok && entering {
+			blockquotes = append(blockquotes, n)
+		}
+		return ast.WalkContinue, nil
+	})
+
+	source := reader.Source()
+	for i, n := range blockquotes {
+		bq := &blockquote{
+			ordinal: i,
+			typ:     BlockquoteTypeRegular,
+		}
+		for _, attr := range n.Attributes() {
+			bq.SetAttribute(attr.Name, attr.Value)
+		}
+
+		if p, ok := n.FirstChild().(*ast.Paragraph); ok && p.Lines().Len() > 0 {
+			first := p.Lines().At(0)
+			line := bytes.TrimRight(first.Value(source), "\r\n")
+			if m := alertRe.FindSubmatch(line); m != nil {
+				bq.typ = BlockquoteTypeAlert
+				bq.alertType = strings.ToLower(string(m[1]))
+				bq.alertSign = string(m[2])
+				bq.alertTitle = string(m[3])
+				bq.marker = line
+				removeFirstLine(p)
+			}
+		}
+
+		for c := n.FirstChild(); c != nil; {
+			next := c.NextSibling()
+			bq.AppendChild(bq, c)
+			c = next
+		}
+		bq.SetBlankPreviousLines(n.HasBlankPreviousLines())
+		n.Parent().ReplaceChild(n.Parent(), n, bq)
+	}
+}
+
+// removeFirstLine removes the inline nodes on the first line of p,
+// and p itself if it's then empty.
+func removeFirstLine(p *ast.Paragraph) {
+	for c := p.FirstChild(); c != nil; {
+		next := c.NextSibling()
+		p.RemoveChild(p, c)
+		if t, ok := c.(*ast.Text); ok && (t.SoftLineBreak() || t.HardLineBreak()) {
+			break
+		}
+		c = next
+	}
+	if !p.HasChildren() {
+		p.Parent().RemoveChild(p.Parent(), p)
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package citations parses Pandoc style citations, e.g. [see @knuth1984, p. 97],
+// and adds a bibliography with the cited entries to the end of the document.
+package citations
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+var (
+	// KindCitation is the kind of a citation.
+	KindCitation = ast.NewNodeKind("Citation")
+	// KindBibliography is the kind of the bibliography added to the end of a document.
+	KindBibliography = ast.NewNodeKind("Bibliography")
+)
+
+// Citation is a citation of one or more bibliography entries, e.g. [see @knuth1984, p. 97; @lamport1994].
+type Citation struct {
+	ast.BaseInline
+
+	// The segment including the brackets.
+	Segment text.Segment
+	Items   []CitationItem
+
+	ordinal int
+}
+
+// CitationItem is a reference to a bibliography entry in a citation.
+type CitationItem struct {
+	Key    string
+	Prefix string
+	Suffix string
+
+	// The one-based number of the entry, in the order the entries are first cited.
+	number int
+}
+
+func (n *Citation) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Value": string(n.Segment.Value(source))}, nil)
+}
+
+func (n *Citation) Kind() ast.NodeKind {
+	return KindCitation
+}
+
+// Bibliography lists the entries cited in the document.
+type Bibliography struct {
+	ast.BaseBlock
+
+	// The cited keys, in the order they are first cited.
+	Keys []string
+}
+
+func (n *Bibliography) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Keys": strings.Join(n.Keys, ",")}, nil)
+}
+
+func (n *Bibliography) Kind() ast.NodeKind {
+	return KindBibliography
+}
+
+type citationsExtension struct{}
+
+// New returns a goldmark extension for citations.
+func New() goldmark.Extender {
+	return &citationsExtension{}
+}
+
+func (e *citationsExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithInlineParsers(
+			// After the footnote parser and before the link parser.
+			util.Prioritized(&inlineParser{}, 150),
+		),
+		parser.WithASTTransformers(
+			// Before the footnote list is added to the end of the document.
+			util.Prioritized(&transformer{}, 900),
+		),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(newHTMLRenderer(), 100),
+	))
+}
+
+type inlineParser struct{}
+
+func (p *inlineParser) Trigger() []byte {
+	return []byte{'['}
+}
+
+func (p *inlineParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	line, segment := block.PeekLine()
+	end := bytes.IndexAny(line[1:], "[]")
+	if end == -1 || line[1+end] != ']' {
+		return nil
+	}
+	end++
+	if end+1 < len(line) {
+		// A link, e.g. [@user](https://example.org/) or [@user][ref], or a link reference definition.
+		switch line[end+1] {
+		case '(', '[', ':':
+			return nil
+		}
+	}
+
+	items, ok := parseCitationItems(string(line[1:end]))
+	if !ok {
+		return nil
+	}
+
+	block.Advance(end + 1)
+	return &Citation{
+		Segment: segment.WithStop(segment.Start + end + 1),
+		Items:   items,
+	}
+}
+
+// parseCitationItems parses the semicolon separated items in s, e.g.
+// "see @knuth1984, p. 97; @lamport1994". It returns false if any of the
+// items doesn't have a citation key.
+func parseCitationItems(s string) ([]CitationItem, bool) {
+	var items []CitationItem
+	for _, part := range strings.Split(s, ";") {
+		item, ok := parseCitationItem(part)
+		if !ok {
+			return nil, false
+		}
+		items = append(items, item)
+	}
+	return items, true
+}
+
+func parseCitationItem(s string) (CitationItem, bool) {
+	s = strings.TrimSpace(s)
+	for i := 0; i < len(s); i++ {
+		if s[i] != '@' || (i > 0 && s[i-1] != ' ') {
+			continue
+		}
+		key := citationKey(s[i+1:])
+		if key == "" {
+			continue
+		}
+		suffix := strings.TrimSpace(s[i+1+len(key):])
+		suffix = strings.TrimSpace(strings.TrimPrefix(suffix, ","))
+		return CitationItem{
+			Key:    key,
+			Prefix: strings.TrimSpace(s[:i]),
+			Suffix: suffix,
+		}, true
+	}
+	return CitationItem{}, false
+}
+
+// citationKey returns the citation key at the start of s, using Pandoc's rules:
+// it must start with a letter, digit or underscore, and may contain
+// the punctuation :.#$%&-+?<>~/ if not at the end.
+func citationKey(s string) string {
+	if s == "" || !isKeyChar(s[0]) {
+		return ""
+	}
+	i := 1
+	for i < len(s) && (isKeyChar(s[i]) || strings.IndexByte(":.#$%&-+?<>~/", s[i]) != -1) {
+		i++
+	}
+	for i > 1 && !isKeyChar(s[i-1]) {
+		i--
+	}
+	return s[:i]
+}
+
+func isKeyChar(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
+
+// transformer sets the ordinal of the citations and the number of the cited entries
+// in document order, and adds the bibliography to the end of the document.
+type transformer struct{}
+
+func (t *transformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	var (
+		ordinal int
+		keys    []string
+		numbers = make(map[string]int)
+	)
+	ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		n, ok := node.(*Citation)
+		if !ok {
+			return ast.WalkContinue, nil
+		}
+		n.ordinal = ordinal
+		ordinal++
+		for i, item := range n.Items {
+			number, found := numbers[item.Key]
+			if !found {
+				keys = append(keys, item.Key)
+				number = len(keys)
+				numbers[item.Key] = number
+			}
+			n.Items[i].number = number
+		}
+		return ast.WalkSkipChildren, nil
+	})
+
+	if len(keys) > 0 {
+		doc.AppendChild(doc, &Bibliography{Keys: keys})
+	}
+}
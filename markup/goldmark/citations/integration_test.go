@@ -0,0 +1,159 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package citations_test
+
+import (
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+const citationsFiles = `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "section", "home", "rss", "sitemap"]
+[markup.goldmark.extensions.citations]
+enable = true
+bibliography = ["refs.bib"]
+-- assets/refs.bib --
+@article{knuth1984,
+  author  = {Knuth, Donald E.},
+  title   = {Literate Programming},
+  journal = {The Computer Journal},
+  year    = 1984,
+  volume  = {27},
+  number  = {2},
+  pages   = {97--111},
+  doi     = {10.1093/comjnl/27.2.97},
+}
+@book{kr1988,
+  author    = {Brian W. Kernighan and Dennis M. Ritchie},
+  title     = {The C Programming Language},
+  publisher = {Prentice Hall},
+  year      = {1988},
+}
+-- layouts/_default/single.html --
+{{ .Content }}
+-- content/p1.md --
+---
+title: "p1"
+---
+
+As shown [see @knuth1984, p. 97; @kr1988], and again [@knuth1984].
+
+Not citations: [email me@example.org], [@user](https://example.org/) and [ @].
+-- content/p2/index.md --
+---
+title: "p2"
+bibliography: "refs.json"
+---
+
+From the page bundle [@lamport1994].
+-- content/p2/refs.json --
+[
+  {
+    "id": "lamport1994",
+    "type": "book",
+    "title": "LaTeX: A Document Preparation System",
+    "author": [{"family": "Lamport", "given": "Leslie"}],
+    "issued": {"date-parts": [[1994]]},
+    "publisher": "Addison-Wesley"
+  }
+]
+`
+
+func TestCitationsDefault(t *testing.T) {
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: citationsFiles,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		`As shown <span class="citation" data-cites="knuth1984 kr1988">(see <a href="#ref-knuth1984">Knuth 1984</a>, p. 97; <a href="#ref-kr1988">Kernighan and Ritchie 1988</a>)</span>`,
+		`and again <span class="citation" data-cites="knuth1984">(<a href="#ref-knuth1984">Knuth 1984</a>)</span>.`,
+		`Not citations: [email me@example.org], <a href="https://example.org/">@user</a> and [ @].`,
+		`<section class="bibliography" role="doc-bibliography">`,
+		"<li id=\"ref-kr1988\">Kernighan, B. W., &amp; Ritchie, D. M. (1988). The C Programming Language. Prentice Hall.</li>\n<li id=\"ref-knuth1984\">Knuth, D. E. (1984).",
+		`<a href="https://doi.org/10.1093/comjnl/27.2.97">https://doi.org/10.1093/comjnl/27.2.97</a></li>`,
+	)
+
+	b.AssertFileContent("public/p2/index.html",
+		`<a href="#ref-lamport1994">Lamport 1994</a>`,
+		`<li id="ref-lamport1994">Lamport, L. (1994). LaTeX: A Document Preparation System. Addison-Wesley.</li>`,
+	)
+}
+
+func TestCitationsHooks(t *testing.T) {
+	files := citationsFiles + `
+-- layouts/_default/_markup/render-citation.html --
+{{- range $i, $c := .Citations }}{{ if $i }},{{ end }}[{{ .Number }}|{{ .Prefix }}|{{ .Key }}|{{ .Suffix }}|{{ .Entry.Title }}]{{ end -}}
+-- layouts/_default/_markup/render-bibliography.html --
+<ol class="refs">
+{{- range .Entries }}
+<li>{{ .ID }}|{{ .Year }}|{{ .ShortAuthors }}</li>
+{{- end }}
+</ol>
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		`As shown [1|see|knuth1984|p. 97|Literate Programming],[2||kr1988||The C Programming Language], and again [1||knuth1984||Literate Programming].`,
+		"<ol class=\"refs\">\n<li>knuth1984|1984|Knuth</li>\n<li>kr1988|1988|Kernighan and Ritchie</li>\n</ol>",
+	)
+
+	content := b.FileContent("public/p1/index.html")
+	b.Assert(content, qt.Not(qt.Contains), "doc-bibliography")
+}
+
+func TestCitationsMissingKey(t *testing.T) {
+	files := strings.Replace(citationsFiles, "and again [@knuth1984]", "and again [@knuth1985]", 1)
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `p1.md:`)
+	b.Assert(err.Error(), qt.Contains, `citation key "knuth1985" not found in bibliography`)
+}
+
+func TestCitationsRebuildOnBibliographyChange(t *testing.T) {
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: citationsFiles,
+			Running:     true,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html", `<a href="#ref-knuth1984">Knuth 1984</a>`)
+
+	b.EditFileReplace("assets/refs.bib", func(s string) string {
+		return strings.Replace(s, "year    = 1984", "year    = 1992", 1)
+	}).Build()
+
+	b.AssertFileContent("public/p1/index.html", `<a href="#ref-knuth1984">Knuth 1992</a>`)
+}
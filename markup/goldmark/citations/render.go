@@ -0,0 +1,298 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package citations
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gohugoio/hugo/common/herrors"
+	htext "github.com/gohugoio/hugo/common/text"
+	hcitations "github.com/gohugoio/hugo/markup/citations"
+	"github.com/gohugoio/hugo/markup/converter/hooks"
+	"github.com/gohugoio/hugo/markup/goldmark/internal/render"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+type htmlRenderer struct{}
+
+func newHTMLRenderer() renderer.NodeRenderer {
+	return &htmlRenderer{}
+}
+
+func (r *htmlRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(KindCitation, r.renderCitation)
+	reg.Register(KindBibliography, r.renderBibliography)
+}
+
+// bibliography loads the bibliography for the document being rendered.
+func bibliography(ctx *render.Context) (*hcitations.Bibliography, error) {
+	load := ctx.DocumentContext().Bibliography
+	if load == nil {
+		return nil, nil
+	}
+	b, ids, err := load()
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		ctx.AddIdentity(id)
+	}
+	return b, nil
+}
+
+func (r *htmlRenderer) renderCitation(w util.BufWriter, src []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	n := node.(*Citation)
+
+	ctx, ok := w.(*render.Context)
+	if !ok {
+		_, _ = w.Write(util.EscapeHTML(n.Segment.Value(src)))
+		return ast.WalkSkipChildren, nil
+	}
+
+	var (
+		cr hooks.CitationRenderer
+		h  = ctx.RenderContext().GetRenderer(hooks.CitationRendererType, nil)
+	)
+	if h != nil {
+		cr = h.(hooks.CitationRenderer)
+	}
+
+	cctx := &citationContext{
+		page:    ctx.DocumentContext().Document,
+		ordinal: n.ordinal,
+	}
+
+	cctx.createPos = func() htext.Position {
+		if resolver, ok := h.(hooks.ElementPositionResolver); ok {
+			return resolver.ResolvePosition(cctx)
+		}
+		return htext.Position{
+			Filename:     ctx.DocumentContext().Filename,
+			LineNumber:   1,
+			ColumnNumber: 1,
+		}
+	}
+
+	b, err := bibliography(ctx)
+	if err != nil {
+		return ast.WalkSkipChildren, herrors.NewFileErrorFromPos(err, cctx.Position())
+	}
+
+	for _, item := range n.Items {
+		entry, found := b.Get(item.Key)
+		if !found {
+			cctx.citations = []hooks.Citation{{Key: item.Key}}
+			return ast.WalkSkipChildren, herrors.NewFileErrorFromPos(fmt.Errorf("citation key %q not found in bibliography", item.Key), cctx.Position())
+		}
+		cctx.citations = append(cctx.citations, hooks.Citation{
+			Key:    item.Key,
+			Prefix: item.Prefix,
+			Suffix: item.Suffix,
+			Number: item.number,
+			Entry:  entry,
+		})
+	}
+
+	if cr == nil {
+		renderCitationDefault(w, cctx.citations)
+		return ast.WalkSkipChildren, nil
+	}
+
+	err = cr.RenderCitation(
+		ctx.RenderContext().Ctx,
+		w,
+		cctx,
+	)
+
+	ctx.AddIdentity(cr)
+
+	if err != nil {
+		return ast.WalkSkipChildren, herrors.NewFileErrorFromPos(err, cctx.Position())
+	}
+
+	return ast.WalkSkipChildren, nil
+}
+
+// renderCitationDefault renders an author-date citation linked to the bibliography entries,
+// e.g. (see Knuth 1984, p. 97; Lamport 1994).
+func renderCitationDefault(w util.BufWriter, citations []hooks.Citation) {
+	keys := make([]string, len(citations))
+	for i, c := range citations {
+		keys[i] = c.Key
+	}
+	_, _ = w.WriteString(`<span class="citation" data-cites="`)
+	_, _ = w.WriteString(html.EscapeString(strings.Join(keys, " ")))
+	_, _ = w.WriteString(`">(`)
+	for i, c := range citations {
+		if i > 0 {
+			_, _ = w.WriteString("; ")
+		}
+		if c.Prefix != "" {
+			_, _ = w.WriteString(html.EscapeString(c.Prefix))
+			_ = w.WriteByte(' ')
+		}
+		_, _ = w.WriteString(`<a href="#ref-`)
+		_, _ = w.WriteString(html.EscapeString(c.Key))
+		_, _ = w.WriteString(`">`)
+		_, _ = w.WriteString(html.EscapeString(c.Entry.Label()))
+		_, _ = w.WriteString(`</a>`)
+		if c.Suffix != "" {
+			_, _ = w.WriteString(", ")
+			_, _ = w.WriteString(html.EscapeString(c.Suffix))
+		}
+	}
+	_, _ = w.WriteString(`)</span>`)
+}
+
+func (r *htmlRenderer) renderBibliography(w util.BufWriter, src []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	n := node.(*Bibliography)
+
+	ctx, ok := w.(*render.Context)
+	if !ok {
+		return ast.WalkContinue, nil
+	}
+
+	pos := htext.Position{
+		Filename:     ctx.DocumentContext().Filename,
+		LineNumber:   1,
+		ColumnNumber: 1,
+	}
+
+	b, err := bibliography(ctx)
+	if err != nil {
+		return ast.WalkContinue, herrors.NewFileErrorFromPos(err, pos)
+	}
+
+	// Missing entries are reported when rendering the citations.
+	var entries []*hcitations.Entry
+	for _, key := range n.Keys {
+		if entry, found := b.Get(key); found {
+			entries = append(entries, entry)
+		}
+	}
+
+	var br hooks.BibliographyRenderer
+	if h := ctx.RenderContext().GetRenderer(hooks.BibliographyRendererType, nil); h != nil {
+		br = h.(hooks.BibliographyRenderer)
+	}
+
+	if br == nil {
+		renderBibliographyDefault(w, entries)
+		return ast.WalkContinue, nil
+	}
+
+	err = br.RenderBibliography(
+		ctx.RenderContext().Ctx,
+		w,
+		&bibliographyContext{
+			page:    ctx.DocumentContext().Document,
+			entries: entries,
+		},
+	)
+
+	ctx.AddIdentity(br)
+
+	if err != nil {
+		return ast.WalkContinue, herrors.NewFileErrorFromPos(err, pos)
+	}
+
+	return ast.WalkContinue, nil
+}
+
+// renderBibliographyDefault renders the entries sorted by their reference,
+// with links to their DOI or URL.
+func renderBibliographyDefault(w util.BufWriter, entries []*hcitations.Entry) {
+	entries = append([]*hcitations.Entry(nil), entries...)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Reference() < entries[j].Reference()
+	})
+
+	_, _ = w.WriteString("<section class=\"bibliography\" role=\"doc-bibliography\">\n<ul>\n")
+	for _, e := range entries {
+		_, _ = w.WriteString(`<li id="ref-`)
+		_, _ = w.WriteString(html.EscapeString(e.ID))
+		_, _ = w.WriteString(`">`)
+		_, _ = w.WriteString(html.EscapeString(e.Reference()))
+		var link string
+		if e.DOI != "" {
+			link = "https://doi.org/" + e.DOI
+		} else {
+			link = e.URL
+		}
+		if link != "" {
+			link = html.EscapeString(link)
+			_, _ = w.WriteString(` <a href="` + link + `">` + link + `</a>`)
+		}
+		_, _ = w.WriteString("</li>\n")
+	}
+	_, _ = w.WriteString("</ul>\n</section>\n")
+}
+
+type citationContext struct {
+	page      any
+	ordinal   int
+	citations []hooks.Citation
+
+	// This is only used in error situations and is expensive to create,
+	// to delay creation until needed.
+	pos       htext.Position
+	posInit   sync.Once
+	createPos func() htext.Position
+}
+
+func (c *citationContext) Page() any {
+	return c.page
+}
+
+func (c *citationContext) Ordinal() int {
+	return c.ordinal
+}
+
+func (c *citationContext) Citations() []hooks.Citation {
+	return c.citations
+}
+
+func (c *citationContext) Position() htext.Position {
+	c.posInit.Do(func() {
+		c.pos = c.createPos()
+	})
+	return c.pos
+}
+
+type bibliographyContext struct {
+	page    any
+	entries []*hcitations.Entry
+}
+
+func (c *bibliographyContext) Page() any {
+	return c.page
+}
+
+func (c *bibliographyContext) Entries() []*hcitations.Entry {
+	return c.entries
+}
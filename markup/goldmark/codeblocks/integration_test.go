@@ -423,3 +423,101 @@ Attributes: {{ .Attributes }}|Type: {{ .Type }}|
 	b.Assert(err.Error(), qt.Contains, "p1.md:7:9\": failed to parse Markdown attributes; you may need to quote the values")
 
 }
+
+func TestCodeblocksDiffAndAnnotations(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term"]
+[markup.highlight]
+noClasses = false
+-- content/p1.md --
+---
+title: "p1"
+---
+
+§§§go {diff=true}
+ func main() {
+-	fmt.Println("Hello")
++	fmt.Println("Hello, World!")
+ }
+§§§
+
+§§§js {annotations=true}
+// [!region Setup]
+const a = 1; // [!note The answer is 42]
+const b = 2;
+// [!endregion]
+console.log(a + b);
+§§§
+
+§§§myjs {annotations=true diff=true}
+ // [!region Setup]
+-const a = 1; // [!note Old]
++const a = 2; /* [!note New] */
+ // [!endregion]
+§§§
+-- layouts/index.html --
+-- layouts/_default/single.html --
+{{ .Content }}
+-- layouts/_default/_markup/render-codeblock-myjs.html --
+Inner: {{ .Inner }}|
+{{ range .Lines }}Line {{ .Number }}: {{ .Text }}|{{ .Diff }}|{{ .Notes }}|
+{{ end }}
+{{ range .Regions }}Region: {{ .Title }}|{{ .Start }}-{{ .End }}|{{ end }}
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		`<div class="highlight"><pre tabindex="0" class="chroma"><code class="language-go" data-lang="go"><span class="line"><span class="cl"><span class="kd">func</span>`,
+		`<span class="line diff-removed"><span class="cl">	<span class="nx">fmt</span>`,
+		`<span class="line diff-added"><span class="cl">	<span class="nx">fmt</span>`,
+		`<span class="line has-note" title="The answer is 42"><span class="cl"><span class="kr">const</span> <span class="nx">a</span> <span class="o">=</span> <span class="mi">1</span><span class="p">;</span>`+"\n</span></span>",
+		"Inner: const a = 1;\nconst a = 2;|",
+		"Line 1: const a = 1;|removed|[Old]|",
+		"Line 2: const a = 2;|added|[New]|",
+		"Region: Setup|1-2|",
+	)
+	content := b.FileContent("public/p1/index.html")
+	b.Assert(content, qt.Not(qt.Contains), "[!region")
+	b.Assert(content, qt.Not(qt.Contains), "[!note")
+	b.Assert(content, qt.Not(qt.Contains), "diff=")
+}
+
+func TestCodeblocksAnnotationsUnclosedRegion(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term"]
+-- content/p1.md --
+---
+title: "p1"
+---
+
+§§§go {annotations=true}
+// [!region]
+func main() {}
+§§§
+-- layouts/index.html --
+-- layouts/_default/single.html --
+{{ .Content }}
+`
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.Not(qt.IsNil))
+	b.Assert(err.Error(), qt.Contains, "[!region] in code block line 1 is not closed")
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codeblocks
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gohugoio/hugo/markup/converter/hooks"
+)
+
+const (
+	optionDiff        = "diff"
+	optionAnnotations = "annotations"
+)
+
+// The annotations may be wrapped in a comment, e.g. // [!note text] or <!-- [!region] -->.
+const (
+	annotationCommentStart = `[^\s\w\[]*\s*`
+	annotationCommentEnd   = `\s*[^\s\w]*`
+)
+
+var (
+	noteRe        = regexp.MustCompile(`(?:^|\s+)` + annotationCommentStart + `\[!note\s+([^\]]*)\]` + annotationCommentEnd + `\s*$`)
+	regionStartRe = regexp.MustCompile(`^\s*` + annotationCommentStart + `\[!region(?:\s+([^\]]*))?\]` + annotationCommentEnd + `\s*$`)
+	regionEndRe   = regexp.MustCompile(`^\s*` + annotationCommentStart + `\[!endregion\]` + annotationCommentEnd + `\s*$`)
+)
+
+// parseLines splits code into lines.
+// If diff is set, a leading +, - or space is removed from every line
+// and the line marked as added or removed.
+// If annotations is set, [!note text] annotations at the end of a line are
+// removed and added to the line's notes, and lines with [!region title] and
+// [!endregion] are removed and added as regions.
+func parseLines(code string, diff, annotations bool) ([]hooks.CodeblockLine, []hooks.CodeblockRegion, error) {
+	var (
+		lines   []hooks.CodeblockLine
+		regions []hooks.CodeblockRegion
+		open    []int // indices into regions
+		// The code block lines of the open regions, for error messages.
+		openLines []int
+	)
+
+	for i, s := range strings.Split(code, "\n") {
+		line := hooks.CodeblockLine{Number: len(lines) + 1}
+
+		if diff && s != "" {
+			switch s[0] {
+			case '+':
+				line.Diff = hooks.CodeblockLineAdded
+				s = s[1:]
+			case '-':
+				line.Diff = hooks.CodeblockLineRemoved
+				s = s[1:]
+			case ' ':
+				s = s[1:]
+			}
+		}
+
+		if annotations {
+			if m := regionStartRe.FindStringSubmatch(s); m != nil {
+				open = append(open, len(regions))
+				openLines = append(openLines, i+1)
+				regions = append(regions, hooks.CodeblockRegion{Title: strings.TrimSpace(m[1]), Start: line.Number})
+				continue
+			}
+			if regionEndRe.MatchString(s) {
+				if len(open) == 0 {
+					return nil, nil, fmt.Errorf("[!endregion] without a [!region] in code block line %d", i+1)
+				}
+				regions[open[len(open)-1]].End = line.Number - 1
+				open = open[:len(open)-1]
+				openLines = openLines[:len(openLines)-1]
+				continue
+			}
+			for {
+				m := noteRe.FindStringSubmatchIndex(s)
+				if m == nil {
+					break
+				}
+				// Notes are found from the end of the line.
+				line.Notes = append([]string{strings.TrimSpace(s[m[2]:m[3]])}, line.Notes...)
+				s = s[:m[0]]
+			}
+		}
+
+		line.Text = s
+		lines = append(lines, line)
+	}
+
+	if len(open) > 0 {
+		return nil, nil, fmt.Errorf("[!region] in code block line %d is not closed", openLines[0])
+	}
+
+	return lines, regions, nil
+}
+
+func joinLines(lines []hooks.CodeblockLine) string {
+	var sb strings.Builder
+	for i, line := range lines {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+		sb.WriteString(line.Text)
+	}
+	return sb.String()
+}
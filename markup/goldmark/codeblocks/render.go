@@ -26,6 +26,7 @@ import (
 	"github.com/gohugoio/hugo/markup/goldmark/internal/render"
 	"github.com/gohugoio/hugo/markup/highlight/chromalexers"
 	"github.com/gohugoio/hugo/markup/internal/attributes"
+	"github.com/spf13/cast"
 	"github.com/yuin/goldmark"
 	"github.com/yuin/goldmark/ast"
 	"github.com/yuin/goldmark/parser"
@@ -125,6 +126,15 @@ func (r *htmlRenderer) renderCodeBlock(w util.BufWriter, src []byte, node ast.No
 		}
 	}
 
+	diff, annotations := cbctx.boolOption(optionDiff), cbctx.boolOption(optionAnnotations)
+	cbctx.lines, cbctx.regions, err = parseLines(s, diff, annotations)
+	if err != nil {
+		return ast.WalkStop, herrors.NewFileErrorFromPos(err, cbctx.createPos())
+	}
+	if diff || annotations {
+		cbctx.code = joinLines(cbctx.lines)
+	}
+
 	cr := renderer.(hooks.CodeBlockRenderer)
 
 	err = cr.RenderCodeblock(
@@ -146,6 +156,8 @@ type codeBlockContext struct {
 	page    any
 	lang    string
 	code    string
+	lines   []hooks.CodeblockLine
+	regions []hooks.CodeblockRegion
 	ordinal int
 
 	// This is only used in error situations and is expensive to create,
@@ -169,6 +181,27 @@ func (c *codeBlockContext) Inner() string {
 	return c.code
 }
 
+func (c *codeBlockContext) Lines() []hooks.CodeblockLine {
+	return c.lines
+}
+
+func (c *codeBlockContext) Regions() []hooks.CodeblockRegion {
+	return c.regions
+}
+
+// boolOption looks up name in the options, which are only set for
+// Chroma code blocks, then in the attributes.
+func (c *codeBlockContext) boolOption(name string) bool {
+	for _, attrs := range [][]attributes.Attribute{c.OptionsSlice(), c.AttributesSlice()} {
+		for _, attr := range attrs {
+			if strings.EqualFold(attr.Name, name) {
+				return cast.ToBool(attr.Value)
+			}
+		}
+	}
+	return false
+}
+
 func (c *codeBlockContext) Ordinal() int {
 	return c.ordinal
 }
@@ -23,6 +23,11 @@ func (*codeBlock) IsRaw() bool { return true }
 func (b *codeBlock) Dump(src []byte, level int) {
 }
 
+// FencedCodeBlock returns the fenced code block this code block replaced.
+func (b *codeBlock) FencedCodeBlock() *ast.FencedCodeBlock {
+	return b.b
+}
+
 type Transformer struct{}
 
 // Transform transforms the provided Markdown AST.
@@ -19,11 +19,17 @@ import (
 
 	"github.com/gohugoio/hugo/identity"
 
+	"github.com/gohugoio/hugo/markup/goldmark/blockquotes"
+	"github.com/gohugoio/hugo/markup/goldmark/citations"
 	"github.com/gohugoio/hugo/markup/goldmark/codeblocks"
+	"github.com/gohugoio/hugo/markup/goldmark/faq"
+	"github.com/gohugoio/hugo/markup/goldmark/footnotes"
 	"github.com/gohugoio/hugo/markup/goldmark/goldmark_config"
 	"github.com/gohugoio/hugo/markup/goldmark/images"
 	"github.com/gohugoio/hugo/markup/goldmark/internal/extensions/attributes"
 	"github.com/gohugoio/hugo/markup/goldmark/internal/render"
+	"github.com/gohugoio/hugo/markup/goldmark/passthrough"
+	"github.com/gohugoio/hugo/markup/goldmark/tables"
 
 	"github.com/gohugoio/hugo/markup/converter"
 	"github.com/gohugoio/hugo/markup/tableofcontents"
@@ -100,13 +106,14 @@ func newMarkdown(pcfg converter.ProviderConfig) goldmark.Markdown {
 	)
 
 	extensions = append(extensions, images.New(cfg.Parser.WrapStandAloneImageWithinParagraph))
+	extensions = append(extensions, blockquotes.New())
 
 	if mcfg.Highlight.CodeFences {
 		extensions = append(extensions, codeblocks.New())
 	}
 
 	if cfg.Extensions.Table {
-		extensions = append(extensions, extension.Table)
+		extensions = append(extensions, tables.New())
 	}
 
 	if cfg.Extensions.Strikethrough {
@@ -130,10 +137,21 @@ func newMarkdown(pcfg converter.ProviderConfig) goldmark.Markdown {
 
 	if cfg.Extensions.DefinitionList {
 		extensions = append(extensions, extension.DefinitionList)
+		if cfg.Extensions.FAQ.Enable {
+			extensions = append(extensions, faq.New(cfg.Extensions.FAQ))
+		}
+	}
+
+	if cfg.Extensions.Passthrough.Enable {
+		extensions = append(extensions, passthrough.New(cfg.Extensions.Passthrough))
 	}
 
 	if cfg.Extensions.Footnote {
-		extensions = append(extensions, extension.Footnote)
+		extensions = append(extensions, footnotes.New())
+	}
+
+	if cfg.Extensions.Citations.Enable {
+		extensions = append(extensions, citations.New())
 	}
 
 	if cfg.Parser.AutoHeadingID {
@@ -146,6 +164,12 @@ func newMarkdown(pcfg converter.ProviderConfig) goldmark.Markdown {
 	if cfg.Parser.Attribute.Block {
 		extensions = append(extensions, attributes.New())
 	}
+	if cfg.Parser.Attribute.Inline {
+		extensions = append(extensions, attributes.NewInline())
+	}
+	if cfg.Parser.Attribute.FencedDiv {
+		extensions = append(extensions, attributes.NewFencedDiv())
+	}
 
 	md := goldmark.New(
 		goldmark.WithExtensions(
@@ -165,8 +189,9 @@ func newMarkdown(pcfg converter.ProviderConfig) goldmark.Markdown {
 var _ identity.IdentitiesProvider = (*converterResult)(nil)
 
 type parserResult struct {
-	doc any
-	toc *tableofcontents.Fragments
+	doc   any
+	toc   *tableofcontents.Fragments
+	plain *plainText
 }
 
 func (p parserResult) Doc() any {
@@ -177,6 +202,10 @@ func (p parserResult) TableOfContents() *tableofcontents.Fragments {
 	return p.toc
 }
 
+func (p parserResult) PlainText() []byte {
+	return p.plain.get()
+}
+
 type renderResult struct {
 	converter.ResultRender
 	ids identity.Identities
@@ -208,8 +237,9 @@ func (c *goldmarkConverter) Parse(ctx converter.RenderContext) (converter.Result
 	)
 
 	return parserResult{
-		doc: doc,
-		toc: pctx.TableOfContents(),
+		doc:   doc,
+		toc:   pctx.TableOfContents(),
+		plain: &plainText{doc: doc, src: ctx.Src},
 	}, nil
 
 }
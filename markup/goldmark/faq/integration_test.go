@@ -0,0 +1,99 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faq_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestFAQ(t *testing.T) {
+	t.Parallel()
+
+	filesTemplate := `
+-- config.toml --
+[markup.goldmark.extensions.faq]
+enable = true
+match = "MATCH"
+[markup.goldmark.parser.attribute]
+block = true
+-- content/p1.md --
+---
+title: "p1"
+---
+
+What is *Hugo*?
+: A static site generator.
+
+Is it fast?
+: Yes.
+
+: Very.
+{.support}
+
+Term
+: Not a question.
+
+-- layouts/_default/single.html --
+{{ .Content }}
+`
+
+	t.Run("Default", func(t *testing.T) {
+		files := strings.ReplaceAll(filesTemplate, "MATCH", "questions")
+		b := hugolib.NewIntegrationTestBuilder(
+			hugolib.IntegrationTestConfig{
+				T:           t,
+				TxtarString: files,
+			},
+		).Build()
+
+		b.AssertFileContent("public/p1/index.html",
+			`<dl class="faq support" itemscope itemtype="https://schema.org/FAQPage">`,
+			`<div itemscope itemprop="mainEntity" itemtype="https://schema.org/Question">
+<dt itemprop="name">What is <em>Hugo</em>?</dt>
+<dd itemscope itemprop="acceptedAnswer" itemtype="https://schema.org/Answer"><div itemprop="text">A static site generator.</div></dd>
+</div>`,
+			`<dt itemprop="name">Is it fast?</dt>
+<dd itemscope itemprop="acceptedAnswer" itemtype="https://schema.org/Answer"><div itemprop="text">Yes.
+<p>Very.</p></div></dd>`,
+			"<dl>\n<dt>Term</dt>\n<dd>Not a question.</dd>\n</dl>",
+		)
+	})
+
+	t.Run("All, with hook", func(t *testing.T) {
+		files := strings.ReplaceAll(filesTemplate, "MATCH", "all")
+		files += `
+-- layouts/_default/_markup/render-faq.html --
+<section class="{{ .Attributes.class }}" data-ordinal="{{ .Ordinal }}">
+{{ range .Items }}<h3>{{ .Question | safeHTML }}</h3>{{ .Answer | safeHTML }}|{{ .PlainQuestion }}|{{ .PlainAnswer }}|
+{{ end }}</section>
+`
+		b := hugolib.NewIntegrationTestBuilder(
+			hugolib.IntegrationTestConfig{
+				T:           t,
+				TxtarString: files,
+			},
+		).Build()
+
+		b.AssertFileContent("public/p1/index.html",
+			`<section class="support" data-ordinal="0">`,
+			"<h3>What is <em>Hugo</em>?</h3>A static site generator.|What is Hugo?|A static site generator.|",
+			"<h3>Is it fast?</h3>Yes.\n<p>Very.</p>|Is it fast?|Yes. Very.|",
+			`<section class="" data-ordinal="1">`,
+			"<h3>Term</h3>Not a question.|Term|Not a question.|",
+		)
+	})
+}
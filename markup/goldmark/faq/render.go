@@ -0,0 +1,236 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faq
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/types/hstring"
+	"github.com/gohugoio/hugo/markup/converter/hooks"
+	"github.com/gohugoio/hugo/markup/goldmark/goldmark_config"
+	"github.com/gohugoio/hugo/markup/goldmark/internal/render"
+	"github.com/gohugoio/hugo/markup/internal/attributes"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+type (
+	faqExtension struct {
+		cfg goldmark_config.FAQ
+	}
+	htmlRenderer struct{}
+)
+
+// New returns a goldmark extension rendering definition lists as FAQs.
+// The DefinitionList extension must also be enabled.
+func New(cfg goldmark_config.FAQ) goldmark.Extender {
+	return &faqExtension{cfg: cfg}
+}
+
+func (e *faqExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithASTTransformers(
+			util.Prioritized(&Transformer{cfg: e.cfg}, 100),
+		),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(newHTMLRenderer(), 100),
+	))
+}
+
+func newHTMLRenderer() renderer.NodeRenderer {
+	return &htmlRenderer{}
+}
+
+func (r *htmlRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(KindFAQ, r.renderFAQ)
+	reg.Register(KindQuestion, r.renderQuestion)
+	reg.Register(KindAnswer, r.renderAnswer)
+}
+
+func (r *htmlRenderer) renderFAQ(w util.BufWriter, src []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	n := node.(*faq)
+	ctx, ok := w.(*render.Context)
+	if !ok {
+		// Not able to capture the questions and answers, render as a plain definition list.
+		if entering {
+			_, _ = w.WriteString("<dl>\n")
+		} else {
+			_, _ = w.WriteString("</dl>\n")
+		}
+		return ast.WalkContinue, nil
+	}
+
+	if entering {
+		n.items = nil
+		return ast.WalkContinue, nil
+	}
+
+	var fr hooks.FAQRenderer
+	if h := ctx.RenderContext().GetRenderer(hooks.FAQRendererType, nil); h != nil {
+		fr = h.(hooks.FAQRenderer)
+	}
+
+	if fr == nil {
+		renderFAQDefault(w, n)
+		return ast.WalkContinue, nil
+	}
+
+	err := fr.RenderFAQ(
+		ctx.RenderContext().Ctx,
+		w,
+		faqContext{
+			page:             ctx.DocumentContext().Document,
+			items:            n.items,
+			ordinal:          n.ordinal,
+			AttributesHolder: attributes.New(n.Attributes(), attributes.AttributesOwnerGeneral),
+		},
+	)
+
+	ctx.AddIdentity(fr)
+
+	return ast.WalkContinue, err
+}
+
+// renderFAQDefault renders n as a definition list with schema.org microdata.
+func renderFAQDefault(w util.BufWriter, n *faq) {
+	_, _ = w.WriteString(`<dl class="faq`)
+	var attrs []ast.Attribute
+	for _, attr := range n.Attributes() {
+		if bytes.Equal(attr.Name, []byte("class")) {
+			_, _ = w.WriteString(" ")
+			_, _ = w.Write(util.EscapeHTML(attributeValue(attr.Value)))
+			continue
+		}
+		attrs = append(attrs, attr)
+	}
+	_, _ = w.WriteString(`"`)
+	attributes.RenderASTAttributes(w, attrs...)
+	_, _ = w.WriteString(` itemscope itemtype="https://schema.org/FAQPage">` + "\n")
+	for _, item := range n.items {
+		_, _ = w.WriteString(`<div itemscope itemprop="mainEntity" itemtype="https://schema.org/Question">` + "\n")
+		_, _ = w.WriteString(`<dt itemprop="name">`)
+		_, _ = w.WriteString(string(item.Question))
+		_, _ = w.WriteString("</dt>\n")
+		_, _ = w.WriteString(`<dd itemscope itemprop="acceptedAnswer" itemtype="https://schema.org/Answer"><div itemprop="text">`)
+		_, _ = w.WriteString(string(item.Answer))
+		_, _ = w.WriteString("</div></dd>\n</div>\n")
+	}
+	_, _ = w.WriteString("</dl>\n")
+}
+
+func (r *htmlRenderer) renderQuestion(w util.BufWriter, src []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	ctx, ok := w.(*render.Context)
+	if !ok {
+		if entering {
+			_, _ = w.WriteString("<dt>")
+		} else {
+			_, _ = w.WriteString("</dt>\n")
+		}
+		return ast.WalkContinue, nil
+	}
+
+	if entering {
+		// Store the current pos so we can capture the rendered text.
+		ctx.PushPos(ctx.Buffer.Len())
+		return ast.WalkContinue, nil
+	}
+
+	pos := ctx.PopPos()
+	text := string(ctx.Buffer.Bytes()[pos:])
+	ctx.Buffer.Truncate(pos)
+
+	f := node.Parent().(*faq)
+	f.items = append(f.items, hooks.FAQItem{
+		Question:      hstring.RenderedString(strings.TrimSpace(text)),
+		PlainQuestion: plainText(node, src),
+	})
+
+	return ast.WalkContinue, nil
+}
+
+func (r *htmlRenderer) renderAnswer(w util.BufWriter, src []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	n := node.(*answer)
+	ctx, ok := w.(*render.Context)
+	if !ok {
+		if entering {
+			_, _ = w.WriteString("<dd>")
+			if !n.isTight {
+				_, _ = w.WriteString("\n")
+			}
+		} else {
+			_, _ = w.WriteString("</dd>\n")
+		}
+		return ast.WalkContinue, nil
+	}
+
+	if entering {
+		ctx.PushPos(ctx.Buffer.Len())
+		return ast.WalkContinue, nil
+	}
+
+	pos := ctx.PopPos()
+	text := string(ctx.Buffer.Bytes()[pos:])
+	ctx.Buffer.Truncate(pos)
+
+	f := node.Parent().(*faq)
+	if len(f.items) == 0 {
+		// Not possible in a definition list, but make sure we don't panic.
+		f.items = append(f.items, hooks.FAQItem{})
+	}
+	item := &f.items[len(f.items)-1]
+	if item.Answer != "" {
+		item.Answer += "\n"
+	}
+	item.Answer += hstring.RenderedString(strings.TrimSpace(text))
+	if item.PlainAnswer != "" {
+		item.PlainAnswer += " "
+	}
+	item.PlainAnswer += plainText(node, src)
+
+	return ast.WalkContinue, nil
+}
+
+func attributeValue(v any) []byte {
+	switch vv := v.(type) {
+	case []byte:
+		return vv
+	case string:
+		return []byte(vv)
+	}
+	return nil
+}
+
+type faqContext struct {
+	page    any
+	items   []hooks.FAQItem
+	ordinal int
+	*attributes.AttributesHolder
+}
+
+func (c faqContext) Page() any {
+	return c.page
+}
+
+func (c faqContext) Items() []hooks.FAQItem {
+	return c.items
+}
+
+func (c faqContext) Ordinal() int {
+	return c.ordinal
+}
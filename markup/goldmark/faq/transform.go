@@ -0,0 +1,168 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package faq renders definition lists as frequently asked questions.
+package faq
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/gohugoio/hugo/markup/converter/hooks"
+	"github.com/gohugoio/hugo/markup/goldmark/goldmark_config"
+	"github.com/yuin/goldmark/ast"
+	extast "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+var (
+	// KindFAQ is the kind of a definition list rendered as a FAQ.
+	KindFAQ = ast.NewNodeKind("HugoFAQ")
+	// KindQuestion is the kind of a definition term in a FAQ.
+	KindQuestion = ast.NewNodeKind("HugoFAQQuestion")
+	// KindAnswer is the kind of a definition description in a FAQ.
+	KindAnswer = ast.NewNodeKind("HugoFAQAnswer")
+)
+
+type faq struct {
+	ast.BaseBlock
+	ordinal int
+
+	// Collected while rendering.
+	items []hooks.FAQItem
+}
+
+func (*faq) Kind() ast.NodeKind { return KindFAQ }
+
+func (n *faq) Dump(src []byte, level int) {
+	ast.DumpHelper(n, src, level, nil, nil)
+}
+
+type question struct {
+	ast.BaseBlock
+}
+
+func (*question) Kind() ast.NodeKind { return KindQuestion }
+
+func (n *question) Dump(src []byte, level int) {
+	ast.DumpHelper(n, src, level, nil, nil)
+}
+
+type answer struct {
+	ast.BaseBlock
+	isTight bool
+}
+
+func (*answer) Kind() ast.NodeKind { return KindAnswer }
+
+func (n *answer) Dump(src []byte, level int) {
+	ast.DumpHelper(n, src, level, nil, nil)
+}
+
+// Transformer replaces the definition lists matching the configuration with FAQ nodes.
+type Transformer struct {
+	cfg goldmark_config.FAQ
+}
+
+// Transform transforms the provided Markdown AST.
+func (t *Transformer) Transform(doc *ast.Document, reader text.Reader, pctx parser.Context) {
+	var lists []*extast.DefinitionList
+
+	ast.Walk(doc, func(node ast.Node, enter bool) (ast.WalkStatus, error) {
+		if !enter {
+			return ast.WalkContinue, nil
+		}
+
+		if dl, ok := node.(*extast.DefinitionList); ok && t.matches(dl, reader.Source()) {
+			lists = append(lists, dl)
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	for i, dl := range lists {
+		f := &faq{ordinal: i}
+		for _, attr := range dl.Attributes() {
+			f.SetAttribute(attr.Name, attr.Value)
+		}
+		for c := dl.FirstChild(); c != nil; {
+			next := c.NextSibling()
+			var n ast.Node
+			switch v := c.(type) {
+			case *extast.DefinitionTerm:
+				n = &question{}
+			case *extast.DefinitionDescription:
+				n = &answer{isTight: v.IsTight}
+			}
+			if n != nil {
+				n.SetLines(c.Lines())
+				moveChildren(c, n)
+				f.AppendChild(f, n)
+			}
+			c = next
+		}
+		if parent := dl.Parent(); parent != nil {
+			parent.ReplaceChild(parent, dl, f)
+		}
+	}
+}
+
+func (t *Transformer) matches(dl *extast.DefinitionList, src []byte) bool {
+	if t.cfg.Match == goldmark_config.FAQMatchAll {
+		return true
+	}
+	var found bool
+	for c := dl.FirstChild(); c != nil; c = c.NextSibling() {
+		if _, ok := c.(*extast.DefinitionTerm); !ok {
+			continue
+		}
+		found = true
+		if !strings.HasSuffix(strings.TrimSpace(plainText(c, src)), "?") {
+			return false
+		}
+	}
+	return found
+}
+
+func moveChildren(from, to ast.Node) {
+	for c := from.FirstChild(); c != nil; {
+		next := c.NextSibling()
+		to.AppendChild(to, c)
+		c = next
+	}
+}
+
+// plainText returns the unrendered text of n.
+func plainText(n ast.Node, src []byte) string {
+	var buf bytes.Buffer
+	ast.Walk(n, func(node ast.Node, enter bool) (ast.WalkStatus, error) {
+		if !enter {
+			if node != n && node.Type() == ast.TypeBlock && buf.Len() > 0 {
+				buf.WriteByte(' ')
+			}
+			return ast.WalkContinue, nil
+		}
+		switch v := node.(type) {
+		case *ast.Text:
+			buf.Write(v.Segment.Value(src))
+			if v.SoftLineBreak() || v.HardLineBreak() {
+				buf.WriteByte(' ')
+			}
+		case *ast.String:
+			buf.Write(v.Value)
+		}
+		return ast.WalkContinue, nil
+	})
+	return strings.TrimSpace(buf.String())
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package footnotes_test
+
+import (
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+const footnotesFiles = `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "section", "home", "rss", "sitemap"]
+-- layouts/_default/single.html --
+{{ .Content }}
+-- content/p1.md --
+---
+title: "p1"
+---
+
+First[^1], second[^note] and first again[^1].
+
+[^1]: The first *footnote*.
+[^note]: The second footnote.
+`
+
+func TestFootnotesDefault(t *testing.T) {
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: footnotesFiles,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		`First<sup id="fnref:1"><a href="#fn:1" class="footnote-ref" role="doc-noteref">1</a></sup>`,
+		`first again<sup id="fnref1:1"><a href="#fn:1" class="footnote-ref" role="doc-noteref">1</a></sup>`,
+		`<div class="footnotes" role="doc-endnotes">`,
+		`<li id="fn:1">`,
+		`<a href="#fnref1:1" class="footnote-backref" role="doc-backlink">`,
+	)
+}
+
+func TestFootnotesHooks(t *testing.T) {
+	files := footnotesFiles + `
+-- layouts/_default/_markup/render-footnote-ref.html --
+<sup id="{{ .ID }}"><a href="#{{ .FootnoteID | safeURL }}">[{{ .Index }}|{{ .Label }}|{{ .RefIndex }}/{{ .RefCount }}]</a></sup>
+{{- /**/ -}}
+-- layouts/_default/_markup/render-footnotes.html --
+<aside class="notes">
+{{ range .Footnotes }}
+<div id="{{ .ID }}">{{ .Index }}|{{ .Label }}|{{ .Text | safeHTML }}|{{ delimit .RefIDs "," }}</div>
+{{ end }}
+</aside>
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		`First<sup id="fnref:1"><a href="#fn:1">[1|1|0/2]</a></sup>`,
+		`second<sup id="fnref:2"><a href="#fn:2">[2|note|0/1]</a></sup>`,
+		`first again<sup id="fnref1:1"><a href="#fn:1">[1|1|1/2]</a></sup>`,
+		`<aside class="notes">`,
+		"<div id=\"fn:1\">1|1|<p>The first <em>footnote</em>.</p>\n|fnref:1,fnref1:1</div>",
+		"<div id=\"fn:2\">2|note|<p>The second footnote.</p>\n|fnref:2</div>",
+	)
+
+	content := b.FileContent("public/p1/index.html")
+	b.Assert(strings.Contains(content, "doc-endnotes"), qt.IsFalse)
+	b.Assert(strings.Contains(content, "footnote-backref"), qt.IsFalse)
+}
+
+func TestFootnoteRefHookError(t *testing.T) {
+	files := footnotesFiles + `
+-- layouts/_default/_markup/render-footnote-ref.html --
+{{ if eq .Label "note" }}{{ .Foo }}{{ end }}
+`
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `p1.md:5:`)
+	b.Assert(err.Error(), qt.Contains, "can't evaluate field Foo")
+}
@@ -0,0 +1,356 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package footnotes renders PHP Markdown Extra footnotes with render hooks,
+// falling back to Goldmark's footnote renderer.
+package footnotes
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/gohugoio/hugo/common/herrors"
+	htext "github.com/gohugoio/hugo/common/text"
+	"github.com/gohugoio/hugo/common/types/hstring"
+	"github.com/gohugoio/hugo/markup/converter/hooks"
+	"github.com/gohugoio/hugo/markup/goldmark/internal/render"
+	"github.com/gohugoio/hugo/markup/internal/attributes"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+type footnotesExtension struct{}
+
+// New returns a goldmark extension for footnotes, rendered with render hooks if provided.
+func New() goldmark.Extender {
+	return &footnotesExtension{}
+}
+
+func (e *footnotesExtension) Extend(m goldmark.Markdown) {
+	extension.Footnote.Extend(m)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		// Before Goldmark's footnote renderer.
+		util.Prioritized(newHTMLRenderer(), 100),
+	))
+}
+
+type htmlRenderer struct {
+	// Goldmark's footnote renderer, used when there's no render hook.
+	defaults      renderer.NodeRenderer
+	defaultsFuncs map[ast.NodeKind]renderer.NodeRendererFunc
+
+	// The footnotes collected for the footnotes render hook, keyed by *render.Context.
+	footnotes sync.Map
+}
+
+func newHTMLRenderer() renderer.NodeRenderer {
+	r := &htmlRenderer{
+		defaults:      extension.NewFootnoteHTMLRenderer(),
+		defaultsFuncs: make(map[ast.NodeKind]renderer.NodeRendererFunc),
+	}
+	r.defaults.RegisterFuncs(r)
+	return r
+}
+
+// Register implements renderer.NodeRendererFuncRegisterer, used to
+// capture the render funcs of Goldmark's footnote renderer.
+func (r *htmlRenderer) Register(kind ast.NodeKind, f renderer.NodeRendererFunc) {
+	r.defaultsFuncs[kind] = f
+}
+
+// SetOption passes the renderer options, e.g. XHTML, on to Goldmark's footnote renderer.
+func (r *htmlRenderer) SetOption(name renderer.OptionName, value any) {
+	if so, ok := r.defaults.(renderer.SetOptioner); ok {
+		so.SetOption(name, value)
+	}
+}
+
+func (r *htmlRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(east.KindFootnoteLink, r.renderFootnoteLink)
+	reg.Register(east.KindFootnoteBacklink, r.renderFootnoteBacklink)
+	reg.Register(east.KindFootnote, r.renderFootnote)
+	reg.Register(east.KindFootnoteList, r.renderFootnoteList)
+}
+
+func (r *htmlRenderer) renderFootnoteLink(w util.BufWriter, src []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	var (
+		fr hooks.FootnoteRefRenderer
+		h  any
+	)
+	ctx, ok := w.(*render.Context)
+	if ok {
+		h = ctx.RenderContext().GetRenderer(hooks.FootnoteRefRendererType, nil)
+		if h != nil {
+			fr = h.(hooks.FootnoteRefRenderer)
+		}
+	}
+
+	if fr == nil {
+		return r.defaultsFuncs[east.KindFootnoteLink](w, src, node, entering)
+	}
+
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	n := node.(*east.FootnoteLink)
+
+	fctx := &footnoteRefContext{
+		page:     ctx.DocumentContext().Document,
+		index:    n.Index,
+		label:    footnoteLabel(node, n.Index),
+		refIndex: n.RefIndex,
+		refCount: n.RefCount,
+	}
+
+	fctx.createPos = func() htext.Position {
+		if resolver, ok := h.(hooks.ElementPositionResolver); ok {
+			return resolver.ResolvePosition(fctx)
+		}
+		return htext.Position{
+			Filename:     ctx.DocumentContext().Filename,
+			LineNumber:   1,
+			ColumnNumber: 1,
+		}
+	}
+
+	err := fr.RenderFootnoteRef(
+		ctx.RenderContext().Ctx,
+		w,
+		fctx,
+	)
+
+	ctx.AddIdentity(fr)
+
+	if err != nil {
+		return ast.WalkContinue, herrors.NewFileErrorFromPos(err, fctx.Position())
+	}
+
+	return ast.WalkContinue, nil
+}
+
+func (r *htmlRenderer) footnotesRenderer(w util.BufWriter) (hooks.FootnotesRenderer, *render.Context) {
+	ctx, ok := w.(*render.Context)
+	if !ok {
+		return nil, nil
+	}
+	h := ctx.RenderContext().GetRenderer(hooks.FootnotesRendererType, nil)
+	if h == nil {
+		return nil, nil
+	}
+	return h.(hooks.FootnotesRenderer), ctx
+}
+
+func (r *htmlRenderer) renderFootnoteBacklink(w util.BufWriter, src []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	_, ctx := r.footnotesRenderer(w)
+	if ctx == nil {
+		return r.defaultsFuncs[east.KindFootnoteBacklink](w, src, node, entering)
+	}
+
+	// The back links are left to the render hook.
+	if entering {
+		n := node.(*east.FootnoteBacklink)
+		if v, ok := r.footnotes.Load(ctx); ok {
+			state := v.(*footnotesState)
+			if len(state.footnotes) > 0 {
+				fn := &state.footnotes[len(state.footnotes)-1]
+				fn.RefIDs = append(fn.RefIDs, refID(n.Index, n.RefIndex))
+			}
+		}
+	}
+
+	return ast.WalkContinue, nil
+}
+
+func (r *htmlRenderer) renderFootnote(w util.BufWriter, src []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	_, ctx := r.footnotesRenderer(w)
+	if ctx == nil {
+		return r.defaultsFuncs[east.KindFootnote](w, src, node, entering)
+	}
+
+	v, ok := r.footnotes.Load(ctx)
+	if !ok {
+		return ast.WalkContinue, nil
+	}
+	state := v.(*footnotesState)
+	n := node.(*east.Footnote)
+
+	if entering {
+		state.footnotes = append(state.footnotes, hooks.Footnote{
+			Index: n.Index,
+			Label: string(n.Ref),
+			ID:    footnoteID(n.Index),
+		})
+		// Store the current pos so we can capture the rendered text.
+		ctx.PushPos(ctx.Buffer.Len())
+		return ast.WalkContinue, nil
+	}
+
+	pos := ctx.PopPos()
+	text := string(ctx.Buffer.Bytes()[pos:])
+	ctx.Buffer.Truncate(pos)
+	state.footnotes[len(state.footnotes)-1].Text = hstring.RenderedString(text)
+
+	return ast.WalkContinue, nil
+}
+
+func (r *htmlRenderer) renderFootnoteList(w util.BufWriter, src []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	fr, ctx := r.footnotesRenderer(w)
+	if fr == nil {
+		return r.defaultsFuncs[east.KindFootnoteList](w, src, node, entering)
+	}
+
+	if entering {
+		r.footnotes.Store(ctx, &footnotesState{})
+		ctx.PushPos(ctx.Buffer.Len())
+		return ast.WalkContinue, nil
+	}
+
+	pos := ctx.PopPos()
+	ctx.Buffer.Truncate(pos)
+
+	var footnotes []hooks.Footnote
+	if v, ok := r.footnotes.LoadAndDelete(ctx); ok {
+		footnotes = v.(*footnotesState).footnotes
+	}
+
+	fctx := &footnotesContext{
+		page:             ctx.DocumentContext().Document,
+		footnotes:        footnotes,
+		AttributesHolder: attributes.New(node.Attributes(), attributes.AttributesOwnerGeneral),
+	}
+
+	err := fr.RenderFootnotes(
+		ctx.RenderContext().Ctx,
+		w,
+		fctx,
+	)
+
+	ctx.AddIdentity(fr)
+
+	if err != nil {
+		return ast.WalkContinue, herrors.NewFileErrorFromPos(err, htext.Position{
+			Filename:     ctx.DocumentContext().Filename,
+			LineNumber:   1,
+			ColumnNumber: 1,
+		})
+	}
+
+	return ast.WalkContinue, nil
+}
+
+type footnotesState struct {
+	footnotes []hooks.Footnote
+}
+
+// footnoteLabel finds the label of the footnote with the given index
+// in the footnote list at the end of the document containing node.
+func footnoteLabel(node ast.Node, index int) string {
+	doc := node
+	for doc.Parent() != nil {
+		doc = doc.Parent()
+	}
+	for c := doc.LastChild(); c != nil; c = c.PreviousSibling() {
+		if c.Kind() != east.KindFootnoteList {
+			continue
+		}
+		for fn := c.FirstChild(); fn != nil; fn = fn.NextSibling() {
+			if n, ok := fn.(*east.Footnote); ok && n.Index == index {
+				return string(n.Ref)
+			}
+		}
+	}
+	return ""
+}
+
+// These are the IDs Goldmark uses.
+
+func footnoteID(index int) string {
+	return "fn:" + strconv.Itoa(index)
+}
+
+func refID(index, refIndex int) string {
+	if refIndex > 0 {
+		return fmt.Sprintf("fnref%d:%d", refIndex, index)
+	}
+	return "fnref:" + strconv.Itoa(index)
+}
+
+type footnoteRefContext struct {
+	page     any
+	index    int
+	label    string
+	refIndex int
+	refCount int
+
+	// This is only used in error situations and is expensive to create,
+	// to delay creation until needed.
+	pos       htext.Position
+	posInit   sync.Once
+	createPos func() htext.Position
+}
+
+func (c *footnoteRefContext) Page() any {
+	return c.page
+}
+
+func (c *footnoteRefContext) Index() int {
+	return c.index
+}
+
+func (c *footnoteRefContext) Label() string {
+	return c.label
+}
+
+func (c *footnoteRefContext) RefIndex() int {
+	return c.refIndex
+}
+
+func (c *footnoteRefContext) RefCount() int {
+	return c.refCount
+}
+
+func (c *footnoteRefContext) ID() string {
+	return refID(c.index, c.refIndex)
+}
+
+func (c *footnoteRefContext) FootnoteID() string {
+	return footnoteID(c.index)
+}
+
+func (c *footnoteRefContext) Position() htext.Position {
+	c.posInit.Do(func() {
+		c.pos = c.createPos()
+	})
+	return c.pos
+}
+
+type footnotesContext struct {
+	page      any
+	footnotes []hooks.Footnote
+
+	*attributes.AttributesHolder
+}
+
+func (c *footnotesContext) Page() any {
+	return c.page
+}
+
+func (c *footnotesContext) Footnotes() []hooks.Footnote {
+	return c.footnotes
+}
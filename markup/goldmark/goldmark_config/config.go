@@ -14,6 +14,13 @@
 // Package goldmark_config holds Goldmark related configuration.
 package goldmark_config
 
+const (
+	// FAQMatchQuestions matches definition lists where all terms are questions.
+	FAQMatchQuestions = "questions"
+	// FAQMatchAll matches all definition lists.
+	FAQMatchAll = "all"
+)
+
 const (
 	AutoHeadingIDTypeGitHub      = "github"
 	AutoHeadingIDTypeGitHubAscii = "github-ascii"
@@ -36,8 +43,12 @@ var Default = Config{
 			RightAngleQuote:  "&raquo;",
 			Apostrophe:       "&rsquo;",
 		},
-		Footnote:        true,
-		DefinitionList:  true,
+		Footnote:       true,
+		DefinitionList: true,
+		FAQ: FAQ{
+			Enable: false,
+			Match:  FAQMatchQuestions,
+		},
 		Table:           true,
 		Strikethrough:   true,
 		Linkify:         true,
@@ -52,8 +63,10 @@ var Default = Config{
 		AutoHeadingIDType:                  AutoHeadingIDTypeGitHub,
 		WrapStandAloneImageWithinParagraph: true,
 		Attribute: ParserAttribute{
-			Title: true,
-			Block: false,
+			Title:     true,
+			Block:     false,
+			Inline:    false,
+			FencedDiv: false,
 		},
 	},
 }
@@ -69,6 +82,10 @@ type Extensions struct {
 	Typographer    Typographer
 	Footnote       bool
 	DefinitionList bool
+	FAQ            FAQ
+	Passthrough    Passthrough
+	Citations      Citations
+	TableOptions   TableOptions
 
 	// GitHub flavored markdown
 	Table           bool
@@ -105,6 +122,77 @@ type Typographer struct {
 	Apostrophe string
 }
 
+// FAQ configures rendering of definition lists as frequently asked questions.
+type FAQ struct {
+	// Whether to render matching definition lists as FAQs, with schema.org
+	// structured data or using a render-faq template if provided.
+	// This requires the DefinitionList extension.
+	Enable bool
+
+	// Which definition lists to render as FAQs, "questions" for the ones where
+	// all terms end with a question mark, or "all". Default is "questions".
+	Match string
+}
+
+// Passthrough configures the passthrough extension, which passes the text
+// between the configured delimiters, e.g. LaTeX math, through unchanged.
+type Passthrough struct {
+	// Whether to enable the extension.
+	Enable bool
+
+	// The delimiters to use for inline and block passthrough elements.
+	Delimiters DelimitersConfig
+
+	// Whether to render passthrough elements to HTML and MathML with
+	// transform.ToMath if there's no render-passthrough template.
+	// This can be overridden per page or section with the renderMath
+	// front matter parameter.
+	RenderMath bool
+}
+
+// Citations configures the citations extension, which renders Pandoc style
+// citations, e.g. [@knuth1984, p. 33], using the entries in the bibliography.
+type Citations struct {
+	// Whether to enable the extension.
+	Enable bool
+
+	// The bibliography files to use for all pages, BibTeX (.bib) or CSL JSON (.json),
+	// relative to the assets directory.
+	// Pages can add to these with the bibliography front matter parameter.
+	Bibliography []string
+}
+
+// TableOptions configures the built-in rendering of tables, used when there's
+// no table render hook and any of the options are set.
+// Pages can override these with the tableOptions front matter parameter.
+type TableOptions struct {
+	// The CSS class of a div to wrap tables in, e.g. to let wide tables
+	// scroll horizontally on small screens. No wrapper if empty.
+	WrapperClass string
+
+	// Whether to add a data-sortable attribute to the table and a data-sort-type
+	// attribute, "number" or "text", to the header cells, for client side sorting.
+	Sortable bool
+
+	// Whether to render the caption attribute of a table as a figcaption in a
+	// figure wrapping the table instead of as a caption element.
+	Figure bool
+}
+
+// IsZero reports whether none of the options are set.
+func (o TableOptions) IsZero() bool {
+	return o == TableOptions{}
+}
+
+// DelimitersConfig holds the opening and closing delimiters of passthrough elements.
+type DelimitersConfig struct {
+	// The delimiters for inline passthrough elements, e.g. [["\\(", "\\)"], ["$", "$"]].
+	Inline [][]string
+
+	// The delimiters for block passthrough elements, e.g. [["\\[", "\\]"], ["$$", "$$"]].
+	Block [][]string
+}
+
 type Renderer struct {
 	// Whether softline breaks should be rendered as '<br>'
 	HardWraps bool
@@ -138,4 +226,9 @@ type ParserAttribute struct {
 	Title bool
 	// Enables custom attributeds for blocks.
 	Block bool
+	// Enables custom attributes for links, images and bracketed spans,
+	// e.g. [text](/url){.myclass} and [text]{.myclass}.
+	Inline bool
+	// Enables fenced divs with custom attributes, e.g. ::: {.myclass}.
+	FencedDiv bool
 }
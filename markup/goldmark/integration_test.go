@@ -155,6 +155,127 @@ safeHTML: |class: Smith & Wesson|id: heading-attribute-which-needs-escaping|
 	`)
 }
 
+func TestAttributesInline(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+[markup.goldmark.parser.attribute]
+inline = INLINE
+-- content/p1.md --
+---
+title: "p1"
+---
+A [link](/a/){.c1 target=_blank} and ![img](/b.jpg){width=300}.
+
+A [span with *emphasis*]{.c2 #s1} and [another] [span]{.c3}.
+
+Not a span: [no]{.c4 and [l](/l/) {.c5} and \[e]{.c6}.
+
+Line [one](/one/){.c7}
+two [three]{.c8}
+four.
+-- layouts/_default/single.html --
+{{ .Content }}
+`
+
+	t.Run("Default", func(t *testing.T) {
+		files := strings.ReplaceAll(files, "INLINE", "false")
+		b := hugolib.NewIntegrationTestBuilder(
+			hugolib.IntegrationTestConfig{
+				T:           t,
+				TxtarString: files,
+			},
+		).Build()
+		b.AssertFileContent("public/p1/index.html", "<a href=\"/a/\">link</a>{.c1 target=_blank}", "[span]{.c3}")
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		files := strings.ReplaceAll(files, "INLINE", "true")
+		b := hugolib.NewIntegrationTestBuilder(
+			hugolib.IntegrationTestConfig{
+				T:           t,
+				TxtarString: files,
+			},
+		).Build()
+		b.AssertFileContent("public/p1/index.html",
+			"<a href=\"/a/\" class=\"c1\" target=\"_blank\">link</a>",
+			"<img src=\"/b.jpg\" alt=\"img\" width=\"300\">",
+			"A <span class=\"c2\" id=\"s1\">span with <em>emphasis</em></span> and [another] <span class=\"c3\">span</span>.",
+			"[no]{.c4 and <a href=\"/l/\">l</a> {.c5} and [e]{.c6}.",
+			"<p>Line <a href=\"/one/\" class=\"c7\">one</a>\ntwo <span class=\"c8\">three</span>\nfour.</p>",
+		)
+	})
+
+	t.Run("Render hooks", func(t *testing.T) {
+		files := strings.ReplaceAll(files, "INLINE", "true")
+		files += `
+-- layouts/_default/_markup/render-link.html --
+<a href="{{ .Destination }}"{{ range $k, $v := .Attributes }} {{ $k }}="{{ $v }}"{{ end }}>{{ .Text }}</a>
+-- layouts/_default/_markup/render-image.html --
+<img src="{{ .Destination }}" width="{{ .Attributes.width }}">
+`
+		b := hugolib.NewIntegrationTestBuilder(
+			hugolib.IntegrationTestConfig{
+				T:           t,
+				TxtarString: files,
+			},
+		).Build()
+		b.AssertFileContent("public/p1/index.html",
+			"<a href=\"/a/\" class=\"c1\" target=\"_blank\">link</a>",
+			"<img src=\"/b.jpg\" width=\"300\">",
+		)
+	})
+}
+
+func TestFencedDiv(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+[markup.goldmark.parser.attribute]
+fencedDiv = true
+-- content/p1.md --
+---
+title: "p1"
+---
+::: {.note #n1}
+Some *text*.
+
+:::: warning
+Nested.
+::::
+
+After nested.
+:::
+
+Text.
+
+::: {.c1} :::
+-- layouts/_default/single.html --
+{{ .Content }}
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+	b.AssertFileContent("public/p1/index.html", `
+<div class="note" id="n1">
+<p>Some <em>text</em>.</p>
+<div class="warning">
+<p>Nested.</p>
+</div>
+<p>After nested.</p>
+</div>
+<p>Text.</p>
+<div class="c1">
+</div>
+`)
+}
+
 // Issue 9504
 func TestLinkInTitle(t *testing.T) {
 	t.Parallel()
@@ -191,6 +312,50 @@ title: "p1"
 	)
 }
 
+func TestLinkAndImageHookContext(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+[markup.goldmark.extensions]
+linkify = true
+-- content/p1.md --
+---
+title: "p1"
+---
+An [intro](/intro/) link.
+
+## First Section
+
+![a](/a.jpg) and [b](/b/).
+
+### Sub *Section*
+
+![c](/c.jpg) and https://example.org.
+-- layouts/_default/single.html --
+{{ .Content }}
+-- layouts/_default/_markup/render-link.html --
+link|{{ .Destination }}|{{ .Ordinal }}|{{ with .Heading }}{{ .Level }}:{{ .Anchor }}:{{ .PlainText }}{{ else }}none{{ end }}|{{ .Position.LineNumber }}|
+-- layouts/_default/_markup/render-image.html --
+<img src="{{ .Destination }}"{{ if gt .Ordinal 0 }} loading="lazy"{{ end }}>|{{ with .Heading }}{{ .Anchor }}{{ end }}|{{ .Position.LineNumber }}|
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		"link|/intro/|0|none|4|",
+		"<img src=\"/a.jpg\">|first-section|8|",
+		"link|/b/|1|2:first-section:First Section|8|",
+		"<img src=\"/c.jpg\" loading=\"lazy\">|sub-section|12|",
+		"link|https://example.org|2|3:sub-section:Sub Section|12|",
+	)
+}
+
 func TestHighlight(t *testing.T) {
 	t.Parallel()
 
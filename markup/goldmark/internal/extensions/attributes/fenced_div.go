@@ -0,0 +1,203 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attributes
+
+import (
+	hattributes "github.com/gohugoio/hugo/markup/internal/attributes"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// KindFencedDiv is the kind of a fenced div.
+var KindFencedDiv = ast.NewNodeKind("FencedDiv")
+
+var fencedDivStackKey = parser.NewContextKey()
+
+// NewFencedDiv returns an extension that adds support for Pandoc style
+// fenced divs, a block of Markdown wrapped in a div element with the
+// given attributes or class:
+//
+//	::: {.note #intro}
+//	Some *Markdown*.
+//	:::
+func NewFencedDiv() goldmark.Extender {
+	return fencedDivExtension{}
+}
+
+type fencedDivExtension struct{}
+
+func (e fencedDivExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithBlockParsers(
+			util.Prioritized(fencedDivParser{}, 100),
+		),
+	)
+	m.Renderer().AddOptions(
+		renderer.WithNodeRenderers(
+			util.Prioritized(fencedDivRenderer{}, 100),
+		),
+	)
+}
+
+// FencedDiv is a block of Markdown fenced with colons.
+type FencedDiv struct {
+	ast.BaseBlock
+}
+
+func (n *FencedDiv) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, nil, nil)
+}
+
+func (n *FencedDiv) Kind() ast.NodeKind {
+	return KindFencedDiv
+}
+
+type fencedDivParser struct{}
+
+func (p fencedDivParser) Trigger() []byte {
+	return []byte{':'}
+}
+
+func (p fencedDivParser) Open(parent ast.Node, reader text.Reader, pc parser.Context) (ast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 {
+		return nil, parser.NoChildren
+	}
+	i := fenceLength(line[pos:])
+	if i < 3 {
+		return nil, parser.NoChildren
+	}
+	rest := util.TrimRightSpace(util.TrimLeftSpace(line[pos+i:]))
+	// Allow a closing fence on the opening line, e.g. ::: {.note} :::
+	for len(rest) > 0 && rest[len(rest)-1] == ':' {
+		rest = rest[:len(rest)-1]
+	}
+	rest = util.TrimRightSpace(rest)
+	if len(rest) == 0 {
+		// A closing fence.
+		return nil, parser.NoChildren
+	}
+
+	node := &FencedDiv{}
+	if rest[0] == '{' {
+		attrs, ok := parser.ParseAttributes(text.NewReader(rest))
+		if !ok {
+			return nil, parser.NoChildren
+		}
+		for _, attr := range attrs {
+			node.SetAttribute(attr.Name, attr.Value)
+		}
+	} else {
+		for _, c := range rest {
+			if util.IsSpace(c) || (util.IsPunct(c) && c != '_' && c != '-') {
+				return nil, parser.NoChildren
+			}
+		}
+		node.SetAttributeString("class", rest)
+	}
+
+	pushFencedDiv(pc, node)
+	newline := 1
+	if line[len(line)-1] != '\n' {
+		newline = 0
+	}
+	reader.Advance(segment.Stop - segment.Start - newline + segment.Padding)
+
+	return node, parser.HasChildren
+}
+
+func (p fencedDivParser) Continue(node ast.Node, reader text.Reader, pc parser.Context) parser.State {
+	line, segment := reader.PeekLine()
+	if pos := pc.BlockOffset(); pos >= 0 && isClosingFence(line[pos:]) && innermostFencedDiv(pc) == node {
+		newline := 1
+		if line[len(line)-1] != '\n' {
+			newline = 0
+		}
+		reader.Advance(segment.Stop - segment.Start - newline + segment.Padding)
+		return parser.Close
+	}
+	return parser.Continue | parser.HasChildren
+}
+
+func (p fencedDivParser) Close(node ast.Node, reader text.Reader, pc parser.Context) {
+	removeFencedDiv(pc, node)
+}
+
+func (p fencedDivParser) CanInterruptParagraph() bool {
+	return true
+}
+
+func (p fencedDivParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+func fenceLength(line []byte) int {
+	i := 0
+	for ; i < len(line) && line[i] == ':'; i++ {
+	}
+	return i
+}
+
+func isClosingFence(line []byte) bool {
+	i := fenceLength(line)
+	return i >= 3 && util.IsBlank(line[i:])
+}
+
+// The open fenced divs are kept in a stack so the closing fence closes the
+// innermost div; goldmark asks the outermost open block first.
+func innermostFencedDiv(pc parser.Context) ast.Node {
+	stack, _ := pc.Get(fencedDivStackKey).([]ast.Node)
+	if len(stack) == 0 {
+		return nil
+	}
+	return stack[len(stack)-1]
+}
+
+func pushFencedDiv(pc parser.Context, node ast.Node) {
+	stack, _ := pc.Get(fencedDivStackKey).([]ast.Node)
+	pc.Set(fencedDivStackKey, append(stack, node))
+}
+
+func removeFencedDiv(pc parser.Context, node ast.Node) {
+	stack, _ := pc.Get(fencedDivStackKey).([]ast.Node)
+	for i, n := range stack {
+		if n == node {
+			stack = append(stack[:i], stack[i+1:]...)
+			break
+		}
+	}
+	pc.Set(fencedDivStackKey, stack)
+}
+
+type fencedDivRenderer struct{}
+
+func (r fencedDivRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(KindFencedDiv, r.renderFencedDiv)
+}
+
+func (r fencedDivRenderer) renderFencedDiv(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if entering {
+		_, _ = w.WriteString("<div")
+		hattributes.RenderASTAttributes(w, node.Attributes()...)
+		_, _ = w.WriteString(">\n")
+	} else {
+		_, _ = w.WriteString("</div>\n")
+	}
+	return ast.WalkContinue, nil
+}
@@ -0,0 +1,259 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attributes
+
+import (
+	hattributes "github.com/gohugoio/hugo/markup/internal/attributes"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+var (
+	// KindSpan is the kind of a bracketed span, e.g. [text]{.myclass}.
+	KindSpan = ast.NewNodeKind("Span")
+
+	kindSpanAttributes = ast.NewNodeKind("SpanAttributes")
+)
+
+// NewInline returns an extension that adds support for attribute lists
+// directly after links, images and bracketed spans, e.g.
+// [text](/url){.myclass}, ![alt](/img.jpg){width=300} and [text]{.myclass}.
+func NewInline() goldmark.Extender {
+	return inlineExtension{}
+}
+
+type inlineExtension struct{}
+
+func (e inlineExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithInlineParsers(
+			util.Prioritized(inlineAttrParser{}, 150),
+		),
+		parser.WithASTTransformers(
+			util.Prioritized(spanTransformer{}, 100),
+		),
+	)
+	m.Renderer().AddOptions(
+		renderer.WithNodeRenderers(
+			util.Prioritized(spanRenderer{}, 100),
+		),
+	)
+}
+
+// Span is a bracketed span with attributes.
+type Span struct {
+	ast.BaseInline
+}
+
+func (n *Span) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, nil, nil)
+}
+
+func (n *Span) Kind() ast.NodeKind {
+	return KindSpan
+}
+
+// spanAttributes holds the attributes following a closing bracket until
+// the spanTransformer has found the matching opening bracket.
+// It's also used as a placeholder for attributes already set on a link or
+// an image, to be removed by the spanTransformer.
+type spanAttributes struct {
+	ast.BaseInline
+	segment text.Segment
+	applied bool
+}
+
+func (n *spanAttributes) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, nil, nil)
+}
+
+func (n *spanAttributes) Kind() ast.NodeKind {
+	return kindSpanAttributes
+}
+
+type inlineAttrParser struct{}
+
+func (p inlineAttrParser) Trigger() []byte {
+	return []byte{'{'}
+}
+
+func (p inlineAttrParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	var target ast.Node
+	switch last := parent.LastChild(); last.(type) {
+	case *ast.Link, *ast.Image:
+		target = last
+	default:
+		if block.PrecendingCharacter() != ']' {
+			return nil
+		}
+	}
+
+	_, start := block.Position()
+	attrs, ok := parser.ParseAttributes(block)
+	if !ok {
+		return nil
+	}
+
+	_, stop := block.Position()
+	n := &spanAttributes{segment: text.NewSegment(start.Start, stop.Start)}
+
+	if target != nil {
+		for _, attr := range attrs {
+			target.SetAttribute(attr.Name, attr.Value)
+		}
+		n.applied = true
+		return n
+	}
+
+	for _, attr := range attrs {
+		n.SetAttribute(attr.Name, attr.Value)
+	}
+	return n
+}
+
+// spanTransformer replaces the text between the brackets before each
+// spanAttributes node with a Span.
+type spanTransformer struct{}
+
+func (t spanTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	var nodes []*spanAttributes
+	ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if n, ok := node.(*spanAttributes); ok && entering {
+			nodes = append(nodes, n)
+		}
+		return ast.WalkContinue, nil
+	})
+
+	source := reader.Source()
+	for _, n := range nodes {
+		if n.applied {
+			n.Parent().RemoveChild(n.Parent(), n)
+			continue
+		}
+		if !t.wrap(n, source) {
+			// Not a span, keep the attribute list as text.
+			parent := n.Parent()
+			parent.ReplaceChild(parent, n, ast.NewTextSegment(n.segment))
+		}
+	}
+}
+
+func (t spanTransformer) wrap(n *spanAttributes, source []byte) bool {
+	closing, ok := n.PreviousSibling().(*ast.Text)
+	if !ok || closing.Segment.Len() == 0 || source[closing.Segment.Stop-1] != ']' {
+		return false
+	}
+
+	// Find the matching opening bracket.
+	var (
+		opening *ast.Text
+		pos     int
+		depth   int
+	)
+	for c := ast.Node(closing); c != nil && opening == nil; c = c.PreviousSibling() {
+		tn, ok := c.(*ast.Text)
+		if !ok {
+			continue
+		}
+		value := tn.Segment.Value(source)
+		if tn == closing {
+			value = value[:len(value)-1]
+		}
+		for i := len(value) - 1; i >= 0; i-- {
+			if i > 0 && value[i-1] == '\\' {
+				// Escaped.
+				continue
+			}
+			switch value[i] {
+			case ']':
+				depth++
+			case '[':
+				if depth == 0 {
+					opening, pos = tn, i
+				}
+				depth--
+			}
+			if opening != nil {
+				break
+			}
+		}
+	}
+	if opening == nil {
+		return false
+	}
+
+	parent := n.Parent()
+	span := &Span{}
+	for _, attr := range n.Attributes() {
+		span.SetAttribute(attr.Name, attr.Value)
+	}
+
+	// Split the opening text node at the bracket.
+	before := opening.Segment.WithStop(opening.Segment.Start + pos)
+	first := ast.NewTextSegment(opening.Segment.WithStart(opening.Segment.Start + pos + 1))
+	if opening == closing {
+		first.Segment = first.Segment.WithStop(closing.Segment.Stop - 1)
+	} else {
+		first.SetSoftLineBreak(opening.SoftLineBreak())
+	}
+	if first.Segment.Len() > 0 || first.SoftLineBreak() {
+		span.AppendChild(span, first)
+	}
+
+	if opening != closing {
+		for c := opening.NextSibling(); c != closing; {
+			next := c.NextSibling()
+			parent.RemoveChild(parent, c)
+			span.AppendChild(span, c)
+			c = next
+		}
+		last := ast.NewTextSegment(closing.Segment.WithStop(closing.Segment.Stop - 1))
+		if last.Segment.Len() > 0 {
+			span.AppendChild(span, last)
+		}
+		parent.RemoveChild(parent, closing)
+	}
+
+	if before.Len() > 0 {
+		opening.Segment = before
+		opening.SetSoftLineBreak(false)
+		parent.InsertAfter(parent, opening, span)
+	} else {
+		parent.ReplaceChild(parent, opening, span)
+	}
+	parent.RemoveChild(parent, n)
+
+	return true
+}
+
+type spanRenderer struct{}
+
+func (r spanRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(KindSpan, r.renderSpan)
+}
+
+func (r spanRenderer) renderSpan(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if entering {
+		_, _ = w.WriteString("<span")
+		hattributes.RenderASTAttributes(w, node.Attributes()...)
+		_ = w.WriteByte('>')
+	} else {
+		_, _ = w.WriteString("</span>")
+	}
+	return ast.WalkContinue, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package passthrough_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+const passthroughFiles = `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "section", "home", "rss", "sitemap"]
+[markup.goldmark.parser.attribute]
+block = true
+[markup.goldmark.extensions.passthrough]
+enable = true
+[markup.goldmark.extensions.passthrough.delimiters]
+inline = [['\(', '\)'], ['$', '$']]
+block = [['\[', '\]'], ['$$', '$$']]
+-- layouts/_default/single.html --
+{{ .Content }}
+-- content/p1.md --
+---
+title: "p1"
+---
+
+Inline \(a^*b_*\) and $x < y$ but it costs $5 and $10.
+
+$$
+\begin{aligned}
+a &= b
+\end{aligned}
+$$
+{.math}
+
+\[a^2 + b^2 = c^2\]
+
+Some \*escaped\* text.
+`
+
+func TestPassthroughDefault(t *testing.T) {
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: passthroughFiles,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		"<p>Inline \\(a^*b_*\\) and $x < y$ but it costs $5 and $10.</p>",
+		"$$\n\\begin{aligned}\na &= b\n\\end{aligned}\n$$\n",
+		"\\[a^2 + b^2 = c^2\\]\n",
+		"<p>Some *escaped* text.</p>",
+	)
+}
+
+func TestPassthroughHook(t *testing.T) {
+	files := passthroughFiles + `
+-- layouts/_default/_markup/render-passthrough-inline.html --
+<span class="math-inline" data-ordinal="{{ .Ordinal }}">{{ .Inner }}</span>
+{{- /**/ -}}
+-- layouts/_default/_markup/render-passthrough.html --
+<div class="math-{{ .Type }} {{ .Attributes.class }}" data-ordinal="{{ .Ordinal }}">{{ .Inner }}</div>
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		`Inline <span class="math-inline" data-ordinal="0">a^*b_*</span> and <span class="math-inline" data-ordinal="1">x &lt; y</span> but it costs $5 and $10.`,
+		`<div class="math-block math" data-ordinal="2">\begin{aligned}
+a &amp;= b
+\end{aligned}</div>`,
+		`<div class="math-block " data-ordinal="3">a^2 &#43; b^2 = c^2</div>`,
+	)
+}
+
+func TestPassthroughHookPosition(t *testing.T) {
+	files := strings.Replace(passthroughFiles, "-- content/p1.md --", `-- layouts/_default/_markup/render-passthrough-block.html --
+Block {{ .Ordinal }}: {{ .Position.LineNumber }}|
+-- content/p1.md --`, 1)
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html", "Block 2: 7|", "Block 3: 13|")
+}
@@ -0,0 +1,316 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package passthrough passes the text between configured delimiters, e.g.
+// LaTeX math, through the Markdown parser unchanged, to be rendered as is
+// or with render hooks.
+package passthrough
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/gohugoio/hugo/markup/goldmark/goldmark_config"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+var (
+	// KindPassthroughInline is the kind of an inline passthrough element.
+	KindPassthroughInline = ast.NewNodeKind("PassthroughInline")
+	// KindPassthroughBlock is the kind of a block passthrough element.
+	KindPassthroughBlock = ast.NewNodeKind("PassthroughBlock")
+)
+
+const (
+	// TypeInline is the type of an inline passthrough element.
+	TypeInline = "inline"
+	// TypeBlock is the type of a block passthrough element.
+	TypeBlock = "block"
+)
+
+// Delimiters holds the opening and closing delimiters of a passthrough element.
+type Delimiters struct {
+	Open  []byte
+	Close []byte
+}
+
+// PassthroughInline is an inline passthrough element, e.g. \(a^2\).
+type PassthroughInline struct {
+	ast.BaseInline
+
+	// The segment including the delimiters.
+	Segment    text.Segment
+	Delimiters Delimiters
+
+	ordinal int
+}
+
+func (n *PassthroughInline) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Value": string(n.Segment.Value(source))}, nil)
+}
+
+func (n *PassthroughInline) Kind() ast.NodeKind {
+	return KindPassthroughInline
+}
+
+// Inner returns the text between the delimiters.
+func (n *PassthroughInline) Inner(source []byte) []byte {
+	v := n.Segment.Value(source)
+	return v[len(n.Delimiters.Open) : len(v)-len(n.Delimiters.Close)]
+}
+
+// PassthroughBlock is a block passthrough element, e.g.
+//
+//	$$
+//	a^2 + b^2 = c^2
+//	$$
+type PassthroughBlock struct {
+	ast.BaseBlock
+
+	Delimiters Delimiters
+
+	ordinal int
+	closed  bool
+}
+
+func (n *PassthroughBlock) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, nil, nil)
+}
+
+func (n *PassthroughBlock) Kind() ast.NodeKind {
+	return KindPassthroughBlock
+}
+
+func (n *PassthroughBlock) IsRaw() bool {
+	return true
+}
+
+// Text returns the source text, including the delimiters.
+func (n *PassthroughBlock) Text(source []byte) []byte {
+	var buf bytes.Buffer
+	lines := n.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		line := lines.At(i)
+		buf.Write(line.Value(source))
+	}
+	return buf.Bytes()
+}
+
+// Inner returns the text between the delimiters.
+func (n *PassthroughBlock) Inner(source []byte) []byte {
+	v := bytes.TrimSpace(n.Text(source))
+	v = bytes.TrimPrefix(v, n.Delimiters.Open)
+	v = bytes.TrimSuffix(v, n.Delimiters.Close)
+	return bytes.TrimSpace(v)
+}
+
+// New returns a goldmark extension for the given configuration.
+func New(cfg goldmark_config.Passthrough) goldmark.Extender {
+	return &passthroughExtension{
+		inline: toDelimiters(cfg.Delimiters.Inline),
+		block:  toDelimiters(cfg.Delimiters.Block),
+	}
+}
+
+// toDelimiters converts the configured delimiter pairs, the longest
+// opening delimiter first so e.g. $$ is tried before $.
+func toDelimiters(pairs [][]string) []Delimiters {
+	var delimiters []Delimiters
+	for _, pair := range pairs {
+		if len(pair) != 2 || pair[0] == "" || pair[1] == "" {
+			continue
+		}
+		delimiters = append(delimiters, Delimiters{Open: []byte(pair[0]), Close: []byte(pair[1])})
+	}
+	sort.SliceStable(delimiters, func(i, j int) bool {
+		return len(delimiters[i].Open) > len(delimiters[j].Open)
+	})
+	return delimiters
+}
+
+func triggers(delimiters []Delimiters) []byte {
+	var b []byte
+	for _, d := range delimiters {
+		if bytes.IndexByte(b, d.Open[0]) == -1 {
+			b = append(b, d.Open[0])
+		}
+	}
+	return b
+}
+
+type passthroughExtension struct {
+	inline []Delimiters
+	block  []Delimiters
+}
+
+func (e *passthroughExtension) Extend(m goldmark.Markdown) {
+	var parserOptions []parser.Option
+	if len(e.inline) > 0 {
+		parserOptions = append(parserOptions, parser.WithInlineParsers(
+			util.Prioritized(&inlineParser{delimiters: e.inline}, 100),
+		))
+	}
+	if len(e.block) > 0 {
+		parserOptions = append(parserOptions, parser.WithBlockParsers(
+			// Before the paragraph, code and heading parsers.
+			util.Prioritized(&blockParser{delimiters: e.block}, 90),
+		))
+	}
+	parserOptions = append(parserOptions, parser.WithASTTransformers(
+		util.Prioritized(&transformer{}, 100),
+	))
+
+	m.Parser().AddOptions(parserOptions...)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(newHTMLRenderer(), 100),
+	))
+}
+
+type inlineParser struct {
+	delimiters []Delimiters
+}
+
+func (p *inlineParser) Trigger() []byte {
+	return triggers(p.delimiters)
+}
+
+func (p *inlineParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	line, segment := block.PeekLine()
+	for _, d := range p.delimiters {
+		if !bytes.HasPrefix(line, d.Open) {
+			continue
+		}
+		rest := line[len(d.Open):]
+		i := bytes.Index(rest, d.Close)
+		if i <= 0 {
+			continue
+		}
+		if isDollar(d) && !isTeXMath(rest, i) {
+			continue
+		}
+		length := len(d.Open) + i + len(d.Close)
+		block.Advance(length)
+		return &PassthroughInline{
+			Segment:    segment.WithStop(segment.Start + length),
+			Delimiters: d,
+		}
+	}
+	return nil
+}
+
+func isDollar(d Delimiters) bool {
+	return string(d.Open) == "$" && string(d.Close) == "$"
+}
+
+// isTeXMath reports whether the $ delimited text in rest[:end] is math,
+// using Pandoc's rule so e.g. "costs $5 and $10" isn't:
+// no space after the opening $, and no space before or digit after the closing $.
+func isTeXMath(rest []byte, end int) bool {
+	if util.IsSpace(rest[0]) || util.IsSpace(rest[end-1]) {
+		return false
+	}
+	if end+1 < len(rest) && rest[end+1] >= '0' && rest[end+1] <= '9' {
+		return false
+	}
+	return true
+}
+
+type blockParser struct {
+	delimiters []Delimiters
+}
+
+func (p *blockParser) Trigger() []byte {
+	return triggers(p.delimiters)
+}
+
+func (p *blockParser) Open(parent ast.Node, reader text.Reader, pc parser.Context) (ast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	pos := pc.BlockOffset()
+	if pos < 0 {
+		return nil, parser.NoChildren
+	}
+	for _, d := range p.delimiters {
+		if !bytes.HasPrefix(line[pos:], d.Open) {
+			continue
+		}
+		node := &PassthroughBlock{Delimiters: d}
+		node.Lines().Append(segment.WithStart(segment.Start + pos))
+		rest := util.TrimRightSpace(line[pos+len(d.Open):])
+		node.closed = len(rest) >= len(d.Close) && bytes.HasSuffix(rest, d.Close)
+		reader.Advance(segment.Len() - trailingNewline(line))
+		return node, parser.NoChildren
+	}
+	return nil, parser.NoChildren
+}
+
+func (p *blockParser) Continue(node ast.Node, reader text.Reader, pc parser.Context) parser.State {
+	n := node.(*PassthroughBlock)
+	if n.closed {
+		return parser.Close
+	}
+	line, segment := reader.PeekLine()
+	if line == nil {
+		return parser.Close
+	}
+	n.Lines().Append(segment)
+	reader.Advance(segment.Len() - trailingNewline(line))
+	if bytes.HasSuffix(util.TrimRightSpace(line), n.Delimiters.Close) {
+		n.closed = true
+		return parser.Close
+	}
+	return parser.Continue | parser.NoChildren
+}
+
+func (p *blockParser) Close(node ast.Node, reader text.Reader, pc parser.Context) {
+}
+
+func (p *blockParser) CanInterruptParagraph() bool {
+	return true
+}
+
+func (p *blockParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+func trailingNewline(line []byte) int {
+	if len(line) > 0 && line[len(line)-1] == '\n' {
+		return 1
+	}
+	return 0
+}
+
+// transformer sets the ordinal of the passthrough elements in document order.
+type transformer struct{}
+
+func (t *transformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	var ordinal int
+	ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch n := node.(type) {
+		case *PassthroughInline:
+			n.ordinal = ordinal
+			ordinal++
+		case *PassthroughBlock:
+			n.ordinal = ordinal
+			ordinal++
+		}
+		return ast.WalkContinue, nil
+	})
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package passthrough
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/gohugoio/hugo/common/herrors"
+	htext "github.com/gohugoio/hugo/common/text"
+	"github.com/gohugoio/hugo/markup/converter/hooks"
+	"github.com/gohugoio/hugo/markup/goldmark/internal/render"
+	"github.com/gohugoio/hugo/markup/internal/attributes"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/util"
+)
+
+type htmlRenderer struct{}
+
+func newHTMLRenderer() renderer.NodeRenderer {
+	return &htmlRenderer{}
+}
+
+func (r *htmlRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(KindPassthroughInline, r.renderPassthrough)
+	reg.Register(KindPassthroughBlock, r.renderPassthrough)
+}
+
+func (r *htmlRenderer) renderPassthrough(w util.BufWriter, src []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	var (
+		typ     string
+		inner   []byte
+		ordinal int
+		attrs   []ast.Attribute
+	)
+
+	switch n := node.(type) {
+	case *PassthroughInline:
+		typ, inner, ordinal = TypeInline, n.Inner(src), n.ordinal
+	case *PassthroughBlock:
+		typ, inner, ordinal, attrs = TypeBlock, n.Inner(src), n.ordinal, n.Attributes()
+	}
+
+	var (
+		pr hooks.PassthroughRenderer
+		h  any
+	)
+	ctx, ok := w.(*render.Context)
+	if ok {
+		h = ctx.RenderContext().GetRenderer(hooks.PassthroughRendererType, typ)
+		if h != nil {
+			pr = h.(hooks.PassthroughRenderer)
+		}
+	}
+
+	if pr == nil {
+		renderPassthroughDefault(w, src, node)
+		return ast.WalkSkipChildren, nil
+	}
+
+	pctx := &passthroughContext{
+		page:             ctx.DocumentContext().Document,
+		ordinal:          ordinal,
+		typ:              typ,
+		inner:            string(inner),
+		AttributesHolder: attributes.New(attrs, attributes.AttributesOwnerGeneral),
+	}
+
+	pctx.createPos = func() htext.Position {
+		if resolver, ok := h.(hooks.ElementPositionResolver); ok {
+			return resolver.ResolvePosition(pctx)
+		}
+		return htext.Position{
+			Filename:     ctx.DocumentContext().Filename,
+			LineNumber:   1,
+			ColumnNumber: 1,
+		}
+	}
+
+	err := pr.RenderPassthrough(
+		ctx.RenderContext().Ctx,
+		w,
+		pctx,
+	)
+
+	ctx.AddIdentity(pr)
+
+	if err != nil {
+		return ast.WalkSkipChildren, herrors.NewFileErrorFromPos(err, pctx.Position())
+	}
+
+	if typ == TypeBlock {
+		_ = w.WriteByte('\n')
+	}
+
+	return ast.WalkSkipChildren, nil
+}
+
+// renderPassthroughDefault writes the source text as is, including the delimiters,
+// e.g. for a client side math library to process.
+func renderPassthroughDefault(w util.BufWriter, src []byte, node ast.Node) {
+	switch n := node.(type) {
+	case *PassthroughInline:
+		_, _ = w.Write(n.Segment.Value(src))
+	case *PassthroughBlock:
+		_, _ = w.Write(bytes.TrimRight(n.Text(src), "\r\n"))
+		_ = w.WriteByte('\n')
+	}
+}
+
+type passthroughContext struct {
+	page    any
+	ordinal int
+	typ     string
+	inner   string
+
+	// This is only used in error situations and is expensive to create,
+	// to delay creation until needed.
+	pos       htext.Position
+	posInit   sync.Once
+	createPos func() htext.Position
+
+	*attributes.AttributesHolder
+}
+
+func (c *passthroughContext) Page() any {
+	return c.page
+}
+
+func (c *passthroughContext) Ordinal() int {
+	return c.ordinal
+}
+
+func (c *passthroughContext) Type() string {
+	return c.typ
+}
+
+func (c *passthroughContext) Inner() string {
+	return c.inner
+}
+
+func (c *passthroughContext) Position() htext.Position {
+	c.posInit.Do(func() {
+		c.pos = c.createPos()
+	})
+	return c.pos
+}
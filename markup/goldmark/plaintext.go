@@ -0,0 +1,101 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goldmark
+
+import (
+	"bytes"
+	"html"
+	"sync"
+
+	"github.com/gohugoio/hugo/markup/goldmark/passthrough"
+	"github.com/yuin/goldmark/ast"
+)
+
+// plainText extracts the plain text from a parsed document when first asked
+// for, so we don't need to render the document and strip the HTML to get it.
+type plainText struct {
+	doc ast.Node
+	src []byte
+
+	once sync.Once
+	text []byte
+}
+
+func (p *plainText) get() []byte {
+	p.once.Do(func() {
+		p.text = extractPlainText(p.doc, p.src)
+	})
+	return p.text
+}
+
+// fencedCodeBlockProvider is implemented by the Hugo code blocks that replace
+// the fenced code blocks when parsing.
+type fencedCodeBlockProvider interface {
+	FencedCodeBlock() *ast.FencedCodeBlock
+}
+
+func extractPlainText(n ast.Node, src []byte) []byte {
+	buf := &bytes.Buffer{}
+
+	newBlock := func() {
+		if buf.Len() > 0 && buf.Bytes()[buf.Len()-1] != '\n' {
+			buf.WriteByte('\n')
+		}
+	}
+
+	ast.Walk(n, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if n.Type() == ast.TypeBlock {
+			newBlock()
+		}
+
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch nn := n.(type) {
+		case *ast.HTMLBlock, *ast.RawHTML, *ast.Image:
+			return ast.WalkSkipChildren, nil
+		case *ast.FencedCodeBlock, *ast.CodeBlock, fencedCodeBlockProvider:
+			if cb, ok := nn.(fencedCodeBlockProvider); ok {
+				n = cb.FencedCodeBlock()
+			}
+			lines := n.Lines()
+			for i := 0; i < lines.Len(); i++ {
+				line := lines.At(i)
+				buf.Write(line.Value(src))
+			}
+			return ast.WalkSkipChildren, nil
+		case *passthrough.PassthroughInline:
+			buf.Write(nn.Inner(src))
+		case *passthrough.PassthroughBlock:
+			buf.Write(nn.Inner(src))
+		case *ast.Text:
+			buf.Write(nn.Segment.Value(src))
+			if nn.HardLineBreak() {
+				buf.WriteByte('\n')
+			} else if nn.SoftLineBreak() {
+				buf.WriteByte(' ')
+			}
+		case *ast.String:
+			// The typographer stores HTML entities in String nodes.
+			buf.WriteString(html.UnescapeString(string(nn.Value)))
+		case *ast.AutoLink:
+			buf.Write(nn.Label(src))
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return bytes.TrimSpace(buf.Bytes())
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package goldmark_test
+
+import (
+	"testing"
+
+	"github.com/gohugoio/hugo/common/loggers"
+	"github.com/gohugoio/hugo/config/testconfig"
+	"github.com/gohugoio/hugo/markup/converter"
+	"github.com/gohugoio/hugo/markup/goldmark"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestPlainTextAndHeadings(t *testing.T) {
+	c := qt.New(t)
+
+	content := `
+# Header 1
+
+Some *emphasized* text with a [link](https://example.org)
+and a soft line break --- with typography.
+
+## H2 with ` + "`code`" + `
+
+<div>raw html</div>
+
+![alt text](image.jpg)
+
+` + "```go" + `
+fmt.Println("hello")
+` + "```" + `
+
+### H3
+`
+	p, err := goldmark.Provider.New(
+		converter.ProviderConfig{
+			Conf:   testconfig.GetTestConfig(nil, nil),
+			Logger: loggers.NewErrorLogger(),
+		})
+	c.Assert(err, qt.IsNil)
+	conv, err := p.New(converter.DocumentContext{})
+	c.Assert(err, qt.IsNil)
+
+	r, err := conv.(converter.ParseRenderer).Parse(converter.RenderContext{Src: []byte(content), RenderTOC: true, GetRenderer: nopGetRenderer})
+	c.Assert(err, qt.IsNil)
+
+	plain := string(r.(converter.PlainTextProvider).PlainText())
+	c.Assert(plain, qt.Equals, "Header 1\nSome emphasized text with a link and a soft line break — with typography.\nH2 with code\nfmt.Println(\"hello\")\nH3")
+
+	headings := r.TableOfContents().Headings
+	c.Assert(headings, qt.HasLen, 1)
+	c.Assert(headings[0].ID, qt.Equals, "header-1")
+	c.Assert(headings[0].Level, qt.Equals, 1)
+	c.Assert(headings[0].Headings[0].ID, qt.Equals, "h2-with-code")
+	c.Assert(headings[0].Headings[0].Level, qt.Equals, 2)
+	c.Assert(headings[0].Headings[0].Headings[0].Level, qt.Equals, 3)
+}
@@ -16,8 +16,11 @@ package goldmark
 import (
 	"bytes"
 	"strings"
+	"sync"
 
+	htext "github.com/gohugoio/hugo/common/text"
 	"github.com/gohugoio/hugo/common/types/hstring"
+	"github.com/gohugoio/hugo/markup/converter"
 	"github.com/gohugoio/hugo/markup/converter/hooks"
 	"github.com/gohugoio/hugo/markup/goldmark/goldmark_config"
 	"github.com/gohugoio/hugo/markup/goldmark/images"
@@ -26,8 +29,10 @@ import (
 
 	"github.com/yuin/goldmark"
 	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
 	"github.com/yuin/goldmark/renderer"
 	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
 	"github.com/yuin/goldmark/util"
 )
 
@@ -53,32 +58,68 @@ type linkContext struct {
 	title       string
 	text        hstring.RenderedString
 	plainText   string
+	ordinal     int
+	heading     *hooks.HeadingInfo
 	*attributes.AttributesHolder
+
+	// This is expensive to create, so delay creation until needed.
+	pos       htext.Position
+	posInit   sync.Once
+	createPos func() htext.Position
 }
 
-func (ctx linkContext) Destination() string {
+func (ctx *linkContext) Destination() string {
 	return ctx.destination
 }
 
-func (ctx linkContext) Page() any {
+func (ctx *linkContext) Page() any {
 	return ctx.page
 }
 
-func (ctx linkContext) Text() hstring.RenderedString {
+func (ctx *linkContext) Text() hstring.RenderedString {
 	return ctx.text
 }
 
-func (ctx linkContext) PlainText() string {
+func (ctx *linkContext) PlainText() string {
 	return ctx.plainText
 }
 
-func (ctx linkContext) Title() string {
+func (ctx *linkContext) Title() string {
 	return ctx.title
 }
 
+func (ctx *linkContext) Ordinal() int {
+	return ctx.ordinal
+}
+
+func (ctx *linkContext) Heading() *hooks.HeadingInfo {
+	return ctx.heading
+}
+
+func (ctx *linkContext) Position() htext.Position {
+	ctx.posInit.Do(func() {
+		ctx.pos = ctx.createPos()
+	})
+	return ctx.pos
+}
+
+// setPositionResolver sets up the lazy creation of the position of the link,
+// using renderer if it implements hooks.ElementPositionResolver.
+func (ctx *linkContext) setPositionResolver(renderer any, dctx converter.DocumentContext, hookCtx hooks.LinkContext) {
+	ctx.createPos = func() htext.Position {
+		if resolver, ok := renderer.(hooks.ElementPositionResolver); ok {
+			return resolver.ResolvePosition(hookCtx)
+		}
+		return htext.Position{
+			Filename:     dctx.Filename,
+			LineNumber:   1,
+			ColumnNumber: 1,
+		}
+	}
+}
+
 type imageLinkContext struct {
-	linkContext
-	ordinal int
+	*linkContext
 	isBlock bool
 }
 
@@ -86,10 +127,6 @@ func (ctx imageLinkContext) IsBlock() bool {
 	return ctx.isBlock
 }
 
-func (ctx imageLinkContext) Ordinal() int {
-	return ctx.ordinal
-}
-
 type headingContext struct {
 	page      any
 	level     int
@@ -173,6 +210,7 @@ func (r *hookedRenderer) renderImage(w util.BufWriter, source []byte, node ast.N
 	if n, ok := n.AttributeString(images.AttrOrdinal); ok {
 		ordinal = n.(int)
 	}
+	heading := headingInfo(n, source)
 
 	// We use the attributes to signal from the parser whether the image is in
 	// a block context or not.
@@ -180,21 +218,25 @@ func (r *hookedRenderer) renderImage(w util.BufWriter, source []byte, node ast.N
 	// internal attributes before rendering.
 	attrs := r.filterInternalAttributes(n.Attributes())
 
+	lctx := imageLinkContext{
+		linkContext: &linkContext{
+			page:             ctx.DocumentContext().Document,
+			destination:      string(n.Destination),
+			title:            string(n.Title),
+			text:             hstring.RenderedString(text),
+			plainText:        string(n.Text(source)),
+			ordinal:          ordinal,
+			heading:          heading,
+			AttributesHolder: attributes.New(attrs, attributes.AttributesOwnerGeneral),
+		},
+		isBlock: isBlock,
+	}
+	lctx.setPositionResolver(lr, ctx.DocumentContext(), lctx)
+
 	err := lr.RenderLink(
 		ctx.RenderContext().Ctx,
 		w,
-		imageLinkContext{
-			linkContext: linkContext{
-				page:             ctx.DocumentContext().Document,
-				destination:      string(n.Destination),
-				title:            string(n.Title),
-				text:             hstring.RenderedString(text),
-				plainText:        string(n.Text(source)),
-				AttributesHolder: attributes.New(attrs, attributes.AttributesOwnerGeneral),
-			},
-			ordinal: ordinal,
-			isBlock: isBlock,
-		},
+		lctx,
 	)
 
 	ctx.AddIdentity(lr)
@@ -203,14 +245,13 @@ func (r *hookedRenderer) renderImage(w util.BufWriter, source []byte, node ast.N
 }
 
 func (r *hookedRenderer) filterInternalAttributes(attrs []ast.Attribute) []ast.Attribute {
-	n := 0
+	var filtered []ast.Attribute
 	for _, x := range attrs {
 		if !bytes.HasPrefix(x.Name, []byte(internalAttrPrefix)) {
-			attrs[n] = x
-			n++
+			filtered = append(filtered, x)
 		}
 	}
-	return attrs[:n]
+	return filtered
 }
 
 // Fall back to the default Goldmark render funcs. Method below borrowed from:
@@ -271,17 +312,22 @@ func (r *hookedRenderer) renderLink(w util.BufWriter, source []byte, node ast.No
 	text := ctx.Buffer.Bytes()[pos:]
 	ctx.Buffer.Truncate(pos)
 
+	lctx := &linkContext{
+		page:             ctx.DocumentContext().Document,
+		destination:      string(n.Destination),
+		title:            string(n.Title),
+		text:             hstring.RenderedString(text),
+		plainText:        string(n.Text(source)),
+		ordinal:          linkOrdinal(n),
+		heading:          headingInfo(n, source),
+		AttributesHolder: attributes.New(r.filterInternalAttributes(n.Attributes()), attributes.AttributesOwnerGeneral),
+	}
+	lctx.setPositionResolver(lr, ctx.DocumentContext(), lctx)
+
 	err := lr.RenderLink(
 		ctx.RenderContext().Ctx,
 		w,
-		linkContext{
-			page:             ctx.DocumentContext().Document,
-			destination:      string(n.Destination),
-			title:            string(n.Title),
-			text:             hstring.RenderedString(text),
-			plainText:        string(n.Text(source)),
-			AttributesHolder: attributes.Empty,
-		},
+		lctx,
 	)
 
 	// TODO(bep) I have a working branch that fixes these rather confusing identity types,
@@ -307,6 +353,10 @@ func (r *hookedRenderer) renderLinkDefault(w util.BufWriter, source []byte, node
 			r.Writer.Write(w, n.Title)
 			_ = w.WriteByte('"')
 		}
+		if n.Attributes() != nil {
+			attrs := r.filterInternalAttributes(n.Attributes())
+			attributes.RenderASTAttributes(w, attrs...)
+		}
 		_ = w.WriteByte('>')
 	} else {
 		_, _ = w.WriteString("</a>")
@@ -341,16 +391,21 @@ func (r *hookedRenderer) renderAutoLink(w util.BufWriter, source []byte, node as
 		url = "mailto:" + url
 	}
 
+	lctx := &linkContext{
+		page:             ctx.DocumentContext().Document,
+		destination:      url,
+		text:             hstring.RenderedString(label),
+		plainText:        label,
+		ordinal:          linkOrdinal(n),
+		heading:          headingInfo(n, source),
+		AttributesHolder: attributes.Empty,
+	}
+	lctx.setPositionResolver(lr, ctx.DocumentContext(), lctx)
+
 	err := lr.RenderLink(
 		ctx.RenderContext().Ctx,
 		w,
-		linkContext{
-			page:             ctx.DocumentContext().Document,
-			destination:      url,
-			text:             hstring.RenderedString(label),
-			plainText:        label,
-			AttributesHolder: attributes.Empty,
-		},
+		lctx,
 	)
 
 	// TODO(bep) I have a working branch that fixes these rather confusing identity types,
@@ -471,11 +526,85 @@ type links struct {
 
 // Extend implements goldmark.Extender.
 func (e *links) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithASTTransformers(
+		util.Prioritized(&linksTransformer{}, 300),
+	))
 	m.Renderer().AddOptions(renderer.WithNodeRenderers(
 		util.Prioritized(newLinkRenderer(e.cfg), 100),
 	))
 }
 
+const (
+	// Used to pass the link ordinal and the closest heading before a link or
+	// an image to the render hooks.
+	attrLinkOrdinal = internalAttrPrefix + "linkOrdinal"
+	attrHeading     = internalAttrPrefix + "heading"
+)
+
+// linksTransformer numbers the links in the document and records the
+// closest heading before each link and image.
+type linksTransformer struct{}
+
+// Transform transforms the provided Markdown AST.
+func (t *linksTransformer) Transform(doc *ast.Document, reader text.Reader, pctx parser.Context) {
+	var (
+		ordinal int
+		heading *ast.Heading
+	)
+	ast.Walk(doc, func(node ast.Node, enter bool) (ast.WalkStatus, error) {
+		if !enter {
+			return ast.WalkContinue, nil
+		}
+
+		switch n := node.(type) {
+		case *ast.Heading:
+			heading = n
+		case *ast.Link, *ast.AutoLink:
+			n.SetAttributeString(attrLinkOrdinal, ordinal)
+			ordinal++
+			if heading != nil {
+				n.SetAttributeString(attrHeading, heading)
+			}
+		case *ast.Image:
+			if heading != nil {
+				n.SetAttributeString(attrHeading, heading)
+			}
+		}
+
+		return ast.WalkContinue, nil
+	})
+}
+
+func linkOrdinal(n ast.Node) int {
+	if v, ok := n.AttributeString(attrLinkOrdinal); ok {
+		return v.(int)
+	}
+	return 0
+}
+
+// headingInfo returns the closest heading before n, nil if none.
+func headingInfo(n ast.Node, source []byte) *hooks.HeadingInfo {
+	v, ok := n.AttributeString(attrHeading)
+	if !ok {
+		return nil
+	}
+	h := v.(*ast.Heading)
+	var anchor string
+	if id, ok := h.AttributeString("id"); ok {
+		switch id := id.(type) {
+		case []byte:
+			anchor = string(id)
+		case string:
+			anchor = id
+		}
+	}
+	return &hooks.HeadingInfo{
+		Level:     h.Level,
+		Anchor:    anchor,
+		PlainText: string(h.Text(source)),
+	}
+}
+
 // Borrowed from Goldmark.
 func nodeToHTMLText(n ast.Node, source []byte) []byte {
 	var buf bytes.Buffer
@@ -0,0 +1,162 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tables_test
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+const tablesFiles = `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "home", "rss", "sitemap"]
+[markup.goldmark.parser.attribute]
+block = true
+-- layouts/_default/single.html --
+{{ .Content }}
+-- layouts/_default/list.html --
+{{ .Content }}
+-- content/p1.md --
+---
+title: "p1"
+---
+
+| Name  | Price | Stock |
+|:------|------:|:-----:|
+| Apple | 1.50  | 1,200 |
+| *Pear*| 2     |       |
+{caption="Fruit prices" class="fruit"}
+
+| A | B |
+|---|---|
+| x | y |
+-- content/blog/_index.md --
+---
+title: "Blog"
+cascade:
+  tableOptions:
+    sortable: true
+    figure: true
+---
+-- content/blog/b1.md --
+---
+title: "b1"
+---
+
+| Name  | Price |
+|:------|------:|
+| Apple | 1.50  |
+{caption="Fruit prices" class="fruit"}
+`
+
+func TestTablesDefault(t *testing.T) {
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: tablesFiles,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		"<table class=\"fruit\">\n<thead>\n<tr>\n<th style=\"text-align:left\">Name</th>\n<th style=\"text-align:right\">Price</th>",
+		"<td style=\"text-align:left\"><em>Pear</em></td>",
+		"<table>\n<thead>\n<tr>\n<th>A</th>",
+	)
+}
+
+func TestTablesHook(t *testing.T) {
+	files := tablesFiles + `
+-- layouts/_default/_markup/render-table.html --
+<div class="table-{{ .Ordinal }} {{ .Attributes.class }}">
+{{- range .Columns }}
+Column: {{ .Index }}|{{ .Title }}|{{ .Alignment }}|{{ .Type }}|
+{{- end }}
+{{- range .THead }}
+Head:{{ range . }} {{ .Text | safeHTML }}|{{ .Alignment }}|{{ end }}
+{{- end }}
+{{- range .TBody }}
+Row:{{ range . }} {{ .Text | safeHTML }}|{{ .PlainText }}|{{ end }}
+{{- end }}
+</div>
+-- layouts/blog/_markup/render-table.html --
+Blog table: {{ len .TBody }} rows
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		`<div class="table-0 fruit">`,
+		"Column: 0|Name|left|text|\nColumn: 1|Price|right|number|\nColumn: 2|Stock|center|number|",
+		"Head: Name|left| Price|right| Stock|center|",
+		"Row: Apple|Apple| 1.50|1.50| 1,200|1,200|",
+		"Row: <em>Pear</em>|Pear| 2|2| ||",
+		`<div class="table-1 ">`,
+		"Column: 0|A||text|",
+	)
+
+	b.AssertFileContent("public/blog/b1/index.html", "Blog table: 1 rows")
+
+	content := b.FileContent("public/p1/index.html")
+	b.Assert(content, qt.Not(qt.Contains), "<table")
+}
+
+func TestTablesOptions(t *testing.T) {
+	files := tablesFiles + `
+-- config/_default/markup.toml --
+[goldmark.extensions.tableOptions]
+wrapperClass = "table-wrapper"
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		"<div class=\"table-wrapper\">\n<table class=\"fruit\">\n<caption>Fruit prices</caption>\n<thead>\n<tr>\n<th style=\"text-align:left\">Name</th>",
+		"<td style=\"text-align:left\"><em>Pear</em></td>",
+		"</tbody>\n</table>\n</div>",
+	)
+
+	b.AssertFileContent("public/blog/b1/index.html",
+		"<figure>\n<div class=\"table-wrapper\">\n<table class=\"fruit\" data-sortable>\n<thead>\n<tr>\n<th style=\"text-align:left\" data-sort-type=\"text\">Name</th>\n<th style=\"text-align:right\" data-sort-type=\"number\">Price</th>",
+		"</table>\n</div>\n<figcaption>Fruit prices</figcaption>\n</figure>",
+	)
+}
+
+func TestTablesHookError(t *testing.T) {
+	files := tablesFiles + `
+-- layouts/_default/_markup/render-table.html --
+{{ if eq .Ordinal 1 }}{{ .Foo }}{{ end }}
+`
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `p1.md:11:`)
+}
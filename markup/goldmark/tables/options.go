@@ -0,0 +1,119 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tables
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/gohugoio/hugo/identity"
+	"github.com/gohugoio/hugo/markup/converter/hooks"
+	"github.com/gohugoio/hugo/markup/goldmark/goldmark_config"
+)
+
+const captionAttribute = "caption"
+
+// NewRenderer returns a table renderer for the built-in table options,
+// used when there's no table render hook.
+func NewRenderer(opts goldmark_config.TableOptions) hooks.TableRenderer {
+	return optionsRenderer{opts: opts}
+}
+
+type optionsRenderer struct {
+	opts goldmark_config.TableOptions
+}
+
+func (r optionsRenderer) GetIdentity() identity.Identity {
+	return identity.KeyValueIdentity{Key: "tables", Value: fmt.Sprintf("%+v", r.opts)}
+}
+
+func (r optionsRenderer) RenderTable(cctx context.Context, w io.Writer, ctx hooks.TableContext) error {
+	var (
+		sb      strings.Builder
+		attrs   = ctx.Attributes()
+		caption = ""
+	)
+	if v, ok := attrs[captionAttribute]; ok {
+		caption = html.EscapeString(fmt.Sprint(v))
+	}
+
+	if r.opts.Figure && caption != "" {
+		sb.WriteString("<figure>\n")
+	}
+	if r.opts.WrapperClass != "" {
+		sb.WriteString(`<div class="` + html.EscapeString(r.opts.WrapperClass) + "\">\n")
+	}
+
+	sb.WriteString("<table")
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		if k != captionAttribute {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(&sb, ` %s="%s"`, k, html.EscapeString(fmt.Sprint(attrs[k])))
+	}
+	if r.opts.Sortable {
+		sb.WriteString(" data-sortable")
+	}
+	sb.WriteString(">\n")
+
+	if !r.opts.Figure && caption != "" {
+		sb.WriteString("<caption>" + caption + "</caption>\n")
+	}
+
+	columns := ctx.Columns()
+	writeRows := func(tag string, rows []hooks.TableRow) {
+		for _, row := range rows {
+			sb.WriteString("<tr>\n")
+			for i, cell := range row {
+				sb.WriteString("<" + tag)
+				if cell.Alignment != "" {
+					sb.WriteString(` style="text-align:` + cell.Alignment + `"`)
+				}
+				if tag == "th" && r.opts.Sortable && i < len(columns) {
+					sb.WriteString(` data-sort-type="` + columns[i].Type + `"`)
+				}
+				sb.WriteString(">" + string(cell.Text) + "</" + tag + ">\n")
+			}
+			sb.WriteString("</tr>\n")
+		}
+	}
+
+	sb.WriteString("<thead>\n")
+	writeRows("th", ctx.THead())
+	sb.WriteString("</thead>\n")
+	if tbody := ctx.TBody(); len(tbody) > 0 {
+		sb.WriteString("<tbody>\n")
+		writeRows("td", tbody)
+		sb.WriteString("</tbody>\n")
+	}
+	sb.WriteString("</table>\n")
+
+	if r.opts.WrapperClass != "" {
+		sb.WriteString("</div>\n")
+	}
+	if r.opts.Figure && caption != "" {
+		sb.WriteString("<figcaption>" + caption + "</figcaption>\n</figure>\n")
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
@@ -0,0 +1,319 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tables
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gohugoio/hugo/common/herrors"
+	htext "github.com/gohugoio/hugo/common/text"
+	"github.com/gohugoio/hugo/common/types/hstring"
+	"github.com/gohugoio/hugo/markup/converter/hooks"
+	"github.com/gohugoio/hugo/markup/goldmark/internal/render"
+	"github.com/gohugoio/hugo/markup/internal/attributes"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/util"
+)
+
+type htmlRenderer struct {
+	// Goldmark's table renderer, used when there's no render hook.
+	defaults      renderer.NodeRenderer
+	defaultsFuncs map[ast.NodeKind]renderer.NodeRendererFunc
+
+	// The rows collected for the table render hook, keyed by *render.Context.
+	tables sync.Map
+}
+
+func newHTMLRenderer() renderer.NodeRenderer {
+	r := &htmlRenderer{
+		defaults:      extension.NewTableHTMLRenderer(),
+		defaultsFuncs: make(map[ast.NodeKind]renderer.NodeRendererFunc),
+	}
+	r.defaults.RegisterFuncs(r)
+	return r
+}
+
+// Register implements renderer.NodeRendererFuncRegisterer, used to
+// capture the render funcs of Goldmark's table renderer.
+func (r *htmlRenderer) Register(kind ast.NodeKind, f renderer.NodeRendererFunc) {
+	r.defaultsFuncs[kind] = f
+}
+
+// SetOption passes the renderer options, e.g. XHTML, on to Goldmark's table renderer.
+func (r *htmlRenderer) SetOption(name renderer.OptionName, value any) {
+	if so, ok := r.defaults.(renderer.SetOptioner); ok {
+		so.SetOption(name, value)
+	}
+}
+
+func (r *htmlRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(KindTable, r.renderTable)
+	reg.Register(east.KindTableHeader, r.renderTableHeader)
+	reg.Register(east.KindTableRow, r.renderTableRow)
+	reg.Register(east.KindTableCell, r.renderTableCell)
+}
+
+func (r *htmlRenderer) renderTable(w util.BufWriter, src []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	n := node.(*table)
+
+	var (
+		tr hooks.TableRenderer
+		h  any
+	)
+	ctx, ok := w.(*render.Context)
+	if ok {
+		h = ctx.RenderContext().GetRenderer(hooks.TableRendererType, nil)
+		if h != nil {
+			tr = h.(hooks.TableRenderer)
+		}
+	}
+
+	if tr == nil {
+		renderTableDefault(w, n, entering)
+		return ast.WalkContinue, nil
+	}
+
+	if entering {
+		r.tables.Store(ctx, &tableState{})
+		ctx.PushPos(ctx.Buffer.Len())
+		return ast.WalkContinue, nil
+	}
+
+	pos := ctx.PopPos()
+	ctx.Buffer.Truncate(pos)
+
+	state := &tableState{}
+	if v, ok := r.tables.LoadAndDelete(ctx); ok {
+		state = v.(*tableState)
+	}
+
+	tctx := &tableContext{
+		page:             ctx.DocumentContext().Document,
+		ordinal:          n.ordinal,
+		thead:            state.thead,
+		tbody:            state.tbody,
+		columns:          newColumns(n.alignments, state.thead, state.tbody),
+		AttributesHolder: attributes.New(n.Attributes(), attributes.AttributesOwnerGeneral),
+	}
+
+	tctx.createPos = func() htext.Position {
+		if resolver, ok := h.(hooks.ElementPositionResolver); ok {
+			return resolver.ResolvePosition(tctx)
+		}
+		return htext.Position{
+			Filename:     ctx.DocumentContext().Filename,
+			LineNumber:   1,
+			ColumnNumber: 1,
+		}
+	}
+
+	err := tr.RenderTable(
+		ctx.RenderContext().Ctx,
+		w,
+		tctx,
+	)
+
+	ctx.AddIdentity(tr)
+
+	if err != nil {
+		return ast.WalkContinue, herrors.NewFileErrorFromPos(err, tctx.Position())
+	}
+
+	return ast.WalkContinue, nil
+}
+
+// renderTableDefault renders the table element the same way as Goldmark.
+func renderTableDefault(w util.BufWriter, n *table, entering bool) {
+	if entering {
+		_, _ = w.WriteString("<table")
+		if n.Attributes() != nil {
+			html.RenderAttributes(w, n, extension.TableAttributeFilter)
+		}
+		_, _ = w.WriteString(">\n")
+	} else {
+		_, _ = w.WriteString("</table>\n")
+	}
+}
+
+// state returns the table state if the table is rendered with a hook.
+func (r *htmlRenderer) state(w util.BufWriter) (*tableState, *render.Context) {
+	ctx, ok := w.(*render.Context)
+	if !ok {
+		return nil, nil
+	}
+	v, ok := r.tables.Load(ctx)
+	if !ok {
+		return nil, nil
+	}
+	return v.(*tableState), ctx
+}
+
+func (r *htmlRenderer) renderTableHeader(w util.BufWriter, src []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	state, _ := r.state(w)
+	if state == nil {
+		return r.defaultsFuncs[east.KindTableHeader](w, src, node, entering)
+	}
+	if entering {
+		state.thead = append(state.thead, hooks.TableRow{})
+		state.row = &state.thead[len(state.thead)-1]
+	}
+	return ast.WalkContinue, nil
+}
+
+func (r *htmlRenderer) renderTableRow(w util.BufWriter, src []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	state, _ := r.state(w)
+	if state == nil {
+		return r.defaultsFuncs[east.KindTableRow](w, src, node, entering)
+	}
+	if entering {
+		state.tbody = append(state.tbody, hooks.TableRow{})
+		state.row = &state.tbody[len(state.tbody)-1]
+	}
+	return ast.WalkContinue, nil
+}
+
+func (r *htmlRenderer) renderTableCell(w util.BufWriter, src []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	state, ctx := r.state(w)
+	if state == nil {
+		return r.defaultsFuncs[east.KindTableCell](w, src, node, entering)
+	}
+
+	if entering {
+		// Store the current pos so we can capture the rendered text.
+		ctx.PushPos(ctx.Buffer.Len())
+		return ast.WalkContinue, nil
+	}
+
+	n := node.(*east.TableCell)
+	pos := ctx.PopPos()
+	text := string(ctx.Buffer.Bytes()[pos:])
+	ctx.Buffer.Truncate(pos)
+
+	*state.row = append(*state.row, hooks.TableCell{
+		Text:      hstring.RenderedString(text),
+		PlainText: string(n.Text(src)),
+		Alignment: alignment(n.Alignment),
+	})
+
+	return ast.WalkContinue, nil
+}
+
+type tableState struct {
+	thead []hooks.TableRow
+	tbody []hooks.TableRow
+	// The row being rendered.
+	row *hooks.TableRow
+}
+
+func alignment(a east.Alignment) string {
+	switch a {
+	case east.AlignLeft:
+		return hooks.TableAlignLeft
+	case east.AlignCenter:
+		return hooks.TableAlignCenter
+	case east.AlignRight:
+		return hooks.TableAlignRight
+	}
+	return ""
+}
+
+func newColumns(alignments []east.Alignment, thead, tbody []hooks.TableRow) []hooks.TableColumn {
+	columns := make([]hooks.TableColumn, len(alignments))
+	for i, a := range alignments {
+		columns[i] = hooks.TableColumn{
+			Index:     i,
+			Alignment: alignment(a),
+			Type:      columnType(i, tbody),
+		}
+		for _, row := range thead {
+			if i < len(row) {
+				columns[i].Title = row[i].PlainText
+			}
+		}
+	}
+	return columns
+}
+
+// columnType returns "number" if all the non-empty cells in column i are numbers,
+// ignoring thousands separators and a trailing percent sign, else "text".
+func columnType(i int, tbody []hooks.TableRow) string {
+	var found bool
+	for _, row := range tbody {
+		if i >= len(row) {
+			continue
+		}
+		s := strings.TrimSpace(row[i].PlainText)
+		if s == "" {
+			continue
+		}
+		s = strings.TrimSuffix(strings.ReplaceAll(s, ",", ""), "%")
+		if _, err := strconv.ParseFloat(s, 64); err != nil {
+			return hooks.TableColumnText
+		}
+		found = true
+	}
+	if !found {
+		return hooks.TableColumnText
+	}
+	return hooks.TableColumnNumber
+}
+
+type tableContext struct {
+	page    any
+	ordinal int
+	thead   []hooks.TableRow
+	tbody   []hooks.TableRow
+	columns []hooks.TableColumn
+
+	// This is only used in error situations and is expensive to create,
+	// to delay creation until needed.
+	pos       htext.Position
+	posInit   sync.Once
+	createPos func() htext.Position
+
+	*attributes.AttributesHolder
+}
+
+func (c *tableContext) Page() any {
+	return c.page
+}
+
+func (c *tableContext) Ordinal() int {
+	return c.ordinal
+}
+
+func (c *tableContext) THead() []hooks.TableRow {
+	return c.thead
+}
+
+func (c *tableContext) TBody() []hooks.TableRow {
+	return c.tbody
+}
+
+func (c *tableContext) Columns() []hooks.TableColumn {
+	return c.columns
+}
+
+func (c *tableContext) Position() htext.Position {
+	c.posInit.Do(func() {
+		c.pos = c.createPos()
+	})
+	return c.pos
+}
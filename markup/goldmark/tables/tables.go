@@ -0,0 +1,93 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tables renders GitHub Flavored Markdown tables with render hooks,
+// falling back to Goldmark's table renderer.
+package tables
+
+import (
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// KindTable is the kind of a table that may be rendered with a hook.
+var KindTable = ast.NewNodeKind("HugoTable")
+
+type table struct {
+	ast.BaseBlock
+	ordinal    int
+	alignments []east.Alignment
+}
+
+func (*table) Kind() ast.NodeKind { return KindTable }
+
+func (n *table) Dump(src []byte, level int) {
+	ast.DumpHelper(n, src, level, nil, nil)
+}
+
+type tablesExtension struct{}
+
+// New returns a goldmark extension for tables, rendered with render hooks if provided.
+func New() goldmark.Extender {
+	return &tablesExtension{}
+}
+
+func (e *tablesExtension) Extend(m goldmark.Markdown) {
+	extension.Table.Extend(m)
+	m.Parser().AddOptions(
+		parser.WithASTTransformers(
+			// Run after the block attributes are set.
+			util.Prioritized(&transformer{}, 200),
+		),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		// Before Goldmark's table renderer.
+		util.Prioritized(newHTMLRenderer(), 100),
+	))
+}
+
+// transformer replaces tables with nodes that can be rendered by hooks.
+type transformer struct{}
+
+func (t *transformer) Transform(doc *ast.Document, reader text.Reader, pctx parser.Context) {
+	var tables []*east.Table
+	ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if n, ok := node.(*east.Table); ok && entering {
+			tables = append(tables, n)
+			return ast.WalkSkipChildren, nil
+		}
+		return ast.WalkContinue, nil
+	})
+
+	for i, n := range tables {
+		tbl := &table{
+			ordinal:    i,
+			alignments: n.Alignments,
+		}
+		for _, attr := range n.Attributes() {
+			tbl.SetAttribute(attr.Name, attr.Value)
+		}
+		for c := n.FirstChild(); c != nil; {
+			next := c.NextSibling()
+			tbl.AppendChild(tbl, c)
+			c = next
+		}
+		n.Parent().ReplaceChild(n.Parent(), n, tbl)
+	}
+}
@@ -72,6 +72,7 @@ func (t *tocTransformer) Transform(n *ast.Document, reader text.Reader, pc parse
 		case ast.KindHeading:
 			heading := n.(*ast.Heading)
 			level = heading.Level
+			tocHeading.Level = level
 
 			if level == 1 || row == -1 {
 				row++
@@ -45,11 +45,25 @@ var DefaultConfig = Config{
 	NoClasses:          true,
 	LineNumbersInTable: true,
 	TabWidth:           4,
+	DarkMode:           DarkModeMedia,
+	DarkModeClass:      "dark",
 }
 
 type Config struct {
 	Style string
 
+	// The style to use in dark mode. If set, CSS classes are used
+	// instead of inline styles, see WriteCSS.
+	DarkStyle string
+
+	// How to switch to DarkStyle in the CSS, "media" to use the
+	// prefers-color-scheme media query or "class" to use DarkModeClass.
+	DarkMode string
+
+	// The class set on an element, usually html, to switch to DarkStyle
+	// when DarkMode is "class".
+	DarkModeClass string
+
 	CodeFences bool
 
 	// Use inline CSS styles.
@@ -82,6 +96,42 @@ type Config struct {
 	TabWidth int
 
 	GuessSyntax bool
+
+	// Highlighting options per output format, e.g. a high contrast style
+	// for a print output format.
+	OutputFormats map[string]map[string]any
+}
+
+// Validate validates the configuration.
+func (cfg Config) Validate() error {
+	if cfg.DarkMode != DarkModeMedia && cfg.DarkMode != DarkModeClass {
+		return fmt.Errorf("invalid darkMode %q, must be one of media or class", cfg.DarkMode)
+	}
+	for name := range cfg.OutputFormats {
+		if _, err := cfg.ForOutputFormat(name); err != nil {
+			return fmt.Errorf("outputFormats.%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// ForOutputFormat returns the configuration with the options set for the
+// output format with the given name applied.
+func (cfg Config) ForOutputFormat(name string) (Config, error) {
+	opts, found := cfg.OutputFormats[strings.ToLower(name)]
+	if !found {
+		return cfg, nil
+	}
+	m := make(map[string]any, len(opts))
+	for k, v := range opts {
+		m[k] = v
+	}
+	// Options for other output formats don't apply.
+	cfg.OutputFormats = nil
+	if err := applyOptionsFromMap(m, &cfg); err != nil {
+		return cfg, err
+	}
+	return cfg, cfg.Validate()
 }
 
 func (cfg Config) toHTMLOptions() []html.Option {
@@ -94,7 +144,7 @@ func (cfg Config) toHTMLOptions() []html.Option {
 		html.WithLineNumbers(cfg.LineNos),
 		html.BaseLineNumber(cfg.LineNoStart),
 		html.LineNumbersInTable(cfg.LineNumbersInTable),
-		html.WithClasses(!cfg.NoClasses),
+		html.WithClasses(!cfg.NoClasses || cfg.DarkStyle != ""),
 		html.WithLinkableLineNumbers(cfg.AnchorLineNos, lineAnchors),
 		html.InlineCode(cfg.Hl_inline),
 	}
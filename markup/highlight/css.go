@@ -0,0 +1,236 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package highlight
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2"
+	"github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/alecthomas/chroma/v2/styles"
+)
+
+const (
+	// DarkModeMedia applies the dark style with the prefers-color-scheme media query.
+	DarkModeMedia = "media"
+	// DarkModeClass applies the dark style below an element with the dark mode class.
+	DarkModeClass = "class"
+)
+
+// WriteCSS writes the CSS for the style(s) in cfg to w.
+// See WriteStylesCSS.
+func (cfg Config) WriteCSS(w io.Writer) error {
+	var dark *chroma.Style
+	if cfg.DarkStyle != "" {
+		dark = getStyle(cfg.DarkStyle)
+	}
+	return WriteStylesCSS(w, getStyle(cfg.Style), dark, cfg.DarkMode, cfg.DarkModeClass)
+}
+
+func getStyle(name string) *chroma.Style {
+	style := styles.Get(name)
+	if style == nil {
+		style = styles.Fallback
+	}
+	return style
+}
+
+// WriteStylesCSS writes the CSS for the Chroma classes in the given style to w.
+// If dark is set, the properties that differ between the two styles are set
+// in CSS custom properties, e.g. --chroma-k-color, on the root element,
+// and overridden with the values from dark with the prefers-color-scheme
+// media query or below an element with darkModeClass, depending on darkMode.
+func WriteStylesCSS(w io.Writer, light, dark *chroma.Style, darkMode, darkModeClass string) error {
+	formatter := html.New(html.WithClasses(true), html.WithAllClasses(true))
+	if dark == nil {
+		return formatter.WriteCSS(w, light)
+	}
+
+	var lightCSS, darkCSS bytes.Buffer
+	if err := formatter.WriteCSS(&lightCSS, light); err != nil {
+		return err
+	}
+	if err := formatter.WriteCSS(&darkCSS, dark); err != nil {
+		return err
+	}
+
+	lightRules, err := parseCSSRules(&lightCSS)
+	if err != nil {
+		return err
+	}
+	darkRules, err := parseCSSRules(&darkCSS)
+	if err != nil {
+		return err
+	}
+
+	darkBySelector := make(map[string]cssRule)
+	for _, r := range darkRules {
+		darkBySelector[r.selector] = r
+	}
+	for _, r := range darkRules {
+		if !containsSelector(lightRules, r.selector) {
+			lightRules = append(lightRules, cssRule{comment: r.comment, selector: r.selector})
+		}
+	}
+
+	var (
+		rules      bytes.Buffer
+		lightVars  bytes.Buffer
+		darkVars   bytes.Buffer
+		newVarName = varNameFunc(make(map[string]bool))
+	)
+
+	for _, lr := range lightRules {
+		dr := darkBySelector[lr.selector]
+		props := lr.propertyNames()
+		for _, p := range dr.propertyNames() {
+			if !containsString(props, p) {
+				props = append(props, p)
+			}
+		}
+
+		var declarations []string
+		for _, p := range props {
+			lv, lok := lr.get(p)
+			dv, dok := dr.get(p)
+			if lok && dok && lv == dv {
+				declarations = append(declarations, p+": "+lv)
+				continue
+			}
+			// The property is missing in one of the styles.
+			if !lok {
+				lv = "unset"
+			}
+			if !dok {
+				dv = "unset"
+			}
+			name := newVarName(lr.selector, p)
+			declarations = append(declarations, fmt.Sprintf("%s: var(%s)", p, name))
+			fmt.Fprintf(&lightVars, "  %s: %s;\n", name, lv)
+			fmt.Fprintf(&darkVars, "  %s: %s;\n", name, dv)
+		}
+
+		if lr.comment != "" {
+			fmt.Fprintf(&rules, "/* %s */ ", lr.comment)
+		}
+		fmt.Fprintf(&rules, "%s { %s }\n", lr.selector, strings.Join(declarations, "; "))
+	}
+
+	fmt.Fprintf(w, ":root {\n%s}\n", lightVars.String())
+	if darkMode == DarkModeClass {
+		if darkModeClass == "" {
+			darkModeClass = DefaultConfig.DarkModeClass
+		}
+		fmt.Fprintf(w, ".%s {\n%s}\n", darkModeClass, darkVars.String())
+	} else {
+		indented := strings.ReplaceAll(darkVars.String(), "\n  ", "\n    ")
+		fmt.Fprintf(w, "@media (prefers-color-scheme: dark) {\n  :root {\n  %s  }\n}\n", indented)
+	}
+	_, err = w.Write(rules.Bytes())
+
+	return err
+}
+
+type cssRule struct {
+	comment      string
+	selector     string
+	declarations [][2]string
+}
+
+func (r cssRule) propertyNames() []string {
+	names := make([]string, len(r.declarations))
+	for i, d := range r.declarations {
+		names[i] = d[0]
+	}
+	return names
+}
+
+func (r cssRule) get(property string) (string, bool) {
+	for _, d := range r.declarations {
+		if d[0] == property {
+			return d[1], true
+		}
+	}
+	return "", false
+}
+
+// Matches the one line rules written by Chroma, e.g.
+// /* Keyword */ .chroma .k { color: #000000; font-weight: bold }
+var cssRuleRe = regexp.MustCompile(`^(?:/\* (.*?) \*/ )?(.+?) \{(.*)\}$`)
+
+func parseCSSRules(r io.Reader) ([]cssRule, error) {
+	var rules []cssRule
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		m := cssRuleRe.FindStringSubmatch(line)
+		if m == nil {
+			return nil, fmt.Errorf("failed to parse Chroma CSS rule %q", line)
+		}
+		rule := cssRule{comment: m[1], selector: m[2]}
+		for _, d := range strings.Split(m[3], ";") {
+			prop, value, found := strings.Cut(d, ":")
+			if !found {
+				continue
+			}
+			rule.declarations = append(rule.declarations, [2]string{strings.TrimSpace(prop), strings.TrimSpace(value)})
+		}
+		rules = append(rules, rule)
+	}
+	return rules, scanner.Err()
+}
+
+var nonIdentRe = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// varNameFunc returns a func creating unique custom property names from a
+// selector and a property, e.g. --chroma-k-color for .chroma .k and color.
+func varNameFunc(used map[string]bool) func(selector, property string) string {
+	return func(selector, property string) string {
+		fields := strings.Fields(selector)
+		class := nonIdentRe.ReplaceAllString(fields[len(fields)-1], "-")
+		base := "--chroma-" + strings.Trim(class, "-") + "-" + property
+		name := base
+		for i := 2; used[name]; i++ {
+			name = fmt.Sprintf("%s-%d", base, i)
+		}
+		used[name] = true
+		return name
+	}
+}
+
+func containsSelector(rules []cssRule, selector string) bool {
+	for _, r := range rules {
+		if r.selector == selector {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(s []string, v string) bool {
+	for _, ss := range s {
+		if ss == v {
+			return true
+		}
+	}
+	return false
+}
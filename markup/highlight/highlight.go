@@ -14,6 +14,7 @@
 package highlight
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	gohtml "html"
@@ -36,6 +37,8 @@ import (
 // Markdown attributes used by the Chroma highlighter.
 var chromaHighlightProcessingAttributes = map[string]bool{
 	"anchorLineNos":      true,
+	"annotations":        true,
+	"diff":               true,
 	"guessSyntax":        true,
 	"hl_Lines":           true,
 	"lineAnchors":        true,
@@ -77,7 +80,7 @@ func (h chromaHighlighter) Highlight(code, lang string, opts any) (string, error
 	}
 	var b strings.Builder
 
-	if _, _, err := highlight(&b, code, lang, nil, cfg); err != nil {
+	if _, _, err := highlight(&b, code, lang, nil, nil, cfg); err != nil {
 		return "", err
 	}
 
@@ -106,7 +109,7 @@ func (h chromaHighlighter) HighlightCodeBlock(ctx hooks.CodeblockContext, opts a
 		return HighlightResult{}, err
 	}
 
-	low, high, err := highlight(&b, ctx.Inner(), ctx.Type(), attributes, cfg)
+	low, high, err := highlight(&b, ctx.Inner(), ctx.Type(), attributes, ctx.Lines(), cfg)
 	if err != nil {
 		return HighlightResult{}, err
 	}
@@ -138,7 +141,7 @@ func (h chromaHighlighter) RenderCodeblock(cctx context.Context, w hugio.FlexiWr
 
 	code := text.Puts(ctx.Inner())
 
-	_, _, err := highlight(w, code, ctx.Type(), attributes, cfg)
+	_, _, err := highlight(w, code, ctx.Type(), attributes, ctx.Lines(), cfg)
 	return err
 }
 
@@ -170,7 +173,7 @@ func (h HighlightResult) Inner() template.HTML {
 	return h.highlighted[h.innerLow:h.innerHigh]
 }
 
-func highlight(fw hugio.FlexiWriter, code, lang string, attributes []attributes.Attribute, cfg Config) (int, int, error) {
+func highlight(fw hugio.FlexiWriter, code, lang string, attributes []attributes.Attribute, lines []hooks.CodeblockLine, cfg Config) (int, int, error) {
 	var lexer chroma.Lexer
 	if lang != "" {
 		lexer = chromalexers.Get(lang)
@@ -213,6 +216,17 @@ func highlight(fw hugio.FlexiWriter, code, lang string, attributes []attributes.
 		writeDivStart(w, attributes)
 	}
 
+	// Chroma writes each line in a span, which we may need to
+	// amend with diff classes and notes.
+	var (
+		lineAttrs = newLineAttributes(lines)
+		buf       bytes.Buffer
+		fww       = w
+	)
+	if lineAttrs != nil && !cfg.Hl_inline {
+		fww = &byteCountFlexiWriter{delegate: &buf, counter: w.counter}
+	}
+
 	options := cfg.toHTMLOptions()
 	var wrapper html.PreWrapper
 
@@ -234,17 +248,27 @@ func highlight(fw hugio.FlexiWriter, code, lang string, attributes []attributes.
 		}
 
 	} else {
-		wrapper = getPreWrapper(lang, w)
+		wrapper = getPreWrapper(lang, fww)
 	}
 
 	options = append(options, html.WithPreWrapper(wrapper))
 
 	formatter := html.New(options...)
 
-	if err := formatter.Format(w, style, iterator); err != nil {
+	if err := formatter.Format(fww, style, iterator); err != nil {
 		return 0, 0, err
 	}
 
+	if fww != w {
+		b, delta := lineAttrs.apply(buf.Bytes())
+		if p, ok := wrapper.(*preWrapper); ok {
+			p.high += delta
+		}
+		if _, err := w.Write(b); err != nil {
+			return 0, 0, err
+		}
+	}
+
 	if !cfg.Hl_inline {
 		writeDivEnd(w)
 	}
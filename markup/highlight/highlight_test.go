@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/markup/converter/hooks"
 )
 
 func TestHighlight(t *testing.T) {
@@ -146,3 +147,26 @@ User-Agent: foo
 		c.Assert(result, qt.Contains, "}")
 	})
 }
+
+func TestLineAttributes(t *testing.T) {
+	c := qt.New(t)
+
+	lines := []hooks.CodeblockLine{
+		{Number: 1, Diff: hooks.CodeblockLineRemoved},
+		{Number: 2, Diff: hooks.CodeblockLineAdded, Notes: []string{"a <b>", "c"}},
+		{Number: 3},
+	}
+
+	c.Assert(newLineAttributes(lines[2:]), qt.IsNil)
+
+	attrs := newLineAttributes(lines)
+
+	b, delta := attrs.apply([]byte(`<span class="line"><span class="cl">a</span></span><span class="line hl"><span class="cl">b</span></span><span class="line"><span class="cl">c</span></span>`))
+	c.Assert(string(b), qt.Equals, `<span class="line diff-removed"><span class="cl">a</span></span><span class="line hl diff-added has-note" title="a &lt;b&gt;
+c"><span class="cl">b</span></span><span class="line"><span class="cl">c</span></span>`)
+	c.Assert(delta, qt.Equals, 55)
+
+	b, _ = attrs.apply([]byte(`<span style="display:flex;"><span>a</span></span><span style="display:flex; background-color:#fff"><span>b</span></span>`))
+	c.Assert(string(b), qt.Equals, `<span style="display:flex;" class="diff-removed"><span>a</span></span><span style="display:flex; background-color:#fff" class="diff-added has-note" title="a &lt;b&gt;
+c"><span>b</span></span>`)
+}
@@ -14,8 +14,10 @@
 package highlight_test
 
 import (
+	"strings"
 	"testing"
 
+	qt "github.com/frankban/quicktest"
 	"github.com/gohugoio/hugo/hugolib"
 )
 
@@ -83,3 +85,83 @@ HighlightCodeBlock: Wrapped:{{ $result.Wrapped  }}|Inner:{{ $result.Inner }}
 		"<code class=\"code-inline language-foo\">(message &#34;highlight me 3&#34;)\n</code>",
 	)
 }
+
+func TestHighlightDarkStyleAndOutputFormats(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+disableKinds = ["taxonomy", "term", "section", "rss", "sitemap"]
+[outputFormats.print]
+mediaType = "text/html"
+baseName = "print"
+isHTML = true
+[outputs]
+page = ["html", "print"]
+home = ["html"]
+[markup.highlight]
+style = "github"
+darkStyle = "monokai"
+darkMode = "class"
+[markup.highlight.outputFormats.print]
+style = "bw"
+darkStyle = ""
+noClasses = true
+-- layouts/_default/single.html --
+{{ .Content }}
+-- layouts/_default/single.print.html --
+Print: {{ .Content }}
+-- layouts/index.html --
+{{ transform.HighlightCSS }}
+Media: {{ transform.HighlightCSS (dict "darkMode" "media") }}
+-- content/p1.md --
+---
+title: "p1"
+---
+
+§§§go
+func main() {}
+§§§
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.ReplaceAll(files, "§§§", "```"),
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html", `<span class="kd">func</span>`)
+	b.AssertFileContent("public/p1/print.html", `Print:`, `<span style="font-weight:bold">func</span>`)
+	b.AssertFileContent("public/index.html",
+		":root {\n  --chroma-bg-background-color: #ffffff;",
+		".dark {\n  --chroma-bg-background-color: #272822;",
+		"/* Background */ .bg { background-color: var(--chroma-bg-background-color); color: var(--chroma-bg-color) }",
+		"/* LineTable */ .chroma .lntable { border-spacing: 0; padding: 0; margin: 0; border: 0 }",
+		"/* Keyword */ .chroma .k { color: var(--chroma-k-color); font-weight: var(--chroma-k-font-weight) }",
+		"--chroma-k-font-weight: bold;",
+		"--chroma-k-font-weight: unset;",
+		"Media: :root {",
+		"@media (prefers-color-scheme: dark) {\n  :root {\n    --chroma-bg-background-color: #272822;",
+	)
+}
+
+func TestHighlightInvalidDarkMode(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+[markup.highlight]
+darkMode = "auto"
+`
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `markup.highlight: invalid darkMode "auto"`)
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package highlight
+
+import (
+	gohtml "html"
+	"regexp"
+	"strings"
+
+	"github.com/gohugoio/hugo/markup/converter/hooks"
+)
+
+// CSS classes added to the line spans in code blocks with the diff or annotations options.
+const (
+	lineClassAdded   = "diff-added"
+	lineClassRemoved = "diff-removed"
+	lineClassNote    = "has-note"
+)
+
+// Matches the start of a line written by Chroma, with and without CSS classes.
+var chromaLineStartRe = regexp.MustCompile(`<span (class="line(?: hl)?"|style="display:flex;[^"]*")>`)
+
+type lineAttribute struct {
+	class string
+	title string
+}
+
+type lineAttributes []lineAttribute
+
+// newLineAttributes returns nil if none of the lines has a diff status or notes.
+func newLineAttributes(lines []hooks.CodeblockLine) lineAttributes {
+	var (
+		attrs lineAttributes
+		found bool
+	)
+	for _, line := range lines {
+		var classes []string
+		switch line.Diff {
+		case hooks.CodeblockLineAdded:
+			classes = append(classes, lineClassAdded)
+		case hooks.CodeblockLineRemoved:
+			classes = append(classes, lineClassRemoved)
+		}
+		if len(line.Notes) > 0 {
+			classes = append(classes, lineClassNote)
+		}
+		if len(classes) > 0 {
+			found = true
+		}
+		attrs = append(attrs, lineAttribute{
+			class: strings.Join(classes, " "),
+			title: strings.Join(line.Notes, "\n"),
+		})
+	}
+	if !found {
+		return nil
+	}
+	return attrs
+}
+
+// apply adds the attributes to the line spans in the HTML written by Chroma,
+// returning the new HTML and the number of bytes added.
+func (a lineAttributes) apply(b []byte) ([]byte, int) {
+	var i int
+	res := chromaLineStartRe.ReplaceAllFunc(b, func(m []byte) []byte {
+		if i >= len(a) {
+			return m
+		}
+		attr := a[i]
+		i++
+		if attr.class == "" {
+			return m
+		}
+		var sb strings.Builder
+		s := string(m)
+		if strings.HasPrefix(s, `<span class="`) {
+			sb.WriteString(strings.TrimSuffix(s, `">`))
+			sb.WriteString(" " + attr.class + `"`)
+		} else {
+			sb.WriteString(strings.TrimSuffix(s, ">"))
+			sb.WriteString(` class="` + attr.class + `"`)
+		}
+		if attr.title != "" {
+			sb.WriteString(` title="` + gohtml.EscapeString(attr.title) + `"`)
+		}
+		sb.WriteString(">")
+		return []byte(sb.String())
+	})
+	return res, len(res) - len(b)
+}
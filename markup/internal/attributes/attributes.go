@@ -28,6 +28,8 @@ import (
 // Markdown attributes used as options by the Chroma highlighter.
 var chromaHighlightProcessingAttributes = map[string]bool{
 	"anchorLineNos":      true,
+	"annotations":        true,
+	"diff":               true,
 	"guessSyntax":        true,
 	"hl_Lines":           true,
 	"hl_inline":          true,
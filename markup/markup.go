@@ -87,9 +87,19 @@ func NewConverterProvider(cfg converter.ProviderConfig) (ConverterProvider, erro
 		return nil, fmt.Errorf(msg, defaultHandler)
 	}
 
+	highlighters := make(map[string]highlight.Highlighter)
+	for name := range mcfg.Highlight.OutputFormats {
+		hcfg, err := mcfg.Highlight.ForOutputFormat(name)
+		if err != nil {
+			return nil, err
+		}
+		highlighters[strings.ToLower(name)] = highlight.New(hcfg)
+	}
+
 	return &converterRegistry{
-		config:     cfg,
-		converters: converters,
+		config:       cfg,
+		converters:   converters,
+		highlighters: highlighters,
 	}, nil
 }
 
@@ -98,6 +108,9 @@ type ConverterProvider interface {
 	// Default() converter.Provider
 	GetMarkupConfig() markup_config.Config
 	GetHighlighter() highlight.Highlighter
+	// GetHighlighterForOutputFormat returns the highlighter with the options
+	// configured for the given output format in markup.highlight.outputFormats.
+	GetHighlighterForOutputFormat(name string) highlight.Highlighter
 }
 
 type converterRegistry struct {
@@ -107,6 +120,10 @@ type converterRegistry struct {
 	// All names are lower case.
 	converters map[string]converter.Provider
 
+	// Maps a lower case output format name to its highlighter,
+	// for the output formats with their own highlight options.
+	highlighters map[string]highlight.Highlighter
+
 	config converter.ProviderConfig
 }
 
@@ -118,6 +135,13 @@ func (r *converterRegistry) GetHighlighter() highlight.Highlighter {
 	return r.config.Highlighter
 }
 
+func (r *converterRegistry) GetHighlighterForOutputFormat(name string) highlight.Highlighter {
+	if h, found := r.highlighters[strings.ToLower(name)]; found {
+		return h
+	}
+	return r.config.Highlighter
+}
+
 func (r *converterRegistry) GetMarkupConfig() markup_config.Config {
 	return r.config.MarkupConfig()
 }
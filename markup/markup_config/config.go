@@ -14,10 +14,14 @@
 package markup_config
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/gohugoio/hugo/common/maps"
 	"github.com/gohugoio/hugo/config"
 	"github.com/gohugoio/hugo/docshelper"
 	"github.com/gohugoio/hugo/markup/asciidocext/asciidocext_config"
+	"github.com/gohugoio/hugo/markup/diagrams"
 	"github.com/gohugoio/hugo/markup/goldmark/goldmark_config"
 	"github.com/gohugoio/hugo/markup/highlight"
 	"github.com/gohugoio/hugo/markup/tableofcontents"
@@ -41,6 +45,9 @@ type Config struct {
 
 	// Configuration for the Asciidoc external markdown engine.
 	AsciidocExt asciidocext_config.Config
+
+	// Configuration for diagrams rendered to SVG at build time.
+	Diagrams diagrams.Config
 }
 
 func Decode(cfg config.Provider) (conf Config, err error) {
@@ -63,6 +70,28 @@ func Decode(cfg config.Provider) (conf Config, err error) {
 		return
 	}
 
+	if err = conf.Highlight.Validate(); err != nil {
+		err = fmt.Errorf("markup.highlight: %w", err)
+		return
+	}
+
+	faq := &conf.Goldmark.Extensions.FAQ
+	faq.Match = strings.ToLower(faq.Match)
+	if faq.Match != goldmark_config.FAQMatchQuestions && faq.Match != goldmark_config.FAQMatchAll {
+		err = fmt.Errorf("markup.goldmark.extensions.faq: invalid match %q, must be one of questions or all", faq.Match)
+		return
+	}
+
+	passthrough := conf.Goldmark.Extensions.Passthrough
+	for _, list := range [][][]string{passthrough.Delimiters.Inline, passthrough.Delimiters.Block} {
+		for _, delimiters := range list {
+			if len(delimiters) != 2 || delimiters[0] == "" || delimiters[1] == "" {
+				err = fmt.Errorf("markup.goldmark.extensions.passthrough: invalid delimiters %q, must be a pair of opening and closing delimiters", delimiters)
+				return
+			}
+		}
+	}
+
 	return
 }
 
@@ -107,6 +136,8 @@ var Default = Config{
 
 	Goldmark:    goldmark_config.Default,
 	AsciidocExt: asciidocext_config.Default,
+
+	Diagrams: diagrams.DefaultConfig,
 }
 
 func init() {
@@ -86,4 +86,37 @@ func TestConfig(t *testing.T) {
 
 	})
 
+	c.Run("Decode FAQ", func(c *qt.C) {
+		c.Parallel()
+		v := config.New()
+
+		v.Set("markup", map[string]any{
+			"goldmark": map[string]any{
+				"extensions": map[string]any{
+					"faq": map[string]any{
+						"enable": true,
+						"match":  "All",
+					},
+				},
+			},
+		})
+
+		conf, err := Decode(v)
+		c.Assert(err, qt.IsNil)
+		c.Assert(conf.Goldmark.Extensions.FAQ.Enable, qt.Equals, true)
+		c.Assert(conf.Goldmark.Extensions.FAQ.Match, qt.Equals, "all")
+
+		v.Set("markup", map[string]any{
+			"goldmark": map[string]any{
+				"extensions": map[string]any{
+					"faq": map[string]any{
+						"match": "terms",
+					},
+				},
+			},
+		})
+
+		_, err = Decode(v)
+		c.Assert(err, qt.ErrorMatches, `.*invalid match "terms".*`)
+	})
 }
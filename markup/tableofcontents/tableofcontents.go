@@ -75,9 +75,15 @@ func (h Headings) FilterBy(fn func(*Heading) bool) Headings {
 
 // Heading holds the data about a heading and its children.
 type Heading struct {
-	ID    string
+	// The heading's ID, e.g. "my-heading", used as the anchor in "#my-heading".
+	ID string
+
+	// The heading's title, rendered as HTML.
 	Title string
 
+	// The heading level, 1 to 6.
+	Level int
+
 	Headings Headings
 }
 
@@ -15,6 +15,8 @@ type BuiltinTypes struct {
 	JSONType           Type
 	WebAppManifestType Type
 	RSSType            Type
+	AtomType           Type
+	JSONFeedType       Type
 	XMLType            Type
 	SVGType            Type
 	TextType           Type
@@ -28,6 +30,7 @@ type BuiltinTypes struct {
 	TIFFType Type
 	BMPType  Type
 	WEBPType Type
+	AVIFType Type
 
 	// Common font types
 	TrueTypeFontType Type
@@ -67,6 +70,8 @@ var (
 		JSONType:           Type{Type: "application/json"},
 		WebAppManifestType: Type{Type: "application/manifest+json"},
 		RSSType:            Type{Type: "application/rss+xml"},
+		AtomType:           Type{Type: "application/atom+xml"},
+		JSONFeedType:       Type{Type: "application/feed+json"},
 		XMLType:            Type{Type: "application/xml"},
 		SVGType:            Type{Type: "image/svg+xml"},
 		TextType:           Type{Type: "text/plain"},
@@ -80,6 +85,7 @@ var (
 		TIFFType: Type{Type: "image/tiff"},
 		BMPType:  Type{Type: "image/bmp"},
 		WEBPType: Type{Type: "image/webp"},
+		AVIFType: Type{Type: "image/avif"},
 
 		// Common font types
 		TrueTypeFontType: Type{Type: "font/ttf"},
@@ -119,6 +125,8 @@ var defaultMediaTypesConfig = map[string]any{
 	"application/json":          map[string]any{"suffixes": []string{"json"}},
 	"application/manifest+json": map[string]any{"suffixes": []string{"webmanifest"}},
 	"application/rss+xml":       map[string]any{"suffixes": []string{"xml", "rss"}},
+	"application/atom+xml":      map[string]any{"suffixes": []string{"xml"}},
+	"application/feed+json":     map[string]any{"suffixes": []string{"json"}},
 	"application/xml":           map[string]any{"suffixes": []string{"xml"}},
 	"image/svg+xml":             map[string]any{"suffixes": []string{"svg"}},
 	"text/plain":                map[string]any{"suffixes": []string{"txt"}},
@@ -132,6 +140,7 @@ var defaultMediaTypesConfig = map[string]any{
 	"image/tiff": map[string]any{"suffixes": []string{"tif", "tiff"}},
 	"image/bmp":  map[string]any{"suffixes": []string{"bmp"}},
 	"image/webp": map[string]any{"suffixes": []string{"webp"}},
+	"image/avif": map[string]any{"suffixes": []string{"avif"}},
 
 	// Common font types
 	"font/ttf": map[string]any{"suffixes": []string{"ttf"}},
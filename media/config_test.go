@@ -129,6 +129,8 @@ func TestDefaultTypes(t *testing.T) {
 		{Builtin.JSXType, "text", "jsx", "jsx", "text/jsx", "text/jsx"},
 		{Builtin.JSONType, "application", "json", "json", "application/json", "application/json"},
 		{Builtin.RSSType, "application", "rss", "xml", "application/rss+xml", "application/rss+xml"},
+		{Builtin.AtomType, "application", "atom", "xml", "application/atom+xml", "application/atom+xml"},
+		{Builtin.JSONFeedType, "application", "feed", "json", "application/feed+json", "application/feed+json"},
 		{Builtin.SVGType, "image", "svg", "svg", "image/svg+xml", "image/svg+xml"},
 		{Builtin.TextType, "text", "plain", "txt", "text/plain", "text/plain"},
 		{Builtin.XMLType, "application", "xml", "xml", "application/xml", "application/xml"},
@@ -146,5 +148,13 @@ func TestDefaultTypes(t *testing.T) {
 
 	}
 
-	c.Assert(len(DefaultTypes), qt.Equals, 36)
+	c.Assert(len(DefaultTypes), qt.Equals, 39)
+
+	// The feed types must not shadow the more general types.
+	tp, _, found := DefaultTypes.GetFirstBySuffix("xml")
+	c.Assert(found, qt.IsTrue)
+	c.Assert(tp.Type, qt.Equals, "application/rss+xml")
+	tp, _, found = DefaultTypes.GetBySuffix("json")
+	c.Assert(found, qt.IsTrue)
+	c.Assert(tp.Type, qt.Equals, "application/json")
 }
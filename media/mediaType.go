@@ -318,7 +318,18 @@ func (t Types) IsTextSuffix(suffix string) bool {
 
 }
 
+// The feed media types share their suffixes with, and would shadow, the more
+// general application/xml and application/json types. They're only meant
+// for output formats, so skip them when looking up media types by suffix.
+var suffixLookupExcluded = map[string]bool{
+	Builtin.AtomType.Type:     true,
+	Builtin.JSONFeedType.Type: true,
+}
+
 func (m Type) hasSuffix(suffix string) bool {
+	if suffixLookupExcluded[m.Type] {
+		return false
+	}
 	return strings.Contains(","+m.SuffixesCSV+",", ","+suffix+",")
 }
 
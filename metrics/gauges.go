@@ -0,0 +1,74 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// Gauges holds the last measured value of a set of gauges, e.g. the file cache sizes.
+type Gauges struct {
+	mu     sync.RWMutex
+	values map[string]float64
+}
+
+// NewGauges creates a new Gauges.
+func NewGauges() *Gauges {
+	return &Gauges{values: make(map[string]float64)}
+}
+
+// Set sets the value of the gauge key.
+func (g *Gauges) Set(key string, value float64) {
+	g.mu.Lock()
+	g.values[key] = value
+	g.mu.Unlock()
+}
+
+// Get returns the value of the gauge key and whether it's set.
+func (g *Gauges) Get(key string) (float64, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	v, found := g.values[key]
+	return v, found
+}
+
+// Values returns a copy of all the gauge values.
+func (g *Gauges) Values() map[string]float64 {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	m := make(map[string]float64, len(g.values))
+	for k, v := range g.values {
+		m[k] = v
+	}
+	return m
+}
+
+// WriteGauges writes the gauges to w, one "key value" line per gauge sorted by key.
+func (g *Gauges) WriteGauges(w io.Writer) error {
+	values := g.Values()
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if _, err := fmt.Fprintf(w, "%s %g\n", k, values[k]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -15,6 +15,7 @@
 package metrics
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"math"
@@ -36,12 +37,28 @@ type Provider interface {
 	// Used with defer and time.Now().
 	MeasureSince(key string, start time.Time)
 
+	// MeasureTemplate starts measuring an execution of the template with the
+	// given name, called from the template caller, empty if not called from
+	// another template. The returned func must be called when done.
+	MeasureTemplate(name, caller string) func()
+
+	// MeasurePageSince adds a measurement for rendering a page of the given kind
+	// in the given output format to the metric store.
+	MeasurePageSince(kind, outputFormat string, start time.Time)
+
 	// WriteMetrics will write a summary of the metrics to w.
 	WriteMetrics(w io.Writer)
 
+	// WriteMetricsJSON will write a summary of the metrics to w as JSON.
+	WriteMetricsJSON(w io.Writer) error
+
 	// TrackValue tracks the value for diff calculations etc.
 	TrackValue(key string, value any, cached bool)
 
+	// TrackCacheLookup tracks a partialCached lookup of the template key.
+	// The cache hits are tracked with TrackValue.
+	TrackCacheLookup(key string)
+
 	// Reset clears the metric store.
 	Reset()
 }
@@ -69,21 +86,40 @@ func (d *diff) add(v any) *diff {
 // Store provides storage for a set of metrics.
 type Store struct {
 	calculateHints bool
+	limit          int
 	metrics        map[string][]time.Duration
+	templates      map[string]*templateStats
+	pageMetrics    map[pageKey][]time.Duration
 	mu             sync.Mutex
 	diffs          map[string]*diff
 	diffmu         sync.Mutex
 	cached         map[string]int
+	lookups        map[string]int
 	cachedmu       sync.Mutex
 }
 
+// templateStats holds the callers of a template.
+type templateStats struct {
+	callers map[string]int
+}
+
+type pageKey struct {
+	kind         string
+	outputFormat string
+}
+
 // NewProvider returns a new instance of a metric store.
-func NewProvider(calculateHints bool) Provider {
+// If limit is greater than 0, only the limit most expensive entries are written.
+func NewProvider(calculateHints bool, limit int) Provider {
 	return &Store{
 		calculateHints: calculateHints,
+		limit:          limit,
 		metrics:        make(map[string][]time.Duration),
+		templates:      make(map[string]*templateStats),
+		pageMetrics:    make(map[pageKey][]time.Duration),
 		diffs:          make(map[string]*diff),
 		cached:         make(map[string]int),
+		lookups:        make(map[string]int),
 	}
 }
 
@@ -91,6 +127,8 @@ func NewProvider(calculateHints bool) Provider {
 func (s *Store) Reset() {
 	s.mu.Lock()
 	s.metrics = make(map[string][]time.Duration)
+	s.templates = make(map[string]*templateStats)
+	s.pageMetrics = make(map[pageKey][]time.Duration)
 	s.mu.Unlock()
 
 	s.diffmu.Lock()
@@ -99,11 +137,18 @@ func (s *Store) Reset() {
 
 	s.cachedmu.Lock()
 	s.cached = make(map[string]int)
+	s.lookups = make(map[string]int)
 	s.cachedmu.Unlock()
 }
 
 // TrackValue tracks the value for diff calculations etc.
 func (s *Store) TrackValue(key string, value any, cached bool) {
+	if cached {
+		s.cachedmu.Lock()
+		s.cached[key] = s.cached[key] + 1
+		s.cachedmu.Unlock()
+	}
+
 	if !s.calculateHints {
 		return
 	}
@@ -118,12 +163,14 @@ func (s *Store) TrackValue(key string, value any, cached bool) {
 
 	d.add(value)
 	s.diffmu.Unlock()
+}
 
-	if cached {
-		s.cachedmu.Lock()
-		s.cached[key] = s.cached[key] + 1
-		s.cachedmu.Unlock()
-	}
+// TrackCacheLookup tracks a partialCached lookup of the template key.
+// The cache hits are tracked with TrackValue.
+func (s *Store) TrackCacheLookup(key string) {
+	s.cachedmu.Lock()
+	s.lookups[key] = s.lookups[key] + 1
+	s.cachedmu.Unlock()
 }
 
 // MeasureSince adds a measurement for key to the metric store.
@@ -133,70 +180,232 @@ func (s *Store) MeasureSince(key string, start time.Time) {
 	s.mu.Unlock()
 }
 
+// MeasureTemplate starts measuring an execution of the template with the
+// given name, called from the template caller, empty if not called from
+// another template. The returned func must be called when done.
+func (s *Store) MeasureTemplate(name, caller string) func() {
+	start := time.Now()
+
+	return func() {
+		d := time.Since(start)
+
+		s.mu.Lock()
+		s.metrics[name] = append(s.metrics[name], d)
+		ts, found := s.templates[name]
+		if !found {
+			ts = &templateStats{callers: make(map[string]int)}
+			s.templates[name] = ts
+		}
+		if caller != "" {
+			ts.callers[caller]++
+		}
+		s.mu.Unlock()
+	}
+}
+
+// MeasurePageSince adds a measurement for rendering a page of the given kind
+// in the given output format to the metric store.
+func (s *Store) MeasurePageSince(kind, outputFormat string, start time.Time) {
+	s.mu.Lock()
+	k := pageKey{kind: kind, outputFormat: outputFormat}
+	s.pageMetrics[k] = append(s.pageMetrics[k], time.Since(start))
+	s.mu.Unlock()
+}
+
 // WriteMetrics writes a summary of the metrics to w.
 func (s *Store) WriteMetrics(w io.Writer) {
-	s.mu.Lock()
+	results, pageResults := s.results()
 
-	results := make([]result, len(s.metrics))
+	if s.calculateHints {
+		fmt.Fprintf(w, "  %13s  %12s  %12s  %9s  %7s  %6s  %7s  %5s  %s\n", "cumulative", "average", "maximum", "cache", "percent", "cached", "", "total", "")
+		fmt.Fprintf(w, "  %13s  %12s  %12s  %9s  %7s  %6s  %7s  %5s  %s\n", "duration", "duration", "duration", "potential", "cached", "count", "callers", "count", "template")
+		fmt.Fprintf(w, "  %13s  %12s  %12s  %9s  %7s  %6s  %7s  %5s  %s\n", "----------", "--------", "--------", "---------", "-------", "------", "-------", "-----", "--------")
+	} else {
+		fmt.Fprintf(w, "  %13s  %12s  %12s  %7s  %6s  %7s  %5s  %s\n", "cumulative", "average", "maximum", "percent", "cached", "", "total", "")
+		fmt.Fprintf(w, "  %13s  %12s  %12s  %7s  %6s  %7s  %5s  %s\n", "duration", "duration", "duration", "cached", "count", "callers", "count", "template")
+		fmt.Fprintf(w, "  %13s  %12s  %12s  %7s  %6s  %7s  %5s  %s\n", "----------", "--------", "--------", "-------", "------", "-------", "-----", "--------")
+	}
 
-	var i int
-	for k, v := range s.metrics {
-		var sum time.Duration
-		var max time.Duration
+	for _, v := range results {
+		if s.calculateHints {
+			fmt.Fprintf(w, "  %13s  %12s  %12s  %9d  %7.f  %6d  %7d  %5d  %s\n", v.sum, v.avg, v.max, v.cacheFactor, v.percentCached(), v.cacheCount, len(v.callers), v.count, v.key)
+		} else {
+			fmt.Fprintf(w, "  %13s  %12s  %12s  %7.f  %6d  %7d  %5d  %s\n", v.sum, v.avg, v.max, v.percentCached(), v.cacheCount, len(v.callers), v.count, v.key)
+		}
+	}
 
-		diff, found := s.diffs[k]
+	if len(pageResults) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "\n  %13s  %12s  %12s  %5s  %-10s  %s\n", "cumulative", "average", "maximum", "", "", "output")
+	fmt.Fprintf(w, "  %13s  %12s  %12s  %5s  %-10s  %s\n", "duration", "duration", "duration", "count", "kind", "format")
+	fmt.Fprintf(w, "  %13s  %12s  %12s  %5s  %-10s  %s\n", "----------", "--------", "--------", "-----", "----", "------")
+	for _, v := range pageResults {
+		fmt.Fprintf(w, "  %13s  %12s  %12s  %5d  %-10s  %s\n", v.sum, v.avg, v.max, v.count, v.key.kind, v.key.outputFormat)
+	}
+}
+
+// WriteMetricsJSON writes a summary of the metrics to w as JSON.
+// All durations are in nanoseconds.
+func (s *Store) WriteMetricsJSON(w io.Writer) error {
+	results, pageResults := s.results()
+
+	type templateJSON struct {
+		Template       string         `json:"template"`
+		Count          int            `json:"count"`
+		Cumulative     int64          `json:"cumulative"`
+		Average        int64          `json:"average"`
+		Maximum        int64          `json:"maximum"`
+		PercentCached  float64        `json:"percentCached"`
+		CachedCount    int            `json:"cachedCount"`
+		CacheLookups   int            `json:"cacheLookups"`
+		CacheHitRatio  *float64       `json:"cacheHitRatio,omitempty"`
+		Callers        map[string]int `json:"callers"`
+		CachePotential *int           `json:"cachePotential,omitempty"`
+	}
+
+	type pageJSON struct {
+		Kind         string `json:"kind"`
+		OutputFormat string `json:"outputFormat"`
+		Count        int    `json:"count"`
+		Cumulative   int64  `json:"cumulative"`
+		Average      int64  `json:"average"`
+		Maximum      int64  `json:"maximum"`
+	}
+
+	m := struct {
+		Templates []templateJSON `json:"templates"`
+		Pages     []pageJSON     `json:"pages"`
+	}{
+		Templates: make([]templateJSON, len(results)),
+		Pages:     make([]pageJSON, len(pageResults)),
+	}
+
+	for i, v := range results {
+		t := templateJSON{
+			Template:      v.key,
+			Count:         v.count,
+			Cumulative:    int64(v.sum),
+			Average:       int64(v.avg),
+			Maximum:       int64(v.max),
+			PercentCached: v.percentCached(),
+			CachedCount:   v.cacheCount,
+			CacheLookups:  v.cacheLookups,
+			Callers:       v.callers,
+		}
+		if t.Callers == nil {
+			t.Callers = make(map[string]int)
+		}
+		if v.cacheLookups > 0 {
+			ratio := float64(v.cacheCount) / float64(v.cacheLookups)
+			t.CacheHitRatio = &ratio
+		}
+		if s.calculateHints {
+			cacheFactor := v.cacheFactor
+			t.CachePotential = &cacheFactor
+		}
+		m.Templates[i] = t
+	}
+
+	for i, v := range pageResults {
+		m.Pages[i] = pageJSON{Kind: v.key.kind, OutputFormat: v.key.outputFormat, Count: v.count, Cumulative: int64(v.sum), Average: int64(v.avg), Maximum: int64(v.max)}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(m)
+}
+
+// results returns the template and page results sorted by cumulative duration
+// and limited to the configured limit.
+func (s *Store) results() ([]result, []pageResult) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cachedmu.Lock()
+	defer s.cachedmu.Unlock()
+
+	results := make([]result, 0, len(s.metrics))
+	for k, v := range s.metrics {
+		sum, avg, max := summarize(v)
 
 		cacheFactor := 0
-		if found {
+		if diff, found := s.diffs[k]; found {
 			cacheFactor = int(math.Floor(float64(diff.simSum) / float64(diff.count)))
 		}
 
-		for _, d := range v {
-			sum += d
-			if d > max {
-				max = d
+		r := result{key: k, count: len(v), max: max, sum: sum, avg: avg, cacheCount: s.cached[k], cacheLookups: s.lookups[k], cacheFactor: cacheFactor}
+		if ts, found := s.templates[k]; found {
+			r.callers = make(map[string]int, len(ts.callers))
+			for caller, n := range ts.callers {
+				r.callers[caller] = n
 			}
 		}
 
-		avg := time.Duration(int(sum) / len(v))
-		cacheCount := s.cached[k]
-
-		results[i] = result{key: k, count: len(v), max: max, sum: sum, avg: avg, cacheCount: cacheCount, cacheFactor: cacheFactor}
-		i++
+		results = append(results, r)
 	}
 
-	s.mu.Unlock()
+	pageResults := make([]pageResult, 0, len(s.pageMetrics))
+	for k, v := range s.pageMetrics {
+		sum, avg, max := summarize(v)
+		pageResults = append(pageResults, pageResult{key: k, count: len(v), max: max, sum: sum, avg: avg})
+	}
 
-	if s.calculateHints {
-		fmt.Fprintf(w, "  %13s  %12s  %12s  %9s  %7s  %6s  %5s  %s\n", "cumulative", "average", "maximum", "cache", "percent", "cached", "total", "")
-		fmt.Fprintf(w, "  %13s  %12s  %12s  %9s  %7s  %6s  %5s  %s\n", "duration", "duration", "duration", "potential", "cached", "count", "count", "template")
-		fmt.Fprintf(w, "  %13s  %12s  %12s  %9s  %7s  %6s  %5s  %s\n", "----------", "--------", "--------", "---------", "-------", "------", "-----", "--------")
-	} else {
-		fmt.Fprintf(w, "  %13s  %12s  %12s  %5s  %s\n", "cumulative", "average", "maximum", "", "")
-		fmt.Fprintf(w, "  %13s  %12s  %12s  %5s  %s\n", "duration", "duration", "duration", "count", "template")
-		fmt.Fprintf(w, "  %13s  %12s  %12s  %5s  %s\n", "----------", "--------", "--------", "-----", "--------")
+	sort.Sort(bySum(results))
+	sort.Slice(pageResults, func(i, j int) bool {
+		if pageResults[i].sum != pageResults[j].sum {
+			return pageResults[i].sum > pageResults[j].sum
+		}
+		return pageResults[i].key.kind+pageResults[i].key.outputFormat < pageResults[j].key.kind+pageResults[j].key.outputFormat
+	})
 
+	if s.limit > 0 {
+		if len(results) > s.limit {
+			results = results[:s.limit]
+		}
+		if len(pageResults) > s.limit {
+			pageResults = pageResults[:s.limit]
+		}
 	}
 
-	sort.Sort(bySum(results))
-	for _, v := range results {
-		if s.calculateHints {
-			fmt.Fprintf(w, "  %13s  %12s  %12s  %9d  %7.f  %6d  %5d  %s\n", v.sum, v.avg, v.max, v.cacheFactor, float64(v.cacheCount)/float64(v.count)*100, v.cacheCount, v.count, v.key)
-		} else {
-			fmt.Fprintf(w, "  %13s  %12s  %12s  %5d  %s\n", v.sum, v.avg, v.max, v.count, v.key)
+	return results, pageResults
+}
+
+func summarize(durations []time.Duration) (sum, avg, max time.Duration) {
+	for _, d := range durations {
+		sum += d
+		if d > max {
+			max = d
 		}
 	}
+	avg = time.Duration(int(sum) / len(durations))
+	return
 }
 
 // A result represents the calculated results for a given metric.
 type result struct {
-	key         string
-	count       int
-	cacheCount  int
-	cacheFactor int
-	sum         time.Duration
-	max         time.Duration
-	avg         time.Duration
+	key          string
+	count        int
+	cacheCount   int
+	cacheLookups int
+	cacheFactor  int
+	sum          time.Duration
+	max          time.Duration
+	avg          time.Duration
+	callers      map[string]int
+}
+
+func (r result) percentCached() float64 {
+	return float64(r.cacheCount) / float64(r.count) * 100
+}
+
+// A pageResult represents the calculated results for a page kind and output format.
+type pageResult struct {
+	key   pageKey
+	count int
+	sum   time.Duration
+	max   time.Duration
+	avg   time.Duration
 }
 
 type bySum []result
@@ -14,9 +14,12 @@
 package metrics
 
 import (
+	"bytes"
+	"encoding/json"
 	"html/template"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gohugoio/hugo/resources/page"
 
@@ -58,6 +61,84 @@ func TestSimilarPercentageNonString(t *testing.T) {
 	c.Assert(howSimilar(testStruct{Name: "A"}, testStruct{Name: "A"}), qt.Equals, 100)
 }
 
+func TestWriteMetrics(t *testing.T) {
+	c := qt.New(t)
+
+	s := NewProvider(false, 2).(*Store)
+	start := time.Now()
+	s.MeasureSince("a.html", start.Add(-3*time.Second))
+	s.MeasureSince("b.html", start.Add(-2*time.Second))
+	s.MeasureSince("b.html", start.Add(-2*time.Second))
+	s.MeasureSince("c.html", start.Add(-1*time.Second))
+	s.MeasurePageSince("page", "html", start.Add(-1*time.Second))
+	s.MeasurePageSince("taxonomy", "html", start.Add(-4*time.Second))
+	s.MeasurePageSince("taxonomy", "rss", start.Add(-2*time.Second))
+
+	var b bytes.Buffer
+	s.WriteMetrics(&b)
+	out := b.String()
+	c.Assert(out, qt.Contains, "b.html")
+	c.Assert(out, qt.Contains, "a.html")
+	c.Assert(out, qt.Not(qt.Contains), "c.html")
+	c.Assert(out, qt.Matches, `(?s).*taxonomy\s+html\n.*taxonomy\s+rss\n`)
+	c.Assert(out, qt.Not(qt.Contains), "page")
+
+	b.Reset()
+	c.Assert(s.WriteMetricsJSON(&b), qt.IsNil)
+	var m struct {
+		Templates []struct {
+			Template string
+			Count    int
+		}
+		Pages []struct {
+			Kind         string
+			OutputFormat string
+			Count        int
+		}
+	}
+	c.Assert(json.Unmarshal(b.Bytes(), &m), qt.IsNil)
+	c.Assert(m.Templates, qt.HasLen, 2)
+	c.Assert(m.Templates[0].Template, qt.Equals, "b.html")
+	c.Assert(m.Templates[0].Count, qt.Equals, 2)
+	c.Assert(m.Templates[1].Template, qt.Equals, "a.html")
+	c.Assert(m.Pages, qt.HasLen, 2)
+	c.Assert(m.Pages[0].Kind, qt.Equals, "taxonomy")
+	c.Assert(m.Pages[0].OutputFormat, qt.Equals, "html")
+	c.Assert(m.Pages[1].OutputFormat, qt.Equals, "rss")
+	c.Assert(b.String(), qt.Not(qt.Contains), "cachePotential")
+
+	s.Reset()
+	b.Reset()
+	c.Assert(s.WriteMetricsJSON(&b), qt.IsNil)
+	c.Assert(b.String(), qt.Equals, "{\n  \"templates\": [],\n  \"pages\": []\n}\n")
+}
+
+func TestMeasureTemplate(t *testing.T) {
+	c := qt.New(t)
+
+	s := NewProvider(false, 0).(*Store)
+	for _, caller := range []string{"a.html", "a.html", "b.html", ""} {
+		done := s.MeasureTemplate("p.html", caller)
+		done()
+	}
+	s.TrackCacheLookup("p.html")
+	s.TrackCacheLookup("p.html")
+	s.TrackValue("p.html", "v", true)
+
+	results, _ := s.results()
+	c.Assert(results, qt.HasLen, 1)
+	r := results[0]
+	c.Assert(r.count, qt.Equals, 4)
+	c.Assert(r.callers, qt.DeepEquals, map[string]int{"a.html": 2, "b.html": 1})
+	c.Assert(r.cacheCount, qt.Equals, 1)
+	c.Assert(r.cacheLookups, qt.Equals, 2)
+	c.Assert(r.percentCached(), qt.Equals, float64(25))
+
+	var b bytes.Buffer
+	s.WriteMetrics(&b)
+	c.Assert(b.String(), qt.Matches, `(?s).*callers.*\s25\s+1\s+2\s+4\s+p\.html\n`)
+}
+
 func BenchmarkHowSimilar(b *testing.B) {
 	s1 := "Hugo is cool and " + strings.Repeat("fun ", 10) + "!"
 	s2 := "Hugo is cool and " + strings.Repeat("cool ", 10) + "!"
@@ -67,3 +148,23 @@ func BenchmarkHowSimilar(b *testing.B) {
 		howSimilar(s1, s2)
 	}
 }
+
+func TestGauges(t *testing.T) {
+	c := qt.New(t)
+
+	g := NewGauges()
+	g.Set("b.size", 32)
+	g.Set("a.size", 1.5)
+	g.Set("b.size", 64)
+
+	v, found := g.Get("b.size")
+	c.Assert(found, qt.IsTrue)
+	c.Assert(v, qt.Equals, float64(64))
+	_, found = g.Get("c.size")
+	c.Assert(found, qt.IsFalse)
+	c.Assert(g.Values(), qt.HasLen, 2)
+
+	var b bytes.Buffer
+	c.Assert(g.WriteGauges(&b), qt.IsNil)
+	c.Assert(b.String(), qt.Equals, "a.size 1.5\nb.size 64\n")
+}
@@ -14,12 +14,16 @@
 package minifiers
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/gohugoio/hugo/common/maps"
 	"github.com/gohugoio/hugo/docshelper"
 	"github.com/gohugoio/hugo/parser"
 	"github.com/spf13/cast"
 
 	"github.com/mitchellh/mapstructure"
+	"github.com/tdewolff/minify/v2"
 	"github.com/tdewolff/minify/v2/css"
 	"github.com/tdewolff/minify/v2/html"
 	"github.com/tdewolff/minify/v2/js"
@@ -73,9 +77,29 @@ type MinifyConfig struct {
 	DisableSVG  bool
 	DisableXML  bool
 
+	// Glob patterns matching published files, relative to the publishDir, that
+	// should not be minified, e.g. "google*.html" or "**/BingSiteAuth.xml".
+	Exclude []string
+
+	// Per media type configuration, keyed by the media type, e.g. "application/rss+xml".
+	// This takes precedence over the Disable* settings above.
+	MediaTypes map[string]MediaTypeConfig
+
 	Tdewolff TdewolffConfig
 }
 
+// MediaTypeConfig configures the minification of a given media type.
+type MediaTypeConfig struct {
+	// Whether to disable minification for this media type.
+	Disable bool
+
+	// Options passed to the minifier for this media type, overriding the
+	// relevant Tdewolff options, e.g. keepWhitespace for XML.
+	Options map[string]any
+
+	minifier minify.Minifier
+}
+
 var defaultConfig = MinifyConfig{
 	Tdewolff: defaultTdewolffConfig,
 }
@@ -105,15 +129,90 @@ func DecodeConfig(v any) (conf MinifyConfig, err error) {
 		}
 	}
 
+	if mt, found := m["mediatypes"]; found {
+		mediaTypes := make(map[string]any)
+		for k, v := range maps.ToStringMap(mt) {
+			if k == maps.MergeStrategyKey {
+				continue
+			}
+			mediaTypes[k] = v
+		}
+		m["mediatypes"] = mediaTypes
+	}
+
 	err = mapstructure.WeakDecode(m, &conf)
 
 	if err != nil {
 		return
 	}
 
+	for k, v := range conf.MediaTypes {
+		if v.Disable {
+			v.minifier = noopMinifier{}
+		} else {
+			if v.minifier, err = conf.newMinifier(k, v.Options); err != nil {
+				return
+			}
+		}
+		conf.MediaTypes[k] = v
+	}
+
 	return
 }
 
+// newMinifier creates a copy of the minifier for the given media type
+// with opts applied.
+func (c MinifyConfig) newMinifier(mediaType string, opts map[string]any) (minify.Minifier, error) {
+	var min any
+	switch minifierKind(mediaType) {
+	case "css":
+		m := c.Tdewolff.CSS
+		min = &m
+	case "html":
+		m := c.Tdewolff.HTML
+		min = &m
+	case "js":
+		m := c.Tdewolff.JS
+		min = &m
+	case "json":
+		m := c.Tdewolff.JSON
+		min = &m
+	case "svg":
+		m := c.Tdewolff.SVG
+		min = &m
+	case "xml":
+		m := c.Tdewolff.XML
+		min = &m
+	default:
+		return nil, fmt.Errorf("minify: no minifier available for media type %q", mediaType)
+	}
+
+	if opts != nil {
+		if err := mapstructure.WeakDecode(opts, min); err != nil {
+			return nil, fmt.Errorf("minify: failed to decode options for media type %q: %w", mediaType, err)
+		}
+	}
+
+	return min.(minify.Minifier), nil
+}
+
+// minifierKind returns the minifier kind (css, html, js, json, svg or xml)
+// for the given media type, e.g. "xml" for "application/rss+xml".
+func minifierKind(mediaType string) string {
+	_, sub, _ := strings.Cut(strings.ToLower(mediaType), "/")
+	sub, _, _ = strings.Cut(sub, ";")
+	switch sub {
+	case "svg+xml":
+		return "svg"
+	case "javascript", "x-javascript", "ecmascript":
+		return "js"
+	}
+	if i := strings.LastIndex(sub, "+"); i != -1 {
+		sub = sub[i+1:]
+	}
+	return sub
+}
+
 func init() {
 	docsProvider := func() docshelper.DocProvider {
 		return docshelper.DocProvider{"config": map[string]any{"minify": parser.LowerCaseCamelJSONMarshaller{Value: defaultConfig}}}
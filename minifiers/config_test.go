@@ -19,6 +19,7 @@ import (
 	qt "github.com/frankban/quicktest"
 	"github.com/gohugoio/hugo/config"
 	"github.com/gohugoio/hugo/config/testconfig"
+	"github.com/gohugoio/hugo/minifiers"
 )
 
 func TestConfig(t *testing.T) {
@@ -59,3 +60,37 @@ func TestConfigLegacy(t *testing.T) {
 	conf := testconfig.GetTestConfigs(nil, v).Base.Minify
 	c.Assert(conf.MinifyOutput, qt.Equals, true)
 }
+
+func TestConfigMediaTypes(t *testing.T) {
+	c := qt.New(t)
+	v := config.New()
+
+	v.Set("minify", map[string]any{
+		"exclude": []string{"google*.html"},
+		"mediatypes": map[string]any{
+			"application/rss+xml": map[string]any{
+				"disable": true,
+			},
+			"application/xml": map[string]any{
+				"options": map[string]any{
+					"keepwhitespace": true,
+				},
+			},
+		},
+	})
+
+	conf := testconfig.GetTestConfigs(nil, v).Base.Minify
+
+	c.Assert(conf.Exclude, qt.DeepEquals, []string{"google*.html"})
+	c.Assert(conf.MediaTypes["application/rss+xml"].Disable, qt.IsTrue)
+	c.Assert(conf.MediaTypes["application/xml"].Options["keepwhitespace"], qt.Equals, true)
+	// The default is left untouched.
+	c.Assert(conf.Tdewolff.XML.KeepWhitespace, qt.IsFalse)
+
+	_, err := minifiers.DecodeConfig(map[string]any{
+		"mediatypes": map[string]any{
+			"image/png": map[string]any{},
+		},
+	})
+	c.Assert(err, qt.ErrorMatches, `.*no minifier available for media type "image/png"`)
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package minifiers_test
+
+import (
+	"testing"
+
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestMinifyOutputMediaTypesAndExclude(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "section"]
+baseURL = "https://example.org/"
+[minify]
+minifyOutput = true
+exclude = ["google*.html"]
+[minify.mediaTypes."application/rss+xml"]
+disable = true
+[minify.mediaTypes."application/json"]
+[minify.mediaTypes."application/json".options]
+precision = 2
+[outputs]
+home = ["html", "rss", "json"]
+-- content/google123.md --
+---
+title: "google-site-verification: google123.html"
+url: /google123.html
+---
+-- content/p1.md --
+---
+title: "P1"
+---
+-- layouts/_default/single.html --
+<html>
+  <body>   {{ .Title }}   </body>
+</html>
+-- layouts/index.html --
+<html>
+  <body>   Home   </body>
+</html>
+-- layouts/index.rss.xml --
+<rss>
+  <channel>   Home   </channel>
+</rss>
+-- layouts/index.json --
+{ "pi": 3.14159 }
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html", "<html><body>P1</body></html>")
+	b.AssertFileContent("public/google123.html", "<html>\n  <body>   google-site-verification: google123.html   </body>\n</html>")
+	b.AssertFileContent("public/index.xml", "<rss>\n  <channel>   Home   </channel>\n</rss>")
+	b.AssertFileContent("public/index.json", `{"pi":3.1}`)
+}
@@ -18,9 +18,11 @@ package minifiers
 
 import (
 	"io"
+	"path/filepath"
 	"regexp"
 
 	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/hugofs/glob"
 	"github.com/gohugoio/hugo/output"
 	"github.com/gohugoio/hugo/transform"
 
@@ -33,9 +35,18 @@ type Client struct {
 	// Whether output minification is enabled (HTML in /public)
 	MinifyOutput bool
 
+	// Published files not to minify.
+	exclude *glob.FilenameFilter
+
 	m *minify.M
 }
 
+// Excluded reports whether the published file in targetPath
+// is excluded from output minification.
+func (m Client) Excluded(targetPath string) bool {
+	return m.exclude != nil && !m.exclude.Match(filepath.ToSlash(targetPath), false)
+}
+
 // Transformer returns a func that can be used in the transformer publishing chain.
 // TODO(bep) minify config etc
 func (m Client) Transformer(mediatype media.Type) transform.Transformer {
@@ -99,7 +110,16 @@ func New(mediaTypes media.Types, outputFormats output.Formats, cfg config.AllPro
 		}
 	}
 
-	return Client{m: m, MinifyOutput: conf.MinifyOutput}, nil
+	for k, v := range conf.MediaTypes {
+		m.Add(k, v.minifier)
+	}
+
+	exclude, err := glob.NewFilenameFilter(nil, conf.Exclude)
+	if err != nil {
+		return Client{}, err
+	}
+
+	return Client{m: m, MinifyOutput: conf.MinifyOutput, exclude: exclude}, nil
 }
 
 // getMinifier returns the appropriate minify.MinifierFunc for the MIME
@@ -141,6 +141,9 @@ type Client struct {
 	// so we can give an instructional error at the end if module/theme
 	// resolution fails.
 	goBinaryStatus goBinaryStatus
+
+	// Hashes of the versioned modules, see EnforceLock.
+	lockSums moduleSumCache
 }
 
 // Graph writes a module dependenchy graph to the given writer.
@@ -339,6 +339,12 @@ func (c *collector) add(owner *moduleAdapter, moduleImport Import, disabled bool
 		return nil, err
 	}
 
+	if moduleImport.Verify && !disabled {
+		if err := VerifyContent(c.fs, ma, c.moduleConfig.Verify); err != nil {
+			return nil, err
+		}
+	}
+
 	c.modules = append(c.modules, ma)
 	return ma, nil
 }
@@ -466,7 +472,7 @@ LOOP:
 
 	// Also check for a config dir, which we overlay on top of the file configuration.
 	configDir := filepath.Join(tc.Dir(), "config")
-	dcfg, dirnames, err := config.LoadConfigFromDir(c.fs, configDir, c.ccfg.Environment)
+	dcfg, dirnames, err := config.LoadConfigFromDir(c.fs, configDir, c.ccfg.Environment, nil)
 	if err != nil {
 		return err
 	}
@@ -220,6 +220,20 @@ func decodeConfig(cfg config.Provider, pathReplacements map[string]string) (Conf
 			c.Mounts[i] = mnt
 		}
 
+		for i, f := range c.Features.Provides {
+			c.Features.Provides[i] = strings.ToLower(strings.TrimSpace(f))
+		}
+		for i, f := range c.Features.Requires {
+			c.Features.Requires[i] = strings.ToLower(strings.TrimSpace(f))
+		}
+
+		c.Lock = strings.ToLower(c.Lock)
+		switch c.Lock {
+		case "", LockModeWarn, LockModeError:
+		default:
+			return c, fmt.Errorf("invalid module.lock %q, must be one of %q or %q", c.Lock, LockModeWarn, LockModeError)
+		}
+
 		if c.Workspace == "" {
 			c.Workspace = WorkspaceDisabled
 		}
@@ -297,6 +311,29 @@ type Config struct {
 	// Requires Go 1.18+.
 	// Note that this can also be set via OS env, e.g. export HUGO_MODULE_WORKSPACE=/my/hugo.work.
 	Workspace string
+
+	// Configures the third-party notices report, see "hugo mod notices".
+	Notices NoticesConfig
+
+	// Configures the verification of content mounted from imports with verify set.
+	Verify VerifyConfig
+
+	// Enforces the lockfile created with "hugo mod lock" when building,
+	// either "warn" or "error". Default is no enforcement.
+	Lock string
+
+	// The features this module provides and requires.
+	Features FeaturesConfig
+}
+
+// FeaturesConfig holds the features a module provides to the site, e.g. "search",
+// and the features it requires from Hugo or other modules.
+type FeaturesConfig struct {
+	// Features provided by this module, available in the templates as site.Features.
+	Provides []string
+
+	// Features required by this module, provided by Hugo (e.g. "extended") or another module.
+	Requires []string
 }
 
 // hasModuleImport reports whether the project config have one or more
@@ -379,6 +416,9 @@ type Import struct {
 	NoVendor bool
 	// Turn off this module.
 	Disable bool
+	// Require the content mounted from this module to match a manifest signed
+	// by one of the keys in the project's module.verify config.
+	Verify bool
 	// File mounts.
 	Mounts []Mount
 }
@@ -398,6 +438,12 @@ type Mount struct {
 
 	// Exclude all files matching the given Glob patterns (string or slice).
 	ExcludeFiles any
+
+	// Rename files in this mount with rules on the form "pattern -> replacement"
+	// (string or slice), where pattern is a regular expression matched against
+	// the file's base name, e.g. "^README.md$ -> _index.md".
+	// Only supported for content mounts.
+	Rename any
 }
 
 // Used as key to remove duplicates.
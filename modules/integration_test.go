@@ -0,0 +1,188 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+	"github.com/gohugoio/hugo/modules"
+	"github.com/spf13/afero"
+)
+
+func TestNoticesIntegration(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+theme = ["mytheme", "othertheme"]
+[module.notices]
+filename = "third-party-notices.txt"
+[module.notices.licenses]
+"othertheme" = "CC0-1.0"
+-- layouts/index.html --
+Home.
+-- themes/mytheme/LICENSE --
+MIT License
+
+Permission is hereby granted, free of charge, to any person obtaining a copy.
+-- themes/othertheme/layouts/_default/single.html --
+Single.
+-- package.json --
+{"dependencies": {"tailwindcss": "^3.0.0"}}
+-- node_modules/tailwindcss/package.json --
+{"name": "tailwindcss", "version": "3.3.2", "license": "MIT"}
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/third-party-notices.txt",
+		"THIRD-PARTY NOTICES",
+		"mytheme (module, MIT)",
+		"Permission is hereby granted",
+		"othertheme (module, CC0-1.0)",
+		"tailwindcss 3.3.2 (npm, MIT)",
+	)
+}
+
+func TestVerifyContentIntegration(t *testing.T) {
+	t.Parallel()
+
+	key := ed25519.NewKeyFromSeed(bytes.Repeat([]byte{1}, ed25519.SeedSize))
+	publicKey := base64.StdEncoding.EncodeToString(key.Public().(ed25519.PublicKey))
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+[module]
+[[module.imports]]
+path = "contrib"
+verify = true
+[module.verify]
+keys = [%q]
+-- layouts/_default/single.html --
+Single: {{ .Title }}|{{ .Content }}
+-- themes/contrib/content/p1.md --
+---
+title: "P1"
+---
+Contributed content.
+-- themes/contrib/hugo_content.sum --
+%s
+-- themes/contrib/hugo_content.sum.sig --
+%s
+`
+
+	// Create the manifest and signature from the contributed content.
+	// Note that the test builder trims the trailing newline.
+	fs := afero.NewMemMapFs()
+	p1 := "---\ntitle: \"P1\"\n---\nContributed content."
+	afero.WriteFile(fs, filepath.FromSlash("/contrib/content/p1.md"), []byte(p1), 0o666)
+	manifest, err := modules.CreateContentManifest(fs, testModule{dir: filepath.FromSlash("/contrib")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: fmt.Sprintf(files, publicKey, manifest, modules.SignContentManifest(key, manifest)),
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html", "Single: P1|<p>Contributed content.</p>")
+
+	b = hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: fmt.Sprintf(files+"-- themes/contrib/content/p2.md --\nUnsigned.\n", publicKey, manifest, modules.SignContentManifest(key, manifest)),
+		},
+	)
+
+	_, err = b.BuildE()
+	b.Assert(err, qt.ErrorMatches, `.*failed to verify content in module "contrib": "content/p2.md" is not listed in the manifest.*`)
+}
+
+// testModule is a minimal modules.Module with a content mount.
+type testModule struct {
+	modules.Module
+	dir string
+}
+
+func (m testModule) Dir() string {
+	return m.dir
+}
+
+func (m testModule) Mounts() []modules.Mount {
+	return []modules.Mount{{Source: "content", Target: "content"}}
+}
+
+func TestLockIntegration(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+theme = "mytheme"
+[module]
+lock = "%s"
+-- themes/mytheme/layouts/index.html --
+Home.
+-- hugo.lock --
+mytheme - sha256:0000000000000000000000000000000000000000000000000000000000000000
+`
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: fmt.Sprintf(files, "error"),
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `modules do not match hugo.lock, run "hugo mod lock"`)
+	b.Assert(err.Error(), qt.Contains, "mytheme -: files do not match the locked hash")
+
+	b = hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: fmt.Sprintf(files, "warn"),
+		},
+	).Build()
+
+	// The build continues with a warning.
+	b.AssertFileContent("public/index.html", "Home.")
+
+	client := b.H.Configs.ModulesClient
+	b.Assert(client.VerifyLock(), qt.IsNotNil)
+	b.Assert(client.WriteLock(), qt.IsNil)
+	b.AssertFileContent("hugo.lock", "mytheme - sha256:")
+	b.Assert(client.VerifyLock(), qt.IsNil)
+	b.Assert(client.EnforceLock(b.H.Configs.Modules), qt.IsNil)
+}
@@ -0,0 +1,268 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gohugoio/hugo/common/herrors"
+	"github.com/spf13/afero"
+)
+
+// LockFilename is the name of the module lockfile stored in the project root.
+const LockFilename = "hugo.lock"
+
+// Lock enforcement modes, see Config.Lock.
+const (
+	LockModeWarn  = "warn"
+	LockModeError = "error"
+)
+
+// The version written to the lockfile for modules without one,
+// e.g. components in the themes directory.
+const lockNoVersion = "-"
+
+type lockEntry struct {
+	Path    string
+	Version string
+	Sum     string
+}
+
+// CreateLock creates the lockfile content for the imported modules in mods.
+// Each line holds the module path, version and the hash of the files in its
+// mounts, sorted by path.
+func CreateLock(fs afero.Fs, mods Modules) ([]byte, error) {
+	entries, err := createLockEntries(fs, mods, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	for _, e := range entries {
+		fmt.Fprintf(&b, "%s %s %s\n", e.Path, e.Version, e.Sum)
+	}
+
+	return b.Bytes(), nil
+}
+
+func createLockEntries(fs afero.Fs, mods Modules, cache *moduleSumCache) ([]lockEntry, error) {
+	var entries []lockEntry
+	for _, mod := range mods {
+		if mod.Owner() == nil || mod.Disabled() {
+			// Skip the project itself.
+			continue
+		}
+		sum, err := cache.sum(fs, mod)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash module %q: %w", mod.Path(), err)
+		}
+		version := mod.Version()
+		if version == "" {
+			version = lockNoVersion
+		}
+		entries = append(entries, lockEntry{Path: mod.Path(), Version: version, Sum: sum})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Path < entries[j].Path
+	})
+
+	return entries, nil
+}
+
+// moduleSum returns the SHA-256 hash of the sorted checksums of the files in
+// the mounts of mod. Vendored modules contain the same mounted files, so the
+// hash does not change when a module is vendored.
+func moduleSum(fs afero.Fs, mod Module) (string, error) {
+	sums, err := mountChecksums(fs, mod)
+	if err != nil {
+		return "", err
+	}
+
+	filenames := make([]string, 0, len(sums))
+	for filename := range sums {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	h := sha256.New()
+	for _, filename := range filenames {
+		fmt.Fprintf(h, "%s  %s\n", sums[filename], filename)
+	}
+
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func parseLock(b []byte) (map[string]lockEntry, error) {
+	m := make(map[string]lockEntry)
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("invalid %s line %q", LockFilename, line)
+		}
+		m[fields[0]] = lockEntry{Path: fields[0], Version: fields[1], Sum: fields[2]}
+	}
+	return m, scanner.Err()
+}
+
+// moduleSumCache caches the hashes of versioned modules, whose files in the
+// module cache do not change, across builds.
+type moduleSumCache struct {
+	mu sync.Mutex
+	m  map[string]string
+}
+
+// sum returns the hash of mod, see moduleSum.
+// A nil cache always computes the hash.
+func (c *moduleSumCache) sum(fs afero.Fs, mod Module) (string, error) {
+	if c == nil || mod.Version() == "" || mod.Vendor() {
+		// Unversioned and vendored modules may be edited.
+		return moduleSum(fs, mod)
+	}
+
+	// The mounts may be configured in the project.
+	key := fmt.Sprintf("%s|%s|%s|%v", mod.Path(), mod.Version(), mod.Dir(), mod.Mounts())
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if sum, found := c.m[key]; found {
+		return sum, nil
+	}
+	sum, err := moduleSum(fs, mod)
+	if err != nil {
+		return "", err
+	}
+	if c.m == nil {
+		c.m = make(map[string]string)
+	}
+	c.m[key] = sum
+	return sum, nil
+}
+
+// checkLock compares the imported modules in mods with the lockfile in workingDir.
+func checkLock(fs afero.Fs, workingDir string, mods Modules, cache *moduleSumCache) error {
+	b, err := afero.ReadFile(fs, filepath.Join(workingDir, LockFilename))
+	if err != nil {
+		if herrors.IsNotExist(err) {
+			return fmt.Errorf("%s not found, run \"hugo mod lock\" to create it", LockFilename)
+		}
+		return err
+	}
+
+	locked, err := parseLock(b)
+	if err != nil {
+		return err
+	}
+
+	entries, err := createLockEntries(fs, mods, cache)
+	if err != nil {
+		return err
+	}
+
+	var drift []string
+	seen := make(map[string]bool)
+	for _, e := range entries {
+		seen[e.Path] = true
+		l, found := locked[e.Path]
+		switch {
+		case !found:
+			drift = append(drift, fmt.Sprintf("%s %s: not in %s", e.Path, e.Version, LockFilename))
+		case l.Version != e.Version:
+			drift = append(drift, fmt.Sprintf("%s: version %s does not match locked version %s", e.Path, e.Version, l.Version))
+		case l.Sum != e.Sum:
+			drift = append(drift, fmt.Sprintf("%s %s: files do not match the locked hash", e.Path, e.Version))
+		}
+	}
+	for path, l := range locked {
+		if !seen[path] {
+			drift = append(drift, fmt.Sprintf("%s %s: locked but not in use", path, l.Version))
+		}
+	}
+
+	if len(drift) == 0 {
+		return nil
+	}
+
+	sort.Strings(drift)
+
+	return fmt.Errorf("modules do not match %s, run \"hugo mod lock\" to update it if the changes are expected:\n%s", LockFilename, strings.Join(drift, "\n"))
+}
+
+// WriteLock writes the lockfile for the modules in use to the project root.
+func (c *Client) WriteLock() error {
+	mc, coll := c.collect(true)
+	if coll.err != nil {
+		return coll.err
+	}
+	if err := (&mc).setActiveMods(c.logger); err != nil {
+		return err
+	}
+
+	b, err := CreateLock(c.fs, mc.ActiveModules)
+	if err != nil {
+		return err
+	}
+
+	return afero.WriteFile(c.fs, filepath.Join(c.ccfg.WorkingDir, LockFilename), b, 0o666)
+}
+
+// VerifyLock checks that the modules in use match the lockfile.
+func (c *Client) VerifyLock() error {
+	mc, coll := c.collect(true)
+	if coll.err != nil {
+		return coll.err
+	}
+	if err := (&mc).setActiveMods(c.logger); err != nil {
+		return err
+	}
+
+	return checkLock(c.fs, c.ccfg.WorkingDir, mc.ActiveModules, nil)
+}
+
+// EnforceLock checks the active modules in mods against the lockfile as
+// configured in module.lock. This is done when building, not when loading
+// the config, so the "hugo mod" commands can be used to fix any drift.
+func (c *Client) EnforceLock(mods Modules) error {
+	if c == nil {
+		return nil
+	}
+	switch c.moduleConfig.Lock {
+	case LockModeWarn, LockModeError:
+	default:
+		return nil
+	}
+
+	err := checkLock(c.fs, c.ccfg.WorkingDir, mods, &c.lockSums)
+	if err == nil {
+		return nil
+	}
+	if c.moduleConfig.Lock == LockModeWarn {
+		c.logger.Warnln(err)
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/spf13/afero"
+)
+
+func TestLock(t *testing.T) {
+	c := qt.New(t)
+
+	workingDir := filepath.FromSlash("/project")
+	project := &moduleAdapter{path: "project", dir: workingDir, projectMod: true}
+
+	setup := func() (afero.Fs, Modules) {
+		fs := afero.NewMemMapFs()
+		for _, filename := range []string{"themes/mytheme/layouts/index.html", "themes/mytheme/assets/main.css", "_vendor/github.com/example/mymod/layouts/_default/single.html"} {
+			c.Assert(afero.WriteFile(fs, filepath.Join(workingDir, filepath.FromSlash(filename)), []byte(filename), 0o666), qt.IsNil)
+		}
+		// Not mounted, so not part of the hash.
+		c.Assert(afero.WriteFile(fs, filepath.Join(workingDir, "themes", "mytheme", "README.md"), []byte("readme"), 0o666), qt.IsNil)
+
+		mods := Modules{
+			project,
+			&moduleAdapter{
+				path:   "mytheme",
+				dir:    filepath.Join(workingDir, "themes", "mytheme"),
+				owner:  project,
+				mounts: []Mount{{Source: "layouts", Target: "layouts"}, {Source: "assets", Target: "assets"}, {Source: "static", Target: "static"}},
+			},
+			&moduleAdapter{
+				path:    "github.com/example/mymod",
+				version: "v1.2.0",
+				vendor:  true,
+				dir:     filepath.Join(workingDir, "_vendor", "github.com", "example", "mymod"),
+				owner:   project,
+				mounts:  []Mount{{Source: "layouts", Target: "layouts"}},
+			},
+		}
+
+		lock, err := CreateLock(fs, mods)
+		c.Assert(err, qt.IsNil)
+		c.Assert(afero.WriteFile(fs, filepath.Join(workingDir, LockFilename), lock, 0o666), qt.IsNil)
+
+		return fs, mods
+	}
+
+	c.Run("Create", func(c *qt.C) {
+		fs, _ := setup()
+		b, err := afero.ReadFile(fs, filepath.Join(workingDir, LockFilename))
+		c.Assert(err, qt.IsNil)
+		lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+		c.Assert(lines, qt.HasLen, 2)
+		c.Assert(lines[0], qt.Matches, `github.com/example/mymod v1.2.0 sha256:[0-9a-f]{64}`)
+		c.Assert(lines[1], qt.Matches, `mytheme - sha256:[0-9a-f]{64}`)
+	})
+
+	c.Run("No drift", func(c *qt.C) {
+		fs, mods := setup()
+		c.Assert(checkLock(fs, workingDir, mods, nil), qt.IsNil)
+		c.Assert(afero.WriteFile(fs, filepath.Join(workingDir, "themes", "mytheme", "README.md"), []byte("changed"), 0o666), qt.IsNil)
+		c.Assert(checkLock(fs, workingDir, mods, nil), qt.IsNil)
+	})
+
+	c.Run("Modified file", func(c *qt.C) {
+		fs, mods := setup()
+		c.Assert(afero.WriteFile(fs, filepath.Join(workingDir, "themes", "mytheme", "assets", "main.css"), []byte("changed"), 0o666), qt.IsNil)
+		err := checkLock(fs, workingDir, mods, nil)
+		c.Assert(err, qt.IsNotNil)
+		c.Assert(err.Error(), qt.Contains, "mytheme -: files do not match the locked hash")
+	})
+
+	c.Run("Added file", func(c *qt.C) {
+		fs, mods := setup()
+		c.Assert(afero.WriteFile(fs, filepath.Join(workingDir, "themes", "mytheme", "static", "evil.js"), []byte("evil"), 0o666), qt.IsNil)
+		err := checkLock(fs, workingDir, mods, nil)
+		c.Assert(err, qt.IsNotNil)
+		c.Assert(err.Error(), qt.Contains, "mytheme -: files do not match the locked hash")
+	})
+
+	c.Run("Version changed", func(c *qt.C) {
+		fs, mods := setup()
+		mods[2].(*moduleAdapter).version = "v1.3.0"
+		err := checkLock(fs, workingDir, mods, nil)
+		c.Assert(err, qt.IsNotNil)
+		c.Assert(err.Error(), qt.Contains, "github.com/example/mymod: version v1.3.0 does not match locked version v1.2.0")
+	})
+
+	c.Run("Added and removed module", func(c *qt.C) {
+		fs, mods := setup()
+		mods[1].(*moduleAdapter).path = "othertheme"
+		err := checkLock(fs, workingDir, mods, nil)
+		c.Assert(err, qt.IsNotNil)
+		c.Assert(err.Error(), qt.Contains, "othertheme -: not in hugo.lock")
+		c.Assert(err.Error(), qt.Contains, "mytheme -: locked but not in use")
+	})
+
+	c.Run("Excluded files", func(c *qt.C) {
+		fs, mods := setup()
+		mytheme := mods[1].(*moduleAdapter)
+		mytheme.mounts = append([]Mount{}, mytheme.mounts...)
+		mytheme.mounts[0].ExcludeFiles = "**.txt"
+		mytheme.mounts[1].IncludeFiles = []string{"*.css"}
+		lock, err := CreateLock(fs, mods)
+		c.Assert(err, qt.IsNil)
+		c.Assert(afero.WriteFile(fs, filepath.Join(workingDir, LockFilename), lock, 0o666), qt.IsNil)
+
+		// Not mounted, so not part of the hash.
+		c.Assert(afero.WriteFile(fs, filepath.Join(workingDir, "themes", "mytheme", "layouts", "notes.txt"), []byte("notes"), 0o666), qt.IsNil)
+		c.Assert(afero.WriteFile(fs, filepath.Join(workingDir, "themes", "mytheme", "assets", "main.js"), []byte("js"), 0o666), qt.IsNil)
+		c.Assert(checkLock(fs, workingDir, mods, nil), qt.IsNil)
+
+		c.Assert(afero.WriteFile(fs, filepath.Join(workingDir, "themes", "mytheme", "assets", "other.css"), []byte("css"), 0o666), qt.IsNil)
+		c.Assert(checkLock(fs, workingDir, mods, nil), qt.ErrorMatches, "(?s).*mytheme -: files do not match the locked hash.*")
+	})
+
+	c.Run("Cache", func(c *qt.C) {
+		fs, mods := setup()
+		mymod := mods[2].(*moduleAdapter)
+		mymod.vendor = false
+		lock, err := CreateLock(fs, mods)
+		c.Assert(err, qt.IsNil)
+		c.Assert(afero.WriteFile(fs, filepath.Join(workingDir, LockFilename), lock, 0o666), qt.IsNil)
+
+		var cache moduleSumCache
+		c.Assert(checkLock(fs, workingDir, mods, &cache), qt.IsNil)
+		c.Assert(cache.m, qt.HasLen, 1)
+
+		// The files of a module version in the module cache do not change.
+		c.Assert(afero.WriteFile(fs, filepath.Join(mymod.dir, "layouts", "_default", "single.html"), []byte("changed"), 0o666), qt.IsNil)
+		c.Assert(checkLock(fs, workingDir, mods, &cache), qt.IsNil)
+		c.Assert(checkLock(fs, workingDir, mods, nil), qt.IsNotNil)
+
+		// Unversioned modules are always hashed.
+		c.Assert(afero.WriteFile(fs, filepath.Join(workingDir, "themes", "mytheme", "assets", "main.css"), []byte("changed"), 0o666), qt.IsNil)
+		c.Assert(checkLock(fs, workingDir, mods, &cache), qt.ErrorMatches, "(?s).*mytheme -: files do not match the locked hash.*")
+	})
+
+	c.Run("Missing lockfile", func(c *qt.C) {
+		fs, mods := setup()
+		c.Assert(fs.Remove(filepath.Join(workingDir, LockFilename)), qt.IsNil)
+		c.Assert(checkLock(fs, workingDir, mods, nil), qt.ErrorMatches, `hugo.lock not found, run "hugo mod lock" to create it`)
+	})
+}
@@ -0,0 +1,301 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gobwas/glob"
+	hglob "github.com/gohugoio/hugo/hugofs/glob"
+	"github.com/spf13/afero"
+	"github.com/spf13/cast"
+)
+
+const (
+	// LicenseUnknown is used when no license could be detected.
+	LicenseUnknown = "UNKNOWN"
+
+	NoticeSourceModule = "module"
+	NoticeSourceNpm    = "npm"
+)
+
+// NoticesConfig configures the third-party notices report built from the
+// modules and npm dependencies in use.
+type NoticesConfig struct {
+	// If set, the notices report will be published to this path below publishDir during the build,
+	// e.g. "third-party-notices.txt".
+	Filename string
+
+	// License overrides keyed by module path or npm package name, e.g.
+	// "github.com/bep/mytheme" = "MIT".
+	Licenses map[string]string
+
+	// Glob patterns matching module paths or npm package names to leave out of the report.
+	Exclude []string
+}
+
+// Notice holds the license information about a third-party dependency.
+type Notice struct {
+	// The module path or npm package name.
+	Name string
+
+	// The version, if known.
+	Version string
+
+	// The SPDX license identifier, LicenseUnknown if it could not be detected.
+	License string
+
+	// One of NoticeSourceModule or NoticeSourceNpm.
+	Source string
+
+	// The license text, if found.
+	Text string
+}
+
+var licenseFilenames = []string{
+	"LICENSE", "LICENSE.md", "LICENSE.txt", "LICENCE", "LICENCE.md", "LICENCE.txt", "COPYING", "COPYING.md", "COPYING.txt",
+}
+
+// CollectNotices collects the third-party notices for the given modules and
+// the npm dependencies listed in the package.json in workingDir.
+// The project module itself is not included.
+func CollectNotices(fs afero.Fs, workingDir string, mods Modules, cfg NoticesConfig) ([]Notice, error) {
+	var excludes []glob.Glob
+	for _, pattern := range cfg.Exclude {
+		g, err := hglob.GetGlob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("module.notices: invalid exclude pattern %q: %w", pattern, err)
+		}
+		excludes = append(excludes, g)
+	}
+
+	isExcluded := func(name string) bool {
+		for _, g := range excludes {
+			if g.Match(name) {
+				return true
+			}
+		}
+		return false
+	}
+
+	override := func(n *Notice) {
+		if l, found := cfg.Licenses[strings.ToLower(n.Name)]; found {
+			n.License = l
+		}
+	}
+
+	var notices []Notice
+
+	for _, m := range mods {
+		if m.Owner() == nil || m.Disabled() {
+			// The project module.
+			continue
+		}
+		if isExcluded(m.Path()) {
+			continue
+		}
+
+		n := Notice{
+			Name:    m.Path(),
+			Version: m.Version(),
+			Source:  NoticeSourceModule,
+			License: LicenseUnknown,
+		}
+
+		n.Text = readLicenseFile(fs, m.Dir())
+		if n.Text != "" {
+			n.License = DetectLicense(n.Text)
+		}
+
+		if n.License == LicenseUnknown {
+			// Fall back to the license set in the module's params.
+			if l := cast.ToString(m.Config().Params["license"]); l != "" {
+				n.License = l
+			}
+		}
+
+		override(&n)
+
+		notices = append(notices, n)
+	}
+
+	npmNotices, err := collectNpmNotices(fs, workingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, n := range npmNotices {
+		if isExcluded(n.Name) {
+			continue
+		}
+		override(&n)
+		notices = append(notices, n)
+	}
+
+	sort.SliceStable(notices, func(i, j int) bool {
+		if notices[i].Source != notices[j].Source {
+			return notices[i].Source < notices[j].Source
+		}
+		return notices[i].Name < notices[j].Name
+	})
+
+	return notices, nil
+}
+
+type npmPackage struct {
+	Name         string            `json:"name"`
+	Version      string            `json:"version"`
+	License      any               `json:"license"`
+	Dependencies map[string]string `json:"dependencies"`
+}
+
+func (p npmPackage) license() string {
+	switch v := p.License.(type) {
+	case string:
+		return v
+	case map[string]any:
+		// Deprecated, but still common: { "type": "MIT", "url": "..." }
+		return cast.ToString(v["type"])
+	}
+	return ""
+}
+
+func collectNpmNotices(fs afero.Fs, workingDir string) ([]Notice, error) {
+	b, err := afero.ReadFile(fs, filepath.Join(workingDir, "package.json"))
+	if err != nil {
+		// No package.json.
+		return nil, nil
+	}
+
+	var project npmPackage
+	if err := json.Unmarshal(b, &project); err != nil {
+		return nil, fmt.Errorf("failed to parse package.json: %w", err)
+	}
+
+	var notices []Notice
+	for name, version := range project.Dependencies {
+		n := Notice{
+			Name:    name,
+			Version: version,
+			Source:  NoticeSourceNpm,
+			License: LicenseUnknown,
+		}
+
+		dir := filepath.Join(workingDir, "node_modules", filepath.FromSlash(name))
+		if b, err := afero.ReadFile(fs, filepath.Join(dir, "package.json")); err == nil {
+			var pkg npmPackage
+			if err := json.Unmarshal(b, &pkg); err == nil {
+				if pkg.Version != "" {
+					n.Version = pkg.Version
+				}
+				if l := pkg.license(); l != "" {
+					n.License = l
+				}
+			}
+		}
+
+		n.Text = readLicenseFile(fs, dir)
+		if n.License == LicenseUnknown && n.Text != "" {
+			n.License = DetectLicense(n.Text)
+		}
+
+		notices = append(notices, n)
+	}
+
+	return notices, nil
+}
+
+func readLicenseFile(fs afero.Fs, dir string) string {
+	for _, name := range licenseFilenames {
+		b, err := afero.ReadFile(fs, filepath.Join(dir, name))
+		if err == nil {
+			return strings.TrimSpace(string(b))
+		}
+	}
+	return ""
+}
+
+// licenseMatchers is an ordered list of SPDX identifiers and the phrases
+// that all must be present in a license text to match.
+var licenseMatchers = []struct {
+	id      string
+	phrases []string
+}{
+	{"AGPL-3.0", []string{"gnu affero general public license", "version 3"}},
+	{"LGPL-3.0", []string{"gnu lesser general public license", "version 3"}},
+	{"LGPL-2.1", []string{"gnu lesser general public license", "version 2.1"}},
+	{"GPL-3.0", []string{"gnu general public license", "version 3"}},
+	{"GPL-2.0", []string{"gnu general public license", "version 2"}},
+	{"MPL-2.0", []string{"mozilla public license", "2.0"}},
+	{"Apache-2.0", []string{"apache license", "version 2.0"}},
+	{"BSD-3-Clause", []string{"redistribution and use in source and binary forms", "neither the name"}},
+	{"BSD-2-Clause", []string{"redistribution and use in source and binary forms"}},
+	{"ISC", []string{"permission to use, copy, modify, and/or distribute this software for any purpose"}},
+	{"MIT", []string{"permission is hereby granted, free of charge"}},
+	{"Unlicense", []string{"this is free and unencumbered software released into the public domain"}},
+	{"CC-BY-4.0", []string{"creative commons attribution 4.0"}},
+	{"CC0-1.0", []string{"cc0 1.0 universal"}},
+}
+
+// DetectLicense tries to detect the SPDX license identifier from the given license text.
+// It returns LicenseUnknown if no match is found.
+func DetectLicense(text string) string {
+	text = strings.ToLower(strings.Join(strings.Fields(text), " "))
+	for _, m := range licenseMatchers {
+		found := true
+		for _, phrase := range m.phrases {
+			if !strings.Contains(text, phrase) {
+				found = false
+				break
+			}
+		}
+		if found {
+			return m.id
+		}
+	}
+	return LicenseUnknown
+}
+
+// WriteNotices writes a plain text notices report to w.
+func WriteNotices(w io.Writer, notices []Notice) error {
+	var err error
+	p := func(format string, a ...any) {
+		if err != nil {
+			return
+		}
+		_, err = fmt.Fprintf(w, format, a...)
+	}
+
+	p("THIRD-PARTY NOTICES\n\n")
+	p("This site is built with the following third-party components.\n")
+
+	for _, n := range notices {
+		p("\n%s\n\n", strings.Repeat("-", 80))
+		p("%s", n.Name)
+		if n.Version != "" {
+			p(" %s", n.Version)
+		}
+		p(" (%s, %s)\n", n.Source, n.License)
+		if n.Text != "" {
+			p("\n%s\n", n.Text)
+		}
+	}
+
+	return err
+}
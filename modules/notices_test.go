@@ -0,0 +1,105 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/spf13/afero"
+)
+
+const (
+	testMITLicense = `MIT License
+
+Copyright (c) 2023 Jane Doe
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software").`
+
+	testApacheLicense = `
+                                 Apache License
+                           Version 2.0, January 2004
+                        http://www.apache.org/licenses/`
+)
+
+func TestDetectLicense(t *testing.T) {
+	c := qt.New(t)
+
+	c.Assert(DetectLicense(testMITLicense), qt.Equals, "MIT")
+	c.Assert(DetectLicense(testApacheLicense), qt.Equals, "Apache-2.0")
+	c.Assert(DetectLicense("GNU GENERAL PUBLIC LICENSE\n   Version 3, 29 June 2007"), qt.Equals, "GPL-3.0")
+	c.Assert(DetectLicense("GNU LESSER GENERAL PUBLIC LICENSE\nVersion 3"), qt.Equals, "LGPL-3.0")
+	c.Assert(DetectLicense("All rights reserved."), qt.Equals, LicenseUnknown)
+}
+
+func TestCollectNotices(t *testing.T) {
+	c := qt.New(t)
+
+	fs := afero.NewMemMapFs()
+	workDir := filepath.FromSlash("/work")
+
+	writeFile := func(filename, content string) {
+		c.Assert(afero.WriteFile(fs, filepath.Join(workDir, filepath.FromSlash(filename)), []byte(content), 0o666), qt.IsNil)
+	}
+
+	writeFile("themes/mytheme/LICENSE", testMITLicense)
+	writeFile("themes/othertheme/COPYING.txt", testApacheLicense)
+	writeFile("package.json", `{"dependencies": {"@scope/pkg": "^1.0.0", "leftpad": "1.2.0", "internal": "1.0.0"}}`)
+	writeFile("node_modules/@scope/pkg/package.json", `{"name": "@scope/pkg", "version": "1.0.3", "license": "ISC"}`)
+	writeFile("node_modules/leftpad/package.json", `{"name": "leftpad", "version": "1.2.0", "license": {"type": "BSD-2-Clause"}}`)
+
+	project := &moduleAdapter{projectMod: true, dir: workDir}
+	mods := Modules{
+		project,
+		&moduleAdapter{path: "github.com/example/mytheme", version: "v1.2.3", dir: filepath.Join(workDir, "themes", "mytheme"), owner: project},
+		&moduleAdapter{path: "github.com/example/othertheme", dir: filepath.Join(workDir, "themes", "othertheme"), owner: project},
+		&moduleAdapter{path: "github.com/example/nolicense", dir: filepath.Join(workDir, "themes", "nolicense"), owner: project, config: Config{Params: map[string]any{"license": "CC-BY-4.0"}}},
+		&moduleAdapter{path: "github.com/example/disabled", disabled: true, owner: project},
+	}
+
+	notices, err := CollectNotices(fs, workDir, mods, NoticesConfig{
+		Licenses: map[string]string{"github.com/example/othertheme": "Apache-2.0 OR MIT"},
+		Exclude:  []string{"internal"},
+	})
+	c.Assert(err, qt.IsNil)
+
+	var got []string
+	for _, n := range notices {
+		got = append(got, strings.Join([]string{n.Source, n.Name, n.Version, n.License}, "|"))
+	}
+
+	c.Assert(got, qt.DeepEquals, []string{
+		"module|github.com/example/mytheme|v1.2.3|MIT",
+		"module|github.com/example/nolicense||CC-BY-4.0",
+		"module|github.com/example/othertheme||Apache-2.0 OR MIT",
+		"npm|@scope/pkg|1.0.3|ISC",
+		"npm|leftpad|1.2.0|BSD-2-Clause",
+	})
+
+	c.Assert(notices[0].Text, qt.Contains, "Copyright (c) 2023 Jane Doe")
+
+	var b strings.Builder
+	c.Assert(WriteNotices(&b, notices), qt.IsNil)
+	s := b.String()
+	c.Assert(s, qt.Contains, "THIRD-PARTY NOTICES")
+	c.Assert(s, qt.Contains, "github.com/example/mytheme v1.2.3 (module, MIT)")
+	c.Assert(s, qt.Contains, "leftpad 1.2.0 (npm, BSD-2-Clause)")
+	c.Assert(s, qt.Contains, "Copyright (c) 2023 Jane Doe")
+
+	_, err = CollectNotices(fs, workDir, mods, NoticesConfig{Exclude: []string{"["}})
+	c.Assert(err, qt.ErrorMatches, ".*invalid exclude pattern.*")
+}
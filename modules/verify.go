@@ -0,0 +1,265 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/herrors"
+	"github.com/gohugoio/hugo/common/types"
+	"github.com/gohugoio/hugo/hugofs/glob"
+	"github.com/spf13/afero"
+)
+
+// DefaultContentManifestFilename is the default name of the content manifest
+// stored in the root of a module.
+const DefaultContentManifestFilename = "hugo_content.sum"
+
+// VerifyConfig configures the verification of content mounted from modules
+// imported with verify enabled.
+type VerifyConfig struct {
+	// The manifest filename relative to the module root.
+	// The detached signature is expected in the same directory with a ".sig" suffix.
+	// Defaults to DefaultContentManifestFilename.
+	Manifest string
+
+	// Base64 encoded Ed25519 public keys trusted to sign content manifests.
+	Keys []string
+}
+
+func (c VerifyConfig) manifestFilename() string {
+	if c.Manifest == "" {
+		return DefaultContentManifestFilename
+	}
+	return c.Manifest
+}
+
+func (c VerifyConfig) publicKeys() ([]ed25519.PublicKey, error) {
+	if len(c.Keys) == 0 {
+		return nil, errors.New("module.verify.keys must be set to verify content")
+	}
+	var keys []ed25519.PublicKey
+	for _, k := range c.Keys {
+		b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(k))
+		if err != nil || len(b) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("module.verify.keys: invalid Ed25519 public key %q", k)
+		}
+		keys = append(keys, ed25519.PublicKey(b))
+	}
+	return keys, nil
+}
+
+// CreateContentManifest creates a content manifest for all the mounts in mod,
+// e.g. content, assets, static and layouts.
+// Each line holds the hex encoded SHA-256 checksum and the slash separated
+// filename relative to the module root, sorted by filename.
+func CreateContentManifest(fs afero.Fs, mod Module) ([]byte, error) {
+	sums, err := mountChecksums(fs, mod)
+	if err != nil {
+		return nil, err
+	}
+
+	filenames := make([]string, 0, len(sums))
+	for filename := range sums {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	var b bytes.Buffer
+	for _, filename := range filenames {
+		fmt.Fprintf(&b, "%s  %s\n", sums[filename], filename)
+	}
+
+	return b.Bytes(), nil
+}
+
+// SignContentManifest returns the base64 encoded detached signature of manifest.
+func SignContentManifest(key ed25519.PrivateKey, manifest []byte) []byte {
+	sig := ed25519.Sign(key, manifest)
+	return []byte(base64.StdEncoding.EncodeToString(sig))
+}
+
+// VerifyContent verifies that the manifest in mod is signed by one of the
+// trusted keys in cfg, that every file in the mounts of mod matches the
+// checksum in the manifest and that no file listed in the manifest is missing.
+func VerifyContent(fs afero.Fs, mod Module, cfg VerifyConfig) error {
+	errMsg := fmt.Sprintf("failed to verify content in module %q", mod.Path())
+
+	keys, err := cfg.publicKeys()
+	if err != nil {
+		return fmt.Errorf("%s: %w", errMsg, err)
+	}
+
+	manifestFilename := filepath.Join(mod.Dir(), filepath.FromSlash(cfg.manifestFilename()))
+	manifest, err := afero.ReadFile(fs, manifestFilename)
+	if err != nil {
+		return fmt.Errorf("%s: failed to read manifest: %w", errMsg, err)
+	}
+	sigb, err := afero.ReadFile(fs, manifestFilename+".sig")
+	if err != nil {
+		return fmt.Errorf("%s: failed to read manifest signature: %w", errMsg, err)
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(sigb)))
+	if err != nil {
+		return fmt.Errorf("%s: invalid manifest signature: %w", errMsg, err)
+	}
+
+	var signed bool
+	for _, key := range keys {
+		if ed25519.Verify(key, manifest, sig) {
+			signed = true
+			break
+		}
+	}
+	if !signed {
+		return fmt.Errorf("%s: manifest signature does not match any of the trusted keys", errMsg)
+	}
+
+	expected, err := parseContentManifest(manifest)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errMsg, err)
+	}
+
+	sums, err := mountChecksums(fs, mod)
+	if err != nil {
+		return fmt.Errorf("%s: %w", errMsg, err)
+	}
+
+	for filename, sum := range sums {
+		expectedSum, found := expected[filename]
+		if !found {
+			return fmt.Errorf("%s: %q is not listed in the manifest", errMsg, filename)
+		}
+		if sum != expectedSum {
+			return fmt.Errorf("%s: checksum mismatch for %q", errMsg, filename)
+		}
+	}
+
+	// Files, or whole directories, removed from the module after signing.
+	var missing []string
+	for filename := range expected {
+		if _, found := sums[filename]; !found {
+			missing = append(missing, filename)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("%s: %q is listed in the manifest but missing", errMsg, missing[0])
+	}
+
+	return nil
+}
+
+func parseContentManifest(b []byte) (map[string]string, error) {
+	m := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		sum, filename, found := strings.Cut(line, " ")
+		filename = strings.TrimSpace(filename)
+		if !found || filename == "" {
+			return nil, fmt.Errorf("invalid manifest line %q", line)
+		}
+		// The filenames are slash separated and relative to the module root.
+		filename = path.Clean(filename)
+		if path.IsAbs(filename) || filename == ".." || strings.HasPrefix(filename, "../") {
+			return nil, fmt.Errorf("invalid manifest filename %q", filename)
+		}
+		m[filename] = sum
+	}
+	return m, scanner.Err()
+}
+
+// mountChecksums returns the SHA-256 checksums of all files in the mounts of
+// mod, keyed by the slash separated filename relative to the module root.
+// Files not matching the includeFiles and excludeFiles of a mount are not
+// mounted and are skipped.
+func mountChecksums(fs afero.Fs, mod Module) (map[string]string, error) {
+	sums := make(map[string]string)
+	dir := mod.Dir()
+
+	for _, mnt := range mod.Mounts() {
+		sourceDir := mnt.Source
+		if !filepath.IsAbs(sourceDir) {
+			sourceDir = filepath.Join(dir, sourceDir)
+		}
+
+		filter, err := glob.NewFilenameFilter(
+			types.ToStringSlicePreserveString(mnt.IncludeFiles),
+			types.ToStringSlicePreserveString(mnt.ExcludeFiles),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		err = afero.Walk(fs, sourceDir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				if path == sourceDir && herrors.IsNotExist(err) {
+					// Mounts of the default component folders may not exist.
+					// Any files listed in a manifest are reported as missing.
+					return nil
+				}
+				return err
+			}
+			if info.IsDir() {
+				return nil
+			}
+
+			// The filter matches filenames relative to the mount source.
+			if !filter.Match(strings.TrimPrefix(path, sourceDir), false) {
+				return nil
+			}
+
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+
+			f, err := fs.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			h := sha256.New()
+			if _, err := io.Copy(h, f); err != nil {
+				return err
+			}
+			sums[filepath.ToSlash(rel)] = hex.EncodeToString(h.Sum(nil))
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sums, nil
+}
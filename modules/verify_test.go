@@ -0,0 +1,141 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"path/filepath"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/spf13/afero"
+)
+
+func TestVerifyContent(t *testing.T) {
+	c := qt.New(t)
+
+	key := ed25519.NewKeyFromSeed(bytes.Repeat([]byte{1}, ed25519.SeedSize))
+	otherKey := ed25519.NewKeyFromSeed(bytes.Repeat([]byte{2}, ed25519.SeedSize))
+	publicKey := func(k ed25519.PrivateKey) string {
+		return base64.StdEncoding.EncodeToString(k.Public().(ed25519.PublicKey))
+	}
+
+	dir := filepath.FromSlash("/themes/mymod")
+
+	setup := func() (afero.Fs, *moduleAdapter) {
+		fs := afero.NewMemMapFs()
+		c.Assert(afero.WriteFile(fs, filepath.Join(dir, "content", "p1.md"), []byte("p1"), 0o666), qt.IsNil)
+		c.Assert(afero.WriteFile(fs, filepath.Join(dir, "content", "sub", "p2.md"), []byte("p2"), 0o666), qt.IsNil)
+		c.Assert(afero.WriteFile(fs, filepath.Join(dir, "layouts", "index.html"), []byte("home"), 0o666), qt.IsNil)
+		c.Assert(afero.WriteFile(fs, filepath.Join(dir, "assets", "css", "main.css"), []byte("body{}"), 0o666), qt.IsNil)
+		// Not mounted, so not verified.
+		c.Assert(afero.WriteFile(fs, filepath.Join(dir, "README.md"), []byte("readme"), 0o666), qt.IsNil)
+
+		mod := &moduleAdapter{
+			path: "github.com/example/mymod",
+			dir:  dir,
+			mounts: []Mount{
+				{Source: "content", Target: "content"},
+				{Source: "layouts", Target: "layouts"},
+				{Source: "assets", Target: "assets"},
+				{Source: "static", Target: "static"},
+			},
+		}
+
+		manifest, err := CreateContentManifest(fs, mod)
+		c.Assert(err, qt.IsNil)
+		c.Assert(string(manifest), qt.Contains, "  content/sub/p2.md\n")
+		c.Assert(string(manifest), qt.Contains, "  layouts/index.html\n")
+		c.Assert(string(manifest), qt.Contains, "  assets/css/main.css\n")
+		c.Assert(string(manifest), qt.Not(qt.Contains), "README.md")
+		c.Assert(afero.WriteFile(fs, filepath.Join(dir, DefaultContentManifestFilename), manifest, 0o666), qt.IsNil)
+		c.Assert(afero.WriteFile(fs, filepath.Join(dir, DefaultContentManifestFilename+".sig"), SignContentManifest(key, manifest), 0o666), qt.IsNil)
+
+		return fs, mod
+	}
+
+	cfg := VerifyConfig{Keys: []string{publicKey(otherKey), publicKey(key)}}
+
+	c.Run("Valid", func(c *qt.C) {
+		fs, mod := setup()
+		c.Assert(VerifyContent(fs, mod, cfg), qt.IsNil)
+		// Changes outside of the mounts are allowed.
+		c.Assert(afero.WriteFile(fs, filepath.Join(dir, "README.md"), []byte("changed"), 0o666), qt.IsNil)
+		c.Assert(VerifyContent(fs, mod, cfg), qt.IsNil)
+	})
+
+	c.Run("Modified layout", func(c *qt.C) {
+		fs, mod := setup()
+		c.Assert(afero.WriteFile(fs, filepath.Join(dir, "layouts", "index.html"), []byte("changed"), 0o666), qt.IsNil)
+		c.Assert(VerifyContent(fs, mod, cfg), qt.ErrorMatches, `.*checksum mismatch for "layouts/index.html"`)
+	})
+
+	c.Run("Added static file", func(c *qt.C) {
+		fs, mod := setup()
+		c.Assert(afero.WriteFile(fs, filepath.Join(dir, "static", "robots.txt"), []byte("robots"), 0o666), qt.IsNil)
+		c.Assert(VerifyContent(fs, mod, cfg), qt.ErrorMatches, `.*"static/robots.txt" is not listed in the manifest`)
+	})
+
+	c.Run("Missing file", func(c *qt.C) {
+		fs, mod := setup()
+		c.Assert(fs.Remove(filepath.Join(dir, "content", "p1.md")), qt.IsNil)
+		c.Assert(VerifyContent(fs, mod, cfg), qt.ErrorMatches, `.*"content/p1.md" is listed in the manifest but missing`)
+	})
+
+	c.Run("Missing directory", func(c *qt.C) {
+		fs, mod := setup()
+		c.Assert(fs.RemoveAll(filepath.Join(dir, "assets")), qt.IsNil)
+		c.Assert(VerifyContent(fs, mod, cfg), qt.ErrorMatches, `.*"assets/css/main.css" is listed in the manifest but missing`)
+	})
+
+	c.Run("Modified file", func(c *qt.C) {
+		fs, mod := setup()
+		c.Assert(afero.WriteFile(fs, filepath.Join(dir, "content", "p1.md"), []byte("changed"), 0o666), qt.IsNil)
+		c.Assert(VerifyContent(fs, mod, cfg), qt.ErrorMatches, `.*checksum mismatch for "content/p1.md"`)
+	})
+
+	c.Run("Added file", func(c *qt.C) {
+		fs, mod := setup()
+		c.Assert(afero.WriteFile(fs, filepath.Join(dir, "content", "p3.md"), []byte("p3"), 0o666), qt.IsNil)
+		c.Assert(VerifyContent(fs, mod, cfg), qt.ErrorMatches, `.*"content/p3.md" is not listed in the manifest`)
+	})
+
+	c.Run("Untrusted key", func(c *qt.C) {
+		fs, mod := setup()
+		c.Assert(VerifyContent(fs, mod, VerifyConfig{Keys: []string{publicKey(otherKey)}}), qt.ErrorMatches, ".*does not match any of the trusted keys")
+	})
+
+	c.Run("Tampered manifest", func(c *qt.C) {
+		fs, mod := setup()
+		c.Assert(afero.WriteFile(fs, filepath.Join(dir, "content", "p1.md"), []byte("changed"), 0o666), qt.IsNil)
+		manifest, err := CreateContentManifest(fs, mod)
+		c.Assert(err, qt.IsNil)
+		c.Assert(afero.WriteFile(fs, filepath.Join(dir, DefaultContentManifestFilename), manifest, 0o666), qt.IsNil)
+		c.Assert(VerifyContent(fs, mod, cfg), qt.ErrorMatches, ".*does not match any of the trusted keys")
+	})
+
+	c.Run("Missing manifest", func(c *qt.C) {
+		fs, mod := setup()
+		c.Assert(fs.Remove(filepath.Join(dir, DefaultContentManifestFilename)), qt.IsNil)
+		c.Assert(VerifyContent(fs, mod, cfg), qt.ErrorMatches, ".*failed to read manifest.*")
+	})
+
+	c.Run("No keys", func(c *qt.C) {
+		fs, mod := setup()
+		c.Assert(VerifyContent(fs, mod, VerifyConfig{}), qt.ErrorMatches, ".*module.verify.keys must be set.*")
+		c.Assert(VerifyContent(fs, mod, VerifyConfig{Keys: []string{"foo"}}), qt.ErrorMatches, ".*invalid Ed25519 public key.*")
+	})
+}
@@ -37,6 +37,16 @@ var defaultOutputFormat = Format{
 	Rel:      "alternate",
 }
 
+// userReplaceableFormats are built-in output formats with names that sites
+// commonly have defined themselves before they were added to Hugo.
+// A user defined format with one of these names replaces the built-in format
+// instead of being merged with it.
+var userReplaceableFormats = map[string]bool{
+	AtomFormat.Name:     true,
+	JSONFeedFormat.Name: true,
+	PrintFormat.Name:    true,
+}
+
 func DecodeConfig(mediaTypes media.Types, in any) (*config.ConfigNamespace[map[string]OutputFormatConfig, Formats], error) {
 	buildConfig := func(in any) (Formats, any, error) {
 		f := make(Formats, len(DefaultFormats))
@@ -53,6 +63,10 @@ func DecodeConfig(mediaTypes media.Types, in any) (*config.ConfigNamespace[map[s
 				for i, vv := range f {
 					// Both are lower case.
 					if k == vv.Name {
+						if userReplaceableFormats[k] {
+							f[i] = defaultOutputFormat
+							f[i].Name = k
+						}
 						// Merge it with the existing
 						if err := decode(mediaTypes, v, &f[i]); err != nil {
 							return f, nil, err
@@ -49,6 +49,25 @@ func TestDecodeConfig(t *testing.T) {
 				c.Assert(json.IsPlainText, qt.Equals, false)
 			},
 		},
+		{
+			"Define print",
+			map[string]any{
+				"print": map[string]any{
+					"mediaType": "application/json",
+					"path":      "print",
+				},
+			},
+			false,
+			func(t *testing.T, name string, f Formats) {
+				c.Assert(len(f), qt.Equals, len(DefaultFormats))
+				pf, _ := f.GetByName("print")
+				c.Assert(pf.BaseName, qt.Equals, "index")
+				c.Assert(pf.Path, qt.Equals, "print")
+				c.Assert(pf.MediaType, qt.Equals, media.Builtin.JSONType)
+				c.Assert(pf.IsHTML, qt.Equals, false)
+				c.Assert(pf.NoUgly, qt.Equals, false)
+			},
+		},
 		{
 			"Add XML format with string as mediatype",
 			map[string]any{
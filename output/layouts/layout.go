@@ -208,13 +208,23 @@ func resolvePageTemplate(d LayoutDescriptor) []string {
 
 	layouts := b.resolveVariations()
 
-	if !d.RenderingHook && !d.Baseof && isRSS {
-		layouts = append(layouts, "_internal/_default/rss.xml")
+	if !d.RenderingHook && !d.Baseof {
+		if isRSS {
+			layouts = append(layouts, "_internal/_default/rss.xml")
+		} else if t, found := internalFeedTemplates[strings.ToLower(d.OutputFormatName)]; found {
+			layouts = append(layouts, t)
+		}
 	}
 
 	return layouts
 }
 
+// The internal templates for the built-in feed output formats other than RSS.
+var internalFeedTemplates = map[string]string{
+	"atom":     "_internal/_default/atom.xml",
+	"jsonfeed": "_internal/_default/feed.json",
+}
+
 func (l *layoutBuilder) resolveVariations() []string {
 	var layouts []string
 
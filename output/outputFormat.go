@@ -167,6 +167,64 @@ var (
 		Rel:       "alternate",
 	}
 
+	AtomFormat = Format{
+		Name:      "atom",
+		MediaType: media.Builtin.AtomType,
+		BaseName:  "atom",
+		NoUgly:    true,
+		Rel:       "alternate",
+	}
+
+	JSONFeedFormat = Format{
+		Name:        "jsonfeed",
+		MediaType:   media.Builtin.JSONFeedType,
+		BaseName:    "feed",
+		IsPlainText: true,
+		NoUgly:      true,
+		Rel:         "alternate",
+	}
+
+	// LLMSFormat and LLMSFullFormat are rendered without templates, see the
+	// llmsTxt config.
+	LLMSFormat = Format{
+		Name:           "llms",
+		MediaType:      media.Builtin.TextType,
+		BaseName:       "llms",
+		IsPlainText:    true,
+		NotAlternative: true,
+		Rel:            "alternate",
+	}
+
+	LLMSFullFormat = Format{
+		Name:           "llmsfull",
+		MediaType:      media.Builtin.TextType,
+		BaseName:       "llms-full",
+		IsPlainText:    true,
+		NotAlternative: true,
+		Rel:            "alternate",
+	}
+
+	// PrintFormat is a print friendly version of a page, which can also be
+	// rendered to PDF, see the pdf config.
+	PrintFormat = Format{
+		Name:      "print",
+		MediaType: media.Builtin.HTMLType,
+		BaseName:  "print",
+		IsHTML:    true,
+		NoUgly:    true,
+		Rel:       "alternate",
+	}
+
+	// SearchIndexFormat is rendered without templates, see the searchIndex config.
+	SearchIndexFormat = Format{
+		Name:           "searchindex",
+		MediaType:      media.Builtin.JSONType,
+		BaseName:       "searchindex",
+		IsPlainText:    true,
+		NotAlternative: true,
+		Rel:            "alternate",
+	}
+
 	SitemapFormat = Format{
 		Name:      "sitemap",
 		MediaType: media.Builtin.XMLType,
@@ -179,15 +237,21 @@ var (
 // DefaultFormats contains the default output formats supported by Hugo.
 var DefaultFormats = Formats{
 	AMPFormat,
+	AtomFormat,
 	CalendarFormat,
 	CSSFormat,
 	CSVFormat,
 	HTMLFormat,
 	JSONFormat,
+	JSONFeedFormat,
+	LLMSFormat,
+	LLMSFullFormat,
 	MarkdownFormat,
 	WebAppManifestFormat,
+	PrintFormat,
 	RobotsTxtFormat,
 	RSSFormat,
+	SearchIndexFormat,
 	SitemapFormat,
 }
 
@@ -68,7 +68,17 @@ func TestDefaultTypes(t *testing.T) {
 	c.Assert(RSSFormat.NoUgly, qt.Equals, true)
 	c.Assert(CalendarFormat.IsHTML, qt.Equals, false)
 
-	c.Assert(len(DefaultFormats), qt.Equals, 11)
+	c.Assert(AtomFormat.MediaType, qt.Equals, media.Builtin.AtomType)
+	c.Assert(AtomFormat.BaseName, qt.Equals, "atom")
+	c.Assert(JSONFeedFormat.MediaType, qt.Equals, media.Builtin.JSONFeedType)
+	c.Assert(JSONFeedFormat.BaseName, qt.Equals, "feed")
+	c.Assert(JSONFeedFormat.IsPlainText, qt.Equals, true)
+
+	c.Assert(PrintFormat.MediaType, qt.Equals, media.Builtin.HTMLType)
+	c.Assert(PrintFormat.IsHTML, qt.Equals, true)
+	c.Assert(PrintFormat.BaseName, qt.Equals, "print")
+
+	c.Assert(len(DefaultFormats), qt.Equals, 17)
 
 }
 
@@ -0,0 +1,178 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pdf renders published HTML, typically the print output format,
+// to PDF using an external renderer.
+package pdf
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/hexec"
+	"github.com/gohugoio/hugo/common/hexternal"
+	"github.com/gohugoio/hugo/config"
+)
+
+const pdfConfigKey = "pdf"
+
+// Config configures the PDF rendering of the published HTML.
+// It is enabled when either Command or URL is set.
+//
+// The Formats default to ["print"], and the PDF is published next to the
+// HTML file, e.g. /docs/intro/print.pdf.
+//
+// A Command, e.g. ["weasyprint", "-", "-"], receives the HTML on stdin and
+// must write the PDF to stdout. The page settings are passed in the
+// HUGO_PDF_PAGE_SIZE, HUGO_PDF_MARGIN, HUGO_PDF_HEADER and HUGO_PDF_FOOTER
+// environment variables.
+//
+// A service at URL receives the HTML in the body of a POST request and must
+// respond with the PDF. The page settings are passed in the
+// X-Hugo-Pdf-Page-Size, X-Hugo-Pdf-Margin, X-Hugo-Pdf-Header and
+// X-Hugo-Pdf-Footer headers.
+type Config struct {
+	hexternal.Config `mapstructure:",squash"`
+
+	// The page size, e.g. "A4" or "letter landscape".
+	PageSize string
+
+	// The page margin, e.g. "2cm".
+	Margin string
+
+	// The running page header and footer text.
+	// Use {page} and {pages} for the page number and the number of pages.
+	Header string
+	Footer string
+}
+
+func newDefaultConfig() Config {
+	return Config{
+		Config: hexternal.Config{
+			Formats: []string{"print"},
+			Timeout: "60s",
+		},
+	}
+}
+
+// DecodeConfig creates a Config from a given Hugo configuration.
+func DecodeConfig(cfg config.Provider) (Config, error) {
+	c := newDefaultConfig()
+	err := hexternal.DecodeConfig(cfg, pdfConfigKey, &c, &c.Config)
+	return c, err
+}
+
+// Renderer renders HTML to PDF.
+type Renderer struct {
+	*hexternal.Runner
+	conf Config
+}
+
+// New creates a new Renderer for the given config.
+// It returns nil if PDF rendering is not enabled.
+func New(conf Config, exec *hexec.Exec) (*Renderer, error) {
+	runner, err := hexternal.NewRunner("PDF renderer", conf.Config, exec)
+	if err != nil || runner == nil {
+		return nil, err
+	}
+	return &Renderer{Runner: runner, conf: conf}, nil
+}
+
+// Render renders the HTML in src to PDF.
+func (r *Renderer) Render(src []byte) ([]byte, error) {
+	req := hexternal.Request{
+		Body:        injectPageCSS(src, r.conf),
+		ContentType: "text/html; charset=utf-8",
+		Header:      map[string]string{"Accept": "application/pdf"},
+	}
+
+	for _, s := range []struct{ name, value string }{
+		{"PAGE_SIZE", r.conf.PageSize},
+		{"MARGIN", r.conf.Margin},
+		{"HEADER", r.conf.Header},
+		{"FOOTER", r.conf.Footer},
+	} {
+		req.Env = append(req.Env, "HUGO_PDF_"+s.name+"="+s.value)
+		if s.value != "" {
+			req.Header["X-Hugo-Pdf-"+strings.ReplaceAll(s.name, "_", "-")] = s.value
+		}
+	}
+
+	return r.Run(req)
+}
+
+var headEndRe = regexp.MustCompile(`(?i)</head\s*>`)
+
+// injectPageCSS adds CSS paged media rules for the page settings in conf
+// to the head of the HTML document in src.
+func injectPageCSS(src []byte, conf Config) []byte {
+	var rules []string
+	if conf.PageSize != "" {
+		rules = append(rules, "size: "+cssValueCleaner.Replace(conf.PageSize)+";")
+	}
+	if conf.Margin != "" {
+		rules = append(rules, "margin: "+cssValueCleaner.Replace(conf.Margin)+";")
+	}
+	if conf.Header != "" {
+		rules = append(rules, "@top-center { content: "+cssContent(conf.Header)+"; }")
+	}
+	if conf.Footer != "" {
+		rules = append(rules, "@bottom-center { content: "+cssContent(conf.Footer)+"; }")
+	}
+	if len(rules) == 0 {
+		return src
+	}
+
+	style := []byte("<style>@page { " + strings.Join(rules, " ") + " }</style>")
+
+	loc := headEndRe.FindIndex(src)
+	if loc == nil {
+		return append(style, src...)
+	}
+
+	b := make([]byte, 0, len(src)+len(style))
+	b = append(b, src[:loc[0]]...)
+	b = append(b, style...)
+	return append(b, src[loc[0]:]...)
+}
+
+// Removes characters that could end the CSS declaration or the style element.
+var cssValueCleaner = strings.NewReplacer(";", "", "{", "", "}", "", "<", "")
+
+var cssCounterRe = regexp.MustCompile(`\{(page|pages)\}`)
+
+// cssContent returns s as a value for the CSS content property, with the
+// {page} and {pages} placeholders replaced with the page counters.
+func cssContent(s string) string {
+	quote := func(s string) string {
+		s = strings.ReplaceAll(s, `\`, `\\`)
+		s = strings.ReplaceAll(s, `"`, `\"`)
+		s = strings.ReplaceAll(s, "<", `\3c `)
+		return `"` + s + `"`
+	}
+
+	var parts []string
+	pos := 0
+	for _, loc := range cssCounterRe.FindAllStringSubmatchIndex(s, -1) {
+		if loc[0] > pos {
+			parts = append(parts, quote(s[pos:loc[0]]))
+		}
+		parts = append(parts, "counter("+s[loc[2]:loc[3]]+")")
+		pos = loc[1]
+	}
+	if pos < len(s) {
+		parts = append(parts, quote(s[pos:]))
+	}
+
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pdf
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/config"
+)
+
+func TestDecodeConfig(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := config.New()
+	conf, err := DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Enabled(), qt.IsFalse)
+	c.Assert(conf.Formats, qt.DeepEquals, []string{"print"})
+	c.Assert(conf.Timeout, qt.Equals, "60s")
+
+	cfg.Set("pdf", map[string]any{
+		"command":  []string{"weasyprint", "-", "-"},
+		"formats":  []string{"Print", "html"},
+		"pageSize": "A4",
+	})
+	conf, err = DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Enabled(), qt.IsTrue)
+	c.Assert(conf.Command, qt.DeepEquals, []string{"weasyprint", "-", "-"})
+	c.Assert(conf.Formats, qt.DeepEquals, []string{"print", "html"})
+	c.Assert(conf.PageSize, qt.Equals, "A4")
+
+	cfg = config.New()
+	cfg.Set("pdf", map[string]any{
+		"command": []string{"weasyprint", "-", "-"},
+		"url":     "http://localhost:3000/pdf",
+	})
+	_, err = DecodeConfig(cfg)
+	c.Assert(err, qt.ErrorMatches, "pdf: only one of command and url can be set")
+
+	cfg = config.New()
+	cfg.Set("pdf", map[string]any{
+		"timeout": "forever",
+	})
+	_, err = DecodeConfig(cfg)
+	c.Assert(err, qt.ErrorMatches, "pdf: failed to parse timeout.*")
+}
+
+func TestInjectPageCSS(t *testing.T) {
+	c := qt.New(t)
+
+	conf := Config{
+		PageSize: "A4 landscape",
+		Margin:   "2cm</style>",
+		Header:   `My "Docs"`,
+		Footer:   "Page {page} of {pages}",
+	}
+
+	c.Assert(string(injectPageCSS([]byte(`<html><head><title>T</title></HEAD><body></body></html>`), conf)), qt.Equals,
+		`<html><head><title>T</title><style>@page { size: A4 landscape; margin: 2cm/style>; @top-center { content: "My \"Docs\""; } @bottom-center { content: "Page " counter(page) " of " counter(pages); } }</style></HEAD><body></body></html>`)
+
+	c.Assert(string(injectPageCSS([]byte(`<p>No head</p>`), Config{PageSize: "letter"})), qt.Equals,
+		`<style>@page { size: letter; }</style><p>No head</p>`)
+
+	c.Assert(string(injectPageCSS([]byte(`<p>Nothing to do</p>`), Config{})), qt.Equals, `<p>Nothing to do</p>`)
+}
+
+func TestCSSContent(t *testing.T) {
+	c := qt.New(t)
+
+	c.Assert(cssContent("{page}"), qt.Equals, "counter(page)")
+	c.Assert(cssContent("{page}/{pages}"), qt.Equals, `counter(page) "/" counter(pages)`)
+	c.Assert(cssContent(`a\b<c`), qt.Equals, `"a\\b\3c c"`)
+	c.Assert(cssContent("{chapter}"), qt.Equals, `"{chapter}"`)
+}
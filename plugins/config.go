@@ -0,0 +1,117 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/mitchellh/mapstructure"
+)
+
+const (
+	// The function called in the WASM module if not set in the config.
+	defaultFunction = "transform"
+
+	defaultTimeout = 30 * time.Second
+
+	// 64 KiB pages, 64 MiB in total.
+	defaultMemoryMaxPages = 1024
+)
+
+// Config holds the resource transformation plugins keyed by their lower case
+// name, e.g. "images.dither".
+// Plugins can be defined in the project and in any of its modules.
+type Config map[string]PluginConfig
+
+// PluginConfig configures a resource transformation plugin.
+type PluginConfig struct {
+	// The path to the WASM module, relative to the assets filesystem,
+	// e.g. "plugins/dither.wasm".
+	Source string
+
+	// The exported function to call. Defaults to "transform".
+	// The function receives the resource content as input and sets the
+	// transformed content as output using the Extism PDK.
+	Function string
+
+	// The media types the plugin accepts, e.g. ["image/png", "image/jpeg"].
+	// Empty means all.
+	MediaTypes []string
+
+	// The media type of the transformed resource. Defaults to the media type
+	// of the input.
+	OutMediaType string
+
+	// Configuration passed to the plugin, readable using the Extism PDK.
+	// Options passed to the template function are merged on top of these.
+	Config map[string]string
+
+	// The maximum time a call to the plugin may take. Defaults to 30s.
+	Timeout time.Duration
+
+	// The maximum number of 64 KiB memory pages the plugin may use.
+	// Defaults to 1024 (64 MiB).
+	MemoryMaxPages uint32
+}
+
+// DecodeConfig creates a Config from the given input.
+func DecodeConfig(in any) (Config, error) {
+	conf := make(Config)
+	if in == nil {
+		return conf, nil
+	}
+
+	m, err := maps.ToStringMapE(in)
+	if err != nil {
+		return conf, err
+	}
+
+	for k, v := range m {
+		if k == maps.MergeStrategyKey {
+			continue
+		}
+
+		pc := PluginConfig{
+			Function:       defaultFunction,
+			Timeout:        defaultTimeout,
+			MemoryMaxPages: defaultMemoryMaxPages,
+		}
+
+		dc := &mapstructure.DecoderConfig{
+			Result:           &pc,
+			WeaklyTypedInput: true,
+			DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+		}
+		decoder, err := mapstructure.NewDecoder(dc)
+		if err != nil {
+			return conf, err
+		}
+		if err := decoder.Decode(v); err != nil {
+			return conf, fmt.Errorf("failed to decode plugin %q: %w", k, err)
+		}
+
+		delete(pc.Config, maps.MergeStrategyKey)
+
+		if pc.Source == "" {
+			return conf, fmt.Errorf("plugin %q: source must be set", k)
+		}
+
+		conf[strings.ToLower(k)] = pc
+	}
+
+	return conf, nil
+}
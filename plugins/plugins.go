@@ -0,0 +1,214 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package plugins runs resource transformation plugins compiled to WASM
+// using the Extism plugin system, see https://extism.org.
+package plugins
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	extism "github.com/extism/go-sdk"
+	"github.com/gohugoio/hugo/common/herrors"
+	"github.com/gohugoio/hugo/helpers"
+	"github.com/spf13/afero"
+	"github.com/tetratelabs/wazero"
+)
+
+// Client runs the plugins defined in Config.
+type Client struct {
+	conf Config
+	fs   afero.Fs
+
+	// Shared by all plugins, so a WASM module is only compiled once.
+	cache wazero.CompilationCache
+
+	mu      sync.Mutex
+	plugins map[string]*Plugin
+}
+
+// New creates a new Client for the plugins in conf, reading the WASM modules
+// from fs.
+func New(conf Config, fs afero.Fs) *Client {
+	return &Client{
+		conf:    conf,
+		fs:      fs,
+		cache:   wazero.NewCompilationCache(),
+		plugins: make(map[string]*Plugin),
+	}
+}
+
+// Get returns the plugin with the given name.
+func (c *Client) Get(name string) (*Plugin, error) {
+	name = strings.ToLower(name)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if p, found := c.plugins[name]; found {
+		return p, nil
+	}
+
+	conf, found := c.conf[name]
+	if !found {
+		return nil, fmt.Errorf("plugin %q not found in config", name)
+	}
+
+	wasm, err := afero.ReadFile(c.fs, conf.Source)
+	if err != nil {
+		if herrors.IsNotExist(err) {
+			return nil, fmt.Errorf("plugin %q: source %q not found in assets", name, conf.Source)
+		}
+		return nil, err
+	}
+
+	hash, err := helpers.MD5FromReader(bytes.NewReader(wasm))
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Plugin{
+		name:  name,
+		conf:  conf,
+		wasm:  wasm,
+		hash:  hash,
+		cache: c.cache,
+	}
+	c.plugins[name] = p
+
+	return p, nil
+}
+
+// Close closes all the plugin instances.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ctx := context.Background()
+	for _, p := range c.plugins {
+		p.mu.Lock()
+		p.close(ctx)
+		p.mu.Unlock()
+	}
+	c.plugins = make(map[string]*Plugin)
+
+	err := c.cache.Close(ctx)
+	c.cache = wazero.NewCompilationCache()
+
+	return err
+}
+
+// Plugin is a resource transformation plugin.
+type Plugin struct {
+	name  string
+	conf  PluginConfig
+	wasm  []byte
+	hash  string
+	cache wazero.CompilationCache
+
+	// Extism plugin instances are not safe for concurrent use.
+	mu       sync.Mutex
+	instance *extism.Plugin
+}
+
+// Name returns the lower case name of the plugin.
+func (p *Plugin) Name() string {
+	return p.name
+}
+
+// Config returns the plugin configuration.
+func (p *Plugin) Config() PluginConfig {
+	return p.conf
+}
+
+// Hash returns a hash of the WASM module.
+func (p *Plugin) Hash() string {
+	return p.hash
+}
+
+// Call calls the plugin function with input and returns its output.
+// The options are merged on top of the configured plugin config.
+//
+// The plugin runs in a sandbox without access to the filesystem or the
+// network, limited by the configured timeout and memory.
+func (p *Plugin) Call(ctx context.Context, input []byte, options map[string]string) ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.instance == nil {
+		if err := p.init(ctx); err != nil {
+			return nil, fmt.Errorf("plugin %q: failed to create instance: %w", p.name, err)
+		}
+	}
+
+	config := make(map[string]string, len(p.conf.Config)+len(options))
+	for k, v := range p.conf.Config {
+		config[k] = v
+	}
+	for k, v := range options {
+		config[k] = v
+	}
+	p.instance.Config = config
+
+	_, output, err := p.instance.CallWithContext(ctx, p.conf.Function, input)
+	if err != nil {
+		// The instance may be left in a bad state, e.g. after a timeout.
+		p.close(ctx)
+		return nil, fmt.Errorf("plugin %q: %w", p.name, err)
+	}
+
+	return output, nil
+}
+
+func (p *Plugin) init(ctx context.Context) error {
+	manifest := extism.Manifest{
+		Wasm: []extism.Wasm{
+			extism.WasmData{Data: p.wasm, Name: "main"},
+		},
+		Memory: &extism.ManifestMemory{
+			MaxPages: p.conf.MemoryMaxPages,
+			// Use the Extism defaults.
+			MaxHttpResponseBytes: -1,
+			MaxVarBytes:          -1,
+		},
+		Timeout: uint64(p.conf.Timeout.Milliseconds()),
+	}
+
+	instance, err := extism.NewPlugin(ctx, manifest, extism.PluginConfig{
+		RuntimeConfig: wazero.NewRuntimeConfig().WithCompilationCache(p.cache),
+		EnableWasi:    true,
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	if !instance.FunctionExists(p.conf.Function) {
+		instance.Close()
+		return fmt.Errorf("function %q not exported", p.conf.Function)
+	}
+
+	p.instance = instance
+
+	return nil
+}
+
+func (p *Plugin) close(ctx context.Context) {
+	if p.instance != nil {
+		p.instance.CloseWithContext(ctx)
+		p.instance = nil
+	}
+}
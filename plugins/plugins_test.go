@@ -0,0 +1,92 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/spf13/afero"
+)
+
+func TestDecodeConfig(t *testing.T) {
+	c := qt.New(t)
+
+	conf, err := DecodeConfig(map[string]any{
+		"images.Dither": map[string]any{
+			"source":     "plugins/dither.wasm",
+			"mediaTypes": []string{"image/png"},
+			"timeout":    "5s",
+			"config":     map[string]any{"levels": 4, "_merge": "shallow"},
+		},
+		"_merge": "shallow",
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf, qt.HasLen, 1)
+
+	pc := conf["images.dither"]
+	c.Assert(pc.Source, qt.Equals, "plugins/dither.wasm")
+	c.Assert(pc.Function, qt.Equals, "transform")
+	c.Assert(pc.MediaTypes, qt.DeepEquals, []string{"image/png"})
+	c.Assert(pc.Timeout, qt.Equals, 5*time.Second)
+	c.Assert(pc.MemoryMaxPages, qt.Equals, uint32(1024))
+	c.Assert(pc.Config, qt.DeepEquals, map[string]string{"levels": "4"})
+
+	_, err = DecodeConfig(map[string]any{"foo": map[string]any{}})
+	c.Assert(err, qt.ErrorMatches, `plugin "foo": source must be set`)
+}
+
+func TestPluginCall(t *testing.T) {
+	c := qt.New(t)
+
+	fs := afero.NewBasePathFs(afero.NewOsFs(), "testdata")
+	client := New(Config{
+		"text.upper": {Source: "upper.wasm", Function: "upper", Timeout: defaultTimeout, MemoryMaxPages: defaultMemoryMaxPages},
+		"text.fail":  {Source: "upper.wasm", Function: "fail", Timeout: defaultTimeout, MemoryMaxPages: defaultMemoryMaxPages},
+		"text.nope":  {Source: "upper.wasm", Function: "nope", Timeout: defaultTimeout, MemoryMaxPages: defaultMemoryMaxPages},
+		"text.gone":  {Source: "gone.wasm", Function: "upper"},
+	}, fs)
+	defer client.Close()
+
+	ctx := context.Background()
+
+	p, err := client.Get("Text.Upper")
+	c.Assert(err, qt.IsNil)
+	c.Assert(p.Name(), qt.Equals, "text.upper")
+	c.Assert(p.Hash(), qt.Not(qt.Equals), "")
+
+	for i := 0; i < 2; i++ {
+		out, err := p.Call(ctx, []byte("Hello, World!"), map[string]string{"a": "b"})
+		c.Assert(err, qt.IsNil)
+		c.Assert(string(out), qt.Equals, "HELLO, WORLD!")
+	}
+
+	p, err = client.Get("text.fail")
+	c.Assert(err, qt.IsNil)
+	_, err = p.Call(ctx, []byte("a"), nil)
+	c.Assert(err, qt.ErrorMatches, `plugin "text.fail": .*fail`)
+
+	p, err = client.Get("text.nope")
+	c.Assert(err, qt.IsNil)
+	_, err = p.Call(ctx, []byte("a"), nil)
+	c.Assert(err, qt.ErrorMatches, `.*function "nope" not exported`)
+
+	_, err = client.Get("text.gone")
+	c.Assert(err, qt.ErrorMatches, `plugin "text.gone": source "gone.wasm" not found in assets`)
+
+	_, err = client.Get("text.missing")
+	c.Assert(err, qt.ErrorMatches, `plugin "text.missing" not found in config`)
+}
@@ -0,0 +1,28 @@
+;; A minimal Extism plugin used in the tests.
+;; upper: writes the input to the output with ASCII letters upper cased.
+;; fail:  returns a non-zero exit code.
+(module
+  (import "extism:host/env" "input_length" (func $input_length (result i64)))
+  (import "extism:host/env" "input_load_u8" (func $input_load_u8 (param i64) (result i32)))
+  (import "extism:host/env" "alloc" (func $alloc (param i64) (result i64)))
+  (import "extism:host/env" "store_u8" (func $store_u8 (param i64 i32)))
+  (import "extism:host/env" "output_set" (func $output_set (param i64 i64)))
+
+  (func (export "upper") (result i32)
+    (local $len i64) (local $out i64) (local $i i64) (local $b i32)
+    (local.set $len (call $input_length))
+    (local.set $out (call $alloc (local.get $len)))
+    (block
+      (loop
+        (br_if 1 (i64.ge_u (local.get $i) (local.get $len)))
+        (local.set $b (call $input_load_u8 (local.get $i)))
+        (if (i32.lt_u (i32.sub (local.get $b) (i32.const 97)) (i32.const 26))
+          (then (local.set $b (i32.sub (local.get $b) (i32.const 32)))))
+        (call $store_u8 (i64.add (local.get $out) (local.get $i)) (local.get $b))
+        (local.set $i (i64.add (local.get $i) (i64.const 1)))
+        (br 0)))
+    (call $output_set (local.get $out) (local.get $len))
+    (i32.const 0))
+
+  (func (export "fail") (result i32)
+    (i32.const 1)))
@@ -63,6 +63,13 @@ type Descriptor struct {
 	// If set, will replace all relative URLs with this one.
 	AbsURLPath string
 
+	// If set, will be applied to HTML output to rewrite external links.
+	ExternalLinks transform.Transformer
+
+	// If set, will be applied to HTML output to inject a skip link and
+	// landmark roles.
+	Accessibility transform.Transformer
+
 	// Enable to minify the output using the OutputFormat defined above to
 	// pick the correct minifier configuration.
 	Minify bool
@@ -168,6 +175,14 @@ func (p DestinationPublisher) createTransformerChain(f Descriptor) transform.Cha
 	}
 
 	if isHTML {
+		if f.ExternalLinks != nil {
+			transformers = append(transformers, f.ExternalLinks)
+		}
+
+		if f.Accessibility != nil {
+			transformers = append(transformers, f.Accessibility)
+		}
+
 		if f.LiveReloadBaseURL != nil {
 			transformers = append(transformers, livereloadinject.New(*f.LiveReloadBaseURL))
 		}
@@ -179,7 +194,7 @@ func (p DestinationPublisher) createTransformerChain(f Descriptor) transform.Cha
 
 	}
 
-	if p.min.MinifyOutput {
+	if p.min.MinifyOutput && !p.min.Excluded(f.TargetPath) {
 		minifyTransformer := p.min.Transformer(f.OutputFormat.MediaType)
 		if minifyTransformer != nil {
 			transformers = append(transformers, minifyTransformer)
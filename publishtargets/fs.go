@@ -0,0 +1,107 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package publishtargets
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/gohugoio/hugo/hugofs"
+	"github.com/spf13/afero"
+)
+
+var _ hugofs.FilesystemUnwrapper = (*Fs)(nil)
+
+// NewFs creates a filesystem that reads and writes the files of each target
+// in the filesystem in fss with the target's name, and the other files in fs.
+func NewFs(fs afero.Fs, fss map[string]afero.Fs, r *Router) *Fs {
+	return &Fs{Fs: fs, fss: fss, r: r}
+}
+
+// Fs routes the published files to the filesystems of their targets.
+type Fs struct {
+	// The default target.
+	afero.Fs
+
+	fss map[string]afero.Fs
+	r   *Router
+}
+
+func (fs *Fs) UnwrapFilesystem() afero.Fs {
+	return fs.Fs
+}
+
+func (fs *Fs) route(name string) afero.Fs {
+	if tfs, found := fs.fss[fs.r.Target(name)]; found {
+		return tfs
+	}
+	return fs.Fs
+}
+
+func (fs *Fs) Create(name string) (afero.File, error) {
+	return fs.route(name).Create(name)
+}
+
+func (fs *Fs) Mkdir(name string, perm os.FileMode) error {
+	return fs.route(name).Mkdir(name, perm)
+}
+
+func (fs *Fs) MkdirAll(path string, perm os.FileMode) error {
+	return fs.route(path).MkdirAll(path, perm)
+}
+
+func (fs *Fs) Open(name string) (afero.File, error) {
+	return fs.route(name).Open(name)
+}
+
+func (fs *Fs) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	return fs.route(name).OpenFile(name, flag, perm)
+}
+
+func (fs *Fs) Remove(name string) error {
+	return fs.route(name).Remove(name)
+}
+
+func (fs *Fs) RemoveAll(path string) error {
+	return fs.route(path).RemoveAll(path)
+}
+
+func (fs *Fs) Rename(oldname, newname string) error {
+	ofs := fs.route(oldname)
+	if ofs != fs.route(newname) {
+		return &os.LinkError{Op: "rename", Old: oldname, New: newname, Err: errors.New("cannot move files between publish targets")}
+	}
+	return ofs.Rename(oldname, newname)
+}
+
+func (fs *Fs) Stat(name string) (os.FileInfo, error) {
+	return fs.route(name).Stat(name)
+}
+
+func (fs *Fs) Name() string {
+	return "publishTargetsFs"
+}
+
+func (fs *Fs) Chmod(name string, mode os.FileMode) error {
+	return fs.route(name).Chmod(name, mode)
+}
+
+func (fs *Fs) Chown(name string, uid, gid int) error {
+	return fs.route(name).Chown(name, uid, gid)
+}
+
+func (fs *Fs) Chtimes(name string, atime, mtime time.Time) error {
+	return fs.route(name).Chtimes(name, atime, mtime)
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package publishtargets_test
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+	"github.com/spf13/afero"
+)
+
+const publishTargetsFiles = `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT", "404"]
+[publishTargets.internal]
+dir = "public-internal"
+sections = ["internal"]
+[publishTargets.partners]
+dir = "public-partners"
+sections = ["partners"]
+linksTo = ["internal"]
+-- layouts/_default/single.html --
+{{ .Title }}|{{ .Content }}|{{ range .Resources }}{{ .RelPermalink }}{{ end }}
+-- layouts/_default/list.html --
+List: {{ .Title }}
+-- content/docs/d1.md --
+---
+title: D1
+---
+[Internal](/internal/i1/)
+[Partners](/partners/p1/)
+-- content/internal/i1/index.md --
+---
+title: I1
+---
+[Docs](/docs/d1/)
+[Partners](/partners/p1/)
+-- content/internal/i1/data.txt --
+Data.
+-- content/partners/p1.md --
+---
+title: P1
+---
+[Internal](/internal/i1/)
+[Docs](/docs/d1/)
+`
+
+func TestPublishTargets(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: publishTargetsFiles,
+		},
+	).BuildE()
+
+	c.Assert(err, qt.ErrorMatches, "logged 3 error\\(s\\)")
+	b.AssertLogContains(`content/docs/d1.md:4:12": broken link "/internal/i1/": links to publish target "internal", which is not visible from "default"`)
+	b.AssertLogContains(`content/docs/d1.md:5:12": broken link "/partners/p1/": links to publish target "partners", which is not visible from "default"`)
+	b.AssertLogContains(`content/internal/i1/index.md:5:12": broken link "/partners/p1/": links to publish target "partners", which is not visible from "internal"`)
+
+	b.AssertFileContent("public/docs/d1/index.html", "D1|")
+	b.AssertFileContent("public-internal/internal/i1/index.html", "I1|", "/internal/i1/data.txt")
+	b.AssertFileContent("public-internal/internal/i1/data.txt", "Data.")
+	b.AssertFileContent("public-internal/internal/index.html", "List: Internals")
+	b.AssertFileContent("public-partners/partners/p1/index.html", "P1|")
+
+	for _, filename := range []string{
+		"public/internal/i1/index.html",
+		"public/internal/i1/data.txt",
+		"public/partners/p1/index.html",
+	} {
+		exists, err := afero.Exists(b.H.Fs.WorkingDirReadOnly, filename)
+		c.Assert(err, qt.IsNil)
+		c.Assert(exists, qt.IsFalse, qt.Commentf(filename))
+	}
+}
+
+func TestPublishTargetsPublishDir(t *testing.T) {
+	t.Parallel()
+
+	files := publishTargetsFiles + `
+-- config/_default/publishTargets.toml --
+[internal]
+dir = "public"
+`
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.ErrorMatches, `.*publishTargets.internal: dir "public" is the publishDir`)
+}
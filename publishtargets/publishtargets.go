@@ -0,0 +1,185 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package publishtargets publishes sections to separate publish directories,
+// e.g. to deploy internal docs to another bucket than the public site, and
+// holds the rules for which targets may link to each other.
+package publishtargets
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/config"
+	"github.com/mitchellh/mapstructure"
+)
+
+const publishTargetsConfigKey = "publishtargets"
+
+// DefaultTarget is the name of the target for the files not in any of the
+// configured targets, published to publishDir.
+const DefaultTarget = "default"
+
+// Target is a publish directory for a set of sections.
+type Target struct {
+	// The publish directory, relative to the working directory unless absolute.
+	Dir string
+
+	// The sections published to Dir, e.g. ["internal"] or ["docs/internal"].
+	Sections []string
+
+	// The other targets the pages in this target may link to.
+	// A page may always link to pages in its own and in the default target.
+	LinksTo []string
+}
+
+// Config holds the publish targets, keyed by their lower case name.
+type Config map[string]Target
+
+// DecodeConfig creates a Config from a given Hugo configuration.
+func DecodeConfig(cfg config.Provider) (Config, error) {
+	c := make(Config)
+
+	m := cfg.GetStringMap(publishTargetsConfigKey)
+	sections := make(map[string]string)
+	dirs := make(map[string]string)
+
+	for k, v := range m {
+		if k == maps.MergeStrategyKey {
+			continue
+		}
+		name := strings.ToLower(k)
+		if name == DefaultTarget {
+			return nil, fmt.Errorf("publishTargets: %q is reserved for the files not in any target", DefaultTarget)
+		}
+
+		var t Target
+		if err := mapstructure.WeakDecode(v, &t); err != nil {
+			return nil, fmt.Errorf("failed to decode publishTargets.%s: %w", k, err)
+		}
+		if t.Dir == "" {
+			return nil, fmt.Errorf("publishTargets.%s: dir must be set", k)
+		}
+		dir := filepath.Clean(t.Dir)
+		if other, found := dirs[dir]; found {
+			return nil, fmt.Errorf("publishTargets.%s: dir %q is also used by %q", k, t.Dir, other)
+		}
+		dirs[dir] = name
+		if len(t.Sections) == 0 {
+			return nil, fmt.Errorf("publishTargets.%s: sections must be set", k)
+		}
+		for i, s := range t.Sections {
+			s = strings.Trim(path.Clean("/"+strings.ToLower(s)), "/")
+			if s == "" {
+				return nil, fmt.Errorf("publishTargets.%s: invalid section %q", k, t.Sections[i])
+			}
+			if other, found := sections[s]; found {
+				return nil, fmt.Errorf("publishTargets.%s: section %q is also published to %q", k, s, other)
+			}
+			sections[s] = name
+			t.Sections[i] = s
+		}
+		for i, l := range t.LinksTo {
+			t.LinksTo[i] = strings.ToLower(l)
+		}
+		c[name] = t
+	}
+
+	for name, t := range c {
+		for _, l := range t.LinksTo {
+			if _, found := c[l]; !found && l != DefaultTarget {
+				return nil, fmt.Errorf("publishTargets.%s: linksTo: target %q not found", name, l)
+			}
+		}
+	}
+
+	return c, nil
+}
+
+type section struct {
+	path   string
+	target string
+}
+
+// Router maps the published files to their target.
+type Router struct {
+	conf      Config
+	sections  []section
+	languages map[string]bool
+}
+
+// NewRouter creates a new Router for conf.
+// languages are the language codes that may prefix the published paths,
+// e.g. "en/docs/index.html".
+func NewRouter(conf Config, languages []string) *Router {
+	r := &Router{conf: conf, languages: make(map[string]bool)}
+	for _, l := range languages {
+		r.languages[strings.ToLower(l)] = true
+	}
+	for name, t := range conf {
+		for _, s := range t.Sections {
+			r.sections = append(r.sections, section{path: s, target: name})
+		}
+	}
+	// Match the most specific section first.
+	sort.Slice(r.sections, func(i, j int) bool {
+		if len(r.sections[i].path) != len(r.sections[j].path) {
+			return len(r.sections[i].path) > len(r.sections[j].path)
+		}
+		return r.sections[i].path < r.sections[j].path
+	})
+	return r
+}
+
+// Target returns the name of the target of the file published to filename,
+// relative to the publish directory, or DefaultTarget.
+func (r *Router) Target(filename string) string {
+	p := strings.ToLower(strings.Trim(filepath.ToSlash(filename), "/"))
+	if first, rest, found := strings.Cut(p, "/"); found && r.languages[first] {
+		p = rest
+	}
+	for _, s := range r.sections {
+		if p == s.path || strings.HasPrefix(p, s.path+"/") {
+			return s.target
+		}
+	}
+	return DefaultTarget
+}
+
+// CanLink reports whether the pages in the target from may link to the pages
+// in the target to.
+func (r *Router) CanLink(from, to string) bool {
+	if from == to || to == DefaultTarget {
+		return true
+	}
+	for _, l := range r.conf[from].LinksTo {
+		if l == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Visibility returns why a link from the file published to from to the file
+// published to to is not allowed, or an empty string if it is.
+func (r *Router) Visibility(from, to string) string {
+	fromTarget, toTarget := r.Target(from), r.Target(to)
+	if r.CanLink(fromTarget, toTarget) {
+		return ""
+	}
+	return fmt.Sprintf("links to publish target %q, which is not visible from %q", toTarget, fromTarget)
+}
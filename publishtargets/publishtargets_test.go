@@ -0,0 +1,149 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package publishtargets
+
+import (
+	"path/filepath"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/config"
+	"github.com/spf13/afero"
+)
+
+func TestDecodeConfig(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := config.New()
+	conf, err := DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf, qt.HasLen, 0)
+
+	cfg.Set("publishTargets", map[string]any{
+		"Internal": map[string]any{
+			"dir":      "public-internal",
+			"sections": []string{"Internal", "/docs/internal/"},
+			"linksTo":  []string{"Partners"},
+		},
+		"partners": map[string]any{
+			"dir":      "public-partners",
+			"sections": []string{"partners"},
+		},
+	})
+	conf, err = DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf, qt.DeepEquals, Config{
+		"internal": {Dir: "public-internal", Sections: []string{"internal", "docs/internal"}, LinksTo: []string{"partners"}},
+		"partners": {Dir: "public-partners", Sections: []string{"partners"}},
+	})
+
+	for _, test := range []struct {
+		m      map[string]any
+		expect string
+	}{
+		{map[string]any{"default": map[string]any{"dir": "a", "sections": []string{"a"}}}, `publishTargets: "default" is reserved.*`},
+		{map[string]any{"a": map[string]any{"sections": []string{"a"}}}, "publishTargets.a: dir must be set"},
+		{map[string]any{"a": map[string]any{"dir": "a"}}, "publishTargets.a: sections must be set"},
+		{map[string]any{"a": map[string]any{"dir": "a", "sections": []string{"/"}}}, `publishTargets.a: invalid section "/"`},
+		{map[string]any{"a": map[string]any{"dir": "a", "sections": []string{"a"}, "linksTo": []string{"b"}}}, `publishTargets.a: linksTo: target "b" not found`},
+		{map[string]any{
+			"a": map[string]any{"dir": "a", "sections": []string{"s"}},
+			"b": map[string]any{"dir": "b", "sections": []string{"s"}},
+		}, `publishTargets.[ab]: section "s" is also published to "[ab]"`},
+		{map[string]any{
+			"a": map[string]any{"dir": "d", "sections": []string{"a"}},
+			"b": map[string]any{"dir": "d/", "sections": []string{"b"}},
+		}, `publishTargets.[ab]: dir .* is also used by "[ab]"`},
+	} {
+		cfg = config.New()
+		cfg.Set("publishTargets", test.m)
+		_, err = DecodeConfig(cfg)
+		c.Assert(err, qt.ErrorMatches, test.expect)
+	}
+}
+
+func newTestRouter() *Router {
+	return NewRouter(Config{
+		"internal": {Dir: "public-internal", Sections: []string{"internal", "docs/internal"}, LinksTo: []string{"partners"}},
+		"partners": {Dir: "public-partners", Sections: []string{"partners"}},
+	}, []string{"en", "nn"})
+}
+
+func TestRouter(t *testing.T) {
+	c := qt.New(t)
+
+	r := newTestRouter()
+
+	for _, test := range []struct {
+		filename string
+		expect   string
+	}{
+		{"index.html", DefaultTarget},
+		{"/internal/index.html", "internal"},
+		{"internal", "internal"},
+		{"Internal/p1/index.html", "internal"},
+		{"internals/index.html", DefaultTarget},
+		{"docs/index.html", DefaultTarget},
+		{"docs/internal/p1/data.json", "internal"},
+		{"en/partners/index.html", "partners"},
+		{"nn/internal/p1/index.html", "internal"},
+		{"de/internal/p1/index.html", DefaultTarget},
+		{filepath.FromSlash("nn/partners/logo.png"), "partners"},
+	} {
+		c.Assert(r.Target(test.filename), qt.Equals, test.expect, qt.Commentf(test.filename))
+	}
+
+	c.Assert(r.CanLink(DefaultTarget, DefaultTarget), qt.IsTrue)
+	c.Assert(r.CanLink("internal", DefaultTarget), qt.IsTrue)
+	c.Assert(r.CanLink("internal", "partners"), qt.IsTrue)
+	c.Assert(r.CanLink("partners", "internal"), qt.IsFalse)
+	c.Assert(r.CanLink(DefaultTarget, "internal"), qt.IsFalse)
+
+	c.Assert(r.Visibility("internal/index.html", "docs/index.html"), qt.Equals, "")
+	c.Assert(r.Visibility("docs/index.html", "docs/internal/index.html"), qt.Equals, `links to publish target "internal", which is not visible from "default"`)
+}
+
+func TestFs(t *testing.T) {
+	c := qt.New(t)
+
+	dfs, ifs, pfs := afero.NewMemMapFs(), afero.NewMemMapFs(), afero.NewMemMapFs()
+	fs := NewFs(dfs, map[string]afero.Fs{"internal": ifs, "partners": pfs}, newTestRouter())
+
+	c.Assert(afero.WriteFile(fs, "/index.html", []byte("home"), 0666), qt.IsNil)
+	c.Assert(afero.WriteFile(fs, "/en/internal/p1/index.html", []byte("p1"), 0666), qt.IsNil)
+	c.Assert(afero.WriteFile(fs, "/partners/index.html", []byte("partners"), 0666), qt.IsNil)
+
+	for _, test := range []struct {
+		fs       afero.Fs
+		filename string
+		exists   bool
+	}{
+		{dfs, "/index.html", true},
+		{ifs, "/index.html", false},
+		{ifs, "/en/internal/p1/index.html", true},
+		{dfs, "/en/internal/p1/index.html", false},
+		{pfs, "/partners/index.html", true},
+	} {
+		exists, err := afero.Exists(test.fs, test.filename)
+		c.Assert(err, qt.IsNil)
+		c.Assert(exists, qt.Equals, test.exists, qt.Commentf(test.filename))
+	}
+
+	b, err := afero.ReadFile(fs, "/en/internal/p1/index.html")
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(b), qt.Equals, "p1")
+
+	c.Assert(fs.Rename("/en/internal/p1/index.html", "/en/internal/p2.html"), qt.IsNil)
+	c.Assert(fs.Rename("/en/internal/p2.html", "/p2.html"), qt.ErrorMatches, ".*cannot move files between publish targets")
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redirects_test
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestRedirectsMap(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/docs/"
+disableKinds = ["taxonomy", "term", "sitemap", "RSS"]
+defaultContentLanguage = "en"
+[redirects]
+formats = ["json", "netlify"]
+status = 302
+[languages.en]
+weight = 1
+[languages.nn]
+weight = 2
+-- content/blog/p1.md --
+---
+title: "P1"
+aliases: ["/old/p1/", "/old/p1.html", "p1-old"]
+---
+-- content/blog/p1.nn.md --
+---
+title: "P1 nn"
+aliases: ["/gamal/p1/"]
+---
+-- layouts/_default/single.html --
+Single.
+-- layouts/_default/list.html --
+List.
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/_redirects",
+		"/docs/blog/p1-old/ /docs/blog/p1/ 302",
+		"/docs/gamal/p1/ /docs/nn/blog/p1/ 302",
+		"/docs/old/p1.html /docs/blog/p1/ 302",
+		"/docs/old/p1/ /docs/blog/p1/ 302",
+	)
+	b.AssertFileContent("public/redirects.json", `{
+    "from": "/docs/old/p1/",
+    "to": "/docs/blog/p1/",
+    "status": 302
+  }`)
+
+	// The HTML alias pages are still rendered.
+	b.AssertFileContent("public/old/p1/index.html", "https://example.org/docs/blog/p1/")
+	b.AssertFileContent("public/gamal/p1/index.html", "https://example.org/docs/nn/blog/p1/")
+}
+
+func TestRedirectsMapCollision(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "sitemap", "RSS"]
+[redirects]
+formats = ["json"]
+-- content/p1.md --
+---
+title: "P1"
+aliases: ["/old/"]
+---
+-- content/p2.md --
+---
+title: "P2"
+aliases: ["/old/"]
+---
+-- layouts/_default/single.html --
+Single.
+`
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `alias "/old/" is defined by both "/content/p1.md" and "/content/p2.md"`)
+}
+
+func TestRedirectsMapPageCollision(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "sitemap", "RSS"]
+[redirects]
+formats = ["netlify"]
+-- content/p1.md --
+---
+title: "P1"
+aliases: ["/p2/"]
+---
+-- content/p2.md --
+---
+title: "P2"
+---
+-- layouts/_default/single.html --
+Single.
+`
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `alias "/p2/" defined by "/content/p1.md" collides with the URL of "/content/p2.md"`)
+}
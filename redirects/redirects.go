@@ -0,0 +1,180 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redirects builds the map of all aliases and the pages they redirect
+// to, published in formats understood by web servers and hosting providers.
+package redirects
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gohugoio/hugo/config"
+	"github.com/mitchellh/mapstructure"
+)
+
+const (
+	redirectsConfigKey = "redirects"
+
+	// FormatJSON writes the redirects as a JSON array to redirects.json.
+	FormatJSON = "json"
+	// FormatNetlify writes the redirects to a Netlify _redirects file.
+	FormatNetlify = "netlify"
+)
+
+var filenames = map[string]string{
+	FormatJSON:    "redirects.json",
+	FormatNetlify: "_redirects",
+}
+
+// DefaultConfig is the default redirects config.
+var DefaultConfig = Config{
+	Status: http.StatusMovedPermanently,
+}
+
+// Config configures the redirects map.
+type Config struct {
+	// The formats to publish the redirects map in, json and/or netlify.
+	// If not set, no redirects map is published and no validation is done.
+	Formats []string
+
+	// The HTTP status code to use for the aliases.
+	Status int
+}
+
+// IsZero returns whether no redirects map is configured.
+func (c Config) IsZero() bool {
+	return len(c.Formats) == 0
+}
+
+// DecodeConfig creates a redirects Config from a given Hugo configuration.
+func DecodeConfig(cfg config.Provider) (Config, error) {
+	c := DefaultConfig
+
+	if !cfg.IsSet(redirectsConfigKey) {
+		return c, nil
+	}
+
+	if err := mapstructure.WeakDecode(cfg.GetStringMap(redirectsConfigKey), &c); err != nil {
+		return c, err
+	}
+
+	for i, f := range c.Formats {
+		f = strings.ToLower(f)
+		if _, found := filenames[f]; !found {
+			return c, fmt.Errorf("redirects: invalid format %q, must be one of json or netlify", f)
+		}
+		c.Formats[i] = f
+	}
+
+	if c.Status < 300 || c.Status > 399 {
+		return c, fmt.Errorf("redirects: invalid status %d, must be a redirect status code (3xx)", c.Status)
+	}
+
+	return c, nil
+}
+
+// Filename returns the filename, relative to the publish root, of the
+// redirects map in the given format.
+func Filename(format string) string {
+	return filenames[format]
+}
+
+// Redirect is an alias redirecting to a page.
+type Redirect struct {
+	// The alias, as a path relative to the server root.
+	From string `json:"from"`
+
+	// The path of the page, relative to the server root.
+	To string `json:"to"`
+
+	// The HTTP status code.
+	Status int `json:"status"`
+
+	// The page defining the alias, used in error messages.
+	Source string `json:"-"`
+}
+
+// Validate checks the redirects for collisions and cycles.
+// pages maps the path of every page published to the page's name, and is
+// used to detect aliases that would hide a page.
+// It returns the redirects sorted by From with any duplicates removed.
+func Validate(redirects []Redirect, pages map[string]string) ([]Redirect, error) {
+	byFrom := make(map[string]Redirect)
+	var result []Redirect
+	for _, r := range redirects {
+		if r2, found := byFrom[r.From]; found {
+			if r2.To == r.To {
+				continue
+			}
+			return nil, fmt.Errorf("alias %q is defined by both %q and %q: remove it from one of them", r.From, r2.Source, r.Source)
+		}
+		byFrom[r.From] = r
+		result = append(result, r)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].From < result[j].From
+	})
+
+	for _, r := range result {
+		if r.From == r.To {
+			return nil, fmt.Errorf("alias %q defined by %q redirects to itself: remove it from the aliases", r.From, r.Source)
+		}
+		chain := []string{r.From}
+		seen := map[string]bool{r.From: true}
+		for next, found := byFrom[r.To]; found; next, found = byFrom[next.To] {
+			chain = append(chain, next.From)
+			if seen[next.To] {
+				chain = append(chain, next.To)
+				return nil, fmt.Errorf("alias %q defined by %q is part of a redirect cycle: %s", r.From, r.Source, strings.Join(chain, " -> "))
+			}
+			seen[next.From] = true
+		}
+	}
+
+	for _, r := range result {
+		if p, found := pages[r.From]; found {
+			return nil, fmt.Errorf("alias %q defined by %q collides with the URL of %q: remove the alias or change the page URL", r.From, r.Source, p)
+		}
+	}
+
+	return result, nil
+}
+
+// Write writes redirects to w in the given format.
+func Write(w io.Writer, format string, redirects []Redirect) error {
+	switch format {
+	case FormatJSON:
+		if redirects == nil {
+			redirects = []Redirect{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		return enc.Encode(redirects)
+	case FormatNetlify:
+		for _, r := range redirects {
+			if _, err := fmt.Fprintf(w, "%s %s %d\n", r.From, r.To, r.Status); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("redirects: invalid format %q", format)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redirects
+
+import (
+	"bytes"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/config"
+)
+
+func TestDecodeConfig(t *testing.T) {
+	c := qt.New(t)
+
+	cfg, err := config.FromConfigString(`
+[redirects]
+formats = ["JSON", "netlify"]
+status = 302
+`, "toml")
+	c.Assert(err, qt.IsNil)
+
+	conf, err := DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Formats, qt.DeepEquals, []string{FormatJSON, FormatNetlify})
+	c.Assert(conf.Status, qt.Equals, 302)
+
+	conf, err = DecodeConfig(config.New())
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.IsZero(), qt.IsTrue)
+	c.Assert(conf.Status, qt.Equals, 301)
+
+	cfg.Set("redirects", map[string]any{"formats": []string{"xml"}})
+	_, err = DecodeConfig(cfg)
+	c.Assert(err, qt.ErrorMatches, ".*invalid format.*")
+
+	cfg = config.New()
+	cfg.Set("redirects", map[string]any{"status": 200})
+	_, err = DecodeConfig(cfg)
+	c.Assert(err, qt.ErrorMatches, ".*invalid status 200.*")
+}
+
+func TestValidate(t *testing.T) {
+	c := qt.New(t)
+
+	pages := map[string]string{
+		"/a/": "a.md",
+		"/b/": "b.md",
+	}
+
+	rs, err := Validate([]Redirect{
+		{From: "/old-b/", To: "/b/", Source: "b.md"},
+		{From: "/old-a/", To: "/a/", Source: "a.md"},
+		{From: "/old-a/", To: "/a/", Source: "a.md"},
+	}, pages)
+	c.Assert(err, qt.IsNil)
+	c.Assert(rs, qt.HasLen, 2)
+	c.Assert(rs[0].From, qt.Equals, "/old-a/")
+
+	_, err = Validate([]Redirect{
+		{From: "/old/", To: "/a/", Source: "a.md"},
+		{From: "/old/", To: "/b/", Source: "b.md"},
+	}, pages)
+	c.Assert(err, qt.ErrorMatches, `alias "/old/" is defined by both "a.md" and "b.md".*`)
+
+	_, err = Validate([]Redirect{
+		{From: "/a/", To: "/a/", Source: "a.md"},
+	}, pages)
+	c.Assert(err, qt.ErrorMatches, `alias "/a/" defined by "a.md" redirects to itself.*`)
+
+	_, err = Validate([]Redirect{
+		{From: "/a/", To: "/b/", Source: "b.md"},
+		{From: "/b/", To: "/a/", Source: "a.md"},
+	}, pages)
+	c.Assert(err, qt.ErrorMatches, `alias "/a/" defined by "b.md" is part of a redirect cycle: /a/ -> /b/ -> /a/`)
+
+	_, err = Validate([]Redirect{
+		{From: "/a/", To: "/c/", Source: "c.md"},
+	}, pages)
+	c.Assert(err, qt.ErrorMatches, `alias "/a/" defined by "c.md" collides with the URL of "a.md".*`)
+}
+
+func TestWrite(t *testing.T) {
+	c := qt.New(t)
+
+	rs := []Redirect{
+		{From: "/old/", To: "/new/", Status: 301},
+		{From: "/old.html", To: "/new/?a&b", Status: 302},
+	}
+
+	var b bytes.Buffer
+	c.Assert(Write(&b, FormatNetlify, rs), qt.IsNil)
+	c.Assert(b.String(), qt.Equals, "/old/ /new/ 301\n/old.html /new/?a&b 302\n")
+
+	b.Reset()
+	c.Assert(Write(&b, FormatJSON, nil), qt.IsNil)
+	c.Assert(b.String(), qt.Equals, "[]\n")
+
+	b.Reset()
+	c.Assert(Write(&b, FormatJSON, rs[:1]), qt.IsNil)
+	c.Assert(b.String(), qt.Equals, `[
+  {
+    "from": "/old/",
+    "to": "/new/",
+    "status": 301
+  }
+]
+`)
+}
@@ -16,6 +16,7 @@ package related_test
 import (
 	"fmt"
 	"math/rand"
+	"runtime"
 	"testing"
 
 	"github.com/gohugoio/hugo/hugolib"
@@ -193,3 +194,175 @@ keywords: ['k%d']
 		builders[i].Build()
 	}
 }
+
+func TestRelatedVector(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "http://example.com/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT"]
+[related]
+threshold = 20
+includeNewer = true
+[[related.indices]]
+name = "content"
+type = "vector"
+weight = 100
+[[related.indices]]
+name = "embedding"
+type = "vector"
+provider = "import"
+weight = 100
+-- content/cats1.md --
+---
+title: "Cats"
+embedding: [1, 0]
+---
+Cats are small furry animals that like to sleep.
+-- content/cats2.md --
+---
+title: "More cats"
+embedding: [0.9, 0.1]
+---
+Some furry cats sleep all day.
+-- content/cars.md --
+---
+title: "Cars"
+embedding: [0, 1]
+---
+Cars have engines and wheels.
+-- layouts/_default/single.html --
+Related: {{ range site.RegularPages.Related . }}{{ .Title }}|{{ end }}END
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/cats1/index.html", "Related: More cats|END")
+	b.AssertFileContent("public/cars/index.html", "Related: END")
+}
+
+func TestRelatedVectorExec(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("skip on Windows")
+	}
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "http://example.com/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT"]
+[security.exec]
+allow = ["^sh$"]
+[related]
+threshold = 50
+includeNewer = true
+[[related.indices]]
+name = "embedding"
+type = "vector"
+provider = "exec"
+model = "test"
+command = ["sh", "-c", "if grep -qi dogs; then echo [0,1]; else echo [1,0.1]; fi"]
+weight = 100
+-- content/p1.md --
+---
+title: "P1"
+---
+Cats.
+-- content/p2.md --
+---
+title: "P2"
+---
+More cats.
+-- content/p3.md --
+---
+title: "P3"
+---
+Dogs.
+-- layouts/_default/single.html --
+Related: {{ range site.RegularPages.Related . }}{{ .Title }}|{{ end }}END
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html", "Related: P2|END")
+	b.AssertFileContent("public/p3/index.html", "Related: END")
+}
+
+func TestRelatedAllLanguages(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "http://example.com/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT"]
+defaultContentLanguage = "en"
+[languages]
+[languages.en]
+weight = 1
+[languages.nn]
+weight = 2
+[related]
+threshold = 20
+includeNewer = true
+[[related.indices]]
+name = "keywords"
+weight = 100
+-- content/posts/p1.en.md --
+---
+title: "P1 en"
+keywords: ["go", "hugo"]
+---
+-- content/posts/p1.nn.md --
+---
+title: "P1 nn"
+keywords: ["go", "hugo"]
+---
+-- content/posts/p2.en.md --
+---
+title: "P2 en"
+keywords: ["hugo"]
+---
+-- content/posts/p2.nn.md --
+---
+title: "P2 nn"
+keywords: ["hugo"]
+---
+-- content/posts/p3.nn.md --
+---
+title: "P3 nn"
+keywords: ["go"]
+---
+-- content/docs/d1.nn.md --
+---
+title: "D1 nn"
+keywords: ["go"]
+---
+-- layouts/_default/single.html --
+Related: {{ range site.RegularPages.Related . }}{{ .Title }}|{{ end }}END
+AllLanguages: {{ range site.RegularPages.Related (dict "document" . "allLanguages" true) }}{{ .Title }}|{{ end }}END
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/posts/p1/index.html",
+		"Related: P2 en|END",
+		// P2 is kept in English, P1 nn is the translation of the page.
+		"AllLanguages: P1 nn|D1 nn|P2 en|P3 nn|END",
+	)
+}
@@ -39,11 +39,13 @@ import (
 const (
 	TypeBasic     = "basic"
 	TypeFragments = "fragments"
+	TypeVector    = "vector"
 )
 
 var validTypes = map[string]bool{
 	TypeBasic:     true,
 	TypeFragments: true,
+	TypeVector:    true,
 }
 
 var (
@@ -126,6 +128,23 @@ type IndexConfig struct {
 	// Will lower case all string values in and queries tothis index.
 	// May get better accurate results, but at a slight performance cost.
 	ToLower bool
+
+	// The vector provider used for indices of type "vector".
+	// One of "tfidf" (default, computed from the content), "import" (read from
+	// the front matter param given by Name) or "exec" (computed by Command).
+	// Documents are ranked by the cosine similarity of their vectors, scaled by Weight.
+	Provider string
+
+	// The model name used by the "exec" provider. It is passed to Command in the
+	// HUGO_RELATED_MODEL environment variable and is part of the cache key.
+	Model string
+
+	// The command and its arguments used by the "exec" provider.
+	// It receives the document text on stdin and must write the embedding
+	// vector as a JSON array of numbers to stdout.
+	// Add its name to security.exec.allow, e.g. "^my-embedder$".
+	// The vectors are cached in the embeddings file cache.
+	Command []string
 }
 
 // Document is the interface an indexable document in Hugo must fulfill.
@@ -141,6 +160,19 @@ type Document interface {
 	Name() string
 }
 
+// pathProvider is an optional interface that can be implemented by a Document,
+// e.g. a Page, to break ties between Documents with the same Name.
+type pathProvider interface {
+	Path() string
+}
+
+func docPath(doc Document) string {
+	if p, ok := doc.(pathProvider); ok {
+		return p.Path()
+	}
+	return ""
+}
+
 // FragmentProvider is an optional interface that can be implemented by a Document.
 type FragmentProvider interface {
 	Fragments(context.Context) *tableofcontents.Fragments
@@ -154,6 +186,8 @@ type FragmentProvider interface {
 type InvertedIndex struct {
 	cfg   Config
 	index map[string]map[Keyword][]Document
+	// Holds the vectors for the indices of type "vector".
+	vectors map[string]*vectorIndex
 	// Counts the number of documents added to each index.
 	indexDocCount map[string]int
 
@@ -177,9 +211,12 @@ func (idx *InvertedIndex) getIndexCfg(name string) (IndexConfig, bool) {
 // NewInvertedIndex creates a new InvertedIndex.
 // Documents to index must be added in Add.
 func NewInvertedIndex(cfg Config) *InvertedIndex {
-	idx := &InvertedIndex{index: make(map[string]map[Keyword][]Document), indexDocCount: make(map[string]int), cfg: cfg}
+	idx := &InvertedIndex{index: make(map[string]map[Keyword][]Document), vectors: make(map[string]*vectorIndex), indexDocCount: make(map[string]int), cfg: cfg}
 	for _, conf := range cfg.Indices {
 		idx.index[conf.Name] = make(map[Keyword][]Document)
+		if conf.Type == TypeVector {
+			idx.vectors[conf.Name] = newVectorIndex(conf)
+		}
 		if conf.Weight < idx.minWeight {
 			// By default, the weight scale starts at 0, but we allow
 			// negative weights.
@@ -206,6 +243,20 @@ func (idx *InvertedIndex) Add(ctx context.Context, docs ...Document) error {
 		}
 		setm := idx.index[config.Name]
 
+		if config.Type == TypeVector {
+			vi := idx.vectors[config.Name]
+			for _, doc := range docs {
+				added, err := vi.add(ctx, doc)
+				if err != nil {
+					return err
+				}
+				if added {
+					idx.indexDocCount[config.Name]++
+				}
+			}
+			continue
+		}
+
 		for _, doc := range docs {
 			var added bool
 			var words []Keyword
@@ -242,6 +293,10 @@ func (idx *InvertedIndex) Finalize(ctx context.Context) error {
 		return nil
 	}
 
+	for _, vi := range idx.vectors {
+		vi.finalize()
+	}
+
 	for _, config := range idx.cfg.Indices {
 		if config.CardinalityThreshold == 0 {
 			continue
@@ -273,6 +328,9 @@ func (idx *InvertedIndex) Finalize(ctx context.Context) error {
 type queryElement struct {
 	Index    string
 	Keywords []Keyword
+
+	// Set for indices of type "vector".
+	Vector vector
 }
 
 func newQueryElement(index string, keywords ...Keyword) queryElement {
@@ -315,6 +373,9 @@ func (r ranks) Swap(i, j int) { r[i], r[j] = r[j], r[i] }
 func (r ranks) Less(i, j int) bool {
 	if r[i].Weight == r[j].Weight {
 		if r[i].Doc.PublishDate() == r[j].Doc.PublishDate() {
+			if r[i].Doc.Name() == r[j].Doc.Name() {
+				return docPath(r[i].Doc) < docPath(r[j].Doc)
+			}
 			return r[i].Doc.Name() < r[j].Doc.Name()
 		}
 		return r[i].Doc.PublishDate().After(r[j].Doc.PublishDate())
@@ -337,6 +398,10 @@ type SearchOpts struct {
 	// for indices configured as type "fragments".
 	// This will match the fragment identifiers of the documents.
 	Fragments []string
+
+	// Enable to also search the equivalent documents in the other languages.
+	// This is currently only supported by Hugo's Pages.Related.
+	AllLanguages bool
 }
 
 // Search finds the documents matching any of the keywords in the given indices
@@ -366,6 +431,18 @@ func (idx *InvertedIndex) Search(ctx context.Context, opts SearchOpts) ([]Docume
 	}
 
 	for _, cfg := range configs {
+		if cfg.Type == TypeVector {
+			if opts.Document == nil {
+				continue
+			}
+			v, err := idx.vectors[cfg.Name].vectorFor(ctx, opts.Document)
+			if err != nil {
+				return nil, err
+			}
+			queryElements = append(queryElements, queryElement{Index: cfg.Name, Vector: v})
+			continue
+		}
+
 		var keywords []Keyword
 		if opts.Document != nil {
 			k, err := opts.Document.RelatedKeywords(cfg)
@@ -401,6 +478,15 @@ func (idx *InvertedIndex) Search(ctx context.Context, opts SearchOpts) ([]Docume
 			return nil, fmt.Errorf("index %q not found", key)
 		}
 
+		if conf.Type == TypeVector {
+			v, err := idx.vectors[conf.Name].vectorForText(strings.Join(cast.ToStringSlice(slice.Values), " "))
+			if err != nil {
+				return nil, err
+			}
+			queryElements = append(queryElements, queryElement{Index: conf.Name, Vector: v})
+			continue
+		}
+
 		for _, val := range slice.Values {
 			k, err := conf.ToKeywords(val)
 			if err != nil {
@@ -483,6 +569,33 @@ func (idx *InvertedIndex) searchDate(ctx context.Context, self Document, upperDa
 			return []Document{}, fmt.Errorf("index config for %q not found", el.Index)
 		}
 
+		if config.Type == TypeVector {
+			if config.Weight == 0 || el.Vector.isZero() {
+				continue
+			}
+			for doc, v := range idx.vectors[el.Index].vectors {
+				if compare.Eq(doc, self) {
+					continue
+				}
+				if applyDateFilter && doc.PublishDate().After(upperDate) {
+					continue
+				}
+				// Scale the weight by the similarity.
+				weight := int(math.Round(el.Vector.cosine(v) * float64(config.Weight)))
+				if weight <= 0 {
+					continue
+				}
+				r, found := matchm[doc]
+				if !found {
+					r = getRank(doc, weight)
+					matchm[doc] = r
+				} else {
+					r.addWeight(weight)
+				}
+			}
+			continue
+		}
+
 		for _, kw := range el.Keywords {
 			if docs, found := setm[kw]; found {
 				for _, doc := range docs {
@@ -595,6 +708,17 @@ func DecodeConfig(m maps.Params) (Config, error) {
 		if icfg.CardinalityThreshold < 0 || icfg.CardinalityThreshold > 100 {
 			return Config{}, errors.New("cardinalityThreshold threshold must be between 0 and 100")
 		}
+		if c.Indices[i].Type == TypeVector {
+			if icfg.Provider == "" {
+				c.Indices[i].Provider = ProviderTFIDF
+			}
+			if !validProviders[c.Indices[i].Provider] {
+				return c, fmt.Errorf("invalid provider %q for index %q. Must be one of %v", icfg.Provider, icfg.Name, xmaps.Keys(validProviders))
+			}
+			if c.Indices[i].Provider == ProviderExec && len(icfg.Command) == 0 {
+				return c, fmt.Errorf("index %q: command must be set for the %q provider", icfg.Name, ProviderExec)
+			}
+		}
 	}
 
 	return c, nil
@@ -236,6 +236,43 @@ func TestSearch(t *testing.T) {
 	})
 }
 
+type testDocWithPath struct {
+	*testDoc
+	path string
+}
+
+func (d *testDocWithPath) Path() string {
+	return d.path
+}
+
+func TestSearchSameNameOrderedByPath(t *testing.T) {
+	c := qt.New(t)
+	config := Config{
+		Threshold:    90,
+		IncludeNewer: false,
+		Indices: IndicesConfig{
+			IndexConfig{Name: "keywords", Weight: 100},
+		},
+	}
+
+	idx := NewInvertedIndex(config)
+	date := time.Now()
+
+	for _, path := range []string{"/c", "/a", "/d", "/b"} {
+		doc := newTestDocWithDate("keywords", date, "a", "b")
+		doc.name = "same"
+		idx.Add(context.Background(), &testDocWithPath{testDoc: doc, path: path})
+	}
+
+	self := newTestDocWithDate("keywords", date, "a", "b")
+	m, err := idx.Search(context.Background(), SearchOpts{Document: self, Indices: []string{"keywords"}})
+	c.Assert(err, qt.IsNil)
+	c.Assert(len(m), qt.Equals, 4)
+	for i, path := range []string{"/a", "/b", "/c", "/d"} {
+		c.Assert(m[i].(*testDocWithPath).path, qt.Equals, path)
+	}
+}
+
 func TestToKeywordsToLower(t *testing.T) {
 	c := qt.New(t)
 	slice := []string{"A", "B", "C"}
@@ -0,0 +1,240 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package related
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+)
+
+const (
+	// ProviderTFIDF computes TF-IDF vectors from the documents' text. This is the default.
+	ProviderTFIDF = "tfidf"
+
+	// ProviderImport reads the vectors from the front matter param given by the index name.
+	ProviderImport = "import"
+
+	// ProviderExec computes the vectors using an external command.
+	ProviderExec = "exec"
+)
+
+var validProviders = map[string]bool{
+	ProviderTFIDF:  true,
+	ProviderImport: true,
+	ProviderExec:   true,
+}
+
+// VectorProvider is an optional interface that can be implemented by a Document
+// to be indexed in indices of type "vector".
+type VectorProvider interface {
+	// RelatedText returns the text used to create TF-IDF vectors.
+	RelatedText(context.Context) string
+
+	// RelatedVector returns the embedding vector for the given index config.
+	// This is used for the "import" and "exec" providers.
+	RelatedVector(context.Context, IndexConfig) ([]float64, error)
+}
+
+// vector is either a dense embedding vector or a sparse TF-IDF vector.
+type vector struct {
+	dense  []float64
+	sparse map[string]float64
+	norm   float64
+}
+
+func newDenseVector(v []float64) vector {
+	var sum float64
+	for _, f := range v {
+		sum += f * f
+	}
+	return vector{dense: v, norm: math.Sqrt(sum)}
+}
+
+func newSparseVector(v map[string]float64) vector {
+	var sum float64
+	for _, f := range v {
+		sum += f * f
+	}
+	return vector{sparse: v, norm: math.Sqrt(sum)}
+}
+
+func (v vector) isZero() bool {
+	return v.norm == 0
+}
+
+// cosine returns the cosine similarity between v and other.
+func (v vector) cosine(other vector) float64 {
+	if v.isZero() || other.isZero() {
+		return 0
+	}
+
+	var dot float64
+	if v.sparse != nil {
+		a, b := v.sparse, other.sparse
+		if len(b) < len(a) {
+			a, b = b, a
+		}
+		for k, f := range a {
+			dot += f * b[k]
+		}
+	} else {
+		if len(v.dense) != len(other.dense) {
+			return 0
+		}
+		for i, f := range v.dense {
+			dot += f * other.dense[i]
+		}
+	}
+
+	return dot / (v.norm * other.norm)
+}
+
+// vectorIndex holds the vectors for an index of type "vector".
+type vectorIndex struct {
+	cfg IndexConfig
+
+	vectors map[Document]vector
+
+	// Used by the TF-IDF provider.
+	termFreqs map[Document]map[string]float64
+	docFreqs  map[string]int
+	idf       map[string]float64
+}
+
+func newVectorIndex(cfg IndexConfig) *vectorIndex {
+	return &vectorIndex{
+		cfg:       cfg,
+		vectors:   make(map[Document]vector),
+		termFreqs: make(map[Document]map[string]float64),
+		docFreqs:  make(map[string]int),
+	}
+}
+
+func (idx *vectorIndex) add(ctx context.Context, doc Document) (bool, error) {
+	vp, ok := doc.(VectorProvider)
+	if !ok {
+		return false, nil
+	}
+
+	if idx.cfg.Provider == ProviderTFIDF {
+		tf := termFrequencies(vp.RelatedText(ctx))
+		if len(tf) == 0 {
+			return false, nil
+		}
+		for term := range tf {
+			idx.docFreqs[term]++
+		}
+		idx.termFreqs[doc] = tf
+		return true, nil
+	}
+
+	v, err := vp.RelatedVector(ctx, idx.cfg)
+	if err != nil {
+		return false, err
+	}
+	if len(v) == 0 {
+		return false, nil
+	}
+	idx.vectors[doc] = newDenseVector(v)
+
+	return true, nil
+}
+
+func (idx *vectorIndex) finalize() {
+	if idx.cfg.Provider != ProviderTFIDF || idx.idf != nil {
+		return
+	}
+
+	numDocs := float64(len(idx.termFreqs))
+	idx.idf = make(map[string]float64, len(idx.docFreqs))
+	for term, df := range idx.docFreqs {
+		// Smoothed to avoid zero weights for terms present in all documents.
+		idx.idf[term] = math.Log((1+numDocs)/(1+float64(df))) + 1
+	}
+
+	for doc, tf := range idx.termFreqs {
+		idx.vectors[doc] = idx.tfidf(tf)
+	}
+
+	idx.termFreqs = nil
+	idx.docFreqs = nil
+}
+
+func (idx *vectorIndex) tfidf(tf map[string]float64) vector {
+	v := make(map[string]float64, len(tf))
+	for term, f := range tf {
+		if idf, found := idx.idf[term]; found {
+			v[term] = f * idf
+		}
+	}
+	return newSparseVector(v)
+}
+
+// vectorForText creates a query vector from the given text.
+func (idx *vectorIndex) vectorForText(text string) (vector, error) {
+	if idx.cfg.Provider != ProviderTFIDF {
+		return vector{}, fmt.Errorf("text queries are not supported for index %q with provider %q", idx.cfg.Name, idx.cfg.Provider)
+	}
+	return idx.tfidf(termFrequencies(text)), nil
+}
+
+// vectorFor returns the query vector for doc.
+func (idx *vectorIndex) vectorFor(ctx context.Context, doc Document) (vector, error) {
+	if v, found := idx.vectors[doc]; found {
+		return v, nil
+	}
+
+	vp, ok := doc.(VectorProvider)
+	if !ok {
+		return vector{}, nil
+	}
+
+	if idx.cfg.Provider == ProviderTFIDF {
+		return idx.vectorForText(vp.RelatedText(ctx))
+	}
+
+	v, err := vp.RelatedVector(ctx, idx.cfg)
+	if err != nil {
+		return vector{}, err
+	}
+
+	return newDenseVector(v), nil
+}
+
+// termFrequencies splits s into lower case terms and returns the
+// normalized frequency of each term.
+func termFrequencies(s string) map[string]float64 {
+	terms := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	counts := make(map[string]float64)
+	var n float64
+	for _, term := range terms {
+		if len([]rune(term)) < 2 {
+			continue
+		}
+		counts[term]++
+		n++
+	}
+
+	for term, c := range counts {
+		counts[term] = c / n
+	}
+
+	return counts
+}
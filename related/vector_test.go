@@ -0,0 +1,139 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package related
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/common/types"
+)
+
+type testVectorDoc struct {
+	*testDoc
+	text   string
+	vector []float64
+}
+
+func (d *testVectorDoc) RelatedText(context.Context) string {
+	return d.text
+}
+
+func (d *testVectorDoc) RelatedVector(ctx context.Context, cfg IndexConfig) ([]float64, error) {
+	return d.vector, nil
+}
+
+func newTestVectorDoc(name, text string, vector ...float64) *testVectorDoc {
+	return &testVectorDoc{
+		testDoc: newTestDocWithDate(name, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+		text:    text,
+		vector:  vector,
+	}
+}
+
+func TestVectorSearchTFIDF(t *testing.T) {
+	c := qt.New(t)
+	ctx := context.Background()
+
+	idx := NewInvertedIndex(Config{
+		Threshold: 10,
+		Indices: IndicesConfig{
+			IndexConfig{Name: "content", Type: TypeVector, Provider: ProviderTFIDF, Weight: 100},
+		},
+	})
+
+	cats1 := newTestVectorDoc("cats1", "Cats are small furry animals. Cats like to sleep.")
+	cats2 := newTestVectorDoc("cats2", "My cats sleep all day, the furry animals.")
+	cars := newTestVectorDoc("cars", "Cars have engines and wheels.")
+	empty := newTestVectorDoc("empty", "")
+
+	c.Assert(idx.Add(ctx, cats1, cats2, cars, empty), qt.IsNil)
+	c.Assert(idx.Finalize(ctx), qt.IsNil)
+	c.Assert(idx.indexDocCount["content"], qt.Equals, 3)
+
+	m, err := idx.Search(ctx, SearchOpts{Document: cats1})
+	c.Assert(err, qt.IsNil)
+	c.Assert(m, qt.HasLen, 1)
+	c.Assert(m[0], qt.Equals, cats2)
+
+	// Document not in the index.
+	wheels := newTestVectorDoc("wheels", "Wheels on cars")
+	m, err = idx.Search(ctx, SearchOpts{Document: wheels})
+	c.Assert(err, qt.IsNil)
+	c.Assert(m, qt.HasLen, 1)
+	c.Assert(m[0], qt.Equals, cars)
+
+	// Text query.
+	m, err = idx.Search(ctx, SearchOpts{NamedSlices: []types.KeyValues{types.NewKeyValuesStrings("content", "furry", "animals")}})
+	c.Assert(err, qt.IsNil)
+	c.Assert(m, qt.HasLen, 2)
+}
+
+func TestVectorSearchImport(t *testing.T) {
+	c := qt.New(t)
+	ctx := context.Background()
+
+	idx := NewInvertedIndex(Config{
+		Threshold: 50,
+		Indices: IndicesConfig{
+			IndexConfig{Name: "embedding", Type: TypeVector, Provider: ProviderImport, Weight: 100},
+		},
+	})
+
+	d1 := newTestVectorDoc("d1", "", 1, 0, 0)
+	d2 := newTestVectorDoc("d2", "", 0.9, 0.1, 0)
+	d3 := newTestVectorDoc("d3", "", 0.5, 0.5, 0)
+	d4 := newTestVectorDoc("d4", "", 0, 0, 1)
+
+	c.Assert(idx.Add(ctx, d1, d2, d3, d4), qt.IsNil)
+	c.Assert(idx.Finalize(ctx), qt.IsNil)
+
+	m, err := idx.Search(ctx, SearchOpts{Document: d1})
+	c.Assert(err, qt.IsNil)
+	c.Assert(m, qt.HasLen, 2)
+	c.Assert(m[0], qt.Equals, d2)
+	c.Assert(m[1], qt.Equals, d3)
+
+	_, err = idx.Search(ctx, SearchOpts{NamedSlices: []types.KeyValues{types.NewKeyValuesStrings("embedding", "foo")}})
+	c.Assert(err, qt.ErrorMatches, ".*text queries are not supported.*")
+}
+
+func TestDecodeConfigVector(t *testing.T) {
+	c := qt.New(t)
+
+	conf, err := DecodeConfig(maps.Params{
+		"indices": []map[string]any{
+			{"name": "content", "type": "vector", "weight": 100},
+		},
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Indices[0].Provider, qt.Equals, ProviderTFIDF)
+
+	_, err = DecodeConfig(maps.Params{
+		"indices": []map[string]any{
+			{"name": "content", "type": "vector", "provider": "foo"},
+		},
+	})
+	c.Assert(err, qt.ErrorMatches, `invalid provider "foo".*`)
+
+	_, err = DecodeConfig(maps.Params{
+		"indices": []map[string]any{
+			{"name": "content", "type": "vector", "provider": "exec"},
+		},
+	})
+	c.Assert(err, qt.ErrorMatches, `.*command must be set.*`)
+}
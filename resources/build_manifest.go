@@ -0,0 +1,119 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// The types of the published files in the build manifest.
+const (
+	BuildManifestTypePage     = "page"
+	BuildManifestTypeAlias    = "alias"
+	BuildManifestTypeResource = "resource"
+	BuildManifestTypeStatic   = "static"
+)
+
+// BuildManifestEntry describes a published file.
+type BuildManifestEntry struct {
+	// The slash separated path relative to the publish dir.
+	Path string `json:"path"`
+
+	// The hex encoded SHA-256 hash and the size of the published file.
+	SHA256 string `json:"sha256"`
+	Size   int64  `json:"size"`
+
+	// What produced the file, e.g. "page". Empty if not known.
+	Type string `json:"type,omitempty"`
+
+	// The source of the file relative to the project, e.g. "content/posts/p1.md"
+	// or "assets/css/main.scss".
+	Source string `json:"source,omitempty"`
+
+	// The output format of a page.
+	OutputFormat string `json:"outputFormat,omitempty"`
+
+	// The templates used to render a page, the layout first.
+	Templates []string `json:"templates,omitempty"`
+
+	// The transformations applied to a resource, in order, e.g. "tocss" and "fingerprint".
+	Transformations []string `json:"transformations,omitempty"`
+}
+
+// BuildManifest holds the provenance of the files published in a build,
+// keyed by their path. See build.manifest.
+// Entries are kept between rebuilds, as are the published files.
+// A nil BuildManifest is valid and records nothing.
+type BuildManifest struct {
+	mu      sync.RWMutex
+	entries map[string]BuildManifestEntry
+}
+
+// NewBuildManifest creates a new BuildManifest.
+func NewBuildManifest() *BuildManifest {
+	return &BuildManifest{entries: make(map[string]BuildManifestEntry)}
+}
+
+// Add records the provenance of the file published to e.Path.
+// This method is thread safe.
+func (m *BuildManifest) Add(e BuildManifestEntry) {
+	if m == nil {
+		return
+	}
+	e.Path = BuildManifestPath(e.Path)
+	m.mu.Lock()
+	m.entries[e.Path] = e
+	m.mu.Unlock()
+}
+
+// Entries returns a copy of the recorded entries, keyed by path.
+// This method is thread safe.
+func (m *BuildManifest) Entries() map[string]BuildManifestEntry {
+	if m == nil {
+		return nil
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	entries := make(map[string]BuildManifestEntry, len(m.entries))
+	for k, v := range m.entries {
+		entries[k] = v
+	}
+	return entries
+}
+
+// BuildManifestPath normalizes filename, relative to the publish dir,
+// to the form used as path in the build manifest, e.g. "posts/p1/index.html".
+func BuildManifestPath(filename string) string {
+	return strings.TrimPrefix(filepath.ToSlash(filepath.Clean(filename)), "/")
+}
+
+// addToBuildManifest records the files published to the target paths of r
+// for the given relative target path.
+func (r *genericResource) addToBuildManifest(relTargetPath string, transformations []string) {
+	m := r.spec.BuildManifest
+	if m == nil {
+		return
+	}
+	source := resourceSourcePath(r.spec, r.getFileInfo(), r.Key())
+	for _, filename := range r.relTargetPathsFor(relTargetPath) {
+		m.Add(BuildManifestEntry{
+			Path:            filename,
+			Type:            BuildManifestTypeResource,
+			Source:          source,
+			Transformations: transformations,
+		})
+	}
+}
@@ -116,6 +116,10 @@ func (e *errorResource) Resize(spec string) (images.ImageResource, error) {
 	panic(e.ResourceError)
 }
 
+func (e *errorResource) ProcessSet(spec string) (*images.ImageSet, error) {
+	panic(e.ResourceError)
+}
+
 func (e *errorResource) Filter(filters ...any) (images.ImageResource, error) {
 	panic(e.ResourceError)
 }
@@ -128,6 +132,14 @@ func (e *errorResource) Colors() ([]string, error) {
 	panic(e.ResourceError)
 }
 
+func (e *errorResource) DominantColor() (string, error) {
+	panic(e.ResourceError)
+}
+
+func (e *errorResource) AverageColor() (string, error) {
+	panic(e.ResourceError)
+}
+
 func (e *errorResource) DecodeImage() (image.Image, error) {
 	panic(e.ResourceError)
 }
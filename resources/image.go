@@ -15,6 +15,7 @@ package resources
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"image"
 	"image/color"
@@ -26,6 +27,8 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -35,6 +38,7 @@ import (
 	"github.com/gohugoio/hugo/identity"
 
 	"github.com/disintegration/gift"
+	"github.com/spf13/cast"
 
 	"github.com/gohugoio/hugo/cache/filecache"
 	"github.com/gohugoio/hugo/resources/images/exif"
@@ -66,8 +70,9 @@ type imageResource struct {
 	metaInitErr error
 	meta        *imageMeta
 
-	dominantColorInit sync.Once
-	dominantColors    []string
+	colorsInit sync.Once
+	colorsErr  error
+	colors     *imageColors
 
 	baseResource
 }
@@ -76,6 +81,12 @@ type imageMeta struct {
 	Exif *exif.ExifInfo
 }
 
+// imageColors holds the colors extracted from an image, as hex strings.
+type imageColors struct {
+	Dominant []string
+	Average  string
+}
+
 func (i *imageResource) Exif() *exif.ExifInfo {
 	return i.root.getExif()
 }
@@ -143,19 +154,67 @@ func (i *imageResource) getExif() *exif.ExifInfo {
 // Colors returns a slice of the most dominant colors in an image
 // using a simple histogram method.
 func (i *imageResource) Colors() ([]string, error) {
-	var err error
-	i.dominantColorInit.Do(func() {
-		var img image.Image
-		img, err = i.DecodeImage()
-		if err != nil {
-			return
+	colors, err := i.getColors()
+	if err != nil {
+		return nil, err
+	}
+	return colors.Dominant, nil
+}
+
+// DominantColor returns the most dominant color in an image as a hex string,
+// e.g. "#2c6fbb".
+func (i *imageResource) DominantColor() (string, error) {
+	colors, err := i.getColors()
+	if err != nil || len(colors.Dominant) == 0 {
+		return "", err
+	}
+	return colors.Dominant[0], nil
+}
+
+// AverageColor returns the average color of an image as a hex string.
+func (i *imageResource) AverageColor() (string, error) {
+	colors, err := i.getColors()
+	if err != nil {
+		return "", err
+	}
+	return colors.Average, nil
+}
+
+// getColors extracts the colors from the image, or reads them from the
+// file cache if they have been extracted in a previous build.
+func (i *imageResource) getColors() (*imageColors, error) {
+	i.colorsInit.Do(func() {
+		key := strings.TrimSuffix(i.getImageMetaCacheTargetPath(), ".json") + "_colors.json"
+
+		read := func(info filecache.ItemInfo, r io.ReadSeeker) error {
+			colors := &imageColors{}
+			if err := json.NewDecoder(r).Decode(colors); err != nil {
+				return err
+			}
+			i.colors = colors
+			return nil
 		}
-		colors := color_extractor.ExtractColors(img)
-		for _, c := range colors {
-			i.dominantColors = append(i.dominantColors, images.ColorToHexString(c))
+
+		create := func(info filecache.ItemInfo, w io.WriteCloser) error {
+			defer w.Close()
+			img, err := i.DecodeImage()
+			if err != nil {
+				return err
+			}
+			colors := &imageColors{
+				Average: images.ColorToHexString(images.AverageColor(img)),
+			}
+			for _, c := range color_extractor.ExtractColors(img) {
+				colors.Dominant = append(colors.Dominant, images.ColorToHexString(c))
+			}
+			i.colors = colors
+			return json.NewEncoder(w).Encode(colors)
 		}
+
+		_, i.colorsErr = i.getSpec().ImageCache.fileCache.ReadOrCreate(key, read, create)
 	})
-	return i.dominantColors, nil
+
+	return i.colors, i.colorsErr
 }
 
 // Clone is for internal use.
@@ -269,6 +328,83 @@ func (i *imageResource) Fill(spec string) (images.ImageResource, error) {
 	return img, err
 }
 
+// ProcessSet processes the image into the sizes and formats in spec, e.g.
+// `resize 480x 800x webp jpg q80`, and returns them as a set.
+// Images are never upscaled in resize operations with only the width set.
+func (i *imageResource) ProcessSet(spec string) (*images.ImageSet, error) {
+	if spec == "" {
+		spec = i.Proc.Cfg.Config.Imaging.Picture
+		if spec == "" {
+			return nil, errors.New("must provide a spec or set picture in the imaging config")
+		}
+	}
+
+	setSpec, err := images.DecodeImageSetSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var process func(spec string) (images.ImageResource, error)
+	switch setSpec.Action {
+	case "fit":
+		process = i.Fit
+	case "fill":
+		process = i.Fill
+	case "crop":
+		process = i.Crop
+	default:
+		process = i.Resize
+	}
+
+	// Avoid processing the same image more than once.
+	var sizes []string
+	seenSizes := make(map[string]bool)
+	for _, size := range setSpec.Sizes {
+		if setSpec.Action == "resize" && strings.HasSuffix(size, "x") {
+			if w, err := strconv.Atoi(strings.TrimSuffix(size, "x")); err == nil && w > i.Width() {
+				size = strconv.Itoa(i.Width()) + "x"
+			}
+		}
+		if !seenSizes[size] {
+			seenSizes[size] = true
+			sizes = append(sizes, size)
+		}
+	}
+
+	formats := setSpec.Formats
+	if len(formats) == 0 {
+		formats = []string{""}
+	}
+	seenFormats := make(map[images.Format]bool)
+
+	set := &images.ImageSet{}
+	for _, format := range formats {
+		if format != "" {
+			f, _ := images.ImageFormatFromExt("." + format)
+			if seenFormats[f] {
+				continue
+			}
+			seenFormats[f] = true
+		}
+
+		var sf images.ImageSetFormat
+		for _, size := range sizes {
+			img, err := process(strings.TrimSpace(strings.Join([]string{size, format, setSpec.Options}, " ")))
+			if err != nil {
+				return nil, err
+			}
+			sf.Images = append(sf.Images, img)
+		}
+		sort.SliceStable(sf.Images, func(i, j int) bool {
+			return sf.Images[i].Width() < sf.Images[j].Width()
+		})
+		sf.MediaType = sf.Images[0].MediaType()
+		set.Formats = append(set.Formats, sf)
+	}
+
+	return set, nil
+}
+
 func (i *imageResource) Filter(filters ...any) (images.ImageResource, error) {
 	conf := images.GetDefaultImageConfig("filter", i.Proc.Cfg)
 
@@ -362,11 +498,22 @@ func (i *imageResource) doWithImageConfig(conf images.ImageConfig, f func(src im
 }
 
 func (i *imageResource) decodeImageConfig(action, spec string) (images.ImageConfig, error) {
+	// A preset set in the resource params, e.g. in front matter, is used
+	// unless a preset is set in spec.
+	spec = images.AddDefaultPreset(spec, cast.ToString(i.Params()["imagepreset"]))
+
 	conf, err := images.DecodeImageConfig(action, spec, i.Proc.Cfg, i.Format)
 	if err != nil {
 		return conf, err
 	}
 
+	// A focal point set in the resource params, e.g. in front matter, is used as the
+	// anchor in Crop and Fill unless an anchor is set in spec.
+	conf, err = conf.ApplyFocalPoint(i.Params()["focalpoint"])
+	if err != nil {
+		return conf, fmt.Errorf("%s: %w", i.Name(), err)
+	}
+
 	return conf, nil
 }
 
@@ -93,6 +93,12 @@ func TestImageTransformBasic(t *testing.T) {
 	colors, err := image.Colors()
 	c.Assert(err, qt.IsNil)
 	c.Assert(colors, qt.DeepEquals, []string{"#2d2f33", "#a49e93", "#d39e59", "#a76936", "#737a84", "#7c838b"})
+	dominant, err := image.DominantColor()
+	c.Assert(err, qt.IsNil)
+	c.Assert(dominant, qt.Equals, "#2d2f33")
+	average, err := image.AverageColor()
+	c.Assert(err, qt.IsNil)
+	c.Assert(average, qt.Equals, "#544c45")
 
 	c.Assert(image.RelPermalink(), qt.Equals, "/a/sunset.jpg")
 	c.Assert(image.ResourceType(), qt.Equals, "image")
@@ -0,0 +1,44 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package avif provides AVIF encoding and decoding of images.
+// The codec is libavif compiled to WebAssembly, so it works in all builds.
+// Importing this package registers the AVIF decoder with the image package.
+package avif
+
+import (
+	"image"
+	"io"
+
+	"github.com/gen2brain/avif"
+)
+
+// Options holds the AVIF encoding options.
+type Options struct {
+	// Quality ranges from 1 to 100 inclusive, higher is better.
+	Quality int
+
+	// Speed ranges from 1 (slowest, smallest files) to 10 (fastest).
+	// 0 means the encoder's default.
+	Speed int
+}
+
+// Encode writes the Image m to w in AVIF format with the given options.
+func Encode(w io.Writer, m image.Image, o Options) error {
+	return avif.Encode(w, m, avif.Options{
+		Quality:           o.Quality,
+		QualityAlpha:      o.Quality,
+		Speed:             o.Speed,
+		ChromaSubsampling: image.YCbCrSubsampleRatio420,
+	})
+}
@@ -16,6 +16,7 @@ package images
 import (
 	"encoding/hex"
 	"fmt"
+	"image"
 	"image/color"
 	"strings"
 )
@@ -53,6 +54,34 @@ func ColorToHexString(c color.Color) string {
 
 }
 
+// AverageColor returns the average color of the opaque parts of img.
+// Transparent pixels are weighted by their alpha value.
+func AverageColor(img image.Image) color.Color {
+	var r, g, b, a uint64
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			// RGBA returns alpha-premultiplied values.
+			rr, gg, bb, aa := img.At(x, y).RGBA()
+			r += uint64(rr)
+			g += uint64(gg)
+			b += uint64(bb)
+			a += uint64(aa)
+		}
+	}
+
+	if a == 0 {
+		return color.Transparent
+	}
+
+	return color.RGBA{
+		R: uint8(r * 0xffff / a >> 8),
+		G: uint8(g * 0xffff / a >> 8),
+		B: uint8(b * 0xffff / a >> 8),
+		A: 0xff,
+	}
+}
+
 func hexStringToColor(s string) (color.Color, error) {
 	s = strings.TrimPrefix(s, "#")
 
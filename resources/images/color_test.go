@@ -14,6 +14,7 @@
 package images
 
 import (
+	"image"
 	"image/color"
 	"testing"
 
@@ -111,3 +112,21 @@ func TestReplaceColorInPalette(t *testing.T) {
 	c.Assert(palette, qt.HasLen, 2)
 	c.Assert(palette[0], qt.Equals, offWhite)
 }
+
+func TestAverageColor(t *testing.T) {
+	c := qt.New(t)
+
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	img.Set(0, 0, color.NRGBA{R: 0xff, A: 0xff})
+	img.Set(1, 0, color.NRGBA{B: 0xff, A: 0xff})
+	img.Set(0, 1, color.NRGBA{R: 0xff, A: 0xff})
+	img.Set(1, 1, color.NRGBA{B: 0xff, A: 0xff})
+	c.Assert(ColorToHexString(AverageColor(img)), qt.Equals, "#7f007f")
+
+	// Transparent pixels do not count.
+	img.Set(1, 0, color.NRGBA{G: 0xff, A: 0})
+	img.Set(1, 1, color.NRGBA{G: 0xff, A: 0})
+	c.Assert(ColorToHexString(AverageColor(img)), qt.Equals, "#ff0000")
+
+	c.Assert(AverageColor(image.NewNRGBA(image.Rect(0, 0, 2, 2))), qt.Equals, color.Transparent)
+}
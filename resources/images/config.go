@@ -44,6 +44,7 @@ var (
 		".bmp":  BMP,
 		".gif":  GIF,
 		".webp": WEBP,
+		".avif": AVIF,
 	}
 
 	imageFormatsBySubType = map[string]Format{
@@ -53,6 +54,7 @@ var (
 		media.Builtin.BMPType.SubType:  BMP,
 		media.Builtin.GIFType.SubType:  GIF,
 		media.Builtin.WEBPType.SubType: WEBP,
+		media.Builtin.AVIFType.SubType: AVIF,
 	}
 
 	// Add or increment if changes to an image format's processing requires
@@ -120,6 +122,7 @@ func ImageFormatFromMediaSubType(sub string) (Format, bool) {
 
 const (
 	defaultJPEGQuality    = 75
+	defaultAVIFSpeed      = 6
 	defaultResampleFilter = "box"
 	defaultBgColor        = "#ffffff"
 	defaultHint           = "photo"
@@ -157,6 +160,21 @@ func DecodeConfig(in map[string]any) (*config.ConfigNamespace[ImagingConfig, Ima
 		// Merge in the defaults.
 		maps.MergeShallow(m, defaultImaging)
 
+		if v, found := m["presets"]; found {
+			presets, err := maps.ToStringMapE(v)
+			if err != nil {
+				return ImagingConfigInternal{}, nil, fmt.Errorf("failed to decode image presets: %w", err)
+			}
+			// Make a copy to avoid mutating the source config.
+			presetsCopy := make(map[string]any, len(presets))
+			for k, vv := range presets {
+				if k != maps.MergeStrategyKey {
+					presetsCopy[k] = vv
+				}
+			}
+			m["presets"] = presetsCopy
+		}
+
 		var i ImagingConfigInternal
 		if err := mapstructure.Decode(m, &i.Imaging); err != nil {
 			return i, nil, err
@@ -210,14 +228,32 @@ func DecodeImageConfig(action, config string, defaults *config.ConfigNamespace[I
 	}
 
 	parts := strings.Fields(config)
+
+	// Apply any preset first so options set in the spec take precedence.
+	for _, part := range parts {
+		name, ok := cutPresetOption(part)
+		if !ok {
+			continue
+		}
+		preset, found := defaults.Config.Imaging.Presets[name]
+		if !found {
+			return c, fmt.Errorf("image preset %q not found in imaging config", name)
+		}
+		c.applyPreset(preset)
+	}
+
 	for _, part := range parts {
 		part = strings.ToLower(part)
 
-		if part == smartCropIdentifier {
+		if _, ok := cutPresetOption(part); ok {
+			continue
+		} else if part == smartCropIdentifier {
 			c.AnchorStr = smartCropIdentifier
+			c.anchorSetForImage = true
 		} else if pos, ok := anchorPositions[part]; ok {
 			c.Anchor = pos
 			c.AnchorStr = part
+			c.anchorSetForImage = true
 		} else if filter, ok := imageFilters[part]; ok {
 			c.Filter = filter
 			c.FilterStr = part
@@ -303,6 +339,13 @@ func DecodeImageConfig(action, config string, defaults *config.ConfigNamespace[I
 		c.TargetFormat = sourceFormat
 	}
 
+	if c.TargetFormat == AVIF {
+		if !c.qualitySetForImage {
+			c.Quality = defaults.Config.Imaging.AVIF.Quality
+		}
+		c.Speed = defaults.Config.Imaging.AVIF.Speed
+	}
+
 	if c.Quality <= 0 && c.TargetFormat.RequiresDefaultQuality() {
 		// We need a quality setting for all JPEGs and WEBPs.
 		c.Quality = defaults.Config.Imaging.Quality
@@ -318,6 +361,42 @@ func DecodeImageConfig(action, config string, defaults *config.ConfigNamespace[I
 	return c, nil
 }
 
+// presetOptionPrefix is the prefix used to reference a named preset in an image spec,
+// e.g. "preset:thumbnail".
+const presetOptionPrefix = "preset:"
+
+func cutPresetOption(part string) (string, bool) {
+	if len(part) <= len(presetOptionPrefix) || !strings.EqualFold(part[:len(presetOptionPrefix)], presetOptionPrefix) {
+		return "", false
+	}
+	return strings.ToLower(part[len(presetOptionPrefix):]), true
+}
+
+// AddDefaultPreset adds a reference to the named preset to spec if spec does
+// not already reference a preset and name is not empty.
+func AddDefaultPreset(spec, name string) string {
+	if name == "" {
+		return spec
+	}
+	for _, part := range strings.Fields(spec) {
+		if _, ok := cutPresetOption(part); ok {
+			return spec
+		}
+	}
+	return spec + " " + presetOptionPrefix + name
+}
+
+func (i *ImageConfig) applyPreset(p ImagePreset) {
+	if p.Quality > 0 {
+		i.Quality = p.Quality
+		i.qualitySetForImage = true
+	}
+	if p.Hint != "" {
+		i.Hint = hints[p.Hint]
+	}
+	i.Sharpen = p.Sharpen
+}
+
 // ImageConfig holds configuration to create a new image from an existing one, resize etc.
 type ImageConfig struct {
 	// This defines the output format of the output image. It defaults to the source format.
@@ -338,6 +417,9 @@ type ImageConfig struct {
 	// The rotation will be performed first.
 	Rotate int
 
+	// Speed is the AVIF encoding speed, see AVIFConfig.
+	Speed int
+
 	// Used to fill any transparency.
 	// When set in site config, it's used when converting to a format that does
 	// not support transparency.
@@ -350,14 +432,23 @@ type ImageConfig struct {
 	// when target is set to webp.
 	Hint webpoptions.EncodingPreset
 
+	// Sharpen is the amount of unsharp masking applied after resizing.
+	// Set from an image preset, see ImagePreset.
+	Sharpen float64
+
 	Width  int
 	Height int
 
 	Filter    gift.Resampling
 	FilterStr string
 
-	Anchor    gift.Anchor
-	AnchorStr string
+	Anchor            gift.Anchor
+	AnchorStr         string
+	anchorSetForImage bool // Whether the above is set for this image.
+
+	// The focal point to use as anchor in crop and fill when AnchorStr is "focalpoint".
+	// See ApplyFocalPoint.
+	FocalPoint FocalPoint
 }
 
 func (i ImageConfig) GetKey(format Format) string {
@@ -384,9 +475,19 @@ func (i ImageConfig) GetKey(format Format) string {
 		k += "_h" + strconv.Itoa(int(i.Hint))
 	}
 
+	if i.TargetFormat == AVIF {
+		k += "_s" + strconv.Itoa(i.Speed)
+	}
+
+	if i.Sharpen != 0 {
+		k += "_sh" + strconv.FormatFloat(i.Sharpen, 'f', -1, 64)
+	}
+
 	anchor := i.AnchorStr
 	if anchor == smartCropIdentifier {
 		anchor = anchor + strconv.Itoa(smartCropVersionNumber)
+	} else if anchor == focalPointIdentifier {
+		anchor = i.FocalPoint.key()
 	}
 
 	k += "_" + i.FilterStr
@@ -461,7 +562,43 @@ type ImagingConfig struct {
 	// Default color used in fill operations (e.g. "fff" for white).
 	BgColor string
 
+	// The default ProcessSet spec, e.g. "resize 480x 800x 1200x webp jpg".
+	// If set, Markdown images are rendered to picture elements using
+	// this spec, unless a render-image template is provided.
+	Picture string
+
+	AVIF AVIFConfig
+
 	Exif ExifConfig
+
+	// Named presets that can be referenced in image specs, e.g. "preset:thumbnail",
+	// or set as the default for an image with imagePreset in the resource params.
+	Presets map[string]ImagePreset
+}
+
+// ImagePreset holds a named set of image encoding options.
+type ImagePreset struct {
+	// Image quality setting (1-100).
+	// Default is the quality setting in the image spec or the imaging config.
+	Quality int
+
+	// Hint about what type of image this is, see ImagingConfig.Hint.
+	Hint string
+
+	// The amount of unsharp masking applied after resizing, e.g. 0.5.
+	// Default is 0, no sharpening.
+	Sharpen float64
+}
+
+// AVIFConfig holds the AVIF encoding options.
+type AVIFConfig struct {
+	// Image quality setting (1-100) for AVIF images.
+	// Default is the quality setting above.
+	Quality int
+
+	// Encoding speed from 1 (slowest, smallest files) to 10 (fastest).
+	// Default is 6.
+	Speed int
 }
 
 func (cfg *ImagingConfig) init() error {
@@ -478,6 +615,44 @@ func (cfg *ImagingConfig) init() error {
 		cfg.Anchor = smartCropIdentifier
 	}
 
+	if cfg.AVIF.Quality < 0 || cfg.AVIF.Quality > 100 {
+		return errors.New("AVIF image quality must be a number between 1 and 100")
+	}
+	if cfg.AVIF.Quality == 0 {
+		cfg.AVIF.Quality = cfg.Quality
+	}
+	if cfg.AVIF.Speed < 0 || cfg.AVIF.Speed > 10 {
+		return errors.New("AVIF encoding speed must be a number between 1 and 10")
+	}
+	if cfg.AVIF.Speed == 0 {
+		cfg.AVIF.Speed = defaultAVIFSpeed
+	}
+
+	if len(cfg.Presets) > 0 {
+		presets := make(map[string]ImagePreset, len(cfg.Presets))
+		for name, p := range cfg.Presets {
+			name = strings.ToLower(name)
+			if p.Quality < 0 || p.Quality > 100 {
+				return fmt.Errorf("image quality in preset %q must be a number between 1 and 100", name)
+			}
+			p.Hint = strings.ToLower(p.Hint)
+			if _, found := hints[p.Hint]; p.Hint != "" && !found {
+				return fmt.Errorf("invalid hint %q in image preset %q", p.Hint, name)
+			}
+			if p.Sharpen < 0 {
+				return fmt.Errorf("sharpen in image preset %q must be 0 or positive", name)
+			}
+			presets[name] = p
+		}
+		cfg.Presets = presets
+	}
+
+	if cfg.Picture != "" {
+		if _, err := DecodeImageSetSpec(cfg.Picture); err != nil {
+			return fmt.Errorf("invalid picture spec in imaging config: %w", err)
+		}
+	}
+
 	if strings.TrimSpace(cfg.Exif.IncludeFields) == "" && strings.TrimSpace(cfg.Exif.ExcludeFields) == "" {
 		// Don't change this for no good reason. Please don't.
 		cfg.Exif.ExcludeFields = "GPS|Exif|Exposure[M|P|B]|Contrast|Resolution|Sharp|JPEG|Metering|Sensing|Saturation|ColorSpace|Flash|WhiteBalance"
@@ -76,6 +76,30 @@ func TestDecodeConfig(t *testing.T) {
 	conf = imagingConfig.Config
 	c.Assert(conf.Imaging.Exif.DisableLatLong, qt.Equals, true)
 	c.Assert(conf.Imaging.Exif.ExcludeFields, qt.Equals, "GPS|Exif|Exposure[M|P|B]|Contrast|Resolution|Sharp|JPEG|Metering|Sensing|Saturation|ColorSpace|Flash|WhiteBalance")
+
+	imagingConfig, err = DecodeConfig(map[string]any{
+		"quality": 42,
+	})
+	c.Assert(err, qt.IsNil)
+	conf = imagingConfig.Config
+	c.Assert(conf.Imaging.AVIF, qt.Equals, AVIFConfig{Quality: 42, Speed: 6})
+
+	imagingConfig, err = DecodeConfig(map[string]any{
+		"avif": map[string]any{
+			"quality": 50,
+			"speed":   3,
+		},
+	})
+	c.Assert(err, qt.IsNil)
+	conf = imagingConfig.Config
+	c.Assert(conf.Imaging.AVIF, qt.Equals, AVIFConfig{Quality: 50, Speed: 3})
+
+	_, err = DecodeConfig(map[string]any{
+		"avif": map[string]any{
+			"speed": 11,
+		},
+	})
+	c.Assert(err, qt.Not(qt.IsNil))
 }
 
 func TestDecodeImageConfig(t *testing.T) {
@@ -150,9 +174,86 @@ func newImageConfig(action string, width, height, quality, rotate int, filter, a
 			if v, ok := anchorPositions[anchor]; ok {
 				c.Anchor = v
 				c.AnchorStr = anchor
+				c.anchorSetForImage = true
 			}
 		}
 	}
 
 	return c
 }
+
+func TestDecodeImageConfigAVIF(t *testing.T) {
+	c := qt.New(t)
+
+	cfg, err := DecodeConfig(map[string]any{
+		"avif": map[string]any{
+			"quality": 50,
+			"speed":   3,
+		},
+	})
+	c.Assert(err, qt.IsNil)
+
+	result, err := DecodeImageConfig("resize", "300x avif", cfg, PNG)
+	c.Assert(err, qt.IsNil)
+	c.Assert(result.TargetFormat, qt.Equals, AVIF)
+	c.Assert(result.Quality, qt.Equals, 50)
+	c.Assert(result.Speed, qt.Equals, 3)
+	c.Assert(result.GetKey(AVIF), qt.Contains, "_q50_s3_")
+}
+
+func TestDecodeImageConfigPresets(t *testing.T) {
+	c := qt.New(t)
+
+	cfg, err := DecodeConfig(map[string]any{
+		"quality": 80,
+		"presets": map[string]any{
+			"thumbnail": map[string]any{
+				"quality": 60,
+				"hint":    "Drawing",
+				"sharpen": 0.5,
+			},
+			"Hero": map[string]any{
+				"quality": 90,
+			},
+		},
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(cfg.Config.Imaging.Presets["thumbnail"].Hint, qt.Equals, "drawing")
+	c.Assert(cfg.Config.Imaging.Presets["hero"].Quality, qt.Equals, 90)
+
+	result, err := DecodeImageConfig("resize", "300x webp preset:thumbnail", cfg, JPEG)
+	c.Assert(err, qt.IsNil)
+	c.Assert(result.Quality, qt.Equals, 60)
+	c.Assert(result.Hint, qt.Equals, hints["drawing"])
+	c.Assert(result.Sharpen, qt.Equals, 0.5)
+	c.Assert(result.GetKey(WEBP), qt.Contains, "_q60_h3_sh0.5_")
+
+	// Options in the spec take precedence over the preset.
+	result, err = DecodeImageConfig("resize", "q70 300x Preset:Hero", cfg, JPEG)
+	c.Assert(err, qt.IsNil)
+	c.Assert(result.Quality, qt.Equals, 70)
+	c.Assert(result.Sharpen, qt.Equals, 0.0)
+
+	// Changing a preset changes the key of the images using it.
+	hero, err := DecodeImageConfig("resize", "300x preset:hero", cfg, JPEG)
+	c.Assert(err, qt.IsNil)
+	c.Assert(hero.GetKey(JPEG), qt.Contains, "_q90_")
+
+	_, err = DecodeImageConfig("resize", "300x preset:banner", cfg, JPEG)
+	c.Assert(err, qt.ErrorMatches, `image preset "banner" not found in imaging config`)
+
+	for _, preset := range []map[string]any{
+		{"quality": 101},
+		{"hint": "foo"},
+		{"sharpen": -1},
+	} {
+		_, err = DecodeConfig(map[string]any{
+			"presets": map[string]any{"p": preset},
+		})
+		c.Assert(err, qt.Not(qt.IsNil))
+	}
+
+	c.Assert(AddDefaultPreset("300x", ""), qt.Equals, "300x")
+	c.Assert(AddDefaultPreset("300x", "hero"), qt.Equals, "300x preset:hero")
+	c.Assert(AddDefaultPreset("300x preset:thumbnail", "hero"), qt.Equals, "300x preset:thumbnail")
+}
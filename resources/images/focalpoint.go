@@ -0,0 +1,148 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package images
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"reflect"
+	"strings"
+
+	"github.com/spf13/cast"
+)
+
+// Do not change.
+const focalPointIdentifier = "focalpoint"
+
+// FocalPoint is the point of interest in an image, relative to its width and
+// height, e.g. {X: 0.5, Y: 0.5} for the center of the image.
+type FocalPoint struct {
+	X float64
+	Y float64
+}
+
+func (fp FocalPoint) key() string {
+	// Per mille, which is more than precise enough for any crop.
+	return fmt.Sprintf("fp%d_%d", int(math.Round(fp.X*1000)), int(math.Round(fp.Y*1000)))
+}
+
+// decodeFocalPoint decodes v, either a slice with two numbers or a string
+// with two numbers separated by a comma or a space, e.g. "0.3,0.2".
+// Both numbers must be in the range 0 to 1 inclusive.
+func decodeFocalPoint(v any) (FocalPoint, error) {
+	var fp FocalPoint
+
+	var parts []any
+	switch vv := v.(type) {
+	case string:
+		for _, s := range strings.FieldsFunc(vv, func(r rune) bool { return r == ',' || r == ' ' }) {
+			parts = append(parts, s)
+		}
+	default:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return fp, fmt.Errorf("invalid focal point %v: must be a slice or a string", v)
+		}
+		for i := 0; i < rv.Len(); i++ {
+			parts = append(parts, rv.Index(i).Interface())
+		}
+	}
+
+	if len(parts) != 2 {
+		return fp, fmt.Errorf("invalid focal point %v: must have an x and an y value", v)
+	}
+
+	for i, part := range parts {
+		f, err := cast.ToFloat64E(part)
+		if err != nil {
+			return fp, fmt.Errorf("invalid focal point %v: %w", v, err)
+		}
+		if f < 0 || f > 1 {
+			return fp, fmt.Errorf("invalid focal point %v: values must be between 0 and 1", v)
+		}
+		if i == 0 {
+			fp.X = f
+		} else {
+			fp.Y = f
+		}
+	}
+
+	return fp, nil
+}
+
+// ApplyFocalPoint returns a copy of i using v as the anchor in crop and fill
+// operations, unless an anchor is set in the image spec.
+// The value v is either "smart" to use Smart Crop, or a focal point, e.g. [0.3, 0.2].
+func (i ImageConfig) ApplyFocalPoint(v any) (ImageConfig, error) {
+	if v == nil || i.anchorSetForImage || (i.Action != "crop" && i.Action != "fill") {
+		return i, nil
+	}
+
+	if s, ok := v.(string); ok && strings.EqualFold(s, smartCropIdentifier) {
+		i.AnchorStr = smartCropIdentifier
+		return i, nil
+	}
+
+	fp, err := decodeFocalPoint(v)
+	if err != nil {
+		return i, err
+	}
+	i.FocalPoint = fp
+	i.AnchorStr = focalPointIdentifier
+
+	return i, nil
+}
+
+// focalPointFill returns the largest rectangle inside bounds with the aspect
+// ratio of width and height, as close to centered on fp as possible.
+func focalPointFill(bounds image.Rectangle, width, height int, fp FocalPoint) image.Rectangle {
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	if width <= 0 || height <= 0 || srcW <= 0 || srcH <= 0 {
+		return bounds
+	}
+
+	cropW, cropH := srcW, srcH
+	if float64(srcW)/float64(srcH) > float64(width)/float64(height) {
+		cropW = int(math.Round(float64(srcH) * float64(width) / float64(height)))
+	} else {
+		cropH = int(math.Round(float64(srcW) * float64(height) / float64(width)))
+	}
+
+	return focalPointRect(bounds, cropW, cropH, fp)
+}
+
+// focalPointCrop returns a rectangle inside bounds of width and height, or smaller
+// if bounds is smaller, as close to centered on fp as possible.
+func focalPointCrop(bounds image.Rectangle, width, height int, fp FocalPoint) image.Rectangle {
+	return focalPointRect(bounds, clampInt(width, 0, bounds.Dx()), clampInt(height, 0, bounds.Dy()), fp)
+}
+
+func focalPointRect(bounds image.Rectangle, cropW, cropH int, fp FocalPoint) image.Rectangle {
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	x0 := clampInt(int(math.Round(fp.X*float64(srcW)))-cropW/2, 0, srcW-cropW)
+	y0 := clampInt(int(math.Round(fp.Y*float64(srcH)))-cropH/2, 0, srcH-cropH)
+
+	return image.Rect(x0, y0, x0+cropW, y0+cropH).Add(bounds.Min)
+}
+
+func clampInt(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
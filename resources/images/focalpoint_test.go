@@ -0,0 +1,110 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package images
+
+import (
+	"image"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestDecodeFocalPoint(t *testing.T) {
+	c := qt.New(t)
+
+	for _, test := range []struct {
+		in     any
+		expect any
+	}{
+		{"0.3,0.2", FocalPoint{X: 0.3, Y: 0.2}},
+		{"0.3 0.2", FocalPoint{X: 0.3, Y: 0.2}},
+		{"1, 0", FocalPoint{X: 1, Y: 0}},
+		{[]any{0.5, 1}, FocalPoint{X: 0.5, Y: 1}},
+		{[]float64{0.25, 0.75}, FocalPoint{X: 0.25, Y: 0.75}},
+		{"0.3", false},
+		{"0.3,0.2,0.1", false},
+		{"1.5,0.2", false},
+		{"a,b", false},
+		{42, false},
+	} {
+		fp, err := decodeFocalPoint(test.in)
+		if b, ok := test.expect.(bool); ok && !b {
+			c.Assert(err, qt.Not(qt.IsNil), qt.Commentf("%v", test.in))
+		} else {
+			c.Assert(err, qt.IsNil, qt.Commentf("%v", test.in))
+			c.Assert(fp, qt.Equals, test.expect)
+		}
+	}
+}
+
+func TestImageConfigApplyFocalPoint(t *testing.T) {
+	c := qt.New(t)
+
+	imagingConfig, err := DecodeConfig(nil)
+	c.Assert(err, qt.IsNil)
+
+	decode := func(action, spec string) ImageConfig {
+		conf, err := DecodeImageConfig(action, spec, imagingConfig, JPEG)
+		c.Assert(err, qt.IsNil)
+		return conf
+	}
+
+	conf, err := decode("fill", "300x200").ApplyFocalPoint([]any{0.3, 0.2})
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.AnchorStr, qt.Equals, "focalpoint")
+	c.Assert(conf.FocalPoint, qt.Equals, FocalPoint{X: 0.3, Y: 0.2})
+	c.Assert(conf.GetKey(JPEG), qt.Equals, "300x200_fill_q75_box_fp300_200")
+
+	conf, err = decode("crop", "300x200").ApplyFocalPoint("Smart")
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.AnchorStr, qt.Equals, "smart")
+
+	// Anchors set in the spec win.
+	conf, err = decode("fill", "300x200 TopLeft").ApplyFocalPoint("0.3,0.2")
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.AnchorStr, qt.Equals, "topleft")
+
+	// Not relevant for resize.
+	conf, err = decode("resize", "300x").ApplyFocalPoint("0.3,0.2")
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.AnchorStr, qt.Equals, "smart")
+
+	conf, err = decode("fill", "300x200").ApplyFocalPoint(nil)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.AnchorStr, qt.Equals, "smart")
+
+	_, err = decode("fill", "300x200").ApplyFocalPoint("top")
+	c.Assert(err, qt.Not(qt.IsNil))
+}
+
+func TestFocalPointRectangles(t *testing.T) {
+	c := qt.New(t)
+
+	bounds := image.Rect(0, 0, 400, 200)
+
+	// Landscape to square, focal point on the right.
+	c.Assert(focalPointFill(bounds, 100, 100, FocalPoint{X: 0.8, Y: 0.5}), qt.Equals, image.Rect(200, 0, 400, 200))
+	c.Assert(focalPointFill(bounds, 100, 100, FocalPoint{X: 0.6, Y: 0.5}), qt.Equals, image.Rect(140, 0, 340, 200))
+	// Clamped to the left edge.
+	c.Assert(focalPointFill(bounds, 100, 100, FocalPoint{X: 0, Y: 0}), qt.Equals, image.Rect(0, 0, 200, 200))
+	// Wider than the source.
+	c.Assert(focalPointFill(bounds, 400, 100, FocalPoint{X: 0.5, Y: 0.1}), qt.Equals, image.Rect(0, 0, 400, 100))
+	c.Assert(focalPointFill(bounds, 400, 100, FocalPoint{X: 0.5, Y: 0.5}), qt.Equals, image.Rect(0, 50, 400, 150))
+
+	c.Assert(focalPointCrop(bounds, 100, 100, FocalPoint{X: 0.25, Y: 0.25}), qt.Equals, image.Rect(50, 0, 150, 100))
+	c.Assert(focalPointCrop(bounds, 100, 500, FocalPoint{X: 1, Y: 1}), qt.Equals, image.Rect(300, 0, 400, 200))
+
+	// Bounds not starting at the origin.
+	c.Assert(focalPointCrop(bounds.Add(image.Pt(10, 10)), 100, 100, FocalPoint{X: 0.25, Y: 0.25}), qt.Equals, image.Rect(60, 10, 160, 110))
+}
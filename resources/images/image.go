@@ -26,6 +26,7 @@ import (
 
 	"github.com/bep/gowebp/libwebp/webpoptions"
 	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/resources/images/avif"
 	"github.com/gohugoio/hugo/resources/images/webp"
 
 	"github.com/gohugoio/hugo/media"
@@ -108,6 +109,14 @@ func (i *Image) EncodeTo(conf ImageConfig, img image.Image, w io.Writer) error {
 				UseSharpYuv:    true,
 			},
 		)
+	case AVIF:
+		return avif.Encode(
+			w,
+			img, avif.Options{
+				Quality: conf.Quality,
+				Speed:   conf.Speed,
+			},
+		)
 	default:
 		return errors.New("format not supported")
 	}
@@ -225,6 +234,8 @@ func (p *ImageProcessor) ApplyFiltersFromConfig(src image.Image, conf ImageConfi
 			// Then center crop the image to get an image the desired size without resizing.
 			filters = append(filters, gift.CropToSize(conf.Width, conf.Height, gift.CenterAnchor))
 
+		} else if conf.AnchorStr == focalPointIdentifier {
+			filters = append(filters, gift.Crop(focalPointCrop(gift.New(filters...).Bounds(src.Bounds()), conf.Width, conf.Height, conf.FocalPoint)))
 		} else {
 			filters = append(filters, gift.CropToSize(conf.Width, conf.Height, conf.Anchor))
 		}
@@ -239,6 +250,9 @@ func (p *ImageProcessor) ApplyFiltersFromConfig(src image.Image, conf ImageConfi
 			filters = append(filters, gift.Crop(bounds))
 			filters = append(filters, gift.Resize(conf.Width, conf.Height, conf.Filter))
 
+		} else if conf.AnchorStr == focalPointIdentifier {
+			filters = append(filters, gift.Crop(focalPointFill(gift.New(filters...).Bounds(src.Bounds()), conf.Width, conf.Height, conf.FocalPoint)))
+			filters = append(filters, gift.Resize(conf.Width, conf.Height, conf.Filter))
 		} else {
 			filters = append(filters, gift.ResizeToFill(conf.Width, conf.Height, conf.Filter, conf.Anchor))
 		}
@@ -248,6 +262,10 @@ func (p *ImageProcessor) ApplyFiltersFromConfig(src image.Image, conf ImageConfi
 		return nil, fmt.Errorf("unsupported action: %q", conf.Action)
 	}
 
+	if conf.Sharpen > 0 {
+		filters = append(filters, gift.UnsharpMask(1, float32(conf.Sharpen), 0))
+	}
+
 	img, err := p.doFilter(src, conf.TargetFormat, filters...)
 	if err != nil {
 		return nil, err
@@ -331,12 +349,13 @@ const (
 	TIFF
 	BMP
 	WEBP
+	AVIF
 )
 
 // RequiresDefaultQuality returns if the default quality needs to be applied to
 // images of this format.
 func (f Format) RequiresDefaultQuality() bool {
-	return f == JPEG || f == WEBP
+	return f == JPEG || f == WEBP || f == AVIF
 }
 
 // SupportsTransparency reports whether it supports transparency in any form.
@@ -365,6 +384,8 @@ func (f Format) MediaType() media.Type {
 		return media.Builtin.BMPType
 	case WEBP:
 		return media.Builtin.WEBPType
+	case AVIF:
+		return media.Builtin.AVIFType
 	default:
 		panic(fmt.Sprintf("%d is not a valid image format", f))
 	}
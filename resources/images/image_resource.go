@@ -51,6 +51,12 @@ type ImageResourceOps interface {
 	// ratio is preserved.
 	Resize(spec string) (ImageResource, error)
 
+	// ProcessSet processes the image into multiple sizes and formats in one go,
+	// e.g. for use in a picture element.
+	// The spec is space delimited, e.g. `resize 480x 800x webp jpg q80`.
+	// If spec is empty, the picture spec in the imaging config is used.
+	ProcessSet(spec string) (*ImageSet, error)
+
 	// Filter applies one or more filters to an Image.
 	//    {{ $image := $image.Filter (images.GaussianBlur 6) (images.Pixelate 8) }}
 	Filter(filters ...any) (ImageResource, error)
@@ -62,6 +68,12 @@ type ImageResourceOps interface {
 	// using a simple histogram method.
 	Colors() ([]string, error)
 
+	// DominantColor returns the most dominant color in an image as a hex string.
+	DominantColor() (string, error)
+
+	// AverageColor returns the average color of an image as a hex string.
+	AverageColor() (string, error)
+
 	// For internal use.
 	DecodeImage() (image.Image, error)
 }
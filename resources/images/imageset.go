@@ -0,0 +1,158 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package images
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gohugoio/hugo/media"
+)
+
+// Formats we know of but cannot encode.
+var unsupportedTargetFormats = map[string]bool{
+	"heic": true,
+	"jxl":  true,
+}
+
+var imageSetDimensionRe = regexp.MustCompile(`^\d*x\d*$`)
+
+// ImageSetSpec is a decoded ProcessSet spec, e.g.
+// "resize 480x 800x 1200x webp jpg q80".
+type ImageSetSpec struct {
+	// One of resize, fit, fill or crop. Default is resize.
+	Action string
+
+	// The dimensions, e.g. "480x" or "300x200", in the order given.
+	Sizes []string
+
+	// The target formats, e.g. "webp", in order of preference.
+	// The last one is the fallback format. If not set, the source format is used.
+	Formats []string
+
+	// Any other options, e.g. quality and anchor, applied to all images in the set.
+	Options string
+}
+
+// DecodeImageSetSpec decodes spec into an ImageSetSpec.
+func DecodeImageSetSpec(spec string) (ImageSetSpec, error) {
+	var (
+		s       ImageSetSpec
+		options []string
+		seen    = make(map[string]bool)
+	)
+
+	for i, part := range strings.Fields(spec) {
+		part = strings.ToLower(part)
+		if seen[part] {
+			continue
+		}
+		seen[part] = true
+
+		switch {
+		case i == 0 && (part == "resize" || part == "fit" || part == "fill" || part == "crop"):
+			s.Action = part
+		case unsupportedTargetFormats[part]:
+			return s, fmt.Errorf("image format %q is not supported", part)
+		case imageSetDimensionRe.MatchString(part) && part != "x":
+			s.Sizes = append(s.Sizes, part)
+		default:
+			if _, ok := ImageFormatFromExt("." + part); ok {
+				s.Formats = append(s.Formats, part)
+			} else {
+				options = append(options, part)
+			}
+		}
+	}
+
+	if s.Action == "" {
+		s.Action = "resize"
+	}
+
+	if len(s.Sizes) == 0 {
+		return s, errors.New("must provide at least one image size")
+	}
+
+	s.Options = strings.Join(options, " ")
+
+	return s, nil
+}
+
+// ImageSet is a set of images processed from the same source image in one or
+// more formats and sizes, typically used in a picture element.
+type ImageSet struct {
+	// The formats in order of preference. The last one is the fallback.
+	Formats []ImageSetFormat
+}
+
+// Sources returns all formats but the fallback, i.e. what would go into
+// the source elements of a picture element.
+func (s *ImageSet) Sources() []ImageSetFormat {
+	if len(s.Formats) == 0 {
+		return nil
+	}
+	return s.Formats[:len(s.Formats)-1]
+}
+
+// Fallback returns the fallback format, i.e. what would go into
+// the img element of a picture element.
+func (s *ImageSet) Fallback() ImageSetFormat {
+	if len(s.Formats) == 0 {
+		return ImageSetFormat{}
+	}
+	return s.Formats[len(s.Formats)-1]
+}
+
+// ImageSetFormat holds the images in an ImageSet of one format.
+type ImageSetFormat struct {
+	MediaType media.Type
+
+	// The images sorted by width.
+	Images []ImageResource
+}
+
+// Srcset returns a srcset attribute value listing the images with their width,
+// e.g. "/images/a_480x.webp 480w, /images/a_800x.webp 800w".
+func (f ImageSetFormat) Srcset() string {
+	var sb strings.Builder
+	for i, img := range f.Images {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(img.RelPermalink())
+		sb.WriteString(" ")
+		sb.WriteString(strconv.Itoa(img.Width()))
+		sb.WriteString("w")
+	}
+	return sb.String()
+}
+
+// Smallest returns the smallest image, nil if none.
+func (f ImageSetFormat) Smallest() ImageResource {
+	if len(f.Images) == 0 {
+		return nil
+	}
+	return f.Images[0]
+}
+
+// Largest returns the largest image, nil if none.
+func (f ImageSetFormat) Largest() ImageResource {
+	if len(f.Images) == 0 {
+		return nil
+	}
+	return f.Images[len(f.Images)-1]
+}
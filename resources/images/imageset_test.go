@@ -0,0 +1,43 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package images
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestDecodeImageSetSpec(t *testing.T) {
+	c := qt.New(t)
+
+	for _, test := range []struct {
+		spec   string
+		expect any
+	}{
+		{"480x 800x webp jpg", ImageSetSpec{Action: "resize", Sizes: []string{"480x", "800x"}, Formats: []string{"webp", "jpg"}}},
+		{"Fill 300x200 600x400 600X400 q80 TopLeft box", ImageSetSpec{Action: "fill", Sizes: []string{"300x200", "600x400"}, Options: "q80 topleft box"}},
+		{"fit x200 png #fff", ImageSetSpec{Action: "fit", Sizes: []string{"x200"}, Formats: []string{"png"}, Options: "#fff"}},
+		{"webp jpg", false},
+		{"480x heic jpg", false},
+	} {
+		s, err := DecodeImageSetSpec(test.spec)
+		if b, ok := test.expect.(bool); ok && !b {
+			c.Assert(err, qt.Not(qt.IsNil), qt.Commentf(test.spec))
+		} else {
+			c.Assert(err, qt.IsNil, qt.Commentf(test.spec))
+			c.Assert(s, qt.DeepEquals, test.expect, qt.Commentf(test.spec))
+		}
+	}
+}
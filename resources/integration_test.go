@@ -14,7 +14,11 @@
 package resources_test
 
 import (
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 
 	qt "github.com/frankban/quicktest"
@@ -100,3 +104,361 @@ Width: {{ $svg.Width }}
 	b.Assert(err.Error(), qt.Contains, `error calling Width: this method is only available for raster images. To determine if an image is SVG, you can do {{ if eq .MediaType.SubType "svg" }}{{ end }}`)
 
 }
+
+func TestImageFocalPoint(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org"
+-- content/mybundle/index.md --
+---
+title: "My Bundle"
+resources:
+- src: "giphy.gif"
+  params:
+    focalPoint: [0.9, 0.5]
+- src: "smart.gif"
+  params:
+    focalPoint: "smart"
+---
+-- content/mybundle/giphy.gif --
+sourcefilename: testdata/giphy.gif
+-- content/mybundle/plain.gif --
+sourcefilename: testdata/giphy.gif
+-- content/mybundle/smart.gif --
+sourcefilename: testdata/giphy.gif
+-- layouts/_default/single.html --
+{{ $img := .Resources.Get "giphy.gif" }}
+{{ $fill := $img.Fill "20x10" }}
+{{ $crop := $img.Crop "20x10" }}
+{{ $topLeft := $img.Fill "20x10 TopLeft" }}
+{{ $plain := (.Resources.Get "plain.gif").Fill "20x10" }}
+{{ $smart := (.Resources.Get "smart.gif").Fill "20x10" }}
+Fill: {{ $fill.RelPermalink }}|{{ $fill.Width }}x{{ $fill.Height }}|
+Crop: {{ $crop.RelPermalink }}|{{ $crop.Width }}x{{ $crop.Height }}|
+TopLeft: {{ $topLeft.RelPermalink }}|
+Plain: {{ $plain.RelPermalink }}|
+Smart: {{ $smart.RelPermalink }}|
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			NeedsOsFS:   true,
+		}).Build()
+
+	b.AssertFileContent("public/mybundle/index.html",
+		"Fill: /mybundle/giphy_hu3eafc418e52414ace6236bf1d31f82e1_52213_20x10_fill_box_fp900_500_1.gif|20x10|",
+		"Crop: /mybundle/giphy_hu3eafc418e52414ace6236bf1d31f82e1_52213_20x10_crop_box_fp900_500_1.gif|20x10|",
+		"TopLeft: /mybundle/giphy_hu3eafc418e52414ace6236bf1d31f82e1_52213_20x10_fill_box_topleft_1.gif|",
+		"Plain: /mybundle/plain_hu3eafc418e52414ace6236bf1d31f82e1_52213_20x10_fill_box_smart1_1.gif|",
+		"Smart: /mybundle/smart_hu3eafc418e52414ace6236bf1d31f82e1_52213_20x10_fill_box_smart1_1.gif|",
+	)
+}
+
+func TestImageFocalPointInvalid(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org"
+-- content/mybundle/index.md --
+---
+title: "My Bundle"
+resources:
+- src: "giphy.gif"
+  params:
+    focalPoint: "left"
+---
+-- content/mybundle/giphy.gif --
+sourcefilename: testdata/giphy.gif
+-- layouts/_default/single.html --
+{{ $img := .Resources.Get "giphy.gif" }}
+{{ ($img.Fill "20x10").RelPermalink }}
+`
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			NeedsOsFS:   true,
+		}).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `giphy.gif: invalid focal point left`)
+}
+
+func TestImageProcessSet(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org"
+[imaging]
+picture = "resize 4x 16x gif png"
+-- content/mybundle/index.md --
+---
+title: "My Bundle"
+---
+![Pixel](giphy.gif "The Title")
+![Remote](https://example.org/a.png)
+-- content/mybundle/giphy.gif --
+sourcefilename: testdata/giphy.gif
+-- layouts/_default/single.html --
+{{ .Content }}
+-- layouts/index.html --
+{{ $img := (site.GetPage "mybundle").Resources.Get "giphy.gif" }}
+{{ $set := $img.ProcessSet "2x 5x 5X 1000x jpeg jpg png q50" }}
+{{ range $set.Formats }}{{ .MediaType.Type }}: {{ .Srcset }}|Smallest: {{ .Smallest.Width }}|Largest: {{ .Largest.Width }}|
+{{ end }}
+Sources: {{ len $set.Sources }}|Fallback: {{ $set.Fallback.MediaType.Type }}|
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			NeedsOsFS:   true,
+		}).Build()
+
+	b.AssertFileContent("public/index.html",
+		"image/jpeg: /mybundle/giphy_hu3eafc418e52414ace6236bf1d31f82e1_52213_2x0_resize_q50_bgffffff_box_1.jpg 2w, /mybundle/giphy_hu3eafc418e52414ace6236bf1d31f82e1_52213_5x0_resize_q50_bgffffff_box_1.jpg 5w, /mybundle/giphy_hu3eafc418e52414ace6236bf1d31f82e1_52213_480x0_resize_q50_bgffffff_box_1.jpg 480w|Smallest: 2|Largest: 480|",
+		"image/png: /mybundle/giphy_hu3eafc418e52414ace6236bf1d31f82e1_52213_2x0_resize_q50_box_1.png 2w, /mybundle/giphy_hu3eafc418e52414ace6236bf1d31f82e1_52213_5x0_resize_q50_box_1.png 5w, /mybundle/giphy_hu3eafc418e52414ace6236bf1d31f82e1_52213_480x0_resize_q50_box_1.png 480w|",
+		"Sources: 1|Fallback: image/png|",
+	)
+
+	b.AssertFileContent("public/mybundle/index.html",
+		"<picture>",
+		`<source type="image/gif" srcset="/mybundle/giphy_hu3eafc418e52414ace6236bf1d31f82e1_52213_4x0_resize_box_1.gif 4w, /mybundle/giphy_hu3eafc418e52414ace6236bf1d31f82e1_52213_16x0_resize_box_1.gif 16w">`,
+		`<img src="/mybundle/giphy_hu3eafc418e52414ace6236bf1d31f82e1_52213_16x0_resize_box_1.png" srcset="/mybundle/giphy_hu3eafc418e52414ace6236bf1d31f82e1_52213_4x0_resize_box_1.png 4w, /mybundle/giphy_hu3eafc418e52414ace6236bf1d31f82e1_52213_16x0_resize_box_1.png 16w" width="16" height="16" alt="Pixel" title="The Title">`,
+		`<img src="https://example.org/a.png" alt="Remote">`,
+	)
+}
+
+func TestImageProcessSetInvalidSpec(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org"
+-- content/mybundle/index.md --
+---
+title: "My Bundle"
+---
+-- content/mybundle/giphy.gif --
+sourcefilename: testdata/giphy.gif
+-- layouts/index.html --
+{{ $img := (site.GetPage "mybundle").Resources.Get "giphy.gif" }}
+{{ $set := $img.ProcessSet "400x heic jpg" }}
+`
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			NeedsOsFS:   true,
+		}).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `image format "heic" is not supported`)
+}
+
+func TestImageAVIF(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org"
+[imaging.avif]
+quality = 50
+speed = 8
+-- content/mybundle/index.md --
+---
+title: "My Bundle"
+---
+-- content/mybundle/sunset.jpg --
+sourcefilename: testdata/sunset.jpg
+-- layouts/index.html --
+{{ $img := (site.GetPage "mybundle").Resources.Get "sunset.jpg" }}
+{{ $avif := $img.Resize "100x avif" }}
+AVIF: {{ $avif.RelPermalink }}|{{ $avif.MediaType }}|{{ $avif.Width }}x{{ $avif.Height }}|
+{{ $png := $avif.Resize "50x png" }}
+PNG: {{ $png.MediaType }}|{{ $png.Width }}x{{ $png.Height }}|
+{{ $set := $img.ProcessSet "80x avif jpg" }}
+{{ range $set.Formats }}{{ .MediaType.Type }}: {{ .Srcset }}|
+{{ end }}
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			NeedsOsFS:   true,
+		}).Build()
+
+	b.AssertFileContent("public/index.html",
+		"AVIF: /mybundle/sunset_hu",
+		"_100x0_resize_q50_s8_box.avif|image/avif|100x62|",
+		"PNG: image/png|50x31|",
+		"_80x0_resize_q50_s8_box.avif 80w|",
+		"image/jpeg: /mybundle/sunset_hu",
+	)
+}
+
+func TestImagePresets(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org"
+[imaging]
+quality = 75
+[imaging.presets.thumbnail]
+quality = 50
+sharpen = 0.5
+[imaging.presets.hero]
+quality = 90
+hint = "picture"
+-- content/mybundle/index.md --
+---
+title: "My Bundle"
+resources:
+- src: "hero.jpg"
+  params:
+    imagePreset: "hero"
+---
+-- content/mybundle/hero.jpg --
+sourcefilename: testdata/sunset.jpg
+-- content/mybundle/plain.jpg --
+sourcefilename: testdata/sunset.jpg
+-- layouts/_default/single.html --
+{{ $hero := .Resources.Get "hero.jpg" }}
+{{ $plain := .Resources.Get "plain.jpg" }}
+{{ $default := $hero.Resize "20x" }}
+{{ $thumb := $hero.Resize "20x preset:thumbnail" }}
+{{ $q := $hero.Resize "20x q30" }}
+{{ $plainResized := $plain.Resize "20x" }}
+Default: {{ $default.RelPermalink }}|
+Thumb: {{ $thumb.RelPermalink }}|
+Q: {{ $q.RelPermalink }}|
+Plain: {{ $plainResized.RelPermalink }}|
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			NeedsOsFS:   true,
+		}).Build()
+
+	b.AssertFileContent("public/mybundle/index.html",
+		"Default: /mybundle/hero_hu59e56ffff1bc1d8d122b1403d34e039f_90587_20x0_resize_q90_box.jpg|",
+		"Thumb: /mybundle/hero_hu59e56ffff1bc1d8d122b1403d34e039f_90587_20x0_resize_q50_sh0.5_box.jpg|",
+		"Q: /mybundle/hero_hu59e56ffff1bc1d8d122b1403d34e039f_90587_20x0_resize_q30_box.jpg|",
+		"Plain: /mybundle/plain_hu59e56ffff1bc1d8d122b1403d34e039f_90587_20x0_resize_q75_box.jpg|",
+	)
+}
+
+func TestImageColors(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org"
+-- content/mybundle/index.md --
+---
+title: "My Bundle"
+---
+-- content/mybundle/sunset.jpg --
+sourcefilename: testdata/sunset.jpg
+-- layouts/_default/single.html --
+{{ $img := .Resources.Get "sunset.jpg" }}
+{{ $resized := $img.Resize "20x" }}
+Dominant: {{ $img.DominantColor }}|
+Average: {{ $img.AverageColor }}|
+Colors: {{ $img.Colors }}|
+Resized: {{ $resized.AverageColor }}|
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			NeedsOsFS:   true,
+		}).Build()
+
+	b.AssertFileContent("public/mybundle/index.html",
+		"Dominant: #2d2f33|",
+		"Average: #544c45|",
+		"Colors: [#2d2f33 #a49e93 #d39e59 #a76936 #737a84 #7c838b]|",
+		"Resized: #",
+	)
+}
+
+func TestRemoteCacheImagesAndGetResource(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	entries := make(map[string]string)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Path == "/data.json" {
+				w.Write([]byte(`{"a": 32}`))
+				return
+			}
+			v, found := entries[r.URL.Path]
+			if !found {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(v))
+		case http.MethodPut:
+			b, _ := io.ReadAll(r.Body)
+			entries[r.URL.Path] = string(b)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	files := `
+-- config.toml --
+baseURL = "https://example.org"
+[security.http]
+methods = ['(?i)GET|PUT']
+[remoteCache]
+url = "SERVER/hugo"
+-- content/mybundle/index.md --
+---
+title: "My Bundle"
+---
+-- content/mybundle/pixel.png --
+iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNkYPhfDwAChwGA60e6kgAAAABJRU5ErkJggg==
+-- layouts/index.html --
+{{ $img := (site.GetPage "mybundle").Resources.Get "pixel.png" }}
+{{ $gif := $img.Resize "1x2 gif" }}
+{{ $data := resources.GetRemote "SERVER/data.json" | transform.Unmarshal }}
+gif: {{ $gif.Width }}|{{ $gif.Height }}|
+data: {{ $data.a }}|
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.ReplaceAll(files, "SERVER", srv.URL),
+			NeedsOsFS:   true,
+		}).Build()
+
+	b.AssertFileContent("public/index.html", "gif: 1|2|", "data: 32|")
+
+	mu.Lock()
+	defer mu.Unlock()
+	caches := make(map[string]bool)
+	for k := range entries {
+		caches[strings.Split(k, "/")[2]] = true
+	}
+	b.Assert(caches, qt.DeepEquals, map[string]bool{"cas": true, "images": true, "getresource": true})
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resources
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cast"
+)
+
+// IntegrityManifest holds the Subresource Integrity hashes of the published
+// fingerprinted resources, keyed by their RelPermalink.
+// Entries are kept between rebuilds, as are the published files.
+type IntegrityManifest struct {
+	mu      sync.RWMutex
+	entries map[string]string
+}
+
+// NewIntegrityManifest creates a new IntegrityManifest.
+func NewIntegrityManifest() *IntegrityManifest {
+	return &IntegrityManifest{entries: make(map[string]string)}
+}
+
+// Add adds the integrity hash of the resource published to relPermalink.
+// This method is thread safe.
+func (m *IntegrityManifest) Add(relPermalink, integrity string) {
+	m.mu.Lock()
+	m.entries[relPermalink] = integrity
+	m.mu.Unlock()
+}
+
+// Get returns the integrity hash of the resource published to
+// relPermalink, or an empty string if not found.
+// The leading slash is optional.
+// This method is thread safe.
+func (m *IntegrityManifest) Get(relPermalink string) string {
+	if !strings.HasPrefix(relPermalink, "/") {
+		relPermalink = "/" + relPermalink
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.entries[relPermalink]
+}
+
+// MarshalJSON marshals the manifest as a JSON object sorted by path.
+func (m *IntegrityManifest) MarshalJSON() ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return json.Marshal(m.entries)
+}
+
+// addToIntegrityManifest adds r to the manifest if it's fingerprinted.
+func (r *resourceAdapter) addToIntegrityManifest() {
+	data, ok := r.target.Data().(map[string]any)
+	if !ok {
+		return
+	}
+	integrity, found := data["Integrity"]
+	if !found {
+		return
+	}
+	r.spec.IntegrityManifest.Add(r.target.RelPermalink(), cast.ToString(integrity))
+}
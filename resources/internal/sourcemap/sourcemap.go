@@ -0,0 +1,350 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sourcemap contains functions to parse, compose and write
+// version 3 source maps, see https://sourcemaps.info/spec.html
+package sourcemap
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Map is a version 3 source map.
+type Map struct {
+	Version        int       `json:"version"`
+	File           string    `json:"file,omitempty"`
+	SourceRoot     string    `json:"sourceRoot,omitempty"`
+	Sources        []string  `json:"sources"`
+	SourcesContent []*string `json:"sourcesContent,omitempty"`
+	Names          []string  `json:"names"`
+	Mappings       string    `json:"mappings"`
+}
+
+// Parse parses the JSON source map in s.
+func Parse(s string) (*Map, error) {
+	var m Map
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		return nil, fmt.Errorf("failed to parse source map: %w", err)
+	}
+	if m.Version != 3 {
+		return nil, fmt.Errorf("unsupported source map version %d", m.Version)
+	}
+	if m.SourceRoot != "" {
+		for i, s := range m.Sources {
+			m.Sources[i] = strings.TrimSuffix(m.SourceRoot, "/") + "/" + s
+		}
+		m.SourceRoot = ""
+	}
+	return &m, nil
+}
+
+// String returns m as JSON.
+func (m *Map) String() string {
+	if m.Sources == nil {
+		m.Sources = []string{}
+	}
+	if m.Names == nil {
+		m.Names = []string{}
+	}
+	b, _ := json.Marshal(m)
+	return string(b)
+}
+
+// segment is a decoded mapping segment.
+// All values are absolute and zero based.
+type segment struct {
+	genCol  int
+	hasSrc  bool
+	src     int
+	srcLine int
+	srcCol  int
+	hasName bool
+	name    int
+}
+
+// decode decodes the mappings in m into one slice of segments per generated line.
+func (m *Map) decode() ([][]segment, error) {
+	var (
+		lines                [][]segment
+		src, srcLine, srcCol int
+		name                 int
+	)
+
+	for _, line := range strings.Split(m.Mappings, ";") {
+		var (
+			segments []segment
+			genCol   int
+		)
+		for _, s := range strings.Split(line, ",") {
+			if s == "" {
+				continue
+			}
+			fields, err := decodeVLQ(s)
+			if err != nil {
+				return nil, err
+			}
+			var seg segment
+			genCol += fields[0]
+			seg.genCol = genCol
+			switch len(fields) {
+			case 1:
+			case 4, 5:
+				src += fields[1]
+				srcLine += fields[2]
+				srcCol += fields[3]
+				seg.hasSrc = true
+				seg.src, seg.srcLine, seg.srcCol = src, srcLine, srcCol
+				if len(fields) == 5 {
+					name += fields[4]
+					seg.hasName = true
+					seg.name = name
+				}
+			default:
+				return nil, fmt.Errorf("invalid source map segment %q", s)
+			}
+			segments = append(segments, seg)
+		}
+		sort.SliceStable(segments, func(i, j int) bool { return segments[i].genCol < segments[j].genCol })
+		lines = append(lines, segments)
+	}
+
+	return lines, nil
+}
+
+// encodeMappings encodes lines into the mappings format.
+func encodeMappings(lines [][]segment) string {
+	var (
+		sb                   strings.Builder
+		src, srcLine, srcCol int
+		name                 int
+	)
+
+	for i, segments := range lines {
+		if i > 0 {
+			sb.WriteByte(';')
+		}
+		var genCol int
+		for j, seg := range segments {
+			if j > 0 {
+				sb.WriteByte(',')
+			}
+			writeVLQ(&sb, seg.genCol-genCol)
+			genCol = seg.genCol
+			if !seg.hasSrc {
+				continue
+			}
+			writeVLQ(&sb, seg.src-src)
+			writeVLQ(&sb, seg.srcLine-srcLine)
+			writeVLQ(&sb, seg.srcCol-srcCol)
+			src, srcLine, srcCol = seg.src, seg.srcLine, seg.srcCol
+			if seg.hasName {
+				writeVLQ(&sb, seg.name-name)
+				name = seg.name
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// Compose returns a source map mapping the generated positions in outer to
+// the original positions in inner, where the source of outer is the
+// generated file of inner, e.g. when inner maps SCSS to CSS and outer maps CSS
+// to minified CSS.
+// Generated positions in outer without a mapping in inner are left unmapped.
+func Compose(outer, inner *Map) (*Map, error) {
+	outerLines, err := outer.decode()
+	if err != nil {
+		return nil, err
+	}
+	innerLines, err := inner.decode()
+	if err != nil {
+		return nil, err
+	}
+
+	composed := &Map{
+		Version:        3,
+		File:           outer.File,
+		Sources:        inner.Sources,
+		SourcesContent: inner.SourcesContent,
+	}
+
+	names := make(map[string]int)
+	nameIndex := func(name string) int {
+		if i, found := names[name]; found {
+			return i
+		}
+		i := len(composed.Names)
+		composed.Names = append(composed.Names, name)
+		names[name] = i
+		return i
+	}
+
+	lines := make([][]segment, len(outerLines))
+	for i, segments := range outerLines {
+		for _, seg := range segments {
+			if !seg.hasSrc {
+				continue
+			}
+			orig, found := lookup(innerLines, seg.srcLine, seg.srcCol)
+			if !found || !orig.hasSrc {
+				continue
+			}
+			s := segment{
+				genCol:  seg.genCol,
+				hasSrc:  true,
+				src:     orig.src,
+				srcLine: orig.srcLine,
+				srcCol:  orig.srcCol,
+			}
+			switch {
+			case seg.hasName && seg.name < len(outer.Names):
+				s.hasName = true
+				s.name = nameIndex(outer.Names[seg.name])
+			case orig.hasName && orig.name < len(inner.Names):
+				s.hasName = true
+				s.name = nameIndex(inner.Names[orig.name])
+			}
+			lines[i] = append(lines[i], s)
+		}
+	}
+
+	composed.Mappings = encodeMappings(lines)
+
+	return composed, nil
+}
+
+// lookup finds the segment in lines covering the given generated position,
+// i.e. the last segment on that line starting at or before col.
+func lookup(lines [][]segment, line, col int) (segment, bool) {
+	if line < 0 || line >= len(lines) {
+		return segment{}, false
+	}
+	segments := lines[line]
+	i := sort.Search(len(segments), func(i int) bool { return segments[i].genCol > col })
+	if i == 0 {
+		return segment{}, false
+	}
+	return segments[i-1], true
+}
+
+const base64Chars = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+
+var base64Values = func() [256]int {
+	var v [256]int
+	for i := range v {
+		v[i] = -1
+	}
+	for i := 0; i < len(base64Chars); i++ {
+		v[base64Chars[i]] = i
+	}
+	return v
+}()
+
+func decodeVLQ(s string) ([]int, error) {
+	var (
+		values       []int
+		value, shift int
+	)
+	for i := 0; i < len(s); i++ {
+		digit := base64Values[s[i]]
+		if digit < 0 {
+			return nil, fmt.Errorf("invalid base64 character %q in source map segment %q", s[i], s)
+		}
+		value += (digit & 31) << shift
+		if digit&32 != 0 {
+			shift += 5
+			continue
+		}
+		if value&1 != 0 {
+			values = append(values, -(value >> 1))
+		} else {
+			values = append(values, value>>1)
+		}
+		value, shift = 0, 0
+	}
+	if shift != 0 {
+		return nil, fmt.Errorf("incomplete source map segment %q", s)
+	}
+	return values, nil
+}
+
+func writeVLQ(sb *strings.Builder, v int) {
+	if v < 0 {
+		v = (-v << 1) | 1
+	} else {
+		v <<= 1
+	}
+	for {
+		digit := v & 31
+		v >>= 5
+		if v > 0 {
+			digit |= 32
+		}
+		sb.WriteByte(base64Chars[digit])
+		if v == 0 {
+			break
+		}
+	}
+}
+
+var commentRe = regexp.MustCompile(`(?:\n)?(?:/\*[#@] sourceMappingURL=([^\s*]*)\s*\*/|//[#@] sourceMappingURL=(\S*))\s*$`)
+
+// ExtractComment removes any trailing sourceMappingURL comment from content,
+// returning the content without the comment and the URL in the comment.
+func ExtractComment(content string) (string, string) {
+	m := commentRe.FindStringSubmatchIndex(content)
+	if m == nil {
+		return content, ""
+	}
+	if m[2] >= 0 {
+		return content[:m[0]], content[m[2]:m[3]]
+	}
+	return content[:m[0]], content[m[4]:m[5]]
+}
+
+const inlinePrefix = "data:application/json;"
+
+// ExtractInline removes a trailing sourceMappingURL comment with an inline
+// base64 encoded source map from content, returning the content without the
+// comment and the source map. The source map is empty if none was found.
+func ExtractInline(content string) (string, string, error) {
+	stripped, url := ExtractComment(content)
+	if !strings.HasPrefix(url, inlinePrefix) {
+		return content, "", nil
+	}
+	_, data, found := strings.Cut(url, "base64,")
+	if !found {
+		return content, "", errors.New("inline source map must be base64 encoded")
+	}
+	b, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return content, "", fmt.Errorf("failed to decode inline source map: %w", err)
+	}
+	return stripped, string(b), nil
+}
+
+// Comment returns the sourceMappingURL comment pointing to url for JavaScript
+// if isJS is set, else for CSS.
+func Comment(url string, isJS bool) string {
+	if isJS {
+		return "\n//# sourceMappingURL=" + url + "\n"
+	}
+	return "\n/*# sourceMappingURL=" + url + " */\n"
+}
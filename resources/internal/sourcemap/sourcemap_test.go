@@ -0,0 +1,127 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sourcemap
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestVLQ(t *testing.T) {
+	c := qt.New(t)
+
+	for _, v := range []int{0, 1, -1, 15, 16, -16, 1000, -123456} {
+		var sb strings.Builder
+		writeVLQ(&sb, v)
+		values, err := decodeVLQ(sb.String())
+		c.Assert(err, qt.IsNil)
+		c.Assert(values, qt.DeepEquals, []int{v})
+	}
+
+	values, err := decodeVLQ("AAgBC")
+	c.Assert(err, qt.IsNil)
+	c.Assert(values, qt.DeepEquals, []int{0, 0, 16, 1})
+
+	_, err = decodeVLQ("g")
+	c.Assert(err, qt.Not(qt.IsNil))
+	_, err = decodeVLQ("A!")
+	c.Assert(err, qt.Not(qt.IsNil))
+}
+
+func TestParseAndString(t *testing.T) {
+	c := qt.New(t)
+
+	m, err := Parse(`{"version":3,"sourceRoot":"src/","sources":["a.scss"],"names":[],"mappings":"AAAA;AACA"}`)
+	c.Assert(err, qt.IsNil)
+	c.Assert(m.Sources, qt.DeepEquals, []string{"src/a.scss"})
+	c.Assert(m.String(), qt.Equals, `{"version":3,"sources":["src/a.scss"],"names":[],"mappings":"AAAA;AACA"}`)
+
+	lines, err := m.decode()
+	c.Assert(err, qt.IsNil)
+	c.Assert(encodeMappings(lines), qt.Equals, m.Mappings)
+
+	_, err = Parse(`{"version":2}`)
+	c.Assert(err, qt.Not(qt.IsNil))
+	_, err = Parse(`{`)
+	c.Assert(err, qt.Not(qt.IsNil))
+}
+
+func TestCompose(t *testing.T) {
+	c := qt.New(t)
+
+	// a.scss to a.css: line 0 maps to line 0, line 1 maps to line 2.
+	inner := &Map{Version: 3, Sources: []string{"a.scss"}, Names: []string{"foo"}}
+	inner.Mappings = encodeMappings([][]segment{
+		{{genCol: 0, hasSrc: true, srcLine: 0, srcCol: 0}},
+		{{genCol: 2, hasSrc: true, srcLine: 2, srcCol: 4, hasName: true, name: 0}},
+	})
+
+	// a.css to a.min.css, all on one line.
+	outer := &Map{Version: 3, File: "a.min.css", Sources: []string{"a.css"}}
+	outer.Mappings = encodeMappings([][]segment{
+		{
+			{genCol: 0, hasSrc: true, srcLine: 0, srcCol: 0},
+			{genCol: 10, hasSrc: true, srcLine: 1, srcCol: 3},
+			// Before the first mapping on line 1 in inner.
+			{genCol: 20, hasSrc: true, srcLine: 1, srcCol: 0},
+			{genCol: 30},
+		},
+	})
+
+	m, err := Compose(outer, inner)
+	c.Assert(err, qt.IsNil)
+	c.Assert(m.File, qt.Equals, "a.min.css")
+	c.Assert(m.Sources, qt.DeepEquals, []string{"a.scss"})
+	c.Assert(m.Names, qt.DeepEquals, []string{"foo"})
+
+	c.Assert(m.Mappings, qt.Equals, encodeMappings([][]segment{
+		{
+			{genCol: 0, hasSrc: true, srcLine: 0, srcCol: 0},
+			{genCol: 10, hasSrc: true, srcLine: 2, srcCol: 4, hasName: true, name: 0},
+		},
+	}))
+}
+
+func TestComments(t *testing.T) {
+	c := qt.New(t)
+
+	content, url := ExtractComment("a{}\n/*# sourceMappingURL=a.css.map */\n")
+	c.Assert(content, qt.Equals, "a{}")
+	c.Assert(url, qt.Equals, "a.css.map")
+
+	content, url = ExtractComment("var a;\n//# sourceMappingURL=a.js.map")
+	c.Assert(content, qt.Equals, "var a;")
+	c.Assert(url, qt.Equals, "a.js.map")
+
+	content, url = ExtractComment("var a;")
+	c.Assert(content, qt.Equals, "var a;")
+	c.Assert(url, qt.Equals, "")
+
+	c.Assert(Comment("a.js.map", true), qt.Equals, "\n//# sourceMappingURL=a.js.map\n")
+	c.Assert(Comment("a.css.map", false), qt.Equals, "\n/*# sourceMappingURL=a.css.map */\n")
+
+	m := `{"version":3,"sources":[],"names":[],"mappings":""}`
+	content, sm, err := ExtractInline("a{}\n/*# sourceMappingURL=data:application/json;base64," + base64.StdEncoding.EncodeToString([]byte(m)) + " */")
+	c.Assert(err, qt.IsNil)
+	c.Assert(content, qt.Equals, "a{}")
+	c.Assert(sm, qt.Equals, m)
+
+	content, sm, err = ExtractInline("a{}\n/*# sourceMappingURL=a.css.map */")
+	c.Assert(err, qt.IsNil)
+	c.Assert(content, qt.Equals, "a{}\n/*# sourceMappingURL=a.css.map */")
+	c.Assert(sm, qt.Equals, "")
+}
@@ -86,6 +86,11 @@ type ContentProvider interface {
 	// PlainWords returns a string slice from splitting Plain using https://pkg.go.dev/strings#Fields.
 	PlainWords(context.Context) []string
 
+	// PlainFast returns the Page Content as plain text extracted from the parsed markup,
+	// i.e. without rendering it to HTML. Shortcodes are not included.
+	// For markup formats that do not support this, it returns the same as Plain.
+	PlainFast(context.Context) string
+
 	// Summary returns a generated summary of the content.
 	// The breakpoint can be set manually by inserting a summary separator in the source file.
 	Summary(context.Context) template.HTML
@@ -407,6 +412,10 @@ type TableOfContentsProvider interface {
 
 	// Fragments returns the fragments for this page.
 	Fragments(context.Context) *tableofcontents.Fragments
+
+	// Headings returns the heading tree for this page, with IDs and levels.
+	// This is a shortcut for .Fragments.Headings.
+	Headings(context.Context) tableofcontents.Headings
 }
 
 // TranslationsProvider provides access to any translations.
@@ -490,6 +499,10 @@ func (p PageWithContext) PlainWords() []string {
 	return p.Page.PlainWords(p.Ctx)
 }
 
+func (p PageWithContext) PlainFast() string {
+	return p.Page.PlainFast(p.Ctx)
+}
+
 func (p PageWithContext) Summary() template.HTML {
 	return p.Page.Summary(p.Ctx)
 }
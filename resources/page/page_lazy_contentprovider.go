@@ -85,6 +85,11 @@ func (lcp *LazyContentProvider) Fragments(ctx context.Context) *tableofcontents.
 	return lcp.cp.Fragments(ctx)
 }
 
+func (lcp *LazyContentProvider) Headings(ctx context.Context) tableofcontents.Headings {
+	lcp.init.Do(ctx)
+	return lcp.cp.Headings(ctx)
+}
+
 func (lcp *LazyContentProvider) Content(ctx context.Context) (any, error) {
 	lcp.init.Do(ctx)
 	return lcp.cp.Content(ctx)
@@ -100,6 +105,11 @@ func (lcp *LazyContentProvider) PlainWords(ctx context.Context) []string {
 	return lcp.cp.PlainWords(ctx)
 }
 
+func (lcp *LazyContentProvider) PlainFast(ctx context.Context) string {
+	lcp.init.Do(ctx)
+	return lcp.cp.PlainFast(ctx)
+}
+
 func (lcp *LazyContentProvider) Summary(ctx context.Context) template.HTML {
 	lcp.init.Do(ctx)
 	return lcp.cp.Summary(ctx)
@@ -19,6 +19,7 @@ import (
 	"strings"
 
 	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/common/types"
 	"github.com/gohugoio/hugo/config"
 	"github.com/gohugoio/hugo/hugofs/glob"
 	"github.com/mitchellh/mapstructure"
@@ -45,16 +46,23 @@ type PageMatcher struct {
 
 // Matches returns whether p matches this matcher.
 func (m PageMatcher) Matches(p Page) bool {
+	return m.MatchesValues(p.Kind(), p.Lang(), p.Pathc(), func() string { return p.Site().Hugo().Environment })
+}
+
+// MatchesValues returns whether a page with the given kind, language and
+// content path, built for the given environment, matches this matcher.
+// This is used for pages that are not fully created yet.
+func (m PageMatcher) MatchesValues(kind, lang, path string, environment func() string) bool {
 	if m.Kind != "" {
 		g, err := glob.GetGlob(m.Kind)
-		if err == nil && !g.Match(p.Kind()) {
+		if err == nil && !g.Match(kind) {
 			return false
 		}
 	}
 
 	if m.Lang != "" {
 		g, err := glob.GetGlob(m.Lang)
-		if err == nil && !g.Match(p.Lang()) {
+		if err == nil && !g.Match(lang) {
 			return false
 		}
 	}
@@ -62,7 +70,7 @@ func (m PageMatcher) Matches(p Page) bool {
 	if m.Path != "" {
 		g, err := glob.GetGlob(m.Path)
 		// TODO(bep) Path() vs filepath vs leading slash.
-		p := strings.ToLower(filepath.ToSlash(p.Pathc()))
+		p := strings.ToLower(filepath.ToSlash(path))
 		if !(strings.HasPrefix(p, "/")) {
 			p = "/" + p
 		}
@@ -73,7 +81,7 @@ func (m PageMatcher) Matches(p Page) bool {
 
 	if m.Environment != "" {
 		g, err := glob.GetGlob(m.Environment)
-		if err == nil && !g.Match(p.Site().Hugo().Environment) {
+		if err == nil && !g.Match(environment()) {
 			return false
 		}
 	}
@@ -199,3 +207,74 @@ func (p *PageMatcherParamsConfig) init() error {
 	maps.PrepareParams(p.Params)
 	return nil
 }
+
+// PageMatcherOutputsConfig configures the output formats of the Pages
+// matching Target, on top of the output formats configured for their Kind.
+type PageMatcherOutputsConfig struct {
+	Target PageMatcher
+
+	// Replaces the output formats configured for the Kind.
+	Outputs []string
+
+	// Output formats to add.
+	Add []string
+
+	// Output formats to remove.
+	Remove []string
+}
+
+// DecodeOutputRules decodes in, a slice of maps, into output rules.
+// The rules are applied in order.
+func DecodeOutputRules(in any) ([]PageMatcherOutputsConfig, error) {
+	if in == nil {
+		return nil, nil
+	}
+	ms, err := maps.ToSliceStringMap(in)
+	if err != nil {
+		return nil, fmt.Errorf("outputRules: %w", err)
+	}
+
+	var rules []PageMatcherOutputsConfig
+	for i, m := range ms {
+		var rule PageMatcherOutputsConfig
+		for k, v := range maps.CleanConfigStringMap(m) {
+			switch strings.ToLower(k) {
+			case "_target", "target":
+				err = decodePageMatcher(v, &rule.Target)
+			case "outputs":
+				rule.Outputs = toLowerStrings(v)
+			case "add":
+				rule.Add = toLowerStrings(v)
+			case "remove":
+				rule.Remove = toLowerStrings(v)
+			default:
+				err = fmt.Errorf("unknown key %q", k)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("outputRules[%d]: %w", i, err)
+			}
+		}
+		for _, pattern := range []string{rule.Target.Path, rule.Target.Kind, rule.Target.Lang, rule.Target.Environment} {
+			if _, err := glob.GetGlob(pattern); err != nil {
+				return nil, fmt.Errorf("outputRules[%d]: invalid glob pattern %q: %w", i, pattern, err)
+			}
+		}
+		if rule.Outputs == nil && len(rule.Add) == 0 && len(rule.Remove) == 0 {
+			return nil, fmt.Errorf("outputRules[%d]: one of outputs, add or remove must be set", i)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func toLowerStrings(v any) []string {
+	s := types.ToStringSlicePreserveString(v)
+	for i, v := range s {
+		s[i] = strings.ToLower(v)
+	}
+	if s == nil {
+		s = []string{}
+	}
+	return s
+}
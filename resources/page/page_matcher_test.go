@@ -169,3 +169,41 @@ func (c testConfig) Environment() string {
 func (c testConfig) WorkingDir() string {
 	return c.workingDir
 }
+
+func TestDecodeOutputRules(t *testing.T) {
+	c := qt.New(t)
+
+	got, err := DecodeOutputRules([]map[string]any{
+		{
+			"_target": map[string]any{"path": "/api/**"},
+			"add":     []string{"OpenAPI"},
+		},
+		{
+			"target":  map[string]any{"path": "/docs/**", "lang": "de"},
+			"remove":  "print",
+			"outputs": []string{},
+		},
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(got, qt.DeepEquals, []PageMatcherOutputsConfig{
+		{Target: PageMatcher{Path: "/api/**"}, Add: []string{"openapi"}},
+		{Target: PageMatcher{Path: "/docs/**", Lang: "de"}, Outputs: []string{}, Remove: []string{"print"}},
+	})
+
+	got, err = DecodeOutputRules(nil)
+	c.Assert(err, qt.IsNil)
+	c.Assert(got, qt.IsNil)
+
+	for _, test := range []struct {
+		in     map[string]any
+		expect string
+	}{
+		{map[string]any{"_target": map[string]any{"kind": "foo"}, "add": "html"}, `outputRules\[0\]: "foo" did not match a valid Page Kind`},
+		{map[string]any{"_target": map[string]any{"path": "/a/[b"}, "add": "html"}, `outputRules\[0\]: invalid glob pattern "/a/\[b".*`},
+		{map[string]any{"_target": map[string]any{"path": "/a/**"}}, `outputRules\[0\]: one of outputs, add or remove must be set`},
+		{map[string]any{"formats": "html"}, `outputRules\[0\]: unknown key "formats"`},
+	} {
+		_, err := DecodeOutputRules([]map[string]any{test.in})
+		c.Assert(err, qt.ErrorMatches, test.expect)
+	}
+}
@@ -329,6 +329,10 @@ func (p *nopPage) Paginate(seq any, options ...any) (*Pager, error) {
 	return nil, nil
 }
 
+func (p *nopPage) PaginateGroups(groups any, options ...any) (*Pager, error) {
+	return nil, nil
+}
+
 func (p *nopPage) Paginator(options ...any) (*Pager, error) {
 	return nil, nil
 }
@@ -373,6 +377,10 @@ func (p *nopPage) PlainWords(context.Context) []string {
 	return nil
 }
 
+func (p *nopPage) PlainFast(context.Context) string {
+	return ""
+}
+
 func (p *nopPage) Prev() Page {
 	return nil
 }
@@ -532,6 +540,10 @@ func (p *nopPage) GetIdentity() identity.Identity {
 func (p *nopPage) Fragments(context.Context) *tableofcontents.Fragments {
 	return nil
 }
+func (p *nopPage) Headings(context.Context) tableofcontents.Headings {
+	return nil
+}
+
 func (p *nopPage) HeadingsFiltered(context.Context) tableofcontents.Headings {
 	return nil
 }
@@ -0,0 +1,248 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pagemeta
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gohugoio/hugo/common/htime"
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/resources/resource"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/cast"
+)
+
+// Fixture is a table driven test case for the front matter handling: the
+// front matter and file of a page and the values expected to be resolved
+// from them with the front matter config of the site.
+// Site maintainers keep them next to their config and run them with
+// hugo test meta to catch regressions when changing the front matter config.
+type Fixture struct {
+	// The name of the test case.
+	Name string
+
+	// The content file's base filename, e.g. "2023-04-05-my-post.md", or the
+	// bundle's directory name.
+	Filename string
+
+	// The content file's mod time, used by :fileModTime.
+	ModTime string
+
+	// The Git author date, used by :git.
+	GitAuthorDate string
+
+	// The page's front matter.
+	FrontMatter map[string]any
+
+	// The expected values. Only the values set are checked.
+	Expect FixtureExpect
+}
+
+// FixtureExpect holds the values expected to be resolved from a Fixture.
+type FixtureExpect struct {
+	// The expected dates, e.g. "2023-04-05" or "2023-04-05T10:00:00Z".
+	// Use "none" to expect a date not set.
+	Date        string
+	Lastmod     string
+	PublishDate string
+	ExpiryDate  string
+
+	// The expected slug and front matter URL.
+	Slug string
+	URL  string
+
+	// The expected params, e.g. a date param set by the date handlers.
+	Params map[string]any
+}
+
+// FixtureFailure is a value resolved from a Fixture not matching the expected one.
+type FixtureFailure struct {
+	Fixture  string
+	Field    string
+	Expected string
+	Got      string
+}
+
+func (f FixtureFailure) String() string {
+	return fmt.Sprintf("%s: %s: expected %q, got %q", f.Fixture, f.Field, f.Expected, f.Got)
+}
+
+// DecodeFixtures decodes the fixtures in the "fixtures" slice of m,
+// e.g. a TOML file with [[fixtures]] tables.
+func DecodeFixtures(m map[string]any) ([]Fixture, error) {
+	var fixtures []Fixture
+	v, _ := maps.LookupEqualFold(m, "fixtures")
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		WeaklyTypedInput: true,
+		Result:           &fixtures,
+		// Allow unquoted TOML and YAML dates in the string fields.
+		DecodeHook: func(from, to reflect.Type, data any) (any, error) {
+			if to.Kind() != reflect.String || from.Kind() != reflect.Struct {
+				return data, nil
+			}
+			switch v := data.(type) {
+			case time.Time:
+				return v.Format(time.RFC3339), nil
+			case fmt.Stringer:
+				// E.g. TOML's local dates, without time zone.
+				return v.String(), nil
+			}
+			return data, nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(v); err != nil {
+		return nil, fmt.Errorf("failed to decode fixtures: %w", err)
+	}
+	for i, fix := range fixtures {
+		if fix.Name == "" {
+			fixtures[i].Name = fmt.Sprintf("fixture %d", i+1)
+		}
+	}
+	return fixtures, nil
+}
+
+// RunFixture resolves the front matter of fix the way Hugo does for a page,
+// with the dates without time zone in loc, and returns the values not
+// matching the expectations.
+func (f FrontMatterHandler) RunFixture(fix Fixture, loc *time.Location) ([]FixtureFailure, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	frontmatter := make(map[string]any)
+	params := make(map[string]any)
+	for k, v := range fix.FrontMatter {
+		k = strings.ToLower(k)
+		frontmatter[k] = v
+		if !f.IsDateKey(k) {
+			params[k] = v
+		}
+	}
+
+	d := &FrontMatterDescriptor{
+		Frontmatter:  frontmatter,
+		BaseFilename: fix.Filename,
+		Params:       params,
+		Dates:        &resource.Dates{},
+		PageURLs:     &URLPath{},
+		Location:     loc,
+	}
+	var err error
+	if d.ModTime, err = parseFixtureDate(fix.ModTime, loc); err != nil {
+		return nil, fmt.Errorf("%s: modTime: %w", fix.Name, err)
+	}
+	if d.GitAuthorDate, err = parseFixtureDate(fix.GitAuthorDate, loc); err != nil {
+		return nil, fmt.Errorf("%s: gitAuthorDate: %w", fix.Name, err)
+	}
+
+	if err := f.HandleDates(d); err != nil {
+		return nil, fmt.Errorf("%s: %w", fix.Name, err)
+	}
+
+	// See the page metadata handling in hugolib.
+	if v, found := frontmatter["slug"]; found {
+		d.PageURLs.Slug = strings.Trim(cast.ToString(v), "-")
+		params["slug"] = d.PageURLs.Slug
+	}
+	if v, found := frontmatter["url"]; found {
+		d.PageURLs.URL = cast.ToString(v)
+	}
+
+	var failures []FixtureFailure
+	fail := func(field, expected, got string) {
+		failures = append(failures, FixtureFailure{Fixture: fix.Name, Field: field, Expected: expected, Got: got})
+	}
+
+	for _, dt := range []struct {
+		field    string
+		expected string
+		got      time.Time
+	}{
+		{"date", fix.Expect.Date, d.Dates.FDate},
+		{"lastmod", fix.Expect.Lastmod, d.Dates.FLastmod},
+		{"publishDate", fix.Expect.PublishDate, d.Dates.FPublishDate},
+		{"expiryDate", fix.Expect.ExpiryDate, d.Dates.FExpiryDate},
+	} {
+		if dt.expected == "" {
+			continue
+		}
+		expected, err := parseFixtureDate(dt.expected, loc)
+		if err != nil {
+			return nil, fmt.Errorf("%s: expect.%s: %w", fix.Name, dt.field, err)
+		}
+		if !expected.Equal(dt.got) {
+			fail(dt.field, dt.expected, formatFixtureValue(dt.got))
+		}
+	}
+
+	if fix.Expect.Slug != "" && fix.Expect.Slug != d.PageURLs.Slug {
+		fail("slug", fix.Expect.Slug, d.PageURLs.Slug)
+	}
+	if fix.Expect.URL != "" && fix.Expect.URL != d.PageURLs.URL {
+		fail("url", fix.Expect.URL, d.PageURLs.URL)
+	}
+
+	var keys []string
+	for k := range fix.Expect.Params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		expected := fix.Expect.Params[k]
+		got, found := params[strings.ToLower(k)]
+		field := "params." + k
+		if !found {
+			fail(field, formatFixtureValue(expected), "")
+			continue
+		}
+		if gt, ok := got.(time.Time); ok {
+			et, err := htime.ToTimeInDefaultLocationE(expected, loc)
+			if err != nil || !et.Equal(gt) {
+				fail(field, formatFixtureValue(expected), formatFixtureValue(got))
+			}
+			continue
+		}
+		if formatFixtureValue(expected) != formatFixtureValue(got) {
+			fail(field, formatFixtureValue(expected), formatFixtureValue(got))
+		}
+	}
+
+	return failures, nil
+}
+
+const fixtureNoDate = "none"
+
+func parseFixtureDate(s string, loc *time.Location) (time.Time, error) {
+	if s == "" || s == fixtureNoDate {
+		return time.Time{}, nil
+	}
+	return htime.ToTimeInDefaultLocationE(s, loc)
+}
+
+func formatFixtureValue(v any) string {
+	if t, ok := v.(time.Time); ok {
+		if t.IsZero() {
+			return fixtureNoDate
+		}
+		return t.Format(time.RFC3339)
+	}
+	return fmt.Sprint(v)
+}
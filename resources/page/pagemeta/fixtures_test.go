@@ -0,0 +1,107 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pagemeta_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/parser/metadecoders"
+	"github.com/gohugoio/hugo/resources/page/pagemeta"
+
+	qt "github.com/frankban/quicktest"
+)
+
+const testFixtures = `
+[[fixtures]]
+name = "Date from filename"
+filename = "2023-04-05-my-post.md"
+modTime = "2023-05-01T10:00:00Z"
+[fixtures.frontMatter]
+title = "My Post"
+[fixtures.expect]
+date = 2023-04-05
+lastmod = "2023-05-01T10:00:00Z"
+expiryDate = "none"
+slug = "my-post"
+[fixtures.expect.params]
+title = "My Post"
+
+[[fixtures]]
+filename = "2023-04-05-my-post.md"
+[fixtures.frontMatter]
+Slug = "-custom-"
+url = "/custom/"
+date = "2023-01-02T10:00:00"
+tags = ["a", "b"]
+[fixtures.expect]
+date = "2023-02-02"
+slug = "wrong"
+url = "/custom/"
+[fixtures.expect.params]
+date = "2023-01-02T10:00:00"
+tags = ["a", "b"]
+slug = "custom"
+missing = "foo"
+`
+
+func TestRunFixtures(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := config.New()
+	cfg.Set("frontmatter", map[string]any{
+		"date":    []string{":filename", ":default"},
+		"lastmod": []string{":fileModTime"},
+	})
+	fc, err := pagemeta.DecodeFrontMatterConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	handler, err := pagemeta.NewFrontmatterHandler(nil, fc)
+	c.Assert(err, qt.IsNil)
+
+	m, err := metadecoders.Default.UnmarshalToMap([]byte(testFixtures), metadecoders.TOML)
+	c.Assert(err, qt.IsNil)
+	fixtures, err := pagemeta.DecodeFixtures(m)
+	c.Assert(err, qt.IsNil)
+	c.Assert(fixtures, qt.HasLen, 2)
+	c.Assert(fixtures[0].Expect.Date, qt.Equals, "2023-04-05")
+	c.Assert(fixtures[1].Name, qt.Equals, "fixture 2")
+
+	loc, _ := time.LoadLocation("Europe/Oslo")
+
+	failures, err := handler.RunFixture(fixtures[0], loc)
+	c.Assert(err, qt.IsNil)
+	c.Assert(failures, qt.HasLen, 0)
+
+	fixtures[0].Expect.Date = "2023-04-05T00:00:00Z"
+	failures, err = handler.RunFixture(fixtures[0], loc)
+	c.Assert(err, qt.IsNil)
+	c.Assert(failures, qt.HasLen, 1)
+	c.Assert(failures[0].String(), qt.Equals, `Date from filename: date: expected "2023-04-05T00:00:00Z", got "2023-04-05T00:00:00+02:00"`)
+
+	failures, err = handler.RunFixture(fixtures[1], loc)
+	c.Assert(err, qt.IsNil)
+	var s []string
+	for _, f := range failures {
+		s = append(s, f.String())
+	}
+	c.Assert(strings.Join(s, "\n"), qt.Equals, `fixture 2: date: expected "2023-02-02", got "2023-04-05T00:00:00+02:00"
+fixture 2: slug: expected "wrong", got "custom"
+fixture 2: params.missing: expected "foo", got ""`)
+
+	fixtures[1].Expect.Date = "bad"
+	_, err = handler.RunFixture(fixtures[1], loc)
+	c.Assert(err, qt.ErrorMatches, "fixture 2: expect.date: .*")
+}
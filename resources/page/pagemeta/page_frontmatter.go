@@ -14,6 +14,7 @@
 package pagemeta
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
@@ -111,9 +112,30 @@ func (f FrontMatterHandler) HandleDates(d *FrontMatterDescriptor) error {
 		return err
 	}
 
+	f.roundDates(d)
+
 	return nil
 }
 
+// roundDates truncates or rounds the resolved dates, including the date
+// params, to the configured granularity.
+func (f FrontMatterHandler) roundDates(d *FrontMatterDescriptor) {
+	if f.fmConfig.DateGranularity == "" {
+		return
+	}
+
+	d.Dates.FDate = f.fmConfig.roundDate(d.Dates.FDate)
+	d.Dates.FLastmod = f.fmConfig.roundDate(d.Dates.FLastmod)
+	d.Dates.FPublishDate = f.fmConfig.roundDate(d.Dates.FPublishDate)
+	d.Dates.FExpiryDate = f.fmConfig.roundDate(d.Dates.FExpiryDate)
+
+	for k := range f.allDateKeys {
+		if t, ok := d.Params[k].(time.Time); ok {
+			d.Params[k] = f.fmConfig.roundDate(t)
+		}
+	}
+}
+
 // IsDateKey returns whether the given front matter key is considered a date by the current
 // configuration.
 func (f FrontMatterHandler) IsDateKey(key string) bool {
@@ -168,6 +190,41 @@ type FrontmatterConfig struct {
 	PublishDate []string
 	// Controls how the ExpiryDate is set from front matter.
 	ExpiryDate []string
+
+	// If set, all dates are truncated (or rounded, see DateRounding) to this
+	// granularity, either "day" or a duration, e.g. "1h". This is useful to
+	// avoid noisy diffs in sitemaps and feeds when using :git or :filemodtime.
+	DateGranularity string
+
+	// Either "truncate" (default) or "round".
+	DateRounding string
+
+	granularity time.Duration
+}
+
+const (
+	dateGranularityDay = "day"
+	dateRoundingRound  = "round"
+)
+
+func (c FrontmatterConfig) roundDate(t time.Time) time.Time {
+	if t.IsZero() || c.DateGranularity == "" {
+		return t
+	}
+
+	if c.DateGranularity == dateGranularityDay {
+		// Use the date's own location, time.Truncate works in UTC.
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		if c.DateRounding == dateRoundingRound && t.Sub(day) >= 12*time.Hour {
+			day = day.AddDate(0, 0, 1)
+		}
+		return day
+	}
+
+	if c.DateRounding == dateRoundingRound {
+		return t.Round(c.granularity)
+	}
+	return t.Truncate(c.granularity)
 }
 
 const (
@@ -215,10 +272,28 @@ func DecodeFrontMatterConfig(cfg config.Provider) (FrontmatterConfig, error) {
 				c.Lastmod = toLowerSlice(v)
 			case fmExpiryDate:
 				c.ExpiryDate = toLowerSlice(v)
+			case "dategranularity":
+				c.DateGranularity = strings.ToLower(cast.ToString(v))
+			case "daterounding":
+				c.DateRounding = strings.ToLower(cast.ToString(v))
 			}
 		}
 	}
 
+	if c.DateGranularity != "" && c.DateGranularity != dateGranularityDay {
+		d, err := time.ParseDuration(c.DateGranularity)
+		if err != nil || d <= 0 {
+			return c, fmt.Errorf("invalid frontmatter.dateGranularity %q: must be \"day\" or a positive duration, e.g. \"1h\"", c.DateGranularity)
+		}
+		c.granularity = d
+	}
+
+	switch c.DateRounding {
+	case "", "truncate", dateRoundingRound:
+	default:
+		return c, fmt.Errorf("invalid frontmatter.dateRounding %q: must be \"truncate\" or \"round\"", c.DateRounding)
+	}
+
 	expander := func(c, d []string) []string {
 		out := expandDefaultValues(c, d)
 		out = addDateFieldAliases(out)
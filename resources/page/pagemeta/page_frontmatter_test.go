@@ -25,6 +25,7 @@ import (
 	"github.com/gohugoio/hugo/resources/resource"
 
 	qt "github.com/frankban/quicktest"
+	"github.com/google/go-cmp/cmp"
 )
 
 func newTestFd() *pagemeta.FrontMatterDescriptor {
@@ -198,3 +199,64 @@ func TestFrontMatterDatesDefaultKeyword(t *testing.T) {
 	c.Assert(d.Dates.FPublishDate.Day(), qt.Equals, 4)
 	c.Assert(d.Dates.FExpiryDate.IsZero(), qt.Equals, true)
 }
+
+func TestFrontMatterDateGranularity(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	newHandler := func(fm map[string]any) pagemeta.FrontMatterHandler {
+		cfg := config.New()
+		cfg.Set("frontmatter", fm)
+		conf := testconfig.GetTestConfig(nil, cfg)
+		handler, err := pagemeta.NewFrontmatterHandler(nil, conf.GetConfigSection("frontmatter").(pagemeta.FrontmatterConfig))
+		c.Assert(err, qt.IsNil)
+		return handler
+	}
+
+	// Front matter dates may come back with a fixed zone.
+	eqTime := qt.CmpEquals(cmp.Comparer(func(a, b time.Time) bool { return a.Equal(b) }))
+
+	loc, _ := time.LoadLocation("Europe/Oslo")
+	gitDate := time.Date(2023, 5, 10, 18, 42, 13, 0, loc)
+	date := time.Date(2023, 5, 9, 8, 31, 59, 0, loc)
+
+	for _, test := range []struct {
+		granularity string
+		rounding    string
+		expectDate  time.Time
+		expectMod   time.Time
+	}{
+		{"day", "", time.Date(2023, 5, 9, 0, 0, 0, 0, loc), time.Date(2023, 5, 10, 0, 0, 0, 0, loc)},
+		{"Day", "round", time.Date(2023, 5, 9, 0, 0, 0, 0, loc), time.Date(2023, 5, 11, 0, 0, 0, 0, loc)},
+		{"1h", "truncate", time.Date(2023, 5, 9, 8, 0, 0, 0, loc), time.Date(2023, 5, 10, 18, 0, 0, 0, loc)},
+		{"1h", "round", time.Date(2023, 5, 9, 9, 0, 0, 0, loc), time.Date(2023, 5, 10, 19, 0, 0, 0, loc)},
+		{"", "", date, gitDate},
+	} {
+		handler := newHandler(map[string]any{
+			"dateGranularity": test.granularity,
+			"dateRounding":    test.rounding,
+		})
+
+		d := newTestFd()
+		d.GitAuthorDate = gitDate
+		d.Frontmatter["date"] = date
+		c.Assert(handler.HandleDates(d), qt.IsNil)
+		comment := qt.Commentf("%s %s", test.granularity, test.rounding)
+		c.Assert(d.Dates.FDate, eqTime, test.expectDate, comment)
+		c.Assert(d.Params["date"], eqTime, test.expectDate, comment)
+		c.Assert(d.Dates.FLastmod, eqTime, test.expectMod, comment)
+		c.Assert(d.Dates.FPublishDate, eqTime, test.expectDate, comment)
+		c.Assert(d.Dates.FExpiryDate.IsZero(), qt.IsTrue)
+	}
+
+	for _, fm := range []map[string]any{
+		{"dateGranularity": "week"},
+		{"dateGranularity": "-1h"},
+		{"dateGranularity": "day", "dateRounding": "ceil"},
+	} {
+		cfg := config.New()
+		cfg.Set("frontmatter", fm)
+		_, err := pagemeta.DecodeFrontMatterConfig(cfg)
+		c.Assert(err, qt.Not(qt.IsNil))
+	}
+}
@@ -0,0 +1,401 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pagemeta
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Recurrence frequencies.
+const (
+	FreqDaily   = "DAILY"
+	FreqWeekly  = "WEEKLY"
+	FreqMonthly = "MONTHLY"
+	FreqYearly  = "YEARLY"
+)
+
+// The maximum number of periods to expand, to guard against rules that
+// never match.
+const maxRecurrencePeriods = 100000
+
+// Recurrence is a rule for recurring events, a subset of the RRULE in
+// RFC 5545, e.g. "FREQ=MONTHLY;BYDAY=1TU;COUNT=12".
+//
+// Supported are FREQ (DAILY, WEEKLY, MONTHLY or YEARLY), INTERVAL, COUNT,
+// UNTIL, BYDAY, BYMONTHDAY and BYMONTH. Weeks start on Monday.
+type Recurrence struct {
+	Freq     string
+	Interval int
+
+	// The maximum number of occurrences, 0 if not set.
+	Count int
+
+	// The last possible occurrence, zero if not set.
+	Until time.Time
+
+	// Whether Until is in the local time of the event, i.e. it was not
+	// given in UTC.
+	UntilIsLocal bool
+
+	ByDay      []RecurrenceDay
+	ByMonthDay []int
+	ByMonth    []time.Month
+
+	rule string
+}
+
+// RecurrenceDay is a weekday in a BYDAY rule part, e.g. "MO" or "-1FR" (the
+// last Friday of the month).
+type RecurrenceDay struct {
+	Weekday time.Weekday
+
+	// The nth occurrence of the weekday in the month or year, counted from
+	// the end if negative. 0 means every occurrence.
+	N int
+}
+
+var recurrenceWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// ParseRecurrence parses the recurrence rule in s.
+// The "RRULE:" prefix is optional.
+func ParseRecurrence(s string) (*Recurrence, error) {
+	rule := strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	r := &Recurrence{Interval: 1, rule: rule}
+
+	errorf := func(format string, args ...any) (*Recurrence, error) {
+		return nil, fmt.Errorf("invalid recurrence rule %q: %s", s, fmt.Sprintf(format, args...))
+	}
+
+	for _, part := range strings.Split(rule, ";") {
+		if part == "" {
+			continue
+		}
+		name, value, found := strings.Cut(part, "=")
+		if !found || value == "" {
+			return errorf("expected NAME=VALUE, got %q", part)
+		}
+		name = strings.ToUpper(name)
+		value = strings.ToUpper(value)
+
+		var err error
+		switch name {
+		case "FREQ":
+			switch value {
+			case FreqDaily, FreqWeekly, FreqMonthly, FreqYearly:
+				r.Freq = value
+			default:
+				return errorf("unsupported FREQ %q, must be one of DAILY, WEEKLY, MONTHLY or YEARLY", value)
+			}
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(value)
+			if err != nil || r.Interval < 1 {
+				return errorf("INTERVAL must be a positive integer")
+			}
+		case "COUNT":
+			r.Count, err = strconv.Atoi(value)
+			if err != nil || r.Count < 1 {
+				return errorf("COUNT must be a positive integer")
+			}
+		case "UNTIL":
+			r.Until, err = parseRecurrenceDate(value)
+			if err != nil {
+				return errorf("invalid UNTIL %q", value)
+			}
+			r.UntilIsLocal = !strings.HasSuffix(value, "Z")
+		case "BYDAY":
+			for _, v := range strings.Split(value, ",") {
+				if len(v) < 2 {
+					return errorf("invalid BYDAY %q", v)
+				}
+				wd, found := recurrenceWeekdays[v[len(v)-2:]]
+				if !found {
+					return errorf("invalid BYDAY %q", v)
+				}
+				d := RecurrenceDay{Weekday: wd}
+				if n := v[:len(v)-2]; n != "" {
+					d.N, err = strconv.Atoi(n)
+					if err != nil || d.N == 0 || d.N < -53 || d.N > 53 {
+						return errorf("invalid BYDAY %q", v)
+					}
+				}
+				r.ByDay = append(r.ByDay, d)
+			}
+		case "BYMONTHDAY":
+			for _, v := range strings.Split(value, ",") {
+				d, err := strconv.Atoi(v)
+				if err != nil || d == 0 || d < -31 || d > 31 {
+					return errorf("invalid BYMONTHDAY %q", v)
+				}
+				r.ByMonthDay = append(r.ByMonthDay, d)
+			}
+		case "BYMONTH":
+			for _, v := range strings.Split(value, ",") {
+				m, err := strconv.Atoi(v)
+				if err != nil || m < 1 || m > 12 {
+					return errorf("invalid BYMONTH %q", v)
+				}
+				r.ByMonth = append(r.ByMonth, time.Month(m))
+			}
+		default:
+			return errorf("unsupported rule part %q", name)
+		}
+	}
+
+	if r.Freq == "" {
+		return errorf("FREQ must be set")
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return errorf("only one of COUNT and UNTIL can be set")
+	}
+	for _, d := range r.ByDay {
+		if d.N == 0 {
+			continue
+		}
+		if r.Freq != FreqMonthly && r.Freq != FreqYearly {
+			return errorf("BYDAY with a number is only supported with FREQ=MONTHLY or FREQ=YEARLY")
+		}
+		if r.Freq == FreqYearly && len(r.ByMonth) == 0 {
+			return errorf("BYDAY with a number and FREQ=YEARLY requires BYMONTH")
+		}
+	}
+
+	return r, nil
+}
+
+func parseRecurrenceDate(s string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			if layout == "20060102" {
+				// The whole day.
+				t = t.Add(24*time.Hour - time.Nanosecond)
+			}
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", s)
+}
+
+// String returns the rule as it was parsed, without the "RRULE:" prefix.
+func (r *Recurrence) String() string {
+	return r.rule
+}
+
+// Between returns the start times of the occurrences of an event first
+// starting at start that start in the interval [from, to), in order.
+// The first occurrence is always start, even if it doesn't match the rule.
+func (r *Recurrence) Between(start, from, to time.Time) []time.Time {
+	var occurrences []time.Time
+
+	until := r.Until
+	if !until.IsZero() && r.UntilIsLocal {
+		until = time.Date(until.Year(), until.Month(), until.Day(), until.Hour(), until.Minute(), until.Second(), until.Nanosecond(), start.Location())
+	}
+
+	count := 0
+	add := func(t time.Time) bool {
+		if !until.IsZero() && t.After(until) {
+			return false
+		}
+		if r.Count > 0 && count >= r.Count {
+			return false
+		}
+		if !t.Before(to) {
+			return false
+		}
+		count++
+		if !t.Before(from) {
+			occurrences = append(occurrences, t)
+		}
+		return true
+	}
+
+	if !add(start) {
+		return occurrences
+	}
+
+	for period := 0; period < maxRecurrencePeriods; period++ {
+		periodStart, candidates := r.candidates(start, period*r.Interval)
+		if !periodStart.Before(to) {
+			break
+		}
+		for _, t := range candidates {
+			if !t.After(start) {
+				continue
+			}
+			if !add(t) {
+				return occurrences
+			}
+		}
+	}
+
+	return occurrences
+}
+
+// candidates returns the start of the period offset periods of the
+// frequency after the one start is in and the possible occurrences in it,
+// in order.
+func (r *Recurrence) candidates(start time.Time, offset int) (time.Time, []time.Time) {
+	y, m, d := start.Date()
+	hh, mm, ss := start.Clock()
+	loc := start.Location()
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, hh, mm, ss, start.Nanosecond(), loc)
+	}
+
+	var (
+		periodStart time.Time
+		candidates  []time.Time
+	)
+
+	switch r.Freq {
+	case FreqDaily:
+		t := date(y, m, d+offset)
+		periodStart = t
+		if r.matchesMonth(t) && r.matchesMonthDay(t) && r.matchesWeekday(t) {
+			candidates = append(candidates, t)
+		}
+	case FreqWeekly:
+		// Weeks start on Monday.
+		monday := date(y, m, d-(int(start.Weekday())+6)%7+7*offset)
+		periodStart = monday
+		for i := 0; i < 7; i++ {
+			t := monday.AddDate(0, 0, i)
+			if len(r.ByDay) == 0 && t.Weekday() != start.Weekday() {
+				continue
+			}
+			if r.matchesMonth(t) && r.matchesWeekday(t) {
+				candidates = append(candidates, t)
+			}
+		}
+	case FreqMonthly:
+		first := date(y, m+time.Month(offset), 1)
+		periodStart = first
+		if r.matchesMonth(first) {
+			candidates = r.inMonth(first, d)
+		}
+	case FreqYearly:
+		periodStart = date(y+offset, time.January, 1)
+		months := r.ByMonth
+		if len(months) == 0 {
+			months = []time.Month{m}
+		}
+		for _, month := range months {
+			candidates = append(candidates, r.inMonth(date(y+offset, month, 1), d)...)
+		}
+		sort.Slice(candidates, func(i, j int) bool { return candidates[i].Before(candidates[j]) })
+	}
+
+	return periodStart, candidates
+}
+
+// inMonth returns the days, in order, matching the rule in the month
+// starting at first. day is the day of month of the first occurrence.
+func (r *Recurrence) inMonth(first time.Time, day int) []time.Time {
+	daysInMonth := first.AddDate(0, 1, -1).Day()
+
+	var days []time.Time
+	for i := 0; i < daysInMonth; i++ {
+		t := first.AddDate(0, 0, i)
+		switch {
+		case len(r.ByMonthDay) > 0:
+			if !r.matchesMonthDay(t) || !r.matchesWeekday(t) {
+				continue
+			}
+		case len(r.ByDay) > 0:
+			if !r.matchesWeekdayInMonth(t, daysInMonth) {
+				continue
+			}
+		default:
+			// The same day as the first occurrence, skipping months
+			// without it.
+			if t.Day() != day {
+				continue
+			}
+		}
+		days = append(days, t)
+	}
+
+	return days
+}
+
+func (r *Recurrence) matchesMonth(t time.Time) bool {
+	if len(r.ByMonth) == 0 {
+		return true
+	}
+	for _, m := range r.ByMonth {
+		if t.Month() == m {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Recurrence) matchesMonthDay(t time.Time) bool {
+	if len(r.ByMonthDay) == 0 {
+		return true
+	}
+	daysInMonth := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	for _, d := range r.ByMonthDay {
+		if d < 0 {
+			d = daysInMonth + d + 1
+		}
+		if t.Day() == d {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesWeekday reports whether t is on one of the BYDAY weekdays,
+// ignoring any numbers.
+func (r *Recurrence) matchesWeekday(t time.Time) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, d := range r.ByDay {
+		if t.Weekday() == d.Weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesWeekdayInMonth reports whether t matches one of the BYDAY weekdays,
+// numbered within the month.
+func (r *Recurrence) matchesWeekdayInMonth(t time.Time, daysInMonth int) bool {
+	n := (t.Day()-1)/7 + 1
+	nFromEnd := -((daysInMonth-t.Day())/7 + 1)
+	for _, d := range r.ByDay {
+		if t.Weekday() != d.Weekday {
+			continue
+		}
+		if d.N == 0 || d.N == n || d.N == nFromEnd {
+			return true
+		}
+	}
+	return false
+}
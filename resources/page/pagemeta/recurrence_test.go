@@ -0,0 +1,117 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pagemeta
+
+import (
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	c := qt.New(t)
+
+	r, err := ParseRecurrence("RRULE:FREQ=monthly;INTERVAL=2;BYDAY=1TU,-1FR;UNTIL=20231231")
+	c.Assert(err, qt.IsNil)
+	c.Assert(r.Freq, qt.Equals, FreqMonthly)
+	c.Assert(r.Interval, qt.Equals, 2)
+	c.Assert(r.ByDay, qt.DeepEquals, []RecurrenceDay{{Weekday: time.Tuesday, N: 1}, {Weekday: time.Friday, N: -1}})
+	c.Assert(r.Until, qt.Equals, time.Date(2023, 12, 31, 23, 59, 59, 999999999, time.UTC))
+	c.Assert(r.UntilIsLocal, qt.IsTrue)
+	c.Assert(r.String(), qt.Equals, "FREQ=monthly;INTERVAL=2;BYDAY=1TU,-1FR;UNTIL=20231231")
+
+	for _, test := range []struct {
+		rule   string
+		expect string
+	}{
+		{"INTERVAL=2", "FREQ must be set"},
+		{"FREQ=HOURLY", `unsupported FREQ "HOURLY".*`},
+		{"FREQ=DAILY;COUNT=0", "COUNT must be a positive integer"},
+		{"FREQ=DAILY;COUNT=2;UNTIL=20230101", "only one of COUNT and UNTIL can be set"},
+		{"FREQ=WEEKLY;BYDAY=XX", `invalid BYDAY "XX"`},
+		{"FREQ=WEEKLY;BYDAY=1MO", "BYDAY with a number is only supported with FREQ=MONTHLY or FREQ=YEARLY"},
+		{"FREQ=YEARLY;BYDAY=1MO", "BYDAY with a number and FREQ=YEARLY requires BYMONTH"},
+		{"FREQ=MONTHLY;BYMONTHDAY=32", `invalid BYMONTHDAY "32"`},
+		{"FREQ=YEARLY;BYMONTH=13", `invalid BYMONTH "13"`},
+		{"FREQ=DAILY;BYSETPOS=1", `unsupported rule part "BYSETPOS"`},
+		{"FREQ", `expected NAME=VALUE, got "FREQ"`},
+	} {
+		_, err := ParseRecurrence(test.rule)
+		c.Assert(err, qt.ErrorMatches, `invalid recurrence rule ".*": `+test.expect, qt.Commentf(test.rule))
+	}
+}
+
+func TestRecurrenceBetween(t *testing.T) {
+	c := qt.New(t)
+
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 18, 30, 0, 0, time.UTC)
+	}
+	dates := func(ts []time.Time) []string {
+		var s []string
+		for _, t := range ts {
+			s = append(s, t.Format("2006-01-02 Mon"))
+		}
+		return s
+	}
+
+	from, to := date(2023, 1, 1), date(2024, 1, 1)
+
+	for _, test := range []struct {
+		rule   string
+		start  time.Time
+		from   time.Time
+		to     time.Time
+		expect []string
+	}{
+		{"FREQ=DAILY;COUNT=3", date(2023, 1, 30), from, to, []string{"2023-01-30 Mon", "2023-01-31 Tue", "2023-02-01 Wed"}},
+		{"FREQ=DAILY;INTERVAL=10;UNTIL=20230125", date(2023, 1, 1), from, to, []string{"2023-01-01 Sun", "2023-01-11 Wed", "2023-01-21 Sat"}},
+		{"FREQ=WEEKLY;COUNT=3", date(2023, 3, 1), from, to, []string{"2023-03-01 Wed", "2023-03-08 Wed", "2023-03-15 Wed"}},
+		{"FREQ=WEEKLY;BYDAY=TU,TH;COUNT=4", date(2023, 3, 2), from, to, []string{"2023-03-02 Thu", "2023-03-07 Tue", "2023-03-09 Thu", "2023-03-14 Tue"}},
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=MO;COUNT=3", date(2023, 3, 6), from, to, []string{"2023-03-06 Mon", "2023-03-20 Mon", "2023-04-03 Mon"}},
+		{"FREQ=MONTHLY;COUNT=4", date(2023, 1, 31), from, to, []string{"2023-01-31 Tue", "2023-03-31 Fri", "2023-05-31 Wed", "2023-07-31 Mon"}},
+		{"FREQ=MONTHLY;BYDAY=1TU;COUNT=3", date(2023, 1, 3), from, to, []string{"2023-01-03 Tue", "2023-02-07 Tue", "2023-03-07 Tue"}},
+		{"FREQ=MONTHLY;BYDAY=-1FR;COUNT=3", date(2023, 1, 27), from, to, []string{"2023-01-27 Fri", "2023-02-24 Fri", "2023-03-31 Fri"}},
+		{"FREQ=MONTHLY;BYMONTHDAY=1,-1;COUNT=4", date(2023, 1, 1), from, to, []string{"2023-01-01 Sun", "2023-01-31 Tue", "2023-02-01 Wed", "2023-02-28 Tue"}},
+		{"FREQ=MONTHLY;BYMONTHDAY=13;BYDAY=FR;COUNT=3", date(2023, 1, 13), from, date(2025, 1, 1), []string{"2023-01-13 Fri", "2023-10-13 Fri", "2024-09-13 Fri"}},
+		{"FREQ=YEARLY", date(2020, 2, 29), from, date(2030, 1, 1), []string{"2024-02-29 Thu", "2028-02-29 Tue"}},
+		{"FREQ=YEARLY;BYMONTH=5,11;BYDAY=2SU;COUNT=4", date(2023, 5, 14), from, to, []string{"2023-05-14 Sun", "2023-11-12 Sun"}},
+		// Limited by from and to.
+		{"FREQ=WEEKLY", date(2022, 12, 1), date(2023, 6, 1), date(2023, 6, 20), []string{"2023-06-01 Thu", "2023-06-08 Thu", "2023-06-15 Thu"}},
+		// COUNT includes occurrences before from.
+		{"FREQ=DAILY;COUNT=5", date(2022, 12, 29), from, to, []string{"2023-01-01 Sun", "2023-01-02 Mon"}},
+		// Not started yet.
+		{"FREQ=DAILY", date(2024, 6, 1), from, to, nil},
+	} {
+		r, err := ParseRecurrence(test.rule)
+		c.Assert(err, qt.IsNil)
+		c.Assert(dates(r.Between(test.start, test.from, test.to)), qt.DeepEquals, test.expect, qt.Commentf(test.rule))
+	}
+
+	// The time of day and location are preserved.
+	loc, _ := time.LoadLocation("Europe/Oslo")
+	r, _ := ParseRecurrence("FREQ=WEEKLY;COUNT=2")
+	got := r.Between(time.Date(2023, 3, 20, 19, 0, 0, 0, loc), from, to)
+	c.Assert(got, qt.HasLen, 2)
+	c.Assert(got[1].Format(time.RFC3339), qt.Equals, "2023-03-27T19:00:00+02:00")
+
+	// A local UNTIL is in the event's time zone.
+	r, _ = ParseRecurrence("FREQ=DAILY;UNTIL=20230321T190000")
+	c.Assert(r.Between(time.Date(2023, 3, 20, 19, 0, 0, 0, loc), from, to), qt.HasLen, 2)
+	r, _ = ParseRecurrence("FREQ=DAILY;UNTIL=20230321T180000Z")
+	c.Assert(r.Between(time.Date(2023, 3, 20, 19, 0, 0, 0, loc), from, to), qt.HasLen, 2)
+	r, _ = ParseRecurrence("FREQ=DAILY;UNTIL=20230321T165959Z")
+	c.Assert(r.Between(time.Date(2023, 3, 20, 19, 0, 0, 0, loc), from, to), qt.HasLen, 1)
+}
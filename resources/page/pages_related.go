@@ -70,6 +70,10 @@ func (p Pages) Related(ctx context.Context, optsv any) (Pages, error) {
 		return nil, fmt.Errorf("invalid argument type %T", optsv)
 	}
 
+	if opts.AllLanguages {
+		return p.searchAllLanguages(ctx, opts)
+	}
+
 	result, err := p.search(ctx, opts)
 	if err != nil {
 		return nil, err
@@ -79,6 +83,62 @@ func (p Pages) Related(ctx context.Context, optsv any) (Pages, error) {
 
 }
 
+// searchAllLanguages searches p and the pages of the same kinds in the other
+// languages. Only one page is kept per translation, the one in the language
+// of the document if found, else the highest ranked.
+func (p Pages) searchAllLanguages(ctx context.Context, opts related.SearchOpts) (Pages, error) {
+	lang := p[0].Language().Lang
+	if doc, ok := opts.Document.(Page); ok {
+		lang = doc.Language().Lang
+	}
+
+	kinds := make(map[string]bool)
+	for _, pp := range p {
+		kinds[pp.Kind()] = true
+	}
+
+	all := append(Pages{}, p...)
+	for _, s := range p[0].Sites() {
+		if s.Language().Lang == p[0].Language().Lang {
+			continue
+		}
+		for _, pp := range s.Pages() {
+			if kinds[pp.Kind()] {
+				all = append(all, pp)
+			}
+		}
+	}
+
+	result, err := all.search(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.dedupeTranslations(lang), nil
+}
+
+// dedupeTranslations keeps one page per translation key in p, the one in
+// lang if found, else the first, at the position of the first.
+func (p Pages) dedupeTranslations(lang string) Pages {
+	if len(p) == 0 {
+		return p
+	}
+	seen := make(map[string]int)
+	var result Pages
+	for _, pp := range p {
+		key := pp.TranslationKey()
+		if i, found := seen[key]; found {
+			if pp.Language().Lang == lang && result[i].Language().Lang != lang {
+				result[i] = pp
+			}
+			continue
+		}
+		seen[key] = len(result)
+		result = append(result, pp)
+	}
+	return result
+}
+
 // RelatedIndices searches the given indices with the search keywords from the
 // supplied document.
 // Deprecated: Use Related instead.
@@ -19,7 +19,10 @@ import (
 	"html/template"
 	"math"
 	"reflect"
+	"sort"
+	"strings"
 
+	"github.com/gohugoio/hugo/common/maps"
 	"github.com/gohugoio/hugo/config"
 
 	"github.com/spf13/cast"
@@ -31,6 +34,11 @@ type PaginatorProvider interface {
 	Paginator(options ...any) (*Pager, error)
 	// Paginate creates a paginator with the given page set in pages.
 	Paginate(pages any, options ...any) (*Pager, error)
+	// PaginateGroups creates a paginator for each of the page groups in groups,
+	// each with its own URL below the page, e.g. /blog/2024/page/2/.
+	// The options are the pager size and a map of pager sizes keyed by
+	// group key, for groups that should use another pager size.
+	PaginateGroups(groups any, options ...any) (*Pager, error)
 }
 
 // Pager represents one of the elements in a paginator.
@@ -61,6 +69,11 @@ type Paginator struct {
 	paginationURLFactory
 	total int
 	size  int
+
+	// Set when created by PaginateGroups.
+	key         any
+	groupPath   string
+	groupPagers pagers
 }
 
 type paginationURLFactory func(int) string
@@ -197,6 +210,24 @@ func (p *Paginator) TotalNumberOfElements() int {
 	return p.total
 }
 
+// Key returns the key of the page group this paginator was created for,
+// or nil if not created by PaginateGroups.
+func (p *Paginator) Key() any {
+	return p.key
+}
+
+// GroupPath returns the path segment below the page that this paginator's
+// pages are published to, e.g. "2024", or empty if not created by PaginateGroups.
+func (p *Paginator) GroupPath() string {
+	return p.groupPath
+}
+
+// GroupPagers returns the first pager of each of the page groups,
+// or nil if not created by PaginateGroups.
+func (p *Paginator) GroupPagers() pagers {
+	return p.groupPagers
+}
+
 func splitPages(pages Pages, size int) []paginatedElement {
 	var split []paginatedElement
 	for low, j := 0, len(pages); low < j; low += size {
@@ -273,7 +304,7 @@ func Paginate(td TargetPathDescriptor, seq any, pagerSize int) (*Paginator, erro
 		return nil, errors.New("'paginate' configuration setting must be positive to paginate")
 	}
 
-	urlFactory := newPaginationURLFactory(td)
+	urlFactory := newPaginationURLFactory(td, "")
 
 	var paginator *Paginator
 
@@ -294,6 +325,88 @@ func Paginate(td TargetPathDescriptor, seq any, pagerSize int) (*Paginator, erro
 	return paginator, nil
 }
 
+// PaginateGroups creates a paginator for each of the page groups in seq and
+// returns the paginator for the first group. The pages in each group are
+// published below the owning page using the group key as the path segment,
+// e.g. /blog/2024/ and /blog/2024/page/2/.
+// groupPagerSizes is an optional map of pager sizes keyed by group key,
+// overriding pagerSize for those groups.
+func PaginateGroups(td TargetPathDescriptor, seq any, pagerSize int, groupPagerSizes any) (*Paginator, error) {
+	if pagerSize <= 0 {
+		return nil, errors.New("'paginate' configuration setting must be positive to paginate")
+	}
+
+	var sizes map[string]any
+	if groupPagerSizes != nil {
+		var err error
+		sizes, err = maps.ToStringMapE(groupPagerSizes)
+		if err != nil {
+			return nil, fmt.Errorf("PaginateGroups: group pager sizes must be a map keyed by group key: %w", err)
+		}
+	}
+
+	groups, ok, err := ToPagesGroup(seq)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("PaginateGroups: expected page groups, got %T", seq)
+	}
+
+	if len(groups) == 0 {
+		return newPaginatorFromPages(nil, pagerSize, newPaginationURLFactory(td, ""))
+	}
+
+	var (
+		firsts pagers
+		seen   = make(map[string]any)
+	)
+
+	for _, g := range groups {
+		key, err := cast.ToStringE(g.Key)
+		if err != nil {
+			return nil, fmt.Errorf("PaginateGroups: invalid group key: %w", err)
+		}
+		groupPath := td.PathSpec.MakePathSanitized(key)
+		if groupPath == "" {
+			return nil, fmt.Errorf("PaginateGroups: group key %q is not a valid path segment", key)
+		}
+		if other, found := seen[groupPath]; found {
+			return nil, fmt.Errorf("PaginateGroups: group keys %v and %v both map to the path %q", other, g.Key, groupPath)
+		}
+		seen[groupPath] = g.Key
+
+		size := pagerSize
+		if v, found := sizes[key]; found {
+			size, err = cast.ToIntE(v)
+			if err != nil || size <= 0 {
+				return nil, fmt.Errorf("PaginateGroups: pager size for group %q must be a positive integer", key)
+			}
+			delete(sizes, key)
+		}
+
+		paginator, _ := newPaginatorFromPages(g.Pages, size, newPaginationURLFactory(td, groupPath))
+		paginator.key = g.Key
+		paginator.groupPath = groupPath
+		firsts = append(firsts, paginator.pagers[0])
+	}
+
+	if len(sizes) > 0 {
+		keys := make([]string, 0, len(sizes))
+		for key := range sizes {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		return nil, fmt.Errorf("PaginateGroups: no page group with key %q", keys[0])
+	}
+
+	for _, first := range firsts {
+		first.groupPagers = firsts
+	}
+
+	return firsts[0].Paginator, nil
+}
+
 // probablyEqual checks page lists for probable equality.
 // It may return false positives.
 // The motivation behind this is to avoid potential costly reflect.DeepEqual
@@ -384,14 +497,17 @@ func newPaginator(elements []paginatedElement, total, size int, urlFactory pagin
 	return p, nil
 }
 
-func newPaginationURLFactory(d TargetPathDescriptor) paginationURLFactory {
+func newPaginationURLFactory(d TargetPathDescriptor, groupPath string) paginationURLFactory {
 	return func(pageNumber int) string {
 		pathDescriptor := d
 		var rel string
+		if groupPath != "" {
+			rel = "/" + groupPath + "/"
+		}
 		if pageNumber > 1 {
-			rel = fmt.Sprintf("/%s/%d/", d.PathSpec.Cfg.PaginatePath(), pageNumber)
-			pathDescriptor.Addends = rel
+			rel = fmt.Sprintf("%s/%s/%d/", strings.TrimSuffix(rel, "/"), d.PathSpec.Cfg.PaginatePath(), pageNumber)
 		}
+		pathDescriptor.Addends = rel
 
 		return CreateTargetPaths(pathDescriptor).RelPermalink(d.PathSpec)
 	}
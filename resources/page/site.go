@@ -14,13 +14,18 @@
 package page
 
 import (
+	"context"
 	"html/template"
 	"time"
 
 	"github.com/gohugoio/hugo/common/maps"
 	"github.com/gohugoio/hugo/config/privacy"
+	"github.com/gohugoio/hugo/config/seo"
 	"github.com/gohugoio/hugo/config/services"
+	"github.com/gohugoio/hugo/featureflags"
+	"github.com/gohugoio/hugo/features"
 	"github.com/gohugoio/hugo/identity"
+	"github.com/gohugoio/hugo/indieweb"
 	"github.com/gohugoio/hugo/tpl"
 
 	"github.com/gohugoio/hugo/config"
@@ -104,6 +109,16 @@ type Site interface {
 	// Returns a map of all the data inside /data.
 	Data() map[string]any
 
+	// Returns the feature flags defined in the site config.
+	Flags() *featureflags.Flags
+
+	// Returns the features provided by Hugo and the modules in use.
+	Features() *features.Features
+
+	// Returns the translation status of the regular pages in this Site
+	// compared to the other languages.
+	TranslationStatus(ctx context.Context) *TranslationStatus
+
 	// Returns the site config.
 	Config() SiteConfig
 
@@ -279,6 +294,18 @@ func (s *siteWrapper) Data() map[string]any {
 	return s.s.Data()
 }
 
+func (s *siteWrapper) Flags() *featureflags.Flags {
+	return s.s.Flags()
+}
+
+func (s *siteWrapper) Features() *features.Features {
+	return s.s.Features()
+}
+
+func (s *siteWrapper) TranslationStatus(ctx context.Context) *TranslationStatus {
+	return s.s.TranslationStatus(ctx)
+}
+
 func (s *siteWrapper) GetIdentity() identity.Identity {
 	return s.s.GetIdentity()
 }
@@ -427,6 +454,18 @@ func (t testSite) Data() map[string]any {
 	return nil
 }
 
+func (t testSite) Flags() *featureflags.Flags {
+	return featureflags.New(nil)
+}
+
+func (t testSite) Features() *features.Features {
+	return &features.Features{}
+}
+
+func (t testSite) TranslationStatus(ctx context.Context) *TranslationStatus {
+	return &TranslationStatus{Lang: t.l.Lang}
+}
+
 func (s testSite) Config() SiteConfig {
 	return SiteConfig{}
 }
@@ -473,4 +512,10 @@ type SiteConfig struct {
 
 	// Services contains config for services such as Google Analytics etc.
 	Services services.Config
+
+	// SEO contains the site wide SEO metadata config.
+	SEO seo.Config
+
+	// IndieWeb contains the Webmention and ActivityPub config.
+	IndieWeb indieweb.Config
 }
@@ -325,6 +325,10 @@ func (p *testPage) Fragments(context.Context) *tableofcontents.Fragments {
 	return nil
 }
 
+func (p *testPage) Headings(context.Context) tableofcontents.Headings {
+	return nil
+}
+
 func (p *testPage) HeadingsFiltered(context.Context) tableofcontents.Headings {
 	return nil
 }
@@ -395,6 +399,10 @@ func (p *testPage) Paginate(seq any, options ...any) (*Pager, error) {
 	return nil, nil
 }
 
+func (p *testPage) PaginateGroups(groups any, options ...any) (*Pager, error) {
+	return nil, nil
+}
+
 func (p *testPage) Paginator(options ...any) (*Pager, error) {
 	return nil, nil
 }
@@ -439,6 +447,10 @@ func (p *testPage) PlainWords(context.Context) []string {
 	panic("tespage: not implemented")
 }
 
+func (p *testPage) PlainFast(context.Context) string {
+	panic("tespage: not implemented")
+}
+
 func (p *testPage) Prev() Page {
 	panic("tespage: not implemented")
 }
@@ -0,0 +1,161 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package page
+
+import (
+	"context"
+	"time"
+
+	"github.com/gohugoio/hugo/langs"
+)
+
+// TranslationStatus holds the translation status of the regular pages in a site
+// compared to their translations in the other languages.
+type TranslationStatus struct {
+	// The language of the source pages.
+	Lang string
+
+	// The status per source page.
+	Pages []*PageTranslationStatus
+
+	// The status per target language, in language weight order.
+	Languages []*LanguageTranslationStatus
+}
+
+// PageTranslationStatus holds the translation status of a single page.
+type PageTranslationStatus struct {
+	// The source page.
+	Page Page
+
+	// The number of words in the source page.
+	WordCount int
+
+	// The status per target language, in language weight order.
+	Translations []*TranslationStatusItem
+
+	// The target languages without a translation of this page.
+	Missing []string
+
+	// The target languages with a translation older than this page.
+	Stale []string
+}
+
+// TranslationStatusItem holds the status of a page in one target language.
+type TranslationStatusItem struct {
+	// The target language.
+	Lang string
+
+	// The translated page, nil if missing.
+	Page Page
+
+	// Whether the page is translated into Lang.
+	Translated bool
+
+	// The number of words in the translated page.
+	WordCount int
+
+	// The lastmod of the source page minus the lastmod of the translation.
+	// A positive value means that the translation is older than the source.
+	LastmodDelta time.Duration
+
+	// Whether the translation is older than the source.
+	Stale bool
+}
+
+// LanguageTranslationStatus summarizes the translation status for one target language.
+type LanguageTranslationStatus struct {
+	Lang string
+
+	// The number of source pages.
+	Total int
+
+	// The number of source pages translated into Lang.
+	Translated int
+
+	// The number of source pages without a translation into Lang.
+	Missing int
+
+	// The number of translations older than their source.
+	Stale int
+
+	// The number of words in the source pages without a translation,
+	// a rough measure of the remaining work.
+	MissingWords int
+}
+
+// Percent returns the share of the source pages translated into this language.
+func (l *LanguageTranslationStatus) Percent() float64 {
+	if l.Total == 0 {
+		return 100
+	}
+	return float64(l.Translated) / float64(l.Total) * 100
+}
+
+// NewTranslationStatus creates the translation status of pages compared to their
+// translations in languages. The source language lang is skipped.
+func NewTranslationStatus(ctx context.Context, lang string, pages Pages, languages langs.Languages) *TranslationStatus {
+	ts := &TranslationStatus{Lang: lang}
+
+	var targets []string
+	summaries := make(map[string]*LanguageTranslationStatus)
+	for _, l := range languages {
+		if l.Lang == lang {
+			continue
+		}
+		targets = append(targets, l.Lang)
+		s := &LanguageTranslationStatus{Lang: l.Lang}
+		summaries[l.Lang] = s
+		ts.Languages = append(ts.Languages, s)
+	}
+
+	for _, p := range pages {
+		ps := &PageTranslationStatus{
+			Page:      p,
+			WordCount: p.WordCount(ctx),
+		}
+
+		translations := make(map[string]Page)
+		for _, t := range p.Translations() {
+			translations[t.Lang()] = t
+		}
+
+		for _, target := range targets {
+			summary := summaries[target]
+			summary.Total++
+
+			item := &TranslationStatusItem{Lang: target}
+			if t, found := translations[target]; found {
+				item.Page = t
+				item.Translated = true
+				item.WordCount = t.WordCount(ctx)
+				item.LastmodDelta = p.Lastmod().Sub(t.Lastmod())
+				item.Stale = item.LastmodDelta > 0
+				summary.Translated++
+				if item.Stale {
+					summary.Stale++
+					ps.Stale = append(ps.Stale, target)
+				}
+			} else {
+				summary.Missing++
+				summary.MissingWords += ps.WordCount
+				ps.Missing = append(ps.Missing, target)
+			}
+			ps.Translations = append(ps.Translations, item)
+		}
+
+		ts.Pages = append(ts.Pages, ps)
+	}
+
+	return ts
+}
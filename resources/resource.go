@@ -163,6 +163,7 @@ type baseResourceInternal interface {
 	getTargetFilenames() []string
 	openDestinationsForWriting() (io.WriteCloser, error)
 	openPublishFileForWriting(relTargetPath string) (io.WriteCloser, error)
+	addToBuildManifest(relTargetPath string, transformations []string)
 
 	relTargetPathForRel(rel string, addBaseTargetPath, isAbs, isURL bool) string
 }
@@ -319,6 +320,9 @@ func (l *genericResource) Publish() error {
 		defer fw.Close()
 
 		_, err = io.Copy(fw, fr)
+		if err == nil {
+			l.addToBuildManifest(l.TargetPath(), nil)
+		}
 	})
 
 	return err
@@ -406,7 +410,10 @@ func (l *genericResource) setTitle(title string) {
 }
 
 func (r *genericResource) tryTransformedFileCache(key string, u *transformationUpdate) io.ReadCloser {
-	fi, f, meta, found := r.spec.ResourceCache.getFromFile(key)
+	// The source may not be available, e.g. a resource created from a remote
+	// resource not in the cache, the entry is then only read from the local cache.
+	h, _ := r.hash()
+	fi, f, meta, found := r.spec.ResourceCache.getFromFile(key, h)
 	if !found {
 		return nil
 	}
@@ -481,6 +488,8 @@ func (l *genericResource) openDestinationsForWriting() (w io.WriteCloser, err er
 		targetFilenames := l.getTargetFilenames()
 		var changedFilenames []string
 
+		l.addToBuildManifest(l.TargetPath(), nil)
+
 		// Fast path:
 		// This is a processed version of the original;
 		// check if it already exists at the destination.
@@ -19,6 +19,7 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 
@@ -48,6 +49,12 @@ type ResourceCache struct {
 
 	// Provides named resource locks.
 	nlocker *locker.Locker
+
+	dependenciesMu sync.RWMutex
+	// Maps the absolute filename of a file a transformed resource depends on,
+	// but that's not read via Hugo's filesystems (e.g. an npm package imported
+	// in js.Build), to the source paths of the resources depending on it.
+	dependencies map[string]map[string]bool
 }
 
 // ResourceCacheKey converts the filename into the format used in the resource
@@ -199,14 +206,17 @@ func (c *ResourceCache) getFilenames(key string) (string, string) {
 	return filenameMeta, filenameContent
 }
 
-func (c *ResourceCache) getFromFile(key string) (filecache.ItemInfo, io.ReadCloser, transformedResourceMetadata, bool) {
+// getFromFile gets the transformed resource with the given key from the file cache.
+// The inputHash is the hash of the source content, used to share the entry in the remote cache.
+func (c *ResourceCache) getFromFile(key, inputHash string) (filecache.ItemInfo, io.ReadCloser, transformedResourceMetadata, bool) {
 	c.RLock()
 	defer c.RUnlock()
 
 	var meta transformedResourceMetadata
 	filenameMeta, filenameContent := c.getFilenames(key)
+	fileCache := c.fileCache.WithInputHash(inputHash)
 
-	_, jsonContent, _ := c.fileCache.GetBytes(filenameMeta)
+	_, jsonContent, _ := fileCache.GetBytes(filenameMeta)
 	if jsonContent == nil {
 		return filecache.ItemInfo{}, nil, meta, false
 	}
@@ -215,20 +225,22 @@ func (c *ResourceCache) getFromFile(key string) (filecache.ItemInfo, io.ReadClos
 		return filecache.ItemInfo{}, nil, meta, false
 	}
 
-	fi, rc, _ := c.fileCache.Get(filenameContent)
+	fi, rc, _ := fileCache.Get(filenameContent)
 
 	return fi, rc, meta, rc != nil
 }
 
 // writeMeta writes the metadata to file and returns a writer for the content part.
-func (c *ResourceCache) writeMeta(key string, meta transformedResourceMetadata) (filecache.ItemInfo, io.WriteCloser, error) {
+// The inputHash is the hash of the source content, see getFromFile.
+func (c *ResourceCache) writeMeta(key, inputHash string, meta transformedResourceMetadata) (filecache.ItemInfo, io.WriteCloser, error) {
 	filenameMeta, filenameContent := c.getFilenames(key)
+	fileCache := c.fileCache.WithInputHash(inputHash)
 	raw, err := json.Marshal(meta)
 	if err != nil {
 		return filecache.ItemInfo{}, nil, err
 	}
 
-	_, fm, err := c.fileCache.WriteCloser(filenameMeta)
+	_, fm, err := fileCache.WriteCloser(filenameMeta)
 	if err != nil {
 		return filecache.ItemInfo{}, nil, err
 	}
@@ -238,7 +250,7 @@ func (c *ResourceCache) writeMeta(key string, meta transformedResourceMetadata)
 		return filecache.ItemInfo{}, nil, err
 	}
 
-	fi, fc, err := c.fileCache.WriteCloser(filenameContent)
+	fi, fc, err := fileCache.WriteCloser(filenameContent)
 
 	return fi, fc, err
 }
@@ -293,6 +305,59 @@ func (c *ResourceCache) DeleteMatchesRe(re *regexp.Regexp) {
 	}
 }
 
+// DependentsOf returns the source paths, e.g. "assets/js/main.js", of the
+// transformed resources depending on filename. The result is typically
+// matched against the cache busters in the build config.
+func (c *ResourceCache) DependentsOf(filename string) []string {
+	c.dependenciesMu.RLock()
+	defer c.dependenciesMu.RUnlock()
+
+	var dependents []string
+	for dependent := range c.dependencies[filename] {
+		dependents = append(dependents, dependent)
+	}
+	sort.Strings(dependents)
+
+	return dependents
+}
+
+// DependencyDirs returns the directories of all the files registered as
+// dependencies of transformed resources, so they can be watched for changes.
+func (c *ResourceCache) DependencyDirs() []string {
+	c.dependenciesMu.RLock()
+	defer c.dependenciesMu.RUnlock()
+
+	var dirs []string
+	for filename := range c.dependencies {
+		dirs = append(dirs, filepath.Dir(filename))
+	}
+
+	return helpers.UniqueStringsSorted(dirs)
+}
+
+// addDependencies registers filenames as dependencies of the transformed
+// resource with the given source path.
+// Note that we never remove any dependencies, so we may get some stale
+// entries in server mode, but that will only lead to some false positive
+// cache busting.
+func (c *ResourceCache) addDependencies(sourcePath string, filenames []string) {
+	c.dependenciesMu.Lock()
+	defer c.dependenciesMu.Unlock()
+
+	if c.dependencies == nil {
+		c.dependencies = make(map[string]map[string]bool)
+	}
+
+	for _, filename := range filenames {
+		dependents, found := c.dependencies[filename]
+		if !found {
+			dependents = make(map[string]bool)
+			c.dependencies[filename] = dependents
+		}
+		dependents[sourcePath] = true
+	}
+}
+
 func (c *ResourceCache) DeleteMatches(match func(string) bool) {
 	c.Lock()
 	defer c.Unlock()
@@ -16,6 +16,7 @@ package create
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"mime"
@@ -85,7 +86,8 @@ func toHTTPError(err error, res *http.Response, readBody bool) *HTTPError {
 
 // FromRemote expects one or n-parts of a URL to a resource
 // If you provide multiple parts they will be joined together to the final URL.
-func (c *Client) FromRemote(uri string, optionsm map[string]any) (resource.Resource, error) {
+// The URL and method are checked against the security policy of the module set in ctx.
+func (c *Client) FromRemote(ctx context.Context, uri string, optionsm map[string]any) (resource.Resource, error) {
 	rURL, err := url.Parse(uri)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse URL for resource %s: %w", uri, err)
@@ -97,6 +99,12 @@ func (c *Client) FromRemote(uri string, optionsm map[string]any) (resource.Resou
 	}
 	isHeadMethod := method == "HEAD"
 
+	// Check before the cache lookup, the resource may have been fetched
+	// by a module with a different policy.
+	if err := c.validateFromRemoteArgs(ctx, uri, method); err != nil {
+		return nil, err
+	}
+
 	resourceID := calculateResourceID(uri, optionsm)
 
 	_, httpResponse, err := c.cacheGetResource.GetOrCreate(resourceID, func() (io.ReadCloser, error) {
@@ -104,10 +112,6 @@ func (c *Client) FromRemote(uri string, optionsm map[string]any) (resource.Resou
 		if err != nil {
 			return nil, fmt.Errorf("failed to decode options for resource %s: %w", uri, err)
 		}
-		if err := c.validateFromRemoteArgs(uri, options); err != nil {
-			return nil, err
-		}
-
 		req, err := options.NewRequest(uri)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create request for resource %s: %w", uri, err)
@@ -227,12 +231,13 @@ func (c *Client) FromRemote(uri string, optionsm map[string]any) (resource.Resou
 		})
 }
 
-func (c *Client) validateFromRemoteArgs(uri string, options fromRemoteOptions) error {
-	if err := c.rs.ExecHelper.Sec().CheckAllowedHTTPURL(uri); err != nil {
+func (c *Client) validateFromRemoteArgs(ctx context.Context, uri, method string) error {
+	sc := c.rs.ExecHelper.Sec().ForContext(ctx)
+	if err := sc.CheckAllowedHTTPURL(uri); err != nil {
 		return err
 	}
 
-	if err := c.rs.ExecHelper.Sec().CheckAllowedHTTPMethod(options.Method); err != nil {
+	if err := sc.CheckAllowedHTTPMethod(method); err != nil {
 		return err
 	}
 
@@ -57,7 +57,7 @@ func NewSpec(
 	errorHandler herrors.ErrorSender,
 	execHelper *hexec.Exec) (*Spec, error) {
 
-	fileCaches, err := filecache.NewCaches(s)
+	fileCaches, err := filecache.NewCaches(s, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create file caches from configuration: %w", err)
 	}
@@ -84,12 +84,18 @@ func NewSpec(
 	}
 
 	if common == nil {
+		var buildManifest *BuildManifest
+		if conf.Build.Manifest != "" {
+			buildManifest = NewBuildManifest()
+		}
 		common = &SpecCommon{
 			incr:       incr,
 			FileCaches: fileCaches,
 			PostBuildAssets: &PostBuildAssets{
 				PostProcessResources: make(map[string]postpub.PostPublishedResource),
 				JSConfigBuilder:      jsconfig.NewBuilder(),
+				IntegrityManifest:    NewIntegrityManifest(),
+				BuildManifest:        buildManifest,
 			},
 			ResourceCache: &ResourceCache{
 				fileCache: fileCaches.AssetsCache(),
@@ -160,6 +166,12 @@ type PostBuildAssets struct {
 	postProcessMu        sync.RWMutex
 	PostProcessResources map[string]postpub.PostPublishedResource
 	JSConfigBuilder      *jsconfig.Builder
+
+	// The integrity hashes of the published fingerprinted resources.
+	IntegrityManifest *IntegrityManifest
+
+	// The provenance of the published files. Nil if build.manifest is not set.
+	BuildManifest *BuildManifest
 }
 
 func (r *Spec) New(fd ResourceSourceDescriptor) (resource.Resource, error) {
@@ -178,6 +190,12 @@ func (r *Spec) BuildConfig() config.BuildConfig {
 	return r.Cfg.GetConfigSection("build").(config.BuildConfig)
 }
 
+// SourceMapsEnabled reports whether source maps are enabled for chained
+// asset transformations in the current environment.
+func (r *Spec) SourceMapsEnabled() bool {
+	return r.BuildConfig().SourceMaps.Enabled(r.Cfg.Environment())
+}
+
 func (r *Spec) CacheStats() string {
 	r.ImageCache.mu.RLock()
 	defer r.ImageCache.mu.RUnlock()
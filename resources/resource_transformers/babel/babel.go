@@ -15,6 +15,7 @@ package babel
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -115,7 +116,7 @@ func (t *babelTransformation) Key() internal.ResourceTransformationKey {
 func (t *babelTransformation) Transform(ctx *resources.ResourceTransformationCtx) error {
 	const binaryName = "babel"
 
-	ex := t.rs.ExecHelper
+	ex := t.rs.ExecHelper.ForContext(ctx.Ctx)
 
 	if err := ex.Sec().CheckAllowedExec(binaryName); err != nil {
 		return err
@@ -231,8 +232,9 @@ func (t *babelTransformation) Transform(ctx *resources.ResourceTransformationCtx
 }
 
 // Process transforms the given Resource with the Babel processor.
-func (c *Client) Process(res resources.ResourceTransformer, options Options) (resource.Resource, error) {
-	return res.Transform(
+// The programs run are checked against the security policy of the module set in ctx.
+func (c *Client) Process(ctx context.Context, res resources.ResourceTransformer, options Options) (resource.Resource, error) {
+	return res.TransformWithContext(ctx,
 		&babelTransformation{rs: c.rs, options: options},
 	)
 }
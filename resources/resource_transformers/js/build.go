@@ -14,12 +14,16 @@
 package js
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"path"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 
 	"errors"
@@ -34,6 +38,7 @@ import (
 
 	"github.com/gohugoio/hugo/hugolib/filesystems"
 	"github.com/gohugoio/hugo/resources/internal"
+	"github.com/gohugoio/hugo/resources/internal/sourcemap"
 
 	"github.com/evanw/esbuild/pkg/api"
 	"github.com/gohugoio/hugo/resources"
@@ -57,14 +62,37 @@ func New(fs *filesystems.SourceFilesystem, rs *resources.Spec) *Client {
 type buildTransformation struct {
 	optsm map[string]any
 	c     *Client
+
+	// Whether this is a CSS build, see ProcessCSS.
+	css bool
 }
 
 func (t *buildTransformation) Key() internal.ResourceTransformationKey {
+	if t.css {
+		return internal.NewResourceTransformationKey("cssbuild", t.optsm)
+	}
 	return internal.NewResourceTransformationKey("jsbuild", t.optsm)
 }
 
+// name returns the name of the template func, used in error messages.
+func (t *buildTransformation) name() string {
+	if t.css {
+		return "css.Build"
+	}
+	return "js.Build"
+}
+
 func (t *buildTransformation) Transform(ctx *resources.ResourceTransformationCtx) error {
-	ctx.OutMediaType = media.Builtin.JavascriptType
+	ext := ".js"
+	if t.css {
+		if ctx.InMediaType.SubType != media.Builtin.CSSType.SubType {
+			return fmt.Errorf("%s: media type %q is not CSS", ctx.SourcePath, ctx.InMediaType.Type)
+		}
+		ctx.OutMediaType = media.Builtin.CSSType
+		ext = ".css"
+	} else {
+		ctx.OutMediaType = media.Builtin.JavascriptType
+	}
 
 	opts, err := decodeOptions(t.optsm)
 	if err != nil {
@@ -74,7 +102,7 @@ func (t *buildTransformation) Transform(ctx *resources.ResourceTransformationCtx
 	if opts.TargetPath != "" {
 		ctx.OutPath = opts.TargetPath
 	} else {
-		ctx.ReplaceOutPathExtension(".js")
+		ctx.ReplaceOutPathExtension(ext)
 	}
 
 	src, err := io.ReadAll(ctx.From)
@@ -83,6 +111,7 @@ func (t *buildTransformation) Transform(ctx *resources.ResourceTransformationCtx
 	}
 
 	opts.sourceDir = filepath.FromSlash(path.Dir(ctx.SourcePath))
+	opts.targetDir = path.Dir(ctx.OutPath)
 	opts.resolveDir = t.c.rs.Cfg.BaseConfig().WorkingDir // where node_modules gets resolved
 	opts.contents = string(src)
 	opts.mediaType = ctx.InMediaType
@@ -97,6 +126,11 @@ func (t *buildTransformation) Transform(ctx *resources.ResourceTransformationCtx
 		return err
 	}
 
+	if ctx.SourceMapsEnabled() {
+		// The source map for the complete chain is published by Hugo.
+		buildOptions.Sourcemap = api.SourceMapExternal
+	}
+
 	if buildOptions.Sourcemap == api.SourceMapExternal && buildOptions.Outdir == "" {
 		buildOptions.Outdir, err = os.MkdirTemp(os.TempDir(), "compileOutput")
 		if err != nil {
@@ -127,6 +161,9 @@ func (t *buildTransformation) Transform(ctx *resources.ResourceTransformationCtx
 
 	}
 
+	// The metafile is used to register the imported files as dependencies.
+	buildOptions.Metafile = true
+
 	result := api.Build(buildOptions)
 
 	if len(result.Errors) > 0 {
@@ -185,18 +222,55 @@ func (t *buildTransformation) Transform(ctx *resources.ResourceTransformationCtx
 		// Return 1, log the rest.
 		for i, err := range errors {
 			if i > 0 {
-				t.c.rs.Logger.Errorf("js.Build failed: %s", err)
+				t.c.rs.Logger.Errorf("%s failed: %s", t.name(), err)
 			}
 		}
 
 		return errors[0]
 	}
 
+	// ESBuild reports the files relative to its working directory,
+	// which defaults to the current working directory.
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	dependencies, err := metafileInputs(result.Metafile, wd)
+	if err != nil {
+		return err
+	}
+	for _, filename := range dependencies {
+		ctx.AddDependency(filename)
+	}
+
+	if ctx.SourceMapsEnabled() {
+		content, _ := sourcemap.ExtractComment(string(result.OutputFiles[1].Contents))
+		t.replaceHash(ctx, content)
+		if _, err := io.WriteString(ctx.To, content); err != nil {
+			return err
+		}
+		m, err := fixSourceMapSources(
+			string(result.OutputFiles[0].Contents),
+			buildOptions.Outdir,
+			t.c.rs.Cfg.BaseConfig().WorkingDir,
+			t.c.sfs.RealFilename(ctx.SourcePath),
+		)
+		if err != nil {
+			return err
+		}
+		return ctx.UpdateSourceMap(m)
+	}
+
 	if buildOptions.Sourcemap == api.SourceMapExternal {
 		content := string(result.OutputFiles[1].Contents)
+		t.replaceHash(ctx, content)
 		symPath := path.Base(ctx.OutPath) + ".map"
-		re := regexp.MustCompile(`//# sourceMappingURL=.*\n?`)
-		content = re.ReplaceAllString(content, "//# sourceMappingURL="+symPath+"\n")
+		if t.css {
+			content = cssSourceMappingURLRe.ReplaceAllString(content, "/*# sourceMappingURL="+symPath+" */\n")
+		} else {
+			re := regexp.MustCompile(`//# sourceMappingURL=.*\n?`)
+			content = re.ReplaceAllString(content, "//# sourceMappingURL="+symPath+"\n")
+		}
 
 		if err = ctx.PublishSourceMap(string(result.OutputFiles[0].Contents)); err != nil {
 			return err
@@ -206,6 +280,7 @@ func (t *buildTransformation) Transform(ctx *resources.ResourceTransformationCtx
 			return err
 		}
 	} else {
+		t.replaceHash(ctx, string(result.OutputFiles[0].Contents))
 		_, err := ctx.To.Write(result.OutputFiles[0].Contents)
 		if err != nil {
 			return err
@@ -214,9 +289,93 @@ func (t *buildTransformation) Transform(ctx *resources.ResourceTransformationCtx
 	return nil
 }
 
+var cssSourceMappingURLRe = regexp.MustCompile(`/\*# sourceMappingURL=.*\*/\n?`)
+
+// hashPlaceholder in the target path is replaced with a hash of the content.
+const hashPlaceholder = "[hash]"
+
+// replaceHash replaces any hashPlaceholder in the target path of a CSS build
+// with the first 8 characters of the hex encoded SHA-256 hash of content.
+func (t *buildTransformation) replaceHash(ctx *resources.ResourceTransformationCtx, content string) {
+	if !t.css || !strings.Contains(ctx.OutPath, hashPlaceholder) {
+		return
+	}
+	sum := sha256.Sum256([]byte(content))
+	ctx.OutPath = strings.ReplaceAll(ctx.OutPath, hashPlaceholder, hex.EncodeToString(sum[:])[:8])
+}
+
+// fixSourceMapSources makes the sources in the ESBuild source map m, which are
+// relative to outDir or absolute, relative to workingDir, replacing the stdin
+// source with stdinFilename.
+func fixSourceMapSources(m, outDir, workingDir, stdinFilename string) (string, error) {
+	sm, err := sourcemap.Parse(m)
+	if err != nil {
+		return "", err
+	}
+	for i, source := range sm.Sources {
+		var filename string
+		switch {
+		case source == stdinImporter:
+			filename = stdinFilename
+		case strings.HasPrefix(source, nsParams+":"):
+			continue
+		default:
+			filename = filepath.FromSlash(strings.TrimPrefix(source, nsImportHugo+":"))
+			if !filepath.IsAbs(filename) {
+				filename = filepath.Join(outDir, filename)
+			}
+		}
+		if rel, err := filepath.Rel(workingDir, filename); err == nil {
+			filename = rel
+		}
+		sm.Sources[i] = filepath.ToSlash(filename)
+	}
+	return sm.String(), nil
+}
+
+// metafileInputs returns the absolute filenames of the input files listed in
+// the ESBuild metafile, relative paths resolved against workingDir.
+// The stdin input and virtual modules, e.g. @params, are not included.
+func metafileInputs(metafile, workingDir string) ([]string, error) {
+	if metafile == "" {
+		return nil, nil
+	}
+
+	var m struct {
+		Inputs map[string]any `json:"inputs"`
+	}
+	if err := json.Unmarshal([]byte(metafile), &m); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal metafile: %w", err)
+	}
+
+	var filenames []string
+	for input := range m.Inputs {
+		if input == stdinImporter || strings.HasPrefix(input, nsParams+":") {
+			continue
+		}
+		// Files resolved by Hugo.
+		filename := strings.TrimPrefix(input, nsImportHugo+":")
+		filename = filepath.FromSlash(filename)
+		if !filepath.IsAbs(filename) {
+			filename = filepath.Join(workingDir, filename)
+		}
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	return filenames, nil
+}
+
 // Process process esbuild transform
 func (c *Client) Process(res resources.ResourceTransformer, opts map[string]any) (resource.Resource, error) {
 	return res.Transform(
 		&buildTransformation{c: c, optsm: opts},
 	)
 }
+
+// ProcessCSS bundles the CSS resource res and its @import'ed stylesheets with ESBuild.
+func (c *Client) ProcessCSS(res resources.ResourceTransformer, opts map[string]any) (resource.Resource, error) {
+	return res.Transform(
+		&buildTransformation{c: c, optsm: opts, css: true},
+	)
+}
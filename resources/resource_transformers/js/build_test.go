@@ -12,3 +12,39 @@
 // limitations under the License.
 
 package js
+
+import (
+	"path/filepath"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestMetafileInputs(t *testing.T) {
+	c := qt.New(t)
+
+	workingDir := filepath.FromSlash("/mysite")
+	metafile := `{
+  "inputs": {
+    "<stdin>": {"bytes": 42, "imports": []},
+    "ns-params:@params": {"bytes": 2, "imports": []},
+    "ns-hugo:/mysite/assets/js/util.js": {"bytes": 10, "imports": []},
+    "node_modules/mylib/index.js": {"bytes": 20, "imports": []}
+  },
+  "outputs": {}
+}`
+
+	filenames, err := metafileInputs(metafile, workingDir)
+	c.Assert(err, qt.IsNil)
+	c.Assert(filenames, qt.DeepEquals, []string{
+		filepath.FromSlash("/mysite/assets/js/util.js"),
+		filepath.FromSlash("/mysite/node_modules/mylib/index.js"),
+	})
+
+	filenames, err = metafileInputs("", workingDir)
+	c.Assert(err, qt.IsNil)
+	c.Assert(filenames, qt.IsNil)
+
+	_, err = metafileInputs("{", workingDir)
+	c.Assert(err, qt.Not(qt.IsNil))
+}
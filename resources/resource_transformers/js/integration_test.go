@@ -74,6 +74,39 @@ JS Content:{{ $js.Content }}:End:
 	})
 }
 
+func TestBuildEditDependencyOutsideAssets(t *testing.T) {
+	c := qt.New(t)
+
+	files := `
+-- config.toml --
+disableKinds=["page", "section", "taxonomy", "term", "sitemap", "robotsTXT"]
+-- node_modules/mylib/index.js --
+import { hello2 } from './lib/hello.js';
+export function hello1() {
+	return hello2();
+}
+-- node_modules/mylib/lib/hello.js --
+export function hello2() {
+	return 'abcd';
+}
+-- assets/js/main.js --
+import { hello1 } from 'mylib';
+hello1();
+-- layouts/index.html --
+{{ $js := resources.Get "js/main.js" | js.Build }}
+JS Content:{{ $js.Content }}:End:
+`
+
+	b := hugolib.NewIntegrationTestBuilder(hugolib.IntegrationTestConfig{T: c, Running: true, NeedsOsFS: true, TxtarString: files}).Build()
+
+	b.AssertFileContent("public/index.html", `abcd`)
+	b.EditFileReplace("node_modules/mylib/lib/hello.js", func(s string) string { return strings.ReplaceAll(s, "abcd", "1234") }).Build()
+	b.AssertFileContent("public/index.html", `1234`)
+
+	dependents := b.H.ResourceSpec.ResourceCache.DependentsOf(filepath.Join(b.Cfg.WorkingDir, "node_modules", "mylib", "lib", "hello.js"))
+	b.Assert(dependents, qt.DeepEquals, []string{"assets/js/main.js"})
+}
+
 func TestBuildWithModAndNpm(t *testing.T) {
 	if !htesting.IsCI() {
 		t.Skip("skip (relative) long running modules test when running locally")
@@ -344,3 +377,90 @@ Main license
 	`)
 
 }
+
+func TestCSSBuild(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "section", "page", "rss", "sitemap"]
+-- assets/css/main.css --
+@import "components/buttons.css";
+@import "./components/cards";
+@import "vars.css";
+@import "https://fonts.example.org/font.css";
+body { background: url("/images/bg.png"); }
+-- assets/css/components/buttons.css --
+.btn { color: var(--color); background: url("icons/btn.svg#x"); }
+-- assets/css/components/cards.css --
+.card {
+	background: url(../../images/card.png);
+	.title { font-weight: bold; }
+}
+-- assets/vars.css --
+:root { --color: red; }
+-- layouts/index.html --
+{{ $css := resources.Get "css/main.css" | css.Build }}
+Default: {{ $css.RelPermalink }}|{{ $css.MediaType }}|
+{{ $min := resources.Get "css/main.css" | css.Build (dict "minify" true "targetPath" "css/main.[hash].css") }}
+Minified: {{ $min.RelPermalink }}|{{ $min.Content }}|
+{{ $sm := resources.Get "css/main.css" | css.Build (dict "sourceMap" "external" "targetPath" "css/sm.css") }}
+SourceMap: {{ $sm.RelPermalink }}|
+{{ $root := resources.Get "css/main.css" | css.Build (dict "targetPath" "bundle.css") }}
+Root: {{ $root.RelPermalink }}|
+{{ $js := resources.Get "js/main.js" | js.Build (dict "targetPath" "js/main.[hash].js") }}
+JS: {{ $js.RelPermalink }}|
+-- assets/js/main.js --
+console.log("main");
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			NeedsOsFS:   true,
+			TxtarString: files,
+		}).Build()
+
+	b.AssertFileContent("public/index.html",
+		"Default: /css/main.css|text/css|",
+		"Minified: /css/main.f92ae0c1.css|@import&#34;https://fonts.example.org/font.css&#34;;.btn{color:var(--color);background:url(components/icons/btn.svg#x)}.card{background:url(../images/card.png);.title{font-weight:700}}:root{--color: red}body{background:url(/images/bg.png)}\n|",
+		"Root: /bundle.css|",
+		// The [hash] placeholder is only supported by css.Build.
+		"JS: /js/main.[hash].js|",
+	)
+	b.AssertFileContent("public/css/main.css",
+		`@import "https://fonts.example.org/font.css";`,
+		".btn {",
+		"color: var(--color);",
+		"--color: red;",
+		"url(/images/bg.png)",
+	)
+	b.AssertFileContent("public/bundle.css",
+		"url(css/components/icons/btn.svg#x)",
+		"url(images/card.png)",
+		"url(/images/bg.png)",
+	)
+	b.AssertFileContent("public/css/sm.css.map", `"sources"`)
+}
+
+func TestCSSBuildError(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- assets/css/main.css --
+@import "missing.css";
+-- layouts/index.html --
+{{ $css := resources.Get "css/main.css" | css.Build }}
+{{ $css.RelPermalink }}
+`
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			NeedsOsFS:   true,
+			TxtarString: files,
+		}).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `Could not resolve "missing.css"`)
+}
@@ -103,6 +103,10 @@ type Options struct {
 	sourceDir  string
 	resolveDir string
 	tsConfig   string
+
+	// The slash separated directory of the target path, used to rebase
+	// relative url() references in CSS.
+	targetDir string
 }
 
 func decodeOptions(m map[string]any) (Options, error) {
@@ -203,10 +207,45 @@ func resolveComponentInAssets(fs afero.Fs, impPath string) *hugofs.FileMeta {
 	return m
 }
 
+// rebaseCSSURL rewrites the relative url() reference u in a stylesheet in
+// sourceDir, relative to /assets, to be relative to targetDir.
+// Absolute paths, URLs with a scheme, e.g. data: URLs, and fragments are
+// returned as is.
+func rebaseCSSURL(u, sourceDir, targetDir string) string {
+	if u == "" || strings.HasPrefix(u, "/") || strings.HasPrefix(u, "#") || strings.Contains(u, ":") {
+		return u
+	}
+
+	p, suffix := u, ""
+	if i := strings.IndexAny(u, "?#"); i != -1 {
+		p, suffix = u[:i], u[i:]
+	}
+
+	target := filepath.Join(string(filepath.Separator), sourceDir, filepath.FromSlash(p))
+	rel, err := filepath.Rel(filepath.Join(string(filepath.Separator), filepath.FromSlash(targetDir)), target)
+	if err != nil {
+		return u
+	}
+
+	return filepath.ToSlash(rel) + suffix
+}
+
 func createBuildPlugins(c *Client, opts Options) ([]api.Plugin, error) {
 	fs := c.rs.Assets
 
 	resolveImport := func(args api.OnResolveArgs) (api.OnResolveResult, error) {
+		if args.Kind == api.ResolveCSSURLToken {
+			// Leave url() references, e.g. to images and fonts, unbundled,
+			// but make relative references relative to the target.
+			p := args.Path
+			if args.Importer == stdinImporter {
+				p = rebaseCSSURL(p, opts.sourceDir, opts.targetDir)
+			} else if rel, found := fs.MakePathRelative(args.Importer); found {
+				p = rebaseCSSURL(p, filepath.Dir(rel), opts.targetDir)
+			}
+			return api.OnResolveResult{Path: p, External: true}, nil
+		}
+
 		impPath := args.Path
 		if opts.Shims != nil {
 			override, found := opts.Shims[impPath]
@@ -229,13 +268,36 @@ func createBuildPlugins(c *Client, opts Options) ([]api.Plugin, error) {
 			relDir = opts.sourceDir
 		}
 
+		var m *hugofs.FileMeta
+
+		if args.Kind == api.ResolveCSSImportRule {
+			// The .css extension may be left out in @import.
+			cssPath := impPath
+			if filepath.Ext(cssPath) == "" {
+				cssPath += ".css"
+			}
+			// In CSS, @import "foo.css" is relative to the importing stylesheet,
+			// so try that before /assets.
+			if relDir != "" && !strings.HasPrefix(cssPath, ".") && !strings.HasPrefix(cssPath, "/") {
+				m = resolveComponentInAssets(fs.Fs, filepath.Join(relDir, cssPath))
+			}
+			if m == nil && cssPath != impPath {
+				if relDir != "" && strings.HasPrefix(cssPath, ".") {
+					cssPath = filepath.Join(relDir, cssPath)
+				}
+				m = resolveComponentInAssets(fs.Fs, cssPath)
+			}
+		}
+
 		// Imports not starting with a "." is assumed to live relative to /assets.
 		// Hugo makes no assumptions about the directory structure below /assets.
 		if relDir != "" && strings.HasPrefix(impPath, ".") {
 			impPath = filepath.Join(relDir, impPath)
 		}
 
-		m := resolveComponentInAssets(fs.Fs, impPath)
+		if m == nil {
+			m = resolveComponentInAssets(fs.Fs, impPath)
+		}
 
 		if m != nil {
 			// Store the source root so we can create a jsconfig.json
@@ -352,6 +414,8 @@ func toBuildOptions(opts Options) (buildOptions api.BuildOptions, err error) {
 		loader = api.LoaderTSX
 	case media.Builtin.JSXType.SubType:
 		loader = api.LoaderJSX
+	case media.Builtin.CSSType.SubType:
+		loader = api.LoaderCSS
 	default:
 		err = fmt.Errorf("unsupported Media Type: %q", opts.mediaType)
 		return
@@ -361,6 +425,10 @@ func toBuildOptions(opts Options) (buildOptions api.BuildOptions, err error) {
 	// One of: iife, cjs, esm
 	switch opts.Format {
 	case "", "iife":
+		if loader == api.LoaderCSS {
+			// The format only applies to JavaScript.
+			break
+		}
 		format = api.FormatIIFE
 	case "esm":
 		format = api.FormatESModule
@@ -14,6 +14,7 @@
 package minifier_test
 
 import (
+	"strings"
 	"testing"
 
 	qt "github.com/frankban/quicktest"
@@ -45,3 +46,64 @@ new Date(2002, 04, 11)
 	b.Assert(err, qt.IsNotNil)
 	b.Assert(err, qt.ErrorMatches, "(?s).*legacy octal numbers.*line 1.*")
 }
+
+func TestTransformMinifySourceMaps(t *testing.T) {
+	files := `
+-- assets/css/main.css --
+body {
+  color: red;
+}
+
+p {
+  margin: 0;
+}
+-- assets/js/main.js --
+import { hello } from "./util";
+hello("world");
+-- assets/js/util.js --
+export function hello(name) {
+  console.log("Hello, " + name);
+}
+-- config.toml --
+disableKinds = ["taxonomy", "term", "page", "section", "rss", "sitemap", "robotsTXT", "404"]
+[build.sourceMaps]
+enable = true
+environments = ["production"]
+-- layouts/index.html --
+{{ $css := resources.Get "css/main.css" | minify | fingerprint }}
+{{ $js := resources.Get "js/main.js" | js.Build | minify | fingerprint }}
+CSS: {{ $css.RelPermalink }}|{{ $css.Content | safeHTML }}|
+JS: {{ $js.RelPermalink }}|{{ $js.Content | safeJS }}|
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			NeedsOsFS:   true,
+		},
+	).Build()
+
+	b.AssertFileContent("public/css/main.min.css.map", `"file":"main.min.css"`, `"sources":["main.css"]`, `"mappings":"`)
+	b.AssertFileContent("public/js/main.min.js.map", `"file":"main.min.js"`, `"sources":["assets/js/util.js","assets/js/main.js"]`, `"mappings":"`)
+	b.Assert(b.FileContent("public/js/main.min.js.map"), qt.Not(qt.Contains), "sourcesContent")
+
+	b.AssertFileContent("public/index.html",
+		"CSS: /css/main.min.",
+		"/*# sourceMappingURL=main.min.css.map */",
+		"JS: /js/main.min.",
+		"//# sourceMappingURL=main.min.js.map",
+	)
+
+	// Source maps are only enabled in production.
+	b = hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.ReplaceAll(files, `"production"`, `"staging"`),
+			NeedsOsFS:   true,
+		},
+	).Build()
+
+	b.AssertDestinationExists("css/main.min.css.map", false)
+	b.AssertDestinationExists("js/main.min.js.map", false)
+}
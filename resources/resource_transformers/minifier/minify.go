@@ -14,9 +14,17 @@
 package minifier
 
 import (
+	"errors"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/evanw/esbuild/pkg/api"
+	"github.com/gohugoio/hugo/media"
 	"github.com/gohugoio/hugo/minifiers"
 	"github.com/gohugoio/hugo/resources"
 	"github.com/gohugoio/hugo/resources/internal"
+	"github.com/gohugoio/hugo/resources/internal/sourcemap"
 	"github.com/gohugoio/hugo/resources/resource"
 )
 
@@ -48,9 +56,48 @@ func (t *minifyTransformation) Key() internal.ResourceTransformationKey {
 
 func (t *minifyTransformation) Transform(ctx *resources.ResourceTransformationCtx) error {
 	ctx.AddOutPathIdentifier(".min")
+	if ctx.SourceMapsEnabled() {
+		if loader, ok := sourceMapLoaders[ctx.InMediaType.SubType]; ok {
+			return minifyWithSourceMap(ctx, loader)
+		}
+	}
 	return t.m.Minify(ctx.InMediaType, ctx.To, ctx.From)
 }
 
+// The media types we can minify with a source map, see minifyWithSourceMap.
+var sourceMapLoaders = map[string]api.Loader{
+	media.Builtin.CSSType.SubType:        api.LoaderCSS,
+	media.Builtin.JavascriptType.SubType: api.LoaderJS,
+}
+
+// minifyWithSourceMap minifies CSS and JavaScript using ESBuild, which, unlike
+// the minifiers configured in the minify config, creates a source map.
+func minifyWithSourceMap(ctx *resources.ResourceTransformationCtx, loader api.Loader) error {
+	b, err := io.ReadAll(ctx.From)
+	if err != nil {
+		return err
+	}
+	content, _ := sourcemap.ExtractComment(string(b))
+
+	result := api.Transform(content, api.TransformOptions{
+		Loader:            loader,
+		MinifyWhitespace:  true,
+		MinifySyntax:      true,
+		MinifyIdentifiers: loader == api.LoaderJS,
+		Sourcemap:         api.SourceMapExternal,
+		Sourcefile:        path.Base(ctx.InPath),
+	})
+	if len(result.Errors) > 0 {
+		return errors.New(result.Errors[0].Text)
+	}
+
+	if _, err := io.WriteString(ctx.To, strings.TrimSuffix(string(result.Code), "\n")); err != nil {
+		return err
+	}
+
+	return ctx.UpdateSourceMap(string(result.Map))
+}
+
 func (c *Client) Minify(res resources.ResourceTransformer) (resource.Resource, error) {
 	return res.Transform(&minifyTransformation{
 		rs: c.rs,
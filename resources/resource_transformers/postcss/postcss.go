@@ -15,6 +15,7 @@ package postcss
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
@@ -35,6 +36,7 @@ import (
 	"github.com/gohugoio/hugo/common/loggers"
 
 	"github.com/gohugoio/hugo/resources/internal"
+	"github.com/gohugoio/hugo/resources/internal/sourcemap"
 	"github.com/spf13/afero"
 	"github.com/spf13/cast"
 
@@ -81,8 +83,9 @@ type Client struct {
 }
 
 // Process transforms the given Resource with the PostCSS processor.
-func (c *Client) Process(res resources.ResourceTransformer, options map[string]any) (resource.Resource, error) {
-	return res.Transform(&postcssTransformation{rs: c.rs, optionsm: options})
+// The programs run are checked against the security policy of the module set in ctx.
+func (c *Client) Process(ctx context.Context, res resources.ResourceTransformer, options map[string]any) (resource.Resource, error) {
+	return res.TransformWithContext(ctx, &postcssTransformation{rs: c.rs, optionsm: options})
 }
 
 // Some of the options from https://github.com/postcss/postcss-cli
@@ -151,7 +154,7 @@ func (t *postcssTransformation) Key() internal.ResourceTransformationKey {
 func (t *postcssTransformation) Transform(ctx *resources.ResourceTransformationCtx) error {
 	const binaryName = "postcss"
 
-	ex := t.rs.ExecHelper
+	ex := t.rs.ExecHelper.ForContext(ctx.Ctx)
 
 	var configFile string
 	logger := t.rs.Logger
@@ -165,6 +168,13 @@ func (t *postcssTransformation) Transform(ctx *resources.ResourceTransformationC
 		}
 	}
 
+	sourceMapsEnabled := ctx.SourceMapsEnabled()
+	if sourceMapsEnabled {
+		// We need the default inline source map to compose it with the
+		// source map from any previous transformations.
+		options.NoMap = false
+	}
+
 	if options.Config != "" {
 		configFile = options.Config
 	} else {
@@ -193,12 +203,16 @@ func (t *postcssTransformation) Transform(ctx *resources.ResourceTransformationC
 		cmdArgs = append(cmdArgs, collections.StringSliceToInterfaceSlice(optArgs)...)
 	}
 
-	var errBuf bytes.Buffer
+	var errBuf, outBuf bytes.Buffer
 	infoW := loggers.LoggerToWriterWithPrefix(logger.Info(), "postcss")
 
 	stderr := io.MultiWriter(infoW, &errBuf)
 	cmdArgs = append(cmdArgs, hexec.WithStderr(stderr))
-	cmdArgs = append(cmdArgs, hexec.WithStdout(ctx.To))
+	if sourceMapsEnabled {
+		cmdArgs = append(cmdArgs, hexec.WithStdout(&outBuf))
+	} else {
+		cmdArgs = append(cmdArgs, hexec.WithStdout(ctx.To))
+	}
 	cmdArgs = append(cmdArgs, hexec.WithEnviron(hugo.GetExecEnviron(t.rs.Cfg.BaseConfig().WorkingDir, t.rs.Cfg, t.rs.BaseFs.Assets.Fs)))
 
 	cmd, err := ex.Npx(binaryName, cmdArgs...)
@@ -217,8 +231,19 @@ func (t *postcssTransformation) Transform(ctx *resources.ResourceTransformationC
 
 	src := ctx.From
 
+	if sourceMapsEnabled {
+		// Remove the sourceMappingURL comment added after any previous
+		// transformation, PostCSS would try to resolve it.
+		b, err := io.ReadAll(src)
+		if err != nil {
+			return err
+		}
+		content, _ := sourcemap.ExtractComment(string(b))
+		src = strings.NewReader(content)
+	}
+
 	imp := newImportResolver(
-		ctx.From,
+		src,
 		ctx.InPath,
 		options,
 		t.rs.Assets.Fs, t.rs.Logger,
@@ -245,6 +270,19 @@ func (t *postcssTransformation) Transform(ctx *resources.ResourceTransformationC
 		return imp.toFileError(errBuf.String())
 	}
 
+	if sourceMapsEnabled {
+		content, m, err := sourcemap.ExtractInline(outBuf.String())
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(ctx.To, content); err != nil {
+			return err
+		}
+		if m != "" {
+			return ctx.UpdateSourceMap(m)
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,83 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package purgecss_test
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestPurgeCSSPostProcess(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "section", "page", "rss", "sitemap"]
+[build]
+writeStats = true
+-- assets/css/main.css --
+body { margin: 0 }
+.used { color: red }
+.unused { color: blue }
+.js-open { display: block }
+@media (min-width: 640px) {
+  #main .used { color: green }
+  h1 { font-size: 3em }
+}
+-- layouts/index.html --
+{{ $css := resources.Get "css/main.css" | resources.PurgeCSS (dict "safelist" (slice "^js-")) | resources.PostProcess }}
+<html>
+<head><link rel="stylesheet" href="{{ $css.RelPermalink }}"></head>
+<body><div id="main" class="used">Home</div></body>
+</html>
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html", `href="/css/main.css"`)
+	b.AssertFileContent("public/css/main.css", "body { margin: 0 }", ".used { color: red }", ".js-open { display: block }", "#main .used { color: green }")
+	css := b.FileContent("public/css/main.css")
+	b.Assert(css, qt.Not(qt.Contains), "unused")
+	b.Assert(css, qt.Not(qt.Contains), "h1")
+}
+
+func TestPurgeCSSNoStats(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "section", "page", "rss", "sitemap"]
+-- assets/css/main.css --
+.used { color: red }
+-- layouts/index.html --
+{{ $css := resources.Get "css/main.css" | resources.PurgeCSS }}
+{{ $css.RelPermalink }}
+`
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.ErrorMatches, `(?s).*hugo_stats.json not found; enable build.writeStats.*`)
+}
@@ -0,0 +1,369 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package purgecss
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gohugoio/hugo/publisher"
+	"github.com/tdewolff/parse/v2"
+	"github.com/tdewolff/parse/v2/css"
+)
+
+// At-rules with nested rules that are pruned. Any other at-rule block,
+// e.g. @font-face and @keyframes, is left as is.
+var prunedAtRules = map[string]bool{
+	"@media":     true,
+	"@supports":  true,
+	"@document":  true,
+	"@layer":     true,
+	"@container": true,
+}
+
+type token struct {
+	tt   css.TokenType
+	data []byte
+}
+
+// pruner removes the rules from a stylesheet with selectors not matching any
+// of the HTML elements collected during the build.
+type pruner struct {
+	tags     map[string]bool
+	classes  map[string]bool
+	ids      map[string]bool
+	safelist []*regexp.Regexp
+
+	tokens []token
+	pos    int
+}
+
+func newPruner(elements publisher.HTMLElements, safelist []*regexp.Regexp) *pruner {
+	toSet := func(s []string) map[string]bool {
+		m := make(map[string]bool, len(s))
+		for _, v := range s {
+			m[v] = true
+		}
+		return m
+	}
+
+	return &pruner{
+		tags:     toSet(elements.Tags),
+		classes:  toSet(elements.Classes),
+		ids:      toSet(elements.IDs),
+		safelist: safelist,
+	}
+}
+
+// prune returns src without the unused rules.
+// Everything not removed, including comments and whitespace, is left as is.
+func (p *pruner) prune(src []byte) ([]byte, error) {
+	l := css.NewLexer(parse.NewInputBytes(src))
+	p.tokens = p.tokens[:0]
+	p.pos = 0
+	for {
+		tt, data := l.Next()
+		if tt == css.ErrorToken {
+			if err := l.Err(); err != io.EOF {
+				return nil, err
+			}
+			break
+		}
+		p.tokens = append(p.tokens, token{tt, data})
+	}
+
+	var buf bytes.Buffer
+	p.pruneRules(&buf, false)
+
+	return buf.Bytes(), nil
+}
+
+// pruneRules writes the rules up to the end of the current block, or the end
+// of the stylesheet, to w, skipping the unused rules.
+func (p *pruner) pruneRules(w *bytes.Buffer, inBlock bool) {
+	for p.pos < len(p.tokens) {
+		t := p.tokens[p.pos]
+		switch t.tt {
+		case css.WhitespaceToken, css.CommentToken, css.CDOToken, css.CDCToken, css.SemicolonToken:
+			w.Write(t.data)
+			p.pos++
+		case css.RightBraceToken:
+			if inBlock {
+				return
+			}
+			w.Write(t.data)
+			p.pos++
+		case css.AtKeywordToken:
+			p.pruneAtRule(w)
+		default:
+			p.pruneQualifiedRule(w)
+		}
+	}
+}
+
+// pruneAtRule handles the at-rule starting at the current position.
+func (p *pruner) pruneAtRule(w *bytes.Buffer) {
+	name := strings.ToLower(string(p.tokens[p.pos].data))
+	prelude, hasBlock := p.readPrelude()
+	if !hasBlock || !prunedAtRules[name] {
+		writeTokens(w, prelude)
+		if hasBlock {
+			writeTokens(w, p.readBlock())
+		}
+		return
+	}
+
+	// Skip the left brace.
+	p.pos++
+	var inner bytes.Buffer
+	p.pruneRules(&inner, true)
+	// Skip the right brace, if any.
+	p.pos++
+
+	if len(bytes.TrimSpace(inner.Bytes())) == 0 {
+		// All of the nested rules are unused.
+		return
+	}
+
+	writeTokens(w, prelude)
+	w.WriteByte('{')
+	w.Write(inner.Bytes())
+	w.WriteByte('}')
+}
+
+// pruneQualifiedRule handles the style rule starting at the current position.
+func (p *pruner) pruneQualifiedRule(w *bytes.Buffer) {
+	prelude, hasBlock := p.readPrelude()
+	if !hasBlock {
+		// Not valid CSS, leave it to the browser.
+		writeTokens(w, prelude)
+		return
+	}
+	block := p.readBlock()
+
+	selectors := splitSelectors(prelude)
+	var kept [][]token
+	for _, sel := range selectors {
+		if p.isUsed(sel) {
+			kept = append(kept, sel)
+		}
+	}
+
+	if len(kept) == 0 {
+		return
+	}
+
+	if len(kept) == len(selectors) {
+		writeTokens(w, prelude)
+	} else {
+		for i, sel := range kept {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			w.Write(bytes.TrimSpace(tokensBytes(sel)))
+		}
+		// Keep any whitespace before the block.
+		if last := prelude[len(prelude)-1]; last.tt == css.WhitespaceToken {
+			w.Write(last.data)
+		}
+	}
+
+	writeTokens(w, block)
+}
+
+// readPrelude reads the tokens up to, but not including, the top level left
+// brace, or up to and including the top level semicolon.
+// It reports whether a block follows.
+func (p *pruner) readPrelude() ([]token, bool) {
+	start := p.pos
+	level := 0
+	for ; p.pos < len(p.tokens); p.pos++ {
+		switch p.tokens[p.pos].tt {
+		case css.LeftParenthesisToken, css.LeftBracketToken, css.FunctionToken:
+			level++
+		case css.RightParenthesisToken, css.RightBracketToken:
+			level--
+		case css.LeftBraceToken:
+			if level <= 0 {
+				return p.tokens[start:p.pos], true
+			}
+		case css.SemicolonToken:
+			if level <= 0 {
+				p.pos++
+				return p.tokens[start:p.pos], false
+			}
+		case css.RightBraceToken:
+			if level <= 0 {
+				// End of the enclosing block.
+				return p.tokens[start:p.pos], false
+			}
+		}
+	}
+	return p.tokens[start:p.pos], false
+}
+
+// readBlock reads the block starting at the current left brace,
+// including any nested blocks.
+func (p *pruner) readBlock() []token {
+	start := p.pos
+	level := 0
+	for ; p.pos < len(p.tokens); p.pos++ {
+		switch p.tokens[p.pos].tt {
+		case css.LeftBraceToken:
+			level++
+		case css.RightBraceToken:
+			level--
+			if level == 0 {
+				p.pos++
+				return p.tokens[start:p.pos]
+			}
+		}
+	}
+	return p.tokens[start:p.pos]
+}
+
+// isUsed reports whether all the type, class and ID selectors in sel match
+// the collected HTML elements or the safelist.
+// Selectors inside functional pseudo-classes such as :not() are ignored.
+func (p *pruner) isUsed(sel []token) bool {
+	level := 0
+	for i, t := range sel {
+		switch t.tt {
+		case css.LeftParenthesisToken, css.LeftBracketToken, css.FunctionToken:
+			level++
+			continue
+		case css.RightParenthesisToken, css.RightBracketToken:
+			level--
+			continue
+		}
+		if level > 0 {
+			continue
+		}
+
+		var prev css.TokenType
+		var prevData []byte
+		if i > 0 {
+			prev, prevData = sel[i-1].tt, sel[i-1].data
+		}
+
+		switch t.tt {
+		case css.HashToken:
+			if !p.match(p.ids, unescape(string(t.data[1:]))) {
+				return false
+			}
+		case css.IdentToken:
+			switch {
+			case prev == css.DelimToken && string(prevData) == ".":
+				if !p.match(p.classes, unescape(string(t.data))) {
+					return false
+				}
+			case prev == css.ColonToken, prev == css.DelimToken && string(prevData) == "|":
+				// Pseudo-class, pseudo-element or namespace.
+			default:
+				if !p.match(p.tags, strings.ToLower(unescape(string(t.data)))) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+func (p *pruner) match(m map[string]bool, name string) bool {
+	if m[name] {
+		return true
+	}
+	for _, re := range p.safelist {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitSelectors splits the selector list in prelude on the top level commas.
+func splitSelectors(prelude []token) [][]token {
+	var (
+		selectors [][]token
+		start     int
+		level     int
+	)
+	for i, t := range prelude {
+		switch t.tt {
+		case css.LeftParenthesisToken, css.LeftBracketToken, css.FunctionToken:
+			level++
+		case css.RightParenthesisToken, css.RightBracketToken:
+			level--
+		case css.CommaToken:
+			if level == 0 {
+				selectors = append(selectors, prelude[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(selectors, prelude[start:])
+}
+
+// unescape resolves the CSS escapes in s, e.g. "md\:flex" and "\31 0" into
+// "md:flex" and "10".
+func unescape(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' || i == len(s)-1 {
+			sb.WriteByte(c)
+			continue
+		}
+		i++
+		j := i
+		for j < len(s) && j-i < 6 && isHex(s[j]) {
+			j++
+		}
+		if j == i {
+			sb.WriteByte(s[i])
+			continue
+		}
+		r, _ := strconv.ParseUint(s[i:j], 16, 32)
+		sb.WriteRune(rune(r))
+		// A single whitespace after a hex escape is part of the escape.
+		if j < len(s) && s[j] == ' ' {
+			j++
+		}
+		i = j - 1
+	}
+	return sb.String()
+}
+
+func isHex(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func writeTokens(w *bytes.Buffer, tokens []token) {
+	for _, t := range tokens {
+		w.Write(t.data)
+	}
+}
+
+func tokensBytes(tokens []token) []byte {
+	var buf bytes.Buffer
+	writeTokens(&buf, tokens)
+	return buf.Bytes()
+}
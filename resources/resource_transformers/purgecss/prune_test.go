@@ -0,0 +1,72 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package purgecss
+
+import (
+	"regexp"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/publisher"
+)
+
+func TestPrune(t *testing.T) {
+	c := qt.New(t)
+
+	elements := publisher.HTMLElements{
+		Tags:    []string{"body", "a", "p", "html"},
+		Classes: []string{"used", "md:flex", "10"},
+		IDs:     []string{"main"},
+	}
+
+	p := newPruner(elements, []*regexp.Regexp{regexp.MustCompile("^is-")})
+
+	for _, test := range []struct {
+		name   string
+		in     string
+		expect string
+	}{
+		{"classes", ".used{color:red}\n.unused{color:blue}\n", ".used{color:red}\n\n"},
+		{"tags", "p, h1 { margin: 0 }\nh2 { margin: 0 }", "p { margin: 0 }\n"},
+		{"ids", "#main{x:1}#other{x:2}", "#main{x:1}"},
+		{"compound", "a.used:hover{x:1}a.unused{x:2}", "a.used:hover{x:1}"},
+		{"descendant", "body .used > p{x:1}body .unused p{x:2}", "body .used > p{x:1}"},
+		{"selector list", ".used, .unused, .is-active { x: 1 }", ".used,.is-active { x: 1 }"},
+		{"pseudo", "a::before{x:1}p:not(.unused){x:2}", "a::before{x:1}p:not(.unused){x:2}"},
+		{"attribute", "a[href$=\".pdf\"]{x:1}h1[title]{x:2}", "a[href$=\".pdf\"]{x:1}"},
+		{"universal", "*{box-sizing:border-box}", "*{box-sizing:border-box}"},
+		{"escapes", ".md\\:flex{x:1}.\\31 0{x:2}.sm\\:flex{x:3}", ".md\\:flex{x:1}.\\31 0{x:2}"},
+		{"media", "@media (min-width: 640px) { .used { x: 1 } .unused { x: 2 } }", "@media (min-width: 640px) { .used { x: 1 }  }"},
+		{"media empty", "@media print { .unused { x: 2 } }\n.used{x:1}", "\n.used{x:1}"},
+		{"keyframes", "@keyframes spin { from { x: 1 } to { x: 2 } }", "@keyframes spin { from { x: 1 } to { x: 2 } }"},
+		{"font-face", "@font-face { font-family: X; src: url(x.woff2) }", "@font-face { font-family: X; src: url(x.woff2) }"},
+		{"import", "@import url(\"foo.css\");\n.used{x:1}", "@import url(\"foo.css\");\n.used{x:1}"},
+		{"comments", "/* keep */\n.used{x:1}", "/* keep */\n.used{x:1}"},
+		{"root", ":root{--x:1}html{x:1}", ":root{--x:1}html{x:1}"},
+	} {
+		got, err := p.prune([]byte(test.in))
+		c.Assert(err, qt.IsNil, qt.Commentf(test.name))
+		c.Assert(string(got), qt.Equals, test.expect, qt.Commentf(test.name))
+	}
+}
+
+func TestUnescape(t *testing.T) {
+	c := qt.New(t)
+
+	c.Assert(unescape("foo"), qt.Equals, "foo")
+	c.Assert(unescape(`md\:flex`), qt.Equals, "md:flex")
+	c.Assert(unescape(`\31 0`), qt.Equals, "10")
+	c.Assert(unescape(`w-1\/2`), qt.Equals, "w-1/2")
+	c.Assert(unescape(`a\`), qt.Equals, `a\`)
+}
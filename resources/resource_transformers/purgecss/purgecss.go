@@ -0,0 +1,135 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package purgecss removes unused CSS rules using the HTML elements collected
+// in hugo_stats.json, see build.writeStats.
+package purgecss
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/gohugoio/hugo/common/herrors"
+	"github.com/gohugoio/hugo/media"
+	"github.com/gohugoio/hugo/publisher"
+	"github.com/gohugoio/hugo/resources"
+	"github.com/gohugoio/hugo/resources/internal"
+	"github.com/gohugoio/hugo/resources/resource"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/afero"
+)
+
+// The name of the file written when build.writeStats is enabled.
+const hugoStatsFilename = "hugo_stats.json"
+
+// Options for PurgeCSS.
+type Options struct {
+	// Regular expressions matching class names, IDs and element names to
+	// always keep, e.g. "^is-" for classes added by JavaScript.
+	Safelist []string
+
+	safelist []*regexp.Regexp
+}
+
+// DecodeOptions decodes the options in m.
+func DecodeOptions(m map[string]any) (opts Options, err error) {
+	if m == nil {
+		return
+	}
+	if err = mapstructure.WeakDecode(m, &opts); err != nil {
+		return
+	}
+	for _, s := range opts.Safelist {
+		re, err := regexp.Compile(s)
+		if err != nil {
+			return opts, fmt.Errorf("invalid safelist pattern %q: %w", s, err)
+		}
+		opts.safelist = append(opts.safelist, re)
+	}
+	return
+}
+
+// Client removes unused CSS rules.
+type Client struct {
+	rs *resources.Spec
+}
+
+// New creates a new Client with the given specification.
+func New(rs *resources.Spec) *Client {
+	return &Client{rs: rs}
+}
+
+type purgeTransformation struct {
+	options Options
+	rs      *resources.Spec
+}
+
+func (t *purgeTransformation) Key() internal.ResourceTransformationKey {
+	return internal.NewResourceTransformationKey("purgecss", t.options)
+}
+
+func (t *purgeTransformation) Transform(ctx *resources.ResourceTransformationCtx) error {
+	if ctx.InMediaType.SubType != media.Builtin.CSSType.SubType {
+		return fmt.Errorf("%s: media type %q is not CSS", ctx.SourcePath, ctx.InMediaType.Type)
+	}
+
+	elements, err := t.readStats()
+	if err != nil {
+		return err
+	}
+
+	src, err := io.ReadAll(ctx.From)
+	if err != nil {
+		return err
+	}
+
+	b, err := newPruner(elements, t.options.safelist).prune(src)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctx.To.Write(b)
+	return err
+}
+
+func (t *purgeTransformation) readStats() (publisher.HTMLElements, error) {
+	b, err := afero.ReadFile(t.rs.BaseFs.WorkDir, hugoStatsFilename)
+	if err != nil {
+		if herrors.IsNotExist(err) {
+			return publisher.HTMLElements{}, errors.New("hugo_stats.json not found; enable build.writeStats and wrap the resource in resources.PostProcess")
+		}
+		return publisher.HTMLElements{}, err
+	}
+
+	var stats publisher.PublishStats
+	if err := json.Unmarshal(b, &stats); err != nil {
+		return publisher.HTMLElements{}, fmt.Errorf("failed to parse hugo_stats.json: %w", err)
+	}
+
+	return stats.HTMLElements, nil
+}
+
+// Process removes the rules in the CSS resource res with selectors not
+// matching any of the HTML elements in hugo_stats.json. This needs
+// build.writeStats enabled and is meant to be used with resources.PostProcess
+// to make sure that all of the pages are rendered when the stats are read.
+func (c *Client) Process(res resources.ResourceTransformer, options map[string]any) (resource.Resource, error) {
+	opts, err := DecodeOptions(options)
+	if err != nil {
+		return nil, err
+	}
+	return res.Transform(&purgeTransformation{rs: c.rs, options: opts})
+}
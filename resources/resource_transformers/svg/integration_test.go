@@ -0,0 +1,143 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package svg_test
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestSVGOptimize(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+disableKinds = ["taxonomy", "term", "section", "page", "rss", "sitemap"]
+-- assets/icons/logo.svg --
+<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<!-- Created with Inkscape -->
+<svg xmlns="http://www.w3.org/2000/svg" xmlns:inkscape="http://www.inkscape.org/namespaces/inkscape" xmlns:sodipodi="http://sodipodi.sourceforge.net/DTD/sodipodi-0.dtd" width="24" height="24" viewBox="0 0 24 24" inkscape:version="1.2">
+  <metadata><rdf:RDF><cc:Work/></rdf:RDF></metadata>
+  <sodipodi:namedview id="namedview1" pagecolor="#ffffff"><inkscape:page x="0" y="0"/></sodipodi:namedview>
+  <g inkscape:label="Layer 1" inkscape:groupmode="layer">
+    <rect x="2.000" y="2.000" width="20" height="20" fill="#ff0000"/>
+  </g>
+</svg>
+-- layouts/index.html --
+{{ $svg := resources.Get "icons/logo.svg" | resources.SVGOptimize }}
+RelPermalink: {{ $svg.RelPermalink }}|
+Content: {{ $svg.Content | safeHTML }}|
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html",
+		"RelPermalink: /icons/logo.min.svg|",
+		`Content: <svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24"><g><rect x="2" y="2" width="20" height="20" fill="red"/></g></svg>|`,
+	)
+
+	files = `
+-- config.toml --
+disableKinds = ["taxonomy", "term", "section", "page", "rss", "sitemap"]
+-- assets/css/main.css --
+body { color: red; }
+-- layouts/index.html --
+{{ $svg := resources.Get "css/main.css" | resources.SVGOptimize }}
+{{ $svg.RelPermalink }}
+`
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `media type "text/css" is not SVG`)
+}
+
+func TestSVGSprite(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+disableKinds = ["taxonomy", "term", "section", "page", "rss", "sitemap"]
+-- assets/icons/github.svg --
+<?xml version="1.0" encoding="UTF-8"?>
+<svg xmlns="http://www.w3.org/2000/svg" width="24" height="24" viewBox="0 0 24 24" fill="none" stroke="currentColor"><path d="M9 19c-5 1.5-5-2.5-7-3"/></svg>
+-- assets/icons/circle.svg --
+<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" version="1.1" width="16px" height="16px">
+<!-- A circle -->
+<circle cx="8" cy="8" r="4"/>
+<g><circle cx="8" cy="8" r="2"/></g>
+</svg>
+-- layouts/index.html --
+{{ $sprite := resources.Match "icons/*.svg" | resources.SVGSprite "sprites/icons.svg" }}
+Sprite: {{ $sprite.RelPermalink }}|
+Github: {{ resources.SVGSymbol $sprite "github" }}|
+Circle: {{ resources.SVGSymbol $sprite "circle" (dict "class" "icon icon-circle" "aria-hidden" "true") }}|
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html",
+		"Sprite: /sprites/icons.svg|",
+		`Github: <svg><use href="/sprites/icons.svg#github"></use></svg>|`,
+		`Circle: <svg aria-hidden="true" class="icon icon-circle"><use href="/sprites/icons.svg#circle"></use></svg>|`,
+	)
+
+	b.AssertFileContent("public/sprites/icons.svg",
+		`<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink"><symbol id="circle" viewBox="0 0 16 16">
+<circle cx="8" cy="8" r="4"/>
+<g><circle cx="8" cy="8" r="2"/></g>
+</symbol><symbol id="github" viewBox="0 0 24 24" fill="none" stroke="currentColor"><path d="M9 19c-5 1.5-5-2.5-7-3"/></symbol></svg>`,
+	)
+}
+
+func TestSVGSymbolNotFound(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+disableKinds = ["taxonomy", "term", "section", "page", "rss", "sitemap"]
+-- assets/icons/github.svg --
+<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24"><path d="M9 19c-5 1.5-5-2.5-7-3"/></svg>
+-- layouts/index.html --
+{{ $sprite := resources.Match "icons/*.svg" | resources.SVGSprite "sprites/icons.svg" }}
+{{ resources.SVGSymbol $sprite "gitlab" }}
+`
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `symbol "gitlab" not found in SVG sprite "/sprites/icons.svg"`)
+}
@@ -0,0 +1,245 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package svg
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/hugio"
+	"github.com/gohugoio/hugo/resources"
+	"github.com/gohugoio/hugo/resources/resource"
+	"github.com/tdewolff/parse/v2/xml"
+)
+
+// Attributes on the root svg element not copied to the symbol element.
+var spriteIgnoredAttributes = map[string]bool{
+	"xmlns":       true,
+	"version":     true,
+	"baseProfile": true,
+	"id":          true,
+	"x":           true,
+	"y":           true,
+	"width":       true,
+	"height":      true,
+	"xml:space":   true,
+}
+
+// Sprite combines the SVG resources in r into a single SVG with one symbol per
+// resource, published to targetPath. The symbol IDs are the resource
+// names without the directory and extension, e.g. "github" for "icons/github.svg".
+// Note that any IDs inside the resources are left as is.
+func (c *Client) Sprite(targetPath string, r resource.Resources) (resource.Resource, error) {
+	ids := make(map[string]bool)
+	for _, res := range r {
+		if !isSVG(res.MediaType()) {
+			return nil, fmt.Errorf("resources in SVG sprites must be SVG, got %q for %q", res.MediaType().Type, res.Name())
+		}
+		id := symbolID(res)
+		if ids[id] {
+			return nil, fmt.Errorf("duplicate SVG sprite symbol ID %q", id)
+		}
+		ids[id] = true
+	}
+
+	// The CACHE_OTHER will make sure this will be re-created and published on rebuilds.
+	return c.rs.ResourceCache.GetOrCreate(path.Join(resources.CACHE_OTHER, targetPath), func() (resource.Resource, error) {
+		spriter := func() (hugio.ReadSeekCloser, error) {
+			var buf bytes.Buffer
+			buf.WriteString(`<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink">`)
+			for _, res := range r {
+				if err := writeSymbol(&buf, res); err != nil {
+					return nil, fmt.Errorf("%s: %w", res.Name(), err)
+				}
+			}
+			buf.WriteString("</svg>\n")
+			return hugio.NewReadSeekerNoOpCloserFromString(buf.String()), nil
+		}
+
+		return c.rs.New(
+			resources.ResourceSourceDescriptor{
+				Fs:                 c.rs.FileCaches.AssetsCache().Fs,
+				LazyPublish:        true,
+				OpenReadSeekCloser: spriter,
+				RelTargetFilename:  filepath.Clean(targetPath),
+			})
+	})
+}
+
+// SymbolIDs returns the IDs of the symbols in the SVG sprite r.
+func SymbolIDs(r resource.Resource) ([]string, error) {
+	rcr, ok := r.(resource.ReadSeekCloserResource)
+	if !ok {
+		return nil, fmt.Errorf("resource %T does not implement resource.ReadSeekerCloserResource", r)
+	}
+	rc, err := rcr.ReadSeekCloser()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var (
+		ids      []string
+		inSymbol bool
+	)
+	err = walk(rc, func(tt xml.TokenType, data []byte, name string, skip bool) error {
+		switch tt {
+		case xml.StartTagToken:
+			inSymbol = name == "symbol"
+		case xml.AttributeToken:
+			if inSymbol && name == "id" {
+				ids = append(ids, attributeValue(data))
+			}
+		case xml.StartTagCloseToken, xml.StartTagCloseVoidToken:
+			inSymbol = false
+		}
+		return nil
+	})
+
+	return ids, err
+}
+
+func symbolID(r resource.Resource) string {
+	name := path.Base(filepath.ToSlash(r.Name()))
+	return strings.TrimSuffix(name, path.Ext(name))
+}
+
+// writeSymbol writes the SVG in r as a symbol element to w.
+func writeSymbol(w io.Writer, r resource.Resource) error {
+	rcr, ok := r.(resource.ReadSeekCloserResource)
+	if !ok {
+		return fmt.Errorf("resource %T does not implement resource.ReadSeekerCloserResource", r)
+	}
+	rc, err := rcr.ReadSeekCloser()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	var (
+		attrs, inner           bytes.Buffer
+		viewBox, width, height string
+
+		// The depth of the current element, the root svg element is 1.
+		depth     int
+		inRootTag bool
+		done      bool
+	)
+
+	err = walk(rc, func(tt xml.TokenType, data []byte, name string, skip bool) error {
+		if skip || done {
+			return nil
+		}
+
+		if depth == 0 && !inRootTag {
+			if tt == xml.StartTagToken {
+				if name != "svg" {
+					return fmt.Errorf("expected svg root element, got %q", name)
+				}
+				inRootTag = true
+			}
+			// Anything else outside of the root element is ignored.
+			return nil
+		}
+
+		if inRootTag {
+			switch tt {
+			case xml.AttributeToken:
+				switch {
+				case name == "viewBox":
+					viewBox = attributeValue(data)
+				case name == "width":
+					width = attributeValue(data)
+				case name == "height":
+					height = attributeValue(data)
+				case spriteIgnoredAttributes[name] || strings.HasPrefix(name, "xmlns:"):
+				default:
+					attrs.Write(data)
+				}
+			case xml.StartTagCloseToken:
+				inRootTag = false
+				depth = 1
+			case xml.StartTagCloseVoidToken:
+				inRootTag = false
+				done = true
+			}
+			return nil
+		}
+
+		switch tt {
+		case xml.StartTagCloseToken:
+			depth++
+		case xml.EndTagToken:
+			depth--
+			if depth == 0 {
+				done = true
+				return nil
+			}
+		}
+
+		_, err := inner.Write(data)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if !done {
+		return errors.New("missing svg root element")
+	}
+
+	if viewBox == "" && width != "" && height != "" {
+		w, errw := strconv.ParseFloat(strings.TrimSuffix(width, "px"), 64)
+		h, errh := strconv.ParseFloat(strings.TrimSuffix(height, "px"), 64)
+		if errw == nil && errh == nil {
+			viewBox = fmt.Sprintf("0 0 %s %s", strconv.FormatFloat(w, 'f', -1, 64), strconv.FormatFloat(h, 'f', -1, 64))
+		}
+	}
+
+	fmt.Fprintf(w, `<symbol id="%s"`, html.EscapeString(symbolID(r)))
+	if viewBox != "" {
+		fmt.Fprintf(w, ` viewBox="%s"`, viewBox)
+	}
+	if _, err := attrs.WriteTo(w); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, ">"); err != nil {
+		return err
+	}
+	if _, err := inner.WriteTo(w); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "</symbol>")
+	return err
+}
+
+// attributeValue returns the unquoted value of the attribute token in data, e.g. ` width="24"`.
+func attributeValue(data []byte) string {
+	_, v, found := bytes.Cut(data, []byte("="))
+	if !found {
+		return ""
+	}
+	v = bytes.TrimSpace(v)
+	if len(v) >= 2 && (v[0] == '"' || v[0] == '\'') && v[len(v)-1] == v[0] {
+		v = v[1 : len(v)-1]
+	}
+	return string(v)
+}
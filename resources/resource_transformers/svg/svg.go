@@ -0,0 +1,181 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package svg contains functions to optimize SVG resources and to combine
+// them into sprites.
+package svg
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gohugoio/hugo/media"
+	"github.com/gohugoio/hugo/minifiers"
+	"github.com/gohugoio/hugo/resources"
+	"github.com/gohugoio/hugo/resources/internal"
+	"github.com/gohugoio/hugo/resources/resource"
+	"github.com/tdewolff/minify/v2"
+	"github.com/tdewolff/parse/v2"
+	"github.com/tdewolff/parse/v2/xml"
+)
+
+// Client contains methods to optimize SVG resources and to combine them into sprites.
+type Client struct {
+	rs *resources.Spec
+	m  *minify.M
+}
+
+// New creates a new Client with the given specification.
+// The SVG and CSS minifiers are configured from the minify config,
+// even if they are disabled there.
+func New(rs *resources.Spec) *Client {
+	conf := rs.Cfg.GetConfigSection("minify").(minifiers.MinifyConfig)
+	m := minify.New()
+	m.Add(media.Builtin.SVGType.Type, &conf.Tdewolff.SVG)
+	m.Add(media.Builtin.CSSType.Type, &conf.Tdewolff.CSS)
+	return &Client{rs: rs, m: m}
+}
+
+// Elements and attributes in these namespaces are only of interest to the
+// editors that created the SVG.
+var editorNamespaces = map[string]bool{
+	"inkscape": true,
+	"sodipodi": true,
+	"sketch":   true,
+	"serif":    true,
+}
+
+// Elements removed from the SVG in addition to those in editorNamespaces.
+var removedElements = map[string]bool{
+	"metadata": true,
+}
+
+func isSVG(m media.Type) bool {
+	return m.SubType == media.Builtin.SVGType.SubType
+}
+
+type optimizeTransformation struct {
+	c *Client
+}
+
+func (t *optimizeTransformation) Key() internal.ResourceTransformationKey {
+	return internal.NewResourceTransformationKey("svgoptimize")
+}
+
+func (t *optimizeTransformation) Transform(ctx *resources.ResourceTransformationCtx) error {
+	if !isSVG(ctx.InMediaType) {
+		return fmt.Errorf("%s: media type %q is not SVG", ctx.SourcePath, ctx.InMediaType.Type)
+	}
+	ctx.AddOutPathIdentifier(".min")
+
+	var buf bytes.Buffer
+	if err := strip(&buf, ctx.From); err != nil {
+		return err
+	}
+	return t.c.m.Minify(media.Builtin.SVGType.Type, ctx.To, &buf)
+}
+
+// Optimize removes editor metadata, comments, the XML declaration and the
+// DOCTYPE from the SVG in res before minifying it.
+func (c *Client) Optimize(res resources.ResourceTransformer) (resource.Resource, error) {
+	return res.Transform(&optimizeTransformation{c: c})
+}
+
+// strip copies the SVG in src to dst, leaving out what's of no use in the browser.
+func strip(dst io.Writer, src io.Reader) error {
+	return walk(src, func(tt xml.TokenType, data []byte, name string, skip bool) error {
+		if !skip {
+			_, err := dst.Write(data)
+			return err
+		}
+		return nil
+	})
+}
+
+// walk calls fn for each token in the XML document in src. The name is the
+// element name for start tags and end tags, and the attribute name for attributes.
+// The skip argument is set for tokens that should be removed from the document.
+func walk(src io.Reader, fn func(tt xml.TokenType, data []byte, name string, skip bool) error) error {
+	l := xml.NewLexer(parse.NewInput(src))
+
+	var (
+		// Greater than 0 when inside an element to skip.
+		skipDepth int
+		// Set when the current start tag is skipped.
+		skipTag bool
+	)
+
+	for {
+		tt, data := l.Next()
+		var (
+			name string
+			skip bool
+		)
+
+		switch tt {
+		case xml.ErrorToken:
+			if err := l.Err(); err != io.EOF {
+				return err
+			}
+			return nil
+		case xml.CommentToken, xml.DOCTYPEToken:
+			skip = true
+		case xml.StartTagPIToken:
+			// Typically <?xml version="1.0" encoding="UTF-8"?>.
+			skipTag = true
+			skip = true
+		case xml.StartTagClosePIToken:
+			skipTag = false
+			skip = true
+		case xml.StartTagToken:
+			name = string(l.Text())
+			skipTag = skipDepth > 0 || removedElements[name] || isEditorName(name)
+			skip = skipTag
+		case xml.AttributeToken:
+			name = string(l.Text())
+			skip = skipTag || isEditorName(name) || isEditorNamespaceDeclaration(name)
+		case xml.StartTagCloseToken:
+			skip = skipTag
+			if skipTag {
+				skipDepth++
+			}
+			skipTag = false
+		case xml.StartTagCloseVoidToken:
+			skip = skipTag
+			skipTag = false
+		case xml.EndTagToken:
+			name = string(l.Text())
+			if skipDepth > 0 {
+				skip = true
+				skipDepth--
+			}
+		default:
+			skip = skipDepth > 0
+		}
+
+		if err := fn(tt, data, name, skip); err != nil {
+			return err
+		}
+	}
+}
+
+func isEditorName(name string) bool {
+	prefix, _, found := strings.Cut(name, ":")
+	return found && editorNamespaces[prefix]
+}
+
+func isEditorNamespaceDeclaration(name string) bool {
+	return strings.HasPrefix(name, "xmlns:") && editorNamespaces[strings.TrimPrefix(name, "xmlns:")]
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package svg
+
+import (
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestStrip(t *testing.T) {
+	c := qt.New(t)
+
+	for _, test := range []struct {
+		in     string
+		expect string
+	}{
+		{`<svg viewBox="0 0 1 1"><path d="M0 0"/></svg>`, `<svg viewBox="0 0 1 1"><path d="M0 0"/></svg>`},
+		{`<?xml version="1.0"?><!DOCTYPE svg><!-- c --><svg><path/></svg>`, `<svg><path/></svg>`},
+		{`<svg xmlns:sodipodi="x" sodipodi:docname="a.svg" fill="none"><sodipodi:namedview><inkscape:grid/></sodipodi:namedview><path/></svg>`, `<svg fill="none"><path/></svg>`},
+		{`<svg><metadata><rdf:RDF><cc:Work>text</cc:Work></rdf:RDF></metadata><g><metadata/><path/></g></svg>`, `<svg><g><path/></g></svg>`},
+		{`<svg><title>My title</title><path serif:id="p"/></svg>`, `<svg><title>My title</title><path/></svg>`},
+	} {
+		var sb strings.Builder
+		c.Assert(strip(&sb, strings.NewReader(test.in)), qt.IsNil)
+		c.Assert(sb.String(), qt.Equals, test.expect, qt.Commentf(test.in))
+	}
+}
+
+func TestAttributeValue(t *testing.T) {
+	c := qt.New(t)
+
+	c.Assert(attributeValue([]byte(` width="24"`)), qt.Equals, "24")
+	c.Assert(attributeValue([]byte(` width = '24px'`)), qt.Equals, "24px")
+	c.Assert(attributeValue([]byte(` width=24`)), qt.Equals, "24")
+	c.Assert(attributeValue([]byte(` hidden`)), qt.Equals, "")
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tailwindcss_test
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/common/hexec"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestTailwindCSS(t *testing.T) {
+	if !hexec.InPath("tailwindcss") {
+		t.Skip("the standalone tailwindcss binary is not installed")
+	}
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "section", "page", "rss", "sitemap"]
+[build]
+writeStats = true
+[security.exec]
+allow = ['^npx$', '^tailwindcss$']
+-- assets/css/main.css --
+@import "tailwindcss";
+@import "./components.css";
+-- assets/css/components.css --
+.btn { @apply font-bold; }
+-- layouts/index.html --
+{{ $css := resources.Get "css/main.css" | resources.TailwindCSS (dict "minify" true) | resources.PostProcess }}
+<html>
+<head><link rel="stylesheet" href="{{ $css.RelPermalink }}"></head>
+<body><div class="text-center">Home</div></body>
+</html>
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			NeedsOsFS:   true,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html", `href="/css/main.css"`)
+	b.AssertFileContent("public/css/main.css", ".text-center{text-align:center}", ".btn{")
+	b.Assert(b.FileContent("public/css/main.css"), qt.Not(qt.Contains), "@source")
+}
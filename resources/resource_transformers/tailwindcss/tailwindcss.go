@@ -0,0 +1,207 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tailwindcss transforms CSS with the Tailwind CSS CLI, either the
+// standalone binary or the one installed with npm.
+package tailwindcss
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/herrors"
+	"github.com/gohugoio/hugo/common/hexec"
+	"github.com/gohugoio/hugo/common/hugo"
+	"github.com/gohugoio/hugo/common/loggers"
+	"github.com/gohugoio/hugo/hugofs"
+	"github.com/gohugoio/hugo/media"
+	"github.com/gohugoio/hugo/resources"
+	"github.com/gohugoio/hugo/resources/internal"
+	"github.com/gohugoio/hugo/resources/resource"
+	"github.com/mitchellh/mapstructure"
+)
+
+const (
+	// The name of the Tailwind CSS CLI, both the standalone binary and
+	// the one in @tailwindcss/cli.
+	binaryName = "tailwindcss"
+
+	// The name of the file written when build.writeStats is enabled.
+	hugoStatsFilename = "hugo_stats.json"
+)
+
+// Options for the Tailwind CSS CLI.
+type Options struct {
+	// Minify the output.
+	Minify bool
+
+	// Optimize the output without minifying.
+	Optimize bool
+
+	// Additional content sources to scan for class names, as globs relative
+	// to the project directory, e.g. "assets/js/**/*.js".
+	Sources []string
+
+	// By default, hugo_stats.json is added as a content source when
+	// build.writeStats is enabled. Set this to disable that.
+	DisableStats bool
+}
+
+// DecodeOptions decodes the options in m.
+func DecodeOptions(m map[string]any) (opts Options, err error) {
+	if m == nil {
+		return
+	}
+	err = mapstructure.WeakDecode(m, &opts)
+	return
+}
+
+func (opts Options) toArgs() []any {
+	args := []any{"--input", "-"}
+	if opts.Minify {
+		args = append(args, "--minify")
+	}
+	if opts.Optimize {
+		args = append(args, "--optimize")
+	}
+	return args
+}
+
+// Client is the client used to do Tailwind CSS transformations.
+type Client struct {
+	rs *resources.Spec
+}
+
+// New creates a new Client with the given specification.
+func New(rs *resources.Spec) *Client {
+	return &Client{rs: rs}
+}
+
+type tailwindcssTransformation struct {
+	options Options
+	rs      *resources.Spec
+}
+
+func (t *tailwindcssTransformation) Key() internal.ResourceTransformationKey {
+	return internal.NewResourceTransformationKey("tailwindcss", t.options)
+}
+
+// Transform runs the Tailwind CSS CLI. The standalone binary, tailwindcss,
+// is used if found in PATH, else the one in node_modules, installed with:
+// npm install --save-dev @tailwindcss/cli
+func (t *tailwindcssTransformation) Transform(ctx *resources.ResourceTransformationCtx) error {
+	if ctx.InMediaType.SubType != media.Builtin.CSSType.SubType {
+		return fmt.Errorf("%s: media type %q is not CSS", ctx.SourcePath, ctx.InMediaType.Type)
+	}
+
+	ex := t.rs.ExecHelper.ForContext(ctx.Ctx)
+	logger := t.rs.Logger
+	workingDir := t.rs.Cfg.BaseConfig().WorkingDir
+
+	src, err := io.ReadAll(ctx.From)
+	if err != nil {
+		return err
+	}
+
+	var sources []string
+	if t.rs.BuildConfig().WriteStats && !t.options.DisableStats {
+		statsFilename := filepath.Join(workingDir, hugoStatsFilename)
+		sources = append(sources, statsFilename)
+		// Bust the cache of this resource when the stats change in server mode.
+		ctx.AddDependency(statsFilename)
+	}
+	for _, s := range t.options.Sources {
+		sources = append(sources, filepath.Join(workingDir, filepath.FromSlash(s)))
+	}
+	src = appendSources(src, sources)
+
+	var errBuf bytes.Buffer
+	infoW := loggers.LoggerToWriterWithPrefix(logger.Info(), "tailwindcss")
+
+	cmdArgs := t.options.toArgs()
+	// Resolve any relative imports from the directory of the source file.
+	cmdArgs = append(cmdArgs, "--cwd", t.sourceDir(ctx.SourcePath, workingDir))
+	cmdArgs = append(cmdArgs, hexec.WithDir(workingDir))
+	cmdArgs = append(cmdArgs, hexec.WithStdin(bytes.NewReader(src)))
+	cmdArgs = append(cmdArgs, hexec.WithStdout(ctx.To))
+	cmdArgs = append(cmdArgs, hexec.WithStderr(io.MultiWriter(infoW, &errBuf)))
+	cmdArgs = append(cmdArgs, hexec.WithEnviron(hugo.GetExecEnviron(workingDir, t.rs.Cfg, t.rs.BaseFs.Assets.Fs)))
+
+	var cmd hexec.Runner
+	if hexec.InPath(binaryName) {
+		cmd, err = ex.New(binaryName, cmdArgs...)
+	} else {
+		cmd, err = ex.Npx(binaryName, cmdArgs...)
+	}
+	if err != nil {
+		if hexec.IsNotFound(err) {
+			// This may be on a CI server etc. Will fall back to pre-built assets.
+			return herrors.ErrFeatureNotAvailable
+		}
+		return err
+	}
+
+	if err := cmd.Run(); err != nil {
+		if hexec.IsNotFound(err) {
+			return herrors.ErrFeatureNotAvailable
+		}
+		return fmt.Errorf("tailwindcss: %s: %w", strings.TrimSpace(errBuf.String()), err)
+	}
+
+	return nil
+}
+
+// sourceDir returns the directory of the source file in the assets file system,
+// falling back to the project directory, e.g. for resources created from a string.
+func (t *tailwindcssTransformation) sourceDir(sourcePath, workingDir string) string {
+	fi, err := t.rs.BaseFs.Assets.Fs.Stat(filepath.FromSlash(sourcePath))
+	if err != nil {
+		return workingDir
+	}
+	if fim, ok := fi.(hugofs.FileMetaInfo); ok && fim.Meta().Filename != "" {
+		return filepath.Dir(fim.Meta().Filename)
+	}
+	return workingDir
+}
+
+// appendSources appends an @source directive for each of the absolute filenames
+// or globs in sources to src.
+func appendSources(src []byte, sources []string) []byte {
+	if len(sources) == 0 {
+		return src
+	}
+	var buf bytes.Buffer
+	buf.Write(src)
+	if len(src) > 0 && src[len(src)-1] != '\n' {
+		buf.WriteByte('\n')
+	}
+	for _, s := range sources {
+		fmt.Fprintf(&buf, "@source %s;\n", strconv.Quote(filepath.ToSlash(s)))
+	}
+	return buf.Bytes()
+}
+
+// Process transforms the given CSS Resource with the Tailwind CSS CLI.
+// The programs run are checked against the security policy of the module set in ctx.
+func (c *Client) Process(ctx context.Context, res resources.ResourceTransformer, options map[string]any) (resource.Resource, error) {
+	opts, err := DecodeOptions(options)
+	if err != nil {
+		return nil, err
+	}
+	return res.TransformWithContext(ctx, &tailwindcssTransformation{rs: c.rs, options: opts})
+}
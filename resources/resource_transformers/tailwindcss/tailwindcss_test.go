@@ -0,0 +1,47 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tailwindcss
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestDecodeOptions(t *testing.T) {
+	c := qt.New(t)
+
+	opts, err := DecodeOptions(map[string]any{
+		"minify":       true,
+		"sources":      []any{"assets/js/**/*.js"},
+		"disableStats": "true",
+	})
+	c.Assert(err, qt.IsNil)
+	c.Assert(opts, qt.DeepEquals, Options{Minify: true, Sources: []string{"assets/js/**/*.js"}, DisableStats: true})
+	c.Assert(opts.toArgs(), qt.DeepEquals, []any{"--input", "-", "--minify"})
+
+	opts, err = DecodeOptions(nil)
+	c.Assert(err, qt.IsNil)
+	c.Assert(opts.toArgs(), qt.DeepEquals, []any{"--input", "-"})
+}
+
+func TestAppendSources(t *testing.T) {
+	c := qt.New(t)
+
+	c.Assert(string(appendSources([]byte(`@import "tailwindcss";`), nil)), qt.Equals, `@import "tailwindcss";`)
+	c.Assert(string(appendSources([]byte(`@import "tailwindcss";`), []string{"/p/hugo_stats.json", "/p/assets/js/**/*.js"})), qt.Equals, `@import "tailwindcss";
+@source "/p/hugo_stats.json";
+@source "/p/assets/js/**/*.js";
+`)
+}
@@ -18,17 +18,21 @@ package dartsass
 import (
 	"fmt"
 	"io"
+	"net/http"
+	"path/filepath"
 	"strings"
 
 	godartsassv1 "github.com/bep/godartsass"
 	"github.com/bep/godartsass/v2"
 	"github.com/gohugoio/hugo/common/herrors"
 	"github.com/gohugoio/hugo/common/hugo"
+	"github.com/gohugoio/hugo/common/maps"
 	"github.com/gohugoio/hugo/helpers"
 	"github.com/gohugoio/hugo/hugofs"
 	"github.com/gohugoio/hugo/hugolib/filesystems"
 	"github.com/gohugoio/hugo/resources"
 	"github.com/gohugoio/hugo/resources/resource"
+	"github.com/gohugoio/hugo/resources/resource_transformers/tocss/sassconfig"
 	"github.com/spf13/afero"
 
 	"github.com/mitchellh/mapstructure"
@@ -60,19 +64,29 @@ func New(fs *filesystems.SourceFilesystem, rs *resources.Spec) (*Client, error)
 		err          error
 	)
 
+	sassConfig := rs.Cfg.GetConfigSection("sass").(sassconfig.Config)
+
+	logEvent := func(deprecated, debug bool, message string) {
+		message = strings.ReplaceAll(message, dartSassStdinPrefix, "")
+		if debug {
+			// Log as Info for now, we may adjust this if it gets too chatty.
+			rs.Logger.Infof("Dart Sass: %s", message)
+			return
+		}
+		// The rest are either deprecations or @warn statements.
+		switch sassConfig.LogLevel(deprecated, isDependencyMessage(message), message) {
+		case sassconfig.LogLevelError:
+			rs.Logger.Errorf("Dart Sass: %s", message)
+		case sassconfig.LogLevelWarn:
+			rs.Logger.Warnf("Dart Sass: %s", message)
+		}
+	}
+
 	if hugo.IsDartSassV2() {
 		transpiler, err = godartsass.Start(godartsass.Options{
 			DartSassEmbeddedFilename: hugo.DartSassBinaryName,
 			LogEventHandler: func(event godartsass.LogEvent) {
-				message := strings.ReplaceAll(event.Message, dartSassStdinPrefix, "")
-				switch event.Type {
-				case godartsass.LogEventTypeDebug:
-					// Log as Info for now, we may adjust this if it gets too chatty.
-					rs.Logger.Infof("Dart Sass: %s", message)
-				default:
-					// The rest are either deprecations or @warn statements.
-					rs.Logger.Warnf("Dart Sass: %s", message)
-				}
+				logEvent(event.Type == godartsass.LogEventTypeDeprecated, event.Type == godartsass.LogEventTypeDebug, event.Message)
 			},
 		})
 
@@ -80,15 +94,7 @@ func New(fs *filesystems.SourceFilesystem, rs *resources.Spec) (*Client, error)
 		transpilerv1, err = godartsassv1.Start(godartsassv1.Options{
 			DartSassEmbeddedFilename: hugo.DartSassBinaryName,
 			LogEventHandler: func(event godartsassv1.LogEvent) {
-				message := strings.ReplaceAll(event.Message, dartSassStdinPrefix, "")
-				switch event.Type {
-				case godartsassv1.LogEventTypeDebug:
-					// Log as Info for now, we may adjust this if it gets too chatty.
-					rs.Logger.Infof("Dart Sass: %s", message)
-				default:
-					// The rest are either deprecations or @warn statements.
-					rs.Logger.Warnf("Dart Sass: %s", message)
-				}
+				logEvent(event.Type == godartsassv1.LogEventTypeDeprecated, event.Type == godartsassv1.LogEventTypeDebug, event.Message)
 			},
 		})
 	}
@@ -96,7 +102,27 @@ func New(fs *filesystems.SourceFilesystem, rs *resources.Spec) (*Client, error)
 	if err != nil {
 		return nil, err
 	}
-	return &Client{sfs: fs, workFs: rs.BaseFs.Work, rs: rs, transpiler: transpiler, transpilerV1: transpilerv1}, nil
+	return &Client{
+		sfs:        fs,
+		workFs:     rs.BaseFs.Work,
+		workingDir: rs.Cfg.WorkingDir(),
+		rs:         rs,
+		sassConfig: sassConfig,
+		httpClient: &http.Client{Timeout: rs.Cfg.Timeout()},
+
+		transpiler:   transpiler,
+		transpilerV1: transpilerv1,
+	}, nil
+}
+
+// isDependencyMessage reports whether the log message, on the form url:line:col: message,
+// comes from a stylesheet in node_modules or a remote URL.
+func isDependencyMessage(message string) bool {
+	loc, _, found := strings.Cut(message, ": ")
+	if !found {
+		return false
+	}
+	return strings.Contains(filepath.ToSlash(loc), "/node_modules/") || strings.HasPrefix(loc, "http://") || strings.HasPrefix(loc, "https://")
 }
 
 type Client struct {
@@ -104,6 +130,9 @@ type Client struct {
 	rs                   *resources.Spec
 	sfs                  *filesystems.SourceFilesystem
 	workFs               afero.Fs
+	workingDir           string
+	sassConfig           sassconfig.Config
+	httpClient           *http.Client
 
 	// One of these are non-nil.
 	transpiler   *godartsass.Transpiler
@@ -192,6 +221,11 @@ type Options struct {
 	//     @use "hugo:vars";
 	//     $color: vars.$color;
 	Vars map[string]any
+
+	// Custom importers to resolve the imports not found in the assets
+	// file system, tried in order. Defaults to the importers in the sass
+	// site configuration.
+	Importers []sassconfig.ImporterConfig
 }
 
 func decodeOptions(m map[string]any) (opts Options, err error) {
@@ -199,6 +233,15 @@ func decodeOptions(m map[string]any) (opts Options, err error) {
 		return
 	}
 	err = mapstructure.WeakDecode(m, &opts)
+	if err != nil {
+		return
+	}
+
+	if v, found := maps.LookupEqualFold(m, "importers"); found {
+		if opts.Importers, err = sassconfig.DecodeImporters(v); err != nil {
+			return
+		}
+	}
 
 	if opts.TargetPath != "" {
 		opts.TargetPath = helpers.ToSlashTrimLeading(opts.TargetPath)
@@ -0,0 +1,274 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dartsass
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/bep/godartsass/v2"
+	"github.com/gohugoio/hugo/common/paths"
+	"github.com/gohugoio/hugo/hugofs"
+	"github.com/gohugoio/hugo/resources/resource_transformers/tocss/sassconfig"
+	"github.com/spf13/afero"
+)
+
+// canonicalizeWithImporters tries the configured importers in order.
+// An empty string means that the URL is left to Dart Sass.
+func (t importResolver) canonicalizeWithImporters(url string) (string, error) {
+	for _, imp := range t.importers {
+		var (
+			s   string
+			err error
+		)
+		switch imp.Type {
+		case sassconfig.ImporterNode:
+			s, err = t.c.resolveNode(imp, url)
+		case sassconfig.ImporterMount:
+			s, err = t.c.resolveMount(imp, url)
+		case sassconfig.ImporterURL:
+			s, err = t.c.resolveRemote(imp, url)
+		}
+		if s != "" || err != nil {
+			return s, err
+		}
+	}
+	return "", nil
+}
+
+func (c *Client) nodeModulesDir(imp sassconfig.ImporterConfig) string {
+	dir := imp.Path
+	if dir == "" {
+		dir = "node_modules"
+	}
+	return filepath.Join(c.workingDir, filepath.FromSlash(dir))
+}
+
+// resolveNode resolves pkg:name/path, ~name/path and name/path in node_modules,
+// and relative imports in stylesheets loaded from there.
+func (c *Client) resolveNode(imp sassconfig.ImporterConfig, url string) (string, error) {
+	nodeModules := c.nodeModulesDir(imp)
+
+	if strings.HasPrefix(url, "file:") {
+		// A relative import in a stylesheet in node_modules.
+		filename, _ := paths.UrlToFilename(url)
+		if !isInDir(nodeModules, filename) {
+			return "", nil
+		}
+		return fileURL(findSassFile(hugofs.Os, filepath.Dir(filename), filepath.Base(filename), true)), nil
+	}
+
+	name := url
+	switch {
+	case strings.HasPrefix(name, "pkg:"):
+		name = strings.TrimPrefix(name, "pkg:")
+	case strings.HasPrefix(name, "~"):
+		name = strings.TrimPrefix(name, "~")
+	case strings.Contains(name, ":"), strings.HasPrefix(name, "."), strings.HasPrefix(name, "/"):
+		return "", nil
+	}
+
+	pkg, sub := splitPackageName(name)
+	if pkg == "" {
+		return "", nil
+	}
+	pkgDir := filepath.Join(nodeModules, filepath.FromSlash(pkg))
+	if !isInDir(nodeModules, pkgDir) {
+		return "", nil
+	}
+	if fi, err := hugofs.Os.Stat(pkgDir); err != nil || !fi.IsDir() {
+		return "", nil
+	}
+
+	if sub == "" {
+		// Use the stylesheet set in package.json, if any.
+		if entry := packageStylesheet(pkgDir); entry != "" {
+			filename := filepath.Join(pkgDir, filepath.FromSlash(entry))
+			if isInDir(pkgDir, filename) {
+				if _, err := hugofs.Os.Stat(filename); err == nil {
+					return fileURL(filename), nil
+				}
+			}
+		}
+		sub = "index"
+	}
+
+	filename := filepath.Join(pkgDir, filepath.FromSlash(sub))
+	if !isInDir(pkgDir, filename) {
+		return "", nil
+	}
+
+	return fileURL(findSassFile(hugofs.Os, filepath.Dir(filename), filepath.Base(filename), true)), nil
+}
+
+// resolveMount resolves prefix/path in the configured directory in the assets file system.
+func (c *Client) resolveMount(imp sassconfig.ImporterConfig, url string) (string, error) {
+	prefix := strings.TrimSuffix(imp.Prefix, "/")
+	var rest string
+	switch {
+	case url == prefix:
+		rest = "index"
+	case strings.HasPrefix(url, prefix+"/"):
+		rest = strings.TrimPrefix(url, prefix+"/")
+	default:
+		return "", nil
+	}
+
+	filename := path.Join(strings.Trim(imp.Path, "/"), rest)
+	if strings.HasPrefix(filename, "..") {
+		return "", nil
+	}
+
+	return c.realFileURL(findSassFile(c.sfs.Fs, filepath.FromSlash(path.Dir(filename)), path.Base(filename), true)), nil
+}
+
+// realFileURL returns the file URL of the real file behind filename in the assets file system,
+// or an empty string if not found.
+func (c *Client) realFileURL(filename string) string {
+	if filename == "" {
+		return ""
+	}
+	fi, err := c.sfs.Fs.Stat(filename)
+	if err != nil {
+		return ""
+	}
+	if fim, ok := fi.(hugofs.FileMetaInfo); ok {
+		return fileURL(fim.Meta().Filename)
+	}
+	return ""
+}
+
+// resolveRemote accepts the remote URLs starting with the configured prefix.
+func (c *Client) resolveRemote(imp sassconfig.ImporterConfig, url string) (string, error) {
+	if !isRemoteURL(url) || !strings.HasPrefix(url, imp.Prefix) {
+		return "", nil
+	}
+	if err := c.rs.ExecHelper.Sec().CheckAllowedHTTPURL(url); err != nil {
+		return "", err
+	}
+	if err := c.rs.ExecHelper.Sec().CheckAllowedHTTPMethod("GET"); err != nil {
+		return "", err
+	}
+	return url, nil
+}
+
+func (c *Client) loadRemote(url string) (godartsass.Import, error) {
+	res, err := c.httpClient.Get(url)
+	if err != nil {
+		return godartsass.Import{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return godartsass.Import{}, fmt.Errorf("failed to fetch remote stylesheet %q: %s", url, http.StatusText(res.StatusCode))
+	}
+
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return godartsass.Import{}, err
+	}
+
+	u := url
+	if i := strings.IndexAny(u, "?#"); i != -1 {
+		u = u[:i]
+	}
+
+	return godartsass.Import{Content: string(b), SourceSyntax: sourceSyntaxFromFilename(u, godartsass.SourceSyntaxCSS)}, nil
+}
+
+// findSassFile finds the Sass file for the import name in dir, trying the
+// partial and, if withIndex is set, the index file variants.
+// It returns an empty string if not found.
+func findSassFile(fs afero.Fs, dir, name string, withIndex bool) string {
+	var namePatterns []string
+	if strings.Contains(name, ".") {
+		namePatterns = []string{"_%s", "%s"}
+	} else if strings.HasPrefix(name, "_") {
+		namePatterns = []string{"_%s.scss", "_%s.sass", "_%s.css"}
+	} else {
+		namePatterns = []string{"_%s.scss", "%s.scss", "_%s.sass", "%s.sass", "_%s.css", "%s.css"}
+	}
+
+	trimmed := strings.TrimPrefix(name, "_")
+
+	for _, namePattern := range namePatterns {
+		filename := filepath.Join(dir, fmt.Sprintf(namePattern, trimmed))
+		if fi, err := fs.Stat(filename); err == nil && !fi.IsDir() {
+			return filename
+		}
+	}
+
+	if withIndex && !strings.Contains(name, ".") {
+		return findSassFile(fs, filepath.Join(dir, name), "index", false)
+	}
+
+	return ""
+}
+
+// splitPackageName splits e.g. "@scope/pkg/scss/main" into "@scope/pkg" and "scss/main".
+func splitPackageName(name string) (string, string) {
+	parts := strings.SplitN(name, "/", 3)
+	if strings.HasPrefix(name, "@") {
+		if len(parts) < 2 {
+			return "", ""
+		}
+		pkg := parts[0] + "/" + parts[1]
+		if len(parts) == 3 {
+			return pkg, parts[2]
+		}
+		return pkg, ""
+	}
+	pkg, sub, _ := strings.Cut(name, "/")
+	return pkg, sub
+}
+
+// packageStylesheet returns the stylesheet set in the sass or style field in the package.json in dir.
+func packageStylesheet(dir string) string {
+	b, err := afero.ReadFile(hugofs.Os, filepath.Join(dir, "package.json"))
+	if err != nil {
+		return ""
+	}
+	var pj struct {
+		Sass  string `json:"sass"`
+		Style string `json:"style"`
+	}
+	if err := json.Unmarshal(b, &pj); err != nil {
+		return ""
+	}
+	if pj.Sass != "" {
+		return pj.Sass
+	}
+	return pj.Style
+}
+
+func isInDir(dir, filename string) bool {
+	rel, err := filepath.Rel(dir, filename)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func isRemoteURL(s string) bool {
+	return strings.HasPrefix(s, "https://") || strings.HasPrefix(s, "http://")
+}
+
+func fileURL(filename string) string {
+	if filename == "" {
+		return ""
+	}
+	return "file://" + filepath.ToSlash(filename)
+}
@@ -530,3 +530,55 @@ T1: {{ $r.Content }}
 	b.AssertLogMatches(`INFO.*Dart Sass: .*assets.*main.scss:14:0: number`)
 
 }
+
+func TestTransformImporters(t *testing.T) {
+	t.Parallel()
+	if !dartsass.Supports() {
+		t.Skip()
+	}
+
+	files := `
+-- hugo.toml --
+disableKinds = ["term", "taxonomy", "section", "page"]
+[sass]
+silenceDeprecations = ["import"]
+[[sass.importers]]
+type = "node"
+[[sass.importers]]
+type = "mount"
+prefix = "ds"
+path = "design-system"
+-- assets/scss/main.scss --
+@use "pkg:foo" as foo;
+@use "~@scope/bar/scss/colors" as bar;
+@use "ds/tokens" as ds;
+@import "legacy";
+a { color: foo.$color; background: bar.$background; border-color: ds.$border; }
+-- assets/scss/_legacy.scss --
+legacy { color: red; }
+-- assets/design-system/_tokens.scss --
+$border: #333;
+-- node_modules/foo/package.json --
+{ "name": "foo", "sass": "scss/foo.scss" }
+-- node_modules/foo/scss/foo.scss --
+@use "vars";
+$color: vars.$color;
+-- node_modules/foo/scss/_vars.scss --
+$color: #111;
+-- node_modules/@scope/bar/scss/_colors.scss --
+$background: #222;
+-- layouts/index.html --
+{{ $r := resources.Get "scss/main.scss" | toCSS (dict "transpiler" "dartsass" "outputStyle" "compressed") }}
+T1: {{ $r.Content }}
+	`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			NeedsOsFS:   true,
+		}).Build()
+
+	b.AssertFileContent("public/index.html", `T1: legacy{color:red}a{color:#111;background:#222;border-color:#333}`)
+	b.Assert(b.H.Log.LogCounters().WarnCounter.Count(), qt.Equals, uint64(0))
+}
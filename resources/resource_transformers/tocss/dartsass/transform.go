@@ -29,6 +29,7 @@ import (
 
 	"github.com/gohugoio/hugo/resources/internal"
 	"github.com/gohugoio/hugo/resources/resource_transformers/tocss/internal/sass"
+	"github.com/gohugoio/hugo/resources/resource_transformers/tocss/sassconfig"
 
 	"github.com/spf13/afero"
 
@@ -52,6 +53,10 @@ type transform struct {
 }
 
 func (t *transform) Key() internal.ResourceTransformationKey {
+	if len(t.c.sassConfig.Importers) > 0 {
+		// The default importers may change the result.
+		return internal.NewResourceTransformationKey(transformationName, t.optsm, t.c.sassConfig.Importers)
+	}
 	return internal.NewResourceTransformationKey(transformationName, t.optsm)
 }
 
@@ -63,12 +68,24 @@ func (t *transform) Transform(ctx *resources.ResourceTransformationCtx) error {
 		return err
 	}
 
+	if ctx.SourceMapsEnabled() {
+		// The source map for the complete chain is published by Hugo,
+		// which also decides whether to include the sources.
+		opts.EnableSourceMap = true
+		opts.SourceMapIncludeSources = true
+	}
+
 	if opts.TargetPath != "" {
 		ctx.OutPath = opts.TargetPath
 	} else {
 		ctx.ReplaceOutPathExtension(".css")
 	}
 
+	importers := opts.Importers
+	if importers == nil {
+		importers = t.c.sassConfig.Importers
+	}
+
 	baseDir := path.Dir(ctx.SourcePath)
 	filename := dartSassStdinPrefix
 
@@ -80,8 +97,9 @@ func (t *transform) Transform(ctx *resources.ResourceTransformationCtx) error {
 		URL:          filename,
 		IncludePaths: t.c.sfs.RealDirs(baseDir),
 		ImportResolver: importResolver{
-			baseDir: baseDir,
-			c:       t.c,
+			baseDir:   baseDir,
+			c:         t.c,
+			importers: importers,
 
 			varsStylesheet: godartsass.Import{Content: sass.CreateVarsStyleSheet(opts.Vars)},
 		},
@@ -115,6 +133,10 @@ func (t *transform) Transform(ctx *resources.ResourceTransformationCtx) error {
 		return err
 	}
 
+	if ctx.SourceMapsEnabled() {
+		return ctx.UpdateSourceMap(res.SourceMap)
+	}
+
 	if opts.EnableSourceMap && res.SourceMap != "" {
 		if err := ctx.PublishSourceMap(res.SourceMap); err != nil {
 			return err
@@ -126,8 +148,9 @@ func (t *transform) Transform(ctx *resources.ResourceTransformationCtx) error {
 }
 
 type importResolver struct {
-	baseDir string
-	c       *Client
+	baseDir   string
+	c         *Client
+	importers []sassconfig.ImporterConfig
 
 	varsStylesheet godartsass.Import
 }
@@ -136,6 +159,14 @@ func (t importResolver) CanonicalizeURL(url string) (string, error) {
 	if url == sass.HugoVarsNamespace {
 		return url, nil
 	}
+	s, err := t.canonicalizeInAssets(url)
+	if s != "" || err != nil || len(t.importers) == 0 {
+		return s, err
+	}
+	return t.canonicalizeWithImporters(url)
+}
+
+func (t importResolver) canonicalizeInAssets(url string) (string, error) {
 	filePath, isURL := paths.UrlToFilename(url)
 	var prevDir string
 	var pathDir string
@@ -156,47 +187,34 @@ func (t importResolver) CanonicalizeURL(url string) (string, error) {
 	name := filepath.Base(filePath)
 
 	// Pick the first match.
-	var namePatterns []string
-	if strings.Contains(name, ".") {
-		namePatterns = []string{"_%s", "%s"}
-	} else if strings.HasPrefix(name, "_") {
-		namePatterns = []string{"_%s.scss", "_%s.sass", "_%s.css"}
-	} else {
-		namePatterns = []string{"_%s.scss", "%s.scss", "_%s.sass", "%s.sass", "_%s.css", "%s.css"}
-	}
-
-	name = strings.TrimPrefix(name, "_")
-
-	for _, namePattern := range namePatterns {
-		filenameToCheck := filepath.Join(basePath, fmt.Sprintf(namePattern, name))
-		fi, err := t.c.sfs.Fs.Stat(filenameToCheck)
-		if err == nil {
-			if fim, ok := fi.(hugofs.FileMetaInfo); ok {
-				return "file://" + filepath.ToSlash(fim.Meta().Filename), nil
-			}
-		}
-	}
-
-	// Not found, let Dart Dass handle it
-	return "", nil
+	// If not found, let Dart Sass handle it.
+	return t.c.realFileURL(findSassFile(t.c.sfs.Fs, basePath, name, false)), nil
 }
 
 func (t importResolver) Load(url string) (godartsass.Import, error) {
 	if url == sass.HugoVarsNamespace {
 		return t.varsStylesheet, nil
 	}
+	if isRemoteURL(url) {
+		return t.c.loadRemote(url)
+	}
 	filename, _ := paths.UrlToFilename(url)
 	b, err := afero.ReadFile(hugofs.Os, filename)
 
-	sourceSyntax := godartsass.SourceSyntaxSCSS
-	if strings.HasSuffix(filename, ".sass") {
-		sourceSyntax = godartsass.SourceSyntaxSASS
-	} else if strings.HasSuffix(filename, ".css") {
-		sourceSyntax = godartsass.SourceSyntaxCSS
-	}
+	return godartsass.Import{Content: string(b), SourceSyntax: sourceSyntaxFromFilename(filename, godartsass.SourceSyntaxSCSS)}, err
 
-	return godartsass.Import{Content: string(b), SourceSyntax: sourceSyntax}, err
+}
 
+func sourceSyntaxFromFilename(filename string, defaultSyntax godartsass.SourceSyntax) godartsass.SourceSyntax {
+	switch {
+	case strings.HasSuffix(filename, ".scss"):
+		return godartsass.SourceSyntaxSCSS
+	case strings.HasSuffix(filename, ".sass"):
+		return godartsass.SourceSyntaxSASS
+	case strings.HasSuffix(filename, ".css"):
+		return godartsass.SourceSyntaxCSS
+	}
+	return defaultSyntax
 }
 
 type importResolverV1 struct {
@@ -0,0 +1,217 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sassconfig holds the site wide Dart Sass configuration.
+package sassconfig
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/config"
+	"github.com/mitchellh/mapstructure"
+)
+
+const configKey = "sass"
+
+// The importer types.
+const (
+	// Resolves pkg:name, ~name and bare package imports in node_modules.
+	ImporterNode = "node"
+
+	// Maps an import prefix to a directory in the assets file system,
+	// which includes the mounts of all modules.
+	ImporterMount = "mount"
+
+	// Loads imports from remote URLs starting with the prefix.
+	ImporterURL = "url"
+)
+
+// The deprecation ID matching all deprecations.
+const DeprecationAll = "all"
+
+// deprecationMessages maps the Dart Sass deprecation IDs to a pattern matching
+// their messages. The embedded protocol does not send the ID, so we need to look
+// at the message. Newer Dart Sass versions include the ID as "[id]" in the message,
+// which is also matched.
+var deprecationMessages = map[string]*regexp.Regexp{
+	"import":          regexp.MustCompile(`@import rules are deprecated`),
+	"global-builtin":  regexp.MustCompile(`Global built-in functions are deprecated`),
+	"slash-div":       regexp.MustCompile(`Using / for division`),
+	"color-functions": regexp.MustCompile(`\b(lighten|darken|saturate|desaturate|opacify|fade-in|transparentize|fade-out|adjust-hue|red|green|blue|hue|saturation|lightness|alpha)\(\) is deprecated`),
+	"mixed-decls":     regexp.MustCompile(`declarations that appear after nested`),
+	"strict-unary":    regexp.MustCompile(`This operation is parsed as`),
+	"abs-percent":     regexp.MustCompile(`Passing percentage units to the global abs\(\) function is deprecated`),
+	"new-global":      regexp.MustCompile(`As of Dart Sass 2\.0\.0, !global assignments won't be able to declare new variables`),
+	"call-string":     regexp.MustCompile(`Passing a string to call\(\) is deprecated`),
+	"elseif":          regexp.MustCompile(`@elseif is deprecated`),
+	"moz-document":    regexp.MustCompile(`@-moz-document is deprecated`),
+}
+
+// Config configures Dart Sass for all calls to toCSS with the dartsass transpiler.
+type Config struct {
+	// Deprecation IDs, e.g. "import" or "slash-div", of the Dart Sass
+	// deprecation warnings to silence. Use "all" to silence all of them.
+	SilenceDeprecations []string
+
+	// Deprecation IDs of the Dart Sass deprecation warnings to treat as errors,
+	// failing the build. Use "all" for all of them.
+	FatalDeprecations []string
+
+	// Silence the warnings and deprecations from stylesheets loaded from
+	// node_modules or remote URLs, which you cannot fix yourself.
+	QuietDeps bool
+
+	// Regular expressions matching @warn messages to silence.
+	AllowedWarnings []string
+
+	// The default importers, used when not set in the options to toCSS.
+	Importers []ImporterConfig
+
+	allowedWarnings []*regexp.Regexp
+}
+
+// ImporterConfig configures a custom Dart Sass importer.
+// The importers are tried in order after Hugo's own resolution in the assets file system.
+type ImporterConfig struct {
+	// One of node, mount or url.
+	Type string
+
+	// For mount, the import prefix, e.g. "ds" for @use "ds/tokens".
+	// For url, the URL prefix, e.g. "https://cdn.example.org/".
+	Prefix string
+
+	// For node, the node_modules directory relative to the project, default "node_modules".
+	// For mount, the directory in the assets file system.
+	Path string
+}
+
+// Validate validates the importer config.
+func (c ImporterConfig) Validate() error {
+	switch c.Type {
+	case ImporterNode:
+	case ImporterMount:
+		if c.Prefix == "" || c.Path == "" {
+			return fmt.Errorf("sass importer %q: prefix and path must be set", c.Type)
+		}
+	case ImporterURL:
+		if !strings.HasPrefix(c.Prefix, "https://") && !strings.HasPrefix(c.Prefix, "http://") {
+			return fmt.Errorf("sass importer %q: prefix must be an http(s) URL, got %q", c.Type, c.Prefix)
+		}
+	default:
+		return fmt.Errorf("unknown sass importer type %q, must be one of node, mount or url", c.Type)
+	}
+	return nil
+}
+
+// DecodeImporters decodes and validates the importers in v.
+func DecodeImporters(v any) ([]ImporterConfig, error) {
+	var importers []ImporterConfig
+	if err := mapstructure.WeakDecode(v, &importers); err != nil {
+		return nil, fmt.Errorf("failed to decode sass importers: %w", err)
+	}
+	for i, imp := range importers {
+		imp.Type = strings.ToLower(imp.Type)
+		if err := imp.Validate(); err != nil {
+			return nil, err
+		}
+		importers[i] = imp
+	}
+	return importers, nil
+}
+
+// DecodeConfig decodes the sass configuration in cfg.
+func DecodeConfig(cfg config.Provider) (Config, error) {
+	var c Config
+	if !cfg.IsSet(configKey) {
+		return c, nil
+	}
+
+	m := maps.CleanConfigStringMap(cfg.GetStringMap(configKey))
+	importers := m["importers"]
+	delete(m, "importers")
+
+	if err := mapstructure.WeakDecode(m, &c); err != nil {
+		return c, fmt.Errorf("failed to decode sass config: %w", err)
+	}
+
+	if importers != nil {
+		var err error
+		if c.Importers, err = DecodeImporters(importers); err != nil {
+			return c, err
+		}
+	}
+
+	for _, s := range c.AllowedWarnings {
+		re, err := regexp.Compile(s)
+		if err != nil {
+			return c, fmt.Errorf("invalid sass allowedWarnings pattern %q: %w", s, err)
+		}
+		c.allowedWarnings = append(c.allowedWarnings, re)
+	}
+
+	return c, nil
+}
+
+// The log levels returned by Config.LogLevel.
+const (
+	LogLevelSilence = iota
+	LogLevelWarn
+	LogLevelError
+)
+
+// LogLevel returns how to log the Dart Sass warning or deprecation message,
+// or LogLevelSilence if it should not be logged.
+// isDependency is set if the message comes from a stylesheet loaded from
+// node_modules or a remote URL.
+func (c Config) LogLevel(deprecation, isDependency bool, message string) int {
+	if deprecation {
+		if c.matchesDeprecation(c.FatalDeprecations, message) {
+			return LogLevelError
+		}
+		if c.QuietDeps && isDependency {
+			return LogLevelSilence
+		}
+		if c.matchesDeprecation(c.SilenceDeprecations, message) {
+			return LogLevelSilence
+		}
+		return LogLevelWarn
+	}
+
+	if c.QuietDeps && isDependency {
+		return LogLevelSilence
+	}
+	for _, re := range c.allowedWarnings {
+		if re.MatchString(message) {
+			return LogLevelSilence
+		}
+	}
+	return LogLevelWarn
+}
+
+func (c Config) matchesDeprecation(ids []string, message string) bool {
+	for _, id := range ids {
+		if id == DeprecationAll {
+			return true
+		}
+		if strings.Contains(message, "["+id+"]") {
+			return true
+		}
+		if re, found := deprecationMessages[id]; found && re.MatchString(message) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sassconfig
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/config"
+)
+
+func TestDecodeConfig(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := config.New()
+	cfg.Set("sass", map[string]any{
+		"silenceDeprecations": []any{"import", "all"},
+		"fatalDeprecations":   "slash-div",
+		"quietDeps":           true,
+		"allowedWarnings":     []any{"^foo"},
+		"importers": []any{
+			map[string]any{"type": "Node"},
+			map[string]any{"type": "mount", "prefix": "ds", "path": "ds/scss"},
+		},
+	})
+
+	conf, err := DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.SilenceDeprecations, qt.DeepEquals, []string{"import", "all"})
+	c.Assert(conf.FatalDeprecations, qt.DeepEquals, []string{"slash-div"})
+	c.Assert(conf.QuietDeps, qt.IsTrue)
+	c.Assert(conf.Importers, qt.DeepEquals, []ImporterConfig{
+		{Type: ImporterNode},
+		{Type: ImporterMount, Prefix: "ds", Path: "ds/scss"},
+	})
+	c.Assert(conf.allowedWarnings, qt.HasLen, 1)
+
+	conf, err = DecodeConfig(config.New())
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Importers, qt.IsNil)
+
+	for _, importers := range []any{
+		[]any{map[string]any{"type": "foo"}},
+		[]any{map[string]any{"type": "mount", "prefix": "ds"}},
+		[]any{map[string]any{"type": "url", "prefix": "cdn.example.org"}},
+	} {
+		cfg := config.New()
+		cfg.Set("sass", map[string]any{"importers": importers})
+		_, err := DecodeConfig(cfg)
+		c.Assert(err, qt.IsNotNil)
+	}
+
+	cfg = config.New()
+	cfg.Set("sass", map[string]any{"allowedWarnings": []any{"("}})
+	_, err = DecodeConfig(cfg)
+	c.Assert(err, qt.ErrorMatches, ".*invalid sass allowedWarnings pattern.*")
+}
+
+func TestLogLevel(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := config.New()
+	cfg.Set("sass", map[string]any{
+		"silenceDeprecations": []any{"import", "mixed-decls"},
+		"fatalDeprecations":   []any{"slash-div"},
+		"allowedWarnings":     []any{"^Vendor"},
+	})
+	conf, err := DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+
+	c.Assert(conf.LogLevel(true, false, "Sass @import rules are deprecated and will be removed"), qt.Equals, LogLevelSilence)
+	c.Assert(conf.LogLevel(true, false, "Deprecation [mixed-decls]: foo"), qt.Equals, LogLevelSilence)
+	c.Assert(conf.LogLevel(true, false, "Using / for division outside of calc() is deprecated"), qt.Equals, LogLevelError)
+	c.Assert(conf.LogLevel(true, false, "Global built-in functions are deprecated"), qt.Equals, LogLevelWarn)
+	c.Assert(conf.LogLevel(false, false, "Vendor prefix used"), qt.Equals, LogLevelSilence)
+	c.Assert(conf.LogLevel(false, false, "Some warning"), qt.Equals, LogLevelWarn)
+	c.Assert(conf.LogLevel(false, true, "Some warning"), qt.Equals, LogLevelWarn)
+
+	conf.QuietDeps = true
+	c.Assert(conf.LogLevel(false, true, "Some warning"), qt.Equals, LogLevelSilence)
+	c.Assert(conf.LogLevel(true, true, "Global built-in functions are deprecated"), qt.Equals, LogLevelSilence)
+	c.Assert(conf.LogLevel(true, true, "Using / for division"), qt.Equals, LogLevelError)
+
+	conf = Config{SilenceDeprecations: []string{DeprecationAll}}
+	c.Assert(conf.LogLevel(true, false, "anything"), qt.Equals, LogLevelSilence)
+	c.Assert(conf.LogLevel(false, false, "anything"), qt.Equals, LogLevelWarn)
+}
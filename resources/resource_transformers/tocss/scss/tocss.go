@@ -128,6 +128,12 @@ func (t *toCSSTransformation) Transform(ctx *resources.ResourceTransformationCtx
 		options.to.SassSyntax = true
 	}
 
+	sourceMapsEnabled := ctx.SourceMapsEnabled()
+	if sourceMapsEnabled {
+		// The source map for the complete chain is published by Hugo.
+		options.from.EnableSourceMap = true
+	}
+
 	if options.from.EnableSourceMap {
 
 		options.to.SourceMapOptions.Filename = outName + ".map"
@@ -140,7 +146,7 @@ func (t *toCSSTransformation) Transform(ctx *resources.ResourceTransformationCtx
 		// options.InputPath = inputPath
 		options.to.SourceMapOptions.OutputPath = outName
 		options.to.SourceMapOptions.Contents = true
-		options.to.SourceMapOptions.OmitURL = false
+		options.to.SourceMapOptions.OmitURL = sourceMapsEnabled
 		options.to.SourceMapOptions.EnableEmbedded = false
 	}
 
@@ -172,6 +178,10 @@ func (t *toCSSTransformation) Transform(ctx *resources.ResourceTransformationCtx
 		// is important enough to go this extra mile.
 		mapContent := strings.Replace(res.SourceMapContent, `stdin"`, fmt.Sprintf("%s\"", sourcePath), 1)
 
+		if sourceMapsEnabled {
+			return ctx.UpdateSourceMap(mapContent)
+		}
+
 		return ctx.PublishSourceMap(mapContent)
 	}
 	return nil
@@ -0,0 +1,113 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wasmplugin_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func newTestBuilder(t *testing.T, files string) *hugolib.IntegrationTestBuilder {
+	c := qt.New(t)
+
+	// The WASM module is copied into place as txtar doesn't handle binary files.
+	workDir := t.TempDir()
+	wasm, err := os.ReadFile(filepath.Join("..", "..", "..", "plugins", "testdata", "upper.wasm"))
+	c.Assert(err, qt.IsNil)
+	c.Assert(os.MkdirAll(filepath.Join(workDir, "assets", "plugins"), 0777), qt.IsNil)
+	c.Assert(os.WriteFile(filepath.Join(workDir, "assets", "plugins", "upper.wasm"), wasm, 0666), qt.IsNil)
+
+	return hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			NeedsOsFS:   true,
+			WorkingDir:  workDir,
+		})
+}
+
+func TestPlugin(t *testing.T) {
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "page", "section", "rss", "sitemap"]
+theme = "mytheme"
+[plugins."text.upper"]
+source = "plugins/upper.wasm"
+function = "upper"
+mediaTypes = ["text/plain"]
+outMediaType = "text/html"
+[plugins."text.upper".config]
+foo = "bar"
+-- themes/mytheme/hugo.toml --
+[plugins."text.shout"]
+source = "plugins/upper.wasm"
+function = "upper"
+-- assets/hello.txt --
+Hello, World!
+-- assets/style.css --
+body { color: red; }
+-- layouts/index.html --
+{{ $r := resources.Get "hello.txt" | resources.Plugin "text.upper" }}
+Upper: {{ $r.Content }}|{{ $r.RelPermalink }}|{{ $r.MediaType }}
+{{ $r2 := resources.Get "hello.txt" | resources.Plugin "Text.Upper" (dict "foo" "baz") }}
+Options: {{ $r2.Content }}
+{{ $r3 := resources.Get "hello.txt" | resources.Plugin "text.shout" }}
+Theme: {{ $r3.Content }}|{{ $r3.RelPermalink }}
+`
+
+	b := newTestBuilder(t, files).Build()
+
+	b.AssertFileContent("public/index.html",
+		"Upper: HELLO, WORLD!\n|/hello.html|text/html",
+		"Options: HELLO, WORLD!",
+		"Theme: HELLO, WORLD!\n|/hello.txt",
+	)
+	b.AssertFileContent("public/hello.html", "HELLO, WORLD!")
+}
+
+func TestPluginErrors(t *testing.T) {
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "page", "section", "rss", "sitemap"]
+[plugins."text.upper"]
+source = "plugins/upper.wasm"
+function = "upper"
+mediaTypes = ["text/plain"]
+-- assets/style.css --
+body { color: red; }
+-- layouts/index.html --
+{{ $r := resources.Get "style.css" | resources.Plugin "PLUGIN" }}
+{{ $r.Content }}
+`
+
+	for _, test := range []struct {
+		name   string
+		expect string
+	}{
+		{"text.upper", `plugin "text.upper" does not support media type "text/css"`},
+		{"text.lower", `plugin "text.lower" not found in config`},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			files := strings.ReplaceAll(files, "PLUGIN", test.name)
+			b, err := newTestBuilder(t, files).BuildE()
+			b.Assert(err, qt.IsNotNil)
+			b.Assert(err.Error(), qt.Contains, test.expect)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wasmplugin transforms resources using the WASM plugins configured
+// in the plugins section of the site config.
+package wasmplugin
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gohugoio/hugo/config/allconfig"
+	"github.com/gohugoio/hugo/plugins"
+	"github.com/gohugoio/hugo/resources"
+	"github.com/gohugoio/hugo/resources/internal"
+	"github.com/gohugoio/hugo/resources/resource"
+	"github.com/spf13/cast"
+)
+
+// Client transforms resources using WASM plugins.
+type Client struct {
+	rs      *resources.Spec
+	plugins *plugins.Client
+}
+
+// New creates a new Client with the given specification.
+// The WASM modules are read from the assets filesystem.
+func New(rs *resources.Spec) *Client {
+	conf := rs.Cfg.GetConfig().(*allconfig.Config)
+	return &Client{
+		rs:      rs,
+		plugins: plugins.New(conf.Plugins, rs.BaseFs.Assets.Fs),
+	}
+}
+
+// Close closes the plugin instances.
+func (c *Client) Close() error {
+	return c.plugins.Close()
+}
+
+type pluginTransformation struct {
+	rs      *resources.Spec
+	plugin  *plugins.Plugin
+	options map[string]string
+}
+
+func (t *pluginTransformation) Key() internal.ResourceTransformationKey {
+	return internal.NewResourceTransformationKey("plugin_"+t.plugin.Name(), t.plugin.Hash(), t.plugin.Config(), t.options)
+}
+
+func (t *pluginTransformation) Transform(ctx *resources.ResourceTransformationCtx) error {
+	conf := t.plugin.Config()
+
+	if len(conf.MediaTypes) > 0 {
+		var found bool
+		for _, mt := range conf.MediaTypes {
+			if mt == ctx.InMediaType.Type {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s: plugin %q does not support media type %q", ctx.SourcePath, t.plugin.Name(), ctx.InMediaType.Type)
+		}
+	}
+
+	if conf.OutMediaType != "" && conf.OutMediaType != ctx.InMediaType.Type {
+		mt, found := t.rs.MediaTypes().GetByType(conf.OutMediaType)
+		if !found {
+			return fmt.Errorf("plugin %q: media type %q not found", t.plugin.Name(), conf.OutMediaType)
+		}
+		ctx.OutMediaType = mt
+		ctx.ReplaceOutPathExtension(mt.FirstSuffix.FullSuffix)
+	}
+
+	input, err := io.ReadAll(ctx.From)
+	if err != nil {
+		return err
+	}
+
+	output, err := t.plugin.Call(ctx.Ctx, input, t.options)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ctx.SourcePath, err)
+	}
+
+	_, err = ctx.To.Write(output)
+	return err
+}
+
+// Process transforms res using the plugin with the given name, e.g.
+// "images.dither". The options are passed to the plugin as config, on top of
+// any config set for the plugin in the site config.
+// The result is cached as any other resource transformation.
+func (c *Client) Process(name string, res resources.ResourceTransformer, options map[string]any) (resource.Resource, error) {
+	p, err := c.plugins.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := make(map[string]string, len(options))
+	for k, v := range options {
+		s, err := cast.ToStringE(v)
+		if err != nil {
+			return nil, fmt.Errorf("plugin %q: option %q: %w", p.Name(), k, err)
+		}
+		// Config keys are lower case.
+		opts[strings.ToLower(k)] = s
+	}
+
+	return res.Transform(&pluginTransformation{rs: c.rs, plugin: p, options: opts})
+}
@@ -35,7 +35,11 @@ import (
 	"github.com/gohugoio/hugo/common/hugio"
 	"github.com/gohugoio/hugo/common/maps"
 	"github.com/gohugoio/hugo/helpers"
+	"github.com/gohugoio/hugo/hugofs"
+	"github.com/gohugoio/hugo/hugofs/files"
+	hglob "github.com/gohugoio/hugo/hugofs/glob"
 	"github.com/gohugoio/hugo/resources/internal"
+	"github.com/gohugoio/hugo/resources/internal/sourcemap"
 	"github.com/gohugoio/hugo/resources/resource"
 
 	"github.com/gohugoio/hugo/media"
@@ -61,6 +65,12 @@ var transformationsToCacheOnDisk = map[string]bool{
 	"tocss-dart": true,
 }
 
+// These are transformations that don't change the content, so any source
+// map from the previous transformations is still valid.
+var transformationsPreservingSourceMaps = map[string]bool{
+	"fingerprint": true,
+}
+
 func newResourceAdapter(spec *Spec, lazyPublish bool, target transformableResource) *resourceAdapter {
 	var po *publishOnce
 	if lazyPublish {
@@ -118,6 +128,62 @@ type ResourceTransformationCtx struct {
 	// This is used to publish additional artifacts, e.g. source maps.
 	// We may improve this.
 	OpenResourcePublisher func(relTargetPath string) (io.WriteCloser, error)
+
+	// The source map for From, mapping to the original sources. Only set
+	// when source maps are enabled, see SourceMapsEnabled and UpdateSourceMap.
+	SourceMap string
+
+	// Absolute filenames of files read by the transformation outside of
+	// Hugo's filesystems, see AddDependency.
+	dependencies []string
+
+	sourceMapsEnabled bool
+	sourceMapUpdated  bool
+}
+
+// SourceMapsEnabled reports whether source maps are enabled in the build
+// config. Transformations supporting source maps should then pass their
+// source map to UpdateSourceMap and not publish it themselves or write any
+// sourceMappingURL comment; that's handled by Hugo for the complete chain.
+func (ctx *ResourceTransformationCtx) SourceMapsEnabled() bool {
+	return ctx.sourceMapsEnabled
+}
+
+// UpdateSourceMap composes m, the source map for this transformation
+// (mapping To to From), with the source map from any previous transformations.
+func (ctx *ResourceTransformationCtx) UpdateSourceMap(m string) error {
+	if !ctx.sourceMapsEnabled {
+		return nil
+	}
+	ctx.sourceMapUpdated = true
+	if ctx.SourceMap == "" {
+		ctx.SourceMap = m
+		return nil
+	}
+
+	outer, err := sourcemap.Parse(m)
+	if err != nil {
+		return err
+	}
+	inner, err := sourcemap.Parse(ctx.SourceMap)
+	if err != nil {
+		return err
+	}
+	composed, err := sourcemap.Compose(outer, inner)
+	if err != nil {
+		return err
+	}
+	ctx.SourceMap = composed.String()
+
+	return nil
+}
+
+// AddDependency registers filename, an absolute filename, as a dependency of
+// the transformed resource. In server mode, changes to filename will bust the
+// cache of the transformed resource, using the cache busters in the build
+// config with the path of the resource's source, e.g. "assets/js/main.js".
+func (ctx *ResourceTransformationCtx) AddDependency(filename string) {
+	ctx.dependencies = append(ctx.dependencies, filename)
 }
 
 // AddOutPathIdentifier transforming InPath to OutPath adding an identifier,
@@ -223,6 +289,14 @@ func (r *resourceAdapter) Colors() ([]string, error) {
 	return r.getImageOps().Colors()
 }
 
+func (r *resourceAdapter) DominantColor() (string, error) {
+	return r.getImageOps().DominantColor()
+}
+
+func (r *resourceAdapter) AverageColor() (string, error) {
+	return r.getImageOps().AverageColor()
+}
+
 func (r *resourceAdapter) Key() string {
 	r.init(false, false)
 	return r.target.(resource.Identifier).Key()
@@ -268,6 +342,10 @@ func (r *resourceAdapter) Resize(spec string) (images.ImageResource, error) {
 	return r.getImageOps().Resize(spec)
 }
 
+func (r *resourceAdapter) ProcessSet(spec string) (*images.ImageSet, error) {
+	return r.getImageOps().ProcessSet(spec)
+}
+
 func (r *resourceAdapter) ResourceType() string {
 	r.init(false, false)
 	return r.target.ResourceType()
@@ -341,6 +419,8 @@ func (r *resourceAdapter) publish() {
 
 		if r.publisherErr != nil {
 			r.spec.Logger.Errorf("Failed to publish Resource: %s", r.publisherErr)
+		} else {
+			r.addToIntegrityManifest()
 		}
 	})
 }
@@ -352,11 +432,84 @@ func (r *resourceAdapter) TransformationKey() string {
 	for _, tr := range r.transformations {
 		key = key + "_" + tr.Key().Value()
 	}
+	if r.spec.SourceMapsEnabled() {
+		key += "_sourcemaps"
+	}
 
 	base := ResourceCacheKey(r.target.Key())
 	return r.spec.ResourceCache.cleanKey(base) + "_" + helpers.MD5String(key)
 }
 
+// handleSourceMap appends a sourceMappingURL comment to the output of a
+// transformation that updated the source map, and drops the source map if
+// the transformation changed the content without updating it.
+// The target is updated with the target path of the source map.
+func (r *resourceAdapter) handleSourceMap(tctx *ResourceTransformationCtx, name string, target *string) error {
+	if tctx.sourceMapUpdated {
+		tctx.sourceMapUpdated = false
+		outPath := tctx.OutPath
+		if outPath == "" {
+			outPath = tctx.InPath
+		}
+		*target = outPath + ".map"
+		isJS := tctx.OutMediaType.SubType == media.Builtin.JavascriptType.SubType
+		_, err := io.WriteString(tctx.To, sourcemap.Comment(path.Base(*target), isJS))
+		return err
+	}
+
+	if tctx.SourceMap == "" || transformationsPreservingSourceMaps[name] {
+		return nil
+	}
+	if b, ok := tctx.To.(*bytes.Buffer); ok && b.Len() == 0 {
+		// Nothing written, so the content is unchanged.
+		return nil
+	}
+
+	r.spec.Logger.Warnf("%s: source map dropped, %s does not support source maps", tctx.InPath, name)
+	tctx.SourceMap = ""
+	*target = ""
+
+	return nil
+}
+
+// publishSourceMap publishes the source map in tctx to target.
+func (r *resourceAdapter) publishSourceMap(tctx *ResourceTransformationCtx, target string) error {
+	m, err := sourcemap.Parse(tctx.SourceMap)
+	if err != nil {
+		return err
+	}
+	m.File = strings.TrimSuffix(path.Base(target), ".map")
+	if !r.spec.BuildConfig().SourceMaps.IncludeSources {
+		m.SourcesContent = nil
+	}
+
+	f, err := tctx.OpenResourcePublisher(target)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.WriteString(f, m.String())
+	return err
+}
+
+// sourcePath returns the path of the source of r relative to the project,
+// e.g. "assets/js/main.js", used to match the cache busters.
+func (r *resourceAdapter) sourcePath() string {
+	return resourceSourcePath(r.spec, r.target.getFileInfo(), r.target.Key())
+}
+
+// resourceSourcePath returns the path of the source of the resource with
+// the given file info and key relative to the project.
+func resourceSourcePath(spec *Spec, fi hugofs.FileMetaInfo, key string) string {
+	if fi != nil && spec.BaseFs != nil {
+		if component, rel := spec.BaseFs.MakePathRelative(fi.Meta().Filename); rel != "" {
+			return hglob.NormalizePath(path.Join(component, rel))
+		}
+	}
+	// Resources not backed by a file, e.g. created with resources.FromString.
+	return hglob.NormalizePath(path.Join(files.ComponentFolderAssets, key))
+}
+
 func (r *resourceAdapter) transform(publish, setContent bool) error {
 	cache := r.spec.ResourceCache
 
@@ -395,6 +548,7 @@ func (r *resourceAdapter) transform(publish, setContent bool) error {
 
 	tctx.InMediaType = r.target.MediaType()
 	tctx.OutMediaType = r.target.MediaType()
+	tctx.sourceMapsEnabled = r.spec.SourceMapsEnabled()
 
 	startCtx := *tctx
 	updates := &transformationUpdate{startCtx: startCtx}
@@ -417,6 +571,9 @@ func (r *resourceAdapter) transform(publish, setContent bool) error {
 	counter := 0
 	writeToFileCache := false
 
+	// The target path of the source map, relative to the publish dir.
+	var sourceMapTarget string
+
 	var transformedContentr io.Reader
 
 	for i, tr := range r.transformations {
@@ -504,6 +661,12 @@ func (r *resourceAdapter) transform(publish, setContent bool) error {
 			break
 		}
 
+		if tctx.sourceMapsEnabled {
+			if err := r.handleSourceMap(tctx, tr.Key().Name, &sourceMapTarget); err != nil {
+				return newErr(err)
+			}
+		}
+
 		if tctx.OutPath != "" {
 			tctx.InPath = tctx.OutPath
 			tctx.OutPath = ""
@@ -512,6 +675,16 @@ func (r *resourceAdapter) transform(publish, setContent bool) error {
 
 	if transformedContentr == nil {
 		updates.updateFromCtx(tctx)
+
+		if tctx.SourceMap != "" && sourceMapTarget != "" {
+			if err := r.publishSourceMap(tctx, sourceMapTarget); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(tctx.dependencies) > 0 {
+		cache.addDependencies(r.sourcePath(), tctx.dependencies)
 	}
 
 	var publishwriters []io.WriteCloser
@@ -522,12 +695,21 @@ func (r *resourceAdapter) transform(publish, setContent bool) error {
 			return err
 		}
 		publishwriters = append(publishwriters, publicw)
+
+		if r.spec.BuildManifest != nil {
+			transformations := make([]string, len(r.transformations))
+			for i, tr := range r.transformations {
+				transformations[i] = tr.Key().Name
+			}
+			r.target.addToBuildManifest(updates.targetPath, transformations)
+		}
 	}
 
 	if transformedContentr == nil {
 		if writeToFileCache {
 			// Also write it to the cache
-			fi, metaw, err := cache.writeMeta(key, updates.toTransformedResourceMetadata())
+			h, _ := r.target.hash()
+			fi, metaw, err := cache.writeMeta(key, h, updates.toTransformedResourceMetadata())
 			if err != nil {
 				return err
 			}
@@ -596,6 +778,9 @@ func (r *resourceAdapter) initTransform(publish, setContent bool) {
 		}
 
 		r.transformationsErr = r.transform(publish, setContent)
+		if r.transformationsErr == nil && publish {
+			r.addToIntegrityManifest()
+		}
 		if r.transformationsErr != nil {
 			if r.spec.ErrorSender != nil {
 				r.spec.ErrorSender.SendError(r.transformationsErr)
@@ -0,0 +1,81 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package searchindex_test
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestSearchIndexOutputFormat(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "sitemap", "RSS"]
+defaultContentLanguage = "en"
+[outputs]
+home = ["html", "searchindex"]
+section = ["html", "searchindex"]
+[languages.en]
+weight = 1
+[languages.nn]
+weight = 2
+[languages.nn.searchIndex]
+format = "ndjson"
+stopWords = ["og"]
+-- content/docs/p1.md --
+---
+title: "Hugo Modules"
+tags: ["hugo"]
+---
+Modules are great.
+
+## Configuration
+-- content/blog/p2.md --
+---
+title: "Blog Post"
+---
+Some blog content.
+-- content/blog/p2.nn.md --
+---
+title: "Hugo og moduler"
+---
+Noko innhald.
+-- layouts/index.html --
+Home.
+-- layouts/_default/list.html --
+List.
+-- layouts/_default/single.html --
+Single.
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/searchindex.json",
+		`{"title":"Hugo Modules","summary":"Modules are great.\nConfiguration","headings":["Configuration"],"tags":["hugo"],"permalink":"https://example.org/docs/p1/","tokens":{"configuration":6,"great":1,"hugo":15,"modules":11}}`,
+		`"title":"Blog Post"`,
+	)
+	b.AssertFileContent("public/blog/searchindex.json", `"title":"Blog Post"`)
+	b.Assert(b.FileContent("public/blog/searchindex.json"), qt.Not(qt.Contains), "Hugo Modules")
+	b.AssertFileContent("public/nn/searchindex.json", `{"title":"Hugo og moduler","summary":"Noko innhald.","permalink":"https://example.org/nn/blog/p2/","tokens":{"hugo":10,"innhald":1,"moduler":10,"noko":1}}`)
+}
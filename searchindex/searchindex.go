@@ -0,0 +1,219 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package searchindex builds the client side search index published with the
+// searchindex output format.
+package searchindex
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/gohugoio/hugo/config"
+	"github.com/mitchellh/mapstructure"
+)
+
+const (
+	searchIndexConfigKey = "searchindex"
+
+	// FormatJSON writes the index as a JSON array.
+	FormatJSON = "json"
+	// FormatNDJSON writes the index as newline delimited JSON, one entry per line.
+	FormatNDJSON = "ndjson"
+
+	FieldTitle    = "title"
+	FieldSummary  = "summary"
+	FieldHeadings = "headings"
+	FieldTags     = "tags"
+)
+
+// DefaultConfig is the default search index config.
+var DefaultConfig = Config{
+	Format:         FormatJSON,
+	MinTokenLength: 2,
+	Weights: map[string]float64{
+		FieldTitle:    10,
+		FieldHeadings: 5,
+		FieldTags:     5,
+		FieldSummary:  1,
+	},
+	StopWords: []string{
+		"a", "an", "and", "are", "as", "at", "be", "but", "by", "for", "if", "in", "into", "is", "it",
+		"no", "not", "of", "on", "or", "such", "that", "the", "their", "then", "there", "these",
+		"they", "this", "to", "was", "will", "with",
+	},
+}
+
+// Config configures the search index.
+// This can be configured per language.
+type Config struct {
+	// The index format, json or ndjson.
+	Format string
+
+	// The weight of each token found in the different fields (title, summary, headings and tags).
+	// Set a weight to 0 to not index that field.
+	Weights map[string]float64
+
+	// Words to leave out of the token list. Set to an empty slice to disable.
+	StopWords []string
+
+	// Tokens shorter than this are skipped.
+	MinTokenLength int
+}
+
+// DecodeConfig creates a search index Config from a given Hugo configuration.
+func DecodeConfig(cfg config.Provider) (Config, error) {
+	c := DefaultConfig.clone()
+
+	m := cfg.GetStringMap(searchIndexConfigKey)
+	if m == nil {
+		return c, nil
+	}
+
+	// Make sure the provided weights are merged with the defaults.
+	weights := c.Weights
+	c.Weights = nil
+
+	// The stop words are replaced if set.
+	stopWords := c.StopWords
+	c.StopWords = nil
+
+	if err := mapstructure.WeakDecode(m, &c); err != nil {
+		return c, fmt.Errorf("failed to decode searchIndex config: %w", err)
+	}
+
+	if _, found := m["stopwords"]; !found {
+		c.StopWords = stopWords
+	}
+
+	for k, v := range c.Weights {
+		weights[strings.ToLower(k)] = v
+	}
+	c.Weights = weights
+
+	c.Format = strings.ToLower(c.Format)
+	if c.Format != FormatJSON && c.Format != FormatNDJSON {
+		return c, fmt.Errorf("searchIndex: invalid format %q, must be one of %q or %q", c.Format, FormatJSON, FormatNDJSON)
+	}
+
+	return c, nil
+}
+
+func (c Config) clone() Config {
+	weights := make(map[string]float64, len(c.Weights))
+	for k, v := range c.Weights {
+		weights[k] = v
+	}
+	c.Weights = weights
+	c.StopWords = append([]string(nil), c.StopWords...)
+	return c
+}
+
+// Entry is a document in the search index.
+type Entry struct {
+	Title     string             `json:"title"`
+	Summary   string             `json:"summary,omitempty"`
+	Headings  []string           `json:"headings,omitempty"`
+	Tags      []string           `json:"tags,omitempty"`
+	Permalink string             `json:"permalink"`
+	Tokens    map[string]float64 `json:"tokens"`
+}
+
+// Indexer creates search index entries.
+type Indexer struct {
+	cfg       Config
+	stopWords map[string]bool
+}
+
+// NewIndexer creates a new Indexer for the given config.
+func NewIndexer(cfg Config) *Indexer {
+	stopWords := make(map[string]bool, len(cfg.StopWords))
+	for _, w := range cfg.StopWords {
+		stopWords[strings.ToLower(w)] = true
+	}
+	return &Indexer{cfg: cfg, stopWords: stopWords}
+}
+
+// Index computes the weighted tokens for e.
+func (idx *Indexer) Index(e Entry) Entry {
+	tokens := make(map[string]float64)
+
+	add := func(field string, values ...string) {
+		weight := idx.cfg.Weights[field]
+		if weight == 0 {
+			return
+		}
+		for _, v := range values {
+			for _, t := range idx.Tokenize(v) {
+				tokens[t] += weight
+			}
+		}
+	}
+
+	add(FieldTitle, e.Title)
+	add(FieldSummary, e.Summary)
+	add(FieldHeadings, e.Headings...)
+	add(FieldTags, e.Tags...)
+
+	for k, v := range tokens {
+		// Keep the JSON output compact.
+		tokens[k] = math.Round(v*100) / 100
+	}
+
+	e.Tokens = tokens
+
+	return e
+}
+
+// Tokenize splits s into lower case tokens, skipping stop words and
+// tokens shorter than the configured minimum length.
+func (idx *Indexer) Tokenize(s string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	tokens := fields[:0]
+	for _, f := range fields {
+		if len([]rune(f)) < idx.cfg.MinTokenLength {
+			continue
+		}
+		if idx.stopWords[f] {
+			continue
+		}
+		tokens = append(tokens, f)
+	}
+	return tokens
+}
+
+// Write writes the entries to w in the configured format.
+func (idx *Indexer) Write(w io.Writer, entries []Entry) error {
+	if idx.cfg.Format == FormatNDJSON {
+		enc := json.NewEncoder(w)
+		for _, e := range entries {
+			if err := enc.Encode(e); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if entries == nil {
+		entries = []Entry{}
+	}
+
+	return json.NewEncoder(w).Encode(entries)
+}
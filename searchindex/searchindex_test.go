@@ -0,0 +1,84 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package searchindex
+
+import (
+	"bytes"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/config"
+)
+
+func TestDecodeConfig(t *testing.T) {
+	c := qt.New(t)
+
+	tomlConfig := `
+[searchIndex]
+format = "NDJSON"
+stopWords = ["foo"]
+[searchIndex.weights]
+title = 20
+`
+	cfg, err := config.FromConfigString(tomlConfig, "toml")
+	c.Assert(err, qt.IsNil)
+
+	conf, err := DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Format, qt.Equals, FormatNDJSON)
+	c.Assert(conf.StopWords, qt.DeepEquals, []string{"foo"})
+	c.Assert(conf.Weights[FieldTitle], qt.Equals, 20.0)
+	c.Assert(conf.Weights[FieldHeadings], qt.Equals, 5.0)
+	c.Assert(conf.MinTokenLength, qt.Equals, 2)
+
+	// Make sure the defaults are not modified.
+	c.Assert(DefaultConfig.Weights[FieldTitle], qt.Equals, 10.0)
+
+	cfg.Set("searchIndex", map[string]any{"format": "xml"})
+	_, err = DecodeConfig(cfg)
+	c.Assert(err, qt.ErrorMatches, ".*invalid format.*")
+}
+
+func TestIndexer(t *testing.T) {
+	c := qt.New(t)
+
+	idx := NewIndexer(DefaultConfig)
+
+	c.Assert(idx.Tokenize("The Quick, brown fox's  a 2nd time!"), qt.DeepEquals, []string{"quick", "brown", "fox", "2nd", "time"})
+
+	e := idx.Index(Entry{
+		Title:    "Hugo Modules",
+		Summary:  "Modules are great.",
+		Headings: []string{"Hugo Configuration"},
+		Tags:     []string{"hugo"},
+	})
+
+	c.Assert(e.Tokens, qt.DeepEquals, map[string]float64{
+		"hugo":          20,
+		"modules":       11,
+		"great":         1,
+		"configuration": 5,
+	})
+
+	var b bytes.Buffer
+	c.Assert(idx.Write(&b, nil), qt.IsNil)
+	c.Assert(b.String(), qt.Equals, "[]\n")
+
+	ndjson := DefaultConfig.clone()
+	ndjson.Format = FormatNDJSON
+	idx = NewIndexer(ndjson)
+	b.Reset()
+	c.Assert(idx.Write(&b, []Entry{{Title: "a"}, {Title: "b"}}), qt.IsNil)
+	c.Assert(b.String(), qt.Equals, "{\"title\":\"a\",\"permalink\":\"\",\"tokens\":null}\n{\"title\":\"b\",\"permalink\":\"\",\"tokens\":null}\n")
+}
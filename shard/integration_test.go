@@ -0,0 +1,146 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shard_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+	"github.com/gohugoio/hugo/shard"
+)
+
+const shardFiles = `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term"]
+shard = "SHARD"
+shardBy = "BY"
+-- static/s.txt --
+static
+-- layouts/_default/single.html --
+Single: {{ .Title }}|Ref: {{ ref . "/b/q1.md" }}|
+-- layouts/_default/list.html --
+List: {{ .Title }}|{{ range .RegularPages }}{{ .Title }},{{ end }}|
+-- layouts/404.html --
+404
+-- content/a/_index.md --
+-- content/a/p1.md --
+---
+title: P1
+aliases: ["/old/p1/"]
+---
+-- content/a/p2.md --
+---
+title: P2
+---
+-- content/a/p3.md --
+---
+title: P3
+---
+-- content/b/_index.md --
+-- content/b/q1.md --
+---
+title: Q1
+---
+-- content/b/q2.md --
+---
+title: Q2
+---
+-- content/b/q3.md --
+---
+title: Q3
+---
+`
+
+func TestShard(t *testing.T) {
+	t.Parallel()
+
+	for _, by := range []string{shard.ByHash, shard.BySection} {
+		by := by
+		t.Run(by, func(t *testing.T) {
+			t.Parallel()
+			qc := qt.New(t)
+
+			owners := make(map[string]int)
+			pages := map[string]string{
+				"/":      "",
+				"/a/":    "a",
+				"/a/p1/": "a",
+				"/a/p2/": "a",
+				"/a/p3/": "a",
+				"/b/":    "b",
+				"/b/q1/": "b",
+				"/b/q2/": "b",
+				"/b/q3/": "b",
+			}
+
+			for i := 1; i <= 3; i++ {
+				c, err := shard.Decode(fmt.Sprintf("%d/3", i), by)
+				qc.Assert(err, qt.IsNil)
+				files := strings.NewReplacer("SHARD", c.String(), "BY", by).Replace(shardFiles)
+				b := hugolib.NewIntegrationTestBuilder(
+					hugolib.IntegrationTestConfig{
+						T:           t,
+						TxtarString: files,
+					},
+				).Build()
+
+				b.AssertFileContent("public/.hugo_shard.json", fmt.Sprintf(`"shard": %d`, i), `"shards": 3`)
+				b.AssertDestinationExists("sitemap.xml", i == 1)
+				b.AssertDestinationExists("404.html", i == 1)
+
+				for p, section := range pages {
+					owns := c.Owns(p, section)
+					b.AssertDestinationExists(p+"index.html", owns)
+					if owns {
+						owners[p]++
+					}
+				}
+				if c.Owns("/a/p1/", "a") {
+					// Pages in other shards can be referenced.
+					b.AssertFileContent("public/a/p1/index.html", "Single: P1|Ref: https://example.org/b/q1/|")
+					b.AssertDestinationExists("old/p1/index.html", true)
+				}
+				if c.Owns("/a/", "a") {
+					b.AssertFileContent("public/a/index.html", "List: |P1,P2,P3,|")
+				}
+				if i == 1 {
+					b.AssertFileContent("public/sitemap.xml", "/a/p1/", "/b/q3/")
+				}
+			}
+
+			for p := range pages {
+				qc.Assert(owners[p], qt.Equals, 1, qt.Commentf(p))
+			}
+		})
+	}
+}
+
+func TestShardInvalid(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+
+	files := strings.NewReplacer("SHARD", "4/3", "BY", "hash").Replace(shardFiles)
+	_, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	c.Assert(err, qt.ErrorMatches, ".*index must be between 1 and count.*")
+}
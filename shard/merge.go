@@ -0,0 +1,341 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shard
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// ManifestFilename is the name of the manifest written to the root of the
+// publish directory of every shard.
+const ManifestFilename = ".hugo_shard.json"
+
+// Manifest describes the shard built into a publish directory.
+type Manifest struct {
+	Shard  int    `json:"shard"`
+	Shards int    `json:"shards"`
+	By     string `json:"by"`
+}
+
+// NewManifest creates the manifest of the shard c.
+func NewManifest(c Config) Manifest {
+	return Manifest{Shard: c.Index, Shards: c.Count, By: c.By}
+}
+
+// WriteManifest writes m to dir.
+func WriteManifest(fs afero.Fs, dir string, m Manifest) error {
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return afero.WriteFile(fs, filepath.Join(dir, ManifestFilename), b, 0666)
+}
+
+func readManifest(fs afero.Fs, dir string) (Manifest, error) {
+	var m Manifest
+	b, err := afero.ReadFile(fs, filepath.Join(dir, ManifestFilename))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return m, fmt.Errorf("%q is not the publish directory of a shard: %s not found", dir, ManifestFilename)
+		}
+		return m, err
+	}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return m, fmt.Errorf("failed to decode %s in %q: %w", ManifestFilename, dir, err)
+	}
+	return m, nil
+}
+
+// Merge copies the publish directories of all the shards of a build, srcs,
+// into dst, and returns the number of files written.
+//
+// Files published by more than one shard, e.g. resources used on pages in
+// different shards, must be identical, except JSON objects, e.g. the SRI
+// manifest or hugo_stats.json, which are merged if their entries don't
+// conflict, see mergeJSON.
+func Merge(fs afero.Fs, dst string, srcs []string) (int, error) {
+	if len(srcs) == 0 {
+		return 0, fmt.Errorf("no shards to merge")
+	}
+
+	var first Manifest
+	seen := make(map[int]string)
+	for i, src := range srcs {
+		m, err := readManifest(fs, src)
+		if err != nil {
+			return 0, err
+		}
+		if i == 0 {
+			first = m
+		} else if m.Shards != first.Shards || m.By != first.By {
+			return 0, fmt.Errorf("%q and %q are shards of different builds", srcs[0], src)
+		}
+		if prev, found := seen[m.Shard]; found {
+			return 0, fmt.Errorf("%q and %q are both shard %d", prev, src, m.Shard)
+		}
+		seen[m.Shard] = src
+	}
+	if len(seen) != first.Shards {
+		var missing []string
+		for i := 1; i <= first.Shards; i++ {
+			if _, found := seen[i]; !found {
+				missing = append(missing, fmt.Sprint(i))
+			}
+		}
+		return 0, fmt.Errorf("missing shard(s) %s of %d", strings.Join(missing, ", "), first.Shards)
+	}
+
+	// The source of the files written so far, keyed by their relative path.
+	written := make(map[string]string)
+	// The slash separated paths of the JSON files merged from several shards.
+	merged := make(map[string]bool)
+
+	for _, src := range srcs {
+		err := afero.Walk(fs, src, func(filename string, fi os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if fi.IsDir() {
+				return nil
+			}
+			rel, err := filepath.Rel(src, filename)
+			if err != nil {
+				return err
+			}
+			if rel == ManifestFilename {
+				return nil
+			}
+
+			b, err := afero.ReadFile(fs, filename)
+			if err != nil {
+				return err
+			}
+			target := filepath.Join(dst, rel)
+
+			if prev, found := written[rel]; found {
+				existing, err := afero.ReadFile(fs, target)
+				if err != nil {
+					return err
+				}
+				if bytes.Equal(existing, b) {
+					return nil
+				}
+				mb, ok := mergeJSONObjects(existing, b)
+				if !ok {
+					return fmt.Errorf("%s differs in %q and %q", filepath.ToSlash(rel), prev, src)
+				}
+				b = mb
+				merged[filepath.ToSlash(rel)] = true
+			}
+
+			if err := fs.MkdirAll(filepath.Dir(target), 0777); err != nil {
+				return err
+			}
+			if err := afero.WriteFile(fs, target, b, 0666); err != nil {
+				return err
+			}
+			written[rel] = src
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// The merged files differ from the ones listed in the shards' build manifests.
+	for p := range merged {
+		if err := updateBuildManifest(fs, dst, p, merged); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(written), nil
+}
+
+// updateBuildManifest updates the hashes and sizes of the merged files
+// listed in the file p in dst if it is a build manifest, see build.manifest.
+func updateBuildManifest(fs afero.Fs, dst, p string, merged map[string]bool) error {
+	filename := filepath.Join(dst, filepath.FromSlash(p))
+	b, err := afero.ReadFile(fs, filename)
+	if err != nil {
+		return err
+	}
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+	files, ok := m["files"].([]any)
+	if !ok {
+		return nil
+	}
+	if _, ok := pathKeyed(files); !ok {
+		return nil
+	}
+	for _, v := range files {
+		e := v.(map[string]any)
+		if !merged[e["path"].(string)] {
+			continue
+		}
+		content, err := afero.ReadFile(fs, filepath.Join(dst, filepath.FromSlash(e["path"].(string))))
+		if err != nil {
+			return err
+		}
+		sum := sha256.Sum256(content)
+		e["sha256"], e["size"] = hex.EncodeToString(sum[:]), len(content)
+	}
+	b, err = json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return afero.WriteFile(fs, filename, b, 0666)
+}
+
+// mergeJSONObjects merges the JSON objects a and b, see mergeJSON. It
+// returns false if any of them isn't a JSON object or they conflict.
+func mergeJSONObjects(a, b []byte) ([]byte, bool) {
+	var ma, mb map[string]any
+	if json.Unmarshal(a, &ma) != nil || json.Unmarshal(b, &mb) != nil || ma == nil || mb == nil {
+		return nil, false
+	}
+	v, ok := mergeJSON(ma, mb)
+	if !ok {
+		return nil, false
+	}
+	merged, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, false
+	}
+	return merged, true
+}
+
+// mergeJSON merges the decoded JSON values a and b. Objects are merged
+// recursively and arrays of strings, e.g. the classes in hugo_stats.json,
+// are merged into a sorted array without duplicates. Arrays of objects
+// with a path, e.g. the files in the build manifest, are concatenated
+// without duplicate paths and sorted by path. Any other values must be
+// equal.
+func mergeJSON(a, b any) (any, bool) {
+	switch va := a.(type) {
+	case map[string]any:
+		vb, ok := b.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		for k, v := range vb {
+			if existing, found := va[k]; found {
+				if v, ok = mergeJSON(existing, v); !ok {
+					return nil, false
+				}
+			}
+			va[k] = v
+		}
+		return va, true
+	case []any:
+		vb, ok := b.([]any)
+		if !ok {
+			return nil, false
+		}
+		if sa, ok := toStrings(va); ok {
+			if sb, ok := toStrings(vb); ok {
+				return mergeStrings(sa, sb), true
+			}
+		}
+		if pa, ok := pathKeyed(va); ok {
+			if pb, ok := pathKeyed(vb); ok {
+				return mergeByPath(va, vb, pa, pb), true
+			}
+		}
+	}
+	return a, reflect.DeepEqual(a, b)
+}
+
+func toStrings(v []any) ([]string, bool) {
+	s := make([]string, len(v))
+	for i, vv := range v {
+		ss, ok := vv.(string)
+		if !ok {
+			return nil, false
+		}
+		s[i] = ss
+	}
+	return s, true
+}
+
+// pathKeyed returns the paths of the objects in v, or false if any of
+// them isn't an object with a string path.
+func pathKeyed(v []any) ([]string, bool) {
+	paths := make([]string, len(v))
+	for i, vv := range v {
+		m, ok := vv.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if paths[i], ok = m["path"].(string); !ok {
+			return nil, false
+		}
+	}
+	return paths, true
+}
+
+// mergeByPath returns the objects in a and b with the paths pa and pb
+// sorted by path. The last one wins for duplicate paths. These only
+// differ for files merged from several shards, see updateBuildManifest.
+func mergeByPath(a, b []any, pa, pb []string) []any {
+	byPath := make(map[string]any)
+	for i, v := range a {
+		byPath[pa[i]] = v
+	}
+	for i, v := range b {
+		byPath[pb[i]] = v
+	}
+	paths := make([]string, 0, len(byPath))
+	for p := range byPath {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	merged := make([]any, len(paths))
+	for i, p := range paths {
+		merged[i] = byPath[p]
+	}
+	return merged
+}
+
+// mergeStrings returns the sorted union of a and b.
+func mergeStrings(a, b []string) []any {
+	seen := make(map[string]bool)
+	var s []string
+	for _, v := range append(a, b...) {
+		if !seen[v] {
+			seen[v] = true
+			s = append(s, v)
+		}
+	}
+	sort.Strings(s)
+	merged := make([]any, len(s))
+	for i, v := range s {
+		merged[i] = v
+	}
+	return merged
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package shard supports splitting the rendering of a site across several
+// builds, e.g. on different CI machines, and merging the results.
+package shard
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ByHash assigns pages to shards by a hash of their path.
+	ByHash = "hash"
+
+	// BySection assigns pages to shards by a hash of their top level
+	// section, rendering all pages of a section in the same shard.
+	BySection = "section"
+)
+
+// Config is the compiled shard configuration.
+// The zero value renders everything.
+type Config struct {
+	// The 1 based index of the shard to render.
+	Index int
+
+	// The total number of shards.
+	Count int
+
+	// How to assign pages to shards, ByHash (default) or BySection.
+	By string
+}
+
+// Decode parses s, on the form "index/count", e.g. "2/8", and by into a Config.
+// An empty s returns the zero Config.
+func Decode(s, by string) (Config, error) {
+	var c Config
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return c, nil
+	}
+
+	is, cs, found := strings.Cut(s, "/")
+	if !found {
+		return c, fmt.Errorf("shard: invalid value %q, must be on the form index/count, e.g. 2/8", s)
+	}
+	var err error
+	if c.Index, err = strconv.Atoi(strings.TrimSpace(is)); err != nil {
+		return c, fmt.Errorf("shard: invalid index in %q", s)
+	}
+	if c.Count, err = strconv.Atoi(strings.TrimSpace(cs)); err != nil {
+		return c, fmt.Errorf("shard: invalid count in %q", s)
+	}
+	if c.Count < 1 || c.Index < 1 || c.Index > c.Count {
+		return c, fmt.Errorf("shard: invalid value %q, index must be between 1 and count", s)
+	}
+
+	switch by = strings.ToLower(by); by {
+	case "", ByHash:
+		c.By = ByHash
+	case BySection:
+		c.By = BySection
+	default:
+		return c, fmt.Errorf("shard: invalid shardBy %q, must be one of %q or %q", by, ByHash, BySection)
+	}
+
+	return c, nil
+}
+
+// Enabled reports whether the build renders a subset of the site.
+func (c Config) Enabled() bool {
+	return c.Count > 1
+}
+
+// IsPrimary reports whether this shard renders the outputs not belonging to
+// any page, e.g. the sitemap, robots.txt, 404 page and the static files.
+// This is always true when sharding is disabled.
+func (c Config) IsPrimary() bool {
+	return !c.Enabled() || c.Index == 1
+}
+
+// Owns reports whether the page with the given path and top level section
+// is rendered in this shard.
+// This is always true when sharding is disabled.
+func (c Config) Owns(path, section string) bool {
+	if !c.Enabled() {
+		return true
+	}
+	key := path
+	if c.By == BySection {
+		key = section
+	}
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return int(h.Sum32()%uint32(c.Count)) == c.Index-1
+}
+
+// String returns the shard on the form "index/count".
+func (c Config) String() string {
+	return fmt.Sprintf("%d/%d", c.Index, c.Count)
+}
@@ -0,0 +1,176 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shard
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/spf13/afero"
+)
+
+func TestDecode(t *testing.T) {
+	c := qt.New(t)
+
+	conf, err := Decode("", "")
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Enabled(), qt.IsFalse)
+	c.Assert(conf.IsPrimary(), qt.IsTrue)
+	c.Assert(conf.Owns("/a/", "a"), qt.IsTrue)
+
+	conf, err = Decode(" 2/8 ", "")
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf, qt.Equals, Config{Index: 2, Count: 8, By: ByHash})
+	c.Assert(conf.IsPrimary(), qt.IsFalse)
+	c.Assert(conf.String(), qt.Equals, "2/8")
+
+	conf, err = Decode("1/1", "Section")
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Enabled(), qt.IsFalse)
+	c.Assert(conf.By, qt.Equals, BySection)
+
+	for _, s := range []string{"2", "a/8", "2/b", "0/8", "9/8", "1/0"} {
+		_, err := Decode(s, "")
+		c.Assert(err, qt.Not(qt.IsNil), qt.Commentf(s))
+	}
+	_, err = Decode("1/2", "kind")
+	c.Assert(err, qt.ErrorMatches, ".*invalid shardBy.*")
+}
+
+func TestOwns(t *testing.T) {
+	c := qt.New(t)
+
+	const count = 4
+	var shards [count]Config
+	for i := range shards {
+		var err error
+		shards[i], err = Decode(fmt.Sprintf("%d/%d", i+1, count), "")
+		c.Assert(err, qt.IsNil)
+	}
+
+	counts := make([]int, count)
+	for i := 0; i < 1000; i++ {
+		path := fmt.Sprintf("/posts/p%d/", i)
+		n := 0
+		for j, s := range shards {
+			if s.Owns(path, "posts") {
+				counts[j]++
+				n++
+			}
+		}
+		c.Assert(n, qt.Equals, 1)
+	}
+	for _, n := range counts {
+		c.Assert(n > 150, qt.IsTrue, qt.Commentf("%v", counts))
+	}
+
+	bySection, err := Decode("1/4", BySection)
+	c.Assert(err, qt.IsNil)
+	c.Assert(bySection.Owns("/posts/p1/", "posts"), qt.Equals, bySection.Owns("/posts/p2/", "posts"))
+}
+
+func TestMerge(t *testing.T) {
+	c := qt.New(t)
+
+	newFs := func() afero.Fs {
+		fs := afero.NewMemMapFs()
+		write := func(filename, content string) {
+			c.Assert(afero.WriteFile(fs, filepath.FromSlash(filename), []byte(content), 0666), qt.IsNil)
+		}
+		c.Assert(WriteManifest(fs, "s1", Manifest{Shard: 1, Shards: 2, By: ByHash}), qt.IsNil)
+		c.Assert(WriteManifest(fs, "s2", Manifest{Shard: 2, Shards: 2, By: ByHash}), qt.IsNil)
+		write("s1/index.html", "home")
+		write("s1/a/index.html", "a")
+		write("s1/img.png", "img")
+		write("s1/sri.json", `{"/a.css": "sha256-a"}`)
+		write("s1/manifest.json", `{"hugoVersion": "0.120.0", "files": [{"path": "sri.json", "sha256": "s1", "size": 23}, {"path": "img.png", "sha256": "i", "size": 3}, {"path": "index.html", "sha256": "h", "size": 4, "type": "page"}]}`)
+		write("s1/hugo_stats.json", `{"htmlElements": {"tags": ["body", "p"], "classes": ["b", "a"], "ids": ["x"]}}`)
+		write("s2/b/index.html", "b")
+		write("s2/img.png", "img")
+		write("s2/sri.json", `{"/b.css": "sha256-b"}`)
+		write("s2/manifest.json", `{"hugoVersion": "0.120.0", "files": [{"path": "b/index.html", "sha256": "b", "size": 1, "type": "page"}, {"path": "img.png", "sha256": "i", "size": 3}, {"path": "sri.json", "sha256": "s2", "size": 23}]}`)
+		write("s2/hugo_stats.json", `{"htmlElements": {"tags": ["body", "div"], "classes": ["c", "a"], "ids": []}}`)
+		return fs
+	}
+
+	fs := newFs()
+	n, err := Merge(fs, "public", []string{"s1", "s2"})
+	c.Assert(err, qt.IsNil)
+	c.Assert(n, qt.Equals, 7)
+	b, err := afero.ReadFile(fs, filepath.FromSlash("public/b/index.html"))
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(b), qt.Equals, "b")
+	b, err = afero.ReadFile(fs, filepath.FromSlash("public/sri.json"))
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(b), qt.Contains, `"/a.css": "sha256-a"`)
+	c.Assert(string(b), qt.Contains, `"/b.css": "sha256-b"`)
+	b, err = afero.ReadFile(fs, filepath.FromSlash("public/hugo_stats.json"))
+	c.Assert(err, qt.IsNil)
+	var stats map[string]map[string][]string
+	c.Assert(json.Unmarshal(b, &stats), qt.IsNil)
+	c.Assert(stats, qt.DeepEquals, map[string]map[string][]string{
+		"htmlElements": {
+			"tags":    {"body", "div", "p"},
+			"classes": {"a", "b", "c"},
+			"ids":     {"x"},
+		},
+	})
+	sri, err := afero.ReadFile(fs, filepath.FromSlash("public/sri.json"))
+	c.Assert(err, qt.IsNil)
+	sum := sha256.Sum256(sri)
+	b, err = afero.ReadFile(fs, filepath.FromSlash("public/manifest.json"))
+	c.Assert(err, qt.IsNil)
+	var manifest struct {
+		HugoVersion string
+		Files       []struct {
+			Path   string
+			SHA256 string
+			Size   int
+		}
+	}
+	c.Assert(json.Unmarshal(b, &manifest), qt.IsNil)
+	c.Assert(manifest.HugoVersion, qt.Equals, "0.120.0")
+	c.Assert(manifest.Files, qt.HasLen, 4)
+	for i, p := range []string{"b/index.html", "img.png", "index.html", "sri.json"} {
+		c.Assert(manifest.Files[i].Path, qt.Equals, p)
+	}
+	c.Assert(manifest.Files[3].SHA256, qt.Equals, hex.EncodeToString(sum[:]))
+	c.Assert(manifest.Files[3].Size, qt.Equals, len(sri))
+	_, err = fs.Stat(filepath.FromSlash("public/" + ManifestFilename))
+	c.Assert(err, qt.Not(qt.IsNil))
+
+	// Conflicting files.
+	fs = newFs()
+	c.Assert(afero.WriteFile(fs, filepath.FromSlash("s2/img.png"), []byte("other"), 0666), qt.IsNil)
+	_, err = Merge(fs, "public", []string{"s1", "s2"})
+	c.Assert(err, qt.ErrorMatches, `img.png differs in "s1" and "s2"`)
+
+	fs = newFs()
+	c.Assert(afero.WriteFile(fs, filepath.FromSlash("s2/sri.json"), []byte(`{"/a.css": "sha256-other"}`), 0666), qt.IsNil)
+	_, err = Merge(fs, "public", []string{"s1", "s2"})
+	c.Assert(err, qt.ErrorMatches, `sri.json differs.*`)
+
+	// Missing and duplicate shards.
+	_, err = Merge(newFs(), "public", []string{"s1"})
+	c.Assert(err, qt.ErrorMatches, `missing shard\(s\) 2 of 2`)
+	_, err = Merge(newFs(), "public", []string{"s1", "s1"})
+	c.Assert(err, qt.ErrorMatches, `.*are both shard 1`)
+	_, err = Merge(newFs(), "public", []string{"s1", "s3"})
+	c.Assert(err, qt.ErrorMatches, `.*is not the publish directory of a shard.*`)
+}
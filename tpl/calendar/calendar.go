@@ -0,0 +1,279 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package calendar provides template functions for rendering pages as
+// events in calendars and agendas.
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gohugoio/hugo/common/htime"
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/resources/page"
+	"github.com/gohugoio/hugo/resources/page/pagemeta"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/cast"
+)
+
+// New returns a new instance of the calendar-namespaced template functions.
+func New(location *time.Location) *Namespace {
+	return &Namespace{location: location}
+}
+
+// Namespace provides template functions for the "calendar" namespace.
+type Namespace struct {
+	location *time.Location
+}
+
+// Event is an occurrence of a page in a calendar.
+// A page is an event starting at its date, with an optional duration set in
+// the front matter param "duration", e.g. "2h30m", and recurrence rule set
+// in "recurrence", e.g. "FREQ=WEEKLY;BYDAY=TU".
+type Event struct {
+	Page page.Page
+
+	Start time.Time
+
+	// Equal to Start if the event has no duration.
+	End time.Time
+
+	// Whether this is one of many occurrences of a recurring event.
+	Recurring bool
+}
+
+// Day is a day in a calendar with the events that take place on it.
+type Day struct {
+	// Midnight at the start of the day.
+	Date time.Time
+
+	// Whether the day is in the month rendered, see Month.
+	InMonth bool
+
+	Events []Event
+}
+
+// Month is the calendar for a month, as rows of weeks.
+type Month struct {
+	// The first day of the month.
+	Date time.Time
+
+	// The first day of the previous and the next month.
+	Prev time.Time
+	Next time.Time
+
+	// The weeks of the month, each with 7 days, starting with the days
+	// from the previous month and ending with the days from the next month
+	// needed to fill the weeks.
+	Weeks [][]Day
+}
+
+// Events returns the occurrences of the events in pages taking place in the
+// interval [from, to), in order.
+// Recurring events are expanded to one event per occurrence.
+func (ns *Namespace) Events(from, to any, pages any) ([]Event, error) {
+	fromt, err := htime.ToTimeInDefaultLocationE(from, ns.location)
+	if err != nil {
+		return nil, err
+	}
+	tot, err := htime.ToTimeInDefaultLocationE(to, ns.location)
+	if err != nil {
+		return nil, err
+	}
+	return ns.events(fromt, tot, pages)
+}
+
+// Agenda returns the days in the interval [from, to) with events taking
+// place on them, in order.
+func (ns *Namespace) Agenda(from, to any, pages any) ([]Day, error) {
+	fromt, err := htime.ToTimeInDefaultLocationE(from, ns.location)
+	if err != nil {
+		return nil, err
+	}
+	tot, err := htime.ToTimeInDefaultLocationE(to, ns.location)
+	if err != nil {
+		return nil, err
+	}
+	events, err := ns.events(fromt, tot, pages)
+	if err != nil {
+		return nil, err
+	}
+
+	byDay := eventsByDay(events)
+	var days []Day
+	for d := ns.midnight(fromt); d.Before(tot); d = d.AddDate(0, 0, 1) {
+		if e := byDay[dayKey(d)]; len(e) > 0 {
+			days = append(days, Day{Date: d, InMonth: true, Events: e})
+		}
+	}
+
+	return days, nil
+}
+
+type monthOptions struct {
+	// The first day of the week, e.g. "monday" (default) or "sunday".
+	WeekStart string
+}
+
+// Month returns the calendar for the month of the given date with the
+// events in pages.
+// The last argument is the pages, optionally preceded by an options map
+// with weekStart, the first day of the week, e.g. "sunday" (default
+// "monday").
+func (ns *Namespace) Month(date any, args ...any) (Month, error) {
+	var m Month
+
+	if len(args) == 0 || len(args) > 2 {
+		return m, errors.New("must provide the pages, optionally preceded by an options map")
+	}
+
+	opts := monthOptions{WeekStart: "monday"}
+	if len(args) == 2 {
+		om, err := maps.ToStringMapE(args[0])
+		if err != nil {
+			return m, err
+		}
+		if err := mapstructure.WeakDecode(om, &opts); err != nil {
+			return m, err
+		}
+	}
+	weekStart, err := parseWeekday(opts.WeekStart)
+	if err != nil {
+		return m, err
+	}
+
+	t, err := htime.ToTimeInDefaultLocationE(date, ns.location)
+	if err != nil {
+		return m, err
+	}
+
+	m.Date = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, ns.location)
+	m.Prev = m.Date.AddDate(0, -1, 0)
+	m.Next = m.Date.AddDate(0, 1, 0)
+
+	first := m.Date.AddDate(0, 0, -((int(m.Date.Weekday()) - int(weekStart) + 7) % 7))
+	last := m.Next.AddDate(0, 0, (int(weekStart)-int(m.Next.Weekday())+7)%7)
+
+	events, err := ns.events(first, last, args[len(args)-1])
+	if err != nil {
+		return m, err
+	}
+	byDay := eventsByDay(events)
+
+	for d := first; d.Before(last); d = d.AddDate(0, 0, 7) {
+		week := make([]Day, 7)
+		for i := range week {
+			day := d.AddDate(0, 0, i)
+			week[i] = Day{Date: day, InMonth: day.Month() == m.Date.Month(), Events: byDay[dayKey(day)]}
+		}
+		m.Weeks = append(m.Weeks, week)
+	}
+
+	return m, nil
+}
+
+func (ns *Namespace) events(from, to time.Time, pages any) ([]Event, error) {
+	ps, err := page.ToPages(pages)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []Event
+	for _, p := range ps {
+		start := p.Date()
+		if start.IsZero() {
+			continue
+		}
+
+		var duration time.Duration
+		if v, found := p.Params()["duration"]; found {
+			duration, err = cast.ToDurationE(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: invalid duration: %w", pathOrTitle(p), err)
+			}
+		}
+
+		rule := cast.ToString(p.Params()["recurrence"])
+		if rule == "" {
+			if start.Before(to) && (start.Add(duration).After(from) || !start.Before(from)) {
+				events = append(events, Event{Page: p, Start: start, End: start.Add(duration)})
+			}
+			continue
+		}
+
+		r, err := pagemeta.ParseRecurrence(rule)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", pathOrTitle(p), err)
+		}
+
+		// Include events that started before from but are still going on.
+		for _, t := range r.Between(start, from.Add(-duration), to) {
+			if t.Add(duration).After(from) || !t.Before(from) {
+				events = append(events, Event{Page: p, Start: t, End: t.Add(duration), Recurring: true})
+			}
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].Start.Equal(events[j].Start) {
+			return events[i].Page.Title() < events[j].Page.Title()
+		}
+		return events[i].Start.Before(events[j].Start)
+	})
+
+	return events, nil
+}
+
+func (ns *Namespace) midnight(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, ns.location)
+}
+
+// eventsByDay groups events by the days they take place on.
+// An event ending at midnight doesn't take place on the day it ends.
+func eventsByDay(events []Event) map[string][]Event {
+	m := make(map[string][]Event)
+	for _, e := range events {
+		last := e.End
+		if last.After(e.Start) {
+			last = last.Add(-time.Nanosecond)
+		}
+		for d := time.Date(e.Start.Year(), e.Start.Month(), e.Start.Day(), 0, 0, 0, 0, e.Start.Location()); !d.After(last); d = d.AddDate(0, 0, 1) {
+			m[dayKey(d)] = append(m[dayKey(d)], e)
+		}
+	}
+	return m
+}
+
+func dayKey(t time.Time) string {
+	return t.Format("2006-01-02")
+}
+
+func parseWeekday(s string) (time.Weekday, error) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), s) {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid weekday %q", s)
+}
+
+func pathOrTitle(p page.Page) string {
+	if p.File() != nil {
+		return p.File().Filename()
+	}
+	return p.Title()
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calendar_test
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestCalendar(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "RSS", "sitemap", "robotsTXT"]
+timeZone = "UTC"
+-- content/events/standup.md --
+---
+title: "Standup"
+date: 2023-03-06T09:00:00Z
+duration: 30m
+recurrence: "FREQ=WEEKLY;BYDAY=MO,WE;UNTIL=20230331"
+---
+-- content/events/conference.md --
+---
+title: "Conference"
+date: 2023-03-29T08:00:00Z
+duration: 56h
+---
+-- content/events/party.md --
+---
+title: "Party"
+date: 2023-02-10T20:00:00Z
+---
+-- layouts/index.html --
+{{ $events := where site.RegularPages "Section" "events" }}
+Events: {{ range calendar.Events "2023-03-01" "2023-03-10" $events }}{{ .Page.Title }} {{ .Start.Format "2006-01-02T15:04" }}-{{ .End.Format "15:04" }} {{ .Recurring }}|{{ end }}
+{{ $m := calendar.Month "2023-03-15" $events }}
+Month: {{ $m.Date.Format "2006-01" }} Prev: {{ $m.Prev.Format "2006-01" }} Next: {{ $m.Next.Format "2006-01" }} Weeks: {{ len $m.Weeks }}
+{{ range $m.Weeks }}Week:{{ range . }} {{ .Date.Day }}{{ if not .InMonth }}*{{ end }}{{ with .Events }}({{ range . }}{{ .Page.Title }}{{ end }}){{ end }}{{ end }}
+{{ end }}
+{{ $m := calendar.Month "2023-03-15" (dict "weekStart" "sunday") $events }}
+First: {{ (index (index $m.Weeks 0) 0).Date.Format "Mon 2006-01-02" }}
+Agenda: {{ range calendar.Agenda "2023-03-27" "2023-04-10" $events }}{{ .Date.Format "01-02" }}:{{ range .Events }}{{ .Page.Title }},{{ end }}|{{ end }}
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html",
+		"Events: Standup 2023-03-06T09:00-09:30 true|Standup 2023-03-08T09:00-09:30 true|",
+		"Month: 2023-03 Prev: 2023-02 Next: 2023-04 Weeks: 5",
+		"Week: 27* 28* 1 2 3 4 5",
+		"Week: 6(Standup) 7 8(Standup) 9 10 11 12",
+		"Week: 27(Standup) 28 29(ConferenceStandup) 30(Conference) 31(Conference) 1* 2*",
+		"First: Sun 2023-02-26",
+		"Agenda: 03-27:Standup,|03-29:Conference,Standup,|03-30:Conference,|03-31:Conference,|",
+	)
+}
+
+func TestCalendarInvalidRecurrence(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+-- content/p1.md --
+---
+title: "P1"
+date: 2023-03-06
+recurrence: "FREQ=HOURLY"
+---
+`
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `invalid recurrence rule "FREQ=HOURLY"`)
+}
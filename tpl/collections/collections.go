@@ -17,6 +17,7 @@ package collections
 
 import (
 	"fmt"
+	"hash/fnv"
 	"html/template"
 	"math/rand"
 	"net/url"
@@ -576,6 +577,56 @@ func (ns *Namespace) Seq(args ...any) ([]int, error) {
 
 // Shuffle returns list l in a randomised order.
 func (ns *Namespace) Shuffle(l any) (any, error) {
+	return shuffle(l, rand.Perm)
+}
+
+// ShuffleSeeded returns list l in a randomised order determined by seed,
+// e.g. a page's permalink. The same seed and list will always give the same order.
+func (ns *Namespace) ShuffleSeeded(seed any, l any) (any, error) {
+	r, err := newSeededRand(seed)
+	if err != nil {
+		return nil, err
+	}
+	return shuffle(l, r.Perm)
+}
+
+// SampleSeeded returns n elements picked from list l in a randomised order
+// determined by seed, e.g. a page's permalink. The same seed and list will
+// always give the same sample.
+func (ns *Namespace) SampleSeeded(seed any, n any, l any) (any, error) {
+	if n == nil {
+		return nil, errors.New("both n and seq must be provided")
+	}
+	nv, err := cast.ToIntE(n)
+	if err != nil {
+		return nil, err
+	}
+	if nv < 0 {
+		return nil, errors.New("sample size must be non-negative")
+	}
+
+	shuffled, err := ns.ShuffleSeeded(seed, l)
+	if err != nil {
+		return nil, err
+	}
+
+	return ns.First(nv, shuffled)
+}
+
+func newSeededRand(seed any) (*rand.Rand, error) {
+	if seed == nil {
+		return nil, errors.New("seed must be provided")
+	}
+	seedStr, err := cast.ToStringE(seed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid seed: %w", err)
+	}
+	h := fnv.New64a()
+	h.Write([]byte(seedStr))
+	return rand.New(rand.NewSource(int64(h.Sum64()))), nil
+}
+
+func shuffle(l any, perm func(n int) []int) (any, error) {
 	if l == nil {
 		return nil, errors.New("both count and seq must be provided")
 	}
@@ -595,7 +646,7 @@ func (ns *Namespace) Shuffle(l any) (any, error) {
 
 	shuffled := reflect.MakeSlice(reflect.TypeOf(l), lv.Len(), lv.Len())
 
-	randomIndices := rand.Perm(lv.Len())
+	randomIndices := perm(lv.Len())
 
 	for index, value := range randomIndices {
 		shuffled.Index(value).Set(lv.Index(index))
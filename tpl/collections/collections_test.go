@@ -721,6 +721,64 @@ func TestShuffleRandomising(t *testing.T) {
 	}
 }
 
+func TestShuffleSeeded(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+	ns := newNs()
+
+	seq := make([]int, 100)
+	for i := range seq {
+		seq[i] = i
+	}
+
+	r1, err := ns.ShuffleSeeded("/p1/", seq)
+	c.Assert(err, qt.IsNil)
+	r2, err := ns.ShuffleSeeded("/p1/", seq)
+	c.Assert(err, qt.IsNil)
+	r3, err := ns.ShuffleSeeded("/p2/", seq)
+	c.Assert(err, qt.IsNil)
+
+	c.Assert(r1, qt.DeepEquals, r2)
+	c.Assert(r1, qt.Not(qt.DeepEquals), r3)
+	c.Assert(r1, qt.Not(qt.DeepEquals), seq)
+	c.Assert(r1.([]int), qt.HasLen, len(seq))
+
+	_, err = ns.ShuffleSeeded(nil, seq)
+	c.Assert(err, qt.Not(qt.IsNil))
+	_, err = ns.ShuffleSeeded("seed", nil)
+	c.Assert(err, qt.Not(qt.IsNil))
+	_, err = ns.ShuffleSeeded(t, seq)
+	c.Assert(err, qt.Not(qt.IsNil))
+}
+
+func TestSampleSeeded(t *testing.T) {
+	t.Parallel()
+	c := qt.New(t)
+	ns := newNs()
+
+	seq := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+
+	r1, err := ns.SampleSeeded(32, 3, seq)
+	c.Assert(err, qt.IsNil)
+	c.Assert(r1.([]string), qt.HasLen, 3)
+	r2, err := ns.SampleSeeded("32", "3", seq)
+	c.Assert(err, qt.IsNil)
+	c.Assert(r1, qt.DeepEquals, r2)
+
+	shuffled, err := ns.ShuffleSeeded(32, seq)
+	c.Assert(err, qt.IsNil)
+	c.Assert(r1, qt.DeepEquals, shuffled.([]string)[:3])
+
+	all, err := ns.SampleSeeded(32, 20, seq)
+	c.Assert(err, qt.IsNil)
+	c.Assert(all, qt.DeepEquals, shuffled)
+
+	_, err = ns.SampleSeeded(32, -1, seq)
+	c.Assert(err, qt.Not(qt.IsNil))
+	_, err = ns.SampleSeeded(32, nil, seq)
+	c.Assert(err, qt.Not(qt.IsNil))
+}
+
 // Also see tests in commons/collection.
 func TestSlice(t *testing.T) {
 	t.Parallel()
@@ -136,6 +136,20 @@ func init() {
 			[][2]string{},
 		)
 
+		ns.AddMethodMapping(ctx.ShuffleSeeded,
+			nil,
+			[][2]string{
+				{`{{ slice 1 2 3 4 5 | collections.ShuffleSeeded "seed" | len }}`, `5`},
+			},
+		)
+
+		ns.AddMethodMapping(ctx.SampleSeeded,
+			nil,
+			[][2]string{
+				{`{{ slice 1 2 3 4 5 | collections.SampleSeeded "seed" 2 | len }}`, `2`},
+			},
+		)
+
 		ns.AddMethodMapping(ctx.Slice,
 			[]string{"slice"},
 			[][2]string{
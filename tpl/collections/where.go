@@ -24,6 +24,13 @@ import (
 )
 
 // Where returns a filtered subset of collection c.
+// The key may be a dot separated path into nested maps and structs, e.g. "Params.author.name".
+// Keys into page and site params are case insensitive, keys into other maps,
+// e.g. from data files, are case sensitive.
+// The operators eq, ne, in, not in, intersect and intersectsAny have case
+// insensitive variants prefixed with an i, e.g. ieq and inot in.
+// The intersectsAny operator matches if the value and the match value have
+// any element in common, where either of them may be a single value.
 func (ns *Namespace) Where(c, key any, args ...any) (any, error) {
 	seqv, isNil := indirect(reflect.ValueOf(c))
 	if isNil {
@@ -51,7 +58,38 @@ func (ns *Namespace) Where(c, key any, args ...any) (any, error) {
 	}
 }
 
+// whereOperators are the operators supported by where, all lower case.
+var whereOperators = map[string]bool{
+	"": true, "=": true, "==": true, "eq": true,
+	"!=": true, "<>": true, "ne": true,
+	">=": true, "ge": true, ">": true, "gt": true,
+	"<=": true, "le": true, "<": true, "lt": true,
+	"in": true, "not in": true,
+	"intersect": true, "intersectsany": true,
+}
+
+// caseInsensitiveWhereOperators are the operators that have a case
+// insensitive variant with an "i" prefix, e.g. "ieq" and "inot in".
+var caseInsensitiveWhereOperators = map[string]bool{
+	"eq": true, "ne": true, "in": true, "not in": true,
+	"intersect": true, "intersectsany": true,
+}
+
+// resolveWhereOperator resolves any case insensitive variant of op into
+// its case sensitive counterpart.
+func resolveWhereOperator(op string) (string, bool) {
+	if whereOperators[op] {
+		return op, false
+	}
+	if strings.HasPrefix(op, "i") && caseInsensitiveWhereOperators[op[1:]] {
+		return op[1:], true
+	}
+	return op, false
+}
+
 func (ns *Namespace) checkCondition(v, mv reflect.Value, op string) (bool, error) {
+	op, foldCase := resolveWhereOperator(op)
+
 	v, vIsNil := indirect(v)
 	if !v.IsValid() {
 		vIsNil = true
@@ -71,6 +109,21 @@ func (ns *Namespace) checkCondition(v, mv reflect.Value, op string) (bool, error
 		return false, nil
 	}
 
+	if foldCase {
+		v, mv = toLowerValue(v), toLowerValue(mv)
+	}
+
+	if op == "intersectsany" {
+		// Either side may be a single value.
+		l1, l2 := toSliceValue(v), toSliceValue(mv)
+		for i := 0; i < l1.Len(); i++ {
+			if in, _ := ns.In(l2.Interface(), l1.Index(i).Interface()); in {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
 	if v.Kind() == reflect.Bool && mv.Kind() == reflect.Bool {
 		switch op {
 		case "", "=", "==", "eq":
@@ -275,6 +328,40 @@ func (ns *Namespace) checkCondition(v, mv reflect.Value, op string) (bool, error
 	return false, nil
 }
 
+// toLowerValue returns v with any string value, or any string element if v
+// is a slice or an array, lower cased.
+func toLowerValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.String:
+		return reflect.ValueOf(strings.ToLower(v.String()))
+	case reflect.Array, reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.String && v.Type().Elem().Kind() != reflect.Interface {
+			return v
+		}
+		l := make([]any, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			ev, _ := indirectInterface(v.Index(i))
+			if ev.Kind() == reflect.String {
+				l[i] = strings.ToLower(ev.String())
+			} else if ev.IsValid() {
+				l[i] = ev.Interface()
+			}
+		}
+		return reflect.ValueOf(l)
+	}
+	return v
+}
+
+// toSliceValue returns v if it's a slice or an array, else a slice with v
+// as its only element.
+func toSliceValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Array, reflect.Slice:
+		return v
+	}
+	return reflect.ValueOf([]any{v.Interface()})
+}
+
 func evaluateSubElem(obj reflect.Value, elemName string) (reflect.Value, error) {
 	if !obj.IsValid() {
 		return zero, errors.New("can't evaluate an invalid value")
@@ -584,6 +584,41 @@ func TestWhere(t *testing.T) {
 			key: "b.z", match: false,
 			expect: []map[string]bool{},
 		},
+		{
+			seq: []map[string]any{
+				{"a": map[string]any{"Author": map[string]any{"Name": "Jo"}}},
+				{"a": map[string]any{"Author": map[string]any{"Name": "Bo"}}},
+			},
+			key: "a.Author.Name", op: "ieq", match: "JO",
+			expect: []map[string]any{
+				{"a": map[string]any{"Author": map[string]any{"Name": "Jo"}}},
+			},
+		},
+		{
+			seq: []map[string]any{
+				{"a": map[string]any{"Author": map[string]any{"Name": "Jo"}}},
+			},
+			key: "a.author.name", op: "ieq", match: "JO",
+			expect: []map[string]any{},
+		},
+		{
+			seq: []maps.Params{
+				{"author": maps.Params{"name": "Jo"}}, {"author": maps.Params{"name": "Bo"}},
+			},
+			key: "Author.Name", match: "Jo",
+			expect: []maps.Params{
+				{"author": maps.Params{"name": "Jo"}},
+			},
+		},
+		{
+			seq: []maps.Params{
+				{"tags": []string{"Go", "Hugo"}}, {"tags": []string{"Rust"}}, {"tags": "go"},
+			},
+			key: "tags", op: "iintersectsAny", match: []string{"go", "zig"},
+			expect: []maps.Params{
+				{"tags": []string{"Go", "Hugo"}}, {"tags": "go"},
+			},
+		},
 		{seq: (*[]TstX)(nil), key: "A", match: "a", expect: false},
 		{seq: TstX{A: "a", B: "b"}, key: "A", match: "a", expect: false},
 		{seq: []map[string]*TstX{{"foo": nil}}, key: "foo.B", match: "d", expect: []map[string]*TstX{}},
@@ -790,6 +825,22 @@ func TestCheckCondition(t *testing.T) {
 		{reflect.ValueOf([]string{"a"}), reflect.ValueOf([]any{"a", "b"}), "intersect", expect{true, false}},
 		{reflect.ValueOf([]any{1, 2}), reflect.ValueOf([]int{1}), "intersect", expect{true, false}},
 		{reflect.ValueOf([]int{1}), reflect.ValueOf([]any{1, 2}), "intersect", expect{true, false}},
+
+		{reflect.ValueOf([]string{"a", "b"}), reflect.ValueOf([]string{"b", "c"}), "intersectsany", expect{true, false}},
+		{reflect.ValueOf([]string{"a", "b"}), reflect.ValueOf([]string{"c", "d"}), "intersectsany", expect{false, false}},
+		{reflect.ValueOf("b"), reflect.ValueOf([]string{"b", "c"}), "intersectsany", expect{true, false}},
+		{reflect.ValueOf([]any{1, 2}), reflect.ValueOf(2), "intersectsany", expect{true, false}},
+		{reflect.ValueOf([]string{"a", "B"}), reflect.ValueOf([]string{"b", "c"}), "intersectsany", expect{false, false}},
+		{reflect.ValueOf([]string{"a", "B"}), reflect.ValueOf([]string{"b", "c"}), "iintersectsany", expect{true, false}},
+		{reflect.ValueOf([]string{"a", "B"}), reflect.ValueOf([]any{"b", "c"}), "iintersect", expect{true, false}},
+		{reflect.ValueOf("Foo"), reflect.ValueOf("fOO"), "eq", expect{false, false}},
+		{reflect.ValueOf("Foo"), reflect.ValueOf("fOO"), "ieq", expect{true, false}},
+		{reflect.ValueOf("Foo"), reflect.ValueOf("fOO"), "ine", expect{false, false}},
+		{reflect.ValueOf("Foo"), reflect.ValueOf([]string{"FOO", "bar"}), "iin", expect{true, false}},
+		{reflect.ValueOf("Foo"), reflect.ValueOf([]string{"FOO", "bar"}), "inot in", expect{false, false}},
+		{reflect.ValueOf("foo"), reflect.ValueOf("BAR-FOO-BAZ"), "iin", expect{true, false}},
+		{reflect.ValueOf(123), reflect.ValueOf(123), "ieq", expect{true, false}},
+		{reflect.ValueOf(123), reflect.ValueOf(123), "ilt", expect{false, true}},
 	} {
 		result, err := ns.checkCondition(test.value, test.match, test.op)
 		if test.expect.isError {
@@ -5,7 +5,11 @@ import (
 
 	"github.com/gohugoio/hugo/common/types/css"
 	"github.com/gohugoio/hugo/deps"
+	"github.com/gohugoio/hugo/resources"
+	"github.com/gohugoio/hugo/resources/resource"
+	"github.com/gohugoio/hugo/resources/resource_transformers/js"
 	"github.com/gohugoio/hugo/tpl/internal"
+	"github.com/gohugoio/hugo/tpl/internal/resourcehelpers"
 	"github.com/spf13/cast"
 )
 
@@ -13,6 +17,33 @@ const name = "css"
 
 // Namespace provides template functions for the "css" namespace.
 type Namespace struct {
+	client *js.Client
+}
+
+// Build bundles the given CSS Resource and its imports with ESBuild.
+func (ns *Namespace) Build(args ...any) (resource.Resource, error) {
+	var (
+		r          resources.ResourceTransformer
+		m          map[string]any
+		targetPath string
+		err        error
+		ok         bool
+	)
+
+	r, targetPath, ok = resourcehelpers.ResolveIfFirstArgIsString(args)
+
+	if !ok {
+		r, m, err = resourcehelpers.ResolveArgs(args)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if targetPath != "" {
+		m = map[string]any{"targetPath": targetPath}
+	}
+
+	return ns.client.ProcessCSS(r, m)
 }
 
 // Quoted returns a string that needs to be quoted in CSS.
@@ -30,6 +61,9 @@ func (ns *Namespace) Unquoted(v any) css.UnquotedString {
 func init() {
 	f := func(d *deps.Deps) *internal.TemplateFuncsNamespace {
 		ctx := &Namespace{}
+		if d.ResourceSpec != nil {
+			ctx.client = js.New(d.BaseFs.Assets, d.ResourceSpec)
+		}
 
 		ns := &internal.TemplateFuncsNamespace{
 			Name:    name,
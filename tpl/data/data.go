@@ -17,6 +17,7 @@ package data
 
 import (
 	"bytes"
+	"context"
 	"encoding/csv"
 	"encoding/json"
 	"errors"
@@ -63,7 +64,7 @@ type Namespace struct {
 // The data separator can be a comma, semi-colon, pipe, etc, but only one character.
 // If you provide multiple parts for the URL they will be joined together to the final URL.
 // GetCSV returns nil or a slice slice to use in a short code.
-func (ns *Namespace) GetCSV(sep string, args ...any) (d [][]string, err error) {
+func (ns *Namespace) GetCSV(ctx context.Context, sep string, args ...any) (d [][]string, err error) {
 	url, headers := toURLAndHeaders(args)
 	cache := ns.cacheGetCSV
 
@@ -87,7 +88,7 @@ func (ns *Namespace) GetCSV(sep string, args ...any) (d [][]string, err error) {
 	addUserProvidedHeaders(headers, req)
 	addDefaultHeaders(req, "text/csv", "text/plain")
 
-	err = ns.getResource(cache, unmarshal, req)
+	err = ns.getResource(ctx, cache, unmarshal, req)
 	if err != nil {
 		if security.IsAccessDenied(err) {
 			return nil, err
@@ -102,7 +103,7 @@ func (ns *Namespace) GetCSV(sep string, args ...any) (d [][]string, err error) {
 // GetJSON expects one or n-parts of a URL in args to a resource which can either be a local or a remote one.
 // If you provide multiple parts they will be joined together to the final URL.
 // GetJSON returns nil or parsed JSON to use in a short code.
-func (ns *Namespace) GetJSON(args ...any) (any, error) {
+func (ns *Namespace) GetJSON(ctx context.Context, args ...any) (any, error) {
 	var v any
 	url, headers := toURLAndHeaders(args)
 	cache := ns.cacheGetJSON
@@ -123,7 +124,7 @@ func (ns *Namespace) GetJSON(args ...any) (any, error) {
 	addUserProvidedHeaders(headers, req)
 	addDefaultHeaders(req, "application/json")
 
-	err = ns.getResource(cache, unmarshal, req)
+	err = ns.getResource(ctx, cache, unmarshal, req)
 	if err != nil {
 		if security.IsAccessDenied(err) {
 			return nil, err
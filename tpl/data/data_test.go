@@ -15,6 +15,7 @@ package data
 
 import (
 	"bytes"
+	"context"
 	"html/template"
 	"net/http"
 	"net/http/httptest"
@@ -105,7 +106,7 @@ func TestGetCSV(t *testing.T) {
 			}
 
 			// Get on with it
-			got, err := ns.GetCSV(test.sep, test.url)
+			got, err := ns.GetCSV(context.Background(), test.sep, test.url)
 
 			if _, ok := test.expect.(bool); ok {
 				c.Assert(int(ns.deps.Log.LogCounters().ErrorCounter.Count()), qt.Equals, 1)
@@ -197,7 +198,7 @@ func TestGetJSON(t *testing.T) {
 			}
 
 			// Get on with it
-			got, _ := ns.GetJSON(test.url)
+			got, _ := ns.GetJSON(context.Background(), test.url)
 
 			if _, ok := test.expect.(bool); ok {
 				c.Assert(int(ns.deps.Log.LogCounters().ErrorCounter.Count()), qt.Equals, 1)
@@ -288,11 +289,11 @@ func TestHeaders(t *testing.T) {
 			}
 
 			testFunc(func(args ...any) error {
-				_, err := ns.GetJSON(args...)
+				_, err := ns.GetJSON(context.Background(), args...)
 				return err
 			})
 			testFunc(func(args ...any) error {
-				_, err := ns.GetCSV(",", args...)
+				_, err := ns.GetCSV(context.Background(), ",", args...)
 				return err
 			})
 
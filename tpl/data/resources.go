@@ -15,6 +15,7 @@ package data
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -25,6 +26,7 @@ import (
 	"github.com/gohugoio/hugo/cache/filecache"
 
 	"github.com/gohugoio/hugo/helpers"
+	"github.com/gohugoio/hugo/tpl"
 	"github.com/spf13/afero"
 )
 
@@ -34,12 +36,13 @@ var (
 )
 
 // getRemote loads the content of a remote file. This method is thread safe.
-func (ns *Namespace) getRemote(cache *filecache.Cache, unmarshal func([]byte) (bool, error), req *http.Request) error {
+func (ns *Namespace) getRemote(ctx context.Context, cache *filecache.Cache, unmarshal func([]byte) (bool, error), req *http.Request) error {
 	url := req.URL.String()
-	if err := ns.deps.ExecHelper.Sec().CheckAllowedHTTPURL(url); err != nil {
+	sc := tpl.SecurityFromContext(ctx, ns.deps.ExecHelper.Sec())
+	if err := sc.CheckAllowedHTTPURL(url); err != nil {
 		return err
 	}
-	if err := ns.deps.ExecHelper.Sec().CheckAllowedHTTPMethod("GET"); err != nil {
+	if err := sc.CheckAllowedHTTPMethod("GET"); err != nil {
 		return err
 	}
 
@@ -106,21 +109,27 @@ func getLocal(workingDir, url string, fs afero.Fs) ([]byte, error) {
 
 // getResource loads the content of a local or remote file and returns its content and the
 // cache ID used, if relevant.
-func (ns *Namespace) getResource(cache *filecache.Cache, unmarshal func(b []byte) (bool, error), req *http.Request) error {
+func (ns *Namespace) getResource(ctx context.Context, cache *filecache.Cache, unmarshal func(b []byte) (bool, error), req *http.Request) error {
 	switch req.URL.Scheme {
 	case "":
 		url, err := url.QueryUnescape(req.URL.String())
 		if err != nil {
 			return err
 		}
-		b, err := getLocal(ns.deps.Conf.BaseConfig().WorkingDir, url, ns.deps.Fs.Source)
+		var b []byte
+		if ns.deps.Conf.Safe() {
+			// Only allow reading files inside the mounts.
+			b, err = getLocal("", url, ns.deps.PathSpec.BaseFs.Mounts)
+		} else {
+			b, err = getLocal(ns.deps.Conf.BaseConfig().WorkingDir, url, ns.deps.Fs.Source)
+		}
 		if err != nil {
 			return err
 		}
 		_, err = unmarshal(b)
 		return err
 	default:
-		return ns.getRemote(cache, unmarshal, req)
+		return ns.getRemote(ctx, cache, unmarshal, req)
 	}
 }
 
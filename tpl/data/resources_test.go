@@ -15,6 +15,7 @@ package data
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -121,7 +122,7 @@ func TestScpGetRemote(t *testing.T) {
 			return false, nil
 		}
 
-		err = ns.getRemote(cache, f, req)
+		err = ns.getRemote(context.Background(), cache, f, req)
 		c.Assert(err, qt.IsNil, msg)
 		c.Assert(string(cb), qt.Equals, string(test.content))
 
@@ -164,7 +165,7 @@ func TestScpGetRemoteParallel(t *testing.T) {
 						cb = b
 						return false, nil
 					}
-					err := ns.getRemote(ns.cacheGetJSON, f, req)
+					err := ns.getRemote(context.Background(), ns.cacheGetJSON, f, req)
 
 					c.Assert(err, qt.IsNil)
 					if string(content) != string(cb) {
@@ -0,0 +1,113 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagrams_test
+
+import (
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/common/hexec"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+// We use cat as the diagram engine, which writes the SVG in the code block as is.
+const catFiles = `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "section", "rss", "sitemap"]
+[security.exec]
+allow = ['^cat$']
+[markup.diagrams.languages.svg]
+command = "cat"
+-- layouts/_default/single.html --
+{{ .Content }}
+-- layouts/index.html --
+{{ with diagrams.Render "svg" "<?xml version=\"1.0\"?>\n<svg xmlns=\"http://www.w3.org/2000/svg\" viewBox=\"0 0 120.5 60\"><rect/></svg>\n" }}
+Width: {{ .Width }}|Height: {{ .Height }}|Inner: {{ .Inner }}|Wrapped: {{ .Wrapped }}|
+{{ end }}
+{{ with diagrams.Render "goat" "--->" }}Goat: {{ .Width }}|{{ end }}
+-- content/p1.md --
+---
+title: "p1"
+---
+
+§§§svg {class="wide"}
+<svg width="200px" height="100" viewBox="0 0 20 10"><circle/></svg>
+§§§
+
+§§§go
+fmt.Println("Hello")
+§§§
+`
+
+func TestRenderExternalEngine(t *testing.T) {
+	if !hexec.InPath("cat") {
+		t.Skip("cat is not installed")
+	}
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.ReplaceAll(catFiles, "§§§", "```"),
+			NeedsOsFS:   true,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html",
+		"Width: 121|Height: 60|",
+		"Inner: <rect/>|",
+		`Wrapped: <svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 120.5 60"><rect/></svg>|`,
+		"Goat: 40|",
+	)
+	b.AssertFileContent("public/p1/index.html",
+		`<div class="diagram diagram-svg wide">`,
+		`<svg width="200px" height="100" viewBox="0 0 20 10"><circle/></svg>`,
+		`<div class="highlight">`,
+	)
+	b.Assert(b.FileContent("public/index.html"), qt.Not(qt.Contains), "<?xml")
+}
+
+func TestRenderExternalEngineNotAllowed(t *testing.T) {
+	files := strings.ReplaceAll(catFiles, "allow = ['^cat$']", "allow = ['^go$']")
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.ReplaceAll(files, "§§§", "```"),
+			NeedsOsFS:   true,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `access denied: "cat" is not whitelisted in policy "security.exec.allow"`)
+}
+
+func TestRenderNoEngine(t *testing.T) {
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "section", "page", "rss", "sitemap"]
+-- layouts/index.html --
+{{ diagrams.Render "mermaid" "graph TD; A-->B" }}
+`
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `no engine configured for "mermaid"`)
+}
@@ -0,0 +1,172 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagrams
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/hexec"
+	"github.com/gohugoio/hugo/helpers"
+	"github.com/gohugoio/hugo/markup/diagrams"
+	"github.com/spf13/cast"
+)
+
+type svgDiagram struct {
+	svg    []byte
+	inner  []byte
+	width  int
+	height int
+}
+
+func (d svgDiagram) Inner() template.HTML {
+	return template.HTML(d.inner)
+}
+
+func (d svgDiagram) Wrapped() template.HTML {
+	return template.HTML(d.svg)
+}
+
+func (d svgDiagram) Width() int {
+	return d.width
+}
+
+func (d svgDiagram) Height() int {
+	return d.height
+}
+
+// Render renders the diagram source v written in lang to SVG.
+// The goat language is rendered by the embedded engine, any other
+// language with the external command configured in markup.diagrams.
+// The result of external commands is cached in the assets file cache.
+func (d *Namespace) Render(lang string, v any) (SVGDiagram, error) {
+	src, err := cast.ToStringE(v)
+	if err != nil {
+		return nil, err
+	}
+
+	lang = strings.ToLower(lang)
+	engine, found := d.d.ContentSpec.Converters.GetMarkupConfig().Diagrams.Engine(lang)
+	if !found {
+		if lang == diagrams.EngineGoat {
+			return d.Goat(src), nil
+		}
+		return nil, fmt.Errorf("diagrams.Render: no engine configured for %q in markup.diagrams.languages", lang)
+	}
+
+	key := fmt.Sprintf("diagrams/%s_%s.svg", lang, helpers.MD5String(fmt.Sprint(engine.Command, engine.Args, src)))
+
+	_, b, err := d.d.ResourceSpec.FileCaches.AssetsCache().GetOrCreateBytes(key, func() ([]byte, error) {
+		return d.runEngine(lang, engine, src)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return parseSVG(b)
+}
+
+func (d *Namespace) runEngine(lang string, engine diagrams.Engine, src string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	args := make([]any, 0, len(engine.Args)+4)
+	for _, arg := range engine.Args {
+		args = append(args, arg)
+	}
+	args = append(args,
+		hexec.WithDir(d.d.Conf.BaseConfig().WorkingDir),
+		hexec.WithStdin(strings.NewReader(src)),
+		hexec.WithStdout(&stdout),
+		hexec.WithStderr(&stderr),
+	)
+
+	cmd, err := d.d.ExecHelper.New(engine.Command, args...)
+	if err == nil {
+		err = cmd.Run()
+	}
+	if err != nil {
+		if hexec.IsNotFound(err) {
+			return nil, fmt.Errorf("diagrams.Render: %s: the command %q is not installed and the diagram is not in the file cache", lang, engine.Command)
+		}
+		if s := strings.TrimSpace(stderr.String()); s != "" {
+			return nil, fmt.Errorf("diagrams.Render: %s: %s: %w", lang, s, err)
+		}
+		return nil, fmt.Errorf("diagrams.Render: %s: %w", lang, err)
+	}
+
+	if s := strings.TrimSpace(stderr.String()); s != "" {
+		d.d.Log.Infof("diagrams: %s: %s", engine.Command, s)
+	}
+
+	return stdout.Bytes(), nil
+}
+
+var (
+	svgStartRe   = regexp.MustCompile(`(?s)<svg\b[^>]*>`)
+	svgAttrRe    = regexp.MustCompile(`\s(width|height|viewBox)\s*=\s*["']([^"']*)["']`)
+	errSVGFormat = errors.New("diagrams.Render: the engine did not produce an SVG")
+)
+
+// parseSVG extracts the svg element and its dimensions from b,
+// skipping any XML declaration, DOCTYPE or comments before it.
+func parseSVG(b []byte) (svgDiagram, error) {
+	loc := svgStartRe.FindIndex(b)
+	end := bytes.LastIndex(b, []byte("</svg>"))
+	if loc == nil || end < loc[1] {
+		return svgDiagram{}, errSVGFormat
+	}
+
+	d := svgDiagram{
+		svg:   b[loc[0] : end+len("</svg>")],
+		inner: bytes.TrimSpace(b[loc[1]:end]),
+	}
+
+	var viewBoxWidth, viewBoxHeight int
+	for _, m := range svgAttrRe.FindAllSubmatch(b[loc[0]:loc[1]], -1) {
+		switch string(m[1]) {
+		case "width":
+			d.width = parseLength(string(m[2]))
+		case "height":
+			d.height = parseLength(string(m[2]))
+		case "viewBox":
+			if fields := strings.Fields(strings.ReplaceAll(string(m[2]), ",", " ")); len(fields) == 4 {
+				viewBoxWidth = parseLength(fields[2])
+				viewBoxHeight = parseLength(fields[3])
+			}
+		}
+	}
+
+	// Relative sizes, e.g. 100%, are no use to the caller.
+	if d.width == 0 || d.height == 0 {
+		d.width, d.height = viewBoxWidth, viewBoxHeight
+	}
+
+	return d, nil
+}
+
+// parseLength parses an SVG length, e.g. 200, 200.5 or 200px, and returns 0 for
+// lengths with other units.
+func parseLength(s string) int {
+	s = strings.TrimSuffix(strings.TrimSpace(s), "px")
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return int(math.Ceil(f))
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hugo_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gohugoio/hugo/common/hugo"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestCapabilities(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "page", "section", "RSS", "sitemap", "robotsTXT", "404"]
+-- layouts/index.html --
+Extended: {{ hugo.Capabilities.extended }}|
+Dart Sass: {{ hugo.Capabilities.dartsass }}|
+{{ with hugo.Capabilities }}{{ if not .asciidoctor }}No Asciidoctor.{{ else }}Asciidoctor.{{ end }}{{ end }}
+Keys: {{ range $k, $v := hugo.Capabilities }}{{ $k }}|{{ end }}
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	capabilities := hugo.HugoInfo{}.Capabilities()
+	asciidoctor := "No Asciidoctor."
+	if capabilities["asciidoctor"] {
+		asciidoctor = "Asciidoctor."
+	}
+
+	b.AssertFileContent("public/index.html",
+		fmt.Sprintf("Extended: %t|", hugo.IsExtended),
+		fmt.Sprintf("Dart Sass: %t|", capabilities["dartsass"]),
+		asciidoctor,
+		"Keys: asciidoctor|dartsass|extended|git|",
+	)
+}
@@ -21,6 +21,7 @@ import (
 	"errors"
 
 	"github.com/gohugoio/hugo/resources/images"
+	"github.com/gohugoio/hugo/resources/resource_factories/create"
 
 	// Importing image codecs for image.DecodeConfig
 	_ "image/gif"
@@ -36,10 +37,16 @@ import (
 
 // New returns a new instance of the images-namespaced template functions.
 func New(deps *deps.Deps) *Namespace {
+	var createClient *create.Client
+	if deps.ResourceSpec != nil {
+		createClient = create.New(deps.ResourceSpec)
+	}
+
 	return &Namespace{
-		Filters: &images.Filters{},
-		cache:   map[string]image.Config{},
-		deps:    deps,
+		Filters:      &images.Filters{},
+		cache:        map[string]image.Config{},
+		deps:         deps,
+		createClient: createClient,
 	}
 }
 
@@ -49,7 +56,8 @@ type Namespace struct {
 	cacheMu sync.RWMutex
 	cache   map[string]image.Config
 
-	deps *deps.Deps
+	deps         *deps.Deps
+	createClient *create.Client
 }
 
 // Config returns the image.Config for the specified path relative to the
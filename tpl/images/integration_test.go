@@ -0,0 +1,91 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package images_test
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestQR(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "page", "section", "rss", "sitemap"]
+-- layouts/index.html --
+{{ $png := images.QR "https://gohugo.io" }}
+PNG: {{ $png.RelPermalink }}|{{ $png.MediaType }}|{{ $png.Width }}x{{ $png.Height }}|
+{{ $small := $png.Resize "66x" }}
+Resized: {{ $small.Width }}|
+{{ $high := images.QR "https://gohugo.io" (dict "level" "high" "scale" 2 "targetDir" "codes") }}
+High: {{ $high.RelPermalink }}|{{ $high.Width }}|
+{{ $svg := images.QR "WIFI:S:MyNetwork;T:WPA;P:secret;;" (dict "format" "svg") }}
+SVG: {{ $svg.RelPermalink }}|{{ $svg.MediaType.SubType }}|
+{{ $svg.Content | safeHTML }}
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html",
+		"|image/png|132x132|",
+		"Resized: 66|",
+		"High: /codes/qr_",
+		".png|74|",
+		"|svg|",
+		`<svg xmlns="http://www.w3.org/2000/svg" width="148" height="148" viewBox="0 0 37 37"`,
+		`<path fill="#000" d="M4 4h7v1h-7z`,
+	)
+
+	content := b.FileContent("public/index.html")
+	b.Assert(content, qt.Matches, `(?s).*PNG: /qr_\d+\.png\|.*`)
+	b.Assert(content, qt.Matches, `(?s).*SVG: /qr_\d+\.svg\|.*`)
+}
+
+func TestQRErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		args   string
+		expect string
+	}{
+		{`""`, "text must not be empty"},
+		{`"foo" (dict "level" "ultra")`, `invalid level "ultra"`},
+		{`"foo" (dict "format" "gif")`, `invalid format "gif"`},
+		{`"foo" (dict "scale" 0)`, `invalid scale 0`},
+	} {
+		files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "page", "section", "rss", "sitemap"]
+-- layouts/index.html --
+{{ $qr := images.QR ` + test.args + ` }}{{ $qr.RelPermalink }}
+`
+		b, err := hugolib.NewIntegrationTestBuilder(
+			hugolib.IntegrationTestConfig{
+				T:           t,
+				TxtarString: files,
+			},
+		).BuildE()
+
+		b.Assert(err, qt.IsNotNil)
+		b.Assert(err.Error(), qt.Contains, test.expect)
+	}
+}
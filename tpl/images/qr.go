@@ -0,0 +1,151 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package images
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/identity"
+	"github.com/gohugoio/hugo/resources/resource"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/cast"
+	"rsc.io/qr"
+)
+
+// The number of modules in the quiet zone around the QR code.
+// This matches the border in the PNG encoding in the qr package.
+const qrQuietZone = 4
+
+type qrOptions struct {
+	// The error correction level, one of low, medium, quartile or high.
+	// Higher levels make the code more robust, but also bigger.
+	Level string
+
+	// The size of each module (square) in pixels.
+	Scale int
+
+	// The image format, png or svg.
+	Format string
+
+	// The directory to publish the image to, relative to the publish dir.
+	TargetDir string
+}
+
+var qrLevels = map[string]qr.Level{
+	"low":      qr.L,
+	"medium":   qr.M,
+	"quartile": qr.Q,
+	"high":     qr.H,
+}
+
+func decodeQROptions(args []any) (qrOptions, error) {
+	opts := qrOptions{
+		Level:  "medium",
+		Scale:  4,
+		Format: "png",
+	}
+	if len(args) == 0 {
+		return opts, nil
+	}
+	if len(args) > 1 {
+		return opts, errors.New("too many arguments")
+	}
+	m, err := maps.ToStringMapE(args[0])
+	if err != nil {
+		return opts, err
+	}
+	if err := mapstructure.WeakDecode(m, &opts); err != nil {
+		return opts, err
+	}
+
+	opts.Level = strings.ToLower(opts.Level)
+	if _, found := qrLevels[opts.Level]; !found {
+		return opts, fmt.Errorf("invalid level %q, must be one of low, medium, quartile or high", opts.Level)
+	}
+	opts.Format = strings.ToLower(opts.Format)
+	if opts.Format != "png" && opts.Format != "svg" {
+		return opts, fmt.Errorf("invalid format %q, must be png or svg", opts.Format)
+	}
+	if opts.Scale < 1 {
+		return opts, fmt.Errorf("invalid scale %d, must be 1 or more", opts.Scale)
+	}
+
+	return opts, nil
+}
+
+// QR encodes text as a QR code and returns it as an image resource.
+// The optional options map supports level (low, medium, quartile or high;
+// default medium), scale (pixels per module; default 4), format (png or svg;
+// default png) and targetDir.
+func (ns *Namespace) QR(text any, options ...any) (resource.Resource, error) {
+	s, err := cast.ToStringE(text)
+	if err != nil {
+		return nil, err
+	}
+	if s == "" {
+		return nil, errors.New("images.QR: text must not be empty")
+	}
+
+	opts, err := decodeQROptions(options)
+	if err != nil {
+		return nil, fmt.Errorf("images.QR: %w", err)
+	}
+
+	code, err := qr.Encode(s, qrLevels[opts.Level])
+	if err != nil {
+		return nil, fmt.Errorf("images.QR: %w", err)
+	}
+	code.Scale = opts.Scale
+
+	var content string
+	if opts.Format == "svg" {
+		content = qrSVG(code)
+	} else {
+		content = string(code.PNG())
+	}
+
+	targetPath := path.Join(opts.TargetDir, fmt.Sprintf("qr_%s.%s", identity.HashString(s, opts), opts.Format))
+
+	return ns.createClient.FromString(targetPath, content)
+}
+
+// qrSVG renders code as an SVG image with one path, with the same dimensions
+// as the PNG encoding.
+func qrSVG(code *qr.Code) string {
+	size := (code.Size + 2*qrQuietZone) * code.Scale
+
+	var d strings.Builder
+	for y := 0; y < code.Size; y++ {
+		for x := 0; x < code.Size; x++ {
+			if !code.Black(x, y) {
+				continue
+			}
+			// Draw runs of black modules as one rectangle.
+			start := x
+			for x+1 < code.Size && code.Black(x+1, y) {
+				x++
+			}
+			fmt.Fprintf(&d, "M%d %dh%dv1h-%dz", start+qrQuietZone, y+qrQuietZone, x-start+1, x-start+1)
+		}
+	}
+
+	viewBox := code.Size + 2*qrQuietZone
+
+	return fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges"><rect width="100%%" height="100%%" fill="#fff"/><path fill="#000" d="%s"/></svg>`,
+		size, size, viewBox, viewBox, d.String())
+}
@@ -60,9 +60,10 @@ func NewExecuter(helper ExecHelper) Executer {
 }
 
 type (
-	pageContextKeyType    string
-	hasLockContextKeyType string
-	stackContextKeyType   string
+	pageContextKeyType          string
+	hasLockContextKeyType       string
+	stackContextKeyType         string
+	cachedPartialContextKeyType string
 )
 
 const (
@@ -70,6 +71,10 @@ const (
 	PageContextKey = pageContextKeyType("page")
 	// Used in partialCached to signal to nested templates that a lock is already taken.
 	HasLockContextKey = hasLockContextKeyType("hasLock")
+	// Used to track the templates executing for a page render.
+	StackContextKey = stackContextKeyType("stack")
+	// Used in partialCached to signal that the result may be shared by several pages.
+	CachedPartialContextKey = cachedPartialContextKeyType("cachedPartial")
 )
 
 // Note: The context is currently not fully implemented in Hugo. This is a work in progress.
@@ -90,6 +95,7 @@ func (t *executer) ExecuteWithContext(ctx context.Context, p Preparer, wr io.Wri
 
 	state := &state{
 		ctx:    ctx,
+		done:   ctx.Done(),
 		helper: t.helper,
 		prep:   p,
 		tmpl:   tmpl,
@@ -124,6 +130,7 @@ func (t *Template) executeWithState(state *state, value reflect.Value) (err erro
 type state struct {
 	tmpl   *Template
 	ctx    context.Context // Added for Hugo. The original data context.
+	done   <-chan struct{} // Added for Hugo. Closed when ctx is cancelled, e.g. on render timeout.
 	prep   Preparer        // Added for Hugo.
 	helper ExecHelper      // Added for Hugo.
 	wr     io.Writer
@@ -132,8 +139,22 @@ type state struct {
 	depth  int        // the height of the stack of executing templates.
 }
 
+// checkDone aborts the execution if the context has been cancelled.
+// Added for Hugo.
+func (s *state) checkDone() {
+	if s.done == nil {
+		return
+	}
+	select {
+	case <-s.done:
+		s.errorf("%w", context.Cause(s.ctx))
+	default:
+	}
+}
+
 func (s *state) evalFunction(dot reflect.Value, node *parse.IdentifierNode, cmd parse.Node, args []parse.Node, final reflect.Value) reflect.Value {
 	s.at(node)
+	s.checkDone()
 	name := node.Ident
 
 	var function reflect.Value
@@ -186,6 +207,7 @@ func (s *state) evalField(dot reflect.Value, fieldName string, node parse.Node,
 	}
 
 	// Added for Hugo.
+	s.checkDone()
 	var first reflect.Value
 	var method reflect.Value
 	if s.helper != nil {
@@ -75,3 +75,37 @@ func TestReadFileNotExists(t *testing.T) {
 OK
 `)
 }
+
+func TestReadDirReadFileSafe(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+safe = true
+-- myproject.txt --
+Hello project!
+-- data/mydata.txt --
+Hello data!
+-- layouts/index.html --
+{{ $entries := (readDir "data") }}
+START:|{{ range $entry := $entries }}{{ $entry.Name }}|{{ end }}:END:
+Project: {{ readFile "myproject.txt" }}|
+Data: {{ readFile "data/mydata.txt" }}|
+Outside: {{ readFile "../myproject.txt" }}|
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			NeedsOsFS:   true,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html", `
+START:|mydata.txt|:END:
+Project: |
+Data: Hello data!|
+Outside: |
+`)
+}
@@ -16,6 +16,7 @@
 package os
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	_os "os"
@@ -24,6 +25,7 @@ import (
 	"github.com/bep/overlayfs"
 	"github.com/gohugoio/hugo/common/herrors"
 	"github.com/gohugoio/hugo/deps"
+	"github.com/gohugoio/hugo/tpl"
 	"github.com/spf13/afero"
 	"github.com/spf13/cast"
 )
@@ -34,14 +36,20 @@ func New(d *deps.Deps) *Namespace {
 
 	// The docshelper script does not have or need all the dependencies set up.
 	if d.PathSpec != nil {
+		baseFs := d.PathSpec.BaseFs.Work
+		// See #9599
+		workFs = d.PathSpec.BaseFs.WorkDir
+		if d.PathSpec.Cfg.Safe() {
+			// Restrict reads to the mounted directories.
+			baseFs = d.PathSpec.BaseFs.Mounts
+			workFs = d.PathSpec.BaseFs.Mounts
+		}
 		readFileFs = overlayfs.New(overlayfs.Options{
 			Fss: []afero.Fs{
-				d.PathSpec.BaseFs.Work,
+				baseFs,
 				d.PathSpec.BaseFs.Content.Fs,
 			},
 		})
-		// See #9599
-		workFs = d.PathSpec.BaseFs.WorkDir
 	}
 
 	return &Namespace{
@@ -60,13 +68,13 @@ type Namespace struct {
 
 // Getenv retrieves the value of the environment variable named by the key.
 // It returns the value, which will be empty if the variable is not present.
-func (ns *Namespace) Getenv(key any) (string, error) {
+func (ns *Namespace) Getenv(ctx context.Context, key any) (string, error) {
 	skey, err := cast.ToStringE(key)
 	if err != nil {
 		return "", nil
 	}
 
-	if err = ns.deps.ExecHelper.Sec().CheckAllowedGetEnv(skey); err != nil {
+	if err = tpl.SecurityFromContext(ctx, ns.deps.ExecHelper.Sec()).CheckAllowedGetEnv(skey); err != nil {
 		return "", err
 	}
 
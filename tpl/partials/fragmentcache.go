@@ -0,0 +1,146 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package partials
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bep/lazycache"
+	"github.com/gobwas/glob"
+	"github.com/gohugoio/hugo/common/htime"
+	"github.com/gohugoio/hugo/common/maps"
+	hglob "github.com/gohugoio/hugo/hugofs/glob"
+	"github.com/gohugoio/hugo/identity"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/cast"
+)
+
+// fragmentCache is the cache used by the cache template func.
+// Unlike the partialCached cache it is kept between rebuilds in server mode.
+// Entries are evicted when they expire or when a source file they depend on
+// changes.
+// Template changes recreate the template funcs, and with them this cache.
+type fragmentCache struct {
+	cache *lazycache.Cache[string, fragmentCacheEntry]
+}
+
+type fragmentCacheEntry struct {
+	result includeResult
+
+	// Zero if the entry never expires.
+	expires time.Time
+
+	dependsOn []glob.Glob
+}
+
+func (e fragmentCacheEntry) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
+
+// invalidate evicts the entries depending on any of the given changed paths,
+// see deps.BuildState.ChangedPaths.
+func (c *fragmentCache) invalidate(changed []string) {
+	if len(changed) == 0 {
+		return
+	}
+	c.cache.DeleteFunc(func(_ string, e fragmentCacheEntry) bool {
+		for _, g := range e.dependsOn {
+			for _, p := range changed {
+				if g.Match(p) {
+					return true
+				}
+			}
+		}
+		return false
+	})
+}
+
+type fragmentCacheOptions struct {
+	// The cache key. Defaults to the partial name.
+	Key any
+
+	// How long to keep the result, e.g. "10m". Zero means until a
+	// dependency or a template changes.
+	TTL any
+
+	// Glob patterns matching the source files the result depends on,
+	// relative to the project, e.g. "data/weather/**" or "assets/*.json".
+	DependsOn []string
+}
+
+// IncludeFragment executes and caches the partial template with the given
+// name, keeping the result between rebuilds in server mode until it expires or
+// its dependencies change.
+// The options are a map with key, ttl and dependsOn, e.g.
+// (dict "key" "weather" "ttl" "10m" "dependsOn" (slice "data/weather/**")).
+// Note that ctx is provided by Hugo, not the end user.
+func (ns *Namespace) IncludeFragment(ctx context.Context, name string, context any, options ...any) (any, error) {
+	opts := fragmentCacheOptions{}
+	if len(options) > 1 {
+		return nil, fmt.Errorf("cache: expected at most one options map, got %d arguments", len(options))
+	}
+	if len(options) == 1 {
+		m, err := maps.ToStringMapE(options[0])
+		if err != nil {
+			return nil, fmt.Errorf("cache: options must be a map: %w", err)
+		}
+		if err := mapstructure.WeakDecode(m, &opts); err != nil {
+			return nil, fmt.Errorf("cache: failed to decode options: %w", err)
+		}
+	}
+
+	var ttl time.Duration
+	if opts.TTL != nil {
+		var err error
+		if ttl, err = cast.ToDurationE(opts.TTL); err != nil {
+			return nil, fmt.Errorf("cache: invalid ttl %v: %w", opts.TTL, err)
+		}
+	}
+
+	var dependsOn []glob.Glob
+	for _, pattern := range opts.DependsOn {
+		g, err := hglob.GetGlob(hglob.NormalizePath(pattern))
+		if err != nil {
+			return nil, fmt.Errorf("cache: invalid dependsOn pattern %q: %w", pattern, err)
+		}
+		dependsOn = append(dependsOn, g)
+	}
+
+	key := strings.TrimPrefix(name, "partials/")
+	if opts.Key != nil {
+		key = identity.HashString(key, opts.Key)
+	}
+
+	now := htime.Now()
+	if e, found := ns.fragments.cache.Get(key); found && e.expired(now) {
+		ns.fragments.cache.Delete(key)
+	}
+
+	e, _, err := ns.fragments.cache.GetOrCreate(key, func(string) (fragmentCacheEntry, error) {
+		r := ns.includWithTimeout(ctx, name, context)
+		e := fragmentCacheEntry{result: r, dependsOn: dependsOn}
+		if ttl > 0 {
+			e.expires = now.Add(ttl)
+		}
+		return e, r.err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return e.result.result, nil
+}
@@ -50,6 +50,11 @@ func init() {
 			[][2]string{},
 		)
 
+		ns.AddMethodMapping(ctx.IncludeFragment,
+			[]string{"cache"},
+			[][2]string{},
+		)
+
 		return ns
 	}
 
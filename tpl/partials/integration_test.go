@@ -15,8 +15,8 @@ package partials_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
-	"regexp"
 	"sort"
 	"strings"
 	"testing"
@@ -194,19 +194,18 @@ D1
 
 	got := buf.String()
 
-	// Get rid of all the durations, they are never the same.
-	durationRe := regexp.MustCompile(`\b[\.\d]*(ms|µs|s)\b`)
-
 	normalize := func(s string) string {
-		s = durationRe.ReplaceAllString(s, "")
+		// Skip the page kind and output format table.
+		s, _, _ = strings.Cut(s, "\n\n")
 		linesIn := strings.Split(s, "\n")[3:]
 		var lines []string
 		for _, l := range linesIn {
-			l = strings.TrimSpace(l)
-			if l == "" {
+			fields := strings.Fields(l)
+			if len(fields) == 0 {
 				continue
 			}
-			lines = append(lines, l)
+			// Get rid of the durations, they are never the same.
+			lines = append(lines, strings.Join(fields[3:], " "))
 		}
 
 		sort.Strings(lines)
@@ -217,16 +216,81 @@ D1
 	got = normalize(got)
 
 	expect := `
-	0        0       0      1  index.html
-	100        0       0      1  partials/static2.html
-	100       50       1      2  partials/static1.html
-	25       50       2      4  partials/dynamic1.html
-	66       33       1      3  partials/halfdynamic1.html
+	0 0 0 0 1 index.html
+	100 0 0 1 1 partials/static2.html
+	100 50 1 1 2 partials/static1.html
+	25 50 2 1 4 partials/dynamic1.html
+	66 33 1 1 3 partials/halfdynamic1.html
 	`
 
 	b.Assert(got, hqt.IsSameString, expect)
 }
 
+func TestIncludeCachedMetricsJSON(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = 'http://example.com/'
+templateMetrics = true
+disableKinds = ["section", "taxonomy", "term", "sitemap", "rss"]
+-- content/p1.md --
+-- content/p2.md --
+-- layouts/index.html --
+{{ partialCached "menu.html" . }}
+{{ partial "footer.html" . }}
+-- layouts/_default/single.html --
+{{ partialCached "menu.html" . }}
+{{ partialCached "menu.html" . }}
+{{ partial "footer.html" . }}
+-- layouts/partials/menu.html --
+{{ $s := slice }}{{ range seq 10 }}{{ $s = $s | append (printf "item-%d" .) }}{{ end }}{{ delimit $s ", " }}
+-- layouts/partials/footer.html --
+Footer
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	var buf bytes.Buffer
+	b.Assert(b.H.Metrics.WriteMetricsJSON(&buf), qt.IsNil)
+
+	var m struct {
+		Templates []struct {
+			Template      string
+			Count         int
+			CachedCount   int
+			CacheLookups  int
+			CacheHitRatio *float64
+			Callers       map[string]int
+		}
+	}
+	b.Assert(json.Unmarshal(buf.Bytes(), &m), qt.IsNil)
+
+	templates := make(map[string]int)
+	for i, tt := range m.Templates {
+		templates[tt.Template] = i
+	}
+
+	menu := m.Templates[templates["partials/menu.html"]]
+	b.Assert(menu.Count, qt.Equals, 5)
+	b.Assert(menu.CacheLookups, qt.Equals, 5)
+	b.Assert(menu.CachedCount, qt.Equals, 4)
+	b.Assert(*menu.CacheHitRatio, qt.Equals, 0.8)
+	b.Assert(menu.Callers, qt.DeepEquals, map[string]int{"index.html": 1, "_default/single.html": 4})
+
+	footer := m.Templates[templates["partials/footer.html"]]
+	b.Assert(footer.CacheLookups, qt.Equals, 0)
+	b.Assert(footer.CacheHitRatio, qt.IsNil)
+	b.Assert(footer.Callers, qt.DeepEquals, map[string]int{"index.html": 1, "_default/single.html": 2})
+
+	b.Assert(m.Templates[templates["index.html"]].Callers, qt.DeepEquals, map[string]int{})
+}
+
 // gobench --package ./tpl/partials
 func BenchmarkIncludeCached(b *testing.B) {
 	files := `
@@ -352,3 +416,64 @@ BAR
 	b.AssertFileContent("public/index.html", "OO:BAR")
 
 }
+
+func TestIncludeFragmentCache(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = 'http://example.com/'
+disableKinds = ["taxonomy", "term", "page", "section", "sitemap", "RSS"]
+-- data/weather.toml --
+temp = 10
+-- data/other.toml --
+v = "v1"
+-- layouts/index.html --
+Weather: {{ cache "weather.html" . (dict "key" "weather" "dependsOn" (slice "data/weather.*")) }}|
+Static: {{ cache "other.html" . (dict "key" "static") }}|
+Expiring: {{ cache "other.html" . (dict "key" "expiring" "ttl" "1ns") }}|
+-- layouts/partials/weather.html --
+{{ site.Data.weather.temp }}
+-- layouts/partials/other.html --
+{{ site.Data.other.v }}
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			Running:     true,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html", "Weather: 10|", "Static: v1|", "Expiring: v1|")
+
+	b.EditFileReplace("data/other.toml", func(s string) string { return strings.Replace(s, "v1", "v2", 1) }).Build()
+	b.AssertFileContent("public/index.html", "Weather: 10|", "Static: v1|", "Expiring: v2|")
+
+	b.EditFileReplace("data/weather.toml", func(s string) string { return strings.Replace(s, "10", "12", 1) }).Build()
+	b.AssertFileContent("public/index.html", "Weather: 12|", "Static: v1|", "Expiring: v2|")
+}
+
+func TestIncludeFragmentCacheInvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = 'http://example.com/'
+-- layouts/index.html --
+{{ cache "foo.html" . (dict "ttl" "forever") }}
+-- layouts/partials/foo.html --
+foo
+`
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `cache: invalid ttl forever`)
+}
@@ -21,7 +21,6 @@ import (
 	"html/template"
 	"io"
 	"strings"
-	"time"
 
 	"github.com/bep/lazycache"
 
@@ -78,14 +77,20 @@ func New(deps *deps.Deps) *Namespace {
 	lru := lazycache.New[string, includeResult](lazycache.Options{MaxEntries: 1000})
 
 	cache := &partialCache{cache: lru}
+
+	// The fragment cache survives rebuilds in server mode.
+	fragments := &fragmentCache{cache: lazycache.New[string, fragmentCacheEntry](lazycache.Options{MaxEntries: 1000})}
+
 	deps.BuildStartListeners.Add(
 		func() {
 			cache.clear()
+			fragments.invalidate(deps.BuildState.ChangedPaths())
 		})
 
 	return &Namespace{
 		deps:           deps,
 		cachedPartials: cache,
+		fragments:      fragments,
 	}
 }
 
@@ -93,6 +98,7 @@ func New(deps *deps.Deps) *Namespace {
 type Namespace struct {
 	deps           *deps.Deps
 	cachedPartials *partialCache
+	fragments      *fragmentCache
 }
 
 // contextWrapper makes room for a return value in a partial invocation.
@@ -221,14 +227,22 @@ func (ns *Namespace) include(ctx context.Context, name string, dataList ...any)
 // IncludeCached executes and caches partial templates.  The cache is created with name+variants as the key.
 // Note that ctx is provided by Hugo, not the end user.
 func (ns *Namespace) IncludeCached(ctx context.Context, name string, context any, variants ...any) (any, error) {
-	start := time.Now()
 	key := partialCacheKey{
 		Name:     name,
 		Variants: variants,
 	}
 
+	var measure func()
+	if ns.deps.Metrics != nil {
+		var caller string
+		if stack := tpl.GetRenderStackFromContext(ctx); stack != nil {
+			caller = stack.Top()
+		}
+		measure = ns.deps.Metrics.MeasureTemplate(key.templateName(), caller)
+	}
+
 	r, found, err := ns.cachedPartials.cache.GetOrCreate(key.Key(), func(string) (includeResult, error) {
-		r := ns.includWithTimeout(ctx, key.Name, context)
+		r := ns.includWithTimeout(tpl.SetIsInCachedPartialInContext(ctx, true), key.Name, context)
 		return r, r.err
 	})
 
@@ -241,9 +255,9 @@ func (ns *Namespace) IncludeCached(ctx context.Context, name string, context any
 			// The templates that gets executed is measured in Execute.
 			// We need to track the time spent in the cache to
 			// get the totals correct.
-			ns.deps.Metrics.MeasureSince(key.templateName(), start)
-
+			measure()
 		}
+		ns.deps.Metrics.TrackCacheLookup(key.templateName())
 		ns.deps.Metrics.TrackValue(key.templateName(), r.result, found)
 	}
 
@@ -0,0 +1,167 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tpl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gohugoio/hugo/common/text"
+	texttemplate "github.com/gohugoio/hugo/tpl/internal/go_templates/texttemplate"
+)
+
+// RenderStack tracks the templates and the shortcode executing for a page render.
+type RenderStack struct {
+	mu        sync.Mutex
+	templates []string
+	shortcode *shortcodeFrame
+}
+
+type shortcodeFrame struct {
+	name     string
+	position func() text.Position
+}
+
+// Push adds a template to the stack and returns a func that removes it again.
+func (s *RenderStack) Push(name string) func() {
+	s.mu.Lock()
+	s.templates = append(s.templates, name)
+	n := len(s.templates) - 1
+	s.mu.Unlock()
+	return func() {
+		s.mu.Lock()
+		s.templates = s.templates[:n]
+		s.mu.Unlock()
+	}
+}
+
+// Top returns the name of the innermost executing template, or an empty string if none.
+func (s *RenderStack) Top() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.templates) == 0 {
+		return ""
+	}
+	return s.templates[len(s.templates)-1]
+}
+
+// EnterShortcode marks the named shortcode as executing and returns a func
+// that restores the previous one. The position is only resolved if needed.
+func (s *RenderStack) EnterShortcode(name string, position func() text.Position) func() {
+	s.mu.Lock()
+	prev := s.shortcode
+	s.shortcode = &shortcodeFrame{name: name, position: position}
+	s.mu.Unlock()
+	return func() {
+		s.mu.Lock()
+		s.shortcode = prev
+		s.mu.Unlock()
+	}
+}
+
+func (s *RenderStack) snapshot() ([]string, string) {
+	s.mu.Lock()
+	templates := make([]string, len(s.templates))
+	copy(templates, s.templates)
+	sc := s.shortcode
+	s.mu.Unlock()
+
+	if sc == nil {
+		return templates, ""
+	}
+	desc := fmt.Sprintf("%q", sc.name)
+	if pos := sc.position(); pos.IsValid() {
+		desc += fmt.Sprintf(" at %s", pos)
+	}
+	return templates, desc
+}
+
+// GetRenderStackFromContext returns the RenderStack stored in ctx, or nil.
+func GetRenderStackFromContext(ctx context.Context) *RenderStack {
+	if v := ctx.Value(texttemplate.StackContextKey); v != nil {
+		return v.(*RenderStack)
+	}
+	return nil
+}
+
+// SetRenderStackInContext returns a context with a new RenderStack, used to
+// track the calling template of each template execution.
+func SetRenderStackInContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, texttemplate.StackContextKey, &RenderStack{})
+}
+
+// SetRenderTimeoutInContext returns a context that is cancelled with a
+// *RenderTimeoutError as the cause when timeout is exceeded.
+// Template execution using that context is aborted on the next function or
+// method call after that.
+func SetRenderTimeoutInContext(ctx context.Context, page string, timeout time.Duration) (context.Context, context.CancelFunc) {
+	stack := &RenderStack{}
+	ctx = context.WithValue(ctx, texttemplate.StackContextKey, stack)
+	return context.WithTimeoutCause(ctx, timeout, &RenderTimeoutError{Page: page, Timeout: timeout, stack: stack})
+}
+
+// RenderTimeoutError is returned when a page render exceeds renderTimeout.
+type RenderTimeoutError struct {
+	Page    string
+	Timeout time.Duration
+
+	// The templates executing when the timeout was detected, outermost first.
+	Templates []string
+
+	// The shortcode executing when the timeout was detected, if any.
+	Shortcode string
+
+	stack *RenderStack
+	once  sync.Once
+}
+
+func (e *RenderTimeoutError) Error() string {
+	// The stack is captured the first time the error is formatted,
+	// which is where the execution is aborted.
+	e.once.Do(func() {
+		e.Templates, e.Shortcode = e.stack.snapshot()
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "render of page %q exceeded renderTimeout (%s)", e.Page, e.Timeout)
+	if len(e.Templates) > 0 {
+		fmt.Fprintf(&b, "; template stack: %s", formatTemplateStack(e.Templates))
+	}
+	if e.Shortcode != "" {
+		fmt.Fprintf(&b, "; executing shortcode %s", e.Shortcode)
+	}
+	return b.String()
+}
+
+// formatTemplateStack joins the template names, collapsing consecutive
+// repeats, which is what recursive partials typically produce.
+func formatTemplateStack(templates []string) string {
+	var parts []string
+	for i := 0; i < len(templates); {
+		j := i + 1
+		for j < len(templates) && templates[j] == templates[i] {
+			j++
+		}
+		if n := j - i; n > 1 {
+			parts = append(parts, fmt.Sprintf("%s (x%d)", templates[i], n))
+		} else {
+			parts = append(parts, templates[i])
+		}
+		i = j
+	}
+	return strings.Join(parts, " > ")
+}
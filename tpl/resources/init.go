@@ -67,6 +67,11 @@ func init() {
 			[][2]string{},
 		)
 
+		ns.AddMethodMapping(ctx.TailwindCSS,
+			[]string{"tailwindCSS"},
+			[][2]string{},
+		)
+
 		ns.AddMethodMapping(ctx.Babel,
 			[]string{"babel"},
 			[][2]string{},
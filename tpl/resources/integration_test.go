@@ -127,3 +127,51 @@ Empty string not found
 		`)
 
 }
+
+func TestSRIManifest(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = "http://example.com/blog"
+disableKinds = ["taxonomy", "term", "page", "section", "rss", "sitemap", "robotsTXT", "404"]
+[build]
+sriManifest = "sri.json"
+-- assets/css/main.css --
+body { color: red; }
+-- assets/js/main.js --
+console.log("Hello");
+-- assets/js/unused.js --
+console.log("Not published");
+-- layouts/index.html --
+{{ $css := resources.Get "css/main.css" | fingerprint "sha512" }}
+{{ $js := resources.Get "js/main.js" | minify | fingerprint }}
+{{ $unused := resources.Get "js/unused.js" | fingerprint }}
+{{ $unused.Data.Integrity | len }}
+CSS: {{ $css.RelPermalink }}|
+JS: {{ $js.RelPermalink }}|
+Lookup: {{ resources.Integrity $js.RelPermalink }}|{{ eq (resources.Integrity $css.RelPermalink) $css.Data.Integrity }}|
+Lookup not found: {{ resources.Integrity "/blog/js/unused.js" }}|
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		}).Build()
+
+	b.AssertFileContent("public/index.html",
+		"CSS: /blog/css/main.",
+		"Lookup: sha256-",
+		"|true|",
+		"Lookup not found: |",
+	)
+
+	b.AssertFileContent("public/sri.json",
+		`"/blog/css/main.`,
+		`.css": "sha512-`,
+		`"/blog/js/main.min.`,
+		`.js": "sha256-`,
+	)
+	b.Assert(b.FileContent("public/sri.json"), qt.Not(qt.Contains), "unused")
+}
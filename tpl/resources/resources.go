@@ -17,11 +17,19 @@ package resources
 import (
 	"context"
 	"fmt"
+	"html"
+	"html/template"
+	"regexp"
+	"sort"
+	"strings"
 	"sync"
 
 	"errors"
 
+	"github.com/gohugoio/hugo/cache/namedmemcache"
+	"github.com/gohugoio/hugo/common/hugo"
 	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/tpl"
 
 	"github.com/gohugoio/hugo/tpl/internal/resourcehelpers"
 
@@ -38,9 +46,13 @@ import (
 	"github.com/gohugoio/hugo/resources/resource_transformers/integrity"
 	"github.com/gohugoio/hugo/resources/resource_transformers/minifier"
 	"github.com/gohugoio/hugo/resources/resource_transformers/postcss"
+	"github.com/gohugoio/hugo/resources/resource_transformers/purgecss"
+	"github.com/gohugoio/hugo/resources/resource_transformers/svg"
+	"github.com/gohugoio/hugo/resources/resource_transformers/tailwindcss"
 	"github.com/gohugoio/hugo/resources/resource_transformers/templates"
 	"github.com/gohugoio/hugo/resources/resource_transformers/tocss/dartsass"
 	"github.com/gohugoio/hugo/resources/resource_transformers/tocss/scss"
+	"github.com/gohugoio/hugo/resources/resource_transformers/wasmplugin"
 
 	"github.com/spf13/cast"
 )
@@ -61,6 +73,12 @@ func New(deps *deps.Deps) (*Namespace, error) {
 		return nil, err
 	}
 
+	svgSymbolsCache := namedmemcache.New()
+	deps.BuildStartListeners.Add(
+		func() {
+			svgSymbolsCache.Clear()
+		})
+
 	return &Namespace{
 		deps:              deps,
 		scssClientLibSass: scssClient,
@@ -69,8 +87,12 @@ func New(deps *deps.Deps) (*Namespace, error) {
 		integrityClient:   integrity.New(deps.ResourceSpec),
 		minifyClient:      minifyClient,
 		postcssClient:     postcss.New(deps.ResourceSpec),
+		purgecssClient:    purgecss.New(deps.ResourceSpec),
+		tailwindcssClient: tailwindcss.New(deps.ResourceSpec),
 		templatesClient:   templates.New(deps.ResourceSpec, deps),
 		babelClient:       babel.New(deps.ResourceSpec),
+		svgClient:         svg.New(deps.ResourceSpec),
+		svgSymbolsCache:   svgSymbolsCache,
 	}, nil
 }
 
@@ -86,14 +108,33 @@ type Namespace struct {
 	integrityClient   *integrity.Client
 	minifyClient      *minifier.Client
 	postcssClient     *postcss.Client
+	purgecssClient    *purgecss.Client
+	tailwindcssClient *tailwindcss.Client
 	babelClient       *babel.Client
 	templatesClient   *templates.Client
+	svgClient         *svg.Client
+
+	// Caches the symbol IDs in the SVG sprites referenced with SVGSymbol.
+	svgSymbolsCache *namedmemcache.Cache
 
 	// The Dart Client requires a os/exec process, so  only
 	// create it if we really need it.
 	// This is mostly to avoid creating one per site build test.
 	scssClientDartSassInit sync.Once
 	scssClientDartSass     *dartsass.Client
+
+	// Created on first use, as it reads the plugin config and WASM modules.
+	pluginClientInit sync.Once
+	pluginClient     *wasmplugin.Client
+}
+
+func (ns *Namespace) getPluginClient() *wasmplugin.Client {
+	ns.pluginClientInit.Do(func() {
+		ns.pluginClient = wasmplugin.New(ns.deps.ResourceSpec)
+		ns.deps.BuildClosers.Add(ns.pluginClient)
+	})
+
+	return ns.pluginClient
 }
 
 func (ns *Namespace) getscssClientDartSass() (*dartsass.Client, error) {
@@ -110,6 +151,17 @@ func (ns *Namespace) getscssClientDartSass() (*dartsass.Client, error) {
 	return ns.scssClientDartSass, err
 }
 
+// checkAllowedExec checks that the template executing in ctx may run the program
+// name when module security policies are configured, see security.ModulePolicy.
+// The program itself is run, and checked, when the resource is transformed.
+func (ns *Namespace) checkAllowedExec(ctx context.Context, name string) error {
+	sc := ns.deps.ExecHelper.Sec()
+	if len(sc.Modules) == 0 {
+		return nil
+	}
+	return tpl.SecurityFromContext(ctx, sc).CheckAllowedExec(name)
+}
+
 // Copy copies r to the new targetPath in s.
 func (ns *Namespace) Copy(s any, r resource.Resource) (resource.Resource, error) {
 	targetPath, err := cast.ToStringE(s)
@@ -147,7 +199,7 @@ func (ns *Namespace) Get(filename any) resource.Resource {
 //
 // Note: This method does not return any error as a second return value,
 // for any error situations the error can be checked in .Err.
-func (ns *Namespace) GetRemote(args ...any) resource.Resource {
+func (ns *Namespace) GetRemote(ctx context.Context, args ...any) resource.Resource {
 	get := func(args ...any) (resource.Resource, error) {
 		if len(args) < 1 {
 			return nil, errors.New("must provide an URL")
@@ -171,7 +223,7 @@ func (ns *Namespace) GetRemote(args ...any) resource.Resource {
 			}
 		}
 
-		return ns.createClient.FromRemote(urlstr, options)
+		return ns.createClient.FromRemote(ctx, urlstr, options)
 
 	}
 
@@ -303,6 +355,19 @@ func (ns *Namespace) ExecuteAsTemplate(ctx context.Context, args ...any) (resour
 	return ns.templatesClient.ExecuteAsTemplate(ctx, r, targetPath, data)
 }
 
+// Integrity returns the Subresource Integrity hash of the fingerprinted
+// resource published to the given path, e.g. "/css/main.min.1234.css",
+// or an empty string if not found.
+// Note that only the resources published so far in the build are found,
+// see build.sriManifest for a manifest with all of them.
+func (ns *Namespace) Integrity(path any) (string, error) {
+	s, err := cast.ToStringE(path)
+	if err != nil {
+		return "", err
+	}
+	return ns.deps.ResourceSpec.IntegrityManifest.Get(s), nil
+}
+
 // Fingerprint transforms the given Resource with a MD5 hash of the content in
 // the RelPermalink and Permalink.
 func (ns *Namespace) Fingerprint(args ...any) (resource.Resource, error) {
@@ -340,10 +405,101 @@ func (ns *Namespace) Minify(r resources.ResourceTransformer) (resource.Resource,
 	return ns.minifyClient.Minify(r)
 }
 
+// SVGOptimize optimizes the given SVG Resource by removing editor metadata,
+// comments etc. before minifying it.
+func (ns *Namespace) SVGOptimize(r resources.ResourceTransformer) (resource.Resource, error) {
+	return ns.svgClient.Optimize(r)
+}
+
+// SVGSprite combines the given SVG Resource objects into a single SVG with
+// one symbol per Resource, published to targetPath.
+// The symbol IDs are the Resource names without the directory and extension.
+func (ns *Namespace) SVGSprite(targetPathIn any, r any) (resource.Resource, error) {
+	targetPath, err := cast.ToStringE(targetPathIn)
+	if err != nil {
+		return nil, err
+	}
+
+	var rr resource.Resources
+
+	switch v := r.(type) {
+	case resource.Resources:
+		rr = v
+	case resource.ResourcesConverter:
+		rr = v.ToResources()
+	default:
+		return nil, fmt.Errorf("slice %T not supported in SVGSprite", r)
+	}
+
+	if len(rr) == 0 {
+		return nil, errors.New("must provide one or more Resource objects to SVGSprite")
+	}
+
+	return ns.svgClient.Sprite(targetPath, rr)
+}
+
+var svgSymbolAttributeNameRe = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:.-]*$`)
+
+// SVGSymbol returns an svg element referencing the symbol with the given id in
+// the SVG sprite created with SVGSprite. Any attributes for the svg element,
+// e.g. class, can be provided in an optional map.
+func (ns *Namespace) SVGSymbol(sprite resource.Resource, idIn any, attrs ...any) (template.HTML, error) {
+	id, err := cast.ToStringE(idIn)
+	if err != nil {
+		return "", err
+	}
+
+	if len(attrs) > 1 {
+		return "", errors.New("must not provide more arguments than sprite, id and attributes")
+	}
+
+	v, err := ns.svgSymbolsCache.GetOrCreate(sprite.RelPermalink(), func() (any, error) {
+		return svg.SymbolIDs(sprite)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	ids := v.([]string)
+	var found bool
+	for _, sid := range ids {
+		if sid == id {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return "", fmt.Errorf("symbol %q not found in SVG sprite %q", id, sprite.RelPermalink())
+	}
+
+	var sb strings.Builder
+	sb.WriteString("<svg")
+	if len(attrs) == 1 {
+		m, err := maps.ToStringMapE(attrs[0])
+		if err != nil {
+			return "", err
+		}
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			if !svgSymbolAttributeNameRe.MatchString(k) {
+				return "", fmt.Errorf("invalid attribute name %q", k)
+			}
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(&sb, ` %s="%s"`, k, html.EscapeString(cast.ToString(m[k])))
+		}
+	}
+	fmt.Fprintf(&sb, `><use href="%s"></use></svg>`, html.EscapeString(sprite.RelPermalink()+"#"+id))
+
+	return template.HTML(sb.String()), nil
+}
+
 // ToCSS converts the given Resource to CSS. You can optional provide an Options object
 // as second argument. As an option, you can e.g. specify e.g. the target path (string)
 // for the converted CSS resource.
-func (ns *Namespace) ToCSS(args ...any) (resource.Resource, error) {
+func (ns *Namespace) ToCSS(ctx context.Context, args ...any) (resource.Resource, error) {
 
 	if len(args) > 2 {
 		return nil, errors.New("must not provide more arguments than resource object and options")
@@ -407,6 +563,10 @@ func (ns *Namespace) ToCSS(args ...any) (resource.Resource, error) {
 		m["targetPath"] = targetPath
 	}
 
+	if err := ns.checkAllowedExec(ctx, hugo.DartSassBinaryName); err != nil {
+		return nil, err
+	}
+
 	client, err := ns.getscssClientDartSass()
 	if err != nil {
 		return nil, err
@@ -417,8 +577,48 @@ func (ns *Namespace) ToCSS(args ...any) (resource.Resource, error) {
 }
 
 // PostCSS processes the given Resource with PostCSS
-func (ns *Namespace) PostCSS(args ...any) (resource.Resource, error) {
+func (ns *Namespace) PostCSS(ctx context.Context, args ...any) (resource.Resource, error) {
+
+	if len(args) > 2 {
+		return nil, errors.New("must not provide more arguments than resource object and options")
+	}
+
+	if err := ns.checkAllowedExec(ctx, "postcss"); err != nil {
+		return nil, err
+	}
+
+	r, m, err := resourcehelpers.ResolveArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return ns.postcssClient.Process(ctx, r, m)
+}
+
+// TailwindCSS processes the given CSS Resource with the Tailwind CSS CLI.
+// With build.writeStats enabled, the class names in hugo_stats.json are
+// included; wrap the result in PostProcess to run it after all pages are rendered.
+func (ns *Namespace) TailwindCSS(ctx context.Context, args ...any) (resource.Resource, error) {
+	if len(args) > 2 {
+		return nil, errors.New("must not provide more arguments than resource object and options")
+	}
 
+	if err := ns.checkAllowedExec(ctx, "tailwindcss"); err != nil {
+		return nil, err
+	}
+
+	r, m, err := resourcehelpers.ResolveArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return ns.tailwindcssClient.Process(ctx, r, m)
+}
+
+// PurgeCSS removes the unused rules from the given CSS Resource using the
+// HTML elements collected in hugo_stats.json, see build.writeStats.
+// Wrap the result in PostProcess to run it after all pages are rendered.
+func (ns *Namespace) PurgeCSS(args ...any) (resource.Resource, error) {
 	if len(args) > 2 {
 		return nil, errors.New("must not provide more arguments than resource object and options")
 	}
@@ -428,7 +628,30 @@ func (ns *Namespace) PostCSS(args ...any) (resource.Resource, error) {
 		return nil, err
 	}
 
-	return ns.postcssClient.Process(r, m)
+	return ns.purgecssClient.Process(r, m)
+}
+
+// Plugin transforms the given Resource using the WASM plugin with the given
+// name as configured in the plugins section of the site config,
+// e.g. resources.Plugin "images.dither" $img.
+// You can optionally provide an Options object before the Resource,
+// which is passed to the plugin as config.
+func (ns *Namespace) Plugin(name any, args ...any) (resource.Resource, error) {
+	if len(args) > 2 {
+		return nil, errors.New("must not provide more arguments than plugin name, resource object and options")
+	}
+
+	pluginName, err := cast.ToStringE(name)
+	if err != nil {
+		return nil, err
+	}
+
+	r, m, err := resourcehelpers.ResolveArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return ns.getPluginClient().Process(pluginName, r, m)
 }
 
 // PostProcess processes r after the build.
@@ -437,12 +660,16 @@ func (ns *Namespace) PostProcess(r resource.Resource) (postpub.PostPublishedReso
 }
 
 // Babel processes the given Resource with Babel.
-func (ns *Namespace) Babel(args ...any) (resource.Resource, error) {
+func (ns *Namespace) Babel(ctx context.Context, args ...any) (resource.Resource, error) {
 
 	if len(args) > 2 {
 		return nil, errors.New("must not provide more arguments than resource object and options")
 	}
 
+	if err := ns.checkAllowedExec(ctx, "babel"); err != nil {
+		return nil, err
+	}
+
 	r, m, err := resourcehelpers.ResolveArgs(args)
 	if err != nil {
 		return nil, err
@@ -456,5 +683,5 @@ func (ns *Namespace) Babel(args ...any) (resource.Resource, error) {
 		}
 	}
 
-	return ns.babelClient.Process(r, options)
+	return ns.babelClient.Process(ctx, r, options)
 }
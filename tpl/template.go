@@ -23,6 +23,7 @@ import (
 	"unicode"
 
 	bp "github.com/gohugoio/hugo/bufferpool"
+	"github.com/gohugoio/hugo/config/security"
 	"github.com/gohugoio/hugo/output/layouts"
 
 	"github.com/gohugoio/hugo/output"
@@ -57,6 +58,12 @@ type UnusedTemplatesProvider interface {
 	UnusedTemplates() []FileInfo
 }
 
+// InternalTemplatesProvider lists the internal templates referenced, e.g. _internal/disqus.html,
+// if the build is configured to track those.
+type InternalTemplatesProvider interface {
+	InternalTemplates() []string
+}
+
 // TemplateHandlers holds the templates needed by Hugo.
 type TemplateHandlers struct {
 	Tmpl    TemplateHandler
@@ -169,6 +176,24 @@ func SetPageInContext(ctx context.Context, p page) context.Context {
 	return context.WithValue(ctx, texttemplate.PageContextKey, p)
 }
 
+// SetModuleInContext sets the module of the executing template, "project"
+// for the project's own templates.
+func SetModuleInContext(ctx context.Context, module string) context.Context {
+	return security.ContextWithModule(ctx, module)
+}
+
+// GetModuleFromContext returns the module of the executing template, or an
+// empty string if not known.
+func GetModuleFromContext(ctx context.Context) string {
+	return security.ModuleFromContext(ctx)
+}
+
+// SecurityFromContext returns the security policy for the template executing in ctx,
+// see security.Config.ForContext.
+func SecurityFromContext(ctx context.Context, sc security.Config) security.Config {
+	return sc.ForContext(ctx)
+}
+
 // SetSecurityAllowActionJSTmpl sets the global setting for allowing tempalte actions in JS template literals.
 // This was added in Hugo 0.114.0.
 // See https://github.com/golang/go/issues/59234
@@ -191,6 +216,19 @@ func SetHasLockInContext(ctx context.Context, hasLock bool) context.Context {
 	return context.WithValue(ctx, texttemplate.HasLockContextKey, hasLock)
 }
 
+// GetIsInCachedPartialFromContext reports whether ctx is executing a cached
+// partial, whose result may be shared by several pages.
+func GetIsInCachedPartialFromContext(ctx context.Context) bool {
+	if v := ctx.Value(texttemplate.CachedPartialContextKey); v != nil {
+		return v.(bool)
+	}
+	return false
+}
+
+func SetIsInCachedPartialInContext(ctx context.Context, inCachedPartial bool) context.Context {
+	return context.WithValue(ctx, texttemplate.CachedPartialContextKey, inCachedPartial)
+}
+
 const hugoNewLinePlaceholder = "___hugonl_"
 
 var (
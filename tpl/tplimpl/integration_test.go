@@ -61,6 +61,86 @@ title: "P1"
 	b.Assert(unused[0].Filename(), qt.Equals, filepath.Join(b.Cfg.WorkingDir, "layouts/_default/single.json"))
 }
 
+func TestInternalTemplatesConfig(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = 'http://example.com/'
+disableKinds = ["taxonomy", "term", "rss", "sitemap", "robotsTXT", "404"]
+disqusShortname = "myshortname"
+printInternalTemplates = true
+[internalTemplates.disqus]
+disable = true
+[internalTemplates.opengraph]
+template = "partials/opengraph.html"
+-- content/p1.md --
+---
+title: "P1"
+---
+-- layouts/partials/opengraph.html --
+<meta property="og:title" content="My {{ .Title }}" />
+-- layouts/partials/analytics.html --
+{{ template "_internal/google_analytics.html" . }}
+-- layouts/_default/single.html --
+Disqus:{{ template "_internal/disqus.html" . }}|
+OpenGraph:{{ template "_internal/opengraph.html" . }}|
+{{ partial "analytics.html" . }}
+{{ if false }}{{ template "_internal/twitter_cards.html" . }}{{ end }}
+-- layouts/index.html --
+Home.
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		"Disqus:|",
+		`OpenGraph:<meta property="og:title" content="My P1" />|`,
+	)
+
+	used := b.H.Tmpl().(tpl.InternalTemplatesProvider).InternalTemplates()
+	// Referenced templates are included even if never executed.
+	b.Assert(used, qt.DeepEquals, []string{"_internal/disqus.html", "_internal/google_analytics.html", "_internal/opengraph.html", "_internal/twitter_cards.html"})
+}
+
+func TestInternalTemplatesConfigErrors(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- config.toml --
+baseURL = 'http://example.com/'
+[internalTemplates.CONFIG]
+-- layouts/index.html --
+Home.
+`
+
+	c := qt.New(t)
+
+	for _, test := range []struct {
+		config string
+		expect string
+	}{
+		{"foo]\ndisable = true", `unknown internal template "foo"`},
+		{"opengraph]\ntemplate = \"partials/notfound.html\"", `template "partials/notfound.html" not found`},
+		{"opengraph]\ntemplate = \"partials/og.html\"\ndisable = true", `mutually exclusive`},
+	} {
+		_, err := hugolib.NewIntegrationTestBuilder(
+			hugolib.IntegrationTestConfig{
+				T:           t,
+				TxtarString: strings.Replace(files, "CONFIG]", test.config, 1),
+			},
+		).BuildE()
+
+		c.Assert(err, qt.Not(qt.IsNil))
+		c.Assert(err.Error(), qt.Contains, test.expect)
+	}
+}
+
 // Verify that the new keywords in Go 1.18 is available.
 func TestGo18Constructs(t *testing.T) {
 	t.Parallel()
@@ -26,11 +26,12 @@ import (
 	"sort"
 	"strings"
 	"sync"
-	"time"
 	"unicode"
 	"unicode/utf8"
 
 	"github.com/gohugoio/hugo/common/types"
+	"github.com/gohugoio/hugo/config/internaltemplates"
+	"github.com/gohugoio/hugo/config/security"
 	"github.com/gohugoio/hugo/output/layouts"
 
 	"github.com/gohugoio/hugo/helpers"
@@ -41,6 +42,7 @@ import (
 	"github.com/spf13/afero"
 
 	"github.com/gohugoio/hugo/common/herrors"
+	"github.com/gohugoio/hugo/common/hugo"
 	"github.com/gohugoio/hugo/hugofs"
 	"github.com/gohugoio/hugo/hugofs/files"
 
@@ -57,6 +59,9 @@ const (
 	shortcodesPathPrefix = "shortcodes/"
 	internalPathPrefix   = "_internal/"
 	baseFileBase         = "baseof"
+
+	// Templates in this directory are only used in the development environment.
+	devOnlyDir = "_dev"
 )
 
 // The identifiers may be truncated in the log, e.g.
@@ -69,11 +74,12 @@ var embeddedTemplatesAliases = map[string][]string{
 }
 
 var (
-	_ tpl.TemplateManager         = (*templateExec)(nil)
-	_ tpl.TemplateHandler         = (*templateExec)(nil)
-	_ tpl.TemplateFuncGetter      = (*templateExec)(nil)
-	_ tpl.TemplateFinder          = (*templateExec)(nil)
-	_ tpl.UnusedTemplatesProvider = (*templateExec)(nil)
+	_ tpl.TemplateManager           = (*templateExec)(nil)
+	_ tpl.TemplateHandler           = (*templateExec)(nil)
+	_ tpl.TemplateFuncGetter        = (*templateExec)(nil)
+	_ tpl.TemplateFinder            = (*templateExec)(nil)
+	_ tpl.UnusedTemplatesProvider   = (*templateExec)(nil)
+	_ tpl.InternalTemplatesProvider = (*templateExec)(nil)
 
 	_ tpl.Template = (*templateState)(nil)
 	_ tpl.Info     = (*templateState)(nil)
@@ -144,6 +150,11 @@ func newTemplateHandlers(d *deps.Deps) (*tpl.TemplateHandlers, error) {
 		templateUsageTracker = make(map[string]templateInfo)
 	}
 
+	var internalTemplateUsageTracker map[string]bool
+	if d.Conf.PrintInternalTemplates() {
+		internalTemplateUsageTracker = make(map[string]bool)
+	}
+
 	h := &templateHandler{
 		nameBaseTemplateName: make(map[string]string),
 		transformNotFound:    make(map[string]*templateState),
@@ -162,6 +173,9 @@ func newTemplateHandlers(d *deps.Deps) (*tpl.TemplateHandlers, error) {
 		layoutTemplateCache: make(map[layoutCacheKey]layoutCacheEntry),
 
 		templateUsageTracker: templateUsageTracker,
+
+		internalTemplates:            make(map[string]bool),
+		internalTemplateUsageTracker: internalTemplateUsageTracker,
 	}
 
 	if err := h.loadEmbedded(); err != nil {
@@ -240,8 +254,23 @@ func (t *templateExec) ExecuteWithContext(ctx context.Context, templ tpl.Templat
 		rlocker.RLock()
 		defer rlocker.RUnlock()
 	}
+	stack := tpl.GetRenderStackFromContext(ctx)
 	if t.Metrics != nil {
-		defer t.Metrics.MeasureSince(templ.Name(), time.Now())
+		if stack == nil {
+			// Needed to track the callers.
+			ctx = tpl.SetRenderStackInContext(ctx)
+			stack = tpl.GetRenderStackFromContext(ctx)
+		}
+		defer t.Metrics.MeasureTemplate(templ.Name(), stack.Top())()
+	}
+	if stack != nil {
+		defer stack.Push(templ.Name())()
+	}
+	if len(t.d.ExecHelper.Sec().Modules) > 0 {
+		// The template funcs check the security policy of the module the template comes from.
+		if ts, ok := templ.(*templateState); ok && ts.info.module != "" {
+			ctx = tpl.SetModuleInContext(ctx, ts.info.module)
+		}
 	}
 
 	if t.templateUsageTracker != nil {
@@ -261,6 +290,19 @@ func (t *templateExec) ExecuteWithContext(ctx context.Context, templ tpl.Templat
 		}
 	}
 
+	if t.internalTemplateUsageTracker != nil {
+		if ts, ok := templ.(*templateState); ok {
+			t.internalTemplateUsageTrackerMu.Lock()
+			if t.internalTemplates[ts.Name()] {
+				t.internalTemplateUsageTracker[ts.Name()] = true
+			}
+			for _, name := range ts.internalTemplates {
+				t.internalTemplateUsageTracker[name] = true
+			}
+			t.internalTemplateUsageTrackerMu.Unlock()
+		}
+	}
+
 	execErr := t.executor.ExecuteWithContext(ctx, templ, wr, data)
 	if execErr != nil {
 		execErr = t.addFileContext(templ, execErr)
@@ -304,6 +346,29 @@ func (t *templateExec) UnusedTemplates() []tpl.FileInfo {
 	return unused
 }
 
+// InternalTemplates returns the sorted names of the internal templates
+// referenced in the build, e.g. _internal/opengraph.html. These are the
+// internal templates executed directly, e.g. with partial, and the ones
+// referenced with the template action in an executed template, found when
+// the template was parsed. The latter are included even if the template
+// action is never reached, e.g. inside an if block with a false condition.
+func (t *templateExec) InternalTemplates() []string {
+	if t.internalTemplateUsageTracker == nil {
+		return nil
+	}
+
+	t.internalTemplateUsageTrackerMu.Lock()
+	defer t.internalTemplateUsageTrackerMu.Unlock()
+
+	var used []string
+	for name := range t.internalTemplateUsageTracker {
+		used = append(used, name)
+	}
+	sort.Strings(used)
+
+	return used
+}
+
 func (t *templateExec) GetFunc(name string) (reflect.Value, bool) {
 	v, found := t.funcs[name]
 	return v, found
@@ -361,6 +426,14 @@ type templateHandler struct {
 	// May be nil.
 	templateUsageTracker   map[string]templateInfo
 	templateUsageTrackerMu sync.Mutex
+
+	// The names of the internal templates that can be disabled or
+	// overridden in config, e.g. _internal/disqus.html.
+	internalTemplates map[string]bool
+
+	// May be nil.
+	internalTemplateUsageTracker   map[string]bool
+	internalTemplateUsageTrackerMu sync.Mutex
 }
 
 type layoutCacheEntry struct {
@@ -631,10 +704,21 @@ func (t *templateHandler) addTemplateFile(name, path string) error {
 
 		s := removeLeadingBOM(string(b))
 
+		if isDevOnlyPath(name) && t.Conf.Environment() != hugo.EnvironmentDevelopment {
+			// Development only templates render nothing outside of development.
+			s = ""
+		}
+
 		realFilename := filename
+		var module string
 		if fi, err := fs.Stat(filename); err == nil {
 			if fim, ok := fi.(hugofs.FileMetaInfo); ok {
-				realFilename = fim.Meta().Filename
+				meta := fim.Meta()
+				realFilename = meta.Filename
+				module = meta.Module
+				if meta.IsProject {
+					module = security.ModuleProject
+				}
 			}
 		}
 
@@ -647,6 +731,7 @@ func (t *templateHandler) addTemplateFile(name, path string) error {
 			template:     s,
 			filename:     filename,
 			realFilename: realFilename,
+			module:       module,
 			fs:           fs,
 		}, nil
 	}
@@ -739,6 +824,12 @@ func (t *templateHandler) applyTemplateTransformers(ns *templateNamespace, ts *t
 		return nil, err
 	}
 
+	for k := range c.visited {
+		if t.internalTemplates[k] {
+			ts.internalTemplates = append(ts.internalTemplates, k)
+		}
+	}
+
 	for k := range c.templateNotFound {
 		t.transformNotFound[k] = ts
 		t.identityNotFound[k] = append(t.identityNotFound[k], c.t)
@@ -753,11 +844,14 @@ func (t *templateHandler) applyTemplateTransformers(ns *templateNamespace, ts *t
 
 //go:embed embedded/templates/*
 //go:embed embedded/templates/_default/*
+//go:embed embedded/templates/_markup/*
 //go:embed embedded/templates/_server/*
 var embededTemplatesFs embed.FS
 
 func (t *templateHandler) loadEmbedded() error {
-	return fs.WalkDir(embededTemplatesFs, ".", func(path string, d fs.DirEntry, err error) error {
+	conf := t.Conf.GetConfigSection("internalTemplates").(internaltemplates.Config)
+
+	err := fs.WalkDir(embededTemplatesFs, ".", func(path string, d fs.DirEntry, err error) error {
 		if d == nil || d.IsDir() {
 			return nil
 		}
@@ -780,8 +874,27 @@ func (t *templateHandler) loadEmbedded() error {
 			templateName = internalPathPrefix + name
 		}
 
+		if !strings.Contains(name, "/") {
+			// Top level internal templates, e.g. _internal/disqus.html,
+			// can be disabled or overridden in config.
+			t.internalTemplates[templateName] = true
+			if tc, found := conf.Get(name); found {
+				switch {
+				case tc.Disable:
+					templ = "{{- /* Disabled in config. */ -}}"
+				case tc.Template != "":
+					templ = fmt.Sprintf("{{- template %q . -}}", tc.Template)
+				}
+			}
+		}
+
 		if _, found := t.Lookup(templateName); !found {
-			if err := t.AddTemplate(templateName, templ); err != nil {
+			addName := templateName
+			if strings.HasSuffix(name, ".json") {
+				// JSON output formats are plain text.
+				addName = textTmplNamePrefix + templateName
+			}
+			if err := t.AddTemplate(addName, templ); err != nil {
 				return err
 			}
 		}
@@ -798,6 +911,17 @@ func (t *templateHandler) loadEmbedded() error {
 
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	for name := range conf {
+		if !t.internalTemplates[internalPathPrefix+name+".html"] {
+			return fmt.Errorf("internalTemplates: unknown internal template %q", name)
+		}
+	}
+
+	return nil
 }
 
 func (t *templateHandler) loadTemplates() error {
@@ -883,6 +1007,16 @@ func (t *templateHandler) extractPartials(templ tpl.Template) error {
 }
 
 func (t *templateHandler) postTransform() error {
+	conf := t.Conf.GetConfigSection("internalTemplates").(internaltemplates.Config)
+	for name, tc := range conf {
+		if tc.Template == "" {
+			continue
+		}
+		if _, found := t.Lookup(tc.Template); !found {
+			return fmt.Errorf("internalTemplates.%s: template %q not found", name, tc.Template)
+		}
+	}
+
 	defineCheckedHTML := false
 	defineCheckedText := false
 
@@ -1053,6 +1187,10 @@ type templateState struct {
 
 	info     templateInfo
 	baseInfo templateInfo // Set when a base template is used.
+
+	// The internal templates referenced by this template with the template
+	// action, e.g. _internal/disqus.html.
+	internalTemplates []string
 }
 
 func (t *templateState) ParseInfo() tpl.ParseInfo {
@@ -1118,6 +1256,11 @@ func isBaseTemplatePath(path string) bool {
 	return strings.Contains(filepath.Base(path), baseFileBase)
 }
 
+// isDevOnlyPath reports whether the template name is inside a _dev directory.
+func isDevOnlyPath(name string) bool {
+	return strings.HasPrefix(name, devOnlyDir+"/") || strings.Contains(name, "/"+devOnlyDir+"/")
+}
+
 func isDotFile(path string) bool {
 	return filepath.Base(path)[0] == '.'
 }
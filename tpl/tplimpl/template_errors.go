@@ -33,6 +33,9 @@ type templateInfo struct {
 
 	// The real filename (if possible). Used for logging.
 	realFilename string
+
+	// The module the template comes from, "project" for the project's templates.
+	module string
 }
 
 func (t templateInfo) Name() string {
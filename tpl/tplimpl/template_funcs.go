@@ -32,6 +32,7 @@ import (
 	"github.com/gohugoio/hugo/tpl/internal"
 
 	// Init the namespaces
+	_ "github.com/gohugoio/hugo/tpl/calendar"
 	_ "github.com/gohugoio/hugo/tpl/cast"
 	_ "github.com/gohugoio/hugo/tpl/collections"
 	_ "github.com/gohugoio/hugo/tpl/compare"
@@ -62,6 +63,7 @@ import (
 	_ "github.com/gohugoio/hugo/tpl/time"
 	_ "github.com/gohugoio/hugo/tpl/transform"
 	_ "github.com/gohugoio/hugo/tpl/urls"
+	_ "github.com/gohugoio/hugo/tpl/validate"
 )
 
 var (
@@ -0,0 +1,181 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"sort"
+	"strings"
+
+	"github.com/gohugoio/hugo/common/hexec"
+	"github.com/gohugoio/hugo/common/maps"
+	"github.com/gohugoio/hugo/helpers"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/cast"
+)
+
+const katexBinaryName = "katex"
+
+// MathOptions configures transform.ToMath.
+// These map to the KaTeX CLI options.
+type MathOptions struct {
+	// The output format, one of html, mathml and htmlAndMathml.
+	// Default is htmlAndMathml.
+	Output string
+
+	// Whether to render in display mode, e.g. for block math.
+	DisplayMode bool
+
+	// Whether to render \tag on the left instead of on the right.
+	Leqno bool
+
+	// Whether to left align display math instead of centering it.
+	Fleqn bool
+
+	// Whether to fail on invalid input. If false, the invalid input is
+	// rendered in ErrorColor. Default is true.
+	ThrowOnError bool
+
+	// The color of invalid input when ThrowOnError is false.
+	ErrorColor string
+
+	// Custom macros, e.g. {"\\RR": "\\mathbb{R}"}.
+	Macros map[string]string
+}
+
+var defaultMathOptions = MathOptions{
+	Output:       "htmlAndMathml",
+	ThrowOnError: true,
+}
+
+func decodeMathOptions(m map[string]any) (MathOptions, error) {
+	opts := defaultMathOptions
+	if m == nil {
+		return opts, nil
+	}
+	if err := mapstructure.WeakDecode(maps.CleanConfigStringMap(m), &opts); err != nil {
+		return opts, err
+	}
+	switch opts.Output {
+	case "html", "mathml", "htmlAndMathml":
+	default:
+		return opts, fmt.Errorf("invalid output %q, must be one of html, mathml or htmlAndMathml", opts.Output)
+	}
+	return opts, nil
+}
+
+func (o MathOptions) toArgs() []any {
+	args := []any{"--format", o.Output}
+	if o.DisplayMode {
+		args = append(args, "--display-mode")
+	}
+	if o.Leqno {
+		args = append(args, "--leqno")
+	}
+	if o.Fleqn {
+		args = append(args, "--fleqn")
+	}
+	if !o.ThrowOnError {
+		args = append(args, "--no-throw-on-error")
+	}
+	if o.ErrorColor != "" {
+		args = append(args, "--error-color", o.ErrorColor)
+	}
+	names := make([]string, 0, len(o.Macros))
+	for name := range o.Macros {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		args = append(args, "--macro", name+":"+o.Macros[name])
+	}
+	return args
+}
+
+// ToMath renders the LaTeX math in s to HTML and/or MathML with the KaTeX CLI.
+// The katex binary is used if found in PATH, else the one in node_modules,
+// installed with npm install katex.
+// The result is cached in the assets file cache, so sites with a primed
+// cache can be built without KaTeX installed.
+func (ns *Namespace) ToMath(s any, options ...any) (template.HTML, error) {
+	if len(options) > 1 {
+		return "", errors.New("transform.ToMath: requires 1 or 2 arguments")
+	}
+
+	ss, err := cast.ToStringE(s)
+	if err != nil {
+		return "", err
+	}
+
+	var m map[string]any
+	if len(options) == 1 {
+		if m, err = maps.ToStringMapE(options[0]); err != nil {
+			return "", fmt.Errorf("transform.ToMath: %w", err)
+		}
+	}
+
+	opts, err := decodeMathOptions(m)
+	if err != nil {
+		return "", fmt.Errorf("transform.ToMath: %w", err)
+	}
+
+	args := opts.toArgs()
+	key := fmt.Sprintf("tomath/%s.html", helpers.MD5String(fmt.Sprint(args, ss)))
+
+	_, b, err := ns.deps.ResourceSpec.FileCaches.AssetsCache().GetOrCreateBytes(key, func() ([]byte, error) {
+		return ns.runKaTeX(ss, args)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return template.HTML(b), nil
+}
+
+func (ns *Namespace) runKaTeX(s string, args []any) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	args = append(args,
+		hexec.WithDir(ns.deps.Conf.BaseConfig().WorkingDir),
+		hexec.WithStdin(strings.NewReader(s)),
+		hexec.WithStdout(&stdout),
+		hexec.WithStderr(&stderr),
+	)
+
+	var (
+		cmd hexec.Runner
+		err error
+	)
+	if hexec.InPath(katexBinaryName) {
+		cmd, err = ns.deps.ExecHelper.New(katexBinaryName, args...)
+	} else {
+		cmd, err = ns.deps.ExecHelper.Npx(katexBinaryName, args...)
+	}
+	if err == nil {
+		err = cmd.Run()
+	}
+	if err != nil {
+		if hexec.IsNotFound(err) {
+			return nil, errors.New("transform.ToMath: KaTeX is not installed and the result is not in the file cache, install it with npm install katex")
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("transform.ToMath: %s: %w", msg, err)
+		}
+		return nil, fmt.Errorf("transform.ToMath: %w", err)
+	}
+
+	return bytes.TrimSpace(stdout.Bytes()), nil
+}
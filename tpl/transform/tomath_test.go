@@ -0,0 +1,159 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+// fakeKaTeX puts a katex script in PATH wrapping its input and arguments in a span.
+func fakeKaTeX(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake katex is a shell script")
+	}
+	dir := t.TempDir()
+	script := "#!/bin/sh\nprintf '<span class=\"katex\" data-args=\"%s\">' \"$*\"\ncat\nprintf '</span>\\n'\n"
+	if err := os.WriteFile(filepath.Join(dir, "katex"), []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func TestToMath(t *testing.T) {
+	fakeKaTeX(t)
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "section", "page", "rss", "sitemap"]
+[security.exec]
+allow = ['^katex$']
+-- layouts/index.html --
+Default: {{ transform.ToMath "a^2" }}|
+Options: {{ transform.ToMath "\\RR" (dict "displayMode" true "output" "mathml" "throwOnError" false "macros" (dict "\\RR" "\\mathbb{R}")) }}|
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			NeedsOsFS:   true,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html",
+		`Default: <span class="katex" data-args="--format htmlAndMathml">a^2</span>|`,
+		`Options: <span class="katex" data-args="--format mathml --display-mode --no-throw-on-error --macro \RR:\mathbb{R}">\RR</span>|`,
+	)
+}
+
+func TestToMathNotAllowed(t *testing.T) {
+	fakeKaTeX(t)
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "section", "page", "rss", "sitemap"]
+-- layouts/index.html --
+{{ transform.ToMath "a^2" }}
+`
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			NeedsOsFS:   true,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `access denied: "katex" is not whitelisted in policy "security.exec.allow"`)
+}
+
+func TestToMathInvalidOptions(t *testing.T) {
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "section", "page", "rss", "sitemap"]
+-- layouts/index.html --
+{{ transform.ToMath "a^2" (dict "output" "svg") }}
+`
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `transform.ToMath: invalid output "svg"`)
+}
+
+func TestToMathPassthrough(t *testing.T) {
+	fakeKaTeX(t)
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "rss", "sitemap"]
+[security.exec]
+allow = ['^katex$']
+[markup.goldmark.extensions.passthrough]
+enable = true
+renderMath = true
+[markup.goldmark.extensions.passthrough.delimiters]
+inline = [['\(', '\)']]
+block = [['$$', '$$']]
+-- layouts/_default/single.html --
+{{ .Content }}
+-- layouts/_default/list.html --
+-- content/p1.md --
+---
+title: "p1"
+---
+Inline \(a^2\).
+
+$$
+b^2
+$$
+-- content/notes/_index.md --
+---
+title: "Notes"
+cascade:
+  renderMath: false
+---
+-- content/notes/n1.md --
+---
+title: "n1"
+---
+Inline \(a^2\).
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+			NeedsOsFS:   true,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		`<p>Inline <span class="katex" data-args="--format htmlAndMathml">a^2</span>.</p>`,
+		`<span class="katex" data-args="--format htmlAndMathml --display-mode">b^2</span>`,
+	)
+	b.AssertFileContent("public/notes/n1/index.html", `<p>Inline \(a^2\).</p>`)
+}
@@ -16,10 +16,14 @@ package transform
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"html"
 	"html/template"
+	"strings"
 
 	"github.com/gohugoio/hugo/cache/namedmemcache"
+	"github.com/gohugoio/hugo/common/maps"
 	"github.com/gohugoio/hugo/markup/converter/hooks"
 	"github.com/gohugoio/hugo/markup/highlight"
 	"github.com/gohugoio/hugo/markup/highlight/chromalexers"
@@ -27,6 +31,7 @@ import (
 
 	"github.com/gohugoio/hugo/deps"
 	"github.com/gohugoio/hugo/helpers"
+	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/cast"
 )
 
@@ -92,6 +97,35 @@ func (ns *Namespace) HighlightCodeBlock(ctx hooks.CodeblockContext, opts ...any)
 	return hl.HighlightCodeBlock(ctx, optsv)
 }
 
+// HighlightCSS returns the CSS for the highlighting style(s) configured in
+// markup.highlight, to use with CSS classes, with any options in opts applied,
+// e.g. (dict "style" "github" "darkStyle" "monokai" "darkMode" "class").
+func (ns *Namespace) HighlightCSS(opts ...any) (string, error) {
+	if len(opts) > 1 {
+		return "", errors.New("transform.HighlightCSS: requires 0 or 1 argument")
+	}
+
+	cfg := ns.deps.ContentSpec.Converters.GetMarkupConfig().Highlight
+	if len(opts) == 1 {
+		m, err := maps.ToStringMapE(opts[0])
+		if err != nil {
+			return "", fmt.Errorf("transform.HighlightCSS: %w", err)
+		}
+		if err := mapstructure.WeakDecode(m, &cfg); err != nil {
+			return "", fmt.Errorf("transform.HighlightCSS: %w", err)
+		}
+		if err := cfg.Validate(); err != nil {
+			return "", fmt.Errorf("transform.HighlightCSS: %w", err)
+		}
+	}
+
+	var b strings.Builder
+	if err := cfg.WriteCSS(&b); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
 // CanHighlight returns whether the given code language is supported by the Chroma highlighter.
 func (ns *Namespace) CanHighlight(language string) bool {
 	return chromalexers.Get(language) != nil
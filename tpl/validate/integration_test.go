@@ -0,0 +1,131 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate_test
+
+import (
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestSchema(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "page", "section", "rss", "sitemap"]
+-- assets/schemas/people.json --
+{
+  "type": "array",
+  "items": {
+    "type": "object",
+    "required": ["name", "age"],
+    "properties": {
+      "name": { "type": "string", "minLength": 1 },
+      "age": { "type": "integer", "minimum": 0 },
+      "email": { "type": "string", "format": "email" }
+    }
+  }
+}
+-- data/people.toml --
+[[people]]
+name = "Jo"
+age = 42
+[[people]]
+name = ""
+age = -1
+[[people]]
+age = 3
+-- layouts/index.html --
+{{ $schema := resources.Get "schemas/people.json" }}
+{{ $result := validate.Schema $schema site.Data.people.people }}
+Valid: {{ $result.Valid }}|
+{{ range $result.Errors }}Error: {{ .Path }}|{{ .Keyword }}|{{ .Message }}|
+{{ end }}
+{{ $inline := dict "type" "object" "required" (slice "title") }}
+Inline: {{ (validate.Schema $inline (dict "title" "foo")).Valid }}|{{ (validate.Schema $inline (dict)).Valid }}|
+YAML: {{ (validate.Schema "type: integer" 42).Valid }}|{{ (validate.Schema "type: integer" "42").Valid }}|
+Must: {{ index (validate.Must $schema (slice (dict "name" "Jo" "age" 42))) 0 "name" }}|
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/index.html",
+		"Valid: false|",
+		"Error: /1/name|minLength|minimum string length is 1|",
+		"Error: /1/age|minimum|number must be at least 0|",
+		"Error: /2/name|required|property &#34;name&#34; is missing|",
+		"Inline: true|false|",
+		"YAML: true|false|",
+		"Must: Jo|",
+	)
+
+	files = strings.Replace(files, `(slice (dict "name" "Jo" "age" 42))`, `site.Data.people.people`, 1)
+
+	b, err := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).BuildE()
+
+	b.Assert(err, qt.IsNotNil)
+	b.Assert(err.Error(), qt.Contains, `data does not match the schema: /1/age: number must be at least 0; /1/name: minimum string length is 1; /2/name: property "name" is missing`)
+}
+
+func TestSchemaUnsupportedKeywords(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+disableKinds = ["taxonomy", "term", "page", "section", "rss", "sitemap"]
+-- layouts/index.html --
+{{ $schema := dict "type" "object" "properties" (dict "const" (dict "SCHEMA")) }}
+{{ (validate.Schema $schema (dict)).Valid }}
+`
+
+	for _, test := range []struct {
+		schema string
+		err    string
+	}{
+		{`"$ref" "#/definitions/name"`, `unsupported schema: /properties/const/$ref: keyword "$ref" is not supported in an OpenAPI 3.0 schema`},
+		{`"const" "foo"`, `unsupported schema: /properties/const/const: keyword "const" is not supported`},
+		{`"type" (slice "string" "null")`, `unsupported schema: /properties/const/type: type must be a string, use nullable to allow null`},
+	} {
+		b, err := hugolib.NewIntegrationTestBuilder(
+			hugolib.IntegrationTestConfig{
+				T:           t,
+				TxtarString: strings.Replace(files, `"SCHEMA"`, test.schema, 1),
+			},
+		).BuildE()
+
+		b.Assert(err, qt.IsNotNil)
+		b.Assert(err.Error(), qt.Contains, test.err)
+	}
+
+	// A property named like an unsupported keyword is fine.
+	hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: strings.Replace(files, `"SCHEMA"`, `"type" "string"`, 1),
+		},
+	).Build()
+}
@@ -0,0 +1,299 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package validate provides template functions for validating data against an
+// OpenAPI 3.0 Schema Object, which is an extended subset of JSON Schema.
+package validate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	kopenapi3 "github.com/getkin/kin-openapi/openapi3"
+	gyaml "github.com/ghodss/yaml"
+	"github.com/gohugoio/hugo/cache/namedmemcache"
+	"github.com/gohugoio/hugo/deps"
+	"github.com/gohugoio/hugo/identity"
+	"github.com/gohugoio/hugo/resources/resource"
+)
+
+// New returns a new instance of the validate-namespaced template functions.
+func New(deps *deps.Deps) *Namespace {
+	cache := namedmemcache.New()
+	deps.BuildStartListeners.Add(
+		func() {
+			cache.Clear()
+		})
+
+	return &Namespace{
+		cache: cache,
+		deps:  deps,
+	}
+}
+
+// Namespace provides template functions for the "validate" namespace.
+type Namespace struct {
+	cache *namedmemcache.Cache
+	deps  *deps.Deps
+}
+
+// Result holds the result of a validation.
+type Result struct {
+	// The validation errors, empty if the data is valid.
+	Errors []ValidationError
+}
+
+// Valid reports whether the data is valid.
+func (r Result) Valid() bool {
+	return len(r.Errors) == 0
+}
+
+// Error returns the errors joined into one message.
+func (r Result) Error() string {
+	msgs := make([]string, len(r.Errors))
+	for i, e := range r.Errors {
+		msgs[i] = e.String()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// ValidationError describes a value that failed validation.
+type ValidationError struct {
+	// The JSON Pointer to the value, e.g. "/items/0/name", "/" for the root.
+	Path string
+
+	// The schema keyword that failed, e.g. "type" or "required".
+	Keyword string
+
+	// A human readable description of the error.
+	Message string
+}
+
+func (e ValidationError) String() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// Schema validates data against schema, an OpenAPI 3.0 Schema Object, and
+// returns the result.
+// The schema can be a map, e.g. created with dict or transform.Unmarshal,
+// a JSON or YAML string or a resource, e.g. from resources.Get.
+// JSON Schema keywords not supported by OpenAPI 3.0, e.g. $ref or const, and
+// type given as a list fail with an error rather than being ignored.
+func (ns *Namespace) Schema(schema, data any) (Result, error) {
+	s, err := ns.getSchema(schema)
+	if err != nil {
+		return Result{}, err
+	}
+
+	v, err := toJSONValue(data)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to convert data: %w", err)
+	}
+
+	return validate(s, v), nil
+}
+
+// Must validates data against schema and returns data if valid. Any
+// validation errors fail the build. See Schema.
+func (ns *Namespace) Must(schema, data any) (any, error) {
+	result, err := ns.Schema(schema, data)
+	if err != nil {
+		return nil, err
+	}
+	if !result.Valid() {
+		return nil, fmt.Errorf("data does not match the schema: %s", result.Error())
+	}
+	return data, nil
+}
+
+func (ns *Namespace) getSchema(schema any) (*kopenapi3.Schema, error) {
+	var (
+		key     string
+		getData func() ([]byte, error)
+	)
+
+	switch v := schema.(type) {
+	case resource.UnmarshableResource:
+		key = v.Key()
+		if key == "" {
+			return nil, errors.New("no Key set in Resource")
+		}
+		getData = func() ([]byte, error) {
+			r, err := v.ReadSeekCloser()
+			if err != nil {
+				return nil, err
+			}
+			defer r.Close()
+			return io.ReadAll(r)
+		}
+	case string:
+		key = identity.HashString(v)
+		getData = func() ([]byte, error) {
+			return []byte(v), nil
+		}
+	case map[string]any, map[string]string:
+		key = identity.HashString(v)
+		getData = func() ([]byte, error) {
+			return json.Marshal(v)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported schema type %T", schema)
+	}
+
+	v, err := ns.cache.GetOrCreate(key, func() (any, error) {
+		b, err := getData()
+		if err != nil {
+			return nil, err
+		}
+		// JSON is a subset of YAML, so this handles both.
+		var m map[string]any
+		if err := gyaml.Unmarshal(b, &m); err != nil {
+			return nil, fmt.Errorf("failed to parse schema: %w", err)
+		}
+		if err := checkSchema(m, ""); err != nil {
+			return nil, fmt.Errorf("unsupported schema: %w", err)
+		}
+		s := &kopenapi3.Schema{}
+		if err := gyaml.Unmarshal(b, s); err != nil {
+			return nil, fmt.Errorf("failed to parse schema: %w", err)
+		}
+		return s, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(*kopenapi3.Schema), nil
+}
+
+// unsupportedKeywords are JSON Schema keywords without an equivalent in an
+// OpenAPI 3.0 Schema Object. The $ref keyword is valid in OpenAPI 3.0, but
+// there is no document to resolve it in.
+var unsupportedKeywords = map[string]bool{
+	"$defs":                 true,
+	"$id":                   true,
+	"$ref":                  true,
+	"const":                 true,
+	"contains":              true,
+	"definitions":           true,
+	"dependencies":          true,
+	"dependentRequired":     true,
+	"dependentSchemas":      true,
+	"else":                  true,
+	"if":                    true,
+	"patternProperties":     true,
+	"prefixItems":           true,
+	"propertyNames":         true,
+	"then":                  true,
+	"unevaluatedItems":      true,
+	"unevaluatedProperties": true,
+}
+
+// checkSchema returns an error if the schema in m, located at the JSON
+// Pointer path, or any of its sub schemas uses JSON Schema features that
+// would otherwise be silently ignored.
+func checkSchema(m map[string]any, path string) error {
+	for k, v := range m {
+		if unsupportedKeywords[k] {
+			return fmt.Errorf("%s/%s: keyword %q is not supported in an OpenAPI 3.0 schema", path, k, k)
+		}
+		if _, ok := v.([]any); ok && k == "type" {
+			return fmt.Errorf("%s/type: type must be a string, use nullable to allow null", path)
+		}
+	}
+
+	check := func(v any, path string) error {
+		if mm, ok := v.(map[string]any); ok {
+			return checkSchema(mm, path)
+		}
+		return nil
+	}
+
+	for _, k := range []string{"items", "additionalProperties", "not"} {
+		if err := check(m[k], path+"/"+k); err != nil {
+			return err
+		}
+	}
+	for _, k := range []string{"allOf", "anyOf", "oneOf"} {
+		vv, _ := m[k].([]any)
+		for i, v := range vv {
+			if err := check(v, fmt.Sprintf("%s/%s/%d", path, k, i)); err != nil {
+				return err
+			}
+		}
+	}
+	props, _ := m["properties"].(map[string]any)
+	for name, v := range props {
+		if err := check(v, path+"/properties/"+name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validate(s *kopenapi3.Schema, v any) Result {
+	var result Result
+
+	err := s.VisitJSON(v, kopenapi3.MultiErrors(), kopenapi3.EnableFormatValidation())
+	if err == nil {
+		return result
+	}
+
+	var collect func(err error)
+	collect = func(err error) {
+		switch e := err.(type) {
+		case kopenapi3.MultiError:
+			for _, ee := range e {
+				collect(ee)
+			}
+		case *kopenapi3.SchemaError:
+			result.Errors = append(result.Errors, ValidationError{
+				Path:    "/" + strings.Join(e.JSONPointer(), "/"),
+				Keyword: e.SchemaField,
+				Message: e.Reason,
+			})
+		default:
+			if u, ok := err.(interface{ Unwrap() error }); ok && u.Unwrap() != nil {
+				collect(u.Unwrap())
+				return
+			}
+			result.Errors = append(result.Errors, ValidationError{Path: "/", Message: err.Error()})
+		}
+	}
+	collect(err)
+
+	sort.SliceStable(result.Errors, func(i, j int) bool {
+		return result.Errors[i].Path < result.Errors[j].Path
+	})
+
+	return result
+}
+
+// toJSONValue converts v to the types produced by encoding/json,
+// which is what the schema validation expects.
+func toJSONValue(v any) (any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var vv any
+	if err := json.Unmarshal(b, &vv); err != nil {
+		return nil, err
+	}
+	return vv, nil
+}
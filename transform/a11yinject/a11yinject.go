@@ -0,0 +1,363 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package a11yinject provides a transformer that injects a skip link and
+// landmark roles into rendered HTML, helping older themes meet accessibility
+// baselines without changing their templates.
+package a11yinject
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/transform"
+	"github.com/gohugoio/hugo/transform/internal/htmltag"
+	"github.com/mitchellh/mapstructure"
+)
+
+const accessibilityConfigKey = "accessibility"
+
+// Config configures the accessibility transformer.
+type Config struct {
+	// Enable to inject a skip link and landmark roles into HTML output.
+	Enable bool
+
+	// Selectors matching the main content element, tried in order.
+	// The first matching element gets role="main", unless the page already
+	// has a main landmark, and is the target of the skip link.
+	// Supported selectors are an element name, a role, an ID and class
+	// names, e.g. "#content", "div.content" or "div[role=main]".
+	Main []string
+
+	// Selectors matching the navigation elements.
+	// All matching elements get role="navigation", unless they are <nav>
+	// elements or already have a role.
+	Nav []string
+
+	// The text of the skip link.
+	SkipLinkText string
+
+	// The class of the skip link, used to style it, e.g. to only show it
+	// on focus.
+	SkipLinkClass string
+
+	// The ID to set on the main content element if it doesn't have one.
+	MainID string
+}
+
+func newDefaultConfig() Config {
+	return Config{
+		Main:          []string{"#main", "#content"},
+		SkipLinkText:  "Skip to main content",
+		SkipLinkClass: "skip-link",
+		MainID:        "main-content",
+	}
+}
+
+// DecodeConfig creates a Config from a given Hugo configuration.
+func DecodeConfig(cfg config.Provider) (Config, error) {
+	c := newDefaultConfig()
+
+	m := cfg.GetStringMap(accessibilityConfigKey)
+	if m == nil {
+		return c, nil
+	}
+
+	if err := mapstructure.WeakDecode(m, &c); err != nil {
+		return c, fmt.Errorf("failed to decode accessibility config: %w", err)
+	}
+
+	_, err := New(Config{Enable: true, Main: c.Main, Nav: c.Nav})
+
+	return c, err
+}
+
+// New creates a new transformer that injects the skip link and the landmark
+// roles configured in cfg.
+// It returns nil if not enabled.
+func New(cfg Config) (transform.Transformer, error) {
+	if !cfg.Enable {
+		return nil, nil
+	}
+
+	in := &injector{cfg: cfg}
+
+	for _, s := range cfg.Main {
+		sel, err := parseSelector(s)
+		if err != nil {
+			return nil, err
+		}
+		in.main = append(in.main, sel)
+	}
+	for _, s := range cfg.Nav {
+		sel, err := parseSelector(s)
+		if err != nil {
+			return nil, err
+		}
+		in.nav = append(in.nav, sel)
+	}
+
+	return in.transform, nil
+}
+
+// selector is a simple CSS selector, e.g. "div#content.main" or "[role=main]".
+type selector struct {
+	tag     string
+	id      string
+	classes []string
+	role    string
+}
+
+var (
+	selectorRe     = regexp.MustCompile(`^([a-zA-Z][a-zA-Z0-9-]*)?(?:\[role=["']?([a-zA-Z]+)["']?\])?((?:[#.][a-zA-Z0-9_-]+)*)$`)
+	selectorPartRe = regexp.MustCompile(`[#.][^#.]+`)
+)
+
+func parseSelector(s string) (selector, error) {
+	m := selectorRe.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil || m[0] == "" {
+		return selector{}, fmt.Errorf("accessibility: unsupported selector %q; use an element name, a role, an ID and class names, e.g. \"div#content.main\"", s)
+	}
+
+	sel := selector{tag: strings.ToLower(m[1]), role: strings.ToLower(m[2])}
+	for _, part := range selectorPartRe.FindAllString(m[3], -1) {
+		if part[0] == '#' {
+			if sel.id != "" {
+				return selector{}, fmt.Errorf("accessibility: unsupported selector %q; only one ID allowed", s)
+			}
+			sel.id = part[1:]
+		} else {
+			sel.classes = append(sel.classes, part[1:])
+		}
+	}
+
+	return sel, nil
+}
+
+func (s selector) matches(t *startTag) bool {
+	if s.tag != "" && s.tag != t.name {
+		return false
+	}
+	if s.role != "" && !strings.EqualFold(t.attr("role"), s.role) {
+		return false
+	}
+	if s.id != "" && t.attr("id") != s.id {
+		return false
+	}
+	if len(s.classes) > 0 {
+		classes := strings.Fields(t.attr("class"))
+		for _, c := range s.classes {
+			if !contains(classes, c) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+var (
+	// Matches the start of an element start tag.
+	startTagRe = regexp.MustCompile(`<([a-zA-Z][a-zA-Z0-9-]*)[\s/>]`)
+)
+
+// Elements with content we must not touch.
+var rawTextElements = map[string]bool{
+	"script":   true,
+	"style":    true,
+	"textarea": true,
+	"template": true,
+}
+
+type startTag struct {
+	name       string // Lower case.
+	start, end int
+	attrs      map[string]string // Lower case names, unescaped values.
+}
+
+func (t *startTag) attr(name string) string {
+	return t.attrs[name]
+}
+
+type injector struct {
+	cfg  Config
+	main []selector
+	nav  []selector
+}
+
+func (in *injector) transform(ft transform.FromTo) error {
+	b := ft.From().Bytes()
+	w := ft.To()
+
+	tags := startTags(b)
+
+	var (
+		body         *startTag
+		main         *startTag
+		mainRank     = len(in.main)
+		existingMain *startTag
+		navs         = make(map[*startTag]bool)
+	)
+
+	for _, t := range tags {
+		if t.name == "body" {
+			if body == nil {
+				body = t
+			}
+			continue
+		}
+		if existingMain == nil && (t.name == "main" || strings.EqualFold(t.attr("role"), "main")) {
+			existingMain = t
+		}
+		for i, sel := range in.main {
+			if i < mainRank && sel.matches(t) {
+				main, mainRank = t, i
+				break
+			}
+		}
+		if t.name != "nav" && t.attr("role") == "" {
+			for _, sel := range in.nav {
+				if sel.matches(t) {
+					navs[t] = true
+					break
+				}
+			}
+		}
+	}
+
+	if existingMain != nil {
+		main = existingMain
+	}
+
+	if main == nil && len(navs) == 0 {
+		// Nothing to do.
+		_, err := w.Write(b)
+		return err
+	}
+
+	var mainID string
+	if main != nil {
+		// The main element may also match a nav selector.
+		delete(navs, main)
+		mainID = main.attr("id")
+	}
+
+	// Don't add a skip link if the page already has a link to the main element.
+	addSkipLink := body != nil && main != nil && main.start >= body.end
+	if addSkipLink && mainID != "" {
+		for _, t := range tags {
+			if t.name == "a" && t.attr("href") == "#"+mainID {
+				addSkipLink = false
+				break
+			}
+		}
+	}
+	if mainID == "" {
+		mainID = in.cfg.MainID
+	}
+
+	pos := 0
+	for _, t := range tags {
+		var extra []string
+		switch {
+		case t == main:
+			if t.name != "main" && t.attr("role") == "" {
+				extra = append(extra, `role="main"`)
+			}
+			if t.attr("id") == "" && addSkipLink {
+				extra = append(extra, `id=`+htmltag.QuoteValue(mainID))
+			}
+		case navs[t]:
+			extra = append(extra, `role="navigation"`)
+		}
+
+		if len(extra) > 0 {
+			end := t.end - 1
+			if b[end-1] == '/' {
+				end--
+			}
+			if _, err := w.Write(b[pos:end]); err != nil {
+				return err
+			}
+			if _, err := w.Write([]byte(" " + strings.Join(extra, " "))); err != nil {
+				return err
+			}
+			pos = end
+		}
+
+		if t == body && addSkipLink {
+			if _, err := w.Write(b[pos:t.end]); err != nil {
+				return err
+			}
+			link := fmt.Sprintf(`<a class=%s href=%s>%s</a>`, htmltag.QuoteValue(in.cfg.SkipLinkClass), htmltag.QuoteValue("#"+mainID), html.EscapeString(in.cfg.SkipLinkText))
+			if _, err := w.Write([]byte(link)); err != nil {
+				return err
+			}
+			pos = t.end
+		}
+	}
+
+	_, err := w.Write(b[pos:])
+	return err
+}
+
+// startTags returns the element start tags in b, in order.
+func startTags(b []byte) []*startTag {
+	var tags []*startTag
+	offset := 0
+	for {
+		loc := startTagRe.FindSubmatchIndex(b[offset:])
+		if loc == nil {
+			break
+		}
+		start := offset + loc[0]
+		name := strings.ToLower(string(b[offset+loc[2] : offset+loc[3]]))
+
+		end := htmltag.End(b[start:])
+		if end == -1 {
+			break
+		}
+		end += start
+
+		t := &startTag{name: name, start: start, end: end, attrs: make(map[string]string)}
+		attrsEnd := end - 1
+		for _, a := range htmltag.Attributes(b[offset+loc[3] : attrsEnd]) {
+			if _, found := t.attrs[a.Name]; !found {
+				t.attrs[a.Name] = html.UnescapeString(a.Value)
+			}
+		}
+		tags = append(tags, t)
+		offset = end
+
+		if rawTextElements[name] {
+			// Skip to the end of the element.
+			i := strings.Index(strings.ToLower(string(b[offset:])), "</"+name)
+			if i == -1 {
+				break
+			}
+			offset += i
+		}
+	}
+	return tags
+}
+
+func contains(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
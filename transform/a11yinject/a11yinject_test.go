@@ -0,0 +1,126 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package a11yinject
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/transform"
+)
+
+func TestA11yInject(t *testing.T) {
+	c := qt.New(t)
+
+	apply := func(cfg Config, in string) string {
+		tr, err := New(cfg)
+		c.Assert(err, qt.IsNil)
+		out := new(bytes.Buffer)
+		chain := transform.New(tr)
+		c.Assert(chain.Apply(out, strings.NewReader(in)), qt.IsNil)
+		return out.String()
+	}
+
+	cfg := newDefaultConfig()
+	cfg.Enable = true
+	cfg.Main = []string{"div.content", "#content"}
+	cfg.Nav = []string{"ul#menu", "div[role=menu]", ".sidebar-nav"}
+
+	for _, test := range []struct {
+		name   string
+		in     string
+		expect string
+	}{
+		{
+			"main selector",
+			`<body class="home"><div id="content"><p>x</p></div></body>`,
+			`<body class="home"><a class="skip-link" href="#content">Skip to main content</a><div id="content" role="main"><p>x</p></div></body>`,
+		},
+		{
+			"selector order",
+			`<body><div id="content"><div class="content wide">x</div></div></body>`,
+			`<body><a class="skip-link" href="#main-content">Skip to main content</a><div id="content"><div class="content wide" role="main" id="main-content">x</div></div></body>`,
+		},
+		{
+			"existing main",
+			`<body><div id="content"><main>x</main></div></body>`,
+			`<body><a class="skip-link" href="#main-content">Skip to main content</a><div id="content"><main id="main-content">x</main></div></body>`,
+		},
+		{
+			"existing skip link",
+			`<body><a href="#top">Skip</a><a href="#main">Skip</a><main id="main">x</main></body>`,
+			`<body><a href="#top">Skip</a><a href="#main">Skip</a><main id="main">x</main></body>`,
+		},
+		{
+			"nav",
+			`<body><ul id="menu"><li>x</li></ul><div class="sidebar-nav" role="complementary"></div><nav class="sidebar-nav"></nav><div class="sidebar-nav"/></body>`,
+			`<body><ul id="menu" role="navigation"><li>x</li></ul><div class="sidebar-nav" role="complementary"></div><nav class="sidebar-nav"></nav><div class="sidebar-nav" role="navigation"/></body>`,
+		},
+		{
+			"script and quoted attributes",
+			`<body data-x="a > b"><script>var s = '<div id="content">';</script><div title='<main>' id="content">x</div></body>`,
+			`<body data-x="a > b"><a class="skip-link" href="#content">Skip to main content</a><script>var s = '<div id="content">';</script><div title='<main>' id="content" role="main">x</div></body>`,
+		},
+		{
+			"no body",
+			`<div id="content">x</div>`,
+			`<div id="content" role="main">x</div>`,
+		},
+		{
+			"nothing to do",
+			`<body><p>x</p></body>`,
+			`<body><p>x</p></body>`,
+		},
+	} {
+		c.Run(test.name, func(c *qt.C) {
+			c.Assert(apply(cfg, test.in), qt.Equals, test.expect)
+		})
+	}
+
+	cfg.SkipLinkText = "Zum Inhalt <springen>"
+	c.Assert(apply(cfg, `<body><main>x</main></body>`), qt.Equals, `<body><a class="skip-link" href="#main-content">Zum Inhalt &lt;springen&gt;</a><main id="main-content">x</main></body>`)
+}
+
+func TestDecodeConfig(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := config.New()
+	conf, err := DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Enable, qt.IsFalse)
+	c.Assert(conf.Main, qt.DeepEquals, []string{"#main", "#content"})
+
+	tr, err := New(conf)
+	c.Assert(err, qt.IsNil)
+	c.Assert(tr, qt.IsNil)
+
+	cfg.Set("accessibility", map[string]any{
+		"enable": true,
+		"nav":    []string{"#menu"},
+	})
+	conf, err = DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Enable, qt.IsTrue)
+	c.Assert(conf.Nav, qt.DeepEquals, []string{"#menu"})
+	c.Assert(conf.SkipLinkText, qt.Equals, "Skip to main content")
+
+	cfg.Set("accessibility", map[string]any{
+		"main": []string{"div > main"},
+	})
+	_, err = DecodeConfig(cfg)
+	c.Assert(err, qt.ErrorMatches, `accessibility: unsupported selector "div > main".*`)
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package a11yinject_test
+
+import (
+	"testing"
+
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestA11yInjectIntegration(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.com/"
+disableKinds = ["taxonomy", "term", "sitemap", "robotsTXT", "404"]
+defaultContentLanguage = "en"
+[accessibility]
+enable = true
+nav = ["#menu"]
+[languages.en]
+weight = 1
+[languages.de]
+weight = 2
+[languages.de.accessibility]
+enable = true
+nav = ["#menu"]
+skipLinkText = "Zum Inhalt springen"
+-- content/p1.md --
+---
+title: "P1"
+---
+Content.
+-- content/p1.de.md --
+---
+title: "P1 DE"
+---
+Inhalt.
+-- layouts/_default/single.html --
+<html><body><ul id="menu"><li>Home</li></ul><div id="content">{{ .Content }}</div></body></html>
+-- layouts/index.xml --
+<body><div id="content"></div></body>
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		`<body><a class="skip-link" href="#content">Skip to main content</a><ul id="menu" role="navigation">`,
+		`<div id="content" role="main">`,
+	)
+	b.AssertFileContent("public/de/p1/index.html",
+		`<a class="skip-link" href="#content">Zum Inhalt springen</a>`,
+	)
+
+	// Only HTML is transformed.
+	b.AssertFileContent("public/index.xml", `<body><div id="content"></div></body>`)
+}
@@ -0,0 +1,273 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package externallinks provides a transformer that applies a site wide
+// policy to external links in rendered HTML.
+package externallinks
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/gobwas/glob"
+	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/transform"
+	"github.com/gohugoio/hugo/transform/internal/htmltag"
+	"github.com/mitchellh/mapstructure"
+)
+
+const (
+	externalLinksConfigKey = "externallinks"
+
+	// The placeholder in Redirect that will be replaced with the query escaped link URL.
+	urlPlaceholder = ":url"
+)
+
+// Config configures the policy applied to external links in HTML output.
+type Config struct {
+	// Values to add to the rel attribute of external links, e.g. ["nofollow", "noopener"].
+	Rel []string
+
+	// If set, the target attribute to set on external links, e.g. "_blank".
+	// Any existing target attribute is preserved.
+	Target string
+
+	// If set, external links will be rewritten to go through this URL.
+	// The :url placeholder will be replaced with the query escaped link URL,
+	// e.g. "https://example.com/out?to=:url".
+	Redirect string
+
+	// Glob patterns matching host names that are exempt from this policy,
+	// e.g. ["example.org", "*.example.org"].
+	// The host of the site's baseURL is always exempt.
+	Exempt []string
+}
+
+// IsZero returns whether no policy is configured.
+func (c Config) IsZero() bool {
+	return len(c.Rel) == 0 && c.Target == "" && c.Redirect == ""
+}
+
+// DecodeConfig creates a Config from a given Hugo configuration.
+func DecodeConfig(cfg config.Provider) (c Config, err error) {
+	m := cfg.GetStringMap(externalLinksConfigKey)
+	if m == nil {
+		return
+	}
+
+	err = mapstructure.WeakDecode(m, &c)
+	if err != nil {
+		return c, fmt.Errorf("failed to decode externalLinks config: %w", err)
+	}
+
+	if c.Redirect != "" && !strings.Contains(c.Redirect, urlPlaceholder) {
+		return c, fmt.Errorf("externalLinks.redirect: must contain the %s placeholder", urlPlaceholder)
+	}
+
+	return
+}
+
+// New creates a new transformer that applies the policy in cfg to external
+// links, i.e. links pointing to a host other than the one in baseURL.
+// It returns nil if no policy is configured.
+func New(cfg Config, baseURL *url.URL) (transform.Transformer, error) {
+	if cfg.IsZero() {
+		return nil, nil
+	}
+
+	p := &policy{
+		cfg: cfg,
+	}
+	if baseURL != nil {
+		p.siteHost = strings.ToLower(baseURL.Hostname())
+	}
+
+	for _, pattern := range cfg.Exempt {
+		g, err := glob.Compile(strings.ToLower(pattern), '.')
+		if err != nil {
+			return nil, fmt.Errorf("externalLinks.exempt: invalid pattern %q: %w", pattern, err)
+		}
+		p.exempt = append(p.exempt, g)
+	}
+
+	return p.transform, nil
+}
+
+var (
+	// Matches the start of a link element or a script or style element,
+	// the content of which we must not touch.
+	startTagRe = regexp.MustCompile(`(?i)<(a|script|style)[\s>]`)
+)
+
+type policy struct {
+	cfg      Config
+	siteHost string
+	exempt   []glob.Glob
+}
+
+func (p *policy) transform(ft transform.FromTo) error {
+	b := ft.From().Bytes()
+	w := ft.To()
+
+	for {
+		loc := startTagRe.FindSubmatchIndex(b)
+		if loc == nil {
+			break
+		}
+
+		name := strings.ToLower(string(b[loc[2]:loc[3]]))
+		if name != "a" {
+			// Skip to the end of the script or style element.
+			end := bytes.Index(bytes.ToLower(b[loc[1]:]), []byte("</"+name))
+			if end == -1 {
+				break
+			}
+			end += loc[1]
+			if _, err := w.Write(b[:end]); err != nil {
+				return err
+			}
+			b = b[end:]
+			continue
+		}
+
+		end := htmltag.End(b[loc[0]:])
+		if end == -1 {
+			break
+		}
+		end += loc[0]
+
+		if _, err := w.Write(b[:loc[0]]); err != nil {
+			return err
+		}
+		if _, err := w.Write(p.rewriteTag(b[loc[0]:end])); err != nil {
+			return err
+		}
+
+		b = b[end:]
+	}
+
+	_, err := w.Write(b)
+	return err
+}
+
+// rewriteTag rewrites the given <a> start tag if it points to an external link.
+func (p *policy) rewriteTag(tag []byte) []byte {
+	// Skip the element name.
+	attrsStart := 2
+	attrsEnd := len(tag) - 1
+	selfClosing := tag[attrsEnd-1] == '/'
+	if selfClosing {
+		attrsEnd--
+	}
+
+	var (
+		href      string
+		hasTarget bool
+		attrs     = htmltag.Attributes(tag[attrsStart:attrsEnd])
+	)
+
+	for _, a := range attrs {
+		switch a.Name {
+		case "href":
+			href = html.UnescapeString(a.Value)
+		case "target":
+			hasTarget = true
+		}
+	}
+
+	if !p.isExternal(href) {
+		return tag
+	}
+
+	var rel []string
+	var sb strings.Builder
+	sb.WriteString("<a")
+
+	for _, a := range attrs {
+		rawValue := a.RawValue
+		switch a.Name {
+		case "rel":
+			rel = append(rel, strings.Fields(html.UnescapeString(a.Value))...)
+			continue
+		case "href":
+			if p.cfg.Redirect != "" {
+				rawValue = htmltag.QuoteValue(strings.Replace(p.cfg.Redirect, urlPlaceholder, url.QueryEscape(href), 1))
+			}
+		}
+		sb.WriteString(" ")
+		sb.WriteString(a.RawName)
+		if rawValue != "" {
+			sb.WriteString("=")
+			sb.WriteString(rawValue)
+		}
+	}
+
+	for _, r := range p.cfg.Rel {
+		if !containsFold(rel, r) {
+			rel = append(rel, r)
+		}
+	}
+	if len(rel) > 0 {
+		sb.WriteString(" rel=" + htmltag.QuoteValue(strings.Join(rel, " ")))
+	}
+
+	if p.cfg.Target != "" && !hasTarget {
+		sb.WriteString(" target=" + htmltag.QuoteValue(p.cfg.Target))
+	}
+
+	if selfClosing {
+		sb.WriteString(" /")
+	}
+	sb.WriteString(">")
+
+	return []byte(sb.String())
+}
+
+func (p *policy) isExternal(href string) bool {
+	href = strings.TrimSpace(href)
+	lower := strings.ToLower(href)
+	if !(strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://") || strings.HasPrefix(lower, "//")) {
+		return false
+	}
+
+	u, err := url.Parse(href)
+	if err != nil {
+		return false
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if host == "" || host == p.siteHost {
+		return false
+	}
+
+	for _, g := range p.exempt {
+		if g.Match(host) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
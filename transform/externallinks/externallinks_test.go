@@ -0,0 +1,101 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externallinks
+
+import (
+	"bytes"
+	"net/url"
+	"strings"
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/config"
+	"github.com/gohugoio/hugo/transform"
+)
+
+func TestExternalLinks(t *testing.T) {
+	c := qt.New(t)
+
+	baseURL, _ := url.Parse("https://example.com/docs/")
+
+	apply := func(cfg Config, in string) string {
+		tr, err := New(cfg, baseURL)
+		c.Assert(err, qt.IsNil)
+		out := new(bytes.Buffer)
+		chain := transform.New(tr)
+		c.Assert(chain.Apply(out, strings.NewReader(in)), qt.IsNil)
+		return out.String()
+	}
+
+	cfg := Config{
+		Rel:    []string{"nofollow", "noopener"},
+		Target: "_blank",
+		Exempt: []string{"*.example.org", "gohugo.io"},
+	}
+
+	for _, test := range []struct {
+		in     string
+		expect string
+	}{
+		{`<a href="https://external.com/">x</a>`, `<a href="https://external.com/" rel="nofollow noopener" target="_blank">x</a>`},
+		{`<A HREF='http://external.com/?a=1&amp;b=2' class=foo>x</A>`, `<a HREF='http://external.com/?a=1&amp;b=2' class=foo rel="nofollow noopener" target="_blank">x</A>`},
+		{`<a rel="NoFollow me" href="//external.com" target="_self">x</a>`, `<a href="//external.com" target="_self" rel="NoFollow me noopener">x</a>`},
+		{`<a title="a > b" href="https://external.com">x</a>`, `<a title="a > b" href="https://external.com" rel="nofollow noopener" target="_blank">x</a>`},
+		// Internal and exempt links.
+		{`<a href="https://example.com/docs/">x</a>`, `<a href="https://example.com/docs/">x</a>`},
+		{`<a href="/docs/">x</a>`, `<a href="/docs/">x</a>`},
+		{`<a href="#foo">x</a>`, `<a href="#foo">x</a>`},
+		{`<a href="mailto:foo@external.com">x</a>`, `<a href="mailto:foo@external.com">x</a>`},
+		{`<a href="https://www.example.org/">x</a>`, `<a href="https://www.example.org/">x</a>`},
+		{`<a href="https://GoHugo.io/">x</a>`, `<a href="https://GoHugo.io/">x</a>`},
+		{`<a>x</a>`, `<a>x</a>`},
+		// Not links.
+		{`<abbr title="https://external.com">x</abbr><article>`, `<abbr title="https://external.com">x</abbr><article>`},
+		{`<script>var s = '<a href="https://external.com">';</script><a href="https://external.com">x</a>`, `<script>var s = '<a href="https://external.com">';</script><a href="https://external.com" rel="nofollow noopener" target="_blank">x</a>`},
+		{`<p>Unclosed <a href="https://external.com"`, `<p>Unclosed <a href="https://external.com"`},
+	} {
+		c.Assert(apply(cfg, test.in), qt.Equals, test.expect, qt.Commentf(test.in))
+	}
+
+	redirect := Config{Redirect: "https://example.com/out?to=:url"}
+	c.Assert(apply(redirect, `<a href="https://external.com/?a=1&amp;b=2">x</a>`), qt.Equals, `<a href="https://example.com/out?to=https%3A%2F%2Fexternal.com%2F%3Fa%3D1%26b%3D2">x</a>`)
+
+	tr, err := New(Config{}, baseURL)
+	c.Assert(err, qt.IsNil)
+	c.Assert(tr, qt.IsNil)
+
+	_, err = New(Config{Rel: []string{"nofollow"}, Exempt: []string{"["}}, baseURL)
+	c.Assert(err, qt.ErrorMatches, ".*invalid pattern.*")
+}
+
+func TestDecodeConfig(t *testing.T) {
+	c := qt.New(t)
+
+	cfg := config.New()
+	cfg.Set("externalLinks", map[string]any{
+		"rel":      []any{"nofollow"},
+		"redirect": "https://example.com/out?to=:url",
+	})
+
+	conf, err := DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.Rel, qt.DeepEquals, []string{"nofollow"})
+	c.Assert(conf.IsZero(), qt.IsFalse)
+
+	cfg.Set("externalLinks", map[string]any{
+		"redirect": "https://example.com/out",
+	})
+	_, err = DecodeConfig(cfg)
+	c.Assert(err, qt.ErrorMatches, ".*must contain the :url placeholder")
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externallinks_test
+
+import (
+	"testing"
+
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestExternalLinksIntegration(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.com/"
+disableKinds = ["taxonomy", "term", "sitemap", "robotsTXT", "404"]
+[externalLinks]
+rel = ["nofollow", "noopener"]
+redirect = "https://example.com/out/?to=:url"
+exempt = ["gohugo.io"]
+-- content/p1.md --
+---
+title: "P1"
+---
+[External](https://external.com/page) [Hugo](https://gohugo.io/) [Internal](/p2/)
+-- layouts/_default/single.html --
+{{ .Content }}
+-- layouts/index.xml --
+<link>https://external.com/</link><a href="https://external.com/">x</a>
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/p1/index.html",
+		`<a href="https://example.com/out/?to=https%3A%2F%2Fexternal.com%2Fpage" rel="nofollow noopener">External</a>`,
+		`<a href="https://gohugo.io/">Hugo</a>`,
+		`<a href="/p2/">Internal</a>`,
+	)
+
+	// Only HTML is transformed.
+	b.AssertFileContent("public/index.xml", `<a href="https://external.com/">x</a>`)
+}
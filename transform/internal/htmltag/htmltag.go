@@ -0,0 +1,76 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package htmltag provides a minimal scanner for HTML start tags, used by the
+// transformers that inspect or rewrite elements in the published HTML.
+package htmltag
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// Matches an attribute in a start tag.
+var attrRe = regexp.MustCompile(`([^\s"'>/=]+)(?:\s*=\s*("[^"]*"|'[^']*'|[^\s"'>]+))?`)
+
+// Attribute is an attribute in a start tag.
+type Attribute struct {
+	Name     string // Lower case.
+	RawName  string
+	Value    string // Unquoted, but not unescaped.
+	RawValue string
+}
+
+// End returns the index after the closing '>' of the start tag in b,
+// ignoring any '>' in quoted attribute values, or -1 if not found.
+func End(b []byte) int {
+	var quote byte
+	for i, c := range b {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '>':
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// Attributes returns the attributes in b, the part of a start tag between
+// the element name and the closing '>', in order.
+func Attributes(b []byte) []Attribute {
+	var attrs []Attribute
+	for _, m := range attrRe.FindAllSubmatch(b, -1) {
+		a := Attribute{
+			Name:     strings.ToLower(string(m[1])),
+			RawName:  string(m[1]),
+			RawValue: string(m[2]),
+		}
+		a.Value = a.RawValue
+		if len(a.Value) > 1 && (a.Value[0] == '"' || a.Value[0] == '\'') {
+			a.Value = a.Value[1 : len(a.Value)-1]
+		}
+		attrs = append(attrs, a)
+	}
+	return attrs
+}
+
+// QuoteValue returns s escaped and double quoted for use as an attribute value.
+func QuoteValue(s string) string {
+	return `"` + html.EscapeString(s) + `"`
+}
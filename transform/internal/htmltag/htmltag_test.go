@@ -0,0 +1,46 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package htmltag
+
+import (
+	"testing"
+
+	qt "github.com/frankban/quicktest"
+)
+
+func TestEnd(t *testing.T) {
+	c := qt.New(t)
+
+	c.Assert(End([]byte(`<a href="/">foo</a>`)), qt.Equals, 12)
+	c.Assert(End([]byte(`<a title="a > b" href='>'>foo</a>`)), qt.Equals, 26)
+	c.Assert(End([]byte(`<a href="/`)), qt.Equals, -1)
+}
+
+func TestAttributes(t *testing.T) {
+	c := qt.New(t)
+
+	c.Assert(Attributes([]byte(` HREF="/a?b=1&amp;c=2" target='_blank' data-x=y hidden /`)), qt.DeepEquals, []Attribute{
+		{Name: "href", RawName: "HREF", Value: "/a?b=1&amp;c=2", RawValue: `"/a?b=1&amp;c=2"`},
+		{Name: "target", RawName: "target", Value: "_blank", RawValue: `'_blank'`},
+		{Name: "data-x", RawName: "data-x", Value: "y", RawValue: "y"},
+		{Name: "hidden", RawName: "hidden"},
+	})
+	c.Assert(Attributes(nil), qt.IsNil)
+}
+
+func TestQuoteValue(t *testing.T) {
+	c := qt.New(t)
+
+	c.Assert(QuoteValue(`a "b" & c`), qt.Equals, `"a &#34;b&#34; &amp; c"`)
+}
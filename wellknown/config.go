@@ -0,0 +1,123 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wellknown provides configuration and generation of the common
+// files published below /.well-known/.
+package wellknown
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gohugoio/hugo/common/htime"
+	"github.com/gohugoio/hugo/config"
+	"github.com/mitchellh/mapstructure"
+)
+
+const wellKnownConfigKey = "wellknown"
+
+// Config configures the files to generate below /.well-known/.
+type Config struct {
+	// The security.txt file, see RFC 9116.
+	SecurityTxt SecurityTxt
+
+	// The change-password redirect, see https://w3c.github.io/webappsec-change-password-url/
+	ChangePassword ChangePassword
+
+	// Android Digital Asset Links statements, published as assetlinks.json.
+	// This can be a slice of statements or a JSON string.
+	AssetLinks any
+
+	// Apple App Site Association, published as apple-app-site-association.
+	// Note that keys in config maps are lower cased, so use a JSON string
+	// to preserve keys such as appIDs.
+	AppleAppSiteAssociation any
+}
+
+// IsZero returns whether no .well-known files are configured.
+func (c Config) IsZero() bool {
+	return c.SecurityTxt.IsZero() && c.ChangePassword.URL == "" && c.AssetLinks == nil && c.AppleAppSiteAssociation == nil
+}
+
+// SecurityTxt holds the fields of a security.txt file.
+type SecurityTxt struct {
+	// One or more URIs (e.g. mailto: or https:) to report security issues to. Required.
+	Contact []string
+
+	// The date and time after which the data in the file should be considered stale. Required.
+	// Any value that can be parsed as a date is allowed, e.g. "2024-01-01" or "2024-01-01T00:00:00Z".
+	Expires string
+
+	// URIs to encryption keys.
+	Encryption []string
+
+	// URIs to acknowledgment pages.
+	Acknowledgments []string
+
+	// Comma separated list of language codes.
+	PreferredLanguages string
+
+	// The canonical URIs of this file.
+	Canonical []string
+
+	// URIs to the security policy.
+	Policy []string
+
+	// URIs to security related job postings.
+	Hiring []string
+}
+
+// IsZero returns whether no security.txt is configured.
+func (s SecurityTxt) IsZero() bool {
+	return len(s.Contact) == 0 && s.Expires == ""
+}
+
+// ChangePassword holds the configuration for the change-password redirect.
+type ChangePassword struct {
+	// The URL of the page where users can change their password.
+	URL string
+}
+
+// DecodeConfig creates a Config from a given Hugo configuration.
+func DecodeConfig(cfg config.Provider) (c Config, err error) {
+	m := cfg.GetStringMap(wellKnownConfigKey)
+	if m == nil {
+		return
+	}
+
+	err = mapstructure.WeakDecode(m, &c)
+	if err != nil {
+		return c, fmt.Errorf("failed to decode wellKnown config: %w", err)
+	}
+
+	return
+}
+
+// CompileConfig validates the configuration.
+// The given clock is used to check the security.txt expiry date, see Config.Files.
+func (c *Config) CompileConfig(clock time.Time) error {
+	_, _, err := c.Files(clock)
+	return err
+}
+
+// ExpiresTime parses and validates the Expires field.
+func (s SecurityTxt) ExpiresTime() (time.Time, error) {
+	if s.Expires == "" {
+		return time.Time{}, fmt.Errorf("wellKnown.securityTxt: expires must be set")
+	}
+	t, err := htime.ToTimeInDefaultLocationE(s.Expires, time.UTC)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("wellKnown.securityTxt: failed to parse expires %q: %w", s.Expires, err)
+	}
+	return t, nil
+}
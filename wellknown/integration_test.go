@@ -0,0 +1,76 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wellknown_test
+
+import (
+	"testing"
+
+	"github.com/gohugoio/hugo/hugolib"
+)
+
+func TestWellKnown(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+disableKinds = ["taxonomy", "term", "section", "page", "sitemap", "RSS"]
+[wellKnown.securityTxt]
+contact = ["mailto:security@example.org", "https://example.org/security/"]
+expires = "2099-01-01"
+[wellKnown.changePassword]
+url = "/account/password/"
+[wellKnown]
+appleAppSiteAssociation = '{"applinks":{"details":[{"appIDs":["ABCDE.org.example.app"]}]}}'
+-- layouts/index.html --
+Home.
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/.well-known/security.txt",
+		"Contact: mailto:security@example.org\nContact: https://example.org/security/\nExpires: 2099-01-01T00:00:00Z",
+	)
+	b.AssertFileContent("public/.well-known/change-password/index.html", "https://example.org/account/password/")
+	b.AssertFileContent("public/.well-known/apple-app-site-association", `"appIDs":["ABCDE.org.example.app"]`)
+	b.AssertDestinationExists("public/.well-known/assetlinks.json", false)
+	b.AssertLogContains("more than a year into the future")
+}
+
+func TestWellKnownSecurityTxtExpired(t *testing.T) {
+	t.Parallel()
+
+	files := `
+-- hugo.toml --
+baseURL = "https://example.org/"
+[wellKnown.securityTxt]
+contact = "mailto:security@example.org"
+expires = "2020-01-01"
+`
+
+	b := hugolib.NewIntegrationTestBuilder(
+		hugolib.IntegrationTestConfig{
+			T:           t,
+			TxtarString: files,
+		},
+	).Build()
+
+	b.AssertFileContent("public/.well-known/security.txt", "Expires: 2020-01-01T00:00:00Z")
+	b.AssertLogContains("is in the past")
+}
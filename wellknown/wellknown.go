@@ -0,0 +1,135 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wellknown
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gohugoio/hugo/common/htime"
+)
+
+const (
+	// Dir is the directory all files are published to, relative to the publish root.
+	Dir = ".well-known"
+
+	SecurityTxtName             = "security.txt"
+	ChangePasswordName          = "change-password"
+	AssetLinksName              = "assetlinks.json"
+	AppleAppSiteAssociationName = "apple-app-site-association"
+)
+
+// securityTxtMaxAge is the recommended max time between now and the expires date.
+const securityTxtMaxAge = 366 * 24 * time.Hour
+
+// File is a file to publish below Dir.
+type File struct {
+	// The filename relative to Dir.
+	Name string
+
+	// The file content.
+	Content []byte
+
+	// Whether this is a JSON file.
+	IsJSON bool
+}
+
+// Files creates the static .well-known files configured in c.
+// Note that the change-password redirect is not included, as that is
+// an alias and needs to be handled by the caller.
+// The returned warnings should be logged by the caller.
+func (c Config) Files(now time.Time) (files []File, warnings []string, err error) {
+	if !c.SecurityTxt.IsZero() {
+		b, warns, err := c.SecurityTxt.Bytes(now)
+		if err != nil {
+			return nil, nil, err
+		}
+		warnings = append(warnings, warns...)
+		files = append(files, File{Name: SecurityTxtName, Content: b})
+	}
+
+	if c.AssetLinks != nil {
+		b, err := toJSON(c.AssetLinks)
+		if err != nil {
+			return nil, nil, fmt.Errorf("wellKnown.assetLinks: %w", err)
+		}
+		files = append(files, File{Name: AssetLinksName, Content: b, IsJSON: true})
+	}
+
+	if c.AppleAppSiteAssociation != nil {
+		b, err := toJSON(c.AppleAppSiteAssociation)
+		if err != nil {
+			return nil, nil, fmt.Errorf("wellKnown.appleAppSiteAssociation: %w", err)
+		}
+		files = append(files, File{Name: AppleAppSiteAssociationName, Content: b, IsJSON: true})
+	}
+
+	return
+}
+
+// Bytes renders the security.txt file as defined in RFC 9116.
+func (s SecurityTxt) Bytes(now time.Time) ([]byte, []string, error) {
+	expires, err := s.ExpiresTime()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(s.Contact) == 0 {
+		return nil, nil, fmt.Errorf("wellKnown.securityTxt: at least one contact must be set")
+	}
+
+	var warnings []string
+	if now.IsZero() {
+		now = htime.Now()
+	}
+	if !expires.After(now) {
+		// Don't fail the build, e.g. when building an old commit.
+		warnings = append(warnings, fmt.Sprintf("wellKnown.securityTxt: expires %s is in the past, update it to keep security.txt valid", expires.Format(time.RFC3339)))
+	} else if expires.Sub(now) > securityTxtMaxAge {
+		warnings = append(warnings, fmt.Sprintf("wellKnown.securityTxt: expires %s is more than a year into the future, this is not recommended", expires.Format(time.RFC3339)))
+	}
+
+	var buf bytes.Buffer
+	writeFields := func(name string, values []string) {
+		for _, v := range values {
+			fmt.Fprintf(&buf, "%s: %s\n", name, v)
+		}
+	}
+
+	writeFields("Contact", s.Contact)
+	writeFields("Expires", []string{expires.UTC().Format(time.RFC3339)})
+	writeFields("Encryption", s.Encryption)
+	writeFields("Acknowledgments", s.Acknowledgments)
+	if s.PreferredLanguages != "" {
+		writeFields("Preferred-Languages", []string{s.PreferredLanguages})
+	}
+	writeFields("Canonical", s.Canonical)
+	writeFields("Policy", s.Policy)
+	writeFields("Hiring", s.Hiring)
+
+	return buf.Bytes(), warnings, nil
+}
+
+// toJSON marshals v to JSON. Strings are validated and returned as-is.
+func toJSON(v any) ([]byte, error) {
+	if s, ok := v.(string); ok {
+		b := []byte(s)
+		if !json.Valid(b) {
+			return nil, fmt.Errorf("invalid JSON: %q", s)
+		}
+		return b, nil
+	}
+	return json.MarshalIndent(v, "", "  ")
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 The Hugo Authors. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wellknown
+
+import (
+	"testing"
+	"time"
+
+	qt "github.com/frankban/quicktest"
+	"github.com/gohugoio/hugo/config"
+)
+
+func TestDecodeConfig(t *testing.T) {
+	c := qt.New(t)
+
+	tomlConfig := `
+[wellKnown.securityTxt]
+contact = "mailto:security@example.org"
+expires = "2024-01-01"
+preferredLanguages = "en, no"
+[wellKnown.changePassword]
+url = "/account/password/"
+[[wellKnown.assetLinks]]
+relation = ["delegate_permission/common.handle_all_urls"]
+[wellKnown.assetLinks.target]
+namespace = "android_app"
+package_name = "org.example.app"
+`
+	cfg, err := config.FromConfigString(tomlConfig, "toml")
+	c.Assert(err, qt.IsNil)
+
+	conf, err := DecodeConfig(cfg)
+	c.Assert(err, qt.IsNil)
+	c.Assert(conf.IsZero(), qt.IsFalse)
+	c.Assert(conf.SecurityTxt.Contact, qt.DeepEquals, []string{"mailto:security@example.org"})
+	c.Assert(conf.SecurityTxt.PreferredLanguages, qt.Equals, "en, no")
+	c.Assert(conf.ChangePassword.URL, qt.Equals, "/account/password/")
+
+	files, warnings, err := conf.Files(time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC))
+	c.Assert(err, qt.IsNil)
+	c.Assert(warnings, qt.HasLen, 0)
+	c.Assert(files, qt.HasLen, 2)
+	c.Assert(files[0].Name, qt.Equals, SecurityTxtName)
+	c.Assert(string(files[0].Content), qt.Equals, "Contact: mailto:security@example.org\nExpires: 2024-01-01T00:00:00Z\nPreferred-Languages: en, no\n")
+	c.Assert(files[1].Name, qt.Equals, AssetLinksName)
+	c.Assert(string(files[1].Content), qt.Contains, `"package_name": "org.example.app"`)
+}
+
+func TestSecurityTxtExpires(t *testing.T) {
+	c := qt.New(t)
+
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	s := SecurityTxt{Contact: []string{"mailto:a@b.org"}, Expires: "2023-01-01"}
+	b, warnings, err := s.Bytes(now)
+	c.Assert(err, qt.IsNil)
+	c.Assert(string(b), qt.Contains, "Expires: 2023-01-01T00:00:00Z")
+	c.Assert(warnings, qt.HasLen, 1)
+	c.Assert(warnings[0], qt.Contains, "is in the past")
+
+	s.Expires = "foo"
+	_, _, err = s.Bytes(now)
+	c.Assert(err, qt.ErrorMatches, ".*failed to parse expires.*")
+
+	s.Expires = "2030-01-01T00:00:00Z"
+	_, warnings, err = s.Bytes(now)
+	c.Assert(err, qt.IsNil)
+	c.Assert(warnings, qt.HasLen, 1)
+
+	s = SecurityTxt{Expires: "2024-01-01"}
+	_, _, err = s.Bytes(now)
+	c.Assert(err, qt.ErrorMatches, ".*at least one contact must be set")
+}
+
+func TestJSONString(t *testing.T) {
+	c := qt.New(t)
+
+	conf := Config{AppleAppSiteAssociation: `{"applinks":{"details":[{"appIDs":["ABCDE.org.example.app"]}]}}`}
+	files, _, err := conf.Files(time.Time{})
+	c.Assert(err, qt.IsNil)
+	c.Assert(files, qt.HasLen, 1)
+	c.Assert(string(files[0].Content), qt.Contains, `"appIDs"`)
+
+	conf.AppleAppSiteAssociation = `{"applinks":`
+	_, _, err = conf.Files(time.Time{})
+	c.Assert(err, qt.ErrorMatches, ".*invalid JSON.*")
+}